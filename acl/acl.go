@@ -64,6 +64,12 @@ type ACL struct {
 	variables         *iradix.Tree[capabilitySet]
 	wildcardVariables *iradix.Tree[capabilitySet]
 
+	// jobs maps a namespace and job ID, separated by a null byte, to a
+	// capabilitySet. Job rules using a glob in either the namespace or job
+	// are stored in wildcardJobs.
+	jobs         *iradix.Tree[capabilitySet]
+	wildcardJobs *iradix.Tree[capabilitySet]
+
 	agent    string
 	node     string
 	operator string
@@ -103,6 +109,8 @@ func NewACL(management bool, policies []*Policy) (*ACL, error) {
 	whvTxn := iradix.New[capabilitySet]().Txn()
 	svTxn := iradix.New[capabilitySet]().Txn()
 	wsvTxn := iradix.New[capabilitySet]().Txn()
+	jobTxn := iradix.New[capabilitySet]().Txn()
+	wjobTxn := iradix.New[capabilitySet]().Txn()
 
 	for _, policy := range policies {
 	NAMESPACES:
@@ -158,6 +166,36 @@ func NewACL(management bool, policies []*Policy) (*ACL, error) {
 				}
 			}
 
+		JOBS:
+			for _, job := range ns.Jobs {
+				key := []byte(ns.Name + "\x00" + job.Name)
+				txn := jobTxn
+				if globDefinition || strings.Contains(job.Name, "*") {
+					txn = wjobTxn
+				}
+
+				jobCapabilities, ok := txn.Get(key)
+				if !ok {
+					jobCapabilities = make(capabilitySet)
+					txn.Insert(key, jobCapabilities)
+				}
+
+				// Deny always takes precedence
+				if jobCapabilities.Check(NamespaceCapabilityDeny) {
+					continue
+				}
+
+				for _, cap := range job.Capabilities {
+					if cap == NamespaceCapabilityDeny {
+						// Overwrite any existing capabilities
+						jobCapabilities.Clear()
+						jobCapabilities.Set(NamespaceCapabilityDeny)
+						continue JOBS
+					}
+					jobCapabilities.Set(cap)
+				}
+			}
+
 			// Deny always takes precedence
 			if capabilities.Check(NamespaceCapabilityDeny) {
 				continue NAMESPACES
@@ -243,6 +281,8 @@ func NewACL(management bool, policies []*Policy) (*ACL, error) {
 	acl.wildcardHostVolumes = whvTxn.Commit()
 	acl.variables = svTxn.Commit()
 	acl.wildcardVariables = wsvTxn.Commit()
+	acl.jobs = jobTxn.Commit()
+	acl.wildcardJobs = wjobTxn.Commit()
 
 	return acl, nil
 }
@@ -308,16 +348,82 @@ func (a *ACL) AllowNamespace(ns string) bool {
 
 	// Check for a matching capability set
 	capabilities, ok := a.matchingNamespaceCapabilitySet(ns)
-	if !ok {
+	if ok && capabilities.Check(PolicyDeny) {
 		return false
 	}
 
 	// Check if the capability has been granted
-	if len(capabilities) == 0 {
+	if ok && len(capabilities) > 0 {
+		return true
+	}
+
+	// Rules for the jobs of the namespace allow access to the namespace
+	return a.anyJobAllows(ns, func(c capabilitySet) bool {
+		return len(c) > 0 && !c.Check(NamespaceCapabilityDeny)
+	})
+}
+
+// AllowJobOp is shorthand for AllowJobOperation
+func (a *ACL) AllowJobOp(ns, job, op string) bool {
+	return a.AllowJobOperation(ns, job, op)
+}
+
+// AllowJobOperation checks if a given operation is allowed for a job. The
+// operation is allowed if it's granted by the closest matching job rule or by
+// the job's namespace, unless either of them denies access.
+func (a *ACL) AllowJobOperation(ns, job, op string) bool {
+	// Hot path if ACL is not enabled.
+	if a == nil {
+		return true
+	}
+
+	// Hot path management tokens
+	if a.management {
+		return true
+	}
+
+	nsCapabilities, nsOk := a.matchingNamespaceCapabilitySet(ns)
+	if nsOk && nsCapabilities.Check(NamespaceCapabilityDeny) {
 		return false
 	}
 
-	return !capabilities.Check(PolicyDeny)
+	jobCapabilities, ok := a.matchingJobCapabilitySet(ns, job)
+	if ok {
+		if jobCapabilities.Check(NamespaceCapabilityDeny) {
+			return false
+		}
+		if jobCapabilities.Check(op) {
+			return true
+		}
+	}
+
+	return nsOk && nsCapabilities.Check(op)
+}
+
+// AllowNsOrJobOp checks if a given operation is allowed for a namespace or
+// for any of the job rules of the namespace. It is used to authorize requests
+// for several jobs, whose results must then be filtered using AllowJobOp.
+func (a *ACL) AllowNsOrJobOp(ns, op string) bool {
+	// Hot path if ACL is not enabled.
+	if a == nil {
+		return true
+	}
+
+	if a.AllowNamespaceOperation(ns, op) {
+		return true
+	}
+
+	// Rules for the jobs of a denied namespace are ignored
+	if ns != AllNamespacesSentinel {
+		capabilities, ok := a.matchingNamespaceCapabilitySet(ns)
+		if ok && capabilities.Check(NamespaceCapabilityDeny) {
+			return false
+		}
+	}
+
+	return a.anyJobAllows(ns, func(c capabilitySet) bool {
+		return c.Check(op)
+	})
 }
 
 // AllowHostVolumeOperation checks if a given operation is allowed for a host volume
@@ -450,6 +556,45 @@ func (a *ACL) anyNamespaceAllows(cb func(capabilitySet) bool) bool {
 	return allow
 }
 
+// anyJobAllows returns true if the callback cb returns true for any job rule
+// of the namespace. The job rules of all namespaces are checked if ns is the
+// all namespaces wildcard.
+func (a *ACL) anyJobAllows(ns string, cb func(capabilitySet) bool) bool {
+	allow := false
+
+	checkFn := func(k []byte, v capabilitySet) bool {
+		nsName, _, _ := strings.Cut(string(k), "\x00")
+		if ns == AllNamespacesSentinel || glob.Glob(nsName, ns) {
+			allow = cb(v)
+		}
+		return allow
+	}
+
+	a.jobs.Root().Walk(checkFn)
+	if allow {
+		return true
+	}
+
+	a.wildcardJobs.Root().Walk(checkFn)
+	return allow
+}
+
+// matchingJobCapabilitySet looks for a capabilitySet that matches the
+// namespace and job ID. If no concrete definitions are found, then we return
+// the closest matching glob.
+func (a *ACL) matchingJobCapabilitySet(ns, job string) (capabilitySet, bool) {
+	key := ns + "\x00" + job
+
+	// Check for a concrete matching capability set
+	raw, ok := a.jobs.Get([]byte(key))
+	if ok {
+		return raw, true
+	}
+
+	// We didn't find a concrete match, so lets try and evaluate globs.
+	return a.findClosestMatchingGlob(a.wildcardJobs, key)
+}
+
 // matchingHostVolumeCapabilitySet looks for a capabilitySet that matches the host volume name,
 // if no concrete definitions are found, then we return the closest matching
 // glob.
@@ -503,17 +648,27 @@ type matchingGlob struct {
 }
 
 func (a *ACL) findClosestMatchingGlob(radix *iradix.Tree[capabilitySet], ns string) (capabilitySet, bool) {
+	match, ok := findClosestMatchingGlob(radix, ns)
+	if !ok {
+		return capabilitySet{}, false
+	}
+	return match.capabilitySet, true
+}
+
+// findClosestMatchingGlob returns the glob closest to the name, along with
+// its definition so callers can tell which rule matched.
+func findClosestMatchingGlob(radix *iradix.Tree[capabilitySet], name string) (matchingGlob, bool) {
 	// First, find all globs that match.
-	matchingGlobs := findAllMatchingWildcards(radix, ns)
+	matchingGlobs := findAllMatchingWildcards(radix, name)
 
 	// If none match, let's return.
 	if len(matchingGlobs) == 0 {
-		return capabilitySet{}, false
+		return matchingGlob{}, false
 	}
 
 	// If a single matches, lets be efficient and return early.
 	if len(matchingGlobs) == 1 {
-		return matchingGlobs[0].capabilitySet, true
+		return matchingGlobs[0], true
 	}
 
 	// Stable sort the matched globs, based on the character difference between
@@ -523,7 +678,7 @@ func (a *ACL) findClosestMatchingGlob(radix *iradix.Tree[capabilitySet], ns stri
 		return matchingGlobs[i].difference <= matchingGlobs[j].difference
 	})
 
-	return matchingGlobs[0].capabilitySet, true
+	return matchingGlobs[0], true
 }
 
 func findAllMatchingWildcards(radix *iradix.Tree[capabilitySet], name string) []matchingGlob {
@@ -713,3 +868,25 @@ func NamespaceValidator(ops ...string) func(*ACL, string) bool {
 		return false
 	}
 }
+
+// JobValidator returns a func that wraps ACL.AllowJobOperation in a list of
+// operations. Returns true (allowed) if acls are disabled or if *any*
+// capabilities match.
+func JobValidator(ops ...string) func(*ACL, string, string) bool {
+	return func(acl *ACL, ns, job string) bool {
+		// Always allow if ACLs are disabled.
+		if acl == nil {
+			return true
+		}
+
+		for _, op := range ops {
+			if acl.AllowJobOperation(ns, job, op) {
+				// An operation is allowed, return true
+				return true
+			}
+		}
+
+		// No operations are allowed by this ACL, return false
+		return false
+	}
+}
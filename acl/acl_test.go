@@ -672,6 +672,134 @@ func TestVariablesMatching(t *testing.T) {
 
 }
 
+func TestJobMatching(t *testing.T) {
+	ci.Parallel(t)
+
+	tests := []struct {
+		name   string
+		policy string
+		ns     string
+		job    string
+		op     string
+		allow  bool
+	}{
+		{
+			name: "concrete job grants capability",
+			policy: `namespace "ns" {
+					job "web" { capabilities = ["submit-job"] }}`,
+			ns:    "ns",
+			job:   "web",
+			op:    NamespaceCapabilitySubmitJob,
+			allow: true,
+		},
+		{
+			name: "concrete job doesn't grant capability to other jobs",
+			policy: `namespace "ns" {
+					job "web" { capabilities = ["submit-job"] }}`,
+			ns:    "ns",
+			job:   "api",
+			op:    NamespaceCapabilitySubmitJob,
+			allow: false,
+		},
+		{
+			name: "wildcard job grants capability",
+			policy: `namespace "ns" {
+					job "team-a-*" { policy = "write" }}`,
+			ns:    "ns",
+			job:   "team-a-web",
+			op:    NamespaceCapabilityAllocLifecycle,
+			allow: true,
+		},
+		{
+			name: "job capabilities are limited to their namespace",
+			policy: `namespace "ns" {
+					job "team-a-*" { policy = "write" }}`,
+			ns:    "other",
+			job:   "team-a-web",
+			op:    NamespaceCapabilitySubmitJob,
+			allow: false,
+		},
+		{
+			name: "wildcard namespace with job grants capability",
+			policy: `namespace "*" {
+					job "team-a-*" { capabilities = ["alloc-exec"] }}`,
+			ns:    "other",
+			job:   "team-a-web",
+			op:    NamespaceCapabilityAllocExec,
+			allow: true,
+		},
+		{
+			name: "namespace capabilities apply to jobs without rules",
+			policy: `namespace "ns" {
+					policy = "write"
+					job "team-a-*" { policy = "read" }}`,
+			ns:    "ns",
+			job:   "team-a-web",
+			op:    NamespaceCapabilitySubmitJob,
+			allow: true,
+		},
+		{
+			name: "job deny overrides namespace capabilities",
+			policy: `namespace "ns" {
+					policy = "write"
+					job "team-b-*" { capabilities = ["deny"] }}`,
+			ns:    "ns",
+			job:   "team-b-web",
+			op:    NamespaceCapabilityReadJob,
+			allow: false,
+		},
+		{
+			name: "namespace deny overrides job capabilities",
+			policy: `namespace "ns" {
+					policy = "deny"
+					job "team-a-*" { policy = "write" }}`,
+			ns:    "ns",
+			job:   "team-a-web",
+			op:    NamespaceCapabilitySubmitJob,
+			allow: false,
+		},
+		{
+			name: "closest matching job glob is used",
+			policy: `namespace "ns" {
+					job "team-*" { capabilities = ["deny"] }
+					job "team-a-*" { capabilities = ["submit-job"] }}`,
+			ns:    "ns",
+			job:   "team-a-web",
+			op:    NamespaceCapabilitySubmitJob,
+			allow: true,
+		},
+	}
+
+	for _, tc := range tests {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			policy, err := Parse(tc.policy)
+			require.NoError(t, err)
+
+			acl, err := NewACL(false, []*Policy{policy})
+			require.NoError(t, err)
+			require.Equal(t, tc.allow, acl.AllowJobOperation(tc.ns, tc.job, tc.op))
+		})
+	}
+
+	t.Run("namespace access", func(t *testing.T) {
+		policy, err := Parse(`namespace "ns" {
+					job "team-a-*" { capabilities = ["list-jobs", "read-job"] }}`)
+		require.NoError(t, err)
+
+		acl, err := NewACL(false, []*Policy{policy})
+		require.NoError(t, err)
+
+		require.True(t, acl.AllowNamespace("ns"))
+		require.False(t, acl.AllowNamespace("other"))
+		require.False(t, acl.AllowNamespaceOperation("ns", NamespaceCapabilityListJobs))
+		require.True(t, acl.AllowNsOrJobOp("ns", NamespaceCapabilityListJobs))
+		require.True(t, acl.AllowNsOrJobOp("*", NamespaceCapabilityListJobs))
+		require.False(t, acl.AllowNsOrJobOp("ns", NamespaceCapabilitySubmitJob))
+		require.False(t, acl.AllowNsOrJobOp("other", NamespaceCapabilityListJobs))
+	})
+}
+
 func TestACL_matchingCapabilitySet_returnsAllMatches(t *testing.T) {
 	ci.Parallel(t)
 
@@ -0,0 +1,363 @@
+package acl
+
+import (
+	"fmt"
+	"strings"
+
+	iradix "github.com/hashicorp/go-immutable-radix/v2"
+	"golang.org/x/exp/slices"
+)
+
+// Resources whose access can be explained by Explain.
+const (
+	ResourceNamespace  = "namespace"
+	ResourceJob        = "job"
+	ResourceVariable   = "variable"
+	ResourceNode       = "node"
+	ResourceHostVolume = "host_volume"
+)
+
+// NamedPolicy is a parsed policy along with its name.
+type NamedPolicy struct {
+	Name   string
+	Policy *Policy
+}
+
+// Explanation is the result of an authorization check along with the rules
+// which determined it.
+type Explanation struct {
+	// Allowed is whether the operation is allowed
+	Allowed bool
+
+	// Reason describes how the policies allowed or denied the operation
+	Reason string
+
+	// Rules are the rules of the policies which granted or denied the
+	// operation. It's empty if no rule matched the resource.
+	Rules []*ExplainedRule
+}
+
+// ExplainedRule is a rule of a policy which granted or denied an operation.
+type ExplainedRule struct {
+	// Policy is the name of the policy
+	Policy string
+
+	// Rule identifies the rule within the policy using the policy syntax, for
+	// example `namespace "prod" job "web-*"`
+	Rule string
+
+	// Capabilities are the capabilities of the rule which granted or denied
+	// the operation
+	Capabilities []string
+}
+
+// Explain checks whether an operation is allowed on a resource by a set of
+// policies, and explains which of their rules granted or denied it. The
+// policies are merged with NewACL, so the result is the same as the result of
+// the ACL's Allow methods for the resource.
+//
+// The name is the job ID, variable path or host volume name, and the
+// namespace is ignored for nodes and host volumes. The operation is a
+// capability of the resource, or "read" or "write" for nodes.
+func Explain(policies []*NamedPolicy, resource, ns, name, op string) (*Explanation, error) {
+	// All resources use the same name for their deny capability
+	if op == PolicyDeny {
+		return nil, fmt.Errorf("%q is not an operation", op)
+	}
+
+	parsed := make([]*Policy, 0, len(policies))
+	for _, policy := range policies {
+		parsed = append(parsed, policy.Policy)
+	}
+
+	aclObj, err := NewACL(false, parsed)
+	if err != nil {
+		return nil, err
+	}
+	e := &explainer{acl: aclObj, policies: policies, op: op}
+
+	if ns == AllNamespacesSentinel {
+		switch resource {
+		case ResourceNamespace, ResourceJob, ResourceVariable:
+			return nil, fmt.Errorf("%s access can't be explained for all namespaces", resource)
+		}
+	}
+
+	switch resource {
+	case ResourceNamespace:
+		if !isNamespaceCapabilityValid(op) {
+			return nil, fmt.Errorf("invalid namespace capability %q", op)
+		}
+		return e.namespace(ns), nil
+
+	case ResourceJob:
+		if !isJobCapabilityValid(op) {
+			return nil, fmt.Errorf("invalid job capability %q", op)
+		}
+		return e.job(ns, name), nil
+
+	case ResourceVariable:
+		if !isPathCapabilityValid(op) {
+			return nil, fmt.Errorf("invalid variable capability %q", op)
+		}
+		return e.variable(ns, name), nil
+
+	case ResourceNode:
+		if op != PolicyRead && op != PolicyWrite {
+			return nil, fmt.Errorf("invalid node policy %q", op)
+		}
+		return e.node(), nil
+
+	case ResourceHostVolume:
+		if !isHostVolumeCapabilityValid(op) {
+			return nil, fmt.Errorf("invalid host volume capability %q", op)
+		}
+		return e.hostVolume(name), nil
+
+	default:
+		return nil, fmt.Errorf("invalid resource %q", resource)
+	}
+}
+
+// explainer explains the result of an operation for the merged ACL of a set
+// of policies.
+type explainer struct {
+	acl      *ACL
+	policies []*NamedPolicy
+	op       string
+}
+
+// ruleLookup returns the capabilities granted by the rule of a policy. It
+// returns nil if the policy doesn't have the rule.
+type ruleLookup func(*Policy) []string
+
+func (e *explainer) namespace(ns string) *Explanation {
+	key, caps, ok := matchingRule(e.acl.namespaces, e.acl.wildcardNamespaces, ns)
+	if !ok {
+		return e.noRule(ResourceNamespace)
+	}
+
+	exp := e.explain(namespaceRule(key), caps, NamespaceCapabilityDeny, namespaceLookup(key))
+	exp.Allowed = e.acl.AllowNamespaceOperation(ns, e.op)
+	return exp
+}
+
+// job follows the precedence of AllowJobOperation: a denied namespace denies
+// all its jobs, then the closest job rule is checked, and then the namespace.
+func (e *explainer) job(ns, job string) *Explanation {
+	allowed := e.acl.AllowJobOperation(ns, job, e.op)
+
+	nsKey, nsCaps, nsOk := matchingRule(e.acl.namespaces, e.acl.wildcardNamespaces, ns)
+	nsRule := namespaceRule(nsKey)
+	if nsOk && nsCaps.Check(NamespaceCapabilityDeny) {
+		exp := e.explain(nsRule, nsCaps, NamespaceCapabilityDeny, namespaceLookup(nsKey))
+		exp.Allowed = allowed
+		return exp
+	}
+
+	jobKey, jobCaps, jobOk := matchingRule(e.acl.jobs, e.acl.wildcardJobs, ns+"\x00"+job)
+	jobNs, jobName, _ := strings.Cut(jobKey, "\x00")
+	jobRule := fmt.Sprintf("%s job %q", namespaceRule(jobNs), jobName)
+	if jobOk && (jobCaps.Check(NamespaceCapabilityDeny) || jobCaps.Check(e.op)) {
+		exp := e.explain(jobRule, jobCaps, NamespaceCapabilityDeny, jobLookup(jobNs, jobName))
+		exp.Allowed = allowed
+		return exp
+	}
+
+	var matched []string
+	if jobOk {
+		matched = append(matched, jobRule)
+	}
+	if nsOk {
+		exp := e.explain(nsRule, nsCaps, NamespaceCapabilityDeny, namespaceLookup(nsKey))
+		if exp.Allowed || len(matched) == 0 {
+			exp.Allowed = allowed
+			return exp
+		}
+		matched = append(matched, nsRule)
+	}
+	if len(matched) == 0 {
+		return e.noRule(ResourceJob)
+	}
+	return &Explanation{Allowed: allowed, Reason: e.notGranted(matched...)}
+}
+
+func (e *explainer) variable(ns, path string) *Explanation {
+	key, caps, ok := matchingRule(e.acl.variables, e.acl.wildcardVariables, ns+"\x00"+path)
+	if !ok {
+		return e.noRule(ResourceVariable)
+	}
+
+	varNs, pathSpec, _ := strings.Cut(key, "\x00")
+	rule := fmt.Sprintf("%s variables path %q", namespaceRule(varNs), pathSpec)
+	exp := e.explain(rule, caps, VariablesCapabilityDeny, func(p *Policy) []string {
+		var caps []string
+		for _, nsPolicy := range p.Namespaces {
+			if nsPolicy.Name != varNs || nsPolicy.Variables == nil {
+				continue
+			}
+			for _, pathPolicy := range nsPolicy.Variables.Paths {
+				if pathPolicy.PathSpec == pathSpec {
+					caps = append(caps, pathPolicy.Capabilities...)
+				}
+			}
+		}
+		return caps
+	})
+	exp.Allowed = e.acl.AllowVariableOperation(ns, path, e.op, nil)
+	return exp
+}
+
+func (e *explainer) node() *Explanation {
+	var allowed bool
+	granting := []string{PolicyWrite}
+	if e.op == PolicyRead {
+		allowed = e.acl.AllowNodeRead()
+		granting = append(granting, PolicyRead)
+	} else {
+		allowed = e.acl.AllowNodeWrite()
+	}
+
+	lookup := func(p *Policy) []string {
+		if p.Node == nil {
+			return nil
+		}
+		return []string{p.Node.Policy}
+	}
+
+	switch {
+	case e.acl.node == "":
+		return e.noRule(ResourceNode)
+	case e.acl.node == PolicyDeny:
+		return &Explanation{
+			Allowed: allowed,
+			Reason:  "denied by node",
+			Rules:   e.rules("node", lookup, PolicyDeny),
+		}
+	case allowed:
+		return &Explanation{
+			Allowed: allowed,
+			Reason:  "granted by node",
+			Rules:   e.rules("node", lookup, granting...),
+		}
+	default:
+		return &Explanation{Allowed: allowed, Reason: e.notGranted("node")}
+	}
+}
+
+func (e *explainer) hostVolume(name string) *Explanation {
+	key, caps, ok := matchingRule(e.acl.hostVolumes, e.acl.wildcardHostVolumes, name)
+	if !ok {
+		return e.noRule("host volume")
+	}
+
+	exp := e.explain(fmt.Sprintf("host_volume %q", key), caps, HostVolumeCapabilityDeny, func(p *Policy) []string {
+		var caps []string
+		for _, hv := range p.HostVolumes {
+			if hv.Name == key {
+				caps = append(caps, hv.Capabilities...)
+			}
+		}
+		return caps
+	})
+	exp.Allowed = e.acl.AllowHostVolumeOperation(name, e.op)
+	return exp
+}
+
+// explain explains the result of the operation for the merged capabilities of
+// the rule matching the resource.
+func (e *explainer) explain(rule string, caps capabilitySet, deny string, lookup ruleLookup) *Explanation {
+	switch {
+	case caps.Check(e.op):
+		return &Explanation{
+			Allowed: true,
+			Reason:  fmt.Sprintf("granted by %s", rule),
+			Rules:   e.rules(rule, lookup, e.op),
+		}
+	case caps.Check(deny):
+		return &Explanation{
+			Reason: fmt.Sprintf("denied by %s", rule),
+			Rules:  e.rules(rule, lookup, deny),
+		}
+	default:
+		return &Explanation{Reason: e.notGranted(rule)}
+	}
+}
+
+// rules returns the rules of the policies which have any of the capabilities.
+func (e *explainer) rules(rule string, lookup ruleLookup, caps ...string) []*ExplainedRule {
+	var rules []*ExplainedRule
+	for _, policy := range e.policies {
+		var matched []string
+		for _, c := range lookup(policy.Policy) {
+			if slices.Contains(caps, c) && !slices.Contains(matched, c) {
+				matched = append(matched, c)
+			}
+		}
+		if len(matched) > 0 {
+			rules = append(rules, &ExplainedRule{
+				Policy:       policy.Name,
+				Rule:         rule,
+				Capabilities: matched,
+			})
+		}
+	}
+	return rules
+}
+
+func (e *explainer) noRule(resource string) *Explanation {
+	return &Explanation{Reason: fmt.Sprintf("no rule matches the %s", resource)}
+}
+
+func (e *explainer) notGranted(rules ...string) string {
+	if len(rules) == 1 {
+		return fmt.Sprintf("%s does not grant %q", rules[0], e.op)
+	}
+	return fmt.Sprintf("%s do not grant %q", strings.Join(rules, " and "), e.op)
+}
+
+func namespaceRule(ns string) string {
+	return fmt.Sprintf("namespace %q", ns)
+}
+
+func namespaceLookup(ns string) ruleLookup {
+	return func(p *Policy) []string {
+		var caps []string
+		for _, nsPolicy := range p.Namespaces {
+			if nsPolicy.Name == ns {
+				caps = append(caps, nsPolicy.Capabilities...)
+			}
+		}
+		return caps
+	}
+}
+
+func jobLookup(ns, job string) ruleLookup {
+	return func(p *Policy) []string {
+		var caps []string
+		for _, nsPolicy := range p.Namespaces {
+			if nsPolicy.Name != ns {
+				continue
+			}
+			for _, jobPolicy := range nsPolicy.Jobs {
+				if jobPolicy.Name == job {
+					caps = append(caps, jobPolicy.Capabilities...)
+				}
+			}
+		}
+		return caps
+	}
+}
+
+// matchingRule returns the definition and capabilities of the rule matching
+// the name, preferring an exact match over the closest glob.
+func matchingRule(exact, wildcard *iradix.Tree[capabilitySet], name string) (string, capabilitySet, bool) {
+	if caps, ok := exact.Get([]byte(name)); ok {
+		return name, caps, true
+	}
+	match, ok := findClosestMatchingGlob(wildcard, name)
+	if !ok {
+		return "", nil, false
+	}
+	return match.name, match.capabilitySet, true
+}
@@ -0,0 +1,248 @@
+package acl
+
+import (
+	"testing"
+
+	"github.com/hashicorp/nomad/ci"
+	"github.com/stretchr/testify/require"
+)
+
+func TestExplain(t *testing.T) {
+	ci.Parallel(t)
+
+	policies := map[string]string{
+		"dev": `
+namespace "dev-*" {
+  policy = "write"
+  variables {
+    path "team/*" {
+      capabilities = ["read"]
+    }
+  }
+}
+namespace "prod" {
+  policy = "read"
+  job "web-*" {
+    capabilities = ["submit-job", "dispatch-job"]
+  }
+  job "web-billing" {
+    capabilities = ["deny"]
+  }
+}
+node {
+  policy = "read"
+}
+host_volume "ssd-*" {
+  policy = "read"
+}
+`,
+		"ops": `
+namespace "prod" {
+  capabilities = ["submit-job", "read-job"]
+}
+namespace "secret" {
+  policy = "deny"
+}
+node {
+  policy = "write"
+}
+`,
+	}
+
+	var named []*NamedPolicy
+	for _, name := range []string{"dev", "ops"} {
+		policy, err := Parse(policies[name])
+		require.NoError(t, err)
+		named = append(named, &NamedPolicy{Name: name, Policy: policy})
+	}
+
+	testCases := []struct {
+		name     string
+		resource string
+		ns       string
+		target   string
+		op       string
+		expected *Explanation
+	}{
+		{
+			name:     "namespace granted by several policies",
+			resource: ResourceNamespace,
+			ns:       "prod",
+			op:       NamespaceCapabilityReadJob,
+			expected: &Explanation{
+				Allowed: true,
+				Reason:  `granted by namespace "prod"`,
+				Rules: []*ExplainedRule{
+					{Policy: "dev", Rule: `namespace "prod"`, Capabilities: []string{"read-job"}},
+					{Policy: "ops", Rule: `namespace "prod"`, Capabilities: []string{"read-job"}},
+				},
+			},
+		},
+		{
+			name:     "namespace granted by glob",
+			resource: ResourceNamespace,
+			ns:       "dev-1",
+			op:       NamespaceCapabilitySubmitJob,
+			expected: &Explanation{
+				Allowed: true,
+				Reason:  `granted by namespace "dev-*"`,
+				Rules: []*ExplainedRule{
+					{Policy: "dev", Rule: `namespace "dev-*"`, Capabilities: []string{"submit-job"}},
+				},
+			},
+		},
+		{
+			name:     "namespace denied",
+			resource: ResourceNamespace,
+			ns:       "secret",
+			op:       NamespaceCapabilityReadJob,
+			expected: &Explanation{
+				Reason: `denied by namespace "secret"`,
+				Rules: []*ExplainedRule{
+					{Policy: "ops", Rule: `namespace "secret"`, Capabilities: []string{"deny"}},
+				},
+			},
+		},
+		{
+			name:     "namespace not granted",
+			resource: ResourceNamespace,
+			ns:       "prod",
+			op:       NamespaceCapabilityAllocExec,
+			expected: &Explanation{Reason: `namespace "prod" does not grant "alloc-exec"`},
+		},
+		{
+			name:     "namespace without rule",
+			resource: ResourceNamespace,
+			ns:       "other",
+			op:       NamespaceCapabilityReadJob,
+			expected: &Explanation{Reason: "no rule matches the namespace"},
+		},
+		{
+			name:     "job granted by job rule",
+			resource: ResourceJob,
+			ns:       "prod",
+			target:   "web-api",
+			op:       NamespaceCapabilityDispatchJob,
+			expected: &Explanation{
+				Allowed: true,
+				Reason:  `granted by namespace "prod" job "web-*"`,
+				Rules: []*ExplainedRule{
+					{Policy: "dev", Rule: `namespace "prod" job "web-*"`, Capabilities: []string{"dispatch-job"}},
+				},
+			},
+		},
+		{
+			name:     "job granted by namespace",
+			resource: ResourceJob,
+			ns:       "prod",
+			target:   "web-api",
+			op:       NamespaceCapabilityReadJob,
+			expected: &Explanation{
+				Allowed: true,
+				Reason:  `granted by namespace "prod"`,
+				Rules: []*ExplainedRule{
+					{Policy: "dev", Rule: `namespace "prod"`, Capabilities: []string{"read-job"}},
+					{Policy: "ops", Rule: `namespace "prod"`, Capabilities: []string{"read-job"}},
+				},
+			},
+		},
+		{
+			name:     "job denied by job rule",
+			resource: ResourceJob,
+			ns:       "prod",
+			target:   "web-billing",
+			op:       NamespaceCapabilitySubmitJob,
+			expected: &Explanation{
+				Reason: `denied by namespace "prod" job "web-billing"`,
+				Rules: []*ExplainedRule{
+					{Policy: "dev", Rule: `namespace "prod" job "web-billing"`, Capabilities: []string{"deny"}},
+				},
+			},
+		},
+		{
+			name:     "job not granted",
+			resource: ResourceJob,
+			ns:       "prod",
+			target:   "web-api",
+			op:       NamespaceCapabilityAllocExec,
+			expected: &Explanation{
+				Reason: `namespace "prod" job "web-*" and namespace "prod" do not grant "alloc-exec"`,
+			},
+		},
+		{
+			name:     "variable granted",
+			resource: ResourceVariable,
+			ns:       "dev-1",
+			target:   "team/config",
+			op:       VariablesCapabilityList,
+			expected: &Explanation{
+				Allowed: true,
+				Reason:  `granted by namespace "dev-*" variables path "team/*"`,
+				Rules: []*ExplainedRule{
+					{Policy: "dev", Rule: `namespace "dev-*" variables path "team/*"`, Capabilities: []string{"list"}},
+				},
+			},
+		},
+		{
+			name:     "variable not granted",
+			resource: ResourceVariable,
+			ns:       "dev-1",
+			target:   "team/config",
+			op:       VariablesCapabilityWrite,
+			expected: &Explanation{
+				Reason: `namespace "dev-*" variables path "team/*" does not grant "write"`,
+			},
+		},
+		{
+			name:     "node granted by highest privilege",
+			resource: ResourceNode,
+			op:       PolicyWrite,
+			expected: &Explanation{
+				Allowed: true,
+				Reason:  "granted by node",
+				Rules: []*ExplainedRule{
+					{Policy: "ops", Rule: "node", Capabilities: []string{"write"}},
+				},
+			},
+		},
+		{
+			name:     "host volume not granted",
+			resource: ResourceHostVolume,
+			target:   "ssd-1",
+			op:       HostVolumeCapabilityMountReadWrite,
+			expected: &Explanation{
+				Reason: `host_volume "ssd-*" does not grant "mount-readwrite"`,
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			exp, err := Explain(named, tc.resource, tc.ns, tc.target, tc.op)
+			require.NoError(t, err)
+			require.Equal(t, tc.expected, exp)
+		})
+	}
+}
+
+func TestExplain_Invalid(t *testing.T) {
+	ci.Parallel(t)
+
+	_, err := Explain(nil, ResourceNamespace, "default", "", "fly")
+	require.EqualError(t, err, `invalid namespace capability "fly"`)
+
+	_, err = Explain(nil, ResourceJob, "default", "web", NamespaceCapabilityCSIMountVolume)
+	require.EqualError(t, err, `invalid job capability "csi-mount-volume"`)
+
+	_, err = Explain(nil, ResourceNode, "", "", NamespaceCapabilityReadJob)
+	require.EqualError(t, err, `invalid node policy "read-job"`)
+
+	_, err = Explain(nil, ResourceJob, AllNamespacesSentinel, "web", NamespaceCapabilityReadJob)
+	require.EqualError(t, err, "job access can't be explained for all namespaces")
+
+	_, err = Explain(nil, ResourceNamespace, "default", "", PolicyDeny)
+	require.EqualError(t, err, `"deny" is not an operation`)
+
+	_, err = Explain(nil, "quota", "", "", PolicyRead)
+	require.EqualError(t, err, `invalid resource "quota"`)
+}
@@ -3,6 +3,7 @@ package acl
 import (
 	"fmt"
 	"regexp"
+	"strings"
 
 	"github.com/hashicorp/hcl"
 )
@@ -108,6 +109,16 @@ type NamespacePolicy struct {
 	Policy       string
 	Capabilities []string
 	Variables    *VariablesPolicy `hcl:"variables"`
+	Jobs         []*JobPolicy     `hcl:"job,expand"`
+}
+
+// JobPolicy is the policy for the jobs of a namespace matching a glob. The
+// capabilities of a job policy are granted in addition to the capabilities of
+// its namespace, unless the job policy denies access to the jobs.
+type JobPolicy struct {
+	Name         string `hcl:",key"`
+	Policy       string
+	Capabilities []string
 }
 
 type VariablesPolicy struct {
@@ -183,6 +194,22 @@ func isNamespaceCapabilityValid(cap string) bool {
 	}
 }
 
+// isJobCapabilityValid ensures the given capability is valid for a job
+// policy. Only the namespace capabilities which apply to a single job can be
+// granted for jobs.
+func isJobCapabilityValid(cap string) bool {
+	switch cap {
+	case NamespaceCapabilityDeny, NamespaceCapabilityListJobs, NamespaceCapabilityReadJob,
+		NamespaceCapabilitySubmitJob, NamespaceCapabilityDispatchJob, NamespaceCapabilityReadLogs,
+		NamespaceCapabilityReadFS, NamespaceCapabilityAllocLifecycle,
+		NamespaceCapabilityAllocExec, NamespaceCapabilityAllocNodeExec,
+		NamespaceCapabilityReadJobScaling, NamespaceCapabilityScaleJob:
+		return true
+	default:
+		return false
+	}
+}
+
 // isPathCapabilityValid ensures the given capability is valid for a
 // variables path policy
 func isPathCapabilityValid(cap string) bool {
@@ -244,6 +271,19 @@ func expandNamespacePolicy(policy string) []string {
 	}
 }
 
+// expandJobPolicy provides the equivalent set of capabilities for a job
+// policy. These are the capabilities of the namespace policy which apply to a
+// single job.
+func expandJobPolicy(policy string) []string {
+	var caps []string
+	for _, cap := range expandNamespacePolicy(policy) {
+		if isJobCapabilityValid(cap) {
+			caps = append(caps, cap)
+		}
+	}
+	return caps
+}
+
 func isHostVolumeCapabilityValid(cap string) bool {
 	switch cap {
 	case HostVolumeCapabilityDeny, HostVolumeCapabilityMountReadOnly, HostVolumeCapabilityMountReadWrite:
@@ -347,6 +387,27 @@ func Parse(rules string) (*Policy, error) {
 
 		}
 
+		for _, job := range ns.Jobs {
+			if job.Name == "" || strings.Contains(job.Name, "\x00") {
+				return nil, fmt.Errorf("Invalid job name in namespace %s: %#v", ns.Name, job)
+			}
+			if job.Policy != "" && !isPolicyValid(job.Policy) {
+				return nil, fmt.Errorf("Invalid job policy in namespace %s: %#v", ns.Name, job)
+			}
+			for _, cap := range job.Capabilities {
+				if !isJobCapabilityValid(cap) {
+					return nil, fmt.Errorf(
+						"Invalid job capability '%s' in namespace %s: %#v", cap, ns.Name, job)
+				}
+			}
+
+			// Expand the short hand policy to the capabilities and
+			// add to any existing capabilities
+			if job.Policy != "" {
+				job.Capabilities = append(job.Capabilities, expandJobPolicy(job.Policy)...)
+			}
+		}
+
 	}
 
 	for _, hv := range p.HostVolumes {
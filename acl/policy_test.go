@@ -340,6 +340,76 @@ func TestParse(t *testing.T) {
 			"Invalid host volume name",
 			nil,
 		},
+		{
+			`
+			namespace "platform" {
+				policy = "read"
+				job "team-a-*" {
+					policy       = "scale"
+					capabilities = ["alloc-lifecycle"]
+				}
+				job "team-b-api" {
+					capabilities = ["deny"]
+				}
+			}
+			`,
+			"",
+			&Policy{
+				Namespaces: []*NamespacePolicy{
+					{
+						Name:   "platform",
+						Policy: PolicyRead,
+						Capabilities: []string{
+							NamespaceCapabilityListJobs,
+							NamespaceCapabilityParseJob,
+							NamespaceCapabilityReadJob,
+							NamespaceCapabilityCSIListVolume,
+							NamespaceCapabilityCSIReadVolume,
+							NamespaceCapabilityReadJobScaling,
+							NamespaceCapabilityListScalingPolicies,
+							NamespaceCapabilityReadScalingPolicy,
+						},
+						Jobs: []*JobPolicy{
+							{
+								Name:   "team-a-*",
+								Policy: PolicyScale,
+								Capabilities: []string{
+									NamespaceCapabilityAllocLifecycle,
+									NamespaceCapabilityReadJobScaling,
+									NamespaceCapabilityScaleJob,
+								},
+							},
+							{
+								Name:         "team-b-api",
+								Capabilities: []string{NamespaceCapabilityDeny},
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			`
+			namespace "platform" {
+				job "team-a-*" {
+					capabilities = ["csi-write-volume"]
+				}
+			}
+			`,
+			"Invalid job capability",
+			nil,
+		},
+		{
+			`
+			namespace "platform" {
+				job "team-a-*" {
+					policy = "owner"
+				}
+			}
+			`,
+			"Invalid job policy",
+			nil,
+		},
 		{
 			`
 			plugin {
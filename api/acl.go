@@ -204,6 +204,53 @@ func (a *ACLTokens) ExchangeOneTimeToken(secret string, q *WriteOptions) (*ACLTo
 	return resp.Token, wm, nil
 }
 
+// Authorize checks whether an operation on a resource is allowed, and explains
+// which ACL policy rules allowed or denied it. The namespace of namespace, job
+// and variable resources is the namespace of the query options.
+func (a *ACLTokens) Authorize(req *ACLAuthorizeRequest, q *QueryOptions) (*ACLAuthorizeResponse, *QueryMeta, error) {
+	var resp ACLAuthorizeResponse
+	qm, err := a.client.putQuery("/v1/acl/authorize", req, &resp, q)
+	if err != nil {
+		return nil, nil, err
+	}
+	return &resp, qm, nil
+}
+
+// Rotate is used to replace a token with a new token which has the same
+// policies, roles and TTL. The rotated token stays valid for the grace period,
+// which defaults to one hour when zero, and is then deleted.
+func (a *ACLTokens) Rotate(accessorID string, gracePeriod time.Duration, q *WriteOptions) (*ACLTokenRotateResponse, *WriteMeta, error) {
+	if accessorID == "" {
+		return nil, nil, errors.New("missing accessor ID")
+	}
+	path := "/v1/acl/token/" + accessorID + "/rotate"
+	if gracePeriod != 0 {
+		path += "?grace_period=" + gracePeriod.String()
+	}
+	var resp ACLTokenRotateResponse
+	wm, err := a.client.put(path, nil, &resp, q)
+	if err != nil {
+		return nil, nil, err
+	}
+	return &resp, wm, nil
+}
+
+// ListExpiring is used to list the tokens which expire within the given time
+// window, ordered by their expiration time. The window defaults to the lead
+// time used by the servers to notify about expiring tokens when zero.
+func (a *ACLTokens) ListExpiring(within time.Duration, q *QueryOptions) ([]*ACLTokenListStub, *QueryMeta, error) {
+	path := "/v1/acl/tokens/expiring"
+	if within != 0 {
+		path += "?within=" + within.String()
+	}
+	var resp []*ACLTokenListStub
+	qm, err := a.client.query(path, &resp, q)
+	if err != nil {
+		return nil, nil, err
+	}
+	return resp, qm, nil
+}
+
 var (
 	// errMissingACLRoleID is the generic errors to use when a call is missing
 	// the required ACL Role ID parameter.
@@ -657,6 +704,61 @@ type OneTimeTokenExchangeResponse struct {
 	Token *ACLToken
 }
 
+// ACLAuthorizeRequest is used to check whether an operation on a resource is
+// allowed.
+type ACLAuthorizeRequest struct {
+	// AccessorID, Roles and Policies select the ACL token, the ACL roles by
+	// name, or the ACL policies by name whose access is checked. They can
+	// only be set by management tokens. The token of the request is checked
+	// if none of them are set.
+	AccessorID string
+	Roles      []string
+	Policies   []string
+
+	// Resource is the type of the resource, which is one of "namespace",
+	// "job", "variable", "node" or "host_volume".
+	Resource string
+
+	// Name is the job ID, variable path or host volume name of the resource.
+	Name string
+
+	// Capability is the capability being checked, or "read" or "write" for
+	// nodes.
+	Capability string
+}
+
+// ACLAuthorizeResponse is the result of checking whether an operation on a
+// resource is allowed.
+type ACLAuthorizeResponse struct {
+	// Allowed is whether the operation is allowed.
+	Allowed bool
+
+	// Reason describes how the policies allowed or denied the operation.
+	Reason string
+
+	// Rules are the rules of the policies which allowed or denied the
+	// operation.
+	Rules []*ACLAuthorizeRule
+}
+
+// ACLAuthorizeRule is a rule of an ACL policy which allowed or denied an
+// operation.
+type ACLAuthorizeRule struct {
+	Policy       string
+	Rule         string
+	Capabilities []string
+}
+
+// ACLTokenRotateResponse is the response when rotating an ACL token.
+type ACLTokenRotateResponse struct {
+
+	// Token is the new ACL token.
+	Token *ACLToken
+
+	// Rotated is the rotated ACL token, with its updated expiration time.
+	Rotated *ACLToken
+}
+
 // BootstrapRequest is used for when operators provide an ACL Bootstrap Token
 type BootstrapRequest struct {
 	BootstrapSecret string
@@ -848,6 +950,33 @@ type ACLAuthMethodConfig struct {
 	// (value).
 	ClaimMappings     map[string]string
 	ListClaimMappings map[string]string
+	// The URL of the LDAP server, using the ldap:// or ldaps:// scheme
+	LDAPURL string
+	// Upgrade ldap:// connections to TLS using StartTLS
+	LDAPStartTLS bool
+	// PEM encoded CA cert used to verify the certificate of the LDAP server
+	LDAPCACert string
+	// Disable verification of the certificate of the LDAP server
+	LDAPInsecureSkipVerify bool
+	// The credentials used to search for users and groups. An anonymous bind
+	// is used if LDAPBindDN is empty.
+	LDAPBindDN       string
+	LDAPBindPassword string
+	// The base DN under which users are searched for
+	LDAPUserBaseDN string
+	// The filter used to search for the user logging in, where {{.Username}}
+	// is replaced by the escaped username
+	LDAPUserFilter string
+	// The attributes of the user entry added to the user's claims
+	LDAPUserAttributes []string
+	// The base DN under which groups are searched for
+	LDAPGroupBaseDN string
+	// The filter used to search for the groups of the user, where
+	// {{.Username}} and {{.UserDN}} are replaced by the escaped username and
+	// DN of the user
+	LDAPGroupFilter string
+	// The attribute of group entries used as the name of the group
+	LDAPGroupAttribute string
 }
 
 // MarshalJSON implements the json.Marshaler interface and allows
@@ -967,6 +1096,15 @@ const (
 	// ACLAuthMethodTypeJWT the ACLAuthMethod.Type and represents an auth-method
 	// which uses the JWT type.
 	ACLAuthMethodTypeJWT = "JWT"
+
+	// ACLAuthMethodTypeLDAP the ACLAuthMethod.Type and represents an
+	// auth-method which authenticates users against an LDAP server.
+	ACLAuthMethodTypeLDAP = "LDAP"
+
+	// ACLAuthMethodTypeWorkload the ACLAuthMethod.Type and represents an
+	// auth-method which authenticates Nomad workloads using their workload
+	// identity.
+	ACLAuthMethodTypeWorkload = "WORKLOAD"
 )
 
 // ACLBindingRule contains a direct relation to an ACLAuthMethod and represents
@@ -1103,6 +1241,11 @@ type ACLLoginRequest struct {
 	// AuthMethodName is the name of the auth method being used to login. This
 	// is a required parameter.
 	AuthMethodName string
-	// LoginToken is the token used to login. This is a required parameter.
+	// LoginToken is the token used to login. This is required unless
+	// Username and Password are set.
 	LoginToken string
+	// Username and Password are the credentials used to login with LDAP auth
+	// methods.
+	Username string
+	Password string
 }
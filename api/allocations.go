@@ -4,7 +4,9 @@ import (
 	"context"
 	"errors"
 	"io"
+	"net/url"
 	"sort"
+	"strconv"
 	"strings"
 	"time"
 )
@@ -119,6 +121,27 @@ func (a *Allocations) Stats(alloc *Allocation, q *QueryOptions) (*AllocResourceU
 	return &resp, err
 }
 
+// StatsHistory gets the recent resource usage history of the tasks in an
+// allocation. If task is set, only the history of that task is returned. If
+// since is non-zero, only samples collected after it are returned.
+//
+// Note: for cluster topologies where API consumers don't have network access to
+// Nomad clients, set api.ClientConnTimeout to a small value (ex 1ms) to avoid
+// long pauses on this API call.
+func (a *Allocations) StatsHistory(alloc *Allocation, task string, since time.Time, q *QueryOptions) (AllocStatsHistory, error) {
+	v := url.Values{}
+	if task != "" {
+		v.Set("task", task)
+	}
+	if !since.IsZero() {
+		v.Set("since", strconv.FormatInt(since.UnixNano(), 10))
+	}
+
+	var resp AllocStatsHistory
+	_, err := a.client.query("/v1/client/allocation/"+alloc.ID+"/stats/history?"+v.Encode(), &resp, q)
+	return resp, err
+}
+
 // Checks gets status information for nomad service checks that exist in the allocation.
 //
 // Note: for cluster topologies where API consumers don't have network access to
@@ -141,6 +164,29 @@ func (a *Allocations) GC(alloc *Allocation, q *QueryOptions) error {
 	return err
 }
 
+// Pin exempts a terminal allocation from garbage collection on its client
+// until it is unpinned.
+//
+// Note: for cluster topologies where API consumers don't have network access to
+// Nomad clients, set api.ClientConnTimeout to a small value (ex 1ms) to avoid
+// long pauses on this API call.
+func (a *Allocations) Pin(alloc *Allocation, q *QueryOptions) error {
+	var resp GenericResponse
+	_, err := a.client.putQuery("/v1/client/allocation/"+alloc.ID+"/pin", nil, &resp, q)
+	return err
+}
+
+// Unpin makes a pinned allocation eligible for garbage collection again.
+//
+// Note: for cluster topologies where API consumers don't have network access to
+// Nomad clients, set api.ClientConnTimeout to a small value (ex 1ms) to avoid
+// long pauses on this API call.
+func (a *Allocations) Unpin(alloc *Allocation, q *QueryOptions) error {
+	var resp GenericResponse
+	_, err := a.client.putQuery("/v1/client/allocation/"+alloc.ID+"/unpin", nil, &resp, q)
+	return err
+}
+
 // Restart restarts the tasks that are currently running or a specific task if
 // taskName is provided. An error is returned if the task to be restarted is
 // not running.
@@ -231,6 +277,39 @@ func (a *Allocations) Signal(alloc *Allocation, q *QueryOptions, task, signal st
 	return err
 }
 
+// Pause freezes the processes of the given task of the allocation, or of all
+// its running tasks if task is empty. Paused tasks keep their memory and state
+// until they are resumed.
+//
+// Note: for cluster topologies where API consumers don't have network access to
+// Nomad clients, set api.ClientConnTimeout to a small value (ex 1ms) to avoid
+// long pauses on this API call.
+func (a *Allocations) Pause(alloc *Allocation, task string, q *QueryOptions) error {
+	req := AllocPauseRequest{
+		Task: task,
+	}
+
+	var resp GenericResponse
+	_, err := a.client.putQuery("/v1/client/allocation/"+alloc.ID+"/pause", &req, &resp, q)
+	return err
+}
+
+// Resume thaws the processes of the given paused task of the allocation, or of
+// all its paused tasks if task is empty.
+//
+// Note: for cluster topologies where API consumers don't have network access to
+// Nomad clients, set api.ClientConnTimeout to a small value (ex 1ms) to avoid
+// long pauses on this API call.
+func (a *Allocations) Resume(alloc *Allocation, task string, q *QueryOptions) error {
+	req := AllocPauseRequest{
+		Task: task,
+	}
+
+	var resp GenericResponse
+	_, err := a.client.putQuery("/v1/client/allocation/"+alloc.ID+"/resume", &req, &resp, q)
+	return err
+}
+
 // Services is used to return a list of service registrations associated to the
 // specified allocID.
 func (a *Allocations) Services(allocID string, q *QueryOptions) ([]*ServiceRegistration, *QueryMeta, error) {
@@ -495,6 +574,10 @@ type AllocSignalRequest struct {
 	Signal string
 }
 
+type AllocPauseRequest struct {
+	Task string
+}
+
 // GenericResponse is used to respond to a request where no
 // specific response information is needed.
 type GenericResponse struct {
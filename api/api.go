@@ -40,6 +40,14 @@ const (
 	// by the API which indicates the caller does not have permission to
 	// perform the action.
 	PermissionDeniedErrorContent = "Permission denied"
+
+	// defaultRateLimitRetries is the number of times requests rejected by a
+	// server rate limit are retried by default.
+	defaultRateLimitRetries = 3
+
+	// maxRateLimitRetryAfter bounds how long to wait before retrying a
+	// request rejected by a server rate limit.
+	maxRateLimitRetryAfter = 30 * time.Second
 )
 
 // QueryOptions are used to parametrize a query
@@ -197,6 +205,12 @@ type Config struct {
 	TLSConfig *TLSConfig
 
 	Headers http.Header
+
+	// RateLimitRetries is the number of times a request rejected by a server
+	// rate limit is retried, after waiting for as long as the server asks.
+	// If zero, requests are retried up to 3 times. A negative value disables
+	// retries.
+	RateLimitRetries int
 }
 
 // ClientConfig copies the configuration with a new client address, region, and
@@ -215,6 +229,8 @@ func (c *Config) ClientConfig(region, address string, tlsEnabled bool) *Config {
 		HttpAuth:   c.HttpAuth,
 		WaitTime:   c.WaitTime,
 		TLSConfig:  c.TLSConfig.Copy(),
+
+		RateLimitRetries: c.RateLimitRetries,
 	}
 
 	// Update the tls server name for connecting to a client
@@ -783,8 +799,61 @@ func (m *multiCloser) Read(p []byte) (int, error) {
 	return m.reader.Read(p)
 }
 
-// doRequest runs a request with our client
+// doRequest runs a request with our client. Requests rejected by a server
+// rate limit are retried after the delay given by the server, unless their
+// body can't be sent again.
 func (c *Client) doRequest(r *request) (time.Duration, *http.Response, error) {
+	retries := c.config.RateLimitRetries
+	if retries == 0 {
+		retries = defaultRateLimitRetries
+	}
+
+	for attempt := 0; ; attempt++ {
+		diff, resp, err := c.doRequestOnce(r)
+		if err != nil || resp.StatusCode != http.StatusTooManyRequests ||
+			attempt >= retries || (r.obj == nil && r.body != nil) {
+			return diff, resp, err
+		}
+
+		wait := rateLimitRetryAfter(resp)
+		_, _ = io.Copy(io.Discard, resp.Body)
+		resp.Body.Close()
+
+		// The body is encoded again from the object on the next attempt
+		if r.obj != nil {
+			r.body = nil
+		}
+
+		ctx := r.ctx
+		if ctx == nil {
+			ctx = context.Background()
+		}
+		timer := time.NewTimer(wait)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return 0, nil, ctx.Err()
+		case <-timer.C:
+		}
+	}
+}
+
+// rateLimitRetryAfter returns how long to wait before retrying a request
+// rejected by a rate limit, from its Retry-After header.
+func rateLimitRetryAfter(resp *http.Response) time.Duration {
+	seconds, err := strconv.Atoi(resp.Header.Get("Retry-After"))
+	if err != nil || seconds < 1 {
+		return time.Second
+	}
+	wait := time.Duration(seconds) * time.Second
+	if wait > maxRateLimitRetryAfter {
+		wait = maxRateLimitRetryAfter
+	}
+	return wait
+}
+
+// doRequestOnce sends a request once
+func (c *Client) doRequestOnce(r *request) (time.Duration, *http.Response, error) {
 	req, err := r.toHTTP()
 	if err != nil {
 		return 0, nil, err
@@ -592,3 +592,41 @@ func TestClient_autoUnzip(t *testing.T) {
 		Body:   io.NopCloser(&b),
 	}, nil)
 }
+
+func TestClient_RateLimitRetry(t *testing.T) {
+	testutil.Parallel(t)
+
+	var requests int
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		body, _ := io.ReadAll(r.Body)
+		if requests == 1 {
+			w.Header().Set("Retry-After", "1")
+			w.WriteHeader(http.StatusTooManyRequests)
+			return
+		}
+		_, _ = w.Write(body)
+	}))
+	defer srv.Close()
+
+	conf := DefaultConfig()
+	conf.Address = srv.URL
+	client, err := NewClient(conf)
+	must.NoError(t, err)
+
+	// The request is retried with the same body after the server's delay
+	var out struct{ S string }
+	start := time.Now()
+	_, err = client.put("/", struct{ S string }{"input"}, &out, nil)
+	must.NoError(t, err)
+	must.Eq(t, "input", out.S)
+	must.Eq(t, 2, requests)
+	must.GreaterEq(t, time.Second, time.Since(start))
+
+	// Retries may be disabled
+	requests = 0
+	client.config.RateLimitRetries = -1
+	_, err = client.put("/", struct{ S string }{"input"}, &out, nil)
+	must.ErrorContains(t, err, "429")
+	must.Eq(t, 1, requests)
+}
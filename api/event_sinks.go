@@ -0,0 +1,130 @@
+package api
+
+import (
+	"sort"
+	"time"
+)
+
+const (
+	// EventSinkWebhook is the type of event sinks that POST events to an
+	// HTTP endpoint.
+	EventSinkWebhook = "webhook"
+)
+
+// EventSinks is used to query the event sink endpoints.
+type EventSinks struct {
+	client *Client
+}
+
+// EventSinks returns a new handle on the event sinks.
+func (c *Client) EventSinks() *EventSinks {
+	return &EventSinks{client: c}
+}
+
+// EventSink is a destination that the leader delivers events from the event
+// stream to.
+type EventSink struct {
+	// ID is the unique identifier of the sink
+	ID string
+
+	// Type is the type of the sink. Only "webhook" is supported.
+	Type string
+
+	// Address is the URL events are POSTed to
+	Address string
+
+	// Topics are the topics and keys of the events sent to the sink
+	Topics map[Topic][]string
+
+	// Namespace restricts the sink to events of a single namespace. "*"
+	// includes events of all namespaces.
+	Namespace string
+
+	// Filter is an expression evaluated against the payload of each event.
+	// Only events which match the expression are sent.
+	Filter string
+
+	// BatchSize is the maximum number of events sent in a single request
+	BatchSize int
+
+	// LatestIndex is the index of the latest event acknowledged by the sink,
+	// as last persisted by the leader.
+	LatestIndex uint64
+
+	CreateIndex uint64
+	ModifyIndex uint64
+}
+
+// EventSinkStatus is the delivery status of an event sink.
+type EventSinkStatus struct {
+	ID          string
+	Running     bool
+	Healthy     bool
+	Lagging     bool
+	LatestIndex uint64
+	Lag         uint64
+	LastError   string
+	Failures    int
+	LastSuccess time.Time
+	LastFailure time.Time
+}
+
+// List is used to list all event sinks.
+func (e *EventSinks) List(q *QueryOptions) ([]*EventSink, *QueryMeta, error) {
+	var resp []*EventSink
+	qm, err := e.client.query("/v1/event/sinks", &resp, q)
+	if err != nil {
+		return nil, nil, err
+	}
+	sort.Slice(resp, func(i, j int) bool { return resp[i].ID < resp[j].ID })
+	return resp, qm, nil
+}
+
+// Info is used to query a single event sink by its ID.
+func (e *EventSinks) Info(id string, q *QueryOptions) (*EventSink, *QueryMeta, error) {
+	var resp EventSink
+	qm, err := e.client.query("/v1/event/sink/"+id, &resp, q)
+	if err != nil {
+		return nil, nil, err
+	}
+	return &resp, qm, nil
+}
+
+// Register is used to register or update an event sink.
+func (e *EventSinks) Register(sink *EventSink, q *WriteOptions) (*WriteMeta, error) {
+	wm, err := e.client.put("/v1/event/sink/"+sink.ID, sink, nil, q)
+	if err != nil {
+		return nil, err
+	}
+	return wm, nil
+}
+
+// Deregister is used to deregister an event sink.
+func (e *EventSinks) Deregister(id string, q *WriteOptions) (*WriteMeta, error) {
+	wm, err := e.client.delete("/v1/event/sink/"+id, nil, nil, q)
+	if err != nil {
+		return nil, err
+	}
+	return wm, nil
+}
+
+// Status is used to query the delivery status of a single event sink.
+func (e *EventSinks) Status(id string, q *QueryOptions) (*EventSinkStatus, *QueryMeta, error) {
+	var resp EventSinkStatus
+	qm, err := e.client.query("/v1/event/sink/"+id+"/status", &resp, q)
+	if err != nil {
+		return nil, nil, err
+	}
+	return &resp, qm, nil
+}
+
+// Statuses is used to query the delivery status of all event sinks.
+func (e *EventSinks) Statuses(q *QueryOptions) ([]*EventSinkStatus, *QueryMeta, error) {
+	var resp []*EventSinkStatus
+	qm, err := e.client.query("/v1/event/sinks/status", &resp, q)
+	if err != nil {
+		return nil, nil, err
+	}
+	sort.Slice(resp, func(i, j int) bool { return resp[i].ID < resp[j].ID })
+	return resp, qm, nil
+}
@@ -11,13 +11,19 @@ import (
 )
 
 const (
-	TopicDeployment Topic = "Deployment"
-	TopicEvaluation Topic = "Evaluation"
-	TopicAllocation Topic = "Allocation"
-	TopicJob        Topic = "Job"
-	TopicNode       Topic = "Node"
-	TopicService    Topic = "Service"
-	TopicAll        Topic = "*"
+	TopicDeployment    Topic = "Deployment"
+	TopicEvaluation    Topic = "Evaluation"
+	TopicAllocation    Topic = "Allocation"
+	TopicJob           Topic = "Job"
+	TopicNode          Topic = "Node"
+	TopicService       Topic = "Service"
+	TopicVariables     Topic = "Variables"
+	TopicNamespace     Topic = "Namespace"
+	TopicCSIVolume     Topic = "CSIVolume"
+	TopicCSIPlugin     Topic = "CSIPlugin"
+	TopicScalingPolicy Topic = "ScalingPolicy"
+	TopicRootKey       Topic = "RootKey"
+	TopicAll           Topic = "*"
 )
 
 // Events is a set of events for a corresponding index. Events returned for the
@@ -106,13 +112,81 @@ func (e *Event) Service() (*ServiceRegistration, error) {
 	return out.Service, nil
 }
 
+// Variable returns the metadata of a variable from a given event payload. If
+// the Event Topic is Variables this will return valid VariableMetadata. The
+// items of variables are never included in events.
+func (e *Event) Variable() (*VariableMetadata, error) {
+	out, err := e.decodePayload()
+	if err != nil {
+		return nil, err
+	}
+	return out.Variable, nil
+}
+
+// Namespace returns a Namespace struct from a given event payload. If the
+// Event Topic is Namespace this will return a valid Namespace.
+func (e *Event) Namespace() (*Namespace, error) {
+	out, err := e.decodePayload()
+	if err != nil {
+		return nil, err
+	}
+	return out.Namespace, nil
+}
+
+// CSIVolume returns a CSIVolume struct from a given event payload. If the
+// Event Topic is CSIVolume this will return a valid CSIVolume, without its
+// secrets.
+func (e *Event) CSIVolume() (*CSIVolume, error) {
+	out, err := e.decodePayload()
+	if err != nil {
+		return nil, err
+	}
+	return out.Volume, nil
+}
+
+// CSIPlugin returns a CSIPlugin struct from a given event payload. If the
+// Event Topic is CSIPlugin this will return a valid CSIPlugin.
+func (e *Event) CSIPlugin() (*CSIPlugin, error) {
+	out, err := e.decodePayload()
+	if err != nil {
+		return nil, err
+	}
+	return out.Plugin, nil
+}
+
+// ScalingPolicy returns a ScalingPolicy struct from a given event payload. If
+// the Event Topic is ScalingPolicy this will return a valid ScalingPolicy.
+func (e *Event) ScalingPolicy() (*ScalingPolicy, error) {
+	out, err := e.decodePayload()
+	if err != nil {
+		return nil, err
+	}
+	return out.ScalingPolicy, nil
+}
+
+// RootKeyMeta returns a RootKeyMeta struct from a given event payload. If the
+// Event Topic is RootKey this will return valid RootKeyMeta.
+func (e *Event) RootKeyMeta() (*RootKeyMeta, error) {
+	out, err := e.decodePayload()
+	if err != nil {
+		return nil, err
+	}
+	return out.RootKeyMeta, nil
+}
+
 type eventPayload struct {
-	Allocation *Allocation          `mapstructure:"Allocation"`
-	Deployment *Deployment          `mapstructure:"Deployment"`
-	Evaluation *Evaluation          `mapstructure:"Evaluation"`
-	Job        *Job                 `mapstructure:"Job"`
-	Node       *Node                `mapstructure:"Node"`
-	Service    *ServiceRegistration `mapstructure:"Service"`
+	Allocation    *Allocation          `mapstructure:"Allocation"`
+	Deployment    *Deployment          `mapstructure:"Deployment"`
+	Evaluation    *Evaluation          `mapstructure:"Evaluation"`
+	Job           *Job                 `mapstructure:"Job"`
+	Node          *Node                `mapstructure:"Node"`
+	Service       *ServiceRegistration `mapstructure:"Service"`
+	Variable      *VariableMetadata    `mapstructure:"Variable"`
+	Namespace     *Namespace           `mapstructure:"Namespace"`
+	Volume        *CSIVolume           `mapstructure:"Volume"`
+	Plugin        *CSIPlugin           `mapstructure:"Plugin"`
+	ScalingPolicy *ScalingPolicy       `mapstructure:"ScalingPolicy"`
+	RootKeyMeta   *RootKeyMeta         `mapstructure:"RootKeyMeta"`
 }
 
 func (e *Event) decodePayload() (*eventPayload, error) {
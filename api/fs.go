@@ -126,6 +126,26 @@ func (a *AllocFS) Cat(alloc *Allocation, path string, q *QueryOptions) (io.ReadC
 		})
 }
 
+// Archive is used to read a tar archive of the directory at the given path in
+// an allocation directory. The archive is gzipped if compress is true. If
+// limit is <= 0, the client's default limit on the size of the archived files
+// is used. The secrets directories of tasks are only included if the token
+// has the alloc-exec capability.
+// Note: for cluster topologies where API consumers don't have network access to
+// Nomad clients, set api.ClientConnTimeout to a small value (ex 1ms) to avoid
+// long pauses on this API call.
+func (a *AllocFS) Archive(alloc *Allocation, path string, compress bool, limit int64, q *QueryOptions) (io.ReadCloser, error) {
+	reqPath := fmt.Sprintf("/v1/client/fs/archive/%s", alloc.ID)
+	return queryClientNode(a.client, alloc, reqPath, q,
+		func(q *QueryOptions) {
+			q.Params["path"] = path
+			q.Params["compress"] = strconv.FormatBool(compress)
+			if limit > 0 {
+				q.Params["limit"] = strconv.FormatInt(limit, 10)
+			}
+		})
+}
+
 // Stream streams the content of a file blocking on EOF.
 // The parameters are:
 // * path: path to file to stream.
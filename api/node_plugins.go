@@ -0,0 +1,47 @@
+package api
+
+// NodePluginReloadRequest is used to reload an external driver or device
+// plugin on a Node.
+type NodePluginReloadRequest struct {
+	NodeID string
+	Name   string
+}
+
+// NodePluginReloadResponse is the result of reloading a plugin on a Node.
+type NodePluginReloadResponse struct {
+	// Name is the name of the reloaded plugin
+	Name string
+
+	// Type is the type of the reloaded plugin, either driver or device
+	Type string
+
+	// PreviousVersion is the version of the plugin that was replaced
+	PreviousVersion string
+
+	// Version is the version of the plugin now running
+	Version string
+}
+
+// NodePlugins is a client for managing the driver and device plugins of a
+// Node.
+type NodePlugins struct {
+	client *Client
+}
+
+// Plugins returns a NodePlugins client.
+func (n *Nodes) Plugins() *NodePlugins {
+	return &NodePlugins{client: n.client}
+}
+
+// Reload rescans the plugin directory of a Node and replaces the running
+// external plugin by the plugin found there. If NodeID is unset then the plugin
+// of the Node receiving the request is reloaded. The running plugin is kept if
+// the new plugin fails to fingerprint.
+func (n *NodePlugins) Reload(req *NodePluginReloadRequest, qo *QueryOptions) (*NodePluginReloadResponse, error) {
+	var out NodePluginReloadResponse
+	_, err := n.client.postQuery("/v1/client/plugin/reload", req, &out, qo)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
@@ -507,6 +507,7 @@ type DriverInfo struct {
 	Detected          bool
 	Healthy           bool
 	HealthDescription string
+	PluginVersion     string
 	UpdateTime        time.Time
 }
 
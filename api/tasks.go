@@ -41,10 +41,20 @@ type CpuStats struct {
 	Measured         []string
 }
 
+// IOStats holds block IO related stats
+type IOStats struct {
+	ReadBytes  uint64
+	WriteBytes uint64
+	ReadOps    uint64
+	WriteOps   uint64
+	Measured   []string
+}
+
 // ResourceUsage holds information related to cpu and memory stats
 type ResourceUsage struct {
 	MemoryStats *MemoryStats
 	CpuStats    *CpuStats
+	IOStats     *IOStats
 	DeviceStats []*DeviceGroupStats
 }
 
@@ -62,8 +72,37 @@ type AllocResourceUsage struct {
 	ResourceUsage *ResourceUsage
 	Tasks         map[string]*TaskResourceUsage
 	Timestamp     int64
+	DiskUsage     *AllocDiskUsage
+}
+
+// AllocDiskUsage holds the usage of an allocation's ephemeral disk
+type AllocDiskUsage struct {
+	UsedBytes   uint64
+	SizeBytes   uint64
+	Enforcement string
+	Timestamp   int64
+}
+
+// ResourceUsageSample is a point-in-time record of a task's resource usage,
+// as kept in the client's stats history.
+type ResourceUsageSample struct {
+	Timestamp        int64
+	CpuPercent       float64
+	CpuTotalTicks    float64
+	ThrottledPeriods uint64
+	ThrottledTime    uint64
+	MemoryRSS        uint64
+	MemoryUsage      uint64
+	MemoryMaxUsage   uint64
+	MemorySwap       uint64
+	IOReadBytes      uint64
+	IOWriteBytes     uint64
 }
 
+// AllocStatsHistory maps task names to their recent resource usage samples,
+// ordered oldest first.
+type AllocStatsHistory map[string][]*ResourceUsageSample
+
 // AllocCheckStatus contains the current status of a nomad service discovery check.
 type AllocCheckStatus struct {
 	ID         string
@@ -677,6 +716,42 @@ func (l *TaskLifecycle) Empty() bool {
 	return l == nil || (l.Hook == "")
 }
 
+// TaskPreStop is an action run against a task right before it is killed.
+type TaskPreStop struct {
+	Exec    *TaskPreStopExec `hcl:"exec,block"`
+	HTTP    *TaskPreStopHTTP `hcl:"http,block"`
+	Timeout *time.Duration   `mapstructure:"timeout" hcl:"timeout,optional"`
+}
+
+// TaskPreStopExec is a command run inside the task before it is killed.
+type TaskPreStopExec struct {
+	Command string   `hcl:"command,optional"`
+	Args    []string `hcl:"args,optional"`
+}
+
+// TaskPreStopHTTP is an HTTP request made to the task before it is killed.
+type TaskPreStopHTTP struct {
+	Port     string              `hcl:"port,optional"`
+	Path     string              `hcl:"path,optional"`
+	Method   string              `hcl:"method,optional"`
+	Protocol string              `hcl:"protocol,optional"`
+	Header   map[string][]string `hcl:"header,block"`
+}
+
+func (p *TaskPreStop) Canonicalize() {
+	if p.Timeout == nil {
+		p.Timeout = pointerOf(5 * time.Second)
+	}
+	if p.HTTP != nil {
+		if p.HTTP.Method == "" {
+			p.HTTP.Method = "GET"
+		}
+		if p.HTTP.Protocol == "" {
+			p.HTTP.Protocol = "http"
+		}
+	}
+}
+
 // Task is a single process in a task group.
 type Task struct {
 	Name            string                 `hcl:"name,label"`
@@ -694,6 +769,7 @@ type Task struct {
 	KillTimeout     *time.Duration         `mapstructure:"kill_timeout" hcl:"kill_timeout,optional"`
 	LogConfig       *LogConfig             `mapstructure:"logs" hcl:"logs,block"`
 	Artifacts       []*TaskArtifact        `hcl:"artifact,block"`
+	Outputs         []*TaskOutput          `hcl:"output,block"`
 	Vault           *Vault                 `hcl:"vault,block"`
 	Templates       []*Template            `hcl:"template,block"`
 	DispatchPayload *DispatchPayloadConfig `hcl:"dispatch_payload,block"`
@@ -705,6 +781,7 @@ type Task struct {
 	Kind            string                 `hcl:"kind,optional"`
 	ScalingPolicies []*ScalingPolicy       `hcl:"scaling,block"`
 	Identity        *WorkloadIdentity      `hcl:"identity,block"`
+	PreStop         *TaskPreStop           `mapstructure:"prestop" hcl:"prestop,block"`
 }
 
 func (t *Task) Canonicalize(tg *TaskGroup, job *Job) {
@@ -724,6 +801,9 @@ func (t *Task) Canonicalize(tg *TaskGroup, job *Job) {
 	for _, artifact := range t.Artifacts {
 		artifact.Canonicalize()
 	}
+	for _, output := range t.Outputs {
+		output.Canonicalize()
+	}
 	if t.Vault != nil {
 		t.Vault.Canonicalize()
 	}
@@ -745,6 +825,9 @@ func (t *Task) Canonicalize(tg *TaskGroup, job *Job) {
 	if t.CSIPluginConfig != nil {
 		t.CSIPluginConfig.Canonicalize()
 	}
+	if t.PreStop != nil {
+		t.PreStop.Canonicalize()
+	}
 	if t.RestartPolicy == nil {
 		t.RestartPolicy = tg.RestartPolicy
 	} else {
@@ -793,6 +876,34 @@ func (a *TaskArtifact) Canonicalize() {
 	}
 }
 
+// TaskOutput is a set of files produced by a task that are uploaded once the
+// task has finished running.
+type TaskOutput struct {
+	Source      *string           `mapstructure:"source" hcl:"source,optional"`
+	Destination *string           `mapstructure:"destination" hcl:"destination,optional"`
+	Options     map[string]string `mapstructure:"options" hcl:"options,block"`
+	Headers     map[string]string `mapstructure:"headers" hcl:"headers,block"`
+	When        *string           `mapstructure:"when" hcl:"when,optional"`
+}
+
+func (o *TaskOutput) Canonicalize() {
+	if o.Source == nil {
+		o.Source = pointerOf("")
+	}
+	if o.Destination == nil {
+		o.Destination = pointerOf("")
+	}
+	if len(o.Options) == 0 {
+		o.Options = nil
+	}
+	if len(o.Headers) == 0 {
+		o.Headers = nil
+	}
+	if o.When == nil {
+		o.When = pointerOf("on_success")
+	}
+}
+
 // WaitConfig is the Min/Max duration to wait for the Consul cluster to reach a
 // consistent state before attempting to render Templates.
 type WaitConfig struct {
@@ -1020,6 +1131,9 @@ const (
 	TaskNotRestarting          = "Not Restarting"
 	TaskDownloadingArtifacts   = "Downloading Artifacts"
 	TaskArtifactDownloadFailed = "Failed Artifact Download"
+	TaskUploadingOutputs       = "Uploading Outputs"
+	TaskOutputsUploaded        = "Outputs Uploaded"
+	TaskOutputUploadFailed     = "Failed Output Upload"
 	TaskSiblingFailed          = "Sibling Task Failed"
 	TaskSignaling              = "Signaling"
 	TaskRestartSignal          = "Restart Signaled"
@@ -1122,6 +1236,7 @@ func (t *TaskCSIPluginConfig) Canonicalize() {
 // WorkloadIdentity is the jobspec block which determines if and how a workload
 // identity is exposed to tasks.
 type WorkloadIdentity struct {
-	Env  bool `hcl:"env,optional"`
-	File bool `hcl:"file,optional"`
+	Env        bool   `hcl:"env,optional"`
+	File       bool   `hcl:"file,optional"`
+	AuthMethod string `hcl:"auth_method,optional"`
 }
@@ -56,7 +56,7 @@ func (a *Allocations) GarbageCollect(args *nstructs.AllocSpecificRequest, reply
 	// Check namespace submit job permission.
 	if aclObj, err := a.c.ResolveToken(args.AuthToken); err != nil {
 		return err
-	} else if aclObj != nil && !aclObj.AllowNsOp(alloc.Namespace, acl.NamespaceCapabilitySubmitJob) {
+	} else if aclObj != nil && !aclObj.AllowJobOp(alloc.Namespace, alloc.JobID, acl.NamespaceCapabilitySubmitJob) {
 		return nstructs.ErrPermissionDenied
 	}
 
@@ -67,6 +67,26 @@ func (a *Allocations) GarbageCollect(args *nstructs.AllocSpecificRequest, reply
 	return nil
 }
 
+// Pin is used to pin or unpin a terminal allocation, exempting it from
+// garbage collection on the client.
+func (a *Allocations) Pin(args *nstructs.AllocPinRequest, reply *nstructs.GenericResponse) error {
+	defer metrics.MeasureSince([]string{"client", "allocations", "pin"}, time.Now())
+
+	alloc, err := a.c.GetAlloc(args.AllocID)
+	if err != nil {
+		return err
+	}
+
+	// Check namespace submit job permission.
+	if aclObj, err := a.c.ResolveToken(args.AuthToken); err != nil {
+		return err
+	} else if aclObj != nil && !aclObj.AllowJobOp(alloc.Namespace, alloc.JobID, acl.NamespaceCapabilitySubmitJob) {
+		return nstructs.ErrPermissionDenied
+	}
+
+	return a.c.PinAllocation(args.AllocID, args.Pinned)
+}
+
 // Signal is used to send a signal to an allocation's tasks on a client.
 func (a *Allocations) Signal(args *nstructs.AllocSignalRequest, reply *nstructs.GenericResponse) error {
 	defer metrics.MeasureSince([]string{"client", "allocations", "signal"}, time.Now())
@@ -79,13 +99,52 @@ func (a *Allocations) Signal(args *nstructs.AllocSignalRequest, reply *nstructs.
 	// Check namespace alloc-lifecycle permission.
 	if aclObj, err := a.c.ResolveToken(args.AuthToken); err != nil {
 		return err
-	} else if aclObj != nil && !aclObj.AllowNsOp(alloc.Namespace, acl.NamespaceCapabilityAllocLifecycle) {
+	} else if aclObj != nil && !aclObj.AllowJobOp(alloc.Namespace, alloc.JobID, acl.NamespaceCapabilityAllocLifecycle) {
 		return nstructs.ErrPermissionDenied
 	}
 
 	return a.c.SignalAllocation(args.AllocID, args.Task, args.Signal)
 }
 
+// Pause is used to freeze the processes of an allocation's tasks on a client.
+func (a *Allocations) Pause(args *nstructs.AllocPauseRequest, reply *nstructs.GenericResponse) error {
+	defer metrics.MeasureSince([]string{"client", "allocations", "pause"}, time.Now())
+
+	alloc, err := a.c.GetAlloc(args.AllocID)
+	if err != nil {
+		return err
+	}
+
+	// Check namespace alloc-lifecycle permission.
+	if aclObj, err := a.c.ResolveToken(args.AuthToken); err != nil {
+		return err
+	} else if aclObj != nil && !aclObj.AllowJobOp(alloc.Namespace, alloc.JobID, acl.NamespaceCapabilityAllocLifecycle) {
+		return nstructs.ErrPermissionDenied
+	}
+
+	return a.c.PauseAllocation(args.AllocID, args.Task)
+}
+
+// Resume is used to thaw the processes of an allocation's paused tasks on a
+// client.
+func (a *Allocations) Resume(args *nstructs.AllocPauseRequest, reply *nstructs.GenericResponse) error {
+	defer metrics.MeasureSince([]string{"client", "allocations", "resume"}, time.Now())
+
+	alloc, err := a.c.GetAlloc(args.AllocID)
+	if err != nil {
+		return err
+	}
+
+	// Check namespace alloc-lifecycle permission.
+	if aclObj, err := a.c.ResolveToken(args.AuthToken); err != nil {
+		return err
+	} else if aclObj != nil && !aclObj.AllowJobOp(alloc.Namespace, alloc.JobID, acl.NamespaceCapabilityAllocLifecycle) {
+		return nstructs.ErrPermissionDenied
+	}
+
+	return a.c.ResumeAllocation(args.AllocID, args.Task)
+}
+
 // Restart is used to trigger a restart of an allocation or a subtask on a client.
 func (a *Allocations) Restart(args *nstructs.AllocRestartRequest, reply *nstructs.GenericResponse) error {
 	defer metrics.MeasureSince([]string{"client", "allocations", "restart"}, time.Now())
@@ -98,7 +157,7 @@ func (a *Allocations) Restart(args *nstructs.AllocRestartRequest, reply *nstruct
 	// Check namespace alloc-lifecycle permission.
 	if aclObj, err := a.c.ResolveToken(args.AuthToken); err != nil {
 		return err
-	} else if aclObj != nil && !aclObj.AllowNsOp(alloc.Namespace, acl.NamespaceCapabilityAllocLifecycle) {
+	} else if aclObj != nil && !aclObj.AllowJobOp(alloc.Namespace, alloc.JobID, acl.NamespaceCapabilityAllocLifecycle) {
 		return nstructs.ErrPermissionDenied
 	}
 
@@ -117,7 +176,7 @@ func (a *Allocations) Stats(args *cstructs.AllocStatsRequest, reply *cstructs.Al
 	// Check read-job permission.
 	if aclObj, err := a.c.ResolveToken(args.AuthToken); err != nil {
 		return err
-	} else if aclObj != nil && !aclObj.AllowNsOp(alloc.Namespace, acl.NamespaceCapabilityReadJob) {
+	} else if aclObj != nil && !aclObj.AllowJobOp(alloc.Namespace, alloc.JobID, acl.NamespaceCapabilityReadJob) {
 		return nstructs.ErrPermissionDenied
 	}
 
@@ -136,6 +195,38 @@ func (a *Allocations) Stats(args *cstructs.AllocStatsRequest, reply *cstructs.Al
 	return nil
 }
 
+// StatsHistory is used to collect the recent resource usage history of an
+// allocation
+func (a *Allocations) StatsHistory(args *cstructs.AllocStatsHistoryRequest, reply *cstructs.AllocStatsHistoryResponse) error {
+	defer metrics.MeasureSince([]string{"client", "allocations", "stats_history"}, time.Now())
+
+	alloc, err := a.c.GetAlloc(args.AllocID)
+	if err != nil {
+		return err
+	}
+
+	// Check read-job permission.
+	if aclObj, err := a.c.ResolveToken(args.AuthToken); err != nil {
+		return err
+	} else if aclObj != nil && !aclObj.AllowJobOp(alloc.Namespace, alloc.JobID, acl.NamespaceCapabilityReadJob) {
+		return nstructs.ErrPermissionDenied
+	}
+
+	clientStats := a.c.StatsReporter()
+	aStats, err := clientStats.GetAllocStats(args.AllocID)
+	if err != nil {
+		return err
+	}
+
+	history, err := aStats.AllocStatsHistory(args.Task, args.Since)
+	if err != nil {
+		return err
+	}
+
+	reply.Tasks = history
+	return nil
+}
+
 // Checks is used to retrieve nomad service discovery check status information.
 func (a *Allocations) Checks(args *cstructs.AllocChecksRequest, reply *cstructs.AllocChecksResponse) error {
 	defer metrics.MeasureSince([]string{"client", "allocations", "checks"}, time.Now())
@@ -149,7 +240,7 @@ func (a *Allocations) Checks(args *cstructs.AllocChecksRequest, reply *cstructs.
 	// Check read-job permission
 	if aclObj, aclErr := a.c.ResolveToken(args.AuthToken); aclErr != nil {
 		return aclErr
-	} else if aclObj != nil && !aclObj.AllowNsOp(alloc.Namespace, acl.NamespaceCapabilityReadJob) {
+	} else if aclObj != nil && !aclObj.AllowJobOp(alloc.Namespace, alloc.JobID, acl.NamespaceCapabilityReadJob) {
 		return nstructs.ErrPermissionDenied
 	}
 
@@ -236,7 +327,7 @@ func (a *Allocations) execImpl(encoder *codec.Encoder, decoder *codec.Decoder, e
 	// Check alloc-exec permission.
 	if err != nil {
 		return nil, err
-	} else if aclObj != nil && !aclObj.AllowNsOp(alloc.Namespace, acl.NamespaceCapabilityAllocExec) {
+	} else if aclObj != nil && !aclObj.AllowJobOp(alloc.Namespace, alloc.JobID, acl.NamespaceCapabilityAllocExec) {
 		return nil, nstructs.ErrPermissionDenied
 	}
 
@@ -260,7 +351,7 @@ func (a *Allocations) execImpl(encoder *codec.Encoder, decoder *codec.Decoder, e
 
 	// check node access
 	if aclObj != nil && capabilities.FSIsolation == drivers.FSIsolationNone {
-		exec := aclObj.AllowNsOp(alloc.Namespace, acl.NamespaceCapabilityAllocNodeExec)
+		exec := aclObj.AllowJobOp(alloc.Namespace, alloc.JobID, acl.NamespaceCapabilityAllocNodeExec)
 		if !exec {
 			return nil, nstructs.ErrPermissionDenied
 		}
@@ -24,6 +24,7 @@ import (
 	"github.com/hashicorp/nomad/plugins/drivers"
 	"github.com/hashicorp/nomad/testutil"
 	"github.com/shoenig/test/must"
+	"github.com/shoenig/test/wait"
 	"github.com/stretchr/testify/require"
 	"golang.org/x/sys/unix"
 )
@@ -292,6 +293,57 @@ func TestAllocations_GarbageCollect(t *testing.T) {
 	})
 }
 
+func TestAllocations_Pin(t *testing.T) {
+	ci.Parallel(t)
+	require := require.New(t)
+
+	client, cleanup := TestClient(t, func(c *config.Config) {
+		c.GCDiskUsageThreshold = 100.0
+	})
+	defer cleanup()
+
+	a := mock.Alloc()
+	a.Job.TaskGroups[0].Tasks[0].Driver = "mock_driver"
+	rp := &nstructs.RestartPolicy{
+		Attempts: 0,
+		Mode:     nstructs.RestartPolicyModeFail,
+	}
+	a.Job.TaskGroups[0].RestartPolicy = rp
+	a.Job.TaskGroups[0].Tasks[0].RestartPolicy = rp
+	a.Job.TaskGroups[0].Tasks[0].Config = map[string]interface{}{
+		"run_for": "10ms",
+	}
+	require.Nil(client.addAlloc(a, ""))
+
+	// Try with bad alloc
+	req := &nstructs.AllocPinRequest{Pinned: true}
+	var resp nstructs.GenericResponse
+	err := client.ClientRPC("Allocations.Pin", &req, &resp)
+	require.NotNil(err)
+
+	// Pin the alloc once it is terminal
+	req.AllocID = a.ID
+	testutil.WaitForResult(func() (bool, error) {
+		var resp2 nstructs.GenericResponse
+		err := client.ClientRPC("Allocations.Pin", &req, &resp2)
+		return err == nil, err
+	}, func(err error) {
+		t.Fatalf("err: %v", err)
+	})
+
+	require.True(client.garbageCollector.IsPinned(a.ID))
+
+	// Pinned allocs cannot be garbage collected
+	gcReq := &nstructs.AllocSpecificRequest{AllocID: a.ID}
+	err = client.ClientRPC("Allocations.GarbageCollect", &gcReq, &resp)
+	require.Error(err)
+
+	// Unpin and garbage collect the alloc
+	req.Pinned = false
+	require.NoError(client.ClientRPC("Allocations.Pin", &req, &resp))
+	require.NoError(client.ClientRPC("Allocations.GarbageCollect", &gcReq, &resp))
+}
+
 func TestAllocations_GarbageCollect_ACL(t *testing.T) {
 	ci.Parallel(t)
 	require := require.New(t)
@@ -463,6 +515,73 @@ func TestAllocations_Signal_ACL(t *testing.T) {
 	}
 }
 
+func TestAllocations_Pause_ACL(t *testing.T) {
+	ci.Parallel(t)
+
+	server, addr, root, cleanupS := testACLServer(t, nil)
+	defer cleanupS()
+
+	client, cleanupC := TestClient(t, func(c *config.Config) {
+		c.Servers = []string{addr}
+		c.ACLEnabled = true
+	})
+	defer cleanupC()
+
+	job := mock.BatchJob()
+	job.TaskGroups[0].Count = 1
+	job.TaskGroups[0].Tasks[0].Config = map[string]interface{}{
+		"run_for": "20s",
+	}
+
+	// Wait for client to be running job
+	alloc := testutil.WaitForRunningWithToken(t, server.RPC, job, root.SecretID)[0]
+
+	// Try request without a token and expect failure
+	{
+		req := &nstructs.AllocPauseRequest{AllocID: alloc.ID}
+		var resp nstructs.GenericResponse
+		err := client.ClientRPC("Allocations.Pause", &req, &resp)
+		must.ErrorContains(t, err, nstructs.ErrPermissionDenied.Error())
+	}
+
+	// Try request with a token without alloc-lifecycle and expect failure
+	{
+		token := mock.CreatePolicyAndToken(t, server.State(), 1005, "invalid",
+			mock.NamespacePolicy(nstructs.DefaultNamespace, "", []string{acl.NamespaceCapabilityReadJob}))
+		req := &nstructs.AllocPauseRequest{AllocID: alloc.ID}
+		req.AuthToken = token.SecretID
+
+		var resp nstructs.GenericResponse
+		err := client.ClientRPC("Allocations.Pause", &req, &resp)
+		must.EqError(t, err, nstructs.ErrPermissionDenied.Error())
+	}
+
+	// Try request with a valid token
+	{
+		token := mock.CreatePolicyAndToken(t, server.State(), 1007, "test-valid",
+			mock.NamespacePolicy(nstructs.DefaultNamespace, "", []string{acl.NamespaceCapabilityAllocLifecycle}))
+		req := &nstructs.AllocPauseRequest{AllocID: alloc.ID}
+		req.AuthToken = token.SecretID
+		req.Namespace = nstructs.DefaultNamespace
+
+		var resp nstructs.GenericResponse
+		must.NoError(t, client.ClientRPC("Allocations.Pause", &req, &resp))
+
+		ar, err := client.getAllocRunner(alloc.ID)
+		must.NoError(t, err)
+		taskName := job.TaskGroups[0].Tasks[0].Name
+		must.Wait(t, wait.InitialSuccess(
+			wait.BoolFunc(func() bool {
+				return ar.AllocState().TaskStates[taskName].State == nstructs.TaskStatePaused
+			}),
+			wait.Timeout(5*time.Second),
+			wait.Gap(100*time.Millisecond),
+		))
+
+		must.NoError(t, client.ClientRPC("Allocations.Resume", &req, &resp))
+	}
+}
+
 func TestAllocations_Stats(t *testing.T) {
 	ci.Parallel(t)
 	require := require.New(t)
@@ -569,6 +688,50 @@ func TestAllocations_Stats_ACL(t *testing.T) {
 	}
 }
 
+func TestAllocations_StatsHistory(t *testing.T) {
+	ci.Parallel(t)
+
+	client, cleanup := TestClient(t, nil)
+	t.Cleanup(func() {
+		must.NoError(t, cleanup())
+	})
+
+	a := mock.Alloc()
+	task := a.Job.TaskGroups[0].Tasks[0]
+	task.Driver = "mock_driver"
+	task.Config = map[string]interface{}{
+		"run_for": "20s",
+	}
+	must.NoError(t, client.addAlloc(a, ""))
+
+	// Try with bad alloc
+	req := &cstructs.AllocStatsHistoryRequest{}
+	var resp cstructs.AllocStatsHistoryResponse
+	must.Error(t, client.ClientRPC("Allocations.StatsHistory", &req, &resp))
+
+	// Try with good alloc and wait for samples to be collected
+	req.AllocID = a.ID
+	testutil.WaitForResult(func() (bool, error) {
+		var resp2 cstructs.AllocStatsHistoryResponse
+		err := client.ClientRPC("Allocations.StatsHistory", &req, &resp2)
+		if err != nil {
+			return false, err
+		}
+		if len(resp2.Tasks[task.Name]) == 0 {
+			return false, fmt.Errorf("expected samples for task %q", task.Name)
+		}
+		return true, nil
+	}, func(err error) {
+		t.Fatalf("err: %v", err)
+	})
+
+	// Filtering by a timestamp in the future returns no samples
+	req.Since = time.Now().Add(time.Hour).UnixNano()
+	must.NoError(t, client.ClientRPC("Allocations.StatsHistory", &req, &resp))
+	must.MapLen(t, 1, resp.Tasks)
+	must.SliceEmpty(t, resp.Tasks[task.Name])
+}
+
 func TestAlloc_Checks(t *testing.T) {
 	ci.Parallel(t)
 
@@ -101,6 +101,7 @@ type AllocDirFS interface {
 	Stat(path string) (*cstructs.AllocFileInfo, error)
 	ReadAt(path string, offset int64) (io.ReadCloser, error)
 	Snapshot(w io.Writer) error
+	Archive(path string, w io.Writer, includeSecrets bool, limit int64) error
 	BlockUntilExists(ctx context.Context, path string) (chan error, error)
 	ChangeEvents(ctx context.Context, path string, curOffset int64) (*watch.FileChanges, error)
 }
@@ -267,6 +268,11 @@ func (d *AllocDir) Destroy() error {
 		mErr.Errors = append(mErr.Errors, err)
 	}
 
+	// Clear the project quota while the alloc dir still holds its project ID
+	if err := d.clearAssignedProjectQuota(); err != nil {
+		mErr.Errors = append(mErr.Errors, err)
+	}
+
 	if err := os.RemoveAll(d.AllocDir); err != nil {
 		mErr.Errors = append(mErr.Errors, fmt.Errorf("failed to remove alloc dir %q: %v", d.AllocDir, err))
 	}
@@ -325,7 +331,7 @@ func (d *AllocDir) Build() error {
 	}
 
 	// Make the shared directory have non-root permissions.
-	if err := dropDirPermissions(d.SharedDir, os.ModePerm); err != nil {
+	if err := dropDirPermissions(d.SharedDir, os.ModePerm, nil); err != nil {
 		return err
 	}
 
@@ -335,7 +341,7 @@ func (d *AllocDir) Build() error {
 		if err := os.MkdirAll(p, 0777); err != nil {
 			return err
 		}
-		if err := dropDirPermissions(p, os.ModePerm); err != nil {
+		if err := dropDirPermissions(p, os.ModePerm, nil); err != nil {
 			return err
 		}
 	}
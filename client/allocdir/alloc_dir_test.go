@@ -517,3 +517,91 @@ func TestAllocDir_SkipAllocDir(t *testing.T) {
 		t.Fatalf("expected chroot to not exist but error is: %v", err)
 	}
 }
+
+func TestAllocDir_DiskUsage(t *testing.T) {
+	ci.Parallel(t)
+
+	d := NewAllocDir(testlog.HCLogger(t), t.TempDir(), "test")
+	defer d.Destroy()
+	td := d.NewTaskDir(t1.Name)
+	require.NoError(t, d.Build())
+	require.NoError(t, td.Build(false, nil))
+
+	before, err := d.DiskUsage()
+	require.NoError(t, err)
+
+	data := bytes.Repeat([]byte("a"), 1024*1024)
+	file := filepath.Join(d.SharedDir, SharedDataDir, "data.bin")
+	require.NoError(t, os.WriteFile(file, data, 0644))
+
+	after, err := d.DiskUsage()
+	require.NoError(t, err)
+	require.GreaterOrEqual(t, after-before, uint64(len(data)))
+
+	if runtime.GOOS == "windows" {
+		return
+	}
+
+	// Hard links to a file are only counted once
+	require.NoError(t, os.Link(file, filepath.Join(td.LocalDir, "link.bin")))
+	linked, err := d.DiskUsage()
+	require.NoError(t, err)
+	require.Less(t, linked-after, uint64(len(data)))
+}
+
+func TestAllocDir_Archive(t *testing.T) {
+	ci.Parallel(t)
+
+	d := NewAllocDir(testlog.HCLogger(t), t.TempDir(), "test")
+	defer d.Destroy()
+	td := d.NewTaskDir(t1.Name)
+	require.NoError(t, d.Build())
+	require.NoError(t, td.Build(false, nil))
+
+	require.NoError(t, os.WriteFile(filepath.Join(td.LocalDir, "core"), []byte("dump"), 0644))
+	require.NoError(t, os.WriteFile(filepath.Join(td.SecretsDir, "token"), []byte("secret"), 0644))
+	require.NoError(t, os.Symlink("core", filepath.Join(td.LocalDir, "link")))
+
+	archive := func(path string, includeSecrets bool, limit int64) (map[string]string, error) {
+		var b bytes.Buffer
+		if err := d.Archive(path, &b, includeSecrets, limit); err != nil {
+			return nil, err
+		}
+
+		files := make(map[string]string)
+		tr := tar.NewReader(&b)
+		for {
+			hdr, err := tr.Next()
+			if err == io.EOF {
+				return files, nil
+			}
+			require.NoError(t, err)
+			content, err := io.ReadAll(tr)
+			require.NoError(t, err)
+			files[hdr.Name] = string(content)
+		}
+	}
+
+	files, err := archive(t1.Name, false, 0)
+	require.NoError(t, err)
+	require.Equal(t, "dump", files["web/local/core"])
+	require.Contains(t, files, "web/local/link")
+	require.Contains(t, files, "web/local/")
+	require.NotContains(t, files, "web/secrets/token")
+
+	// The secrets dir is only archived when allowed
+	files, err = archive(t1.Name, true, 0)
+	require.NoError(t, err)
+	require.Equal(t, "secret", files["web/secrets/token"])
+
+	_, err = archive(filepath.Join(t1.Name, "secrets"), false, 0)
+	require.ErrorContains(t, err, "secret file prohibited")
+
+	// Archives larger than the limit are rejected
+	_, err = archive(filepath.Join(t1.Name, "local"), false, 2)
+	require.ErrorIs(t, err, ErrArchiveLimitExceeded)
+
+	// Paths can't escape the alloc dir
+	_, err = archive("../", false, 0)
+	require.ErrorContains(t, err, "escapes")
+}
@@ -0,0 +1,164 @@
+package allocdir
+
+import (
+	"archive/tar"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/hashicorp/nomad/helper/escapingfs"
+)
+
+// ErrArchiveLimitExceeded is returned by Archive when the files to archive
+// are larger than the limit.
+var ErrArchiveLimitExceeded = errors.New("archive size limit exceeded")
+
+// Archive writes a tar archive of the file or directory at path, relative to
+// the alloc dir, to w. The names of the archived files are relative to the
+// alloc dir. The secrets dir of each task is only archived if includeSecrets
+// is true. If limit is greater than zero, an error is returned before anything
+// is written if the files to archive are larger than limit bytes.
+func (d *AllocDir) Archive(path string, w io.Writer, includeSecrets bool, limit int64) error {
+	if escapes, err := escapingfs.PathEscapesAllocDir(d.AllocDir, "", path); err != nil {
+		return fmt.Errorf("Failed to check if path escapes alloc directory: %v", err)
+	} else if escapes {
+		return fmt.Errorf("Path escapes the alloc directory")
+	}
+
+	root := filepath.Join(d.AllocDir, path)
+
+	// The shared alloc dir is mounted into each task dir, so only archive it
+	// once through the alloc dir itself
+	d.mu.RLock()
+	skip := make(map[string]struct{}, len(d.TaskDirs)*2)
+	for _, dir := range d.TaskDirs {
+		skip[dir.SharedTaskDir] = struct{}{}
+		if !includeSecrets {
+			if root == dir.SecretsDir || pathWithinDir(root, dir.SecretsDir) {
+				d.mu.RUnlock()
+				return fmt.Errorf("Reading secret file prohibited: %s", path)
+			}
+			skip[dir.SecretsDir] = struct{}{}
+		}
+	}
+	d.mu.RUnlock()
+
+	walk := func(fn func(path string, info fs.FileInfo) error) error {
+		return filepath.WalkDir(root, func(p string, entry fs.DirEntry, err error) error {
+			if err != nil {
+				// Files may be removed by the task while walking
+				if os.IsNotExist(err) && p != root {
+					return nil
+				}
+				return err
+			}
+
+			if _, ok := skip[p]; ok && p != root {
+				return filepath.SkipDir
+			}
+
+			info, err := entry.Info()
+			if err != nil {
+				if os.IsNotExist(err) {
+					return nil
+				}
+				return err
+			}
+
+			// Only regular files, directories and symlinks can be archived
+			if !info.Mode().IsRegular() && !info.IsDir() && info.Mode()&fs.ModeSymlink == 0 {
+				return nil
+			}
+			return fn(p, info)
+		})
+	}
+
+	if limit > 0 {
+		var size int64
+		err := walk(func(_ string, info fs.FileInfo) error {
+			if info.Mode().IsRegular() {
+				size += info.Size()
+			}
+			return nil
+		})
+		if err != nil {
+			return err
+		}
+		if size > limit {
+			return fmt.Errorf("%w: %d bytes exceeds the limit of %d bytes", ErrArchiveLimitExceeded, size, limit)
+		}
+	}
+
+	// Files may grow between measuring and archiving them, so the limit is
+	// also enforced while archiving
+	var written int64
+
+	tw := tar.NewWriter(w)
+	err := walk(func(p string, info fs.FileInfo) error {
+		relPath, err := filepath.Rel(d.AllocDir, p)
+		if err != nil {
+			return err
+		}
+		if relPath == "." {
+			return nil
+		}
+
+		link := ""
+		if info.Mode()&fs.ModeSymlink != 0 {
+			if link, err = os.Readlink(p); err != nil {
+				return fmt.Errorf("error reading symlink: %v", err)
+			}
+		}
+		hdr, err := tar.FileInfoHeader(info, link)
+		if err != nil {
+			return fmt.Errorf("error creating file header: %v", err)
+		}
+		hdr.Name = filepath.ToSlash(relPath)
+		if info.IsDir() {
+			hdr.Name += "/"
+		}
+
+		if !info.Mode().IsRegular() {
+			return tw.WriteHeader(hdr)
+		}
+
+		written += hdr.Size
+		if limit > 0 && written > limit {
+			return fmt.Errorf("%w: more than %d bytes", ErrArchiveLimitExceeded, limit)
+		}
+
+		file, err := os.Open(p)
+		if err != nil {
+			if os.IsNotExist(err) {
+				return nil
+			}
+			return err
+		}
+		defer file.Close()
+
+		if err := tw.WriteHeader(hdr); err != nil {
+			return err
+		}
+
+		// Files may be written to while they are archived, so only copy the
+		// size recorded in the header
+		if _, err := io.CopyN(tw, file, hdr.Size); err != nil {
+			return fmt.Errorf("error archiving %q: %v", relPath, err)
+		}
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	return tw.Close()
+}
+
+// pathWithinDir returns true if path is inside of dir.
+func pathWithinDir(path, dir string) bool {
+	return strings.HasPrefix(path, dir+string(filepath.Separator))
+}
@@ -0,0 +1,74 @@
+package allocdir
+
+import (
+	"io/fs"
+	"os"
+	"path/filepath"
+)
+
+// DiskUsage returns the number of bytes of disk used by the files in the
+// alloc dir. Directories mounted into the alloc dir, such as the shared alloc
+// dir in each task dir or the secrets tmpfs, are not counted, and files with
+// multiple hard links are only counted once.
+func (d *AllocDir) DiskUsage() (uint64, error) {
+	d.mu.RLock()
+	skip := make(map[string]struct{}, len(d.TaskDirs)*2)
+	for _, dir := range d.TaskDirs {
+		skip[dir.SharedTaskDir] = struct{}{}
+		skip[dir.SecretsDir] = struct{}{}
+	}
+	d.mu.RUnlock()
+
+	root, err := os.Lstat(d.AllocDir)
+	if err != nil {
+		return 0, err
+	}
+	rootDev, _, _ := fileUsage(root)
+
+	var total uint64
+	seen := make(map[uint64]struct{})
+	walkFn := func(path string, entry fs.DirEntry, err error) error {
+		if err != nil {
+			// Files may be removed by the task while walking
+			if os.IsNotExist(err) {
+				return nil
+			}
+			return err
+		}
+
+		if _, ok := skip[path]; ok {
+			return filepath.SkipDir
+		}
+
+		info, err := entry.Info()
+		if err != nil {
+			if os.IsNotExist(err) {
+				return nil
+			}
+			return err
+		}
+
+		dev, ino, usage := fileUsage(info)
+		if dev != rootDev {
+			// Don't cross into other filesystems mounted into the alloc dir
+			if entry.IsDir() {
+				return filepath.SkipDir
+			}
+			return nil
+		}
+		if ino != 0 {
+			if _, ok := seen[ino]; ok {
+				return nil
+			}
+			seen[ino] = struct{}{}
+		}
+
+		total += usage
+		return nil
+	}
+
+	if err := filepath.WalkDir(d.AllocDir, walkFn); err != nil {
+		return 0, err
+	}
+	return total, nil
+}
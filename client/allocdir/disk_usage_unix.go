@@ -0,0 +1,18 @@
+//go:build unix
+
+package allocdir
+
+import (
+	"os"
+	"syscall"
+)
+
+// fileUsage returns the device and inode of a file along with the number of
+// bytes of disk allocated to it.
+func fileUsage(info os.FileInfo) (dev uint64, ino uint64, usage uint64) {
+	st, ok := info.Sys().(*syscall.Stat_t)
+	if !ok {
+		return 0, 0, uint64(info.Size())
+	}
+	return uint64(st.Dev), uint64(st.Ino), uint64(st.Blocks) * 512
+}
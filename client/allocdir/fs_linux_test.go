@@ -10,6 +10,8 @@ import (
 	"testing"
 
 	"github.com/hashicorp/nomad/ci"
+	"github.com/hashicorp/nomad/helper/testlog"
+	"github.com/hashicorp/nomad/helper/users"
 	"golang.org/x/sys/unix"
 )
 
@@ -157,3 +159,40 @@ func TestLinuxUnprivilegedSecretDir(t *testing.T) {
 		t.Fatalf("error removing nonexistent secrets dir %q: %v", secretsDir, err)
 	}
 }
+
+// Test that the directories of a task running in a user namespace are owned by
+// the host user nobody is mapped to.
+func TestTaskDir_IDMap(t *testing.T) {
+	ci.Parallel(t)
+	if os.Geteuid() != 0 {
+		t.Skip("Must be root to run test")
+	}
+
+	tmp := t.TempDir()
+
+	d := NewAllocDir(testlog.HCLogger(t), tmp, "test")
+	defer d.Destroy()
+	td := d.NewTaskDir(t1.Name)
+	if err := d.Build(); err != nil {
+		t.Fatalf("Build() failed: %v", err)
+	}
+
+	td.IDMap = &IDMap{UID: 100000, GID: 200000}
+	if err := td.Build(false, nil); err != nil {
+		t.Fatalf("TaskDir.Build failed: %v", err)
+	}
+
+	nobody := users.Nobody()
+	uid, _ := getUid(&nobody)
+	gid, _ := getGid(&nobody)
+
+	for _, dir := range []string{td.Dir, td.LocalDir, td.SecretsDir} {
+		fi, err := os.Stat(dir)
+		if err != nil {
+			t.Fatalf("Stat(%q) failed: %v", dir, err)
+		}
+		if owner, group := getOwner(fi); owner != uid+100000 || group != gid+200000 {
+			t.Fatalf("Expected %q to be owned by %d:%d, got %d:%d", dir, uid+100000, gid+200000, owner, group)
+		}
+	}
+}
@@ -29,8 +29,9 @@ var (
 )
 
 // dropDirPermissions gives full access to a directory to all users and sets
-// the owner to nobody.
-func dropDirPermissions(path string, desired os.FileMode) error {
+// the owner to nobody. If idMap is set, the owner is the host user nobody is
+// mapped to in the user namespace of the task instead.
+func dropDirPermissions(path string, desired os.FileMode, idMap *IDMap) error {
 	if err := os.Chmod(path, desired|0777); err != nil {
 		return fmt.Errorf("Chmod(%v) failed: %v", path, err)
 	}
@@ -52,6 +53,11 @@ func dropDirPermissions(path string, desired os.FileMode) error {
 		return err
 	}
 
+	if idMap != nil {
+		uid += idMap.UID
+		gid += idMap.GID
+	}
+
 	if err := os.Chown(path, uid, gid); err != nil {
 		return fmt.Errorf("Couldn't change owner/group of %v to (uid: %v, gid: %v): %v", path, uid, gid, err)
 	}
@@ -45,7 +45,7 @@ func removeSecretDir(dir string) error {
 }
 
 // The windows version does nothing currently.
-func dropDirPermissions(path string, desired os.FileMode) error {
+func dropDirPermissions(path string, desired os.FileMode, idMap *IDMap) error {
 	return nil
 }
 
@@ -0,0 +1,47 @@
+//go:build !linux
+
+package allocdir
+
+import (
+	"errors"
+)
+
+// errProjectQuotasUnsupported is returned when managing project quotas on
+// platforms that don't support them.
+var errProjectQuotasUnsupported = errors.New("project quotas are only supported on Linux")
+
+// ProjectQuotasSupported always returns false as project quotas are only
+// supported on Linux.
+func ProjectQuotasSupported(dir string) (bool, error) {
+	return false, nil
+}
+
+// ProjectQuotaID is not supported on this platform.
+func (d *AllocDir) ProjectQuotaID(allocID string) (uint32, error) {
+	return 0, errProjectQuotasUnsupported
+}
+
+// AssignedProjectQuotaID is not supported on this platform.
+func (d *AllocDir) AssignedProjectQuotaID() (uint32, error) {
+	return 0, errProjectQuotasUnsupported
+}
+
+// SetProjectQuota is not supported on this platform.
+func (d *AllocDir) SetProjectQuota(projectID uint32, limit uint64) error {
+	return errProjectQuotasUnsupported
+}
+
+// ProjectQuotaUsage is not supported on this platform.
+func (d *AllocDir) ProjectQuotaUsage(projectID uint32) (uint64, error) {
+	return 0, errProjectQuotasUnsupported
+}
+
+// ClearProjectQuota is not supported on this platform.
+func (d *AllocDir) ClearProjectQuota(projectID uint32) error {
+	return errProjectQuotasUnsupported
+}
+
+// clearAssignedProjectQuota is a no-op on this platform.
+func (d *AllocDir) clearAssignedProjectQuota() error {
+	return nil
+}
@@ -0,0 +1,469 @@
+package allocdir
+
+import (
+	"bufio"
+	"fmt"
+	"hash/fnv"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"sync"
+	"unsafe"
+
+	"golang.org/x/sys/unix"
+)
+
+const (
+	// fsIocFsGetXattr and fsIocFsSetXattr are the FS_IOC_FSGETXATTR and
+	// FS_IOC_FSSETXATTR ioctls used to read and write a file's project ID.
+	fsIocFsGetXattr = 0x801c581f
+	fsIocFsSetXattr = 0x401c5820
+
+	// fsXflagProjInherit is FS_XFLAG_PROJINHERIT, which makes new files and
+	// directories inherit the project ID of their parent directory.
+	fsXflagProjInherit = 0x00000200
+
+	// qGetQuota and qSetQuota are the Q_GETQUOTA and Q_SETQUOTA quotactl
+	// commands, and prjQuota is the PRJQUOTA quota type.
+	qGetQuota = 0x800007
+	qSetQuota = 0x800008
+	prjQuota  = 2
+
+	// qifBlimits is QIF_BLIMITS, marking the block limits of an ifDqblk
+	// as valid.
+	qifBlimits = 1
+
+	// quotaBlockSize is QIF_DQBLKSIZE, the unit of quota block limits.
+	quotaBlockSize = 1024
+)
+
+// fsxattr mirrors struct fsxattr from linux/fs.h
+type fsxattr struct {
+	xflags     uint32
+	extsize    uint32
+	nextents   uint32
+	projid     uint32
+	cowextsize uint32
+	pad        [8]byte
+}
+
+// ifDqblk mirrors struct if_dqblk from linux/quota.h
+type ifDqblk struct {
+	bhardlimit uint64
+	bsoftlimit uint64
+	curspace   uint64
+	ihardlimit uint64
+	isoftlimit uint64
+	curinodes  uint64
+	btime      uint64
+	itime      uint64
+	valid      uint32
+}
+
+// mountInfo is the subset of a /proc/self/mountinfo entry needed to manage
+// project quotas.
+type mountInfo struct {
+	mountPoint string
+	fsType     string
+	source     string
+	options    []string
+}
+
+// ProjectQuotasSupported returns true if the filesystem backing dir has
+// project quotas enabled and the agent is privileged enough to manage them.
+func ProjectQuotasSupported(dir string) (bool, error) {
+	if unix.Geteuid() != 0 {
+		return false, nil
+	}
+
+	mi, err := findMount(dir)
+	if err != nil {
+		return false, err
+	}
+
+	switch mi.fsType {
+	case "xfs", "ext4":
+	default:
+		return false, nil
+	}
+
+	for _, opt := range mi.options {
+		switch opt {
+		case "prjquota", "pquota", "pqnoenforce":
+			return opt != "pqnoenforce", nil
+		}
+	}
+	return false, nil
+}
+
+const (
+	// projidFile lists the project IDs reserved by the administrator of the
+	// host, which are never used for allocations.
+	projidFile = "/etc/projid"
+
+	// maxProjectIDProbes limits how many project IDs are tried when looking
+	// for an unused one.
+	maxProjectIDProbes = 4096
+)
+
+var (
+	// claimedProjectIDs are the project IDs allocated to the alloc dirs of
+	// this agent. They are tracked in memory as well, because the quota of a
+	// new alloc dir isn't set until after its project ID is allocated.
+	claimedProjectIDs   = map[uint32]struct{}{}
+	claimedProjectIDsMu sync.Mutex
+)
+
+// ProjectQuotaID returns the project ID used for the quota of the alloc dir.
+// The ID already assigned to the alloc dir is reused, so the ID doesn't change
+// when the allocation is restored. Otherwise an ID is allocated starting from
+// a hash of the allocation ID, skipping IDs which are reserved in /etc/projid,
+// claimed by other alloc dirs, or which already have a quota or usage on the
+// filesystem.
+func (d *AllocDir) ProjectQuotaID(allocID string) (uint32, error) {
+	claimedProjectIDsMu.Lock()
+	defer claimedProjectIDsMu.Unlock()
+
+	id, err := d.AssignedProjectQuotaID()
+	if err != nil {
+		return 0, err
+	}
+	if id != 0 {
+		claimedProjectIDs[id] = struct{}{}
+		return id, nil
+	}
+
+	mi, err := findMount(d.AllocDir)
+	if err != nil {
+		return 0, err
+	}
+	reserved, err := reservedProjectIDs(projidFile)
+	if err != nil {
+		return 0, err
+	}
+
+	h := fnv.New32a()
+	h.Write([]byte(allocID))
+	id = h.Sum32()
+
+	for i := 0; i < maxProjectIDProbes; i, id = i+1, id+1 {
+		// Project ID 0 is the default project of every file and can't be used
+		if id == 0 {
+			continue
+		}
+		if _, ok := reserved[id]; ok {
+			continue
+		}
+		if _, ok := claimedProjectIDs[id]; ok {
+			continue
+		}
+		inUse, err := projectIDInUse(mi.source, id)
+		if err != nil {
+			return 0, err
+		}
+		if inUse {
+			continue
+		}
+
+		claimedProjectIDs[id] = struct{}{}
+		return id, nil
+	}
+	return 0, fmt.Errorf("failed to find an unused project ID after %d attempts", maxProjectIDProbes)
+}
+
+// AssignedProjectQuotaID returns the project ID assigned to the alloc dir, or
+// 0 if no project ID is assigned or the alloc dir doesn't exist.
+func (d *AllocDir) AssignedProjectQuotaID() (uint32, error) {
+	f, err := os.Open(d.AllocDir)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return 0, nil
+		}
+		return 0, err
+	}
+	defer f.Close()
+
+	var attr fsxattr
+	switch err := ioctl(f.Fd(), fsIocFsGetXattr, unsafe.Pointer(&attr)); err {
+	case nil:
+		return attr.projid, nil
+	case unix.ENOTTY, unix.EOPNOTSUPP:
+		// The filesystem doesn't support project IDs
+		return 0, nil
+	default:
+		return 0, fmt.Errorf("failed to get attributes of %q: %v", d.AllocDir, err)
+	}
+}
+
+// reservedProjectIDs returns the project IDs listed in the projid file at
+// path, whose lines are formatted as "name:id".
+func reservedProjectIDs(path string) (map[uint32]struct{}, error) {
+	ids := map[uint32]struct{}{}
+
+	f, err := os.Open(path)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return ids, nil
+		}
+		return nil, err
+	}
+	defer f.Close()
+
+	scanner := bufio.NewScanner(f)
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+		_, idStr, ok := strings.Cut(line, ":")
+		if !ok {
+			continue
+		}
+		id, err := strconv.ParseUint(strings.TrimSpace(idStr), 10, 32)
+		if err != nil {
+			continue
+		}
+		ids[uint32(id)] = struct{}{}
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// projectIDInUse returns true if the project has a quota or uses any disk
+// space on the device.
+func projectIDInUse(device string, projectID uint32) (bool, error) {
+	var dq ifDqblk
+	err := quotactl(qGetQuota, device, projectID, &dq)
+	switch err {
+	case nil:
+	case unix.ENOENT, unix.ESRCH:
+		// The filesystem has no quota record for the project
+		return false, nil
+	default:
+		return false, fmt.Errorf("failed to get project quota: %v", err)
+	}
+	return dq.bhardlimit != 0 || dq.bsoftlimit != 0 ||
+		dq.curspace != 0 || dq.curinodes != 0, nil
+}
+
+// SetProjectQuota assigns the project ID to the alloc dir and everything in
+// it, and limits the disk used by the project to limit bytes.
+func (d *AllocDir) SetProjectQuota(projectID uint32, limit uint64) error {
+	mi, err := findMount(d.AllocDir)
+	if err != nil {
+		return err
+	}
+
+	err = filepath.WalkDir(d.AllocDir, func(path string, entry fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		if entry.Type()&(fs.ModeSymlink|fs.ModeNamedPipe|fs.ModeSocket|fs.ModeDevice) != 0 {
+			return nil
+		}
+		return setProjectID(path, projectID, entry.IsDir())
+	})
+	if err != nil {
+		return fmt.Errorf("failed to set project ID on alloc dir: %v", err)
+	}
+
+	blocks := (limit + quotaBlockSize - 1) / quotaBlockSize
+	dq := &ifDqblk{
+		bhardlimit: blocks,
+		bsoftlimit: blocks,
+		valid:      qifBlimits,
+	}
+	if err := quotactl(qSetQuota, mi.source, projectID, dq); err != nil {
+		return fmt.Errorf("failed to set project quota: %v", err)
+	}
+	return nil
+}
+
+// ProjectQuotaUsage returns the number of bytes used by the project.
+func (d *AllocDir) ProjectQuotaUsage(projectID uint32) (uint64, error) {
+	mi, err := findMount(d.AllocDir)
+	if err != nil {
+		return 0, err
+	}
+
+	var dq ifDqblk
+	if err := quotactl(qGetQuota, mi.source, projectID, &dq); err != nil {
+		return 0, fmt.Errorf("failed to get project quota: %v", err)
+	}
+	return dq.curspace, nil
+}
+
+// ClearProjectQuota removes the disk limit of the project, and releases the
+// project ID.
+func (d *AllocDir) ClearProjectQuota(projectID uint32) error {
+	mi, err := findMount(d.AllocDir)
+	if err != nil {
+		return err
+	}
+
+	dq := &ifDqblk{valid: qifBlimits}
+	if err := quotactl(qSetQuota, mi.source, projectID, dq); err != nil {
+		return fmt.Errorf("failed to clear project quota: %v", err)
+	}
+
+	claimedProjectIDsMu.Lock()
+	delete(claimedProjectIDs, projectID)
+	claimedProjectIDsMu.Unlock()
+	return nil
+}
+
+// clearAssignedProjectQuota clears the quota of the project ID assigned to the
+// alloc dir, if any. It must be called before the alloc dir is removed.
+func (d *AllocDir) clearAssignedProjectQuota() error {
+	id, err := d.AssignedProjectQuotaID()
+	if err != nil || id == 0 {
+		return err
+	}
+	return d.ClearProjectQuota(id)
+}
+
+// setProjectID sets the project ID of the file at path. Directories are also
+// marked so that files created in them inherit the project ID.
+func setProjectID(path string, projectID uint32, isDir bool) error {
+	f, err := os.Open(path)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	var attr fsxattr
+	if err := ioctl(f.Fd(), fsIocFsGetXattr, unsafe.Pointer(&attr)); err != nil {
+		return fmt.Errorf("failed to get attributes of %q: %v", path, err)
+	}
+
+	attr.projid = projectID
+	if isDir {
+		attr.xflags |= fsXflagProjInherit
+	}
+	if err := ioctl(f.Fd(), fsIocFsSetXattr, unsafe.Pointer(&attr)); err != nil {
+		return fmt.Errorf("failed to set attributes of %q: %v", path, err)
+	}
+	return nil
+}
+
+func ioctl(fd uintptr, req uintptr, arg unsafe.Pointer) error {
+	_, _, errno := unix.Syscall(unix.SYS_IOCTL, fd, req, uintptr(arg))
+	if errno != 0 {
+		return errno
+	}
+	return nil
+}
+
+func quotactl(cmd int, device string, id uint32, dq *ifDqblk) error {
+	dev, err := unix.BytePtrFromString(device)
+	if err != nil {
+		return err
+	}
+
+	// QCMD(cmd, type)
+	qcmd := uintptr(cmd<<8 | prjQuota)
+	_, _, errno := unix.Syscall6(unix.SYS_QUOTACTL, qcmd,
+		uintptr(unsafe.Pointer(dev)), uintptr(id), uintptr(unsafe.Pointer(dq)), 0, 0)
+	if errno != 0 {
+		return errno
+	}
+	return nil
+}
+
+// findMount returns the mount that contains path.
+func findMount(path string) (*mountInfo, error) {
+	path, err := filepath.EvalSymlinks(path)
+	if err != nil {
+		return nil, err
+	}
+
+	f, err := os.Open("/proc/self/mountinfo")
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	var found *mountInfo
+	scanner := bufio.NewScanner(f)
+	for scanner.Scan() {
+		mi, ok := parseMountInfo(scanner.Text())
+		if !ok || !pathWithin(path, mi.mountPoint) {
+			continue
+		}
+
+		// Later entries are mounted over earlier ones, so the last and
+		// longest match wins
+		if found == nil || len(mi.mountPoint) >= len(found.mountPoint) {
+			found = mi
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+	if found == nil {
+		return nil, fmt.Errorf("failed to find mount of %q", path)
+	}
+	return found, nil
+}
+
+// parseMountInfo parses a line of /proc/self/mountinfo, documented in
+// proc(5).
+func parseMountInfo(line string) (*mountInfo, bool) {
+	fields := strings.Fields(line)
+
+	// The optional fields are terminated by a single hyphen
+	sep := -1
+	for i := 6; i < len(fields); i++ {
+		if fields[i] == "-" {
+			sep = i
+			break
+		}
+	}
+	if sep < 0 || len(fields) < sep+4 {
+		return nil, false
+	}
+
+	options := strings.Split(fields[5], ",")
+	options = append(options, strings.Split(fields[sep+3], ",")...)
+	return &mountInfo{
+		mountPoint: unescapeMountPath(fields[4]),
+		fsType:     fields[sep+1],
+		source:     unescapeMountPath(fields[sep+2]),
+		options:    options,
+	}, true
+}
+
+// unescapeMountPath decodes the octal escapes used for whitespace and
+// backslashes in mountinfo paths.
+func unescapeMountPath(s string) string {
+	if !strings.Contains(s, `\`) {
+		return s
+	}
+
+	var b strings.Builder
+	for i := 0; i < len(s); i++ {
+		if s[i] == '\\' && i+3 < len(s) && isOctal(s[i+1]) && isOctal(s[i+2]) && isOctal(s[i+3]) {
+			b.WriteByte((s[i+1]-'0')<<6 | (s[i+2]-'0')<<3 | (s[i+3] - '0'))
+			i += 3
+			continue
+		}
+		b.WriteByte(s[i])
+	}
+	return b.String()
+}
+
+func isOctal(c byte) bool {
+	return c >= '0' && c <= '7'
+}
+
+// pathWithin returns true if path is dir or is inside of it.
+func pathWithin(path, dir string) bool {
+	if dir == "/" || path == dir {
+		return true
+	}
+	return strings.HasPrefix(path, dir+"/")
+}
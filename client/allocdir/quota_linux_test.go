@@ -0,0 +1,83 @@
+package allocdir
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/hashicorp/nomad/ci"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAllocDir_parseMountInfo(t *testing.T) {
+	ci.Parallel(t)
+
+	cases := []struct {
+		name string
+		line string
+		exp  *mountInfo
+	}{
+		{
+			name: "xfs with project quotas",
+			line: "36 25 8:2 / /var/lib/nomad rw,relatime shared:1 - xfs /dev/sda2 rw,attr2,inode64,prjquota",
+			exp: &mountInfo{
+				mountPoint: "/var/lib/nomad",
+				fsType:     "xfs",
+				source:     "/dev/sda2",
+				options:    []string{"rw", "relatime", "rw", "attr2", "inode64", "prjquota"},
+			},
+		},
+		{
+			name: "no optional fields and escaped path",
+			line: `40 25 0:35 / /mnt/with\040space rw - tmpfs tmpfs rw,size=1024k`,
+			exp: &mountInfo{
+				mountPoint: "/mnt/with space",
+				fsType:     "tmpfs",
+				source:     "tmpfs",
+				options:    []string{"rw", "rw", "size=1024k"},
+			},
+		},
+		{
+			name: "malformed",
+			line: "36 25 8:2 / /var/lib/nomad rw",
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			mi, ok := parseMountInfo(tc.line)
+			require.Equal(t, tc.exp != nil, ok)
+			require.Equal(t, tc.exp, mi)
+		})
+	}
+}
+
+func TestAllocDir_reservedProjectIDs(t *testing.T) {
+	ci.Parallel(t)
+
+	path := filepath.Join(t.TempDir(), "projid")
+	require.NoError(t, os.WriteFile(path, []byte(`# reserved projects
+logs:10
+ data : 42
+malformed
+invalid:abc
+`), 0644))
+
+	ids, err := reservedProjectIDs(path)
+	require.NoError(t, err)
+	require.Equal(t, map[uint32]struct{}{10: {}, 42: {}}, ids)
+
+	// A missing projid file reserves nothing
+	ids, err = reservedProjectIDs(filepath.Join(t.TempDir(), "missing"))
+	require.NoError(t, err)
+	require.Empty(t, ids)
+}
+
+func TestAllocDir_AssignedProjectQuotaID_Missing(t *testing.T) {
+	ci.Parallel(t)
+
+	d := &AllocDir{AllocDir: filepath.Join(t.TempDir(), "missing")}
+	id, err := d.AssignedProjectQuotaID()
+	require.NoError(t, err)
+	require.Zero(t, id)
+}
@@ -38,6 +38,10 @@ type TaskDir struct {
 	// <task_dir>/secrets/
 	SecretsDir string
 
+	// IDMap is set if the task runs in a user namespace, in which case the
+	// directories of the task are owned by the host IDs nobody is mapped to.
+	IDMap *IDMap
+
 	// skip embedding these paths in chroots. Used for avoiding embedding
 	// client.alloc_dir recursively.
 	skip map[string]struct{}
@@ -45,6 +49,13 @@ type TaskDir struct {
 	logger hclog.Logger
 }
 
+// IDMap maps the user and group IDs of a task running in a user namespace to
+// the host IDs starting at UID and GID.
+type IDMap struct {
+	UID int
+	GID int
+}
+
 // newTaskDir creates a TaskDir struct with paths set. Call Build() to
 // create paths on disk.
 //
@@ -79,7 +90,7 @@ func (t *TaskDir) Build(createChroot bool, chroot map[string]string) error {
 	}
 
 	// Make the task directory have non-root permissions.
-	if err := dropDirPermissions(t.Dir, os.ModePerm); err != nil {
+	if err := dropDirPermissions(t.Dir, os.ModePerm, t.IDMap); err != nil {
 		return err
 	}
 
@@ -88,7 +99,7 @@ func (t *TaskDir) Build(createChroot bool, chroot map[string]string) error {
 		return err
 	}
 
-	if err := dropDirPermissions(t.LocalDir, os.ModePerm); err != nil {
+	if err := dropDirPermissions(t.LocalDir, os.ModePerm, t.IDMap); err != nil {
 		return err
 	}
 
@@ -99,7 +110,7 @@ func (t *TaskDir) Build(createChroot bool, chroot map[string]string) error {
 			return err
 		}
 
-		if err := dropDirPermissions(absdir, perms); err != nil {
+		if err := dropDirPermissions(absdir, perms, t.IDMap); err != nil {
 			return err
 		}
 	}
@@ -123,7 +134,7 @@ func (t *TaskDir) Build(createChroot bool, chroot map[string]string) error {
 		return err
 	}
 
-	if err := dropDirPermissions(t.SecretsDir, os.ModePerm); err != nil {
+	if err := dropDirPermissions(t.SecretsDir, os.ModePerm, t.IDMap); err != nil {
 		return err
 	}
 
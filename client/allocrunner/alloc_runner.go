@@ -20,12 +20,14 @@ import (
 	"github.com/hashicorp/nomad/client/dynamicplugins"
 	cinterfaces "github.com/hashicorp/nomad/client/interfaces"
 	"github.com/hashicorp/nomad/client/lib/cgutil"
+	"github.com/hashicorp/nomad/client/lib/idmap"
 	"github.com/hashicorp/nomad/client/pluginmanager/csimanager"
 	"github.com/hashicorp/nomad/client/pluginmanager/drivermanager"
 	"github.com/hashicorp/nomad/client/serviceregistration"
 	"github.com/hashicorp/nomad/client/serviceregistration/checks/checkstore"
 	"github.com/hashicorp/nomad/client/serviceregistration/wrapper"
 	cstate "github.com/hashicorp/nomad/client/state"
+	"github.com/hashicorp/nomad/client/stats"
 	cstructs "github.com/hashicorp/nomad/client/structs"
 	"github.com/hashicorp/nomad/client/vaultclient"
 	"github.com/hashicorp/nomad/helper/pointer"
@@ -128,6 +130,9 @@ type allocRunner struct {
 	// transitions.
 	runnerHooks []interfaces.RunnerHook
 
+	// diskQuotaHook enforces the ephemeral disk size and reports its usage
+	diskQuotaHook *diskQuotaHook
+
 	// hookState is the output of allocrunner hooks
 	hookState   *cstructs.AllocHookResources
 	hookStateMu sync.RWMutex
@@ -167,6 +172,10 @@ type allocRunner struct {
 	// event handlers
 	driverManager drivermanager.Manager
 
+	// userNamespaces allocates the host ID range of the user namespace of the
+	// allocation
+	userNamespaces *idmap.Allocator
+
 	// serversContactedCh is passed to TaskRunners so they can detect when
 	// servers have been contacted for the first time in case of a failed
 	// restore.
@@ -233,6 +242,7 @@ func NewAllocRunner(config *Config) (*allocRunner, error) {
 		cpusetManager:            config.CpusetManager,
 		devicemanager:            config.DeviceManager,
 		driverManager:            config.DriverManager,
+		userNamespaces:           config.UserNamespaces,
 		serversContactedCh:       config.ServersContactedCh,
 		rpcClient:                config.RPCClient,
 		serviceRegWrapper:        config.ServiceRegWrapper,
@@ -288,11 +298,13 @@ func (ar *allocRunner) initTaskRunners(tasks []*structs.Task) error {
 			CSIManager:          ar.csiManager,
 			DeviceManager:       ar.devicemanager,
 			DriverManager:       ar.driverManager,
+			UserNamespaces:      ar.userNamespaces,
 			ServersContactedCh:  ar.serversContactedCh,
 			StartConditionMetCh: ar.taskCoordinator.StartConditionForTask(task),
 			ShutdownDelayCtx:    ar.shutdownDelayCtx,
 			ServiceRegWrapper:   ar.serviceRegWrapper,
 			Getter:              ar.getter,
+			RPCClient:           ar.rpcClient,
 		}
 
 		if ar.cpusetManager != nil {
@@ -815,7 +827,7 @@ func getClientStatus(taskStates map[string]*structs.TaskState) (status, descript
 	var pending, running, dead, failed bool
 	for _, state := range taskStates {
 		switch state.State {
-		case structs.TaskStateRunning:
+		case structs.TaskStateRunning, structs.TaskStatePaused:
 			running = true
 		case structs.TaskStatePending:
 			pending = true
@@ -1009,6 +1021,11 @@ func (ar *allocRunner) destroyImpl() {
 		ar.logger.Warn("failed to delete allocation state", "error", err)
 	}
 
+	// The alloc dir was destroyed, so its user namespace can be reused
+	if ar.userNamespaces != nil {
+		ar.userNamespaces.Release(ar.id)
+	}
+
 	if !ar.shutdown {
 		ar.shutdown = true
 		close(ar.shutdownCh)
@@ -1221,9 +1238,30 @@ func (ar *allocRunner) LatestAllocStats(taskFilter string) (*cstructs.AllocResou
 		}
 	}
 
+	// The ephemeral disk is shared by all tasks so it is only reported for
+	// the allocation as a whole
+	if ar.diskQuotaHook != nil {
+		astat.DiskUsage = ar.diskQuotaHook.DiskUsage()
+	}
+
 	return astat, nil
 }
 
+// AllocStatsHistory returns the recent resource usage history of each task
+// in the allocation, collected after since (in UnixNano). If taskFilter is
+// set, only the history for that task -- if it exists -- is returned.
+func (ar *allocRunner) AllocStatsHistory(taskFilter string, since int64) (map[string][]*stats.ResourceUsageSample, error) {
+	history := make(map[string][]*stats.ResourceUsageSample, len(ar.tasks))
+	for name, tr := range ar.tasks {
+		if taskFilter != "" && taskFilter != name {
+			continue
+		}
+		history[name] = tr.StatsHistory(since)
+	}
+
+	return history, nil
+}
+
 func (ar *allocRunner) GetTaskEventHandler(taskName string) drivermanager.EventHandler {
 	if tr, ok := ar.tasks[taskName]; ok {
 		return func(ev *drivers.TaskEvent) {
@@ -1337,6 +1375,79 @@ func (ar *allocRunner) Signal(taskName, signal string) error {
 	return err.ErrorOrNil()
 }
 
+// Pause freezes the processes of a task, or of all running tasks if taskName
+// is empty.
+func (ar *allocRunner) Pause(taskName string) error {
+	event := structs.NewTaskEvent(structs.TaskPaused)
+
+	if taskName != "" {
+		tr, ok := ar.tasks[taskName]
+		if !ok {
+			return fmt.Errorf("Task not found")
+		}
+
+		return tr.Pause(event)
+	}
+
+	var err *multierror.Error
+
+	for tn, tr := range ar.tasks {
+		// Tasks that are not running have nothing to pause
+		if perr := tr.Pause(event.Copy()); perr != nil && perr != taskrunner.ErrTaskNotRunning {
+			err = multierror.Append(err, fmt.Errorf("Failed to pause task: %s, err: %v", tn, perr))
+		}
+	}
+
+	return err.ErrorOrNil()
+}
+
+// Resume thaws the processes of a paused task, or of all paused tasks if
+// taskName is empty.
+func (ar *allocRunner) Resume(taskName string) error {
+	event := structs.NewTaskEvent(structs.TaskResumed)
+
+	if taskName != "" {
+		tr, ok := ar.tasks[taskName]
+		if !ok {
+			return fmt.Errorf("Task not found")
+		}
+
+		return tr.Resume(event)
+	}
+
+	var err *multierror.Error
+
+	for tn, tr := range ar.tasks {
+		if rerr := tr.Resume(event.Copy()); rerr != nil && rerr != taskrunner.ErrTaskNotRunning {
+			err = multierror.Append(err, fmt.Errorf("Failed to resume task: %s, err: %v", tn, rerr))
+		}
+	}
+
+	return err.ErrorOrNil()
+}
+
+// IsPaused satisfies the WorkloadPauser interface and returns true if any task
+// of the allocation is paused.
+func (ar *allocRunner) IsPaused() bool {
+	for _, tr := range ar.tasks {
+		if tr.IsPaused() {
+			return true
+		}
+	}
+	return false
+}
+
+// IsTaskPaused returns true if the named task is paused. If taskName is
+// empty, it returns true if any task of the allocation is paused.
+func (ar *allocRunner) IsTaskPaused(taskName string) bool {
+	if taskName == "" {
+		return ar.IsPaused()
+	}
+
+	tr, ok := ar.tasks[taskName]
+	return ok && tr.IsPaused()
+}
+
 // Reconnect logs a reconnect event for each task in the allocation and syncs the current alloc state with the server.
 func (ar *allocRunner) Reconnect(update *structs.Allocation) (err error) {
 	event := structs.NewTaskEvent(structs.TaskClientReconnected)
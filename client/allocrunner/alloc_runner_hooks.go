@@ -107,6 +107,23 @@ func (a *allocHealthSetter) SetHealth(healthy, isDeploy bool, trackerTaskEvents
 	a.ar.allocBroadcaster.Send(calloc)
 }
 
+// allocTaskFailer is a shim to allow alloc runner hooks to fail and kill all
+// of the allocation's tasks without full access to the alloc runner.
+type allocTaskFailer struct {
+	ar *allocRunner
+}
+
+// FailTasks emits the failing event on every live task and kills them.
+func (a *allocTaskFailer) FailTasks(event *structs.TaskEvent) {
+	for _, tr := range a.ar.tasks {
+		if tr.IsPoststopTask() || tr.TaskState().State == structs.TaskStateDead {
+			continue
+		}
+		tr.EmitEvent(event)
+	}
+	a.ar.killTasks()
+}
+
 // initRunnerHooks initializes the runners hooks.
 func (ar *allocRunner) initRunnerHooks(config *clientconfig.Config) error {
 	hookLogger := ar.logger.Named("runner_hook")
@@ -147,11 +164,16 @@ func (ar *allocRunner) initRunnerHooks(config *clientconfig.Config) error {
 	// Create the alloc directory hook. This is run first to ensure the
 	// directory path exists for other hooks.
 	alloc := ar.Alloc()
+	ar.diskQuotaHook = newDiskQuotaHook(hookLogger, alloc, ar.allocDir, &allocTaskFailer{ar}, config.Node.Attributes)
 	ar.runnerHooks = []interfaces.RunnerHook{
 		newAllocDirHook(hookLogger, ar.allocDir),
 		newCgroupHook(ar.Alloc(), ar.cpusetManager),
 		newUpstreamAllocsHook(hookLogger, ar.prevAllocWatcher),
 		newDiskMigrationHook(hookLogger, ar.prevAllocMigrator, ar.allocDir),
+		// The disk quota hook must run after the disk migration hook, so the
+		// project ID is also set on the data migrated from the previous
+		// allocation.
+		ar.diskQuotaHook,
 		newAllocHealthWatcherHook(hookLogger, alloc, newEnvBuilder, hs, ar.Listener(), ar.consulClient, ar.checkStore),
 		newNetworkHook(hookLogger, ns, alloc, nm, nc, ar, builtTaskEnv),
 		newGroupServiceHook(groupServiceHookConfig{
@@ -167,7 +189,7 @@ func (ar *allocRunner) initRunnerHooks(config *clientconfig.Config) error {
 		newConsulGRPCSocketHook(hookLogger, alloc, ar.allocDir, config.ConsulConfig, config.Node.Attributes),
 		newConsulHTTPSocketHook(hookLogger, alloc, ar.allocDir, config.ConsulConfig),
 		newCSIHook(alloc, hookLogger, ar.csiManager, ar.rpcClient, ar, hrs, ar.clientConfig.Node.SecretID),
-		newChecksHook(hookLogger, alloc, ar.checkStore, ar),
+		newChecksHook(hookLogger, alloc, ar.checkStore, ar, ar),
 	}
 
 	return nil
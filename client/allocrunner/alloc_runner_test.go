@@ -1,6 +1,7 @@
 package allocrunner
 
 import (
+	"bytes"
 	"errors"
 	"fmt"
 	"os"
@@ -1716,6 +1717,62 @@ func TestAllocRunner_MoveAllocDir(t *testing.T) {
 
 }
 
+// TestAllocRunner_MoveAllocDir_DiskQuota asserts that the ephemeral disk size
+// of a rescheduled allocation is applied to the data migrated from the
+// previous allocation.
+func TestAllocRunner_MoveAllocDir_DiskQuota(t *testing.T) {
+	ci.Parallel(t)
+
+	alloc := mock.BatchAlloc()
+	conf, cleanup := testAllocRunnerConfig(t, alloc)
+	defer cleanup()
+	ar, err := NewAllocRunner(conf)
+	require.NoError(t, err)
+	ar.Run()
+	defer destroy(ar)
+
+	WaitForClientState(t, ar, structs.AllocClientStatusComplete)
+
+	data := bytes.Repeat([]byte("a"), 512*1024)
+	dataFile := filepath.Join(ar.allocDir.SharedDir, "data", "data_file")
+	require.NoError(t, os.WriteFile(dataFile, data, 0644))
+
+	alloc2 := mock.BatchAlloc()
+	alloc2.PreviousAllocation = alloc.ID
+	alloc2.Job.TaskGroups[0].EphemeralDisk.Sticky = true
+	alloc2.Job.TaskGroups[0].EphemeralDisk.SizeMB = 10
+
+	conf2, cleanup := testAllocRunnerConfig(t, alloc2)
+	conf2.PrevAllocWatcher, conf2.PrevAllocMigrator = allocwatcher.NewAllocWatcher(allocwatcher.Config{
+		Alloc:          alloc2,
+		PreviousRunner: ar,
+		Logger:         conf2.Logger,
+	})
+	defer cleanup()
+	ar2, err := NewAllocRunner(conf2)
+	require.NoError(t, err)
+
+	// The quota must be set once the previous allocation's data is migrated
+	hookIndex := map[string]int{}
+	for i, hook := range ar2.runnerHooks {
+		hookIndex[hook.Name()] = i
+	}
+	require.Less(t, hookIndex["migrate_disk"], hookIndex[diskQuotaHookName])
+
+	ar2.Run()
+	defer destroy(ar2)
+
+	WaitForClientState(t, ar2, structs.AllocClientStatusComplete)
+
+	dataFile = filepath.Join(ar2.allocDir.SharedDir, "data", "data_file")
+	require.FileExists(t, dataFile)
+
+	// The first measurement of the disk usage includes the migrated data
+	usage := ar2.diskQuotaHook.DiskUsage()
+	require.NotNil(t, usage)
+	require.GreaterOrEqual(t, usage.UsedBytes, uint64(len(data)))
+}
+
 // TestAllocRuner_HandlesArtifactFailure ensures that if one task in a task group is
 // retrying fetching an artifact, other tasks in the group should be able
 // to proceed.
@@ -33,6 +33,7 @@ type observer struct {
 	qc      *checks.QueryContext
 	check   *structs.ServiceCheck
 	allocID string
+	pauser  taskPauser
 }
 
 // start checking our check on its interval
@@ -52,6 +53,12 @@ func (o *observer) start() {
 
 		// time to execute the check
 		case <-timer.C:
+			// the frozen processes of a paused task cannot respond
+			if o.pauser.IsTaskPaused(o.qc.Task) {
+				timer.Reset(o.check.Interval)
+				continue
+			}
+
 			query := checks.GetCheckQuery(o.check)
 			result := o.checker.Do(o.ctx, o.qc, query)
 
@@ -69,6 +76,14 @@ func (o *observer) stop() {
 	o.cancel()
 }
 
+// taskPauser reports whether the tasks of an allocation are paused, so that
+// their checks can be skipped.
+type taskPauser interface {
+	// IsTaskPaused returns true if the named task is paused, or if any task
+	// of the allocation is paused when the name is empty.
+	IsTaskPaused(taskName string) bool
+}
+
 // checksHook manages checks of Nomad service registrations, at both the group and
 // task level, by storing / removing them from the Client state store.
 //
@@ -78,6 +93,7 @@ type checksHook struct {
 	network structs.NetworkStatus
 	shim    checkstore.Shim
 	checker checks.Checker
+	pauser  taskPauser
 	allocID string
 
 	// fields that get re-initialized on allocation update
@@ -93,6 +109,7 @@ func newChecksHook(
 	alloc *structs.Allocation,
 	shim checkstore.Shim,
 	network structs.NetworkStatus,
+	pauser taskPauser,
 ) *checksHook {
 	h := &checksHook{
 		logger:  logger.Named(checksHookName),
@@ -100,6 +117,7 @@ func newChecksHook(
 		alloc:   alloc,
 		shim:    shim,
 		network: network,
+		pauser:  pauser,
 		checker: checks.New(logger),
 	}
 	h.initialize(alloc)
@@ -165,6 +183,7 @@ func (h *checksHook) observe(alloc *structs.Allocation, services []*structs.Serv
 				checkStore: h.shim,
 				checker:    h.checker,
 				allocID:    h.allocID,
+				pauser:     h.pauser,
 				qc: &checks.QueryContext{
 					ID:               id,
 					CustomAddress:    service.Address,
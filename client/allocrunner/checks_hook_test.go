@@ -138,6 +138,44 @@ var checkHandler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request)
 	}
 })
 
+// testTaskPauser reports all tasks as paused if set
+type testTaskPauser bool
+
+func (p testTaskPauser) IsTaskPaused(string) bool {
+	return bool(p)
+}
+
+func TestCheckHook_Checks_Paused(t *testing.T) {
+	ci.Parallel(t)
+
+	logger := testlog.HCLogger(t)
+
+	// create an http server with various responses
+	ts := httptest.NewServer(checkHandler)
+	defer ts.Close()
+
+	// get the address and port for http server
+	tokens := strings.Split(ts.URL, ":")
+	addr, port := strings.TrimPrefix(tokens[1], "//"), tokens[2]
+
+	checkStore := makeCheckStore(logger)
+	network := mock.NewNetworkStatus(addr)
+	alloc := allocWithNomadChecks(addr, port, false)
+
+	h := newChecksHook(logger, alloc, checkStore, network, testTaskPauser(true))
+	must.NoError(t, h.Prerun())
+	defer h.PreKill()
+
+	// checks of paused tasks are never executed, so they remain pending
+	// well past their interval
+	time.Sleep(time.Second)
+	results := checkStore.List(alloc.ID)
+	must.MapLen(t, 3, results)
+	for _, result := range results {
+		must.Eq(t, structs.CheckPending, result.Status)
+	}
+}
+
 func TestCheckHook_Checks_ResultsSet(t *testing.T) {
 	ci.Parallel(t)
 
@@ -166,7 +204,7 @@ func TestCheckHook_Checks_ResultsSet(t *testing.T) {
 
 		alloc := allocWithNomadChecks(addr, port, tc.onGroup)
 
-		h := newChecksHook(logger, alloc, checkStore, network)
+		h := newChecksHook(logger, alloc, checkStore, network, testTaskPauser(false))
 
 		// initialize is called; observers are created but not started yet
 		must.MapEmpty(t, h.observers)
@@ -231,7 +269,7 @@ func TestCheckHook_Checks_UpdateSet(t *testing.T) {
 
 	alloc := allocWithNomadChecks(addr, port, true)
 
-	h := newChecksHook(logger, alloc, shim, network)
+	h := newChecksHook(logger, alloc, shim, network, testTaskPauser(false))
 
 	// calling pre-run starts the observers
 	err := h.Prerun()
@@ -9,6 +9,7 @@ import (
 	"github.com/hashicorp/nomad/client/dynamicplugins"
 	"github.com/hashicorp/nomad/client/interfaces"
 	"github.com/hashicorp/nomad/client/lib/cgutil"
+	"github.com/hashicorp/nomad/client/lib/idmap"
 	"github.com/hashicorp/nomad/client/pluginmanager/csimanager"
 	"github.com/hashicorp/nomad/client/pluginmanager/drivermanager"
 	"github.com/hashicorp/nomad/client/serviceregistration"
@@ -73,6 +74,10 @@ type Config struct {
 	// DriverManager handles dispensing of driver plugins
 	DriverManager drivermanager.Manager
 
+	// UserNamespaces allocates the host ID ranges of the user namespaces of
+	// allocations
+	UserNamespaces *idmap.Allocator
+
 	// CpusetManager configures the cpuset cgroup if supported by the platform
 	CpusetManager cgutil.CpusetManager
 
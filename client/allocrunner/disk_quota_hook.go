@@ -0,0 +1,235 @@
+package allocrunner
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/hashicorp/go-hclog"
+	"github.com/hashicorp/nomad/client/allocdir"
+	"github.com/hashicorp/nomad/client/allocrunner/interfaces"
+	cstructs "github.com/hashicorp/nomad/client/structs"
+	"github.com/hashicorp/nomad/helper"
+	"github.com/hashicorp/nomad/nomad/structs"
+)
+
+const (
+	// diskQuotaHookName is the name of this hook as appears in logs
+	diskQuotaHookName = "disk_quota"
+
+	// diskUsageInterval is how often the disk usage of the alloc dir is
+	// measured
+	diskUsageInterval = 30 * time.Second
+
+	// projectQuotaAttr is the node attribute set by the project_quota
+	// fingerprinter when the alloc dir supports project quotas
+	projectQuotaAttr = "storage.project_quota"
+)
+
+// taskFailer is used by hooks to fail and kill all of the allocation's tasks.
+type taskFailer interface {
+	FailTasks(event *structs.TaskEvent)
+}
+
+// diskQuotaHook enforces the ephemeral_disk size of an allocation. When the
+// filesystem of the alloc dir supports project quotas, the size is enforced by
+// the filesystem. Otherwise the disk usage of the alloc dir is measured
+// periodically, and the allocation's tasks are failed once the usage exceeds
+// the size.
+type diskQuotaHook struct {
+	allocDir *allocdir.AllocDir
+	failer   taskFailer
+	logger   hclog.Logger
+
+	// size is the ephemeral_disk size of the allocation in MB
+	size int64
+
+	// projectQuotas is true if the alloc dir supports project quotas
+	projectQuotas bool
+
+	// allocID is the ID of the allocation
+	allocID string
+
+	// projectID is the project ID used for the quota of the alloc dir. It is
+	// allocated when the quota is set.
+	projectID uint32
+
+	// interval is how often the disk usage is measured
+	interval time.Duration
+
+	// mu protects the fields below
+	mu sync.Mutex
+
+	// usage is the latest measured disk usage
+	usage *cstructs.AllocDiskUsage
+
+	// cancel stops the disk usage watcher
+	cancel context.CancelFunc
+}
+
+func newDiskQuotaHook(logger hclog.Logger, alloc *structs.Allocation, allocDir *allocdir.AllocDir,
+	failer taskFailer, nodeAttrs map[string]string) *diskQuotaHook {
+
+	h := &diskQuotaHook{
+		allocDir:      allocDir,
+		failer:        failer,
+		projectQuotas: nodeAttrs[projectQuotaAttr] == "true",
+		allocID:       alloc.ID,
+		interval:      diskUsageInterval,
+	}
+	if tg := alloc.Job.LookupTaskGroup(alloc.TaskGroup); tg != nil && tg.EphemeralDisk != nil {
+		h.size = int64(tg.EphemeralDisk.SizeMB)
+	}
+	h.logger = logger.Named(h.Name())
+	return h
+}
+
+// statically assert the hook implements the expected interfaces
+var (
+	_ interfaces.RunnerPrerunHook  = (*diskQuotaHook)(nil)
+	_ interfaces.RunnerPostrunHook = (*diskQuotaHook)(nil)
+	_ interfaces.RunnerDestroyHook = (*diskQuotaHook)(nil)
+	_ interfaces.ShutdownHook      = (*diskQuotaHook)(nil)
+)
+
+func (h *diskQuotaHook) Name() string {
+	return diskQuotaHookName
+}
+
+func (h *diskQuotaHook) Prerun() error {
+	if h.size <= 0 {
+		return nil
+	}
+
+	enforcement := cstructs.DiskEnforcementAccounting
+	if h.projectQuotas {
+		if err := h.setProjectQuota(); err != nil {
+			h.logger.Warn("failed to set project quota, falling back to disk usage accounting", "error", err)
+		} else {
+			enforcement = cstructs.DiskEnforcementProjectQuota
+		}
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	// Prerun is called again when the allocation is restored
+	if h.cancel != nil {
+		h.cancel()
+	}
+	h.cancel = cancel
+
+	go h.watch(ctx, enforcement)
+	return nil
+}
+
+// setProjectQuota allocates a project ID for the alloc dir and limits its
+// disk usage to the ephemeral disk size.
+func (h *diskQuotaHook) setProjectQuota() error {
+	projectID, err := h.allocDir.ProjectQuotaID(h.allocID)
+	if err != nil {
+		return err
+	}
+	limit := uint64(h.size) * 1024 * 1024
+	if err := h.allocDir.SetProjectQuota(projectID, limit); err != nil {
+		return err
+	}
+	h.projectID = projectID
+	return nil
+}
+
+// watch periodically measures the disk usage of the alloc dir until ctx is
+// canceled. When enforcing the size through accounting, the allocation's tasks
+// are failed once the usage exceeds the size.
+func (h *diskQuotaHook) watch(ctx context.Context, enforcement string) {
+	timer, stop := helper.NewSafeTimer(0)
+	defer stop()
+
+	sizeBytes := uint64(h.size) * 1024 * 1024
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-timer.C:
+		}
+
+		var used uint64
+		var err error
+		if enforcement == cstructs.DiskEnforcementProjectQuota {
+			used, err = h.allocDir.ProjectQuotaUsage(h.projectID)
+		} else {
+			used, err = h.allocDir.DiskUsage()
+		}
+		if err != nil {
+			h.logger.Warn("failed to measure disk usage", "error", err)
+			timer.Reset(h.interval)
+			continue
+		}
+
+		h.mu.Lock()
+		h.usage = &cstructs.AllocDiskUsage{
+			UsedBytes:   used,
+			SizeBytes:   sizeBytes,
+			Enforcement: enforcement,
+			Timestamp:   time.Now().UTC().UnixNano(),
+		}
+		h.mu.Unlock()
+
+		if enforcement == cstructs.DiskEnforcementAccounting && used > sizeBytes {
+			usedMB := int64(used / 1024 / 1024)
+			h.logger.Info("disk usage exceeded ephemeral disk size, failing tasks",
+				"used_mb", usedMB, "size_mb", h.size)
+
+			event := structs.NewTaskEvent(structs.TaskDiskExceeded).
+				SetDiskLimit(h.size).
+				SetDiskSize(usedMB).
+				SetFailsTask()
+			h.failer.FailTasks(event)
+			return
+		}
+
+		timer.Reset(h.interval)
+	}
+}
+
+// DiskUsage returns the latest measured usage of the alloc dir, or nil if the
+// ephemeral disk size is not being enforced.
+func (h *diskQuotaHook) DiskUsage() *cstructs.AllocDiskUsage {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	if h.usage == nil {
+		return nil
+	}
+	usage := *h.usage
+	return &usage
+}
+
+func (h *diskQuotaHook) Postrun() error {
+	h.stop()
+	return nil
+}
+
+func (h *diskQuotaHook) Shutdown() {
+	h.stop()
+}
+
+// Destroy stops the disk usage watcher. The project quota is cleared when the
+// alloc dir is destroyed, because the project ID is stored on the alloc dir.
+func (h *diskQuotaHook) Destroy() error {
+	h.stop()
+	return nil
+}
+
+// stop stops the disk usage watcher, if it is running.
+func (h *diskQuotaHook) stop() {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	if h.cancel != nil {
+		h.cancel()
+		h.cancel = nil
+	}
+}
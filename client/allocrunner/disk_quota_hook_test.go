@@ -0,0 +1,80 @@
+package allocrunner
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/nomad/ci"
+	"github.com/hashicorp/nomad/client/allocdir"
+	cstructs "github.com/hashicorp/nomad/client/structs"
+	"github.com/hashicorp/nomad/helper/testlog"
+	"github.com/hashicorp/nomad/nomad/mock"
+	"github.com/hashicorp/nomad/nomad/structs"
+	"github.com/stretchr/testify/require"
+)
+
+type mockTaskFailer struct {
+	events chan *structs.TaskEvent
+}
+
+func (m *mockTaskFailer) FailTasks(event *structs.TaskEvent) {
+	m.events <- event
+}
+
+func TestDiskQuotaHook_Accounting(t *testing.T) {
+	ci.Parallel(t)
+
+	alloc := mock.Alloc()
+	alloc.Job.TaskGroups[0].EphemeralDisk.SizeMB = 1
+
+	logger := testlog.HCLogger(t)
+	allocDir, cleanupDir := allocdir.TestAllocDir(t, logger, "DiskQuota", alloc.ID)
+	defer cleanupDir()
+
+	failer := &mockTaskFailer{events: make(chan *structs.TaskEvent, 1)}
+	h := newDiskQuotaHook(logger, alloc, allocDir, failer, map[string]string{})
+	h.interval = 10 * time.Millisecond
+	require.NoError(t, h.Prerun())
+	defer h.Destroy()
+
+	require.Eventually(t, func() bool {
+		usage := h.DiskUsage()
+		return usage != nil && usage.Enforcement == cstructs.DiskEnforcementAccounting
+	}, 5*time.Second, 10*time.Millisecond)
+	require.Equal(t, uint64(1024*1024), h.DiskUsage().SizeBytes)
+
+	// Exceed the ephemeral disk size
+	data := bytes.Repeat([]byte("a"), 2*1024*1024)
+	file := filepath.Join(allocDir.SharedDir, allocdir.SharedDataDir, "data.bin")
+	require.NoError(t, os.WriteFile(file, data, 0644))
+
+	select {
+	case event := <-failer.events:
+		require.Equal(t, structs.TaskDiskExceeded, event.Type)
+		require.True(t, event.FailsTask)
+		require.Equal(t, int64(1), event.DiskLimit)
+	case <-time.After(5 * time.Second):
+		t.Fatal("timed out waiting for tasks to be failed")
+	}
+}
+
+func TestDiskQuotaHook_NoSize(t *testing.T) {
+	ci.Parallel(t)
+
+	alloc := mock.Alloc()
+	alloc.Job.TaskGroups[0].EphemeralDisk.SizeMB = 0
+
+	logger := testlog.HCLogger(t)
+	allocDir, cleanupDir := allocdir.TestAllocDir(t, logger, "DiskQuota", alloc.ID)
+	defer cleanupDir()
+
+	failer := &mockTaskFailer{events: make(chan *structs.TaskEvent, 1)}
+	h := newDiskQuotaHook(logger, alloc, allocDir, failer, map[string]string{projectQuotaAttr: "true"})
+	require.NoError(t, h.Prerun())
+	require.Nil(t, h.DiskUsage())
+	require.NoError(t, h.Postrun())
+	require.NoError(t, h.Destroy())
+}
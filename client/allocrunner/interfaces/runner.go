@@ -2,6 +2,7 @@ package interfaces
 
 import (
 	"github.com/hashicorp/nomad/client/allocrunner/state"
+	"github.com/hashicorp/nomad/client/stats"
 	cstructs "github.com/hashicorp/nomad/client/structs"
 )
 
@@ -27,8 +28,9 @@ type TaskStateHandler interface {
 	TaskStateUpdated()
 }
 
-// AllocStatsReporter gives access to the latest resource usage from the
-// allocation
+// AllocStatsReporter gives access to the latest resource usage and the
+// recent resource usage history from the allocation
 type AllocStatsReporter interface {
 	LatestAllocStats(taskFilter string) (*cstructs.AllocResourceUsage, error)
+	AllocStatsHistory(taskFilter string, since int64) (map[string][]*stats.ResourceUsageSample, error)
 }
@@ -130,7 +130,11 @@ type TaskPoststartHook interface {
 }
 
 type TaskPreKillRequest struct{}
-type TaskPreKillResponse struct{}
+type TaskPreKillResponse struct {
+	// KillTimeoutUsed is how long the hook spent stopping the task
+	// gracefully. It is deducted from the task's kill timeout.
+	KillTimeoutUsed time.Duration
+}
 
 type TaskPreKillHook interface {
 	TaskHook
@@ -154,6 +158,34 @@ type TaskExitedHook interface {
 	Exited(context.Context, *TaskExitedRequest, *TaskExitedResponse) error
 }
 
+type TaskPostrunRequest struct {
+	// Task is the task that finished
+	Task *structs.Task
+
+	// ExitResult is the final exit result of the task. It may be nil if
+	// the task never started or its result could not be retrieved.
+	ExitResult *drivers.ExitResult
+
+	// TaskDir contains the task's directory tree on the host
+	TaskDir *allocdir.TaskDir
+
+	// TaskEnv is the task's environment
+	TaskEnv *taskenv.TaskEnv
+}
+type TaskPostrunResponse struct{}
+
+type TaskPostrunHook interface {
+	TaskHook
+
+	// Postrun is called once after the task has exited for the last time
+	// and will not be restarted, but before it is marked as dead. Time
+	// spent in Postrun counts against the task's lifecycle.
+	//
+	// The context is only cancelled if the client is shutting down, so
+	// hooks must bound their own work.
+	Postrun(context.Context, *TaskPostrunRequest, *TaskPostrunResponse) error
+}
+
 type TaskUpdateRequest struct {
 	VaultToken string
 
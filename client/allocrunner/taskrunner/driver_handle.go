@@ -50,8 +50,14 @@ func (h *DriverHandle) SetKillSignal(signal string) {
 	h.killSignal = signal
 }
 
-func (h *DriverHandle) Kill() error {
-	return h.driver.StopTask(h.taskID, h.killTimeout, h.killSignal)
+// Kill stops the task. The task is given its kill timeout, less the time
+// already used stopping it gracefully, before being forcefully killed.
+func (h *DriverHandle) Kill(killTimeoutUsed time.Duration) error {
+	timeout := h.killTimeout - killTimeoutUsed
+	if timeout < 0 {
+		timeout = 0
+	}
+	return h.driver.StopTask(h.taskID, timeout, h.killSignal)
 }
 
 func (h *DriverHandle) Stats(ctx context.Context, interval time.Duration) (<-chan *cstructs.TaskResourceUsage, error) {
@@ -62,6 +68,19 @@ func (h *DriverHandle) Signal(s string) error {
 	return h.driver.SignalTask(h.taskID, s)
 }
 
+// UpdateResources changes the resources enforced on the running task.
+func (h *DriverHandle) UpdateResources(resources *drivers.Resources) error {
+	return h.driver.UpdateTaskResources(h.taskID, resources)
+}
+
+func (h *DriverHandle) Pause() error {
+	return h.driver.PauseTask(h.taskID)
+}
+
+func (h *DriverHandle) Resume() error {
+	return h.driver.ResumeTask(h.taskID)
+}
+
 // Exec is the handled used by client endpoint handler to invoke the appropriate task driver exec.
 func (h *DriverHandle) Exec(timeout time.Duration, cmd string, args []string) ([]byte, int, error) {
 	command := append([]string{cmd}, args...)
@@ -2,23 +2,33 @@ package taskrunner
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"path/filepath"
 	"sync"
+	"time"
 
 	log "github.com/hashicorp/go-hclog"
 
 	"github.com/hashicorp/nomad/client/allocrunner/interfaces"
+	"github.com/hashicorp/nomad/helper"
 	"github.com/hashicorp/nomad/helper/users"
+	"github.com/hashicorp/nomad/nomad/structs"
 )
 
 // identityHook sets the task runner's Nomad workload identity token
-// based on the signed identity stored on the Allocation
+// based on the signed identity stored on the Allocation. If the identity has
+// an auth method, the identity is exchanged for an ACL token which is
+// refreshed before it expires.
 
 const (
 	// wiTokenFile is the name of the file holding the Nomad token inside the
 	// task's secret directory
 	wiTokenFile = "nomad_token"
+
+	// wiLoginRetryInterval is how long to wait before retrying to refresh an
+	// ACL token after a failed login
+	wiLoginRetryInterval = 5 * time.Second
 )
 
 type identityHook struct {
@@ -29,12 +39,23 @@ type identityHook struct {
 
 	// tokenPath is the path in which to read and write the token
 	tokenPath string
+
+	// aclToken is the ACL token the workload identity was exchanged for, if
+	// the identity has an auth method
+	aclToken *structs.ACLToken
+
+	// ctx and cancel are used to stop refreshing the ACL token
+	ctx    context.Context
+	cancel context.CancelFunc
 }
 
 func newIdentityHook(tr *TaskRunner, logger log.Logger) *identityHook {
+	ctx, cancel := context.WithCancel(context.Background())
 	h := &identityHook{
 		tr:       tr,
 		taskName: tr.taskName,
+		ctx:      ctx,
+		cancel:   cancel,
 	}
 	h.logger = logger.Named(h.Name())
 	return h
@@ -49,16 +70,59 @@ func (h *identityHook) Prestart(ctx context.Context, req *interfaces.TaskPrestar
 	defer h.lock.Unlock()
 	h.tokenPath = filepath.Join(req.TaskDir.SecretsDir, wiTokenFile)
 
-	return h.setToken()
+	if h.authMethod() == "" {
+		return h.setToken()
+	}
+
+	// The ACL token is kept across restarts of the task, and refreshed until
+	// the task stops.
+	if h.aclToken != nil {
+		return nil
+	}
+
+	token, err := h.login()
+	if err != nil {
+		return structs.NewRecoverableError(
+			fmt.Errorf("failed to login with workload identity: %w", err), true)
+	}
+	if err := h.setACLToken(token); err != nil {
+		return err
+	}
+
+	go h.refresh(token)
+	return nil
 }
 
 func (h *identityHook) Update(_ context.Context, req *interfaces.TaskUpdateRequest, _ *interfaces.TaskUpdateResponse) error {
 	h.lock.Lock()
 	defer h.lock.Unlock()
 
+	// ACL tokens are only updated when they're refreshed
+	if h.authMethod() != "" {
+		return nil
+	}
+
 	return h.setToken()
 }
 
+func (h *identityHook) Stop(_ context.Context, _ *interfaces.TaskStopRequest, _ *interfaces.TaskStopResponse) error {
+	h.cancel()
+	return nil
+}
+
+func (h *identityHook) Shutdown() {
+	h.cancel()
+}
+
+// authMethod returns the name of the auth method used to exchange the
+// workload identity for an ACL token, if any.
+func (h *identityHook) authMethod() string {
+	if id := h.tr.task.Identity; id != nil {
+		return id.AuthMethod
+	}
+	return ""
+}
+
 // setToken adds the Nomad token to the task's environment and writes it to a
 // file if requested by the jobsepc.
 func (h *identityHook) setToken() error {
@@ -78,6 +142,97 @@ func (h *identityHook) setToken() error {
 	return nil
 }
 
+// setACLToken exposes the ACL token to the task in place of the workload
+// identity. The caller must hold the lock.
+func (h *identityHook) setACLToken(token *structs.ACLToken) error {
+	h.aclToken = token
+	h.tr.setNomadToken(token.SecretID)
+
+	if h.tr.task.Identity.File {
+		if err := h.writeToken(token.SecretID); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// login exchanges the workload identity for an ACL token using the auth
+// method of the identity.
+func (h *identityHook) login() (*structs.ACLToken, error) {
+	identity := h.tr.Alloc().SignedIdentities[h.taskName]
+	if identity == "" {
+		return nil, errors.New("task has no workload identity")
+	}
+
+	req := &structs.ACLLoginRequest{
+		AuthMethodName: h.authMethod(),
+		LoginToken:     identity,
+		WriteRequest:   structs.WriteRequest{Region: h.tr.clientConfig.Region},
+	}
+	var resp structs.ACLLoginResponse
+	if err := h.tr.rpcClient.RPC(structs.ACLLoginRPCMethod, req, &resp); err != nil {
+		return nil, err
+	}
+	if resp.ACLToken == nil {
+		return nil, errors.New("login did not return an ACL token")
+	}
+	return resp.ACLToken, nil
+}
+
+// refresh should be called in a goroutine and logs in again before the ACL
+// token expires, until the hook is stopped. Update hooks are triggered with
+// each new token so templates are rendered using it.
+func (h *identityHook) refresh(token *structs.ACLToken) {
+	if token.ExpirationTime == nil {
+		return
+	}
+
+	timer, stop := helper.NewSafeTimer(refreshInterval(token))
+	defer stop()
+
+	for {
+		select {
+		case <-h.ctx.Done():
+			return
+		case <-timer.C:
+		}
+
+		newToken, err := h.login()
+		if err != nil {
+			h.logger.Error("failed to refresh ACL token", "error", err,
+				"expires", token.ExpirationTime)
+			timer.Reset(wiLoginRetryInterval)
+			continue
+		}
+
+		h.lock.Lock()
+		err = h.setACLToken(newToken)
+		h.lock.Unlock()
+		if err != nil {
+			h.logger.Error("failed to set refreshed ACL token", "error", err)
+		}
+		h.tr.triggerUpdateHooks()
+
+		token = newToken
+		if token.ExpirationTime == nil {
+			return
+		}
+		timer.Reset(refreshInterval(token))
+	}
+}
+
+// refreshInterval returns how long to wait before refreshing the ACL token,
+// which is between half and two thirds of its remaining TTL so tokens of
+// several tasks aren't all refreshed at once.
+func refreshInterval(token *structs.ACLToken) time.Duration {
+	remaining := time.Until(*token.ExpirationTime)
+	if remaining <= 0 {
+		return 0
+	}
+	return remaining/2 + helper.RandomStagger(remaining/6)
+}
+
 // writeToken writes the given token to disk
 func (h *identityHook) writeToken(token string) error {
 	// Write token as owner readable only
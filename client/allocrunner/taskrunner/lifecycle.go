@@ -2,6 +2,7 @@ package taskrunner
 
 import (
 	"context"
+	"fmt"
 
 	"github.com/hashicorp/nomad/nomad/structs"
 )
@@ -101,7 +102,7 @@ func (tr *TaskRunner) restartImpl(ctx context.Context, event *structs.TaskEvent,
 	}
 
 	// Run the pre-kill hooks prior to restarting the task
-	tr.preKill()
+	killTimeoutUsed := tr.preKill()
 
 	// Grab a handle to the wait channel that will timeout with context cancelation
 	// _before_ killing the task.
@@ -111,7 +112,7 @@ func (tr *TaskRunner) restartImpl(ctx context.Context, event *structs.TaskEvent,
 	}
 
 	// Kill the task using an exponential backoff in-case of failures.
-	if _, err := tr.killTask(handle, waitCh); err != nil {
+	if _, err := tr.killTask(handle, waitCh, killTimeoutUsed); err != nil {
 		// We couldn't successfully destroy the resource created.
 		tr.logger.Error("failed to kill task. Resources may have been leaked", "error", err)
 	}
@@ -141,6 +142,80 @@ func (tr *TaskRunner) Signal(event *structs.TaskEvent, s string) error {
 	return handle.Signal(s)
 }
 
+// Pause freezes the processes of a running task, keeping their memory and
+// state until the task is resumed. Returns an error if the task is not running
+// or if its driver cannot pause tasks.
+func (tr *TaskRunner) Pause(event *structs.TaskEvent) error {
+	tr.logger.Trace("Pause requested")
+
+	tr.pauseLock.Lock()
+	defer tr.pauseLock.Unlock()
+
+	handle := tr.getDriverHandle()
+	if handle == nil {
+		return ErrTaskNotRunning
+	}
+
+	switch tr.TaskState().State {
+	case structs.TaskStatePaused:
+		return nil
+	case structs.TaskStateRunning:
+	default:
+		return ErrTaskNotRunning
+	}
+
+	if tr.driverCapabilities == nil || !tr.driverCapabilities.PauseTask {
+		return fmt.Errorf("driver %q does not support pausing tasks", tr.Task().Driver)
+	}
+
+	if err := handle.Pause(); err != nil {
+		return err
+	}
+
+	// The task may have exited while it was being paused
+	if !tr.transitionState(structs.TaskStateRunning, structs.TaskStatePaused, event) {
+		return ErrTaskNotRunning
+	}
+	return nil
+}
+
+// Resume thaws the processes of a paused task. Resuming a running task is a
+// no-op.
+func (tr *TaskRunner) Resume(event *structs.TaskEvent) error {
+	tr.logger.Trace("Resume requested")
+
+	handle := tr.getDriverHandle()
+	if handle == nil {
+		return ErrTaskNotRunning
+	}
+
+	return tr.resume(handle, event)
+}
+
+// resume thaws the processes of the task if it is paused.
+func (tr *TaskRunner) resume(handle *DriverHandle, event *structs.TaskEvent) error {
+	tr.pauseLock.Lock()
+	defer tr.pauseLock.Unlock()
+
+	if !tr.IsPaused() {
+		return nil
+	}
+
+	if err := handle.Resume(); err != nil {
+		return err
+	}
+
+	tr.transitionState(structs.TaskStatePaused, structs.TaskStateRunning, event)
+	return nil
+}
+
+// IsPaused returns true if the processes of the task are frozen.
+func (tr *TaskRunner) IsPaused() bool {
+	tr.stateLock.RLock()
+	defer tr.stateLock.RUnlock()
+	return tr.state.State == structs.TaskStatePaused
+}
+
 // Kill a task. Blocks until task exits or context is canceled. State is set to
 // dead.
 func (tr *TaskRunner) Kill(ctx context.Context, event *structs.TaskEvent) error {
@@ -0,0 +1,101 @@
+package taskrunner
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	log "github.com/hashicorp/go-hclog"
+	"github.com/hashicorp/nomad/client/allocrunner/interfaces"
+	ti "github.com/hashicorp/nomad/client/allocrunner/taskrunner/interfaces"
+	"github.com/hashicorp/nomad/client/allocrunner/taskrunner/uploader"
+	"github.com/hashicorp/nomad/client/config"
+	"github.com/hashicorp/nomad/nomad/structs"
+)
+
+const (
+	// defaultOutputUploadTimeout bounds the time spent uploading the
+	// outputs of a task when the client has no output configuration.
+	defaultOutputUploadTimeout = 30 * time.Minute
+)
+
+// outputHook uploads the outputs of a task once it has finished.
+type outputHook struct {
+	eventEmitter ti.EventEmitter
+	config       *config.OutputConfig
+	logger       log.Logger
+}
+
+func newOutputHook(e ti.EventEmitter, config *config.OutputConfig, logger log.Logger) *outputHook {
+	h := &outputHook{
+		eventEmitter: e,
+		config:       config,
+	}
+	h.logger = logger.Named(h.Name())
+	return h
+}
+
+func (*outputHook) Name() string {
+	return "outputs"
+}
+
+func (h *outputHook) Postrun(ctx context.Context, req *interfaces.TaskPostrunRequest, _ *interfaces.TaskPostrunResponse) error {
+	successful := req.ExitResult != nil && req.ExitResult.Successful()
+
+	var outputs []*structs.TaskOutput
+	for _, output := range req.Task.Outputs {
+		if output.ShouldUpload(successful) {
+			outputs = append(outputs, output)
+		}
+	}
+	if len(outputs) == 0 {
+		return nil
+	}
+
+	timeout := defaultOutputUploadTimeout
+	uploaderConfig := &uploader.Config{}
+	if h.config != nil {
+		timeout = h.config.UploadTimeout
+		uploaderConfig.FileAllowlist = h.config.FileAllowlist
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	h.eventEmitter.EmitEvent(structs.NewTaskEvent(structs.TaskUploadingOutputs))
+
+	start := time.Now()
+	total := 0
+	for _, output := range outputs {
+		output = interpolateOutput(req, output)
+
+		n, err := uploader.Upload(ctx, h.logger, req.TaskDir, output, uploaderConfig)
+		total += n
+		if err != nil {
+			err = fmt.Errorf("failed to upload output %q: %v", output.Source, err)
+			h.logger.Error("failed to upload output", "source", output.Source, "error", err)
+			h.eventEmitter.EmitEvent(structs.NewTaskEvent(structs.TaskOutputUploadFailed).
+				SetDisplayMessage(err.Error()).
+				SetFailsTask())
+			return nil
+		}
+	}
+
+	h.eventEmitter.EmitEvent(structs.NewTaskEvent(structs.TaskOutputsUploaded).
+		SetDisplayMessage(fmt.Sprintf("Uploaded %d files in %s", total, time.Since(start).Round(time.Millisecond))))
+	return nil
+}
+
+// interpolateOutput returns a copy of the output with the task environment
+// interpolated into its destination, options and headers.
+func interpolateOutput(req *interfaces.TaskPostrunRequest, output *structs.TaskOutput) *structs.TaskOutput {
+	output = output.Copy()
+	output.Destination = req.TaskEnv.ReplaceEnv(output.Destination)
+	for k, v := range output.Options {
+		output.Options[k] = req.TaskEnv.ReplaceEnv(v)
+	}
+	for k, v := range output.Headers {
+		output.Headers[k] = req.TaskEnv.ReplaceEnv(v)
+	}
+	return output
+}
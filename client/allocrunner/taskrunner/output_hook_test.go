@@ -0,0 +1,112 @@
+package taskrunner
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/nomad/ci"
+	"github.com/hashicorp/nomad/client/allocdir"
+	"github.com/hashicorp/nomad/client/allocrunner/interfaces"
+	"github.com/hashicorp/nomad/client/config"
+	"github.com/hashicorp/nomad/client/taskenv"
+	"github.com/hashicorp/nomad/helper/testlog"
+	"github.com/hashicorp/nomad/nomad/structs"
+	"github.com/hashicorp/nomad/plugins/drivers"
+	"github.com/shoenig/test/must"
+)
+
+// Statically assert the output hook implements the expected interface
+var _ interfaces.TaskPostrunHook = (*outputHook)(nil)
+
+func TestTaskRunner_OutputHook(t *testing.T) {
+	ci.Parallel(t)
+
+	allocDir := t.TempDir()
+	taskDir := &allocdir.TaskDir{
+		AllocDir: allocDir,
+		Dir:      filepath.Join(allocDir, "web"),
+	}
+	must.NoError(t, os.MkdirAll(filepath.Join(taskDir.Dir, "local"), 0o755))
+	must.NoError(t, os.WriteFile(filepath.Join(taskDir.Dir, "local", "out.txt"), []byte("out"), 0o644))
+
+	dst := t.TempDir()
+	task := &structs.Task{
+		Name: "web",
+		Outputs: []*structs.TaskOutput{
+			{
+				Source:      "local/out.txt",
+				Destination: filepath.ToSlash(dst) + "/${NOMAD_TASK_NAME}/success.txt",
+				When:        structs.TaskOutputOnSuccess,
+			},
+			{
+				Source:      "local/out.txt",
+				Destination: filepath.ToSlash(dst) + "/${NOMAD_TASK_NAME}/failure.txt",
+				When:        structs.TaskOutputOnFailure,
+			},
+		},
+	}
+	env := taskenv.NewTaskEnv(map[string]string{"NOMAD_TASK_NAME": "web"}, nil, nil, nil, "", "")
+
+	me := &mockEmitter{}
+	hook := newOutputHook(me, &config.OutputConfig{
+		UploadTimeout: time.Minute,
+		FileAllowlist: []string{dst},
+	}, testlog.HCLogger(t))
+
+	req := &interfaces.TaskPostrunRequest{
+		Task:       task,
+		ExitResult: &drivers.ExitResult{ExitCode: 0},
+		TaskDir:    taskDir,
+		TaskEnv:    env,
+	}
+	must.NoError(t, hook.Postrun(context.Background(), req, &interfaces.TaskPostrunResponse{}))
+
+	must.Len(t, 2, me.events)
+	must.Eq(t, structs.TaskUploadingOutputs, me.events[0].Type)
+	must.Eq(t, structs.TaskOutputsUploaded, me.events[1].Type)
+	must.FileExistsFS(t, os.DirFS(dst), "web/success.txt")
+	must.FileNotExistsFS(t, os.DirFS(dst), "web/failure.txt")
+}
+
+func TestTaskRunner_OutputHook_Failed(t *testing.T) {
+	ci.Parallel(t)
+
+	allocDir := t.TempDir()
+	taskDir := &allocdir.TaskDir{
+		AllocDir: allocDir,
+		Dir:      filepath.Join(allocDir, "web"),
+	}
+	must.NoError(t, os.MkdirAll(filepath.Join(taskDir.Dir, "local"), 0o755))
+	must.NoError(t, os.WriteFile(filepath.Join(taskDir.Dir, "local", "out.txt"), []byte("out"), 0o644))
+
+	task := &structs.Task{
+		Name: "web",
+		Outputs: []*structs.TaskOutput{
+			{
+				Source:      "local/out.txt",
+				Destination: filepath.ToSlash(t.TempDir()) + "/out.txt",
+				When:        structs.TaskOutputAlways,
+			},
+		},
+	}
+
+	// the client allows no local path destinations by default
+	me := &mockEmitter{}
+	hook := newOutputHook(me, nil, testlog.HCLogger(t))
+
+	req := &interfaces.TaskPostrunRequest{
+		Task:       task,
+		ExitResult: &drivers.ExitResult{ExitCode: 1},
+		TaskDir:    taskDir,
+		TaskEnv:    taskenv.NewEmptyTaskEnv(),
+	}
+	must.NoError(t, hook.Postrun(context.Background(), req, &interfaces.TaskPostrunResponse{}))
+
+	must.Len(t, 2, me.events)
+	must.Eq(t, structs.TaskOutputUploadFailed, me.events[1].Type)
+	must.True(t, me.events[1].FailsTask)
+	must.StrContains(t, me.events[1].DisplayMessage, "not within an allowed directory")
+}
@@ -0,0 +1,161 @@
+package taskrunner
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"net/url"
+	"strconv"
+	"time"
+
+	hclog "github.com/hashicorp/go-hclog"
+	"github.com/hashicorp/nomad/client/allocrunner/interfaces"
+	"github.com/hashicorp/nomad/client/taskenv"
+	"github.com/hashicorp/nomad/nomad/structs"
+)
+
+var _ interfaces.TaskPreKillHook = (*prestopHook)(nil)
+
+// prestopHook runs the task's prestop action before the task is killed, to
+// give it a chance to stop gracefully. The time spent running the action is
+// deducted from the task's kill timeout.
+type prestopHook struct {
+	tr *TaskRunner
+
+	logger hclog.Logger
+}
+
+func newPrestopHook(tr *TaskRunner, logger hclog.Logger) *prestopHook {
+	h := &prestopHook{
+		tr: tr,
+	}
+	h.logger = logger.Named(h.Name())
+	return h
+}
+
+func (h *prestopHook) Name() string {
+	return "prestop"
+}
+
+func (h *prestopHook) PreKilling(ctx context.Context, _ *interfaces.TaskPreKillRequest, resp *interfaces.TaskPreKillResponse) error {
+	task := h.tr.Task()
+	if task.PreStop == nil {
+		return nil
+	}
+
+	// The action can only be run against a running task
+	handle := h.tr.getDriverHandle()
+	if handle == nil {
+		return nil
+	}
+
+	prestop := task.PreStop
+	taskEnv := h.tr.envBuilder.Build()
+
+	start := time.Now()
+	var err error
+	switch {
+	case prestop.Exec != nil:
+		err = h.runExec(handle, prestop, taskEnv)
+	case prestop.HTTP != nil:
+		err = h.runHTTP(ctx, prestop, taskEnv)
+	}
+	resp.KillTimeoutUsed = time.Since(start)
+
+	var event *structs.TaskEvent
+	if err != nil {
+		h.logger.Warn("prestop action failed", "error", err, "duration", resp.KillTimeoutUsed)
+		event = structs.NewTaskEvent(structs.TaskPreStopFailed).
+			SetDisplayMessage(fmt.Sprintf("PreStop failed after %s: %v", resp.KillTimeoutUsed.Round(time.Millisecond), err))
+	} else {
+		h.logger.Debug("prestop action succeeded", "duration", resp.KillTimeoutUsed)
+		event = structs.NewTaskEvent(structs.TaskPreStopSucceeded).
+			SetDisplayMessage(fmt.Sprintf("PreStop succeeded after %s", resp.KillTimeoutUsed.Round(time.Millisecond)))
+	}
+	h.tr.EmitEvent(event)
+
+	// A failed prestop action does not prevent the task from being killed,
+	// so it is reported through the task event instead of an error
+	return nil
+}
+
+// runExec runs the prestop command inside the task.
+func (h *prestopHook) runExec(handle *DriverHandle, prestop *structs.TaskPreStop, taskEnv *taskenv.TaskEnv) error {
+	cmd := taskEnv.ReplaceEnv(prestop.Exec.Command)
+	args := taskEnv.ParseAndReplace(prestop.Exec.Args)
+
+	_, code, err := handle.Exec(prestop.Timeout, cmd, args)
+	if err != nil {
+		return err
+	}
+	if code != 0 {
+		return fmt.Errorf("command exited with code %d", code)
+	}
+	return nil
+}
+
+// runHTTP sends the prestop request to the task and expects a 2xx response.
+func (h *prestopHook) runHTTP(ctx context.Context, prestop *structs.TaskPreStop, taskEnv *taskenv.TaskEnv) error {
+	addr, err := h.address(prestop.HTTP.Port)
+	if err != nil {
+		return err
+	}
+
+	u := url.URL{
+		Scheme: prestop.HTTP.Protocol,
+		Host:   addr,
+		Path:   taskEnv.ReplaceEnv(prestop.HTTP.Path),
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, prestop.Timeout)
+	defer cancel()
+
+	req, err := http.NewRequestWithContext(ctx, prestop.HTTP.Method, u.String(), nil)
+	if err != nil {
+		return err
+	}
+	for k, vs := range prestop.HTTP.Header {
+		for _, v := range vs {
+			req.Header.Add(k, taskEnv.ReplaceEnv(v))
+		}
+	}
+	if host := req.Header.Get("Host"); host != "" {
+		req.Host = host
+	}
+
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	_, _ = io.Copy(io.Discard, io.LimitReader(resp.Body, 4096))
+
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		return fmt.Errorf("unexpected response status %q", resp.Status)
+	}
+	return nil
+}
+
+// address returns the host address of the port with the given label, looking
+// in the ports of the allocation before those of the task's networks.
+func (h *prestopHook) address(label string) (string, error) {
+	alloc := h.tr.Alloc()
+	if alloc.AllocatedResources == nil {
+		return "", errors.New("allocation has no allocated resources")
+	}
+
+	if port, ok := alloc.AllocatedResources.Shared.Ports.Get(label); ok {
+		return net.JoinHostPort(port.HostIP, strconv.Itoa(port.Value)), nil
+	}
+
+	if taskRes, ok := alloc.AllocatedResources.Tasks[h.tr.Task().Name]; ok {
+		if port := taskRes.Networks.Port(label); port.Value != 0 {
+			return net.JoinHostPort(port.HostIP, strconv.Itoa(port.Value)), nil
+		}
+	}
+
+	return "", fmt.Errorf("port %q not found", label)
+}
@@ -0,0 +1,88 @@
+package taskrunner
+
+import (
+	"context"
+	"fmt"
+
+	hclog "github.com/hashicorp/go-hclog"
+	"github.com/hashicorp/nomad/client/allocrunner/interfaces"
+	"github.com/hashicorp/nomad/nomad/structs"
+)
+
+// resourcesHook applies the resources changed by in-place updates of the
+// allocation to the running task, if its driver supports it.
+type resourcesHook struct {
+	runner *TaskRunner
+	logger hclog.Logger
+
+	// resources are the resources the task is running with. They are only
+	// accessed by Update, which is never called concurrently.
+	resources *structs.AllocatedTaskResources
+}
+
+func newResourcesHook(runner *TaskRunner, logger hclog.Logger) *resourcesHook {
+	h := &resourcesHook{
+		runner:    runner,
+		resources: runner.TaskResources(),
+	}
+	h.logger = logger.Named(h.Name())
+	return h
+}
+
+func (*resourcesHook) Name() string {
+	return "resources"
+}
+
+func (h *resourcesHook) Update(ctx context.Context, _ *interfaces.TaskUpdateRequest, _ *interfaces.TaskUpdateResponse) error {
+	resources := h.runner.TaskResources()
+	if !resourcesChanged(h.resources, resources) {
+		return nil
+	}
+
+	// Tasks that are not running are started with the new resources
+	handle := h.runner.getDriverHandle()
+	if handle == nil {
+		h.resources = resources
+		return nil
+	}
+
+	caps := h.runner.driverCapabilities
+	if caps == nil || !caps.UpdateTaskResources {
+		h.resources = resources
+		h.runner.EmitEvent(structs.NewTaskEvent(structs.TaskResourcesUpdated).
+			SetMessage("Driver cannot update running tasks, resources apply when the task restarts"))
+		return nil
+	}
+
+	driverResources := h.runner.buildTaskResources()
+	if h.runner.cpusetCgroupPathGetter != nil {
+		cpusetCgroupPath, err := h.runner.cpusetCgroupPathGetter(ctx)
+		if err != nil {
+			return err
+		}
+		driverResources.LinuxResources.CpusetCgroupPath = cpusetCgroupPath
+	}
+
+	h.logger.Debug("updating task resources", "cpu", resources.Cpu.CpuShares,
+		"memory", resources.Memory.MemoryMB, "memory_max", resources.Memory.MemoryMaxMB)
+	if err := handle.UpdateResources(driverResources); err != nil {
+		return fmt.Errorf("failed to update task resources: %v", err)
+	}
+
+	h.resources = resources
+	h.runner.EmitEvent(structs.NewTaskEvent(structs.TaskResourcesUpdated).
+		SetMessage(fmt.Sprintf("Task updated to %d MHz CPU and %d MB memory",
+			resources.Cpu.CpuShares, resources.Memory.MemoryMB)))
+	return nil
+}
+
+// resourcesChanged returns true if the resources drivers can update on running
+// tasks differ between a and b.
+func resourcesChanged(a, b *structs.AllocatedTaskResources) bool {
+	if a == nil || b == nil {
+		return a != b
+	}
+	return a.Cpu.CpuShares != b.Cpu.CpuShares ||
+		a.Memory.MemoryMB != b.Memory.MemoryMB ||
+		a.Memory.MemoryMaxMB != b.Memory.MemoryMaxMB
+}
@@ -0,0 +1,7 @@
+package taskrunner
+
+import "github.com/hashicorp/nomad/client/allocrunner/interfaces"
+
+var _ interfaces.TaskUpdateHook = (*resourcesHook)(nil)
+
+// See task_runner_test.go:TestTaskRunner_UpdateResources
@@ -27,6 +27,7 @@ type scriptCheckHookConfig struct {
 	alloc        *structs.Allocation
 	task         *structs.Task
 	consul       serviceregistration.Handler
+	pauser       serviceregistration.WorkloadPauser
 	logger       log.Logger
 	shutdownWait time.Duration
 }
@@ -36,6 +37,7 @@ type scriptCheckHookConfig struct {
 type scriptCheckHook struct {
 	consul          serviceregistration.Handler
 	consulNamespace string
+	pauser          serviceregistration.WorkloadPauser // skips scripts while the task is paused
 	alloc           *structs.Allocation
 	task            *structs.Task
 	logger          log.Logger
@@ -61,6 +63,7 @@ type scriptCheckHook struct {
 func newScriptCheckHook(c scriptCheckHookConfig) *scriptCheckHook {
 	h := &scriptCheckHook{
 		consul:          c.consul,
+		pauser:          c.pauser,
 		consulNamespace: c.alloc.Job.LookupTaskGroup(c.alloc.TaskGroup).Consul.GetNamespace(),
 		alloc:           c.alloc,
 		task:            c.task,
@@ -192,6 +195,7 @@ func (h *scriptCheckHook) newScriptChecks() map[string]*scriptCheck {
 				serviceID:       serviceID,
 				ttlUpdater:      h.consul,
 				driverExec:      h.driverExec,
+				pauser:          h.pauser,
 				taskEnv:         h.taskEnv,
 				logger:          h.logger,
 				shutdownCh:      h.shutdownCh,
@@ -232,6 +236,7 @@ func (h *scriptCheckHook) newScriptChecks() map[string]*scriptCheck {
 				serviceID:       serviceID,
 				ttlUpdater:      h.consul,
 				driverExec:      h.driverExec,
+				pauser:          h.pauser,
 				taskEnv:         h.taskEnv,
 				logger:          h.logger,
 				shutdownCh:      h.shutdownCh,
@@ -285,6 +290,7 @@ type scriptCheckConfig struct {
 	check           *structs.ServiceCheck
 	ttlUpdater      TTLUpdater
 	driverExec      tinterfaces.ScriptExecutor
+	pauser          serviceregistration.WorkloadPauser
 	taskEnv         *taskenv.TaskEnv
 	logger          log.Logger
 	shutdownCh      chan struct{}
@@ -316,6 +322,7 @@ func newScriptCheck(config *scriptCheckConfig) *scriptCheck {
 	sc.Interval = config.check.Interval
 	sc.Timeout = config.check.Timeout
 	sc.exec = config.driverExec
+	sc.pauser = config.pauser
 	sc.callback = newScriptCheckCallback(sc)
 	sc.logger = config.logger
 	sc.shutdownCh = config.shutdownCh
@@ -12,10 +12,18 @@ import (
 	bstructs "github.com/hashicorp/nomad/plugins/base/structs"
 )
 
+// statsHistoryPersistInterval is the interval at which the stats hook
+// persists the task's resource usage history while the task is running.
+const statsHistoryPersistInterval = 1 * time.Minute
+
 // StatsUpdater is the interface required by the StatsHook to update stats.
 // Satisfied by TaskRunner.
 type StatsUpdater interface {
 	UpdateStats(*cstructs.TaskResourceUsage)
+
+	// PersistStatsHistory persists the resource usage history so it
+	// survives client restarts.
+	PersistStatsHistory()
 }
 
 // statsHook manages the task stats collection goroutine.
@@ -81,12 +89,18 @@ func (h *statsHook) Exited(context.Context, *interfaces.TaskExitedRequest, *inte
 	// Clear cancel func so we don't double call for any reason
 	h.cancel = nil
 
+	// Persist the history so it is available for inspecting the task after
+	// it has exited, even across client restarts
+	h.updater.PersistStatsHistory()
+
 	return nil
 }
 
 // collectResourceUsageStats starts collecting resource usage stats of a Task.
 // Collection ends when the passed channel is closed
 func (h *statsHook) collectResourceUsageStats(ctx context.Context, handle interfaces.DriverStats) {
+	persistTicker := time.NewTicker(statsHistoryPersistInterval)
+	defer persistTicker.Stop()
 
 MAIN:
 	ch, err := h.callStatsWithRetry(ctx, handle)
@@ -113,6 +127,9 @@ MAIN:
 			// Update stats on TaskRunner and emit them
 			h.updater.UpdateStats(ru)
 
+		case <-persistTicker.C:
+			h.updater.PersistStatsHistory()
+
 		case <-ctx.Done():
 			return
 		}
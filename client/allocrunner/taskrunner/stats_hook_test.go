@@ -37,6 +37,8 @@ func (m *mockStatsUpdater) UpdateStats(ru *cstructs.TaskResourceUsage) {
 	}
 }
 
+func (m *mockStatsUpdater) PersistStatsHistory() {}
+
 type mockDriverStats struct {
 	called uint32
 
@@ -2,6 +2,7 @@ package taskrunner
 
 import (
 	"context"
+	"fmt"
 	"strings"
 
 	log "github.com/hashicorp/go-hclog"
@@ -43,6 +44,12 @@ func (h *taskDirHook) Name() string {
 }
 
 func (h *taskDirHook) Prestart(ctx context.Context, req *interfaces.TaskPrestartRequest, resp *interfaces.TaskPrestartResponse) error {
+	// The user namespace must be set up again when the task is restored, as
+	// the driver is given its range every time the task starts
+	if err := h.setupUserNamespace(); err != nil {
+		return err
+	}
+
 	fsi := h.runner.driverCapabilities.FSIsolation
 	if v, ok := req.PreviousState[TaskDirHookIsDoneDataKey]; ok && v == "true" {
 		setEnvvars(h.runner.envBuilder, fsi, h.runner.taskDir, h.runner.clientConfig)
@@ -75,6 +82,32 @@ func (h *taskDirHook) Prestart(ctx context.Context, req *interfaces.TaskPrestart
 	return nil
 }
 
+// setupUserNamespace allocates the range of host IDs of the user namespace of
+// the allocation if the driver runs the task in one, and makes the task
+// directories owned by it.
+func (h *taskDirHook) setupUserNamespace() error {
+	pool := h.runner.driverCapabilities.UserNamespaceIDs
+	if pool == nil {
+		return nil
+	}
+	if h.runner.userNamespaces == nil {
+		return fmt.Errorf("driver %q runs tasks in user namespaces which are not supported by this client", h.runner.Task().Driver)
+	}
+
+	userns, err := h.runner.userNamespaces.Allocate(h.runner.allocID, pool)
+	if err != nil {
+		// Ranges are released as allocations are garbage collected
+		return structs.NewRecoverableError(err, true)
+	}
+
+	h.runner.hookResources.setUserNamespace(userns)
+	h.runner.taskDir.IDMap = &allocdir.IDMap{
+		UID: int(userns.UIDStart),
+		GID: int(userns.GIDStart),
+	}
+	return nil
+}
+
 // setEnvvars sets path and host env vars depending on the FS isolation used.
 func setEnvvars(envBuilder *taskenv.Builder, fsi drivers.FSIsolation, taskDir *allocdir.TaskDir, conf *cconfig.Config) {
 
@@ -24,11 +24,13 @@ import (
 	"github.com/hashicorp/nomad/client/devicemanager"
 	"github.com/hashicorp/nomad/client/dynamicplugins"
 	cinterfaces "github.com/hashicorp/nomad/client/interfaces"
+	"github.com/hashicorp/nomad/client/lib/idmap"
 	"github.com/hashicorp/nomad/client/pluginmanager/csimanager"
 	"github.com/hashicorp/nomad/client/pluginmanager/drivermanager"
 	"github.com/hashicorp/nomad/client/serviceregistration"
 	"github.com/hashicorp/nomad/client/serviceregistration/wrapper"
 	cstate "github.com/hashicorp/nomad/client/state"
+	"github.com/hashicorp/nomad/client/stats"
 	cstructs "github.com/hashicorp/nomad/client/structs"
 	"github.com/hashicorp/nomad/client/taskenv"
 	"github.com/hashicorp/nomad/client/vaultclient"
@@ -71,15 +73,17 @@ const (
 )
 
 type TaskRunner struct {
-	// allocID, taskName, taskLeader, and taskResources are immutable so these fields may
+	// allocID, taskName, and taskLeader are immutable so these fields may
 	// be accessed without locks
-	allocID       string
-	taskName      string
-	taskLeader    bool
-	taskResources *structs.AllocatedTaskResources
+	allocID    string
+	taskName   string
+	taskLeader bool
 
-	alloc     *structs.Allocation
-	allocLock sync.Mutex
+	// taskResources are changed by in-place updates of the allocation, and
+	// are guarded by allocLock like alloc
+	alloc         *structs.Allocation
+	taskResources *structs.AllocatedTaskResources
+	allocLock     sync.Mutex
 
 	clientConfig *config.Config
 
@@ -104,6 +108,9 @@ type TaskRunner struct {
 	// restartCh is used to signal that the task should restart.
 	restartCh chan struct{}
 
+	// pauseLock serializes pausing and resuming the task.
+	pauseLock sync.Mutex
+
 	// shutdownCtx is used to exit the TaskRunner *without* affecting task state.
 	shutdownCtx context.Context
 
@@ -214,6 +221,11 @@ type TaskRunner struct {
 	resourceUsage     *cstructs.TaskResourceUsage
 	resourceUsageLock sync.Mutex
 
+	// statsHistory is a bounded history of the resource usage samples
+	// written via UpdateStats. It is persisted in the state DB so that it
+	// survives client restarts.
+	statsHistory *stats.ResourceHistory
+
 	// deviceStatsReporter is used to lookup resource usage for alloc devices
 	deviceStatsReporter cinterfaces.DeviceStatsReporter
 
@@ -231,6 +243,10 @@ type TaskRunner struct {
 	// handlers
 	driverManager drivermanager.Manager
 
+	// userNamespaces allocates the host ID range of the user namespace of the
+	// allocation
+	userNamespaces *idmap.Allocator
+
 	// dynamicRegistry is where dynamic plugins should be registered.
 	dynamicRegistry dynamicplugins.Registry
 
@@ -261,6 +277,9 @@ type TaskRunner struct {
 
 	// getter is an interface for retrieving artifacts.
 	getter cinterfaces.ArtifactGetter
+
+	// rpcClient is used to make RPCs to the servers
+	rpcClient config.RPCHandler
 }
 
 type Config struct {
@@ -309,6 +328,10 @@ type Config struct {
 	// handlers
 	DriverManager drivermanager.Manager
 
+	// UserNamespaces allocates the host ID range of the user namespace of the
+	// allocation, if the driver runs tasks in user namespaces
+	UserNamespaces *idmap.Allocator
+
 	// ServersContactedCh is closed when the first GetClientAllocs call to
 	// servers succeeds and allocs are synced.
 	ServersContactedCh chan struct{}
@@ -329,6 +352,10 @@ type Config struct {
 
 	// Getter is an interface for retrieving artifacts.
 	Getter cinterfaces.ArtifactGetter
+
+	// RPCClient is the RPC client used by hooks to communicate with the
+	// servers.
+	RPCClient config.RPCHandler
 }
 
 func NewTaskRunner(config *Config) (*TaskRunner, error) {
@@ -382,13 +409,16 @@ func NewTaskRunner(config *Config) (*TaskRunner, error) {
 		cpusetCgroupPathGetter: config.CpusetCgroupPathGetter,
 		devicemanager:          config.DeviceManager,
 		driverManager:          config.DriverManager,
+		userNamespaces:         config.UserNamespaces,
 		maxEvents:              defaultMaxEvents,
+		statsHistory:           stats.NewResourceHistory(config.ClientConfig.StatsHistorySize),
 		serversContactedCh:     config.ServersContactedCh,
 		startConditionMetCh:    config.StartConditionMetCh,
 		shutdownDelayCtx:       config.ShutdownDelayCtx,
 		shutdownDelayCancelFn:  config.ShutdownDelayCancelFn,
 		serviceRegWrapper:      config.ServiceRegWrapper,
 		getter:                 config.Getter,
+		rpcClient:              config.RPCClient,
 	}
 
 	// Create the logger based on the allocation ID
@@ -688,6 +718,14 @@ MAIN:
 		}
 	}
 
+	// Run the postrun hooks unless this is a restored task that was already
+	// dead, in which case they ran before the client restarted.
+	if !dead {
+		if err := tr.postrun(result); err != nil {
+			tr.logger.Error("postrun hooks failed", "error", err)
+		}
+	}
+
 	// Mark the task as dead
 	tr.UpdateState(structs.TaskStateDead, nil)
 
@@ -894,8 +932,13 @@ func (tr *TaskRunner) runDriver() error {
 	if tr.getDriverHandle() != nil {
 		// Ensure running state is persisted but do *not* append a new
 		// task event as restoring is a client event and not relevant
-		// to a task's lifecycle.
-		if err := tr.updateStateImpl(structs.TaskStateRunning); err != nil {
+		// to a task's lifecycle. Tasks paused before the client restarted
+		// are still frozen and stay paused.
+		state := structs.TaskStateRunning
+		if tr.IsPaused() {
+			state = structs.TaskStatePaused
+		}
+		if err := tr.updateStateImpl(state); err != nil {
 			//TODO return error and destroy task to avoid an orphaned task?
 			tr.logger.Warn("error persisting task state", "error", err)
 		}
@@ -979,7 +1022,7 @@ func (tr *TaskRunner) initDriver() error {
 // runner killErr value.
 func (tr *TaskRunner) handleKill(resultCh <-chan *drivers.ExitResult) *drivers.ExitResult {
 	// Run the pre killing hooks
-	tr.preKill()
+	killTimeoutUsed := tr.preKill()
 
 	// Wait for task ShutdownDelay after running prekill hooks
 	// This allows for things like service de-registration to run
@@ -1023,7 +1066,7 @@ func (tr *TaskRunner) handleKill(resultCh <-chan *drivers.ExitResult) *drivers.E
 	}
 
 	// Kill the task using an exponential backoff in-case of failures.
-	result, killErr := tr.killTask(handle, resultCh)
+	result, killErr := tr.killTask(handle, resultCh, killTimeoutUsed)
 	if killErr != nil {
 		// We couldn't successfully destroy the resource created.
 		tr.logger.Error("failed to kill task. Resources may have been leaked", "error", killErr)
@@ -1062,11 +1105,18 @@ func (tr *TaskRunner) handleKill(resultCh <-chan *drivers.ExitResult) *drivers.E
 // killTask kills the task handle. In the case that killing fails,
 // killTask will retry with an exponential backoff and will give up at a
 // given limit. Returns an error if the task could not be killed.
-func (tr *TaskRunner) killTask(handle *DriverHandle, resultCh <-chan *drivers.ExitResult) (*drivers.ExitResult, error) {
+// killTimeoutUsed is deducted from the task's kill timeout.
+func (tr *TaskRunner) killTask(handle *DriverHandle, resultCh <-chan *drivers.ExitResult, killTimeoutUsed time.Duration) (*drivers.ExitResult, error) {
+	// The frozen processes of a paused task cannot handle the kill signal,
+	// so resume the task first.
+	if err := tr.resume(handle, nil); err != nil {
+		tr.logger.Warn("failed to resume paused task before killing it", "error", err)
+	}
+
 	// Cap the number of times we attempt to kill the task.
 	var err error
 	for i := 0; i < killFailureLimit; i++ {
-		if err = handle.Kill(); err != nil {
+		if err = handle.Kill(killTimeoutUsed); err != nil {
 			if err == drivers.ErrTaskNotFound {
 				tr.logger.Warn("couldn't find task to kill", "task_id", handle.ID())
 				return nil, nil
@@ -1106,8 +1156,6 @@ func (tr *TaskRunner) buildTaskConfig() *drivers.TaskConfig {
 	task := tr.Task()
 	alloc := tr.Alloc()
 	invocationid := uuid.Generate()[:8]
-	taskResources := tr.taskResources
-	ports := tr.Alloc().AllocatedResources.Shared.Ports
 	env := tr.envBuilder.Build()
 	tr.networkIsolationLock.Lock()
 	defer tr.networkIsolationLock.Unlock()
@@ -1125,35 +1173,16 @@ func (tr *TaskRunner) buildTaskConfig() *drivers.TaskConfig {
 		}
 	}
 
-	memoryLimit := taskResources.Memory.MemoryMB
-	if max := taskResources.Memory.MemoryMaxMB; max > memoryLimit {
-		memoryLimit = max
-	}
-
-	cpusetCpus := make([]string, len(taskResources.Cpu.ReservedCores))
-	for i, v := range taskResources.Cpu.ReservedCores {
-		cpusetCpus[i] = fmt.Sprintf("%d", v)
-	}
-
 	return &drivers.TaskConfig{
-		ID:            fmt.Sprintf("%s/%s/%s", alloc.ID, task.Name, invocationid),
-		Name:          task.Name,
-		JobName:       alloc.Job.Name,
-		JobID:         alloc.Job.ID,
-		TaskGroupName: alloc.TaskGroup,
-		Namespace:     alloc.Namespace,
-		NodeName:      alloc.NodeName,
-		NodeID:        alloc.NodeID,
-		Resources: &drivers.Resources{
-			NomadResources: taskResources,
-			LinuxResources: &drivers.LinuxResources{
-				MemoryLimitBytes: memoryLimit * 1024 * 1024,
-				CPUShares:        taskResources.Cpu.CpuShares,
-				CpusetCpus:       strings.Join(cpusetCpus, ","),
-				PercentTicks:     float64(taskResources.Cpu.CpuShares) / float64(tr.clientConfig.Node.NodeResources.Cpu.CpuShares),
-			},
-			Ports: &ports,
-		},
+		ID:               fmt.Sprintf("%s/%s/%s", alloc.ID, task.Name, invocationid),
+		Name:             task.Name,
+		JobName:          alloc.Job.Name,
+		JobID:            alloc.Job.ID,
+		TaskGroupName:    alloc.TaskGroup,
+		Namespace:        alloc.Namespace,
+		NodeName:         alloc.NodeName,
+		NodeID:           alloc.NodeID,
+		Resources:        tr.buildTaskResources(),
 		Devices:          tr.hookResources.getDevices(),
 		Mounts:           tr.hookResources.getMounts(),
 		Env:              env.Map(),
@@ -1165,6 +1194,35 @@ func (tr *TaskRunner) buildTaskConfig() *drivers.TaskConfig {
 		AllocID:          tr.allocID,
 		NetworkIsolation: tr.networkIsolationSpec,
 		DNS:              dns,
+		UserNamespace:    tr.hookResources.getUserNamespace(),
+	}
+}
+
+// buildTaskResources returns the resources drivers enforce on the task, from
+// the current resources of the task.
+func (tr *TaskRunner) buildTaskResources() *drivers.Resources {
+	taskResources := tr.TaskResources()
+	ports := tr.Alloc().AllocatedResources.Shared.Ports
+
+	memoryLimit := taskResources.Memory.MemoryMB
+	if max := taskResources.Memory.MemoryMaxMB; max > memoryLimit {
+		memoryLimit = max
+	}
+
+	cpusetCpus := make([]string, len(taskResources.Cpu.ReservedCores))
+	for i, v := range taskResources.Cpu.ReservedCores {
+		cpusetCpus[i] = fmt.Sprintf("%d", v)
+	}
+
+	return &drivers.Resources{
+		NomadResources: taskResources,
+		LinuxResources: &drivers.LinuxResources{
+			MemoryLimitBytes: memoryLimit * 1024 * 1024,
+			CPUShares:        taskResources.Cpu.CpuShares,
+			CpusetCpus:       strings.Join(cpusetCpus, ","),
+			PercentTicks:     float64(taskResources.Cpu.CpuShares) / float64(tr.clientConfig.Node.NodeResources.Cpu.CpuShares),
+		},
+		Ports: &ports,
 	}
 }
 
@@ -1186,6 +1244,14 @@ func (tr *TaskRunner) Restore() error {
 		tr.state = ts
 	}
 
+	// The stats history is a debugging aid, so failing to restore it should
+	// not prevent the task from being restored
+	if samples, err := tr.stateDB.GetTaskStatsHistory(tr.allocID, tr.taskName); err != nil {
+		tr.logger.Warn("failed to restore stats history", "error", err)
+	} else {
+		tr.statsHistory.Restore(samples)
+	}
+
 	// If a TaskHandle was persisted, ensure it is valid or destroy it.
 	if taskHandle := tr.localState.TaskHandle; taskHandle != nil {
 		//TODO if RecoverTask returned the DriverNetwork we wouldn't
@@ -1225,7 +1291,7 @@ func (tr *TaskRunner) restoreHandle(taskHandle *drivers.TaskHandle, net *drivers
 	}
 
 	if err := tr.driver.RecoverTask(taskHandle); err != nil {
-		if tr.TaskState().State != structs.TaskStateRunning {
+		if state := tr.TaskState().State; state != structs.TaskStateRunning && state != structs.TaskStatePaused {
 			// RecoverTask should fail if the Task wasn't running
 			return true
 		}
@@ -1259,6 +1325,25 @@ func (tr *TaskRunner) UpdateState(state string, event *structs.TaskEvent) {
 	tr.stateLock.Lock()
 	defer tr.stateLock.Unlock()
 
+	tr.updateStateLocked(state, event)
+}
+
+// transitionState sets the task state like UpdateState, but only if the task
+// is in the from state. Returns true if the state was updated.
+func (tr *TaskRunner) transitionState(from, to string, event *structs.TaskEvent) bool {
+	tr.stateLock.Lock()
+	defer tr.stateLock.Unlock()
+
+	if tr.state.State != from {
+		return false
+	}
+
+	tr.updateStateLocked(to, event)
+	return true
+}
+
+// updateStateLocked implements UpdateState. Caller must hold stateLock.
+func (tr *TaskRunner) updateStateLocked(state string, event *structs.TaskEvent) {
 	tr.logger.Trace("setting task state", "state", state)
 
 	if event != nil {
@@ -1294,8 +1379,9 @@ func (tr *TaskRunner) updateStateImpl(state string) error {
 	// Handle the state transition.
 	switch state {
 	case structs.TaskStateRunning:
-		// Capture the start time if it is just starting
-		if oldState != structs.TaskStateRunning {
+		// Capture the start time if it is just starting, resumed tasks keep
+		// their start time
+		if oldState != structs.TaskStateRunning && oldState != structs.TaskStatePaused {
 			taskState.StartedAt = time.Now().UTC()
 			metrics.IncrCounterWithLabels([]string{"client", "allocs", "running"}, 1, tr.baseLabels)
 		}
@@ -1449,6 +1535,7 @@ func (tr *TaskRunner) Shutdown() {
 
 	// Persist once more
 	tr.persistLocalState()
+	tr.PersistStatsHistory()
 }
 
 // LatestResourceUsage returns the last resource utilization datapoint
@@ -1461,22 +1548,43 @@ func (tr *TaskRunner) LatestResourceUsage() *cstructs.TaskResourceUsage {
 
 	// Look up device statistics lazily when fetched, as currently we do not emit any stats for them yet
 	if ru != nil && tr.deviceStatsReporter != nil {
-		deviceResources := tr.taskResources.Devices
+		deviceResources := tr.TaskResources().Devices
 		ru.ResourceUsage.DeviceStats = tr.deviceStatsReporter.LatestDeviceResourceStats(deviceResources)
 	}
 	return ru
 }
 
-// UpdateStats updates and emits the latest stats from the driver.
+// StatsHistory returns the resource usage samples collected after since (in
+// UnixNano), ordered oldest first.
+func (tr *TaskRunner) StatsHistory(since int64) []*stats.ResourceUsageSample {
+	return tr.statsHistory.Samples(since)
+}
+
+// UpdateStats updates, records and emits the latest stats from the driver.
 func (tr *TaskRunner) UpdateStats(ru *cstructs.TaskResourceUsage) {
 	tr.resourceUsageLock.Lock()
 	tr.resourceUsage = ru
 	tr.resourceUsageLock.Unlock()
 	if ru != nil {
+		tr.statsHistory.Add(ru.Sample())
 		tr.emitStats(ru)
 	}
 }
 
+// PersistStatsHistory persists the resource usage history to disk
+// synchronously. Errors are logged as the history is not critical to running
+// the task.
+func (tr *TaskRunner) PersistStatsHistory() {
+	if tr.statsHistory.Capacity() == 0 {
+		return
+	}
+
+	samples := tr.statsHistory.Samples(0)
+	if err := tr.stateDB.PutTaskStatsHistory(tr.allocID, tr.taskName, samples); err != nil {
+		tr.logger.Warn("failed to persist stats history", "error", err)
+	}
+}
+
 // TODO Remove Backwardscompat or use tr.Alloc()?
 func (tr *TaskRunner) setGaugeForMemory(ru *cstructs.TaskResourceUsage) {
 	alloc := tr.Alloc()
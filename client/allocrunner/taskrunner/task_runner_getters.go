@@ -21,6 +21,20 @@ func (tr *TaskRunner) setAlloc(updated *structs.Allocation, task *structs.Task)
 
 	tr.alloc = updated
 	tr.task = task
+
+	// In-place updates may change the resources of the task
+	if updated.AllocatedResources != nil {
+		if tres, ok := updated.AllocatedResources.Tasks[tr.taskName]; ok {
+			tr.taskResources = tres
+		}
+	}
+}
+
+// TaskResources returns the resources allocated to the task.
+func (tr *TaskRunner) TaskResources() *structs.AllocatedTaskResources {
+	tr.allocLock.Lock()
+	defer tr.allocLock.Unlock()
+	return tr.taskResources
 }
 
 // IsLeader returns true if this task is the leader of its task group.
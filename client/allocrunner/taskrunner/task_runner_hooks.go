@@ -16,8 +16,9 @@ import (
 
 // hookResources captures the resources for the task provided by hooks.
 type hookResources struct {
-	Devices []*drivers.DeviceConfig
-	Mounts  []*drivers.MountConfig
+	Devices       []*drivers.DeviceConfig
+	Mounts        []*drivers.MountConfig
+	UserNamespace *drivers.IDMapping
 	sync.RWMutex
 }
 
@@ -45,6 +46,18 @@ func (h *hookResources) getMounts() []*drivers.MountConfig {
 	return h.Mounts
 }
 
+func (h *hookResources) setUserNamespace(m *drivers.IDMapping) {
+	h.Lock()
+	h.UserNamespace = m
+	h.Unlock()
+}
+
+func (h *hookResources) getUserNamespace() *drivers.IDMapping {
+	h.RLock()
+	defer h.RUnlock()
+	return h.UserNamespace
+}
+
 // initHooks initializes the tasks hooks.
 func (tr *TaskRunner) initHooks() {
 	hookLogger := tr.logger.Named("task_hook")
@@ -66,8 +79,10 @@ func (tr *TaskRunner) initHooks() {
 		newDispatchHook(alloc, hookLogger),
 		newVolumeHook(tr, hookLogger),
 		newArtifactHook(tr, tr.getter, hookLogger),
+		newOutputHook(tr, tr.clientConfig.Output, hookLogger),
 		newStatsHook(tr, tr.clientConfig.StatsCollectionInterval, hookLogger),
 		newDeviceHook(tr.devicemanager, hookLogger),
+		newResourcesHook(tr, hookLogger),
 		newAPIHook(tr.shutdownCtx, tr.clientConfig.APIListenerRegistrar, hookLogger),
 	}
 
@@ -130,6 +145,11 @@ func (tr *TaskRunner) initHooks() {
 		logger:            hookLogger,
 	}))
 
+	// Always add the prestop hook, after the service hook so the task is
+	// deregistered before its prestop action runs. A task with no prestop
+	// block may be updated to include one.
+	tr.runnerHooks = append(tr.runnerHooks, newPrestopHook(tr, hookLogger))
+
 	// If this is a Connect sidecar proxy (or a Connect Native) service,
 	// add the sidsHook for requesting a Service Identity token (if ACLs).
 	if task.UsesConnect() {
@@ -164,6 +184,7 @@ func (tr *TaskRunner) initHooks() {
 		alloc:  tr.Alloc(),
 		task:   tr.Task(),
 		consul: tr.consulServiceClient,
+		pauser: tr,
 		logger: hookLogger,
 	}))
 
@@ -225,7 +246,7 @@ func (tr *TaskRunner) prestart() error {
 			Task:          tr.Task(),
 			TaskDir:       tr.taskDir,
 			TaskEnv:       tr.envBuilder.Build(),
-			TaskResources: tr.taskResources,
+			TaskResources: tr.TaskResources(),
 		}
 
 		origHookState := tr.hookState(name)
@@ -407,6 +428,55 @@ func (tr *TaskRunner) exited() error {
 
 }
 
+// postrun is used to run the postrun hooks once the task has exited for the
+// last time.
+func (tr *TaskRunner) postrun(result *drivers.ExitResult) error {
+	if tr.logger.IsTrace() {
+		start := time.Now()
+		tr.logger.Trace("running postrun hooks", "start", start)
+		defer func() {
+			end := time.Now()
+			tr.logger.Trace("finished postrun hooks", "end", end, "duration", end.Sub(start))
+		}()
+	}
+
+	var merr multierror.Error
+	for _, hook := range tr.runnerHooks {
+		post, ok := hook.(interfaces.TaskPostrunHook)
+		if !ok {
+			continue
+		}
+
+		name := post.Name()
+		var start time.Time
+		if tr.logger.IsTrace() {
+			start = time.Now()
+			tr.logger.Trace("running postrun hook", "name", name, "start", start)
+		}
+
+		req := interfaces.TaskPostrunRequest{
+			Task:       tr.Task(),
+			ExitResult: result,
+			TaskDir:    tr.taskDir,
+			TaskEnv:    tr.envBuilder.Build(),
+		}
+		var resp interfaces.TaskPostrunResponse
+		if err := post.Postrun(tr.shutdownCtx, &req, &resp); err != nil {
+			tr.emitHookError(err, name)
+			merr.Errors = append(merr.Errors, fmt.Errorf("postrun hook %q failed: %v", name, err))
+		}
+
+		// No need to persist as TaskPostrunResponse is currently empty
+
+		if tr.logger.IsTrace() {
+			end := time.Now()
+			tr.logger.Trace("finished postrun hook", "name", name, "end", end, "duration", end.Sub(start))
+		}
+	}
+
+	return merr.ErrorOrNil()
+}
+
 // stop is used to run the stop hooks.
 func (tr *TaskRunner) stop() error {
 	if tr.logger.IsTrace() {
@@ -518,8 +588,9 @@ func (tr *TaskRunner) updateHooks() {
 
 // preKill is used to run the runners preKill hooks
 // preKill hooks contain logic that must be executed before
-// a task is killed or restarted
-func (tr *TaskRunner) preKill() {
+// a task is killed or restarted. It returns how much of the task's kill
+// timeout was used by the hooks.
+func (tr *TaskRunner) preKill() time.Duration {
 	if tr.logger.IsTrace() {
 		start := time.Now()
 		tr.logger.Trace("running pre kill hooks", "start", start)
@@ -529,6 +600,7 @@ func (tr *TaskRunner) preKill() {
 		}()
 	}
 
+	var killTimeoutUsed time.Duration
 	for _, hook := range tr.runnerHooks {
 		killHook, ok := hook.(interfaces.TaskPreKillHook)
 		if !ok {
@@ -551,14 +623,17 @@ func (tr *TaskRunner) preKill() {
 			tr.emitHookError(err, name)
 			tr.logger.Error("prekill hook failed", "name", name, "error", err)
 		}
+		killTimeoutUsed += resp.KillTimeoutUsed
 
-		// No need to persist as TaskKillResponse is currently empty
+		// No need to persist as TaskKillResponse is only used to kill the task
 
 		if tr.logger.IsTrace() {
 			end := time.Now()
 			tr.logger.Trace("finished prekill hook", "name", name, "end", end, "duration", end.Sub(start))
 		}
 	}
+
+	return killTimeoutUsed
 }
 
 // shutdownHooks is called when the TaskRunner is gracefully shutdown but the
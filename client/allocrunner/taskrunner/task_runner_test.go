@@ -4,11 +4,13 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"net"
 	"net/http"
 	"net/http/httptest"
 	"os"
 	"path/filepath"
 	"strings"
+	"sync"
 	"testing"
 	"time"
 
@@ -21,6 +23,7 @@ import (
 	consulapi "github.com/hashicorp/nomad/client/consul"
 	"github.com/hashicorp/nomad/client/devicemanager"
 	"github.com/hashicorp/nomad/client/lib/cgutil"
+	"github.com/hashicorp/nomad/client/lib/idmap"
 	"github.com/hashicorp/nomad/client/pluginmanager/drivermanager"
 	regMock "github.com/hashicorp/nomad/client/serviceregistration/mock"
 	"github.com/hashicorp/nomad/client/serviceregistration/wrapper"
@@ -147,6 +150,7 @@ func testTaskRunnerConfig(t *testing.T, alloc *structs.Allocation, taskName stri
 		ShutdownDelayCancelFn: shutdownDelayCancelFn,
 		ServiceRegWrapper:     wrapperMock,
 		Getter:                getter.TestSandbox(t),
+		UserNamespaces:        idmap.NewAllocator(cstate.NoopDB{}),
 	}
 
 	// Set the cgroup path getter if we are in v2 mode
@@ -2593,3 +2597,318 @@ func TestTaskRunner_IdentityHook_Disabled(t *testing.T) {
 	taskEnv := tr.envBuilder.Build()
 	must.MapNotContainsKey(t, taskEnv.EnvMap, "NOMAD_TOKEN")
 }
+
+// mockLoginRPC is an RPC client which logs workload identities in with
+// short-lived ACL tokens.
+type mockLoginRPC struct {
+	l      sync.Mutex
+	ttl    time.Duration
+	logins []*structs.ACLLoginRequest
+}
+
+func (m *mockLoginRPC) RPC(method string, args interface{}, reply interface{}) error {
+	if method != structs.ACLLoginRPCMethod {
+		return fmt.Errorf("unexpected RPC %q", method)
+	}
+
+	m.l.Lock()
+	defer m.l.Unlock()
+	m.logins = append(m.logins, args.(*structs.ACLLoginRequest))
+
+	reply.(*structs.ACLLoginResponse).ACLToken = &structs.ACLToken{
+		SecretID:       fmt.Sprintf("token-%d", len(m.logins)),
+		ExpirationTime: pointer.Of(time.Now().Add(m.ttl)),
+	}
+	return nil
+}
+
+// TestTaskRunner_IdentityHook_AuthMethod asserts that the identity hook
+// exchanges the workload identity for an ACL token and refreshes it before
+// it expires.
+func TestTaskRunner_IdentityHook_AuthMethod(t *testing.T) {
+	ci.Parallel(t)
+
+	alloc := mock.BatchAlloc()
+	task := alloc.Job.TaskGroups[0].Tasks[0]
+	task.Config = map[string]interface{}{
+		"run_for": "10s",
+	}
+
+	alloc.SignedIdentities = map[string]string{
+		task.Name: "foo",
+	}
+	task.Identity = &structs.WorkloadIdentity{
+		Env:        true,
+		File:       true,
+		AuthMethod: "nomad-workloads",
+	}
+
+	conf, cleanup := testTaskRunnerConfig(t, alloc, task.Name)
+	defer cleanup()
+
+	rpc := &mockLoginRPC{ttl: 900 * time.Millisecond}
+	conf.RPCClient = rpc
+
+	tr, err := NewTaskRunner(conf)
+	must.NoError(t, err)
+	go tr.Run()
+	defer tr.Kill(context.Background(), structs.NewTaskEvent("cleanup"))
+
+	testWaitForTaskToStart(t, tr)
+
+	// The identity is exchanged for an ACL token using the auth method
+	rpc.l.Lock()
+	must.Eq(t, "nomad-workloads", rpc.logins[0].AuthMethodName)
+	must.Eq(t, "foo", rpc.logins[0].LoginToken)
+	rpc.l.Unlock()
+
+	taskEnv := tr.envBuilder.Build()
+	must.Eq(t, "token-1", taskEnv.EnvMap["NOMAD_TOKEN"])
+
+	// The token is refreshed before it expires
+	tokenPath := filepath.Join(tr.taskDir.SecretsDir, "nomad_token")
+	testutil.WaitForResult(func() (bool, error) {
+		tokenBytes, err := os.ReadFile(tokenPath)
+		if err != nil {
+			return false, err
+		}
+		if string(tokenBytes) == "token-1" {
+			return false, errors.New("token was not refreshed")
+		}
+		return true, nil
+	}, func(err error) {
+		must.NoError(t, err)
+	})
+	must.NotEq(t, "token-1", tr.getNomadToken())
+}
+
+// TestTaskRunner_UpdateResources asserts that in-place updates of the
+// resources of a task are applied to the running task.
+func TestTaskRunner_UpdateResources(t *testing.T) {
+	ci.Parallel(t)
+
+	alloc := mock.Alloc()
+	task := alloc.Job.TaskGroups[0].Tasks[0]
+	task.Driver = "mock_driver"
+	task.Config = map[string]interface{}{
+		"run_for": "10s",
+	}
+
+	tr, _, cleanup := runTestTaskRunner(t, alloc, task.Name)
+	defer cleanup()
+	testWaitForTaskToStart(t, tr)
+
+	resourcesUpdated := func() []*structs.TaskEvent {
+		var events []*structs.TaskEvent
+		for _, ev := range tr.TaskState().Events {
+			if ev.Type == structs.TaskResourcesUpdated {
+				events = append(events, ev)
+			}
+		}
+		return events
+	}
+
+	// Updates which do not change the resources are ignored
+	update := alloc.Copy()
+	update.AllocModifyIndex++
+	tr.Update(update)
+
+	// Changed resources are applied to the running task
+	update = update.Copy()
+	update.AllocModifyIndex++
+	update.AllocatedResources.Tasks[task.Name].Memory.MemoryMB = 512
+	tr.Update(update)
+
+	testutil.WaitForResult(func() (bool, error) {
+		events := resourcesUpdated()
+		if len(events) != 1 {
+			return false, fmt.Errorf("expected 1 resources updated event, got %d", len(events))
+		}
+		return true, nil
+	}, func(err error) {
+		must.NoError(t, err)
+	})
+	must.StrContains(t, resourcesUpdated()[0].DisplayMessage, "512 MB memory")
+	must.Eq(t, 512, tr.buildTaskResources().NomadResources.Memory.MemoryMB)
+	must.Eq(t, structs.TaskStateRunning, tr.TaskState().State)
+}
+
+// TestTaskRunner_PauseResume asserts that paused tasks keep the paused state
+// across client restarts and are resumed before being killed.
+func TestTaskRunner_PauseResume(t *testing.T) {
+	ci.Parallel(t)
+
+	alloc := mock.BatchAlloc()
+	task := alloc.Job.TaskGroups[0].Tasks[0]
+	task.Driver = "mock_driver"
+	task.Config = map[string]interface{}{
+		"run_for": "10s",
+	}
+	conf, cleanup := testTaskRunnerConfig(t, alloc, task.Name)
+	conf.StateDB = cstate.NewMemDB(conf.Logger) // "persist" state between task runners
+	defer cleanup()
+
+	origTR, err := NewTaskRunner(conf)
+	must.NoError(t, err)
+	go origTR.Run()
+	defer origTR.Kill(context.Background(), structs.NewTaskEvent("cleanup"))
+	testWaitForTaskToStart(t, origTR)
+
+	// Pausing is idempotent and keeps the start time of the task
+	startedAt := origTR.TaskState().StartedAt
+	must.NoError(t, origTR.Pause(structs.NewTaskEvent(structs.TaskPaused)))
+	must.NoError(t, origTR.Pause(structs.NewTaskEvent(structs.TaskPaused)))
+	must.True(t, origTR.IsPaused())
+	must.Eq(t, structs.TaskStatePaused, origTR.TaskState().State)
+
+	// Cause TR to exit without shutting down task
+	origTR.Shutdown()
+
+	// The restored task is still paused
+	newTR, err := NewTaskRunner(conf)
+	must.NoError(t, err)
+	must.NoError(t, newTR.Restore())
+	go newTR.Run()
+	defer newTR.Kill(context.Background(), structs.NewTaskEvent("cleanup"))
+
+	testutil.WaitForResult(func() (bool, error) {
+		if newTR.getDriverHandle() == nil {
+			return false, fmt.Errorf("task not restored")
+		}
+		return true, nil
+	}, func(err error) {
+		must.NoError(t, err)
+	})
+	must.Eq(t, structs.TaskStatePaused, newTR.TaskState().State)
+
+	must.NoError(t, newTR.Resume(structs.NewTaskEvent(structs.TaskResumed)))
+	state := newTR.TaskState()
+	must.Eq(t, structs.TaskStateRunning, state.State)
+	must.Eq(t, startedAt, state.StartedAt)
+
+	// Paused tasks are resumed when they are killed
+	must.NoError(t, newTR.Pause(structs.NewTaskEvent(structs.TaskPaused)))
+	must.NoError(t, newTR.Kill(context.Background(), structs.NewTaskEvent(structs.TaskKilling)))
+	must.Eq(t, structs.TaskStateDead, newTR.TaskState().State)
+
+	var paused, resumed int
+	for _, ev := range newTR.TaskState().Events {
+		switch ev.Type {
+		case structs.TaskPaused:
+			paused++
+		case structs.TaskResumed:
+			resumed++
+		}
+	}
+	must.Eq(t, 2, paused)
+	must.Eq(t, 1, resumed)
+}
+
+// TestTaskRunner_PreStop_Exec asserts that the prestop command is run inside
+// the task before it is killed.
+func TestTaskRunner_PreStop_Exec(t *testing.T) {
+	ci.Parallel(t)
+
+	alloc := mock.Alloc()
+	task := alloc.Job.TaskGroups[0].Tasks[0]
+	task.Driver = "mock_driver"
+	task.Config = map[string]interface{}{
+		"run_for": "10s",
+	}
+	task.PreStop = &structs.TaskPreStop{
+		Exec: &structs.TaskPreStopExec{
+			Command: "/bin/drain",
+			Args:    []string{"${NOMAD_TASK_NAME}"},
+		},
+		Timeout: time.Second,
+	}
+
+	tr, _, cleanup := runTestTaskRunner(t, alloc, task.Name)
+	defer cleanup()
+
+	testWaitForTaskToStart(t, tr)
+	must.NoError(t, tr.Kill(context.Background(), structs.NewTaskEvent("test")))
+	testWaitForTaskToDie(t, tr)
+
+	event := testFindTaskEvent(tr.TaskState(), structs.TaskPreStopSucceeded)
+	must.NotNil(t, event)
+	must.StrHasPrefix(t, "PreStop succeeded", event.DisplayMessage)
+}
+
+// TestTaskRunner_PreStop_HTTP asserts that the prestop request is sent to the
+// task before it is killed, and that failures are reported without
+// preventing the task from being killed.
+func TestTaskRunner_PreStop_HTTP(t *testing.T) {
+	ci.Parallel(t)
+
+	cases := []struct {
+		name   string
+		status int
+		event  string
+	}{
+		{name: "success", status: http.StatusOK, event: structs.TaskPreStopSucceeded},
+		{name: "failure", status: http.StatusInternalServerError, event: structs.TaskPreStopFailed},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			reqCh := make(chan *http.Request, 1)
+			ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				reqCh <- r
+				w.WriteHeader(tc.status)
+			}))
+			defer ts.Close()
+
+			port := ts.Listener.Addr().(*net.TCPAddr).Port
+
+			alloc := mock.Alloc()
+			alloc.AllocatedResources.Shared.Ports = structs.AllocatedPorts{
+				{Label: "admin", Value: port, HostIP: "127.0.0.1"},
+			}
+			task := alloc.Job.TaskGroups[0].Tasks[0]
+			task.Driver = "mock_driver"
+			task.Config = map[string]interface{}{
+				"run_for": "10s",
+			}
+			task.PreStop = &structs.TaskPreStop{
+				HTTP: &structs.TaskPreStopHTTP{
+					Port:     "admin",
+					Path:     "/drain/${NOMAD_TASK_NAME}",
+					Method:   http.MethodPost,
+					Protocol: structs.TaskPreStopProtocolHTTP,
+					Header:   map[string][]string{"X-Drain": {"true"}},
+				},
+				Timeout: time.Second,
+			}
+
+			tr, _, cleanup := runTestTaskRunner(t, alloc, task.Name)
+			defer cleanup()
+
+			testWaitForTaskToStart(t, tr)
+			must.NoError(t, tr.Kill(context.Background(), structs.NewTaskEvent("test")))
+			testWaitForTaskToDie(t, tr)
+
+			select {
+			case r := <-reqCh:
+				must.Eq(t, http.MethodPost, r.Method)
+				must.Eq(t, "/drain/"+task.Name, r.URL.Path)
+				must.Eq(t, "true", r.Header.Get("X-Drain"))
+			default:
+				t.Fatal("prestop request was not sent")
+			}
+
+			must.NotNil(t, testFindTaskEvent(tr.TaskState(), tc.event))
+			must.Eq(t, structs.TaskStateDead, tr.TaskState().State)
+		})
+	}
+}
+
+// testFindTaskEvent returns the last event of the given type, or nil.
+func testFindTaskEvent(state *structs.TaskState, eventType string) *structs.TaskEvent {
+	for i := len(state.Events) - 1; i >= 0; i-- {
+		if state.Events[i].Type == eventType {
+			return state.Events[i]
+		}
+	}
+	return nil
+}
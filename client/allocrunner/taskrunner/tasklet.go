@@ -8,6 +8,7 @@ import (
 	log "github.com/hashicorp/go-hclog"
 
 	"github.com/hashicorp/nomad/client/allocrunner/taskrunner/interfaces"
+	"github.com/hashicorp/nomad/client/serviceregistration"
 )
 
 // contextExec allows canceling a interfaces.ScriptExecutor with a context.
@@ -69,6 +70,7 @@ type tasklet struct {
 	Interval   time.Duration // Interval of the tasklet
 	Timeout    time.Duration // Timeout of the tasklet
 	exec       interfaces.ScriptExecutor
+	pauser     serviceregistration.WorkloadPauser // optional, skips runs while paused
 	callback   taskletCallback
 	logger     log.Logger
 	shutdownCh <-chan struct{}
@@ -119,6 +121,17 @@ func (t *tasklet) run() *taskletHandle {
 				timer.Reset(t.Interval)
 			}
 
+			// The frozen processes of a paused task cannot run the script
+			if t.pauser != nil && t.pauser.IsPaused() {
+				t.logger.Trace("tasklet skipped while task is paused")
+				select {
+				case <-t.shutdownCh:
+					return
+				default:
+					continue
+				}
+			}
+
 			metrics.IncrCounter([]string{
 				"client", "allocrunner", "taskrunner", "tasklet_runs"}, 1)
 
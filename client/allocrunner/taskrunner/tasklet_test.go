@@ -87,6 +87,44 @@ func TestTasklet_Exec_Cancel(t *testing.T) {
 	}
 }
 
+// testPauser is a serviceregistration.WorkloadPauser for tests
+type testPauser struct {
+	paused atomic.Bool
+}
+
+func (p *testPauser) IsPaused() bool {
+	return p.paused.Load()
+}
+
+// TestTasklet_Exec_Paused asserts tasklets are not run while their task is
+// paused.
+func TestTasklet_Exec_Paused(t *testing.T) {
+	ci.Parallel(t)
+
+	exec := newSimpleExec(0, nil)
+	tm := newTaskletMock(exec, testlog.HCLogger(t), 10*time.Millisecond, 3*time.Second)
+	pauser := new(testPauser)
+	pauser.paused.Store(true)
+	tm.pauser = pauser
+
+	handle := tm.run()
+	defer handle.cancel()
+
+	select {
+	case call := <-tm.calls:
+		t.Fatalf("expected 0 calls of paused tasklet, got %v", call)
+	case <-time.After(100 * time.Millisecond):
+	}
+
+	// Resumed tasklets run again
+	pauser.paused.Store(false)
+	select {
+	case <-tm.calls:
+	case <-time.After(3 * time.Second):
+		t.Fatalf("timed out waiting for resumed tasklet to run")
+	}
+}
+
 // TestTasklet_Exec_Timeout asserts a tasklet script will be killed
 // when the timeout is reached.
 func TestTasklet_Exec_Timeout(t *testing.T) {
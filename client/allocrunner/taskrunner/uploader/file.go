@@ -0,0 +1,105 @@
+package uploader
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/url"
+	"os"
+	"path/filepath"
+
+	"github.com/hashicorp/nomad/helper/escapingfs"
+)
+
+// fileUploader copies files to a directory on the host. Only directories
+// in the client's allowlist may be written to.
+type fileUploader struct {
+	allowlist []string
+}
+
+func newFileUploader(allowlist []string) *fileUploader {
+	return &fileUploader{allowlist: allowlist}
+}
+
+func (u *fileUploader) Upload(ctx context.Context, src string, dst *url.URL) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+
+	path := filepath.Clean(filepath.FromSlash(dst.Path))
+	if !filepath.IsAbs(path) {
+		return fmt.Errorf("destination %q must be an absolute path", dst.Path)
+	}
+
+	allowed, err := u.allowed(path)
+	if err != nil {
+		return err
+	}
+	if allowed == "" {
+		return fmt.Errorf("destination %q is not within an allowed directory", path)
+	}
+
+	dir := filepath.Dir(path)
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		return err
+	}
+
+	// Make sure no symlink within the allowed directory redirects the file
+	// elsewhere.
+	resolved, err := filepath.EvalSymlinks(dir)
+	if err != nil {
+		return err
+	}
+	if escapingfs.PathEscapesSandbox(allowed, resolved) {
+		return fmt.Errorf("destination %q escapes the allowed directory", path)
+	}
+
+	in, err := os.Open(src)
+	if err != nil {
+		return err
+	}
+	defer in.Close()
+
+	info, err := in.Stat()
+	if err != nil {
+		return err
+	}
+
+	// Write to a temporary file and rename it into place so that an
+	// existing symlink at the destination is replaced, not followed.
+	out, err := os.CreateTemp(resolved, ".nomad-output-*")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(out.Name())
+
+	if _, err := io.Copy(out, in); err != nil {
+		out.Close()
+		return err
+	}
+	if err := out.Chmod(info.Mode().Perm()); err != nil {
+		out.Close()
+		return err
+	}
+	if err := out.Close(); err != nil {
+		return err
+	}
+
+	return os.Rename(out.Name(), filepath.Join(resolved, filepath.Base(path)))
+}
+
+// allowed returns the resolved allowlist directory containing path, or the
+// empty string if there is none.
+func (u *fileUploader) allowed(path string) (string, error) {
+	for _, dir := range u.allowlist {
+		if escapingfs.PathEscapesSandbox(dir, path) {
+			continue
+		}
+		resolved, err := filepath.EvalSymlinks(dir)
+		if err != nil {
+			return "", fmt.Errorf("failed to resolve allowed directory %q: %v", dir, err)
+		}
+		return resolved, nil
+	}
+	return "", nil
+}
@@ -0,0 +1,60 @@
+package uploader
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"os"
+
+	cleanhttp "github.com/hashicorp/go-cleanhttp"
+)
+
+// httpUploader uploads files with an HTTP PUT request.
+type httpUploader struct {
+	client  *http.Client
+	headers map[string]string
+}
+
+func newHTTPUploader(headers map[string]string) *httpUploader {
+	return &httpUploader{
+		client:  cleanhttp.DefaultClient(),
+		headers: headers,
+	}
+}
+
+func (u *httpUploader) Upload(ctx context.Context, src string, dst *url.URL) error {
+	f, err := os.Open(src)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	info, err := f.Stat()
+	if err != nil {
+		return err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPut, dst.String(), f)
+	if err != nil {
+		return err
+	}
+	req.ContentLength = info.Size()
+	req.Header.Set("Content-Type", "application/octet-stream")
+	for k, v := range u.headers {
+		req.Header.Set(k, v)
+	}
+
+	resp, err := u.client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	_, _ = io.Copy(io.Discard, resp.Body)
+
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		return fmt.Errorf("unexpected response status %s", resp.Status)
+	}
+	return nil
+}
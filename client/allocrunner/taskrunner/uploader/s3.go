@@ -0,0 +1,85 @@
+package uploader
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"os"
+	"strconv"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/credentials"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/s3/s3manager"
+)
+
+const (
+	// defaultS3Region is used when no region option is given, which is
+	// typical of S3 compatible stores.
+	defaultS3Region = "us-east-1"
+)
+
+// s3Uploader uploads files to an S3 compatible object store. Destinations
+// are of the form s3://bucket/key.
+type s3Uploader struct {
+	uploader *s3manager.Uploader
+}
+
+// newS3Uploader returns an uploader configured by the output options:
+//
+//   - region: the bucket region
+//   - endpoint: the endpoint of an S3 compatible store
+//   - force_path_style: use path style requests, as most S3 compatible
+//     stores require
+//   - aws_access_key_id, aws_access_key_secret, aws_access_token: static
+//     credentials, otherwise the default credential chain of the client
+//     is used
+func newS3Uploader(options map[string]string) (*s3Uploader, error) {
+	config := aws.NewConfig().WithRegion(defaultS3Region)
+	if region := options["region"]; region != "" {
+		config = config.WithRegion(region)
+	}
+	if endpoint := options["endpoint"]; endpoint != "" {
+		config = config.WithEndpoint(endpoint)
+	}
+	if v := options["force_path_style"]; v != "" {
+		pathStyle, err := strconv.ParseBool(v)
+		if err != nil {
+			return nil, fmt.Errorf("invalid force_path_style option %q: %v", v, err)
+		}
+		config = config.WithS3ForcePathStyle(pathStyle)
+	}
+	if id := options["aws_access_key_id"]; id != "" {
+		config = config.WithCredentials(credentials.NewStaticCredentials(
+			id, options["aws_access_key_secret"], options["aws_access_token"]))
+	}
+
+	sess, err := session.NewSession(config)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create S3 session: %v", err)
+	}
+
+	return &s3Uploader{uploader: s3manager.NewUploader(sess)}, nil
+}
+
+func (u *s3Uploader) Upload(ctx context.Context, src string, dst *url.URL) error {
+	bucket := dst.Host
+	key := strings.TrimPrefix(dst.Path, "/")
+	if bucket == "" || key == "" {
+		return fmt.Errorf("destination %q must include a bucket and key", dst.Redacted())
+	}
+
+	f, err := os.Open(src)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	_, err = u.uploader.UploadWithContext(ctx, &s3manager.UploadInput{
+		Bucket: aws.String(bucket),
+		Key:    aws.String(key),
+		Body:   f,
+	})
+	return err
+}
@@ -0,0 +1,177 @@
+// Package uploader uploads files produced by a task to external storage once
+// the task has finished. It is the counterpart of the artifact getter.
+package uploader
+
+import (
+	"context"
+	"fmt"
+	"io/fs"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/hashicorp/go-hclog"
+	"github.com/hashicorp/nomad/client/allocdir"
+	"github.com/hashicorp/nomad/helper/escapingfs"
+	"github.com/hashicorp/nomad/nomad/structs"
+)
+
+// Uploader uploads a single local file to a destination.
+type Uploader interface {
+	Upload(ctx context.Context, src string, dst *url.URL) error
+}
+
+// Config is the client configuration used when uploading outputs.
+type Config struct {
+	// FileAllowlist is the list of host directories local path destinations
+	// may be written to. Local path destinations are refused if empty.
+	FileAllowlist []string
+}
+
+// New returns the Uploader for the scheme of the destination. The output
+// must already have been interpolated.
+func New(output *structs.TaskOutput, config *Config) (Uploader, *url.URL, error) {
+	dst, err := url.Parse(output.Destination)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to parse destination %q: %v", output.Destination, err)
+	}
+
+	switch dst.Scheme {
+	case "http", "https":
+		return newHTTPUploader(output.Headers), dst, nil
+	case "s3":
+		u, err := newS3Uploader(output.Options)
+		if err != nil {
+			return nil, nil, err
+		}
+		return u, dst, nil
+	case "file", "":
+		return newFileUploader(config.FileAllowlist), dst, nil
+	default:
+		return nil, nil, fmt.Errorf("unsupported destination scheme %q", dst.Scheme)
+	}
+}
+
+// Upload uploads all the files matched by the output's source to its
+// destination and returns the number of files uploaded. Symlinks and files
+// escaping the allocation directory are never uploaded. A source matching
+// nothing is not an error.
+func Upload(ctx context.Context, logger hclog.Logger, taskDir *allocdir.TaskDir, output *structs.TaskOutput, config *Config) (int, error) {
+	uploader, dst, err := New(output, config)
+	if err != nil {
+		return 0, err
+	}
+
+	files, err := expand(taskDir, output.Source)
+	if err != nil {
+		return 0, err
+	}
+
+	// A single file uploaded to a destination not ending in a slash is
+	// uploaded to exactly that destination, otherwise files are uploaded
+	// beneath it keeping their path relative to the matched path.
+	if len(files) == 1 && files[0].exact && !strings.HasSuffix(dst.Path, "/") {
+		logger.Debug("uploading output", "source", files[0].path, "destination", dst.Redacted())
+		if err := uploader.Upload(ctx, files[0].path, dst); err != nil {
+			return 0, fmt.Errorf("failed to upload %q: %v", files[0].rel, err)
+		}
+		return 1, nil
+	}
+
+	for i, f := range files {
+		target := join(dst, f.rel)
+		logger.Debug("uploading output", "source", f.path, "destination", target.Redacted())
+		if err := uploader.Upload(ctx, f.path, target); err != nil {
+			return i, fmt.Errorf("failed to upload %q: %v", f.rel, err)
+		}
+	}
+	return len(files), nil
+}
+
+// file is a regular file matched by an output source.
+type file struct {
+	// path is the absolute path of the file on the host
+	path string
+
+	// rel is the path of the file relative to the parent of the matched path
+	rel string
+
+	// exact is true if the file was matched directly by the source glob
+	// rather than found by walking a matched directory
+	exact bool
+}
+
+// expand returns the regular files matched by the source glob, which is
+// relative to the task directory, walking matched directories.
+func expand(taskDir *allocdir.TaskDir, source string) ([]file, error) {
+	allocDir, err := filepath.EvalSymlinks(taskDir.AllocDir)
+	if err != nil {
+		return nil, err
+	}
+
+	matches, err := filepath.Glob(filepath.Join(taskDir.Dir, source))
+	if err != nil {
+		return nil, fmt.Errorf("invalid source %q: %v", source, err)
+	}
+
+	var files []file
+	for _, match := range matches {
+		rel, err := filepath.Rel(taskDir.Dir, match)
+		if err != nil {
+			return nil, err
+		}
+
+		// Globbing follows symlinks, so make sure the match is still within
+		// the allocation directory once they are resolved.
+		resolved, err := filepath.EvalSymlinks(match)
+		if err != nil {
+			return nil, fmt.Errorf("failed to resolve source %q: %v", rel, err)
+		}
+		if escapingfs.PathEscapesSandbox(allocDir, resolved) {
+			return nil, fmt.Errorf("source %q escapes the allocation directory", rel)
+		}
+
+		info, err := os.Lstat(match)
+		if err != nil {
+			return nil, err
+		}
+
+		base := filepath.Dir(match)
+		switch {
+		case info.Mode().IsRegular():
+			files = append(files, file{path: match, rel: info.Name(), exact: true})
+		case info.IsDir():
+			// WalkDir does not follow symlinks, so everything it finds is
+			// within the matched directory.
+			err := filepath.WalkDir(match, func(path string, d fs.DirEntry, err error) error {
+				if err != nil {
+					return err
+				}
+				if !d.Type().IsRegular() {
+					return nil
+				}
+				rel, err := filepath.Rel(base, path)
+				if err != nil {
+					return err
+				}
+				files = append(files, file{path: path, rel: rel})
+				return nil
+			})
+			if err != nil {
+				return nil, fmt.Errorf("failed to walk %q: %v", rel, err)
+			}
+		}
+	}
+
+	return files, nil
+}
+
+// join returns a copy of dst with the slash separated form of rel appended
+// to its path.
+func join(dst *url.URL, rel string) *url.URL {
+	u := *dst
+	u.Path = strings.TrimSuffix(u.Path, "/") + "/" + filepath.ToSlash(rel)
+	u.RawPath = ""
+	return &u
+}
@@ -0,0 +1,199 @@
+package uploader
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"sync"
+	"testing"
+
+	"github.com/hashicorp/nomad/ci"
+	"github.com/hashicorp/nomad/client/allocdir"
+	"github.com/hashicorp/nomad/helper/testlog"
+	"github.com/hashicorp/nomad/nomad/structs"
+	"github.com/shoenig/test/must"
+)
+
+// testTaskDir returns a task directory containing:
+//
+//	local/result.txt
+//	local/logs/a.log
+//	local/logs/nested/b.log
+//	local/logs/link -> ../result.txt
+func testTaskDir(t *testing.T) *allocdir.TaskDir {
+	allocDir := t.TempDir()
+	taskDir := &allocdir.TaskDir{
+		AllocDir: allocDir,
+		Dir:      filepath.Join(allocDir, "web"),
+	}
+
+	local := filepath.Join(taskDir.Dir, "local")
+	must.NoError(t, os.MkdirAll(filepath.Join(local, "logs", "nested"), 0o755))
+	must.NoError(t, os.WriteFile(filepath.Join(local, "result.txt"), []byte("result"), 0o644))
+	must.NoError(t, os.WriteFile(filepath.Join(local, "logs", "a.log"), []byte("a"), 0o644))
+	must.NoError(t, os.WriteFile(filepath.Join(local, "logs", "nested", "b.log"), []byte("b"), 0o644))
+	must.NoError(t, os.Symlink("../result.txt", filepath.Join(local, "logs", "link")))
+	return taskDir
+}
+
+func TestUpload_HTTP(t *testing.T) {
+	ci.Parallel(t)
+
+	var lock sync.Mutex
+	received := map[string]string{}
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		must.Eq(t, http.MethodPut, r.Method)
+		must.Eq(t, "secret", r.Header.Get("X-Token"))
+		body, err := io.ReadAll(r.Body)
+		must.NoError(t, err)
+
+		lock.Lock()
+		defer lock.Unlock()
+		received[r.URL.Path] = string(body)
+	}))
+	t.Cleanup(srv.Close)
+
+	taskDir := testTaskDir(t)
+	logger := testlog.HCLogger(t)
+
+	// a single file is uploaded to exactly the destination
+	n, err := Upload(context.Background(), logger, taskDir, &structs.TaskOutput{
+		Source:      "local/result.txt",
+		Destination: srv.URL + "/results/out.txt",
+		Headers:     map[string]string{"X-Token": "secret"},
+	}, &Config{})
+	must.NoError(t, err)
+	must.Eq(t, 1, n)
+
+	// directories are walked without following symlinks
+	n, err = Upload(context.Background(), logger, taskDir, &structs.TaskOutput{
+		Source:      "local/log*",
+		Destination: srv.URL + "/archive/",
+		Headers:     map[string]string{"X-Token": "secret"},
+	}, &Config{})
+	must.NoError(t, err)
+	must.Eq(t, 2, n)
+
+	must.Eq(t, map[string]string{
+		"/results/out.txt":           "result",
+		"/archive/logs/a.log":        "a",
+		"/archive/logs/nested/b.log": "b",
+	}, received)
+}
+
+func TestUpload_HTTP_Error(t *testing.T) {
+	ci.Parallel(t)
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusForbidden)
+	}))
+	t.Cleanup(srv.Close)
+
+	_, err := Upload(context.Background(), testlog.HCLogger(t), testTaskDir(t), &structs.TaskOutput{
+		Source:      "local/result.txt",
+		Destination: srv.URL + "/out.txt",
+	}, &Config{})
+	must.ErrorContains(t, err, "403 Forbidden")
+}
+
+func TestUpload_NoMatches(t *testing.T) {
+	ci.Parallel(t)
+
+	n, err := Upload(context.Background(), testlog.HCLogger(t), testTaskDir(t), &structs.TaskOutput{
+		Source:      "local/*.missing",
+		Destination: "https://example.invalid/",
+	}, &Config{})
+	must.NoError(t, err)
+	must.Zero(t, n)
+}
+
+func TestUpload_Escape(t *testing.T) {
+	ci.Parallel(t)
+
+	taskDir := testTaskDir(t)
+	outside := t.TempDir()
+	must.NoError(t, os.WriteFile(filepath.Join(outside, "secret"), []byte("secret"), 0o644))
+	must.NoError(t, os.Symlink(outside, filepath.Join(taskDir.Dir, "local", "escape")))
+
+	_, err := Upload(context.Background(), testlog.HCLogger(t), taskDir, &structs.TaskOutput{
+		Source:      "local/escape/*",
+		Destination: "https://example.invalid/",
+	}, &Config{})
+	must.ErrorContains(t, err, "escapes the allocation directory")
+}
+
+func TestUpload_File(t *testing.T) {
+	ci.Parallel(t)
+
+	taskDir := testTaskDir(t)
+	allowed := t.TempDir()
+	config := &Config{FileAllowlist: []string{allowed}}
+	logger := testlog.HCLogger(t)
+
+	n, err := Upload(context.Background(), logger, taskDir, &structs.TaskOutput{
+		Source:      "local/logs",
+		Destination: "file://" + filepath.ToSlash(filepath.Join(allowed, "run1")) + "/",
+	}, config)
+	must.NoError(t, err)
+	must.Eq(t, 2, n)
+
+	b, err := os.ReadFile(filepath.Join(allowed, "run1", "logs", "nested", "b.log"))
+	must.NoError(t, err)
+	must.Eq(t, "b", string(b))
+
+	// plain absolute paths are local path destinations too
+	n, err = Upload(context.Background(), logger, taskDir, &structs.TaskOutput{
+		Source:      "local/result.txt",
+		Destination: filepath.ToSlash(filepath.Join(allowed, "result.txt")),
+	}, config)
+	must.NoError(t, err)
+	must.Eq(t, 1, n)
+
+	b, err = os.ReadFile(filepath.Join(allowed, "result.txt"))
+	must.NoError(t, err)
+	must.Eq(t, "result", string(b))
+}
+
+func TestUpload_File_NotAllowed(t *testing.T) {
+	ci.Parallel(t)
+
+	taskDir := testTaskDir(t)
+	allowed := t.TempDir()
+	other := t.TempDir()
+	config := &Config{FileAllowlist: []string{allowed}}
+	logger := testlog.HCLogger(t)
+
+	_, err := Upload(context.Background(), logger, taskDir, &structs.TaskOutput{
+		Source:      "local/result.txt",
+		Destination: filepath.ToSlash(filepath.Join(other, "result.txt")),
+	}, config)
+	must.ErrorContains(t, err, "not within an allowed directory")
+
+	// a symlink within the allowed directory cannot redirect uploads
+	must.NoError(t, os.Symlink(other, filepath.Join(allowed, "link")))
+	_, err = Upload(context.Background(), logger, taskDir, &structs.TaskOutput{
+		Source:      "local/result.txt",
+		Destination: filepath.ToSlash(filepath.Join(allowed, "link", "result.txt")),
+	}, config)
+	must.ErrorContains(t, err, "escapes the allowed directory")
+
+	// no local path destinations are allowed by default
+	_, err = Upload(context.Background(), logger, taskDir, &structs.TaskOutput{
+		Source:      "local/result.txt",
+		Destination: filepath.ToSlash(filepath.Join(allowed, "result.txt")),
+	}, &Config{})
+	must.ErrorContains(t, err, "not within an allowed directory")
+}
+
+func TestUpload_UnsupportedScheme(t *testing.T) {
+	ci.Parallel(t)
+
+	_, err := Upload(context.Background(), testlog.HCLogger(t), testTaskDir(t), &structs.TaskOutput{
+		Source:      "local/result.txt",
+		Destination: "ftp://example.invalid/result.txt",
+	}, &Config{})
+	must.ErrorContains(t, err, `unsupported destination scheme "ftp"`)
+}
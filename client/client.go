@@ -30,6 +30,7 @@ import (
 	"github.com/hashicorp/nomad/client/fingerprint"
 	cinterfaces "github.com/hashicorp/nomad/client/interfaces"
 	"github.com/hashicorp/nomad/client/lib/cgutil"
+	"github.com/hashicorp/nomad/client/lib/idmap"
 	"github.com/hashicorp/nomad/client/pluginmanager"
 	"github.com/hashicorp/nomad/client/pluginmanager/csimanager"
 	"github.com/hashicorp/nomad/client/pluginmanager/drivermanager"
@@ -155,6 +156,8 @@ type AllocRunner interface {
 	DestroyCh() <-chan struct{}
 	ShutdownCh() <-chan struct{}
 	Signal(taskName, signal string) error
+	Pause(taskName string) error
+	Resume(taskName string) error
 	GetTaskEventHandler(taskName string) drivermanager.EventHandler
 	PersistState() error
 
@@ -235,6 +238,12 @@ type Client struct {
 	allocs    map[string]AllocRunner
 	allocLock sync.RWMutex
 
+	// retainedAllocs maps alloc IDs to the AllocRunners of allocations which
+	// the server GC'd, but which are retained by the GC because they are
+	// pinned or failed. They are tracked until they are destroyed so they can
+	// still be inspected and unpinned. Guarded by allocLock.
+	retainedAllocs map[string]AllocRunner
+
 	// invalidAllocs is a map that tracks allocations that failed because
 	// the client couldn't initialize alloc or task runners for it. This can
 	// happen due to driver errors
@@ -318,6 +327,13 @@ type Client struct {
 	// drivermanager is responsible for managing driver plugins
 	drivermanager drivermanager.Manager
 
+	// pluginReloadLock serializes reloading driver and device plugins
+	pluginReloadLock sync.Mutex
+
+	// userNamespaces allocates the host ID ranges of the user namespaces of
+	// allocations
+	userNamespaces *idmap.Allocator
+
 	// baseLabels are used when emitting tagged metrics. All client metrics will
 	// have these tags, and optionally more.
 	baseLabels []metrics.Label
@@ -394,6 +410,7 @@ func NewClient(cfg *config.Config, consulCatalog consul.CatalogAPI, consulProxie
 		logger:               logger,
 		rpcLogger:            logger.Named("rpc"),
 		allocs:               make(map[string]AllocRunner),
+		retainedAllocs:       make(map[string]AllocRunner),
 		allocUpdates:         make(chan *structs.Allocation, 64),
 		shutdownCh:           make(chan struct{}),
 		triggerDiscoveryCh:   make(chan struct{}),
@@ -532,6 +549,9 @@ func NewClient(cfg *config.Config, consulCatalog consul.CatalogAPI, consulProxie
 		Interval:            cfg.GCInterval,
 		ParallelDestroys:    cfg.GCParallelDestroys,
 		ReservedDiskMB:      cfg.Node.Reserved.DiskMB,
+
+		FailedAllocRetention: cfg.GCFailedAllocRetention,
+		MaxFailedAllocs:      cfg.GCMaxFailedAllocs,
 	}
 	c.garbageCollector = NewAllocGarbageCollector(c.logger, statsCollector, c, gcConfig)
 	go c.garbageCollector.Run()
@@ -653,6 +673,7 @@ func (c *Client) init() error {
 	}
 
 	c.stateDB = db
+	c.userNamespaces = idmap.NewAllocator(db)
 
 	// Ensure the alloc dir exists if we have one
 	if conf.AllocDir != "" {
@@ -753,6 +774,8 @@ func (c *Client) Reload(newConfig *config.Config) error {
 
 	c.fingerprintManager.Reload()
 
+	c.reloadPluginConfigs(newConfig.Plugins)
+
 	return nil
 }
 
@@ -931,6 +954,28 @@ func (c *Client) SignalAllocation(allocID, task, signal string) error {
 	return ar.Signal(task, signal)
 }
 
+// PauseAllocation freezes the processes of a task of an allocation, or of all
+// its running tasks if task is empty.
+func (c *Client) PauseAllocation(allocID, task string) error {
+	ar, err := c.getAllocRunner(allocID)
+	if err != nil {
+		return err
+	}
+
+	return ar.Pause(task)
+}
+
+// ResumeAllocation thaws the processes of a paused task of an allocation, or
+// of all its paused tasks if task is empty.
+func (c *Client) ResumeAllocation(allocID, task string) error {
+	ar, err := c.getAllocRunner(allocID)
+	if err != nil {
+		return err
+	}
+
+	return ar.Resume(task)
+}
+
 // CollectAllocation garbage collects a single allocation on a node. Returns
 // true if alloc was found and garbage collected; otherwise false.
 func (c *Client) CollectAllocation(allocID string) bool {
@@ -943,6 +988,37 @@ func (c *Client) CollectAllAllocs() {
 	c.garbageCollector.CollectAll()
 }
 
+// PinAllocation pins or unpins a terminal allocation. Pinned allocations are
+// not garbage collected until they are unpinned. The pin is persisted in the
+// client state so it survives client restarts.
+func (c *Client) PinAllocation(allocID string, pinned bool) error {
+	ar, err := c.getAllocRunner(allocID)
+	if err != nil {
+		return err
+	}
+
+	// Terminal allocations are marked for GC, but the alloc runner may not
+	// have reported its terminal state yet
+	if pinned && !c.garbageCollector.IsMarked(allocID) {
+		switch ar.AllocState().ClientStatus {
+		case structs.AllocClientStatusComplete, structs.AllocClientStatusFailed, structs.AllocClientStatusLost:
+		default:
+			return fmt.Errorf("allocation %q is not terminal", allocID)
+		}
+	}
+
+	if err := c.stateDB.PutAllocPinned(allocID, pinned); err != nil {
+		return fmt.Errorf("failed to persist allocation pin: %v", err)
+	}
+
+	if pinned {
+		c.garbageCollector.Pin(allocID)
+	} else {
+		c.garbageCollector.Unpin(allocID)
+	}
+	return nil
+}
+
 func (c *Client) RestartAllocation(allocID, taskName string, allTasks bool) error {
 	if allTasks && taskName != "" {
 		return fmt.Errorf("task name cannot be set when restarting all tasks")
@@ -982,6 +1058,9 @@ func (c *Client) getAllocRunner(allocID string) (AllocRunner, error) {
 	defer c.allocLock.RUnlock()
 
 	ar, ok := c.allocs[allocID]
+	if !ok {
+		ar, ok = c.retainedAllocs[allocID]
+	}
 	if !ok {
 		return nil, structs.NewErrUnknownAllocation(allocID)
 	}
@@ -1239,6 +1318,7 @@ func (c *Client) restoreState() error {
 			CpusetManager:       c.cpusetManager,
 			DeviceManager:       c.devicemanager,
 			DriverManager:       c.drivermanager,
+			UserNamespaces:      c.userNamespaces,
 			ServersContactedCh:  c.serversContactedCh,
 			ServiceRegWrapper:   c.serviceRegWrapper,
 			CheckStore:          c.checkStore,
@@ -1263,6 +1343,11 @@ func (c *Client) restoreState() error {
 			continue
 		}
 
+		// Keep the user namespace of the alloc so it is not reused
+		if err := c.userNamespaces.Restore(alloc.ID); err != nil {
+			c.logger.Error("error restoring alloc user namespace", "error", err, "alloc_id", alloc.ID)
+		}
+
 		// Maybe mark the alloc for halt on missing server heartbeats
 		if c.heartbeatStop.shouldStop(alloc) {
 			err = c.heartbeatStop.stopAlloc(alloc.ID)
@@ -1272,6 +1357,13 @@ func (c *Client) restoreState() error {
 			continue
 		}
 
+		// Restore the pin so the alloc survives GC after a restart
+		if pinned, err := c.stateDB.GetAllocPinned(alloc.ID); err != nil {
+			c.logger.Error("error restoring alloc pin", "error", err, "alloc_id", alloc.ID)
+		} else if pinned {
+			c.garbageCollector.Pin(alloc.ID)
+		}
+
 		//XXX is this locking necessary?
 		c.allocLock.Lock()
 		c.allocs[alloc.ID] = ar
@@ -2544,10 +2636,34 @@ func (c *Client) removeAlloc(allocID string) {
 	// applies rate limiting
 	c.garbageCollector.MarkForCollection(allocID, ar)
 
+	// Keep pinned and retained failed allocs addressable until the GC
+	// destroys them
+	if c.garbageCollector.RetainRemoved(allocID) {
+		c.retainedAllocs[allocID] = ar
+		go c.untrackRetainedAlloc(allocID, ar)
+		return
+	}
+
 	// GC immediately since the server has GC'd it
 	go c.garbageCollector.Collect(allocID)
 }
 
+// untrackRetainedAlloc stops tracking a retained alloc once the GC destroys
+// it.
+func (c *Client) untrackRetainedAlloc(allocID string, ar AllocRunner) {
+	select {
+	case <-ar.DestroyCh():
+	case <-c.shutdownCh:
+		return
+	}
+
+	c.allocLock.Lock()
+	defer c.allocLock.Unlock()
+	if c.retainedAllocs[allocID] == ar {
+		delete(c.retainedAllocs, allocID)
+	}
+}
+
 // updateAlloc is invoked when we should update an allocation
 func (c *Client) updateAlloc(update *structs.Allocation) {
 	ar, err := c.getAllocRunner(update.ID)
@@ -2634,6 +2750,7 @@ func (c *Client) addAlloc(alloc *structs.Allocation, migrateToken string) error
 		CpusetManager:       c.cpusetManager,
 		DeviceManager:       c.devicemanager,
 		DriverManager:       c.drivermanager,
+		UserNamespaces:      c.userNamespaces,
 		ServiceRegWrapper:   c.serviceRegWrapper,
 		CheckStore:          c.checkStore,
 		RPCClient:           c,
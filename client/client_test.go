@@ -534,6 +534,82 @@ func TestClient_WatchAllocs(t *testing.T) {
 	})
 }
 
+func TestClient_RemoveAlloc_Retained(t *testing.T) {
+	ci.Parallel(t)
+
+	s1, _, cleanupS1 := testServer(t, nil)
+	defer cleanupS1()
+	testutil.WaitForLeader(t, s1.RPC)
+
+	c1, cleanup := TestClient(t, func(c *config.Config) {
+		c.RPCHandler = s1
+	})
+	defer cleanup()
+
+	waitTilNodeReady(c1, t)
+
+	job := mock.Job()
+	job.TaskGroups[0].Tasks[0].Driver = "mock_driver"
+	job.TaskGroups[0].Tasks[0].Config = map[string]interface{}{
+		"run_for":   "1ms",
+		"exit_code": 1,
+	}
+	job.TaskGroups[0].Tasks[0].RestartPolicy = &structs.RestartPolicy{
+		Attempts: 0,
+		Mode:     structs.RestartPolicyModeFail,
+	}
+	alloc := mock.Alloc()
+	alloc.NodeID = c1.Node().ID
+	alloc.JobID = job.ID
+	alloc.Job = job
+
+	state := s1.State()
+	require.NoError(t, state.UpsertJob(structs.MsgTypeTestSetup, 100, job))
+	require.NoError(t, state.UpsertJobSummary(101, mock.JobSummary(alloc.JobID)))
+	require.NoError(t, state.UpsertAllocs(structs.MsgTypeTestSetup, 102, []*structs.Allocation{alloc}))
+
+	// Pin the alloc once it failed
+	testutil.WaitForResult(func() (bool, error) {
+		allocState, err := c1.GetAllocState(alloc.ID)
+		if err != nil {
+			return false, err
+		}
+		if allocState.ClientStatus != structs.AllocClientStatusFailed {
+			return false, fmt.Errorf("alloc client status is %q", allocState.ClientStatus)
+		}
+		return true, nil
+	}, func(err error) {
+		t.Fatalf("err: %v", err)
+	})
+	require.NoError(t, c1.PinAllocation(alloc.ID, true))
+
+	// Remove the alloc from the servers
+	require.NoError(t, state.DeleteEval(103, nil, []string{alloc.ID}, false))
+	testutil.WaitForResult(func() (bool, error) {
+		c1.allocLock.RLock()
+		_, ok := c1.allocs[alloc.ID]
+		c1.allocLock.RUnlock()
+		return !ok, nil
+	}, func(err error) {
+		t.Fatalf("alloc was not removed")
+	})
+
+	// The retained alloc can still be inspected
+	_, err := c1.GetAllocState(alloc.ID)
+	require.NoError(t, err)
+	_, err = c1.GetAllocFS(alloc.ID)
+	require.NoError(t, err)
+
+	// Unpinning the alloc collects it
+	require.NoError(t, c1.PinAllocation(alloc.ID, false))
+	testutil.WaitForResult(func() (bool, error) {
+		_, err := c1.GetAllocState(alloc.ID)
+		return structs.IsErrUnknownAllocation(err), err
+	}, func(err error) {
+		t.Fatalf("alloc was not collected: %v", err)
+	})
+}
+
 func waitTilNodeReady(client *Client, t *testing.T) {
 	testutil.WaitForResult(func() (bool, error) {
 		n := client.Node()
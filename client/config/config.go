@@ -18,6 +18,7 @@ import (
 
 	log "github.com/hashicorp/go-hclog"
 	"github.com/hashicorp/nomad/client/state"
+	"github.com/hashicorp/nomad/helper"
 	"github.com/hashicorp/nomad/helper/bufconndialer"
 	"github.com/hashicorp/nomad/helper/pluginutils/loader"
 	"github.com/hashicorp/nomad/helper/pointer"
@@ -175,6 +176,10 @@ type Config struct {
 	// collects resource usage stats
 	StatsCollectionInterval time.Duration
 
+	// StatsHistorySize is the number of resource usage samples kept for each
+	// task. A value of zero or less disables the history.
+	StatsHistorySize int
+
 	// PublishNodeMetrics determines whether nomad is going to publish node
 	// level metrics to remote Telemetry sinks
 	PublishNodeMetrics bool
@@ -206,6 +211,14 @@ type Config struct {
 	// before garbage collection is triggered.
 	GCMaxAllocs int
 
+	// GCFailedAllocRetention is how long failed or OOM killed allocations
+	// are retained before they are eligible for garbage collection.
+	GCFailedAllocRetention time.Duration
+
+	// GCMaxFailedAllocs is the maximum number of failed or OOM killed
+	// allocations to retain.
+	GCMaxFailedAllocs int
+
 	// NoHostUUID disables using the host's UUID and will force generation of a
 	// random UUID.
 	NoHostUUID bool
@@ -243,6 +256,10 @@ type Config struct {
 	// instances of the plugins.
 	PluginSingletonLoader loader.PluginCatalog
 
+	// Plugins are the plugin configs the plugin loader was created with. They
+	// are used to configure plugins when the plugin directory is rescanned.
+	Plugins []*structsc.PluginConfig
+
 	// StateDBFactory is used to override stateDB implementations,
 	StateDBFactory state.NewStateDBFunc
 
@@ -313,6 +330,9 @@ type Config struct {
 
 	// Artifact configuration from the agent's config file.
 	Artifact *ArtifactConfig
+
+	// Output configuration from the agent's config file.
+	Output *OutputConfig
 }
 
 type APIListenerRegistrar interface {
@@ -740,6 +760,8 @@ func (c *Config) Copy() *Config {
 	nc.TemplateConfig = c.TemplateConfig.Copy()
 	nc.ReservableCores = slices.Clone(c.ReservableCores)
 	nc.Artifact = c.Artifact.Copy()
+	nc.Output = c.Output.Copy()
+	nc.Plugins = helper.CopySlice(c.Plugins)
 	return &nc
 }
 
@@ -751,6 +773,7 @@ func DefaultConfig() *Config {
 		ConsulConfig:            structsc.DefaultConsulConfig(),
 		Region:                  "global",
 		StatsCollectionInterval: 1 * time.Second,
+		StatsHistorySize:        600,
 		TLSConfig:               &structsc.TLSConfig{},
 		GCInterval:              1 * time.Minute,
 		GCParallelDestroys:      2,
@@ -0,0 +1,41 @@
+package config
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/nomad/nomad/structs/config"
+	"golang.org/x/exp/slices"
+)
+
+// OutputConfig is the internal readonly copy of the client agent's
+// OutputConfig.
+type OutputConfig struct {
+	UploadTimeout time.Duration
+	FileAllowlist []string
+}
+
+// OutputConfigFromAgent creates a new internal readonly copy of the client
+// agent's OutputConfig. The config should have already been validated.
+func OutputConfigFromAgent(c *config.OutputConfig) (*OutputConfig, error) {
+	uploadTimeout, err := time.ParseDuration(*c.UploadTimeout)
+	if err != nil {
+		return nil, fmt.Errorf("error parsing UploadTimeout: %w", err)
+	}
+
+	return &OutputConfig{
+		UploadTimeout: uploadTimeout,
+		FileAllowlist: slices.Clone(c.FileAllowlist),
+	}, nil
+}
+
+func (o *OutputConfig) Copy() *OutputConfig {
+	if o == nil {
+		return nil
+	}
+
+	return &OutputConfig{
+		UploadTimeout: o.UploadTimeout,
+		FileAllowlist: slices.Clone(o.FileAllowlist),
+	}
+}
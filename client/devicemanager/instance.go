@@ -24,6 +24,10 @@ const (
 	// statsBackoffLimit is the limit of the exponential backoff for collecting
 	// device statistics.
 	statsBackoffLimit = 30 * time.Minute
+
+	// reloadFingerprintTimeout is how long a reloaded device plugin has to
+	// fingerprint before the reload fails.
+	reloadFingerprintTimeout = 30 * time.Second
 )
 
 // instanceManagerConfig configures a device instance manager
@@ -310,6 +314,85 @@ func (i *instanceManager) dispense() (plugin device.DevicePlugin, err error) {
 	return device, nil
 }
 
+// reload launches the plugin from the given catalog and replaces the running
+// plugin with it once it has fingerprinted valid devices. The running plugin is
+// kept if the new plugin fails. Fingerprinting and stats collection switch to
+// the new plugin once the replaced plugin is killed.
+func (i *instanceManager) reload(catalog loader.PluginCatalog) error {
+	pluginInstance, err := catalog.Dispense(i.id.Name, i.id.PluginType, i.pluginConfig, i.logger)
+	if err != nil {
+		return fmt.Errorf("failed to start plugin: %v", err)
+	}
+
+	devicePlugin, ok := pluginInstance.Plugin().(device.DevicePlugin)
+	if !ok {
+		pluginInstance.Kill()
+		return fmt.Errorf("plugin loaded does not implement the device interface")
+	}
+
+	if err := i.reloadFingerprint(devicePlugin); err != nil {
+		pluginInstance.Kill()
+		return err
+	}
+
+	i.pluginLock.Lock()
+	old := i.plugin
+	i.plugin = pluginInstance
+	i.device = devicePlugin
+
+	// Store the reattach config
+	if c, ok := pluginInstance.ReattachConfig(); ok {
+		i.storeReattach(c)
+	}
+	i.pluginLock.Unlock()
+
+	if old != nil && !old.Exited() {
+		old.Kill()
+	}
+
+	i.logger.Info("device plugin reloaded")
+	return nil
+}
+
+// reloadFingerprint waits for the first fingerprint of a reloaded device
+// plugin and returns an error if the plugin failed to fingerprint or returned
+// invalid devices.
+func (i *instanceManager) reloadFingerprint(devicePlugin device.DevicePlugin) error {
+	ctx, cancel := context.WithTimeout(i.ctx, reloadFingerprintTimeout)
+	defer cancel()
+
+	fingerprintCh, err := devicePlugin.Fingerprint(ctx)
+	if err == device.ErrPluginDisabled {
+		return fmt.Errorf("failed to fingerprint device plugin: plugin is not enabled")
+	} else if err != nil {
+		return fmt.Errorf("failed to fingerprint device plugin: %v", err)
+	}
+
+	select {
+	case <-ctx.Done():
+		return fmt.Errorf("failed to fingerprint device plugin: %v", ctx.Err())
+	case fresp, ok := <-fingerprintCh:
+		switch {
+		case !ok || fresp == nil:
+			return fmt.Errorf("failed to fingerprint device plugin: fingerprinting stopped")
+		case fresp.Error != nil:
+			return fmt.Errorf("failed to fingerprint device plugin: %v", fresp.Error)
+		}
+
+		var validationErr multierror.Error
+		for i, d := range fresp.Devices {
+			if err := d.Validate(); err != nil {
+				multierror.Append(&validationErr, multierror.Prefix(err, fmt.Sprintf("device group %d: ", i)))
+			}
+		}
+		if err := validationErr.ErrorOrNil(); err != nil {
+			return fmt.Errorf("device plugin returned invalid devices: %v", err)
+		}
+	}
+
+	return nil
+}
+
 // cleanup shutsdown the plugin
 func (i *instanceManager) cleanup() {
 	i.shutdownLock.Lock()
@@ -19,6 +19,10 @@ import (
 	pstructs "github.com/hashicorp/nomad/plugins/shared/structs"
 )
 
+// ErrDevicePluginNotFound is returned when reloading a device plugin that isn't
+// managed by the device manager
+var ErrDevicePluginNotFound = fmt.Errorf("device plugin not found")
+
 // Manager is the interface used to manage device plugins
 type Manager interface {
 	pluginmanager.PluginManager
@@ -263,6 +267,18 @@ func (m *manager) DeviceStats(d *structs.AllocatedDeviceResource) (*device.Devic
 	return nil, UnknownDeviceErrFromAllocated("failed to collect statistics", d)
 }
 
+// Reload launches the named device plugin from the given catalog and replaces
+// the running device plugin once the new plugin has fingerprinted. The running
+// device plugin is kept if the new plugin fails to launch or fingerprint.
+func (m *manager) Reload(name string, catalog loader.PluginCatalog) error {
+	instance, ok := m.instances[loader.PluginID{Name: name, PluginType: base.PluginTypeDevice}]
+	if !ok {
+		return ErrDevicePluginNotFound
+	}
+
+	return instance.reload(catalog)
+}
+
 // cleanupStalePlugins reads the device managers state and shuts down any
 // previously launched plugin.
 func (m *manager) cleanupStalePlugins() error {
@@ -490,3 +490,72 @@ func TestManager_Run_ShutdownOld(t *testing.T) {
 		t.Fatal(err)
 	})
 }
+
+// Test that reloading a plugin replaces it only once the new plugin has
+// fingerprinted
+func TestManager_Reload(t *testing.T) {
+	ci.Parallel(t)
+	require := require.New(t)
+
+	config, _, catalog := baseTestConfig(t)
+	nvidiaAndIntelDefaultPlugins(catalog)
+
+	m := New(config)
+	m.Run()
+	defer m.Shutdown()
+
+	// Wait till we get a fingerprint result
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	<-m.WaitForFirstFingerprint(ctx)
+	require.NoError(ctx.Err())
+
+	oldNvidia, err := catalog.Dispense("nvidia", base.PluginTypeDevice, &base.AgentConfig{}, config.Logger)
+	require.NoError(err)
+
+	// Reloading with a plugin that fails to fingerprint keeps the old plugin
+	badInfo := pluginInfoResponse("nvidia")
+	badCatalog := &loader.MockCatalog{}
+	configureCatalogWith(badCatalog, map[*base.PluginInfoResponse]loader.PluginInstance{
+		badInfo: loader.MockBasicExternalPlugin(&device.MockDevicePlugin{
+			MockPlugin: &base.MockPlugin{
+				PluginInfoF:   base.StaticInfo(badInfo),
+				ConfigSchemaF: base.TestConfigSchema(),
+				SetConfigF:    base.NoopSetConfig(),
+			},
+			FingerprintF: device.ErrorChFingerprinter(fmt.Errorf("bad fingerprint")),
+		}, device.ApiVersion010),
+	})
+	badPlugin, err := badCatalog.Dispense("nvidia", base.PluginTypeDevice, &base.AgentConfig{}, config.Logger)
+	require.NoError(err)
+
+	require.Error(m.Reload("nvidia", badCatalog))
+	require.True(badPlugin.Exited())
+	require.False(oldNvidia.Exited())
+
+	// Reloading with a working plugin replaces the old plugin
+	newInfo := pluginInfoResponse("nvidia")
+	newInfo.PluginVersion = "v0.0.2"
+	newCatalog := &loader.MockCatalog{}
+	configureCatalogWith(newCatalog, map[*base.PluginInfoResponse]loader.PluginInstance{
+		newInfo: loader.MockBasicExternalPlugin(&device.MockDevicePlugin{
+			MockPlugin: &base.MockPlugin{
+				PluginInfoF:   base.StaticInfo(newInfo),
+				ConfigSchemaF: base.TestConfigSchema(),
+				SetConfigF:    base.NoopSetConfig(),
+			},
+			FingerprintF: device.StaticFingerprinter([]*device.DeviceGroup{nvidiaDeviceGroup}),
+			ReserveF:     deviceReserveFn,
+			StatsF:       device.StaticStats([]*device.DeviceGroupStats{nvidiaDeviceGroupStats}),
+		}, device.ApiVersion010),
+	})
+	newPlugin, err := newCatalog.Dispense("nvidia", base.PluginTypeDevice, &base.AgentConfig{}, config.Logger)
+	require.NoError(err)
+
+	require.NoError(m.Reload("nvidia", newCatalog))
+	require.True(oldNvidia.Exited())
+	require.False(newPlugin.Exited())
+
+	// Unknown plugins can't be reloaded
+	require.ErrorIs(m.Reload("amd", newCatalog), ErrDevicePluginNotFound)
+}
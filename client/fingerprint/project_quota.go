@@ -0,0 +1,43 @@
+package fingerprint
+
+import (
+	"github.com/hashicorp/go-hclog"
+	"github.com/hashicorp/nomad/client/allocdir"
+)
+
+const (
+	projectQuotaKey = "storage.project_quota"
+)
+
+// ProjectQuotaFingerprint is used to fingerprint whether the filesystem of the
+// client's alloc dir supports project quotas, which are used to enforce the
+// ephemeral_disk size of allocations.
+type ProjectQuotaFingerprint struct {
+	StaticFingerprinter
+	logger   hclog.Logger
+	detector func(dir string) (bool, error)
+}
+
+func NewProjectQuotaFingerprint(logger hclog.Logger) Fingerprint {
+	return &ProjectQuotaFingerprint{
+		logger:   logger.Named("project_quota"),
+		detector: allocdir.ProjectQuotasSupported,
+	}
+}
+
+func (f *ProjectQuotaFingerprint) Fingerprint(req *FingerprintRequest, resp *FingerprintResponse) error {
+	if req.Config.AllocDir == "" {
+		return nil
+	}
+
+	supported, err := f.detector(req.Config.AllocDir)
+	if err != nil {
+		f.logger.Warn("failed to fingerprint project quota support", "error", err)
+		supported = false
+	}
+	if supported {
+		resp.AddAttribute(projectQuotaKey, "true")
+	}
+	resp.Detected = true
+	return nil
+}
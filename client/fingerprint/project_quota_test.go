@@ -0,0 +1,42 @@
+package fingerprint
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/hashicorp/nomad/ci"
+	"github.com/hashicorp/nomad/client/config"
+	"github.com/hashicorp/nomad/helper/testlog"
+	"github.com/shoenig/test/must"
+)
+
+func TestProjectQuotaFingerprint(t *testing.T) {
+	ci.Parallel(t)
+
+	cases := []struct {
+		name      string
+		supported bool
+		err       error
+		exp       string
+	}{
+		{name: "supported", supported: true, exp: "true"},
+		{name: "unsupported", supported: false},
+		{name: "error", err: errors.New("oops")},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			f := NewProjectQuotaFingerprint(testlog.HCLogger(t))
+			f.(*ProjectQuotaFingerprint).detector = func(dir string) (bool, error) {
+				must.Eq(t, "/var/lib/nomad/alloc", dir)
+				return tc.supported, tc.err
+			}
+
+			request := &FingerprintRequest{Config: &config.Config{AllocDir: "/var/lib/nomad/alloc"}}
+			var response FingerprintResponse
+			must.NoError(t, f.Fingerprint(request, &response))
+			must.True(t, response.Detected)
+			must.Eq(t, tc.exp, response.Attributes[projectQuotaKey])
+		})
+	}
+}
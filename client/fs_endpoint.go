@@ -1,8 +1,11 @@
 package client
 
 import (
+	"bufio"
 	"bytes"
+	"compress/gzip"
 	"context"
+	"errors"
 	"fmt"
 	"io"
 	"math"
@@ -62,6 +65,10 @@ const (
 	deleteEvent   = "file deleted"
 	truncateEvent = "file truncated"
 
+	// archiveDefaultLimit is the maximum number of bytes of files archived
+	// when the request doesn't set a limit.
+	archiveDefaultLimit = 1024 * 1024 * 1024
+
 	// OriginStart and OriginEnd are the available parameters for the origin
 	// argument when streaming a file. They respectively offset from the start
 	// and end of a file.
@@ -79,6 +86,7 @@ func NewFileSystemEndpoint(c *Client) *FileSystem {
 	f := &FileSystem{c}
 	f.c.streamingRpcs.Register("FileSystem.Logs", f.logs)
 	f.c.streamingRpcs.Register("FileSystem.Stream", f.stream)
+	f.c.streamingRpcs.Register("FileSystem.Archive", f.archive)
 	return f
 }
 
@@ -108,7 +116,7 @@ func (f *FileSystem) List(args *cstructs.FsListRequest, reply *cstructs.FsListRe
 	// Check namespace read-fs permission.
 	if aclObj, err := f.c.ResolveToken(args.QueryOptions.AuthToken); err != nil {
 		return err
-	} else if aclObj != nil && !aclObj.AllowNsOp(alloc.Namespace, acl.NamespaceCapabilityReadFS) {
+	} else if aclObj != nil && !aclObj.AllowJobOp(alloc.Namespace, alloc.JobID, acl.NamespaceCapabilityReadFS) {
 		return structs.ErrPermissionDenied
 	}
 
@@ -137,7 +145,7 @@ func (f *FileSystem) Stat(args *cstructs.FsStatRequest, reply *cstructs.FsStatRe
 	// Check namespace read-fs permission.
 	if aclObj, err := f.c.ResolveToken(args.QueryOptions.AuthToken); err != nil {
 		return err
-	} else if aclObj != nil && !aclObj.AllowNsOp(alloc.Namespace, acl.NamespaceCapabilityReadFS) {
+	} else if aclObj != nil && !aclObj.AllowJobOp(alloc.Namespace, alloc.JobID, acl.NamespaceCapabilityReadFS) {
 		return structs.ErrPermissionDenied
 	}
 
@@ -184,7 +192,7 @@ func (f *FileSystem) stream(conn io.ReadWriteCloser) {
 	if aclObj, err := f.c.ResolveToken(req.QueryOptions.AuthToken); err != nil {
 		handleStreamResultError(err, pointer.Of(int64(403)), encoder)
 		return
-	} else if aclObj != nil && !aclObj.AllowNsOp(alloc.Namespace, acl.NamespaceCapabilityReadFS) {
+	} else if aclObj != nil && !aclObj.AllowJobOp(alloc.Namespace, alloc.JobID, acl.NamespaceCapabilityReadFS) {
 		handleStreamResultError(structs.ErrPermissionDenied, pointer.Of(int64(403)), encoder)
 		return
 	}
@@ -360,8 +368,8 @@ func (f *FileSystem) logs(conn io.ReadWriteCloser) {
 		handleStreamResultError(err, nil, encoder)
 		return
 	} else if aclObj != nil {
-		readfs := aclObj.AllowNsOp(alloc.Namespace, acl.NamespaceCapabilityReadFS)
-		logs := aclObj.AllowNsOp(alloc.Namespace, acl.NamespaceCapabilityReadLogs)
+		readfs := aclObj.AllowJobOp(alloc.Namespace, alloc.JobID, acl.NamespaceCapabilityReadFS)
+		logs := aclObj.AllowJobOp(alloc.Namespace, alloc.JobID, acl.NamespaceCapabilityReadLogs)
 		if !readfs && !logs {
 			handleStreamResultError(structs.ErrPermissionDenied, nil, encoder)
 			return
@@ -518,6 +526,116 @@ OUTER:
 	}
 }
 
+// archive is used to stream a tar archive of a directory in an allocation's
+// directory.
+func (f *FileSystem) archive(conn io.ReadWriteCloser) {
+	defer metrics.MeasureSince([]string{"client", "file_system", "archive"}, time.Now())
+	defer conn.Close()
+
+	// Decode the arguments
+	var req cstructs.FsArchiveRequest
+	decoder := codec.NewDecoder(conn, structs.MsgpackHandle)
+	encoder := codec.NewEncoder(conn, structs.MsgpackHandle)
+
+	if err := decoder.Decode(&req); err != nil {
+		handleStreamResultError(err, pointer.Of(int64(500)), encoder)
+		return
+	}
+
+	if req.AllocID == "" {
+		handleStreamResultError(allocIDNotPresentErr, pointer.Of(int64(400)), encoder)
+		return
+	}
+	alloc, err := f.c.GetAlloc(req.AllocID)
+	if err != nil {
+		handleStreamResultError(structs.NewErrUnknownAllocation(req.AllocID), pointer.Of(int64(404)), encoder)
+		return
+	}
+
+	// Check read permissions. The secrets dir is only archived if the token
+	// could read it by executing a command in the allocation.
+	includeSecrets := true
+	if aclObj, err := f.c.ResolveToken(req.QueryOptions.AuthToken); err != nil {
+		handleStreamResultError(err, pointer.Of(int64(403)), encoder)
+		return
+	} else if aclObj != nil {
+		if !aclObj.AllowJobOp(alloc.Namespace, alloc.JobID, acl.NamespaceCapabilityReadFS) {
+			handleStreamResultError(structs.ErrPermissionDenied, pointer.Of(int64(403)), encoder)
+			return
+		}
+		includeSecrets = aclObj.AllowJobOp(alloc.Namespace, alloc.JobID, acl.NamespaceCapabilityAllocExec)
+	}
+
+	// Validate the arguments
+	if req.Path == "" {
+		handleStreamResultError(pathNotPresentErr, pointer.Of(int64(400)), encoder)
+		return
+	}
+	if req.Limit <= 0 {
+		req.Limit = archiveDefaultLimit
+	}
+
+	fs, err := f.c.GetAllocFS(req.AllocID)
+	if err != nil {
+		code := pointer.Of(int64(500))
+		if structs.IsErrUnknownAllocation(err) {
+			code = pointer.Of(int64(404))
+		}
+
+		handleStreamResultError(err, code, encoder)
+		return
+	}
+
+	if _, err := fs.Stat(req.Path); err != nil {
+		code := pointer.Of(int64(400))
+		if os.IsNotExist(err) {
+			code = pointer.Of(int64(404))
+		}
+		handleStreamResultError(err, code, encoder)
+		return
+	}
+
+	// Buffer the archive into frames, optionally compressing it first
+	frames := &archiveFrameWriter{conn: conn, encoder: encoder}
+	buffered := bufio.NewWriterSize(frames, streamFrameSize)
+	var w io.Writer = buffered
+	var gz *gzip.Writer
+	if req.Compress {
+		gz = gzip.NewWriter(buffered)
+		w = gz
+	}
+
+	err = fs.Archive(req.Path, w, includeSecrets, req.Limit)
+	if err == nil && gz != nil {
+		err = gz.Close()
+	}
+	if err == nil {
+		err = buffered.Flush()
+	}
+	if err != nil {
+		code := pointer.Of(int64(500))
+		if errors.Is(err, allocdir.ErrArchiveLimitExceeded) {
+			code = pointer.Of(int64(400))
+		}
+		handleStreamResultError(err, code, encoder)
+		return
+	}
+}
+
+// archiveFrameWriter sends each write as the payload of a stream frame.
+type archiveFrameWriter struct {
+	conn    io.Writer
+	encoder *codec.Encoder
+}
+
+func (w *archiveFrameWriter) Write(p []byte) (int, error) {
+	if err := w.encoder.Encode(&cstructs.StreamErrWrapper{Payload: p}); err != nil {
+		return 0, err
+	}
+	w.encoder.Reset(w.conn)
+	return len(p), nil
+}
+
 // logsImpl is used to stream the logs of a the given task. Output is sent on
 // the passed frames channel and the method will return on EOF if follow is not
 // true otherwise when the context is cancelled or on an error.
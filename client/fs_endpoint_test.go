@@ -1,6 +1,9 @@
 package client
 
 import (
+	"archive/tar"
+	"bytes"
+	"compress/gzip"
 	"context"
 	"fmt"
 	"io"
@@ -622,6 +625,210 @@ OUTER:
 	})
 }
 
+func TestFS_Archive(t *testing.T) {
+	ci.Parallel(t)
+
+	// Start a server and client
+	s, cleanupS := nomad.TestServer(t, nil)
+	defer cleanupS()
+	testutil.WaitForLeader(t, s.RPC)
+
+	c, cleanupC := TestClient(t, func(c *config.Config) {
+		c.Servers = []string{s.GetConfig().RPCAddr.String()}
+	})
+	defer cleanupC()
+
+	expected := "Hello from the other side"
+	job := mock.BatchJob()
+	job.TaskGroups[0].Count = 1
+	job.TaskGroups[0].Tasks[0].Config = map[string]interface{}{
+		"run_for":       "2s",
+		"stdout_string": expected,
+	}
+
+	// Wait for alloc to be running
+	alloc := testutil.WaitForRunning(t, s.RPC, job)[0]
+
+	testutil.WaitForResult(func() (bool, error) {
+		files, err := testFSArchive(t, c, &cstructs.FsArchiveRequest{
+			AllocID:      alloc.ID,
+			Path:         "alloc/logs",
+			Compress:     true,
+			QueryOptions: structs.QueryOptions{Region: "global"},
+		})
+		if err != nil {
+			return false, err
+		}
+		if got := files["alloc/logs/web.stdout.0"]; got != expected {
+			return false, fmt.Errorf("expected %q in archive, got %q", expected, got)
+		}
+		return true, nil
+	}, func(err error) {
+		t.Fatal(err)
+	})
+
+	// Archives larger than the limit are rejected
+	_, err := testFSArchive(t, c, &cstructs.FsArchiveRequest{
+		AllocID:      alloc.ID,
+		Path:         "alloc/logs",
+		Limit:        1,
+		QueryOptions: structs.QueryOptions{Region: "global"},
+	})
+	require.ErrorContains(t, err, allocdir.ErrArchiveLimitExceeded.Error())
+
+	// Paths can't escape the alloc dir
+	_, err = testFSArchive(t, c, &cstructs.FsArchiveRequest{
+		AllocID:      alloc.ID,
+		Path:         "../",
+		QueryOptions: structs.QueryOptions{Region: "global"},
+	})
+	require.ErrorContains(t, err, "escapes")
+}
+
+func TestFS_Archive_ACL(t *testing.T) {
+	ci.Parallel(t)
+
+	// Start a server
+	s, root, cleanupS := nomad.TestACLServer(t, nil)
+	defer cleanupS()
+	testutil.WaitForLeader(t, s.RPC)
+
+	client, cleanup := TestClient(t, func(c *config.Config) {
+		c.ACLEnabled = true
+		c.Servers = []string{s.GetConfig().RPCAddr.String()}
+	})
+	defer cleanup()
+
+	policyBad := mock.NamespacePolicy("other", "", []string{acl.NamespaceCapabilityReadFS})
+	tokenBad := mock.CreatePolicyAndToken(t, s.State(), 1005, "invalid", policyBad)
+
+	policyReadFS := mock.NamespacePolicy(structs.DefaultNamespace, "",
+		[]string{acl.NamespaceCapabilityReadFS})
+	tokenReadFS := mock.CreatePolicyAndToken(t, s.State(), 1007, "read-fs", policyReadFS)
+
+	policyExec := mock.NamespacePolicy(structs.DefaultNamespace, "",
+		[]string{acl.NamespaceCapabilityReadFS, acl.NamespaceCapabilityAllocExec})
+	tokenExec := mock.CreatePolicyAndToken(t, s.State(), 1009, "alloc-exec", policyExec)
+
+	job := mock.BatchJob()
+	job.TaskGroups[0].Count = 1
+	job.TaskGroups[0].Tasks[0].Config = map[string]interface{}{
+		"run_for": "20s",
+	}
+
+	// Wait for client to be running job
+	alloc := testutil.WaitForRunningWithToken(t, s.RPC, job, root.SecretID)[0]
+
+	secretsDir := filepath.Join(client.config.AllocDir, alloc.ID, "web", allocdir.TaskSecrets)
+	require.NoError(t, os.WriteFile(filepath.Join(secretsDir, "token"), []byte("secret"), 0600))
+
+	cases := []struct {
+		Name          string
+		Token         string
+		ExpectedError string
+		Secrets       bool
+	}{
+		{
+			Name:          "bad token",
+			Token:         tokenBad.SecretID,
+			ExpectedError: structs.ErrPermissionDenied.Error(),
+		},
+		{
+			Name:  "read-fs token",
+			Token: tokenReadFS.SecretID,
+		},
+		{
+			Name:    "alloc-exec token",
+			Token:   tokenExec.SecretID,
+			Secrets: true,
+		},
+		{
+			Name:    "root token",
+			Token:   root.SecretID,
+			Secrets: true,
+		},
+	}
+
+	for _, c := range cases {
+		t.Run(c.Name, func(t *testing.T) {
+			files, err := testFSArchive(t, client, &cstructs.FsArchiveRequest{
+				AllocID: alloc.ID,
+				Path:    "web",
+				QueryOptions: structs.QueryOptions{
+					Namespace: structs.DefaultNamespace,
+					Region:    "global",
+					AuthToken: c.Token,
+				},
+			})
+			if c.ExpectedError != "" {
+				require.ErrorContains(t, err, c.ExpectedError)
+				return
+			}
+			require.NoError(t, err)
+			require.Contains(t, files, "web/local/")
+
+			if c.Secrets {
+				require.Equal(t, "secret", files["web/secrets/token"])
+			} else {
+				require.NotContains(t, files, "web/secrets/token")
+			}
+		})
+	}
+}
+
+// testFSArchive makes a FileSystem.Archive request and returns the contents
+// of the files in the archive.
+func testFSArchive(t *testing.T, c *Client, req *cstructs.FsArchiveRequest) (map[string]string, error) {
+	handler, err := c.StreamingRpcHandler("FileSystem.Archive")
+	require.NoError(t, err)
+
+	p1, p2 := net.Pipe()
+	defer p1.Close()
+	defer p2.Close()
+
+	go handler(p2)
+
+	encoder := codec.NewEncoder(p1, structs.MsgpackHandle)
+	require.NoError(t, encoder.Encode(req))
+
+	// Read the whole archive before parsing it
+	var archive bytes.Buffer
+	decoder := codec.NewDecoder(p1, structs.MsgpackHandle)
+	for {
+		var msg cstructs.StreamErrWrapper
+		if err := decoder.Decode(&msg); err != nil {
+			if err == io.EOF || strings.Contains(err.Error(), "closed") {
+				break
+			}
+			return nil, err
+		}
+		if msg.Error != nil {
+			return nil, msg.Error
+		}
+		archive.Write(msg.Payload)
+	}
+
+	var r io.Reader = &archive
+	if req.Compress {
+		gz, err := gzip.NewReader(r)
+		require.NoError(t, err)
+		r = gz
+	}
+
+	files := make(map[string]string)
+	tr := tar.NewReader(r)
+	for {
+		hdr, err := tr.Next()
+		if err == io.EOF {
+			return files, nil
+		}
+		require.NoError(t, err)
+		content, err := io.ReadAll(tr)
+		require.NoError(t, err)
+		files[hdr.Name] = string(content)
+	}
+}
+
 type ReadWriteCloseChecker struct {
 	io.ReadWriteCloser
 	l      sync.Mutex
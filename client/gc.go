@@ -3,10 +3,12 @@ package client
 import (
 	"container/heap"
 	"fmt"
+	"sort"
 	"sync"
 	"time"
 
 	hclog "github.com/hashicorp/go-hclog"
+	arstate "github.com/hashicorp/nomad/client/allocrunner/state"
 	"github.com/hashicorp/nomad/client/stats"
 	"github.com/hashicorp/nomad/nomad/structs"
 )
@@ -26,6 +28,16 @@ type GCConfig struct {
 	Interval            time.Duration
 	ReservedDiskMB      int
 	ParallelDestroys    int
+
+	// FailedAllocRetention is how long failed or OOM killed allocations are
+	// retained after they stop before they are eligible for garbage
+	// collection. Zero disables retention by age.
+	FailedAllocRetention time.Duration
+
+	// MaxFailedAllocs is the maximum number of failed or OOM killed
+	// allocations to retain, newest first. Zero disables retention by
+	// count.
+	MaxFailedAllocs int
 }
 
 // AllocCounter is used by AllocGarbageCollector to discover how many un-GC'd
@@ -57,6 +69,16 @@ type AllocGarbageCollector struct {
 	// triggerCh is ticked by the Trigger method to cause a GC
 	triggerCh chan struct{}
 
+	// pinned allocations are never garbage collected until unpinned
+	pinned map[string]struct{}
+
+	// removed allocations have been garbage collected by the servers but
+	// are retained until they are unpinned or their retention expires
+	removed map[string]struct{}
+
+	// retainLock guards pinned and removed
+	retainLock sync.Mutex
+
 	logger hclog.Logger
 }
 
@@ -80,6 +102,8 @@ func NewAllocGarbageCollector(logger hclog.Logger, statsCollector stats.NodeStat
 		destroyCh:      make(chan struct{}, config.ParallelDestroys),
 		shutdownCh:     make(chan struct{}),
 		triggerCh:      make(chan struct{}, 1),
+		pinned:         make(map[string]struct{}),
+		removed:        make(map[string]struct{}),
 	}
 
 	return gc
@@ -100,6 +124,8 @@ func (a *AllocGarbageCollector) Run() {
 		if err := a.keepUsageBelowThreshold(); err != nil {
 			a.logger.Error("error garbage collecting allocations", "error", err)
 		}
+
+		a.collectRemoved()
 	}
 }
 
@@ -131,6 +157,7 @@ func (a *AllocGarbageCollector) keepUsageBelowThreshold() error {
 		diskStats := a.statsCollector.Stats().AllocDirStats
 		reason := ""
 		logf := a.logger.Warn
+		diskPressure := true
 
 		liveAllocs := a.allocCounter.NumAllocs()
 
@@ -147,6 +174,7 @@ func (a *AllocGarbageCollector) keepUsageBelowThreshold() error {
 				logf = a.logger.Info
 			}
 			reason = fmt.Sprintf("number of allocations (%d) is over the limit (%d)", liveAllocs, a.config.MaxAllocs)
+			diskPressure = false
 		}
 
 		if reason == "" {
@@ -154,8 +182,12 @@ func (a *AllocGarbageCollector) keepUsageBelowThreshold() error {
 			break
 		}
 
-		// Collect an allocation
-		gcAlloc := a.allocRunners.Pop()
+		// Collect an allocation, only giving up retained failed allocations
+		// when running out of disk
+		gcAlloc := a.allocRunners.PopOldest(a.retained())
+		if gcAlloc == nil && diskPressure {
+			gcAlloc = a.allocRunners.PopOldest(a.pinnedAllocs())
+		}
 		if gcAlloc == nil {
 			logf("garbage collection skipped because no terminal allocations", "reason", reason)
 			break
@@ -182,6 +214,11 @@ func (a *AllocGarbageCollector) destroyAllocRunner(allocID string, ar AllocRunne
 
 	ar.Destroy()
 
+	a.retainLock.Lock()
+	delete(a.pinned, allocID)
+	delete(a.removed, allocID)
+	a.retainLock.Unlock()
+
 	select {
 	case <-ar.DestroyCh():
 	case <-a.shutdownCh:
@@ -198,8 +235,14 @@ func (a *AllocGarbageCollector) Stop() {
 }
 
 // Collect garbage collects a single allocation on a node. Returns true if
-// alloc was found and garbage collected; otherwise false.
+// alloc was found and garbage collected; otherwise false. Pinned allocations
+// are never collected.
 func (a *AllocGarbageCollector) Collect(allocID string) bool {
+	if a.IsPinned(allocID) {
+		a.logger.Debug("not garbage collecting pinned alloc", "alloc_id", allocID)
+		return false
+	}
+
 	gcAlloc := a.allocRunners.Remove(allocID)
 	if gcAlloc == nil {
 		a.logger.Debug("alloc was already garbage collected", "alloc_id", allocID)
@@ -210,8 +253,58 @@ func (a *AllocGarbageCollector) Collect(allocID string) bool {
 	return true
 }
 
-// CollectAll garbage collects all terminated allocations on a node
+// RetainRemoved returns true if an allocation which has been garbage
+// collected by the servers is retained, because it is pinned or a retained
+// failed allocation. Retained allocations are collected once they are no longer
+// pinned or their retention expires.
+func (a *AllocGarbageCollector) RetainRemoved(allocID string) bool {
+	if _, ok := a.retained()[allocID]; !ok {
+		return false
+	}
+
+	a.logger.Info("retaining allocation removed by the servers", "alloc_id", allocID)
+	a.retainLock.Lock()
+	a.removed[allocID] = struct{}{}
+	a.retainLock.Unlock()
+	return true
+}
+
+// collectRemoved garbage collects allocations removed by the servers which
+// are no longer retained.
+func (a *AllocGarbageCollector) collectRemoved() {
+	retained := a.retained()
+
+	a.retainLock.Lock()
+	var expired []string
+	for allocID := range a.removed {
+		if _, ok := retained[allocID]; !ok {
+			expired = append(expired, allocID)
+		}
+	}
+	a.retainLock.Unlock()
+
+	for _, allocID := range expired {
+		select {
+		case <-a.shutdownCh:
+			return
+		default:
+		}
+
+		gcAlloc := a.allocRunners.Remove(allocID)
+		if gcAlloc == nil {
+			a.retainLock.Lock()
+			delete(a.removed, allocID)
+			a.retainLock.Unlock()
+			continue
+		}
+		a.destroyAllocRunner(allocID, gcAlloc.allocRunner, "retention of allocation removed by the servers ended")
+	}
+}
+
+// CollectAll garbage collects all terminated allocations on a node, except
+// for pinned allocations.
 func (a *AllocGarbageCollector) CollectAll() {
+	pinned := a.pinnedAllocs()
 	for {
 		select {
 		case <-a.shutdownCh:
@@ -219,7 +312,7 @@ func (a *AllocGarbageCollector) CollectAll() {
 		default:
 		}
 
-		gcAlloc := a.allocRunners.Pop()
+		gcAlloc := a.allocRunners.PopOldest(pinned)
 		if gcAlloc == nil {
 			return
 		}
@@ -245,7 +338,7 @@ func (a *AllocGarbageCollector) MakeRoomFor(allocations []*structs.Allocation) e
 		default:
 		}
 
-		gcAlloc := a.allocRunners.Pop()
+		gcAlloc := a.allocRunners.PopOldest(a.retained())
 		if gcAlloc == nil {
 			// It's fine if we can't lower below the limit here as
 			// we'll keep trying to drop below the limit with each
@@ -310,7 +403,10 @@ func (a *AllocGarbageCollector) MakeRoomFor(allocations []*structs.Allocation) e
 			}
 		}
 
-		gcAlloc := a.allocRunners.Pop()
+		gcAlloc := a.allocRunners.PopOldest(a.retained())
+		if gcAlloc == nil {
+			gcAlloc = a.allocRunners.PopOldest(a.pinnedAllocs())
+		}
 		if gcAlloc == nil {
 			break
 		}
@@ -341,6 +437,127 @@ func (a *AllocGarbageCollector) MarkForCollection(allocID string, ar AllocRunner
 	}
 }
 
+// Pin exempts an allocation from garbage collection until it is unpinned.
+func (a *AllocGarbageCollector) Pin(allocID string) {
+	a.retainLock.Lock()
+	defer a.retainLock.Unlock()
+	a.pinned[allocID] = struct{}{}
+}
+
+// Unpin makes a pinned allocation eligible for garbage collection again.
+func (a *AllocGarbageCollector) Unpin(allocID string) {
+	a.retainLock.Lock()
+	_, ok := a.pinned[allocID]
+	delete(a.pinned, allocID)
+	a.retainLock.Unlock()
+
+	// Collect the allocation if it was only kept because of the pin
+	if ok {
+		a.Trigger()
+	}
+}
+
+// IsPinned returns true if the allocation is pinned.
+func (a *AllocGarbageCollector) IsPinned(allocID string) bool {
+	a.retainLock.Lock()
+	defer a.retainLock.Unlock()
+	_, ok := a.pinned[allocID]
+	return ok
+}
+
+// IsMarked returns true if the allocation is tracked for garbage collection.
+func (a *AllocGarbageCollector) IsMarked(allocID string) bool {
+	return a.allocRunners.Get(allocID) != nil
+}
+
+// pinnedAllocs returns a copy of the set of pinned allocations.
+func (a *AllocGarbageCollector) pinnedAllocs() map[string]struct{} {
+	a.retainLock.Lock()
+	defer a.retainLock.Unlock()
+
+	pinned := make(map[string]struct{}, len(a.pinned))
+	for allocID := range a.pinned {
+		pinned[allocID] = struct{}{}
+	}
+	return pinned
+}
+
+// retained returns the set of allocations which should not be garbage
+// collected: pinned allocations and failed allocations within the failed
+// allocation retention policy.
+func (a *AllocGarbageCollector) retained() map[string]struct{} {
+	retained := a.pinnedAllocs()
+	if a.config.FailedAllocRetention <= 0 && a.config.MaxFailedAllocs <= 0 {
+		return retained
+	}
+
+	type failedAlloc struct {
+		allocID  string
+		finished time.Time
+	}
+
+	var failed []failedAlloc
+	for _, gcAlloc := range a.allocRunners.Items() {
+		state := gcAlloc.allocRunner.AllocState()
+		if !allocFailed(state) {
+			continue
+		}
+		finished := allocFinishedAt(state)
+		if finished.IsZero() {
+			finished = gcAlloc.timeStamp
+		}
+		failed = append(failed, failedAlloc{gcAlloc.allocID, finished})
+	}
+
+	// Retain the most recently failed allocations first
+	sort.Slice(failed, func(i, j int) bool {
+		return failed[i].finished.After(failed[j].finished)
+	})
+
+	now := time.Now()
+	for i, f := range failed {
+		if a.config.MaxFailedAllocs > 0 && i >= a.config.MaxFailedAllocs {
+			break
+		}
+		if a.config.FailedAllocRetention > 0 && now.Sub(f.finished) > a.config.FailedAllocRetention {
+			break
+		}
+		retained[f.allocID] = struct{}{}
+	}
+	return retained
+}
+
+// allocFailed returns true if the allocation failed or any of its tasks were
+// OOM killed.
+func allocFailed(state *arstate.State) bool {
+	if state == nil {
+		return false
+	}
+	if state.ClientStatus == structs.AllocClientStatusFailed {
+		return true
+	}
+	for _, ts := range state.TaskStates {
+		for _, ev := range ts.Events {
+			if ev.Details["oom_killed"] == "true" {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// allocFinishedAt returns the time the last task of the allocation finished,
+// or the zero time if it is unknown.
+func allocFinishedAt(state *arstate.State) time.Time {
+	var finished time.Time
+	for _, ts := range state.TaskStates {
+		if ts.FinishedAt.After(finished) {
+			finished = ts.FinishedAt
+		}
+	}
+	return finished
+}
+
 // GCAlloc wraps an allocation runner and an index enabling it to be used within
 // a PQ
 type GCAlloc struct {
@@ -431,6 +648,48 @@ func (i *IndexedGCAllocPQ) Pop() *GCAlloc {
 	return gcAlloc
 }
 
+// PopOldest removes and returns the oldest alloc runner which is not in
+// the skip set. Returns nil if there is none.
+func (i *IndexedGCAllocPQ) PopOldest(skip map[string]struct{}) *GCAlloc {
+	i.pqLock.Lock()
+	defer i.pqLock.Unlock()
+
+	var oldest *GCAlloc
+	for _, gcAlloc := range i.heap {
+		if _, ok := skip[gcAlloc.allocID]; ok {
+			continue
+		}
+		if oldest == nil || gcAlloc.timeStamp.Before(oldest.timeStamp) {
+			oldest = gcAlloc
+		}
+	}
+	if oldest == nil {
+		return nil
+	}
+
+	heap.Remove(&i.heap, oldest.index)
+	delete(i.index, oldest.allocID)
+	return oldest
+}
+
+// Get returns the alloc runner in the GC queue or nil if it doesn't exist.
+func (i *IndexedGCAllocPQ) Get(allocID string) *GCAlloc {
+	i.pqLock.Lock()
+	defer i.pqLock.Unlock()
+
+	return i.index[allocID]
+}
+
+// Items returns a snapshot of the alloc runners in the GC queue.
+func (i *IndexedGCAllocPQ) Items() []*GCAlloc {
+	i.pqLock.Lock()
+	defer i.pqLock.Unlock()
+
+	items := make([]*GCAlloc, len(i.heap))
+	copy(items, i.heap)
+	return items
+}
+
 // Remove alloc from GC. Returns nil if alloc doesn't exist.
 func (i *IndexedGCAllocPQ) Remove(allocID string) *GCAlloc {
 	i.pqLock.Lock()
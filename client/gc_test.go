@@ -585,3 +585,107 @@ func TestAllocGarbageCollector_UsedPercentThreshold(t *testing.T) {
 		t.Fatalf("gcAlloc: %v", gcAlloc)
 	}
 }
+
+// testTerminalAllocRunner returns a running alloc runner for a batch alloc
+// which has completed successfully or failed.
+func testTerminalAllocRunner(t *testing.T, failed bool) (AllocRunner, func()) {
+	alloc := mock.BatchAlloc()
+	tg := alloc.Job.TaskGroups[0]
+	tg.RestartPolicy.Attempts = 0
+	tg.RestartPolicy.Mode = structs.RestartPolicyModeFail
+	tg.Tasks[0].Config["run_for"] = "1ms"
+
+	status := structs.AllocClientStatusComplete
+	if failed {
+		tg.Tasks[0].Config["exit_code"] = 1
+		status = structs.AllocClientStatusFailed
+	}
+
+	ar, cleanup := allocrunner.TestAllocRunnerFromAlloc(t, alloc)
+	go ar.Run()
+	allocrunner.WaitForClientState(t, ar, status)
+	return ar, cleanup
+}
+
+func TestAllocGarbageCollector_RetainFailed(t *testing.T) {
+	ci.Parallel(t)
+
+	logger := testlog.HCLogger(t)
+	statsCollector := &MockStatsCollector{}
+	conf := gcConfig()
+	conf.MaxAllocs = 0
+	conf.MaxFailedAllocs = 1
+	gc := NewAllocGarbageCollector(logger, statsCollector, &MockAllocCounter{allocs: 3}, conf)
+
+	failed1, cleanup1 := testTerminalAllocRunner(t, true)
+	defer cleanup1()
+	failed2, cleanup2 := testTerminalAllocRunner(t, true)
+	defer cleanup2()
+	complete, cleanup3 := testTerminalAllocRunner(t, false)
+	defer cleanup3()
+
+	gc.MarkForCollection(failed1.Alloc().ID, failed1)
+	gc.MarkForCollection(failed2.Alloc().ID, failed2)
+	gc.MarkForCollection(complete.Alloc().ID, complete)
+
+	statsCollector.availableValues = []uint64{1000}
+	statsCollector.usedPercents = []float64{20}
+	statsCollector.inodePercents = []float64{10}
+
+	// Only the most recently failed alloc is retained when over the max
+	// number of allocs
+	require.NoError(t, gc.keepUsageBelowThreshold())
+	require.Equal(t, 1, gc.allocRunners.Length())
+	require.NotNil(t, gc.allocRunners.Get(failed2.Alloc().ID))
+
+	// Failed allocs are no longer retained after the retention period
+	gc.config.FailedAllocRetention = time.Hour
+	require.Contains(t, gc.retained(), failed2.Alloc().ID)
+	gc.config.FailedAllocRetention = time.Nanosecond
+	require.NotContains(t, gc.retained(), failed2.Alloc().ID)
+	gc.config.FailedAllocRetention = 0
+
+	// Retained failed allocs are collected when running out of disk
+	statsCollector.usedPercents = []float64{85}
+	require.NoError(t, gc.keepUsageBelowThreshold())
+	require.Zero(t, gc.allocRunners.Length())
+}
+
+func TestAllocGarbageCollector_Pin(t *testing.T) {
+	ci.Parallel(t)
+
+	logger := testlog.HCLogger(t)
+	statsCollector := &MockStatsCollector{}
+	gc := NewAllocGarbageCollector(logger, statsCollector, &MockAllocCounter{}, gcConfig())
+
+	ar1, cleanup1 := testTerminalAllocRunner(t, false)
+	defer cleanup1()
+	ar2, cleanup2 := testTerminalAllocRunner(t, false)
+	defer cleanup2()
+
+	gc.MarkForCollection(ar1.Alloc().ID, ar1)
+	gc.MarkForCollection(ar2.Alloc().ID, ar2)
+	gc.Pin(ar1.Alloc().ID)
+
+	// Pinned allocs are never collected, even when running out of disk
+	require.False(t, gc.Collect(ar1.Alloc().ID))
+
+	statsCollector.availableValues = []uint64{1000}
+	statsCollector.usedPercents = []float64{85}
+	statsCollector.inodePercents = []float64{10}
+	require.NoError(t, gc.keepUsageBelowThreshold())
+	require.Equal(t, 1, gc.allocRunners.Length())
+
+	gc.CollectAll()
+	require.NotNil(t, gc.allocRunners.Get(ar1.Alloc().ID))
+
+	// Pinned allocs removed by the servers are retained until unpinned
+	require.True(t, gc.RetainRemoved(ar1.Alloc().ID))
+	gc.collectRemoved()
+	require.NotNil(t, gc.allocRunners.Get(ar1.Alloc().ID))
+
+	gc.Unpin(ar1.Alloc().ID)
+	gc.collectRemoved()
+	require.Zero(t, gc.allocRunners.Length())
+	require.False(t, gc.IsPinned(ar1.Alloc().ID))
+}
@@ -0,0 +1,102 @@
+// Package idmap allocates the ranges of host user and group IDs the user
+// namespaces of allocations are mapped to.
+package idmap
+
+import (
+	"fmt"
+	"sync"
+
+	cstate "github.com/hashicorp/nomad/client/state"
+	"github.com/hashicorp/nomad/plugins/drivers"
+)
+
+// Size is the number of user and group IDs mapped into the user namespace of
+// each allocation, which covers every ID a task may use including nobody.
+const Size = 65536
+
+// Allocator allocates a unique range of host IDs to each allocation with
+// tasks running in user namespaces. All tasks of an allocation share its
+// range, so files in the shared alloc directory are owned by the same IDs in
+// every task. Ranges are stored in the state DB, so they are kept across
+// client restarts until the allocation is destroyed.
+type Allocator struct {
+	db cstate.StateDB
+
+	// allocs is the range of each allocation
+	allocs map[string]*drivers.IDMapping
+	lock   sync.Mutex
+}
+
+// NewAllocator returns an Allocator persisting ranges to db.
+func NewAllocator(db cstate.StateDB) *Allocator {
+	return &Allocator{
+		db:     db,
+		allocs: make(map[string]*drivers.IDMapping),
+	}
+}
+
+// Restore loads the range of a restored allocation from the state DB, so it
+// is not allocated to other allocations.
+func (a *Allocator) Restore(allocID string) error {
+	m, err := a.db.GetAllocUserNamespace(allocID)
+	if err != nil {
+		return err
+	}
+	if m == nil {
+		return nil
+	}
+
+	a.lock.Lock()
+	defer a.lock.Unlock()
+	a.allocs[allocID] = m
+	return nil
+}
+
+// Allocate returns the range of the allocation, allocating the first free
+// range from pool if it does not have one yet. The pool is the range of IDs
+// the driver of the task may use.
+func (a *Allocator) Allocate(allocID string, pool *drivers.IDMapping) (*drivers.IDMapping, error) {
+	a.lock.Lock()
+	defer a.lock.Unlock()
+
+	if m, ok := a.allocs[allocID]; ok {
+		return m.Copy(), nil
+	}
+
+	for offset := uint64(0); offset+Size <= uint64(pool.Size); offset += Size {
+		m := &drivers.IDMapping{
+			UIDStart: pool.UIDStart + uint32(offset),
+			GIDStart: pool.GIDStart + uint32(offset),
+			Size:     Size,
+		}
+		if a.inUse(m) {
+			continue
+		}
+
+		if err := a.db.PutAllocUserNamespace(allocID, m); err != nil {
+			return nil, fmt.Errorf("failed to store user namespace: %v", err)
+		}
+		a.allocs[allocID] = m
+		return m.Copy(), nil
+	}
+
+	return nil, fmt.Errorf("no user namespace ID range available: all %d ranges of %d IDs from UID %d and GID %d are in use",
+		uint64(pool.Size)/Size, Size, pool.UIDStart, pool.GIDStart)
+}
+
+// Release frees the range of a destroyed allocation.
+func (a *Allocator) Release(allocID string) {
+	a.lock.Lock()
+	defer a.lock.Unlock()
+	delete(a.allocs, allocID)
+}
+
+// inUse returns true if any ID of the range is allocated.
+func (a *Allocator) inUse(m *drivers.IDMapping) bool {
+	for _, other := range a.allocs {
+		if m.Overlaps(other) {
+			return true
+		}
+	}
+	return false
+}
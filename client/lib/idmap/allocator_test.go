@@ -0,0 +1,84 @@
+package idmap
+
+import (
+	"testing"
+
+	"github.com/hashicorp/nomad/ci"
+	cstate "github.com/hashicorp/nomad/client/state"
+	"github.com/hashicorp/nomad/helper/testlog"
+	"github.com/hashicorp/nomad/plugins/drivers"
+	"github.com/shoenig/test/must"
+)
+
+func TestAllocator_Allocate(t *testing.T) {
+	ci.Parallel(t)
+
+	db := cstate.NewMemDB(testlog.HCLogger(t))
+	a := NewAllocator(db)
+	pool := &drivers.IDMapping{UIDStart: 100000, GIDStart: 200000, Size: 2 * Size}
+
+	m1, err := a.Allocate("alloc1", pool)
+	must.NoError(t, err)
+	must.Eq(t, &drivers.IDMapping{UIDStart: 100000, GIDStart: 200000, Size: Size}, m1)
+
+	// The range is stored and reused by other tasks of the allocation
+	stored, err := db.GetAllocUserNamespace("alloc1")
+	must.NoError(t, err)
+	must.Eq(t, m1, stored)
+
+	again, err := a.Allocate("alloc1", pool)
+	must.NoError(t, err)
+	must.Eq(t, m1, again)
+
+	m2, err := a.Allocate("alloc2", pool)
+	must.NoError(t, err)
+	must.Eq(t, &drivers.IDMapping{UIDStart: 100000 + Size, GIDStart: 200000 + Size, Size: Size}, m2)
+
+	// The pool is exhausted
+	_, err = a.Allocate("alloc3", pool)
+	must.ErrorContains(t, err, "no user namespace ID range available")
+
+	// Released ranges are allocated again
+	a.Release("alloc1")
+	m3, err := a.Allocate("alloc3", pool)
+	must.NoError(t, err)
+	must.Eq(t, m1, m3)
+}
+
+func TestAllocator_OverlappingPools(t *testing.T) {
+	ci.Parallel(t)
+
+	a := NewAllocator(cstate.NewMemDB(testlog.HCLogger(t)))
+
+	m1, err := a.Allocate("alloc1", &drivers.IDMapping{UIDStart: 100000, GIDStart: 100000, Size: 4 * Size})
+	must.NoError(t, err)
+
+	// Ranges from another pool must not overlap with allocated ranges
+	m2, err := a.Allocate("alloc2", &drivers.IDMapping{UIDStart: 100000 + Size/2, GIDStart: 500000, Size: 4 * Size})
+	must.NoError(t, err)
+	must.False(t, m1.Overlaps(m2))
+	must.Eq(t, 100000+Size/2+Size, m2.UIDStart)
+}
+
+func TestAllocator_Restore(t *testing.T) {
+	ci.Parallel(t)
+
+	db := cstate.NewMemDB(testlog.HCLogger(t))
+	pool := &drivers.IDMapping{UIDStart: 100000, GIDStart: 100000, Size: 2 * Size}
+
+	m1, err := NewAllocator(db).Allocate("alloc1", pool)
+	must.NoError(t, err)
+
+	// A restored range is kept by its allocation after a restart
+	a := NewAllocator(db)
+	must.NoError(t, a.Restore("alloc1"))
+	must.NoError(t, a.Restore("alloc2"))
+
+	m2, err := a.Allocate("alloc2", pool)
+	must.NoError(t, err)
+	must.False(t, m1.Overlaps(m2))
+
+	again, err := a.Allocate("alloc1", pool)
+	must.NoError(t, err)
+	must.Eq(t, m1, again)
+}
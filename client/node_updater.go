@@ -244,6 +244,10 @@ func (c *Client) applyNodeUpdatesFromDriver(name string, info *structs.DriverInf
 			hasChanged = true
 		}
 
+		if oldVal.PluginVersion != info.PluginVersion {
+			hasChanged = true
+		}
+
 		// If health state has change, trigger node event
 		if oldVal.Healthy != info.Healthy || oldVal.HealthDescription != info.HealthDescription {
 			hasChanged = true
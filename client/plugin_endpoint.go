@@ -0,0 +1,43 @@
+package client
+
+import (
+	"net/http"
+	"time"
+
+	"github.com/armon/go-metrics"
+	"github.com/hashicorp/nomad/nomad/structs"
+)
+
+type NodePlugin struct {
+	c *Client
+}
+
+func newNodePluginEndpoint(c *Client) *NodePlugin {
+	n := &NodePlugin{c: c}
+	return n
+}
+
+// Reload replaces a running external driver or device plugin by the plugin
+// rescanned from the plugin directory.
+func (n *NodePlugin) Reload(args *structs.NodePluginReloadRequest, reply *structs.NodePluginReloadResponse) error {
+	defer metrics.MeasureSince([]string{"client", "node_plugin", "reload"}, time.Now())
+
+	// Check node write permissions
+	if aclObj, err := n.c.ResolveToken(args.AuthToken); err != nil {
+		return err
+	} else if aclObj != nil && !aclObj.AllowNodeWrite() {
+		return structs.ErrPermissionDenied
+	}
+
+	if err := args.Validate(); err != nil {
+		return structs.NewErrRPCCoded(http.StatusBadRequest, err.Error())
+	}
+
+	resp, err := n.c.ReloadPlugin(args.Name)
+	if err != nil {
+		return err
+	}
+
+	*reply = *resp
+	return nil
+}
@@ -23,6 +23,10 @@ const (
 	// driverFPBackoffLimit is the limit of the exponential backoff for fingerprinting
 	// a driver.
 	driverFPBackoffLimit = 2 * time.Minute
+
+	// reloadFingerprintTimeout is how long a reloaded driver plugin has to
+	// fingerprint before the reload fails.
+	reloadFingerprintTimeout = 30 * time.Second
 )
 
 // instanceManagerConfig configures a driver instance manager
@@ -48,6 +52,9 @@ type instanceManagerConfig struct {
 	// ID is the ID of the plugin being managed
 	ID *loader.PluginID
 
+	// Version is the version of the plugin being managed
+	Version string
+
 	// updateNodeFromDriver is the callback used to update the node from fingerprinting
 	UpdateNodeFromDriver UpdateNodeDriverInfoFn
 
@@ -87,7 +94,10 @@ type instanceManager struct {
 	// driver is the driver plugin being managed
 	driver drivers.DriverPlugin
 
-	// pluginLock locks access to the driver and plugin
+	// version is the version of the driver plugin being managed
+	version string
+
+	// pluginLock locks access to the driver, plugin and version
 	pluginLock sync.Mutex
 
 	// shutdownLock is used to serialize attempts to shutdown
@@ -124,6 +134,7 @@ func newInstanceManager(c *instanceManagerConfig) *instanceManager {
 		fetchReattach:        c.FetchReattach,
 		pluginConfig:         c.PluginConfig,
 		id:                   c.ID,
+		version:              c.Version,
 		updateNodeFromDriver: c.UpdateNodeFromDriver,
 		eventHandlerFactory:  c.EventHandlerFactory,
 		firstFingerprintCh:   make(chan struct{}),
@@ -237,6 +248,101 @@ func (i *instanceManager) dispense() (plugin drivers.DriverPlugin, err error) {
 	return driver, nil
 }
 
+// reload launches the plugin from the given catalog and replaces the running
+// plugin with it once it has fingerprinted. The running plugin is kept if the
+// new plugin fails. Once the replaced plugin is killed, task runners recover
+// their tasks on the new plugin the same way as when a plugin exits
+// unexpectedly.
+func (i *instanceManager) reload(catalog loader.PluginCatalog, version string) error {
+	pluginInstance, err := catalog.Dispense(i.id.Name, i.id.PluginType, i.pluginConfig, i.logger)
+	if err != nil {
+		return fmt.Errorf("failed to start plugin: %v", err)
+	}
+
+	driver, ok := pluginInstance.Plugin().(drivers.DriverPlugin)
+	if !ok {
+		pluginInstance.Kill()
+		return fmt.Errorf("plugin loaded does not implement the driver interface")
+	}
+
+	if err := i.reloadFingerprint(driver); err != nil {
+		pluginInstance.Kill()
+		return err
+	}
+
+	i.pluginLock.Lock()
+	old := i.plugin
+	i.plugin = pluginInstance
+	i.driver = driver
+	i.version = version
+
+	// Store the reattach config
+	if c, ok := pluginInstance.ReattachConfig(); ok {
+		if err := i.storeReattach(c); err != nil {
+			i.logger.Error("error storing driver plugin reattach config", "error", err)
+		}
+	}
+	i.pluginLock.Unlock()
+
+	if old != nil && !old.Exited() {
+		old.Kill()
+	}
+
+	i.logger.Info("driver plugin reloaded", "version", version)
+	return nil
+}
+
+// reloadFingerprint waits for the first fingerprint of a reloaded driver
+// plugin and returns an error if the driver failed to fingerprint or wasn't
+// detected.
+func (i *instanceManager) reloadFingerprint(driver drivers.DriverPlugin) error {
+	ctx, cancel := context.WithTimeout(i.ctx, reloadFingerprintTimeout)
+	defer cancel()
+
+	fpChan, err := driver.Fingerprint(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to fingerprint driver: %v", err)
+	}
+
+	select {
+	case <-ctx.Done():
+		return fmt.Errorf("failed to fingerprint driver: %v", ctx.Err())
+	case fp, ok := <-fpChan:
+		switch {
+		case !ok:
+			return fmt.Errorf("failed to fingerprint driver: fingerprinting stopped")
+		case fp.Err != nil:
+			return fmt.Errorf("failed to fingerprint driver: %v", fp.Err)
+		case fp.Health == drivers.HealthStateUndetected:
+			return fmt.Errorf("driver was not detected: %s", fp.HealthDescription)
+		}
+	}
+
+	return nil
+}
+
+// canUpdateTaskResources returns true if the driver plugin can update the
+// resources of running tasks.
+func (i *instanceManager) canUpdateTaskResources() bool {
+	driver, err := i.dispense()
+	if err != nil {
+		return false
+	}
+	caps, err := driver.Capabilities()
+	if err != nil {
+		i.logger.Debug("failed to get driver capabilities", "error", err)
+		return false
+	}
+	return caps.UpdateTaskResources
+}
+
+// getVersion returns the version of the driver plugin being managed
+func (i *instanceManager) getVersion() string {
+	i.pluginLock.Lock()
+	defer i.pluginLock.Unlock()
+	return i.version
+}
+
 // cleanup shutsdown the plugin
 func (i *instanceManager) cleanup() {
 	i.shutdownLock.Lock()
@@ -362,11 +468,18 @@ func (i *instanceManager) handleFingerprint(fp *drivers.Fingerprint) {
 	for key, attr := range fp.Attributes {
 		attrs[key] = attr.GoString()
 	}
+
+	// The scheduler only updates the resources of tasks in-place when their
+	// driver can update running tasks
+	if i.canUpdateTaskResources() {
+		attrs[structs.DriverUpdateTaskResourcesAttr(i.id.Name)] = "true"
+	}
 	di := &structs.DriverInfo{
 		Attributes:        attrs,
 		Detected:          fp.Health != drivers.HealthStateUndetected,
 		Healthy:           fp.Health == drivers.HealthStateHealthy,
 		HealthDescription: fp.HealthDescription,
+		PluginVersion:     i.getVersion(),
 		UpdateTime:        time.Now(),
 	}
 	i.updateNodeFromDriver(i.id.Name, di)
@@ -11,6 +11,7 @@ import (
 	"github.com/hashicorp/nomad/helper/pluginutils/singleton"
 	"github.com/hashicorp/nomad/helper/testlog"
 	"github.com/hashicorp/nomad/plugins/base"
+	"github.com/hashicorp/nomad/plugins/drivers"
 	dtu "github.com/hashicorp/nomad/plugins/drivers/testutils"
 	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
@@ -20,14 +21,18 @@ type mockedCatalog struct {
 	mock.Mock
 }
 
+func mockCapabilities() (*drivers.Capabilities, error) {
+	return &drivers.Capabilities{}, nil
+}
+
 func (m *mockedCatalog) Dispense(name, pluginType string, cfg *base.AgentConfig, logger log.Logger) (loader.PluginInstance, error) {
 	args := m.Called(name, pluginType, cfg, logger)
-	return loader.MockBasicExternalPlugin(&dtu.MockDriver{}, "0.1.0"), args.Error(0)
+	return loader.MockBasicExternalPlugin(&dtu.MockDriver{CapabilitiesF: mockCapabilities}, "0.1.0"), args.Error(0)
 }
 
 func (m *mockedCatalog) Reattach(name, pluginType string, config *plugin.ReattachConfig) (loader.PluginInstance, error) {
 	args := m.Called(name, pluginType, config)
-	return loader.MockBasicExternalPlugin(&dtu.MockDriver{}, "0.1.0"), args.Error(0)
+	return loader.MockBasicExternalPlugin(&dtu.MockDriver{CapabilitiesF: mockCapabilities}, "0.1.0"), args.Error(0)
 }
 
 func (m *mockedCatalog) Catalog() map[string][]*base.PluginInfoResponse {
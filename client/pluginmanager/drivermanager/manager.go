@@ -198,6 +198,7 @@ func (m *manager) Run() {
 			FetchReattach:        fetchFn,
 			PluginConfig:         m.pluginConfig,
 			ID:                   &id,
+			Version:              d.PluginVersion,
 			UpdateNodeFromDriver: m.updater,
 			EventHandlerFactory:  m.eventHandlerFactory,
 		})
@@ -382,6 +383,28 @@ func (m *manager) Dispense(d string) (drivers.DriverPlugin, error) {
 	return nil, ErrDriverNotFound
 }
 
+// Reload launches the named driver plugin from the given catalog and replaces
+// the running driver plugin once the new plugin has fingerprinted. The running
+// driver plugin is kept if the new plugin fails to launch or fingerprint.
+func (m *manager) Reload(name string, catalog loader.PluginCatalog) error {
+	m.instancesMu.RLock()
+	instance, ok := m.instances[name]
+	m.instancesMu.RUnlock()
+	if !ok {
+		return ErrDriverNotFound
+	}
+
+	var version string
+	for _, p := range catalog.Catalog()[base.PluginTypeDriver] {
+		if p.Name == name {
+			version = p.PluginVersion
+			break
+		}
+	}
+
+	return instance.reload(catalog, version)
+}
+
 func (m *manager) isDriverBlocked(name string) bool {
 	// Block drivers that are not in the allowed list if it is set.
 	if _, ok := m.allowedDrivers[name]; len(m.allowedDrivers) > 0 && !ok {
@@ -54,6 +54,9 @@ func mockDriver(fpChan chan *drivers.Fingerprint, evChan chan *drivers.TaskEvent
 		TaskEventsF: func(ctx context.Context) (<-chan *drivers.TaskEvent, error) {
 			return evChan, nil
 		},
+		CapabilitiesF: func() (*drivers.Capabilities, error) {
+			return &drivers.Capabilities{UpdateTaskResources: true}, nil
+		},
 	}
 }
 
@@ -166,6 +169,9 @@ func TestManager_Fingerprint(t *testing.T) {
 	require.True(infos[1].Detected)
 	require.False(infos[2].Healthy)
 	require.False(infos[2].Detected)
+
+	// The driver advertises that it can update the resources of tasks
+	require.Equal("true", infos[0].Attributes[structs.DriverUpdateTaskResourcesAttr("mock")])
 }
 
 func TestManager_TaskEvents(t *testing.T) {
@@ -296,3 +302,66 @@ func TestManager_Run_AllowedBlockedDrivers_Combined(t *testing.T) {
 	mgr.instancesMu.Unlock()
 	require.True(ok)
 }
+
+func TestManager_Reload(t *testing.T) {
+	ci.Parallel(t)
+	require := require.New(t)
+	fpChan, _, mgr := testSetup(t)
+	go mgr.Run()
+	defer mgr.Shutdown()
+	fpChan <- &drivers.Fingerprint{Health: drivers.HealthStateHealthy}
+
+	testutil.WaitForResult(func() (bool, error) {
+		mgr.instancesMu.Lock()
+		defer mgr.instancesMu.Unlock()
+		if len(mgr.instances) != 1 {
+			return false, fmt.Errorf("manager should have registered 1 instance")
+		}
+		if mgr.instances["mock"].getLastHealth() != drivers.HealthStateHealthy {
+			return false, fmt.Errorf("mock instance should be healthy")
+		}
+		return true, nil
+	}, func(err error) {
+		require.NoError(err)
+	})
+
+	instance := mgr.instances["mock"]
+	instance.pluginLock.Lock()
+	oldPlugin := instance.plugin
+	instance.pluginLock.Unlock()
+
+	// reloadCatalog returns a catalog that dispenses a single plugin instance
+	// of a driver fingerprinting with the given health
+	reloadCatalog := func(health drivers.HealthState, version string) (*loader.MockCatalog, loader.PluginInstance) {
+		fp := make(chan *drivers.Fingerprint, 1)
+		fp <- &drivers.Fingerprint{Health: health}
+		inst := loader.MockBasicExternalPlugin(mockDriver(fp, make(chan *drivers.TaskEvent)), "0.1.0")
+		return &loader.MockCatalog{
+			DispenseF: func(string, string, *base.AgentConfig, log.Logger) (loader.PluginInstance, error) {
+				return inst, nil
+			},
+			CatalogF: func() map[string][]*base.PluginInfoResponse {
+				return map[string][]*base.PluginInfoResponse{
+					base.PluginTypeDriver: {{Name: "mock", Type: base.PluginTypeDriver, PluginVersion: version}},
+				}
+			},
+		}, inst
+	}
+
+	// A driver that isn't detected doesn't replace the running driver
+	badCatalog, badPlugin := reloadCatalog(drivers.HealthStateUndetected, "0.2.0")
+	require.Error(mgr.Reload("mock", badCatalog))
+	require.True(badPlugin.Exited())
+	require.False(oldPlugin.Exited())
+	require.Empty(instance.getVersion())
+
+	// A healthy driver replaces the running driver
+	newCatalog, newPlugin := reloadCatalog(drivers.HealthStateHealthy, "0.2.0")
+	require.NoError(mgr.Reload("mock", newCatalog))
+	require.True(oldPlugin.Exited())
+	require.False(newPlugin.Exited())
+	require.Equal("0.2.0", instance.getVersion())
+
+	// Unknown drivers can't be reloaded
+	require.ErrorIs(mgr.Reload("unknown", newCatalog), ErrDriverNotFound)
+}
@@ -0,0 +1,154 @@
+package client
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"reflect"
+
+	"github.com/hashicorp/nomad/client/config"
+	"github.com/hashicorp/nomad/client/devicemanager"
+	"github.com/hashicorp/nomad/client/pluginmanager/drivermanager"
+	"github.com/hashicorp/nomad/helper/pluginutils/loader"
+	"github.com/hashicorp/nomad/nomad/structs"
+	structsc "github.com/hashicorp/nomad/nomad/structs/config"
+	"github.com/hashicorp/nomad/plugins/base"
+)
+
+// pluginReloader is implemented by the plugin managers that can replace a
+// running plugin by the plugin launched from another plugin catalog.
+type pluginReloader interface {
+	Reload(name string, catalog loader.PluginCatalog) error
+}
+
+// ReloadPlugin rescans the plugin directory and replaces the running external
+// driver or device plugin with the given name by the plugin found in the
+// plugin directory. The running plugin is kept if the new plugin fails to
+// fingerprint. Tasks of a reloaded driver are recovered by the new plugin.
+func (c *Client) ReloadPlugin(name string) (*structs.NodePluginReloadResponse, error) {
+	return c.reloadPlugin(name, c.GetConfig().Plugins)
+}
+
+// reloadPlugin reloads the named plugin, configuring the plugins found in the
+// plugin directory with the given plugin configs.
+func (c *Client) reloadPlugin(name string, configs []*structsc.PluginConfig) (*structs.NodePluginReloadResponse, error) {
+	c.pluginReloadLock.Lock()
+	defer c.pluginReloadLock.Unlock()
+
+	reloader, ok := c.GetConfig().PluginLoader.(loader.PluginReloader)
+	if !ok {
+		return nil, fmt.Errorf("plugin loader does not support reloading plugins")
+	}
+
+	staged, err := reloader.Rescan(configs)
+	if err != nil {
+		return nil, fmt.Errorf("failed to rescan plugins: %v", err)
+	}
+
+	info, ok := findPlugin(staged.Catalog(), name)
+	if !ok {
+		return nil, structs.NewErrRPCCoded(http.StatusNotFound, fmt.Sprintf("plugin %q not found", name))
+	}
+
+	id := loader.PluginInfoID(info)
+	if !reloader.IsExternal(id) || !staged.IsExternal(id) {
+		return nil, structs.NewErrRPCCoded(http.StatusBadRequest,
+			fmt.Sprintf("plugin %q is built into the agent and can't be reloaded", name))
+	}
+
+	var manager pluginReloader
+	switch id.PluginType {
+	case base.PluginTypeDriver:
+		manager, ok = c.drivermanager.(pluginReloader)
+	case base.PluginTypeDevice:
+		manager, ok = c.devicemanager.(pluginReloader)
+	}
+	if !ok {
+		return nil, fmt.Errorf("%s plugins can't be reloaded", id.PluginType)
+	}
+
+	resp := &structs.NodePluginReloadResponse{
+		Name:    id.Name,
+		Type:    id.PluginType,
+		Version: info.PluginVersion,
+	}
+	if prev, ok := findPlugin(reloader.Catalog(), name); ok {
+		resp.PreviousVersion = prev.PluginVersion
+	}
+
+	c.logger.Info("reloading plugin", "plugin", id.Name, "type", id.PluginType,
+		"previous_version", resp.PreviousVersion, "version", resp.Version)
+
+	if err := manager.Reload(id.Name, staged); err != nil {
+		if errors.Is(err, drivermanager.ErrDriverNotFound) || errors.Is(err, devicemanager.ErrDevicePluginNotFound) {
+			return nil, structs.NewErrRPCCoded(http.StatusNotFound,
+				fmt.Sprintf("plugin %q is not running on this client", name))
+		}
+		return nil, fmt.Errorf("failed to reload plugin %q, keeping version %q: %v", name, resp.PreviousVersion, err)
+	}
+
+	// Dispense the new plugin from now on, such as when it has to be
+	// relaunched after exiting
+	if err := reloader.Update(id, staged); err != nil {
+		return nil, err
+	}
+
+	return resp, nil
+}
+
+// reloadPluginConfigs reloads the running external plugins whose config
+// changed, and stores the new plugin configs. Changes to the config of
+// plugins built into the agent only apply once the agent is restarted.
+func (c *Client) reloadPluginConfigs(configs []*structsc.PluginConfig) {
+	old := make(map[string]*structsc.PluginConfig)
+	for _, p := range c.GetConfig().Plugins {
+		old[p.Name] = p
+	}
+
+	var changed []string
+	for _, p := range configs {
+		prev, ok := old[p.Name]
+		delete(old, p.Name)
+		if ok && reflect.DeepEqual(prev.Args, p.Args) && reflect.DeepEqual(prev.Config, p.Config) {
+			continue
+		}
+		changed = append(changed, p.Name)
+	}
+	for name := range old {
+		changed = append(changed, name)
+	}
+
+	c.UpdateConfig(func(c *config.Config) {
+		c.Plugins = configs
+	})
+
+	reloader, ok := c.GetConfig().PluginLoader.(loader.PluginReloader)
+	for _, name := range changed {
+		info, found := findPlugin(c.GetConfig().PluginLoader.Catalog(), name)
+		if !found {
+			continue
+		}
+
+		if !ok || !reloader.IsExternal(loader.PluginInfoID(info)) {
+			c.logger.Warn("plugin config changed, restart the agent to apply the new config", "plugin", name)
+			continue
+		}
+
+		if _, err := c.reloadPlugin(name, configs); err != nil {
+			c.logger.Error("failed to reload plugin with new config", "plugin", name, "error", err)
+		}
+	}
+}
+
+// findPlugin returns the driver or device plugin with the given name from
+// the plugin catalog.
+func findPlugin(catalog map[string][]*base.PluginInfoResponse, name string) (*base.PluginInfoResponse, bool) {
+	for _, pluginType := range []string{base.PluginTypeDriver, base.PluginTypeDevice} {
+		for _, info := range catalog[pluginType] {
+			if info.Name == name {
+				return info, true
+			}
+		}
+	}
+	return nil, false
+}
@@ -25,6 +25,7 @@ type rpcEndpoints struct {
 	Allocations *Allocations
 	Agent       *Agent
 	NodeMeta    *NodeMeta
+	NodePlugin  *NodePlugin
 }
 
 // ClientRPC is used to make a local, client only RPC call
@@ -270,6 +271,7 @@ func (c *Client) setupClientRpc(rpcs map[string]interface{}) {
 		c.endpoints.Allocations = NewAllocationsEndpoint(c)
 		c.endpoints.Agent = NewAgentEndpoint(c)
 		c.endpoints.NodeMeta = newNodeMetaEndpoint(c)
+		c.endpoints.NodePlugin = newNodePluginEndpoint(c)
 		c.setupClientRpcServer(c.rpcServer)
 	}
 
@@ -285,6 +287,7 @@ func (c *Client) setupClientRpcServer(server *rpc.Server) {
 	server.Register(c.endpoints.Allocations)
 	server.Register(c.endpoints.Agent)
 	server.Register(c.endpoints.NodeMeta)
+	server.Register(c.endpoints.NodePlugin)
 }
 
 // rpcConnListener is a long lived function that listens for new connections
@@ -51,6 +51,13 @@ type WorkloadRestarter interface {
 	Restart(ctx context.Context, event *structs.TaskEvent, failure bool) error
 }
 
+// WorkloadPauser is implemented by workloads whose processes can be paused.
+// The checks of paused workloads are skipped, as frozen processes cannot
+// respond to them.
+type WorkloadPauser interface {
+	IsPaused() bool
+}
+
 // AllocRegistration holds the status of services registered for a particular
 // allocations by task.
 type AllocRegistration struct {
@@ -50,6 +50,15 @@ func (r *restarter) apply(ctx context.Context, now time.Time, status string) boo
 			r.unhealthyState = time.Time{}
 		}
 	}
+
+	// Failures of paused workloads are ignored, and the grace period starts
+	// over once they are resumed.
+	if p, ok := r.task.(WorkloadPauser); ok && p.IsPaused() {
+		healthy()
+		r.graceUntil = now.Add(r.grace)
+		return false
+	}
+
 	switch status {
 	case "critical": // consul
 	case string(structs.CheckFailure): // nomad
@@ -37,6 +37,9 @@ type fakeWorkloadRestarter struct {
 	taskName  string
 	checkName string
 
+	// paused workloads have their check failures ignored
+	paused bool
+
 	lock sync.Mutex
 }
 
@@ -72,6 +75,13 @@ func (c *fakeWorkloadRestarter) Restart(_ context.Context, event *structs.TaskEv
 	return nil
 }
 
+// IsPaused implements the WorkloadPauser interface.
+func (c *fakeWorkloadRestarter) IsPaused() bool {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	return c.paused
+}
+
 // String is useful for debugging.
 func (c *fakeWorkloadRestarter) String() string {
 	c.lock.Lock()
@@ -236,6 +246,31 @@ func TestCheckWatcher_Unhealthy(t *testing.T) {
 	must.Len(t, 1, restarter1.restarts, must.Sprint("expected check to be restarted once"))
 }
 
+// TestCheckWatcher_Paused asserts failing checks of paused workloads do not
+// restart them.
+func TestCheckWatcher_Paused(t *testing.T) {
+	ci.Parallel(t)
+
+	now := before()
+	getter, cw := testWatcherSetup(t)
+
+	// Check has always been failing
+	getter.add("testcheck1", "critical", now)
+
+	check1 := testCheck()
+	restarter1 := newFakeWorkloadRestarter(cw, "testalloc1", "testtask1", "testcheck1", check1)
+	restarter1.paused = true
+	cw.Watch("testalloc1", "testtask1", "testcheck1", check1, restarter1)
+
+	// Run
+	ctx, cancel := context.WithTimeout(context.Background(), 500*time.Millisecond)
+	defer cancel()
+	cw.Run(ctx)
+
+	// Ensure restart was never called
+	must.SliceEmpty(t, restarter1.restarts, must.Sprint("expected paused task not to be restarted"))
+}
+
 // TestCheckWatcher_HealthyWarning asserts checks in warning with
 // ignore_warnings=true do not restart tasks.
 func TestCheckWatcher_HealthyWarning(t *testing.T) {
@@ -13,8 +13,10 @@ import (
 	"github.com/hashicorp/nomad/client/dynamicplugins"
 	driverstate "github.com/hashicorp/nomad/client/pluginmanager/drivermanager/state"
 	"github.com/hashicorp/nomad/client/serviceregistration/checks"
+	"github.com/hashicorp/nomad/client/stats"
 	"github.com/hashicorp/nomad/helper/boltdd"
 	"github.com/hashicorp/nomad/nomad/structs"
+	"github.com/hashicorp/nomad/plugins/drivers"
 	"go.etcd.io/bbolt"
 )
 
@@ -29,9 +31,12 @@ allocations/
    |--> alloc          -> allocEntry{*structs.Allocation}
 	 |--> deploy_status  -> deployStatusEntry{*structs.AllocDeploymentStatus}
 	 |--> network_status -> networkStatusEntry{*structs.AllocNetworkStatus}
+	 |--> pinned         -> allocPinnedEntry{bool}
+	 |--> user_namespace -> allocUserNamespaceEntry{*drivers.IDMapping}
    |--> task-<name>/
-      |--> local_state -> *trstate.LocalState # Local-only state
-      |--> task_state  -> *structs.TaskState  # Syncs to servers
+      |--> local_state   -> *trstate.LocalState # Local-only state
+      |--> task_state    -> *structs.TaskState  # Syncs to servers
+      |--> stats_history -> statsHistoryEntry{[]*stats.ResourceUsageSample}
    |--> checks/
       |--> check-<id> -> *structs.CheckState # Syncs to servers
 
@@ -80,12 +85,21 @@ var (
 	// stored under
 	allocNetworkStatusKey = []byte("network_status")
 
+	// allocPinnedKey is the key whether the allocation is pinned is stored
+	// under
+	allocPinnedKey = []byte("pinned")
+
+	// allocUserNamespaceKey is the key the *drivers.IDMapping of the user
+	// namespace of the allocation is stored under
+	allocUserNamespaceKey = []byte("user_namespace")
+
 	// checkResultsBucket is the bucket name in which check query results are stored
 	checkResultsBucket = []byte("check_results")
 
 	// allocations -> $allocid -> task-$taskname -> the keys below
-	taskLocalStateKey = []byte("local_state")
-	taskStateKey      = []byte("task_state")
+	taskLocalStateKey   = []byte("local_state")
+	taskStateKey        = []byte("task_state")
+	taskStatsHistoryKey = []byte("stats_history")
 
 	// devManagerBucket is the bucket name containing all device manager related
 	// data
@@ -389,6 +403,113 @@ func (s *BoltStateDB) GetNetworkStatus(allocID string) (*structs.AllocNetworkSta
 	return entry.NetworkStatus, nil
 }
 
+// allocPinnedEntry wraps values for Pinned keys.
+type allocPinnedEntry struct {
+	Pinned bool
+}
+
+// PutAllocPinned stores whether an allocation is pinned or returns an error.
+func (s *BoltStateDB) PutAllocPinned(allocID string, pinned bool) error {
+	return s.db.Update(func(tx *boltdd.Tx) error {
+		allocBkt, err := getAllocationBucket(tx, allocID)
+		if err != nil {
+			return err
+		}
+
+		entry := allocPinnedEntry{
+			Pinned: pinned,
+		}
+		return allocBkt.Put(allocPinnedKey, &entry)
+	})
+}
+
+// GetAllocPinned retrieves whether an allocation is pinned or returns an
+// error.
+func (s *BoltStateDB) GetAllocPinned(allocID string) (bool, error) {
+	var entry allocPinnedEntry
+
+	err := s.db.View(func(tx *boltdd.Tx) error {
+		allAllocsBkt := tx.Bucket(allocationsBucketName)
+		if allAllocsBkt == nil {
+			// No state, return
+			return nil
+		}
+
+		allocBkt := allAllocsBkt.Bucket([]byte(allocID))
+		if allocBkt == nil {
+			// No state for alloc, return
+			return nil
+		}
+
+		return allocBkt.Get(allocPinnedKey, &entry)
+	})
+
+	// Allocations are not pinned unless set
+	if boltdd.IsErrNotFound(err) {
+		return false, nil
+	}
+
+	if err != nil {
+		return false, err
+	}
+
+	return entry.Pinned, nil
+}
+
+// allocUserNamespaceEntry wraps values for UserNamespace keys.
+type allocUserNamespaceEntry struct {
+	UserNamespace *drivers.IDMapping
+}
+
+// PutAllocUserNamespace stores the user namespace of an allocation or returns
+// an error.
+func (s *BoltStateDB) PutAllocUserNamespace(allocID string, userns *drivers.IDMapping) error {
+	return s.db.Update(func(tx *boltdd.Tx) error {
+		allocBkt, err := getAllocationBucket(tx, allocID)
+		if err != nil {
+			return err
+		}
+
+		entry := allocUserNamespaceEntry{
+			UserNamespace: userns,
+		}
+		return allocBkt.Put(allocUserNamespaceKey, &entry)
+	})
+}
+
+// GetAllocUserNamespace retrieves the user namespace of an allocation or
+// returns an error.
+func (s *BoltStateDB) GetAllocUserNamespace(allocID string) (*drivers.IDMapping, error) {
+	var entry allocUserNamespaceEntry
+
+	err := s.db.View(func(tx *boltdd.Tx) error {
+		allAllocsBkt := tx.Bucket(allocationsBucketName)
+		if allAllocsBkt == nil {
+			// No state, return
+			return nil
+		}
+
+		allocBkt := allAllocsBkt.Bucket([]byte(allocID))
+		if allocBkt == nil {
+			// No state for alloc, return
+			return nil
+		}
+
+		return allocBkt.Get(allocUserNamespaceKey, &entry)
+	})
+
+	// It is valid for this field to be nil/missing
+	if boltdd.IsErrNotFound(err) {
+		return nil, nil
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	return entry.UserNamespace, nil
+}
+
 // GetTaskRunnerState returns the LocalState and TaskState for a
 // TaskRunner. LocalState or TaskState will be nil if they do not exist.
 //
@@ -488,6 +609,66 @@ func putTaskStateImpl(tx *boltdd.Tx, allocID, taskName string, state *structs.Ta
 	return taskBkt.Put(taskStateKey, state)
 }
 
+// statsHistoryEntry wraps values for StatsHistory keys.
+type statsHistoryEntry struct {
+	Samples []*stats.ResourceUsageSample
+}
+
+// GetTaskStatsHistory returns the resource usage history of a task or nil if
+// it does not exist.
+func (s *BoltStateDB) GetTaskStatsHistory(allocID, taskName string) ([]*stats.ResourceUsageSample, error) {
+	var entry statsHistoryEntry
+
+	err := s.db.View(func(tx *boltdd.Tx) error {
+		allAllocsBkt := tx.Bucket(allocationsBucketName)
+		if allAllocsBkt == nil {
+			// No state, return
+			return nil
+		}
+
+		allocBkt := allAllocsBkt.Bucket([]byte(allocID))
+		if allocBkt == nil {
+			// No state for alloc, return
+			return nil
+		}
+
+		taskBkt := allocBkt.Bucket(taskBucketName(taskName))
+		if taskBkt == nil {
+			// No state for task, return
+			return nil
+		}
+
+		return taskBkt.Get(taskStatsHistoryKey, &entry)
+	})
+
+	// It's valid for this field to be nil/missing
+	if boltdd.IsErrNotFound(err) {
+		return nil, nil
+	}
+
+	if err != nil {
+		return nil, fmt.Errorf("failed to read task stats history: %v", err)
+	}
+
+	return entry.Samples, nil
+}
+
+// PutTaskStatsHistory stores the resource usage history of a task or returns
+// an error.
+func (s *BoltStateDB) PutTaskStatsHistory(allocID, taskName string, samples []*stats.ResourceUsageSample) error {
+	return s.db.Update(func(tx *boltdd.Tx) error {
+		taskBkt, err := getTaskBucket(tx, allocID, taskName)
+		if err != nil {
+			return fmt.Errorf("failed to retrieve allocation bucket: %v", err)
+		}
+
+		entry := statsHistoryEntry{
+			Samples: samples,
+		}
+		return taskBkt.Put(taskStatsHistoryKey, &entry)
+	})
+}
+
 // DeleteTaskBucket is used to delete a task bucket if it exists.
 func (s *BoltStateDB) DeleteTaskBucket(allocID, taskName string) error {
 	return s.db.Update(func(tx *boltdd.Tx) error {
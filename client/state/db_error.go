@@ -8,7 +8,9 @@ import (
 	"github.com/hashicorp/nomad/client/dynamicplugins"
 	driverstate "github.com/hashicorp/nomad/client/pluginmanager/drivermanager/state"
 	"github.com/hashicorp/nomad/client/serviceregistration/checks"
+	"github.com/hashicorp/nomad/client/stats"
 	"github.com/hashicorp/nomad/nomad/structs"
+	"github.com/hashicorp/nomad/plugins/drivers"
 )
 
 // ErrDB implements a StateDB that returns errors on restore methods, used for testing
@@ -49,6 +51,22 @@ func (m *ErrDB) PutNetworkStatus(allocID string, ns *structs.AllocNetworkStatus,
 	return fmt.Errorf("Error!")
 }
 
+func (m *ErrDB) GetAllocPinned(allocID string) (bool, error) {
+	return false, fmt.Errorf("Error!")
+}
+
+func (m *ErrDB) PutAllocPinned(allocID string, pinned bool) error {
+	return fmt.Errorf("Error!")
+}
+
+func (m *ErrDB) GetAllocUserNamespace(allocID string) (*drivers.IDMapping, error) {
+	return nil, fmt.Errorf("Error!")
+}
+
+func (m *ErrDB) PutAllocUserNamespace(allocID string, userns *drivers.IDMapping) error {
+	return fmt.Errorf("Error!")
+}
+
 func (m *ErrDB) GetTaskRunnerState(allocID string, taskName string) (*state.LocalState, *structs.TaskState, error) {
 	return nil, nil, fmt.Errorf("Error!")
 }
@@ -61,6 +79,14 @@ func (m *ErrDB) PutTaskState(allocID string, taskName string, state *structs.Tas
 	return fmt.Errorf("Error!")
 }
 
+func (m *ErrDB) GetTaskStatsHistory(allocID, taskName string) ([]*stats.ResourceUsageSample, error) {
+	return nil, fmt.Errorf("Error!")
+}
+
+func (m *ErrDB) PutTaskStatsHistory(allocID, taskName string, samples []*stats.ResourceUsageSample) error {
+	return fmt.Errorf("Error!")
+}
+
 func (m *ErrDB) DeleteTaskBucket(allocID, taskName string) error {
 	return fmt.Errorf("Error!")
 }
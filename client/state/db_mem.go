@@ -9,8 +9,11 @@ import (
 	"github.com/hashicorp/nomad/client/dynamicplugins"
 	driverstate "github.com/hashicorp/nomad/client/pluginmanager/drivermanager/state"
 	"github.com/hashicorp/nomad/client/serviceregistration/checks"
+	"github.com/hashicorp/nomad/client/stats"
 	"github.com/hashicorp/nomad/nomad/structs"
+	"github.com/hashicorp/nomad/plugins/drivers"
 	"golang.org/x/exp/maps"
+	"golang.org/x/exp/slices"
 )
 
 // MemDB implements a StateDB that stores data in memory and should only be
@@ -25,9 +28,16 @@ type MemDB struct {
 	// alloc_id -> value
 	networkStatus map[string]*structs.AllocNetworkStatus
 
+	// alloc_id -> pinned
+	pinned map[string]bool
+
+	// alloc_id -> value
+	userNamespace map[string]*drivers.IDMapping
+
 	// alloc_id -> task_name -> value
 	localTaskState map[string]map[string]*state.LocalState
 	taskState      map[string]map[string]*structs.TaskState
+	statsHistory   map[string]map[string][]*stats.ResourceUsageSample
 
 	// alloc_id -> check_id -> result
 	checks checks.ClientResults
@@ -55,8 +65,11 @@ func NewMemDB(logger hclog.Logger) *MemDB {
 		allocs:         make(map[string]*structs.Allocation),
 		deployStatus:   make(map[string]*structs.AllocDeploymentStatus),
 		networkStatus:  make(map[string]*structs.AllocNetworkStatus),
+		pinned:         make(map[string]bool),
+		userNamespace:  make(map[string]*drivers.IDMapping),
 		localTaskState: make(map[string]map[string]*state.LocalState),
 		taskState:      make(map[string]map[string]*structs.TaskState),
+		statsHistory:   make(map[string]map[string][]*stats.ResourceUsageSample),
 		checks:         make(checks.ClientResults),
 		logger:         logger,
 	}
@@ -115,6 +128,32 @@ func (m *MemDB) PutNetworkStatus(allocID string, ns *structs.AllocNetworkStatus,
 	return nil
 }
 
+func (m *MemDB) GetAllocPinned(allocID string) (bool, error) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	return m.pinned[allocID], nil
+}
+
+func (m *MemDB) PutAllocPinned(allocID string, pinned bool) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.pinned[allocID] = pinned
+	return nil
+}
+
+func (m *MemDB) GetAllocUserNamespace(allocID string) (*drivers.IDMapping, error) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	return m.userNamespace[allocID], nil
+}
+
+func (m *MemDB) PutAllocUserNamespace(allocID string, userns *drivers.IDMapping) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.userNamespace[allocID] = userns
+	return nil
+}
+
 func (m *MemDB) GetTaskRunnerState(allocID string, taskName string) (*state.LocalState, *structs.TaskState, error) {
 	m.mu.RLock()
 	defer m.mu.RUnlock()
@@ -169,6 +208,29 @@ func (m *MemDB) PutTaskState(allocID string, taskName string, state *structs.Tas
 	return nil
 }
 
+func (m *MemDB) GetTaskStatsHistory(allocID, taskName string) ([]*stats.ResourceUsageSample, error) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	return m.statsHistory[allocID][taskName], nil
+}
+
+func (m *MemDB) PutTaskStatsHistory(allocID, taskName string, samples []*stats.ResourceUsageSample) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if ash, ok := m.statsHistory[allocID]; ok {
+		ash[taskName] = slices.Clone(samples)
+		return nil
+	}
+
+	m.statsHistory[allocID] = map[string][]*stats.ResourceUsageSample{
+		taskName: slices.Clone(samples),
+	}
+
+	return nil
+}
+
 func (m *MemDB) DeleteTaskBucket(allocID, taskName string) error {
 	m.mu.Lock()
 	defer m.mu.Unlock()
@@ -181,6 +243,10 @@ func (m *MemDB) DeleteTaskBucket(allocID, taskName string) error {
 		delete(alts, taskName)
 	}
 
+	if ash, ok := m.statsHistory[allocID]; ok {
+		delete(ash, taskName)
+	}
+
 	return nil
 }
 
@@ -189,8 +255,11 @@ func (m *MemDB) DeleteAllocationBucket(allocID string, _ ...WriteOption) error {
 	defer m.mu.Unlock()
 
 	delete(m.allocs, allocID)
+	delete(m.pinned, allocID)
+	delete(m.userNamespace, allocID)
 	delete(m.taskState, allocID)
 	delete(m.localTaskState, allocID)
+	delete(m.statsHistory, allocID)
 
 	return nil
 }
@@ -6,7 +6,9 @@ import (
 	"github.com/hashicorp/nomad/client/dynamicplugins"
 	driverstate "github.com/hashicorp/nomad/client/pluginmanager/drivermanager/state"
 	"github.com/hashicorp/nomad/client/serviceregistration/checks"
+	"github.com/hashicorp/nomad/client/stats"
 	"github.com/hashicorp/nomad/nomad/structs"
+	"github.com/hashicorp/nomad/plugins/drivers"
 )
 
 // NoopDB implements a StateDB that does not persist any data.
@@ -44,6 +46,22 @@ func (n NoopDB) PutNetworkStatus(allocID string, ds *structs.AllocNetworkStatus,
 	return nil
 }
 
+func (n NoopDB) GetAllocPinned(allocID string) (bool, error) {
+	return false, nil
+}
+
+func (n NoopDB) PutAllocPinned(allocID string, pinned bool) error {
+	return nil
+}
+
+func (n NoopDB) GetAllocUserNamespace(allocID string) (*drivers.IDMapping, error) {
+	return nil, nil
+}
+
+func (n NoopDB) PutAllocUserNamespace(allocID string, userns *drivers.IDMapping) error {
+	return nil
+}
+
 func (n NoopDB) GetTaskRunnerState(allocID string, taskName string) (*state.LocalState, *structs.TaskState, error) {
 	return nil, nil, nil
 }
@@ -56,6 +74,14 @@ func (n NoopDB) PutTaskState(allocID string, taskName string, state *structs.Tas
 	return nil
 }
 
+func (n NoopDB) GetTaskStatsHistory(allocID, taskName string) ([]*stats.ResourceUsageSample, error) {
+	return nil, nil
+}
+
+func (n NoopDB) PutTaskStatsHistory(allocID, taskName string, samples []*stats.ResourceUsageSample) error {
+	return nil
+}
+
 func (n NoopDB) DeleteTaskBucket(allocID, taskName string) error {
 	return nil
 }
@@ -12,9 +12,11 @@ import (
 	dmstate "github.com/hashicorp/nomad/client/devicemanager/state"
 	"github.com/hashicorp/nomad/client/dynamicplugins"
 	driverstate "github.com/hashicorp/nomad/client/pluginmanager/drivermanager/state"
+	"github.com/hashicorp/nomad/client/stats"
 	"github.com/hashicorp/nomad/helper/testlog"
 	"github.com/hashicorp/nomad/nomad/mock"
 	"github.com/hashicorp/nomad/nomad/structs"
+	"github.com/hashicorp/nomad/plugins/drivers"
 	"github.com/kr/pretty"
 	"github.com/shoenig/test/must"
 	"github.com/stretchr/testify/require"
@@ -306,6 +308,95 @@ func TestStateDB_TaskState(t *testing.T) {
 	})
 }
 
+// TestStateDB_TaskStatsHistory asserts the behavior of task stats history
+// related StateDB methods.
+func TestStateDB_TaskStatsHistory(t *testing.T) {
+	ci.Parallel(t)
+
+	testDB(t, func(t *testing.T, db StateDB) {
+		// Getting nonexistent history should return nil
+		samples, err := db.GetTaskStatsHistory("allocid", "taskname")
+		must.NoError(t, err)
+		must.Nil(t, samples)
+
+		history := []*stats.ResourceUsageSample{
+			{Timestamp: 1, CpuPercent: 10, MemoryRSS: 1024},
+			{Timestamp: 2, CpuPercent: 20, MemoryRSS: 2048, ThrottledPeriods: 1},
+		}
+		must.NoError(t, db.PutTaskStatsHistory("allocid", "taskname", history))
+
+		samples, err = db.GetTaskStatsHistory("allocid", "taskname")
+		must.NoError(t, err)
+		must.Eq(t, history, samples)
+
+		// Deleting the task should remove the history
+		must.NoError(t, db.DeleteTaskBucket("allocid", "taskname"))
+		samples, err = db.GetTaskStatsHistory("allocid", "taskname")
+		must.NoError(t, err)
+		must.Nil(t, samples)
+
+		// Deleting the allocation should remove the history
+		must.NoError(t, db.PutTaskStatsHistory("allocid", "taskname", history))
+		must.NoError(t, db.DeleteAllocationBucket("allocid"))
+		samples, err = db.GetTaskStatsHistory("allocid", "taskname")
+		must.NoError(t, err)
+		must.Nil(t, samples)
+	})
+}
+
+// TestStateDB_AllocPinned asserts the behavior of allocation pinning related
+// StateDB methods.
+func TestStateDB_AllocPinned(t *testing.T) {
+	ci.Parallel(t)
+
+	testDB(t, func(t *testing.T, db StateDB) {
+		// Allocations are not pinned by default
+		pinned, err := db.GetAllocPinned("allocid")
+		must.NoError(t, err)
+		must.False(t, pinned)
+
+		must.NoError(t, db.PutAllocPinned("allocid", true))
+		pinned, err = db.GetAllocPinned("allocid")
+		must.NoError(t, err)
+		must.True(t, pinned)
+
+		must.NoError(t, db.PutAllocPinned("allocid", false))
+		pinned, err = db.GetAllocPinned("allocid")
+		must.NoError(t, err)
+		must.False(t, pinned)
+
+		// Deleting the allocation should remove the pin
+		must.NoError(t, db.PutAllocPinned("allocid", true))
+		must.NoError(t, db.DeleteAllocationBucket("allocid"))
+		pinned, err = db.GetAllocPinned("allocid")
+		must.NoError(t, err)
+		must.False(t, pinned)
+	})
+}
+
+func TestStateDB_AllocUserNamespace(t *testing.T) {
+	ci.Parallel(t)
+
+	testDB(t, func(t *testing.T, db StateDB) {
+		// Allocations have no user namespace by default
+		userns, err := db.GetAllocUserNamespace("allocid")
+		must.NoError(t, err)
+		must.Nil(t, userns)
+
+		expected := &drivers.IDMapping{UIDStart: 100000, GIDStart: 100000, Size: 65536}
+		must.NoError(t, db.PutAllocUserNamespace("allocid", expected))
+		userns, err = db.GetAllocUserNamespace("allocid")
+		must.NoError(t, err)
+		must.Eq(t, expected, userns)
+
+		// Deleting the allocation should remove the user namespace
+		must.NoError(t, db.DeleteAllocationBucket("allocid"))
+		userns, err = db.GetAllocUserNamespace("allocid")
+		must.NoError(t, err)
+		must.Nil(t, userns)
+	})
+}
+
 // TestStateDB_DeviceManager asserts the behavior of device manager state related StateDB
 // methods.
 func TestStateDB_DeviceManager(t *testing.T) {
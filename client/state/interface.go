@@ -6,7 +6,9 @@ import (
 	"github.com/hashicorp/nomad/client/dynamicplugins"
 	driverstate "github.com/hashicorp/nomad/client/pluginmanager/drivermanager/state"
 	"github.com/hashicorp/nomad/client/serviceregistration/checks"
+	"github.com/hashicorp/nomad/client/stats"
 	"github.com/hashicorp/nomad/nomad/structs"
+	"github.com/hashicorp/nomad/plugins/drivers"
 )
 
 // StateDB implementations store and load Nomad client state.
@@ -41,6 +43,21 @@ type StateDB interface {
 	// PutNetworkStatus puts the allocation's network status. It may be nil.
 	PutNetworkStatus(allocID string, ns *structs.AllocNetworkStatus, opts ...WriteOption) error
 
+	// GetAllocPinned returns true if the allocation is pinned and must not
+	// be garbage collected.
+	GetAllocPinned(allocID string) (bool, error)
+
+	// PutAllocPinned sets whether the allocation is pinned.
+	PutAllocPinned(allocID string, pinned bool) error
+
+	// GetAllocUserNamespace returns the range of host IDs allocated to the
+	// user namespace of the allocation, or nil if it has none.
+	GetAllocUserNamespace(allocID string) (*drivers.IDMapping, error)
+
+	// PutAllocUserNamespace stores the range of host IDs allocated to the user
+	// namespace of the allocation.
+	PutAllocUserNamespace(allocID string, userns *drivers.IDMapping) error
+
 	// GetTaskRunnerState returns the LocalState and TaskState for a
 	// TaskRunner. Either state may be nil if it is not found, but if an
 	// error is encountered only the error will be non-nil.
@@ -54,6 +71,14 @@ type StateDB interface {
 	// error.
 	PutTaskState(allocID, taskName string, state *structs.TaskState) error
 
+	// GetTaskStatsHistory returns the resource usage history of a
+	// TaskRunner, ordered oldest first. It may be nil.
+	GetTaskStatsHistory(allocID, taskName string) ([]*stats.ResourceUsageSample, error)
+
+	// PutTaskStatsHistory stores the resource usage history of a
+	// TaskRunner or returns an error.
+	PutTaskStatsHistory(allocID, taskName string, samples []*stats.ResourceUsageSample) error
+
 	// DeleteTaskBucket deletes a task's state bucket if it exists. No
 	// error is returned if it does not exist.
 	DeleteTaskBucket(allocID, taskName string) error
@@ -0,0 +1,116 @@
+package stats
+
+import (
+	"sync"
+)
+
+// ResourceUsageSample is a compact point-in-time record of a task's resource
+// usage, as kept in the client's stats history.
+type ResourceUsageSample struct {
+	// Timestamp is when the sample was collected, in UnixNano
+	Timestamp int64
+
+	CpuPercent       float64
+	CpuTotalTicks    float64
+	ThrottledPeriods uint64
+	ThrottledTime    uint64
+
+	MemoryRSS      uint64
+	MemoryUsage    uint64
+	MemoryMaxUsage uint64
+	MemorySwap     uint64
+
+	IOReadBytes  uint64
+	IOWriteBytes uint64
+}
+
+// ResourceHistory is a bounded ring buffer of a task's resource usage
+// samples. Once full, adding a sample evicts the oldest one.
+type ResourceHistory struct {
+	samples []*ResourceUsageSample
+
+	// next is the index the next sample will be written to
+	next int
+
+	// count is the number of valid samples in the buffer
+	count int
+
+	l sync.RWMutex
+}
+
+// NewResourceHistory returns a ResourceHistory holding at most capacity
+// samples. A capacity of zero or less disables the history.
+func NewResourceHistory(capacity int) *ResourceHistory {
+	if capacity < 0 {
+		capacity = 0
+	}
+	return &ResourceHistory{
+		samples: make([]*ResourceUsageSample, capacity),
+	}
+}
+
+// Capacity returns the maximum number of samples kept.
+func (h *ResourceHistory) Capacity() int {
+	return len(h.samples)
+}
+
+// Add records the given sample.
+func (h *ResourceHistory) Add(sample *ResourceUsageSample) {
+	h.l.Lock()
+	defer h.l.Unlock()
+	h.addLocked(sample)
+}
+
+func (h *ResourceHistory) addLocked(sample *ResourceUsageSample) {
+	if len(h.samples) == 0 || sample == nil {
+		return
+	}
+
+	h.samples[h.next] = sample
+	h.next = (h.next + 1) % len(h.samples)
+	if h.count < len(h.samples) {
+		h.count++
+	}
+}
+
+// Restore replaces the contents of the history with the given samples, which
+// must be ordered oldest first. If there are more samples than the history
+// can hold, only the most recent are kept.
+func (h *ResourceHistory) Restore(samples []*ResourceUsageSample) {
+	h.l.Lock()
+	defer h.l.Unlock()
+
+	for i := range h.samples {
+		h.samples[i] = nil
+	}
+	h.next, h.count = 0, 0
+
+	if len(samples) > len(h.samples) {
+		samples = samples[len(samples)-len(h.samples):]
+	}
+	for _, sample := range samples {
+		h.addLocked(sample)
+	}
+}
+
+// Samples returns the samples collected after since (in UnixNano), ordered
+// oldest first. A zero since returns all samples.
+func (h *ResourceHistory) Samples(since int64) []*ResourceUsageSample {
+	h.l.RLock()
+	defer h.l.RUnlock()
+
+	out := make([]*ResourceUsageSample, 0, h.count)
+	if h.count == 0 {
+		return out
+	}
+
+	start := (h.next - h.count + len(h.samples)) % len(h.samples)
+	for i := 0; i < h.count; i++ {
+		sample := h.samples[(start+i)%len(h.samples)]
+		if sample.Timestamp <= since {
+			continue
+		}
+		out = append(out, sample)
+	}
+	return out
+}
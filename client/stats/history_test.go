@@ -0,0 +1,62 @@
+package stats
+
+import (
+	"testing"
+
+	"github.com/hashicorp/nomad/ci"
+	"github.com/shoenig/test/must"
+)
+
+func sampleTimestamps(samples []*ResourceUsageSample) []int64 {
+	out := make([]int64, 0, len(samples))
+	for _, s := range samples {
+		out = append(out, s.Timestamp)
+	}
+	return out
+}
+
+func TestResourceHistory_Add(t *testing.T) {
+	ci.Parallel(t)
+
+	h := NewResourceHistory(3)
+	must.Eq(t, []int64{}, sampleTimestamps(h.Samples(0)))
+
+	for i := int64(1); i <= 2; i++ {
+		h.Add(&ResourceUsageSample{Timestamp: i})
+	}
+	must.Eq(t, []int64{1, 2}, sampleTimestamps(h.Samples(0)))
+
+	// Wrap around and evict the oldest samples
+	for i := int64(3); i <= 5; i++ {
+		h.Add(&ResourceUsageSample{Timestamp: i})
+	}
+	must.Eq(t, []int64{3, 4, 5}, sampleTimestamps(h.Samples(0)))
+
+	// Filter by timestamp
+	must.Eq(t, []int64{5}, sampleTimestamps(h.Samples(4)))
+}
+
+func TestResourceHistory_Disabled(t *testing.T) {
+	ci.Parallel(t)
+
+	h := NewResourceHistory(0)
+	h.Add(&ResourceUsageSample{Timestamp: 1})
+	must.Len(t, 0, h.Samples(0))
+}
+
+func TestResourceHistory_Restore(t *testing.T) {
+	ci.Parallel(t)
+
+	h := NewResourceHistory(2)
+	h.Add(&ResourceUsageSample{Timestamp: 1})
+
+	// Only the most recent samples are kept when restoring more samples than
+	// the history can hold
+	h.Restore([]*ResourceUsageSample{
+		{Timestamp: 2}, {Timestamp: 3}, {Timestamp: 4},
+	})
+	must.Eq(t, []int64{3, 4}, sampleTimestamps(h.Samples(0)))
+
+	h.Add(&ResourceUsageSample{Timestamp: 5})
+	must.Eq(t, []int64{4, 5}, sampleTimestamps(h.Samples(0)))
+}
@@ -129,6 +129,25 @@ type FsStreamRequest struct {
 	structs.QueryOptions
 }
 
+// FsArchiveRequest is the initial request for streaming a tar archive of a
+// directory in an allocation.
+type FsArchiveRequest struct {
+	// AllocID is the allocation to archive the directory of
+	AllocID string
+
+	// Path is the path of the directory to archive
+	Path string
+
+	// Compress gzips the archive.
+	Compress bool
+
+	// Limit is the maximum number of bytes of files to archive. If zero, the
+	// client's default limit is used.
+	Limit int64
+
+	structs.QueryOptions
+}
+
 // FsLogsRequest is the initial request for accessing allocation logs.
 type FsLogsRequest struct {
 	// AllocID is the allocation to stream logs from
@@ -215,6 +234,30 @@ type AllocStatsResponse struct {
 	structs.QueryMeta
 }
 
+// AllocStatsHistoryRequest is used to request the recent resource usage
+// history of a given allocation, potentially filtering by task
+type AllocStatsHistoryRequest struct {
+	// AllocID is the allocation to retrieve the history for
+	AllocID string
+
+	// Task is an optional filter to only request the history for the task.
+	Task string
+
+	// Since is an optional UnixNano timestamp. Only samples collected after
+	// it are returned.
+	Since int64
+
+	structs.QueryOptions
+}
+
+// AllocStatsHistoryResponse is used to return the recent resource usage
+// history of a given allocation.
+type AllocStatsHistoryResponse struct {
+	// Tasks maps task names to their samples, ordered oldest first
+	Tasks map[string][]*stats.ResourceUsageSample
+	structs.QueryMeta
+}
+
 // MemoryStats holds memory usage related stats
 type MemoryStats struct {
 	RSS            uint64
@@ -273,16 +316,47 @@ func (cs *CpuStats) Add(other *CpuStats) {
 	cs.Measured = joinStringSet(cs.Measured, other.Measured)
 }
 
+// IOStats holds block IO related stats. Byte and operation counts are
+// cumulative for the lifetime of the task.
+type IOStats struct {
+	ReadBytes  uint64
+	WriteBytes uint64
+	ReadOps    uint64
+	WriteOps   uint64
+
+	// A list of fields whose values were actually sampled
+	Measured []string
+}
+
+func (is *IOStats) Add(other *IOStats) {
+	if other == nil {
+		return
+	}
+
+	is.ReadBytes += other.ReadBytes
+	is.WriteBytes += other.WriteBytes
+	is.ReadOps += other.ReadOps
+	is.WriteOps += other.WriteOps
+	is.Measured = joinStringSet(is.Measured, other.Measured)
+}
+
 // ResourceUsage holds information related to cpu and memory stats
 type ResourceUsage struct {
 	MemoryStats *MemoryStats
 	CpuStats    *CpuStats
+	IOStats     *IOStats
 	DeviceStats []*device.DeviceGroupStats
 }
 
 func (ru *ResourceUsage) Add(other *ResourceUsage) {
 	ru.MemoryStats.Add(other.MemoryStats)
 	ru.CpuStats.Add(other.CpuStats)
+	if other.IOStats != nil {
+		if ru.IOStats == nil {
+			ru.IOStats = &IOStats{}
+		}
+		ru.IOStats.Add(other.IOStats)
+	}
 	ru.DeviceStats = append(ru.DeviceStats, other.DeviceStats...)
 }
 
@@ -294,6 +368,40 @@ type TaskResourceUsage struct {
 	Pids          map[string]*ResourceUsage
 }
 
+// Sample returns a compact record of the aggregate resource usage, as kept in
+// the client's stats history.
+func (tru *TaskResourceUsage) Sample() *stats.ResourceUsageSample {
+	sample := &stats.ResourceUsageSample{
+		Timestamp: tru.Timestamp,
+	}
+
+	ru := tru.ResourceUsage
+	if ru == nil {
+		return sample
+	}
+
+	if cs := ru.CpuStats; cs != nil {
+		sample.CpuPercent = cs.Percent
+		sample.CpuTotalTicks = cs.TotalTicks
+		sample.ThrottledPeriods = cs.ThrottledPeriods
+		sample.ThrottledTime = cs.ThrottledTime
+	}
+
+	if ms := ru.MemoryStats; ms != nil {
+		sample.MemoryRSS = ms.RSS
+		sample.MemoryUsage = ms.Usage
+		sample.MemoryMaxUsage = ms.MaxUsage
+		sample.MemorySwap = ms.Swap
+	}
+
+	if is := ru.IOStats; is != nil {
+		sample.IOReadBytes = is.ReadBytes
+		sample.IOWriteBytes = is.WriteBytes
+	}
+
+	return sample
+}
+
 // AllocResourceUsage holds the aggregated task resource usage of the
 // allocation.
 type AllocResourceUsage struct {
@@ -305,6 +413,35 @@ type AllocResourceUsage struct {
 
 	// The max timestamp of all the Tasks
 	Timestamp int64
+
+	// DiskUsage is the usage of the allocation's ephemeral disk, if its size
+	// is being enforced
+	DiskUsage *AllocDiskUsage
+}
+
+const (
+	// DiskEnforcementProjectQuota is used when an allocation's ephemeral disk
+	// size is enforced by a filesystem project quota.
+	DiskEnforcementProjectQuota = "project_quota"
+
+	// DiskEnforcementAccounting is used when an allocation's ephemeral disk
+	// size is enforced by periodically measuring its usage.
+	DiskEnforcementAccounting = "accounting"
+)
+
+// AllocDiskUsage holds the usage of an allocation's ephemeral disk
+type AllocDiskUsage struct {
+	// UsedBytes is the number of bytes used by the alloc dir
+	UsedBytes uint64
+
+	// SizeBytes is the ephemeral_disk size of the allocation
+	SizeBytes uint64
+
+	// Enforcement is how the size is enforced
+	Enforcement string
+
+	// Timestamp is when the usage was measured, in UnixNano
+	Timestamp int64
 }
 
 // joinStringSet takes two slices of strings and joins them
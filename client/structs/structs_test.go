@@ -0,0 +1,35 @@
+package structs
+
+import (
+	"testing"
+
+	"github.com/hashicorp/nomad/ci"
+	"github.com/hashicorp/nomad/client/stats"
+	"github.com/shoenig/test/must"
+)
+
+func TestTaskResourceUsage_Sample(t *testing.T) {
+	ci.Parallel(t)
+
+	tru := &TaskResourceUsage{
+		Timestamp: 10,
+		ResourceUsage: &ResourceUsage{
+			CpuStats:    &CpuStats{Percent: 12.5, ThrottledPeriods: 3},
+			MemoryStats: &MemoryStats{RSS: 1024, Usage: 2048},
+			IOStats:     &IOStats{ReadBytes: 1, WriteBytes: 2},
+		},
+	}
+	must.Eq(t, &stats.ResourceUsageSample{
+		Timestamp:        10,
+		CpuPercent:       12.5,
+		ThrottledPeriods: 3,
+		MemoryRSS:        1024,
+		MemoryUsage:      2048,
+		IOReadBytes:      1,
+		IOWriteBytes:     2,
+	}, tru.Sample())
+
+	// Drivers that do not report IO stats leave them empty
+	tru.ResourceUsage.IOStats = nil
+	must.Zero(t, tru.Sample().IOReadBytes)
+}
@@ -102,6 +102,25 @@ func formatAuthMethodConfig(config *api.ACLAuthMethodConfig) string {
 		fmt.Sprintf("Claim mappings|%s", strings.Join(formatMap(config.ClaimMappings), "; ")),
 		fmt.Sprintf("List claim mappings|%s", strings.Join(formatMap(config.ListClaimMappings), "; ")),
 	}
+
+	// Only LDAP auth methods have an LDAP URL, so avoid cluttering the output
+	// of other methods with empty LDAP fields.
+	if config.LDAPURL != "" {
+		out = append(out,
+			fmt.Sprintf("LDAP URL|%s", config.LDAPURL),
+			fmt.Sprintf("LDAP StartTLS|%t", config.LDAPStartTLS),
+			fmt.Sprintf("LDAP CA cert|%s", config.LDAPCACert),
+			fmt.Sprintf("LDAP insecure skip verify|%t", config.LDAPInsecureSkipVerify),
+			fmt.Sprintf("LDAP bind DN|%s", config.LDAPBindDN),
+			fmt.Sprintf("LDAP bind password|%s", config.LDAPBindPassword),
+			fmt.Sprintf("LDAP user base DN|%s", config.LDAPUserBaseDN),
+			fmt.Sprintf("LDAP user filter|%s", config.LDAPUserFilter),
+			fmt.Sprintf("LDAP user attributes|%s", strings.Join(config.LDAPUserAttributes, ",")),
+			fmt.Sprintf("LDAP group base DN|%s", config.LDAPGroupBaseDN),
+			fmt.Sprintf("LDAP group filter|%s", config.LDAPGroupFilter),
+			fmt.Sprintf("LDAP group attribute|%s", config.LDAPGroupAttribute),
+		)
+	}
 	return formatKV(out)
 }
 
@@ -50,7 +50,8 @@ ACL Auth Method Create Options:
     between 1-128 characters and is a required parameter.
 
   -type
-    Sets the type of the auth method. Supported types are 'OIDC' and 'JWT'.
+    Sets the type of the auth method. Supported types are 'OIDC', 'JWT',
+    'LDAP' and 'WORKLOAD'.
 
   -max-token-ttl
     Sets the duration of time all tokens created by this auth method should be
@@ -82,7 +83,7 @@ func (a *ACLAuthMethodCreateCommand) AutocompleteFlags() complete.Flags {
 	return mergeAutocompleteFlags(a.Meta.AutocompleteFlags(FlagSetClient),
 		complete.Flags{
 			"-name":           complete.PredictAnything,
-			"-type":           complete.PredictSet("OIDC", "JWT"),
+			"-type":           complete.PredictSet("OIDC", "JWT", "LDAP", "WORKLOAD"),
 			"-max-token-ttl":  complete.PredictAnything,
 			"-token-locality": complete.PredictSet("local", "global"),
 			"-default":        complete.PredictSet("true", "false"),
@@ -139,25 +140,30 @@ func (a *ACLAuthMethodCreateCommand) Run(args []string) int {
 		a.Ui.Error("Max token TTL must be set to a value between min and max TTL configured for the server.")
 		return 1
 	}
-	if !slices.Contains([]string{"OIDC", "JWT"}, strings.ToUpper(a.methodType)) {
-		a.Ui.Error("ACL auth method type must be set to 'OIDC' or 'JWT'")
-		return 1
-	}
-	if len(a.config) == 0 {
-		a.Ui.Error("Must provide ACL auth method config in JSON format")
+	if !slices.Contains([]string{"OIDC", "JWT", "LDAP", "WORKLOAD"}, strings.ToUpper(a.methodType)) {
+		a.Ui.Error("ACL auth method type must be set to 'OIDC', 'JWT', 'LDAP' or 'WORKLOAD'")
 		return 1
 	}
 
-	config, err := loadDataSource(a.config, a.testStdin)
-	if err != nil {
-		a.Ui.Error(fmt.Sprintf("Error loading configuration: %v", err))
-		return 1
-	}
+	// Workload auth methods work without any config, since workload
+	// identities are verified by the servers and their claims are mapped by
+	// default.
+	var configJSON *api.ACLAuthMethodConfig
+	if len(a.config) != 0 {
+		config, err := loadDataSource(a.config, a.testStdin)
+		if err != nil {
+			a.Ui.Error(fmt.Sprintf("Error loading configuration: %v", err))
+			return 1
+		}
 
-	configJSON := api.ACLAuthMethodConfig{}
-	err = json.Unmarshal([]byte(config), &configJSON)
-	if err != nil {
-		a.Ui.Error(fmt.Sprintf("Unable to parse config: %v", err))
+		configJSON = &api.ACLAuthMethodConfig{}
+		err = json.Unmarshal([]byte(config), configJSON)
+		if err != nil {
+			a.Ui.Error(fmt.Sprintf("Unable to parse config: %v", err))
+			return 1
+		}
+	} else if strings.ToUpper(a.methodType) != api.ACLAuthMethodTypeWorkload {
+		a.Ui.Error("Must provide ACL auth method config in JSON format")
 		return 1
 	}
 
@@ -168,7 +174,7 @@ func (a *ACLAuthMethodCreateCommand) Run(args []string) int {
 		TokenLocality: a.tokenLocality,
 		MaxTokenTTL:   a.maxTokenTTL,
 		Default:       a.isDefault,
-		Config:        &configJSON,
+		Config:        configJSON,
 	}
 
 	// Get the HTTP client.
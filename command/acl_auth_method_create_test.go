@@ -96,4 +96,17 @@ func TestACLAuthMethodCreateCommand_Run(t *testing.T) {
 
 	ui.OutputWriter.Reset()
 	ui.ErrorWriter.Reset()
+
+	// Create a workload auth method, which doesn't need a config
+	args = []string{
+		"-address=" + url, "-token=" + rootACLToken.SecretID, "-name=acl-auth-method-workload",
+		"-type=WORKLOAD", "-token-locality=local", "-max-token-ttl=3600s",
+	}
+	must.Eq(t, 0, cmd.Run(args))
+	s = ui.OutputWriter.String()
+	must.StrContains(t, s, "acl-auth-method-workload")
+	must.StrContains(t, s, "{nomad_job_id: job_id}")
+
+	ui.OutputWriter.Reset()
+	ui.ErrorWriter.Reset()
 }
@@ -27,6 +27,14 @@ Usage: nomad acl token <subcommand> [options] [args]
 
       $ nomad acl policy info <token_accessor_id>
 
+  Check whether the current token can submit jobs in a namespace:
+
+      $ nomad acl token can-i -namespace prod submit-job
+
+  Replace an ACL token with a new token, keeping the old token valid for 30m:
+
+      $ nomad acl token rotate -grace-period 30m <token_accessor_id>
+
   Revoke an ACL token:
 
       $ nomad acl policy delete <token_accessor_id>
@@ -0,0 +1,206 @@
+package command
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/nomad/api"
+	"github.com/posener/complete"
+)
+
+type ACLTokenCanICommand struct {
+	Meta
+
+	roleNames []string
+	policies  []string
+}
+
+func (c *ACLTokenCanICommand) Help() string {
+	helpText := `
+Usage: nomad acl token can-i [options] <capability>
+
+  Can-i checks whether the current ACL token is allowed a capability, and
+  explains which ACL policy rules allowed or denied it. The capability is
+  checked on the namespace of the -namespace flag, unless one of the -job,
+  -variable, -node or -host-volume flags is set. Node capabilities are "read"
+  and "write".
+
+  Management tokens can check the access of another token using -accessor-id,
+  or of a set of ACL roles and policies using -role-name and -policy.
+
+  The exit code is 0 if the capability is allowed, 2 if it is denied, and 1 if
+  the check failed.
+
+General Options:
+
+  ` + generalOptionsUsage(usageOptsDefault) + `
+
+Can-I Options:
+
+  -job=""
+    Checks the capability on the job with the given ID.
+
+  -variable=""
+    Checks the capability on the variable with the given path.
+
+  -host-volume=""
+    Checks the capability on the host volume with the given name.
+
+  -node
+    Checks the node policy.
+
+  -accessor-id=""
+    Checks the access of the ACL token with the given accessor ID.
+
+  -role-name=""
+    Checks the access of the ACL role with the given name. May be specified
+    multiple times.
+
+  -policy=""
+    Checks the access of the ACL policy with the given name. May be specified
+    multiple times.
+
+  -json
+    Output the result in JSON format.
+
+  -t
+    Format and display the result using a Go template.
+`
+	return strings.TrimSpace(helpText)
+}
+
+func (c *ACLTokenCanICommand) AutocompleteFlags() complete.Flags {
+	return mergeAutocompleteFlags(c.Meta.AutocompleteFlags(FlagSetClient),
+		complete.Flags{
+			"-job":         complete.PredictAnything,
+			"-variable":    complete.PredictAnything,
+			"-host-volume": complete.PredictAnything,
+			"-node":        complete.PredictNothing,
+			"-accessor-id": complete.PredictAnything,
+			"-role-name":   complete.PredictAnything,
+			"-policy":      complete.PredictAnything,
+			"-json":        complete.PredictNothing,
+			"-t":           complete.PredictAnything,
+		})
+}
+
+func (c *ACLTokenCanICommand) AutocompleteArgs() complete.Predictor {
+	return complete.PredictNothing
+}
+
+func (c *ACLTokenCanICommand) Synopsis() string {
+	return "Check whether an ACL token is allowed a capability"
+}
+
+func (c *ACLTokenCanICommand) Name() string { return "acl token can-i" }
+
+func (c *ACLTokenCanICommand) Run(args []string) int {
+	var job, variable, hostVolume, accessorID, tmpl string
+	var node, json bool
+
+	flags := c.Meta.FlagSet(c.Name(), FlagSetClient)
+	flags.Usage = func() { c.Ui.Output(c.Help()) }
+	flags.StringVar(&job, "job", "", "")
+	flags.StringVar(&variable, "variable", "", "")
+	flags.StringVar(&hostVolume, "host-volume", "", "")
+	flags.BoolVar(&node, "node", false, "")
+	flags.StringVar(&accessorID, "accessor-id", "", "")
+	flags.BoolVar(&json, "json", false, "")
+	flags.StringVar(&tmpl, "t", "", "")
+	flags.Var((funcVar)(func(s string) error {
+		c.roleNames = append(c.roleNames, s)
+		return nil
+	}), "role-name", "")
+	flags.Var((funcVar)(func(s string) error {
+		c.policies = append(c.policies, s)
+		return nil
+	}), "policy", "")
+	if err := flags.Parse(args); err != nil {
+		return 1
+	}
+
+	// Check that we got exactly one argument
+	args = flags.Args()
+	if l := len(args); l != 1 {
+		c.Ui.Error("This command takes one argument: <capability>")
+		c.Ui.Error(commandErrorText(c))
+		return 1
+	}
+
+	req := &api.ACLAuthorizeRequest{
+		AccessorID: accessorID,
+		Roles:      c.roleNames,
+		Policies:   c.policies,
+		Resource:   "namespace",
+		Capability: args[0],
+	}
+
+	// Determine the resource from the flags, only allowing one of them
+	var resources int
+	if job != "" {
+		req.Resource, req.Name = "job", job
+		resources++
+	}
+	if variable != "" {
+		req.Resource, req.Name = "variable", variable
+		resources++
+	}
+	if hostVolume != "" {
+		req.Resource, req.Name = "host_volume", hostVolume
+		resources++
+	}
+	if node {
+		req.Resource = "node"
+		resources++
+	}
+	if resources > 1 {
+		c.Ui.Error("Only one of -job, -variable, -host-volume or -node can be set")
+		return 1
+	}
+
+	// Get the HTTP client
+	client, err := c.Meta.Client()
+	if err != nil {
+		c.Ui.Error(fmt.Sprintf("Error initializing client: %s", err))
+		return 1
+	}
+
+	resp, _, err := client.ACLTokens().Authorize(req, nil)
+	if err != nil {
+		c.Ui.Error(fmt.Sprintf("Error checking ACL token capability: %s", err))
+		return 1
+	}
+
+	if json || len(tmpl) > 0 {
+		out, err := Format(json, tmpl, resp)
+		if err != nil {
+			c.Ui.Error(err.Error())
+			return 1
+		}
+		c.Ui.Output(out)
+	} else {
+		c.Ui.Output(formatACLAuthorizeResponse(resp))
+	}
+
+	if !resp.Allowed {
+		return 2
+	}
+	return 0
+}
+
+func formatACLAuthorizeResponse(resp *api.ACLAuthorizeResponse) string {
+	out := formatKV([]string{
+		fmt.Sprintf("Allowed|%t", resp.Allowed),
+		fmt.Sprintf("Reason|%s", resp.Reason),
+	})
+	if len(resp.Rules) == 0 {
+		return out
+	}
+
+	rules := []string{"Policy|Rule|Capabilities"}
+	for _, rule := range resp.Rules {
+		rules = append(rules, fmt.Sprintf("%s|%s|%s",
+			rule.Policy, rule.Rule, strings.Join(rule.Capabilities, ",")))
+	}
+	return fmt.Sprintf("%s\n\nRules\n%s", out, formatList(rules))
+}
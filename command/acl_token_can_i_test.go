@@ -0,0 +1,74 @@
+package command
+
+import (
+	"testing"
+
+	"github.com/hashicorp/nomad/ci"
+	"github.com/hashicorp/nomad/command/agent"
+	"github.com/hashicorp/nomad/nomad/mock"
+	"github.com/hashicorp/nomad/nomad/structs"
+	"github.com/mitchellh/cli"
+	"github.com/shoenig/test/must"
+)
+
+func TestACLTokenCanICommand_Run(t *testing.T) {
+	ci.Parallel(t)
+
+	config := func(c *agent.Config) {
+		c.ACL.Enabled = true
+	}
+
+	srv, _, url := testServer(t, true, config)
+	defer srv.Shutdown()
+
+	state := srv.Agent.Server().State()
+
+	// Create a policy and a token which uses it
+	policy := mock.ACLPolicy()
+	policy.Name = "web-deploy"
+	policy.Rules = `namespace "default" { job "web-*" { capabilities = ["submit-job"] } }`
+	policy.SetHash()
+	must.NoError(t, state.UpsertACLPolicies(structs.MsgTypeTestSetup, 1000, []*structs.ACLPolicy{policy}))
+
+	token := mock.ACLToken()
+	token.Policies = []string{policy.Name}
+	token.SetHash()
+	must.NoError(t, state.UpsertACLTokens(structs.MsgTypeTestSetup, 1001, []*structs.ACLToken{token}))
+
+	// The token is allowed to submit the job
+	ui := cli.NewMockUi()
+	cmd := &ACLTokenCanICommand{Meta: Meta{Ui: ui, flagAddress: url}}
+	code := cmd.Run([]string{"-address=" + url, "-token=" + token.SecretID, "-job=web-api", "submit-job"})
+	must.Zero(t, code)
+
+	out := ui.OutputWriter.String()
+	must.StrContains(t, out, `granted by namespace "default" job "web-*"`)
+	must.StrContains(t, out, "web-deploy")
+
+	// But not to submit other jobs
+	ui = cli.NewMockUi()
+	cmd = &ACLTokenCanICommand{Meta: Meta{Ui: ui, flagAddress: url}}
+	code = cmd.Run([]string{"-address=" + url, "-token=" + token.SecretID, "-job=api", "submit-job"})
+	must.Eq(t, 2, code)
+	must.StrContains(t, ui.OutputWriter.String(), `does not grant "submit-job"`)
+
+	// Only management tokens can check the access of policies
+	ui = cli.NewMockUi()
+	cmd = &ACLTokenCanICommand{Meta: Meta{Ui: ui, flagAddress: url}}
+	code = cmd.Run([]string{"-address=" + url, "-token=" + token.SecretID, "-policy=" + policy.Name, "read-job"})
+	must.One(t, code)
+	must.StrContains(t, ui.ErrorWriter.String(), "Permission denied")
+
+	ui = cli.NewMockUi()
+	cmd = &ACLTokenCanICommand{Meta: Meta{Ui: ui, flagAddress: url}}
+	code = cmd.Run([]string{"-address=" + url, "-token=" + srv.RootToken.SecretID, "-policy=" + policy.Name, "read-job"})
+	must.Eq(t, 2, code)
+	must.StrContains(t, ui.OutputWriter.String(), `namespace "default" does not grant "read-job"`)
+
+	// Only one resource can be checked at a time
+	ui = cli.NewMockUi()
+	cmd = &ACLTokenCanICommand{Meta: Meta{Ui: ui, flagAddress: url}}
+	code = cmd.Run([]string{"-address=" + url, "-job=web", "-node", "read"})
+	must.One(t, code)
+	must.StrContains(t, ui.ErrorWriter.String(), "Only one of")
+}
@@ -0,0 +1,127 @@
+package command
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/posener/complete"
+)
+
+type ACLTokenRotateCommand struct {
+	Meta
+}
+
+func (c *ACLTokenRotateCommand) Help() string {
+	helpText := `
+Usage: nomad acl token rotate [options] <token_accessor_id>
+
+  Rotate is used to replace an existing ACL token with a new token, which has
+  the same name, type, policies and roles. Both tokens are valid for a grace
+  period, so users of the rotated token can switch to the new token. The
+  rotated token then expires and is deleted. Requires a management token, or
+  the token being rotated.
+
+  A token rotating itself keeps its expiration time, while management tokens
+  give the new token the full TTL of the rotated token. Tokens created by an
+  auth method login can't be rotated.
+
+General Options:
+
+  ` + generalOptionsUsage(usageOptsDefault|usageOptsNoNamespace) + `
+
+Rotate Options:
+
+  -grace-period
+    Specifies how long the rotated token stays valid, such as "30m" or "24h".
+    Defaults to "1h". The grace period cannot be longer than the maximum
+    token expiration TTL of the servers, and the rotated token never stays
+    valid beyond its own expiration time.
+
+  -json
+    Output the new ACL token information in JSON format.
+
+  -t
+    Format and display the new ACL token information using a Go template.
+`
+	return strings.TrimSpace(helpText)
+}
+
+func (c *ACLTokenRotateCommand) AutocompleteFlags() complete.Flags {
+	return mergeAutocompleteFlags(c.Meta.AutocompleteFlags(FlagSetClient),
+		complete.Flags{
+			"-grace-period": complete.PredictAnything,
+			"-json":         complete.PredictNothing,
+			"-t":            complete.PredictAnything,
+		})
+}
+
+func (c *ACLTokenRotateCommand) AutocompleteArgs() complete.Predictor {
+	return complete.PredictNothing
+}
+
+func (c *ACLTokenRotateCommand) Synopsis() string {
+	return "Replace an ACL token with a new token"
+}
+
+func (c *ACLTokenRotateCommand) Name() string { return "acl token rotate" }
+
+func (c *ACLTokenRotateCommand) Run(args []string) int {
+	var gracePeriod time.Duration
+	var json bool
+	var tmpl string
+
+	flags := c.Meta.FlagSet(c.Name(), FlagSetClient)
+	flags.Usage = func() { c.Ui.Output(c.Help()) }
+	flags.DurationVar(&gracePeriod, "grace-period", 0, "")
+	flags.BoolVar(&json, "json", false, "")
+	flags.StringVar(&tmpl, "t", "", "")
+	if err := flags.Parse(args); err != nil {
+		return 1
+	}
+
+	// Check that we got exactly one argument
+	args = flags.Args()
+	if l := len(args); l != 1 {
+		c.Ui.Error("This command takes one argument: <token_accessor_id>")
+		c.Ui.Error(commandErrorText(c))
+		return 1
+	}
+
+	if gracePeriod < 0 {
+		c.Ui.Error("Grace period must not be negative")
+		return 1
+	}
+
+	// Get the HTTP client
+	client, err := c.Meta.Client()
+	if err != nil {
+		c.Ui.Error(fmt.Sprintf("Error initializing client: %s", err))
+		return 1
+	}
+
+	// Rotate the specified token
+	resp, _, err := client.ACLTokens().Rotate(args[0], gracePeriod, nil)
+	if err != nil {
+		c.Ui.Error(fmt.Sprintf("Error rotating token: %s", err))
+		return 1
+	}
+
+	if json || len(tmpl) > 0 {
+		out, err := Format(json, tmpl, resp.Token)
+		if err != nil {
+			c.Ui.Error(err.Error())
+			return 1
+		}
+
+		c.Ui.Output(out)
+		return 0
+	}
+
+	// Format the output
+	outputACLToken(c.Ui, resp.Token)
+	c.Ui.Output("")
+	c.Ui.Output(fmt.Sprintf("Token %s expires at %s",
+		resp.Rotated.AccessorID, expiryTimeString(resp.Rotated.ExpirationTime)))
+	return 0
+}
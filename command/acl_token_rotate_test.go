@@ -0,0 +1,71 @@
+package command
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/nomad/acl"
+	"github.com/hashicorp/nomad/ci"
+	"github.com/hashicorp/nomad/command/agent"
+	"github.com/hashicorp/nomad/nomad/mock"
+	"github.com/hashicorp/nomad/nomad/structs"
+	"github.com/mitchellh/cli"
+	"github.com/shoenig/test/must"
+)
+
+func TestACLTokenRotateCommand_Implements(t *testing.T) {
+	ci.Parallel(t)
+	var _ cli.Command = &ACLTokenRotateCommand{}
+}
+
+func TestACLTokenRotateCommand_Run(t *testing.T) {
+	ci.Parallel(t)
+
+	config := func(c *agent.Config) {
+		c.ACL.Enabled = true
+	}
+
+	srv, _, url := testServer(t, true, config)
+	defer srv.Shutdown()
+
+	token := srv.RootToken
+	must.NotNil(t, token)
+
+	ui := cli.NewMockUi()
+	cmd := &ACLTokenRotateCommand{Meta: Meta{Ui: ui, flagAddress: url}}
+	state := srv.Agent.Server().State()
+
+	mockToken := mock.ACLToken()
+	mockToken.Policies = []string{acl.PolicyWrite}
+	mockToken.SetHash()
+	must.NoError(t, state.UpsertACLTokens(structs.MsgTypeTestSetup, 1000, []*structs.ACLToken{mockToken}))
+
+	// The command takes exactly one argument
+	code := cmd.Run([]string{"-address=" + url, "-token=" + token.SecretID})
+	must.One(t, code)
+	must.StrContains(t, ui.ErrorWriter.String(), "This command takes one argument")
+	ui.ErrorWriter.Reset()
+
+	// Other tokens can't rotate the token
+	otherToken := mock.ACLToken()
+	must.NoError(t, state.UpsertACLTokens(structs.MsgTypeTestSetup, 1010, []*structs.ACLToken{otherToken}))
+	code = cmd.Run([]string{"-address=" + url, "-token=" + otherToken.SecretID, mockToken.AccessorID})
+	must.One(t, code)
+	must.StrContains(t, ui.ErrorWriter.String(), structs.ErrPermissionDenied.Error())
+	ui.ErrorWriter.Reset()
+
+	// Rotate the token using the token itself
+	code = cmd.Run([]string{"-address=" + url, "-token=" + mockToken.SecretID,
+		"-grace-period=10m", mockToken.AccessorID})
+	must.Zero(t, code)
+
+	out := ui.OutputWriter.String()
+	must.StrContains(t, out, mockToken.Name)
+	must.StrContains(t, out, fmt.Sprintf("Token %s expires at", mockToken.AccessorID))
+
+	rotated, err := state.ACLTokenByAccessorID(nil, mockToken.AccessorID)
+	must.NoError(t, err)
+	must.NotNil(t, rotated.ExpirationTime)
+	must.True(t, rotated.ExpirationTime.Before(time.Now().Add(11*time.Minute)))
+}
@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"net/http"
 	"strings"
+	"time"
 
 	"github.com/hashicorp/nomad/nomad/structs"
 )
@@ -133,6 +134,37 @@ func (s *HTTPServer) ACLTokensRequest(resp http.ResponseWriter, req *http.Reques
 	return out.Tokens, nil
 }
 
+// ACLTokensExpiringRequest lists the ACL tokens which expire within the time
+// window of the "within" query parameter.
+func (s *HTTPServer) ACLTokensExpiringRequest(resp http.ResponseWriter, req *http.Request) (interface{}, error) {
+	if req.Method != http.MethodGet {
+		return nil, CodedError(http.StatusMethodNotAllowed, ErrInvalidMethod)
+	}
+
+	args := structs.ACLTokenExpiringListRequest{}
+	if s.parse(resp, req, &args.Region, &args.QueryOptions) {
+		return nil, nil
+	}
+	if within := req.URL.Query().Get("within"); within != "" {
+		dur, err := time.ParseDuration(within)
+		if err != nil {
+			return nil, CodedError(http.StatusBadRequest, fmt.Sprintf("failed to parse within: %v", err))
+		}
+		args.Within = dur
+	}
+
+	var out structs.ACLTokenListResponse
+	if err := s.agent.RPC(structs.ACLListExpiringTokensRPCMethod, &args, &out); err != nil {
+		return nil, err
+	}
+
+	setMeta(resp, &out.QueryMeta)
+	if out.Tokens == nil {
+		out.Tokens = make([]*structs.ACLTokenListStub, 0)
+	}
+	return out.Tokens, nil
+}
+
 func (s *HTTPServer) ACLTokenBootstrap(resp http.ResponseWriter, req *http.Request) (interface{}, error) {
 	// Ensure this is a PUT or POST
 	if !(req.Method == "PUT" || req.Method == "POST") {
@@ -174,6 +206,9 @@ func (s *HTTPServer) ACLTokenSpecificRequest(resp http.ResponseWriter, req *http
 	}
 
 	accessor := strings.TrimPrefix(path, "/v1/acl/token/")
+	if strings.HasSuffix(accessor, "/rotate") {
+		return s.aclTokenRotate(resp, req, strings.TrimSuffix(accessor, "/rotate"))
+	}
 	return s.aclTokenCrud(resp, req, accessor)
 }
 
@@ -285,6 +320,38 @@ func (s *HTTPServer) aclTokenDelete(resp http.ResponseWriter, req *http.Request,
 	return nil, nil
 }
 
+// aclTokenRotate replaces the token with a new token which has the same
+// policies, roles and TTL. The rotated token expires after the grace period
+// of the "grace_period" query parameter.
+func (s *HTTPServer) aclTokenRotate(resp http.ResponseWriter, req *http.Request,
+	tokenAccessor string) (interface{}, error) {
+	if req.Method != http.MethodPut && req.Method != http.MethodPost {
+		return nil, CodedError(http.StatusMethodNotAllowed, ErrInvalidMethod)
+	}
+	if tokenAccessor == "" {
+		return nil, CodedError(http.StatusBadRequest, "Missing Token Accessor")
+	}
+
+	args := structs.ACLTokenRotateRequest{
+		AccessorID: tokenAccessor,
+	}
+	if gracePeriod := req.URL.Query().Get("grace_period"); gracePeriod != "" {
+		dur, err := time.ParseDuration(gracePeriod)
+		if err != nil {
+			return nil, CodedError(http.StatusBadRequest, fmt.Sprintf("failed to parse grace_period: %v", err))
+		}
+		args.GracePeriod = dur
+	}
+	s.parseWriteRequest(req, &args.WriteRequest)
+
+	var out structs.ACLTokenRotateResponse
+	if err := s.agent.RPC(structs.ACLRotateTokenRPCMethod, &args, &out); err != nil {
+		return nil, err
+	}
+	setIndex(resp, out.Index)
+	return &out, nil
+}
+
 func (s *HTTPServer) UpsertOneTimeToken(resp http.ResponseWriter, req *http.Request) (interface{}, error) {
 	// Ensure this is a PUT or POST
 	if !(req.Method == "PUT" || req.Method == "POST") {
@@ -893,3 +960,27 @@ func (s *HTTPServer) ACLLoginRequest(resp http.ResponseWriter, req *http.Request
 	setIndex(resp, out.Index)
 	return out.ACLToken, nil
 }
+
+// ACLAuthorizeRequest checks whether an operation on a resource is allowed,
+// and explains which ACL policy rules allowed or denied it.
+func (s *HTTPServer) ACLAuthorizeRequest(resp http.ResponseWriter, req *http.Request) (any, error) {
+	// The endpoint only supports PUT or POST requests.
+	if req.Method != http.MethodPost && req.Method != http.MethodPut {
+		return nil, CodedError(http.StatusMethodNotAllowed, ErrInvalidMethod)
+	}
+
+	var args structs.ACLAuthorizeRequest
+	if err := decodeBody(req, &args); err != nil {
+		return nil, CodedError(http.StatusBadRequest, err.Error())
+	}
+	if s.parse(resp, req, &args.Region, &args.QueryOptions) {
+		return nil, nil
+	}
+
+	var out structs.ACLAuthorizeResponse
+	if err := s.agent.RPC(structs.ACLAuthorizeRPCMethod, &args, &out); err != nil {
+		return nil, err
+	}
+	setMeta(resp, &out.QueryMeta)
+	return &out, nil
+}
@@ -559,6 +559,101 @@ func TestHTTP_ACLTokenDelete(t *testing.T) {
 	})
 }
 
+func TestHTTP_ACLTokenRotate(t *testing.T) {
+	ci.Parallel(t)
+	httpACLTest(t, nil, func(s *TestAgent) {
+		token := mock.ACLToken()
+		token.AccessorID = ""
+		token.ExpirationTTL = time.Hour
+		args := structs.ACLTokenUpsertRequest{
+			Tokens: []*structs.ACLToken{token},
+			WriteRequest: structs.WriteRequest{
+				Region:    "global",
+				AuthToken: s.RootToken.SecretID,
+			},
+		}
+		var resp structs.ACLTokenUpsertResponse
+		must.NoError(t, s.Agent.RPC(structs.ACLUpsertTokensRPCMethod, &args, &resp))
+		accessorID := resp.Tokens[0].AccessorID
+
+		// Only PUT and POST requests are allowed
+		req, err := http.NewRequest(http.MethodGet, "/v1/acl/token/"+accessorID+"/rotate", nil)
+		must.NoError(t, err)
+		respW := httptest.NewRecorder()
+		setToken(req, s.RootToken)
+		_, err = s.Server.ACLTokenSpecificRequest(respW, req)
+		must.ErrorContains(t, err, ErrInvalidMethod)
+
+		req, err = http.NewRequest(http.MethodPost,
+			"/v1/acl/token/"+accessorID+"/rotate?grace_period=5m", nil)
+		must.NoError(t, err)
+		respW = httptest.NewRecorder()
+		setToken(req, s.RootToken)
+
+		obj, err := s.Server.ACLTokenSpecificRequest(respW, req)
+		must.NoError(t, err)
+		must.NotEq(t, "", respW.Result().Header.Get("X-Nomad-Index"))
+
+		out := obj.(*structs.ACLTokenRotateResponse)
+		must.NotEq(t, accessorID, out.Token.AccessorID)
+		must.Eq(t, time.Hour, out.Token.ExpirationTTL)
+		must.Eq(t, accessorID, out.Rotated.AccessorID)
+		must.True(t, out.Rotated.ExpirationTime.Before(time.Now().Add(6*time.Minute)))
+
+		// The grace period must be a valid duration
+		req, err = http.NewRequest(http.MethodPost,
+			"/v1/acl/token/"+out.Token.AccessorID+"/rotate?grace_period=soon", nil)
+		must.NoError(t, err)
+		respW = httptest.NewRecorder()
+		setToken(req, s.RootToken)
+		_, err = s.Server.ACLTokenSpecificRequest(respW, req)
+		must.ErrorContains(t, err, "failed to parse grace_period")
+	})
+}
+
+func TestHTTP_ACLTokensExpiring(t *testing.T) {
+	ci.Parallel(t)
+	httpACLTest(t, nil, func(s *TestAgent) {
+		soonToken := mock.ACLToken()
+		soonToken.AccessorID = ""
+		soonToken.ExpirationTTL = 10 * time.Minute
+		laterToken := mock.ACLToken()
+		laterToken.AccessorID = ""
+		laterToken.ExpirationTTL = 5 * time.Hour
+		args := structs.ACLTokenUpsertRequest{
+			Tokens: []*structs.ACLToken{soonToken, laterToken},
+			WriteRequest: structs.WriteRequest{
+				Region:    "global",
+				AuthToken: s.RootToken.SecretID,
+			},
+		}
+		var resp structs.ACLTokenUpsertResponse
+		must.NoError(t, s.Agent.RPC(structs.ACLUpsertTokensRPCMethod, &args, &resp))
+
+		req, err := http.NewRequest(http.MethodGet, "/v1/acl/tokens/expiring?within=1h", nil)
+		must.NoError(t, err)
+		respW := httptest.NewRecorder()
+		setToken(req, s.RootToken)
+
+		obj, err := s.Server.ACLTokensExpiringRequest(respW, req)
+		must.NoError(t, err)
+		must.NotEq(t, "", respW.Result().Header.Get("X-Nomad-Index"))
+
+		stubs := obj.([]*structs.ACLTokenListStub)
+		must.Len(t, 1, stubs)
+		must.Eq(t, resp.Tokens[0].AccessorID, stubs[0].AccessorID)
+
+		req, err = http.NewRequest(http.MethodGet, "/v1/acl/tokens/expiring?within=6h", nil)
+		must.NoError(t, err)
+		respW = httptest.NewRecorder()
+		setToken(req, s.RootToken)
+
+		obj, err = s.Server.ACLTokensExpiringRequest(respW, req)
+		must.NoError(t, err)
+		must.Len(t, 2, obj.([]*structs.ACLTokenListStub))
+	})
+}
+
 func TestHTTP_OneTimeToken(t *testing.T) {
 	ci.Parallel(t)
 	httpACLTest(t, nil, func(s *TestAgent) {
@@ -23,6 +23,7 @@ import (
 	"github.com/hashicorp/nomad/client/state"
 	"github.com/hashicorp/nomad/command/agent/consul"
 	"github.com/hashicorp/nomad/command/agent/event"
+	"github.com/hashicorp/nomad/helper"
 	"github.com/hashicorp/nomad/helper/bufconndialer"
 	"github.com/hashicorp/nomad/helper/escapingfs"
 	"github.com/hashicorp/nomad/helper/pluginutils/loader"
@@ -273,6 +274,9 @@ func convertServerConfig(agentConfig *Config) (*nomad.Config, error) {
 	if agentConfig.ACL.TokenMaxExpirationTTL != 0 {
 		conf.ACLTokenMaxExpirationTTL = agentConfig.ACL.TokenMaxExpirationTTL
 	}
+	if agentConfig.ACL.TokenExpirationLeadTime != 0 {
+		conf.ACLTokenExpirationLeadTime = agentConfig.ACL.TokenExpirationLeadTime
+	}
 	if agentConfig.Sentinel != nil {
 		conf.SentinelConfig = agentConfig.Sentinel
 	}
@@ -338,6 +342,23 @@ func convertServerConfig(agentConfig *Config) (*nomad.Config, error) {
 	conf.JobMaxPriority = jobMaxPriority
 	conf.JobDefaultPriority = jobDefaultPriority
 
+	webhookNames := make(map[string]struct{}, len(agentConfig.Server.AdmissionWebhooks))
+	for _, webhook := range agentConfig.Server.AdmissionWebhooks {
+		if err := webhook.Validate(); err != nil {
+			return nil, fmt.Errorf("admission_webhook %q is invalid: %v", webhook.Name, err)
+		}
+		if _, ok := webhookNames[webhook.Name]; ok {
+			return nil, fmt.Errorf("admission_webhook %q is defined more than once", webhook.Name)
+		}
+		webhookNames[webhook.Name] = struct{}{}
+	}
+	conf.AdmissionWebhooks = helper.CopySlice(agentConfig.Server.AdmissionWebhooks)
+
+	if err := agentConfig.Server.RPCRateLimit.Validate(); err != nil {
+		return nil, fmt.Errorf("rpc_rate_limit is invalid: %v", err)
+	}
+	conf.RPCRateLimit = agentConfig.Server.RPCRateLimit.Copy()
+
 	// Set up the bind addresses
 	rpcAddr, err := net.ResolveTCPAddr("tcp", agentConfig.normalizedAddrs.RPC)
 	if err != nil {
@@ -691,6 +712,7 @@ func convertClientConfig(agentConfig *Config) (*clientconfig.Config, error) {
 	}
 	conf.ChrootEnv = agentConfig.Client.ChrootEnv
 	conf.Options = agentConfig.Client.Options
+	conf.Plugins = agentConfig.Plugins
 	if agentConfig.Client.NetworkSpeed != 0 {
 		conf.NetworkSpeed = agentConfig.Client.NetworkSpeed
 	}
@@ -783,6 +805,7 @@ func convertClientConfig(agentConfig *Config) (*clientconfig.Config, error) {
 	conf.StatsCollectionInterval = agentConfig.Telemetry.collectionInterval
 	conf.PublishNodeMetrics = agentConfig.Telemetry.PublishNodeMetrics
 	conf.PublishAllocationMetrics = agentConfig.Telemetry.PublishAllocationMetrics
+	conf.StatsHistorySize = agentConfig.Client.StatsHistorySize
 
 	// Set the TLS related configs
 	conf.TLSConfig = agentConfig.TLSConfig
@@ -794,6 +817,8 @@ func convertClientConfig(agentConfig *Config) (*clientconfig.Config, error) {
 	conf.GCDiskUsageThreshold = agentConfig.Client.GCDiskUsageThreshold
 	conf.GCInodeUsageThreshold = agentConfig.Client.GCInodeUsageThreshold
 	conf.GCMaxAllocs = agentConfig.Client.GCMaxAllocs
+	conf.GCFailedAllocRetention = agentConfig.Client.GCFailedAllocRetention
+	conf.GCMaxFailedAllocs = agentConfig.Client.GCMaxFailedAllocs
 	if agentConfig.Client.NoHostUUID != nil {
 		conf.NoHostUUID = *agentConfig.Client.NoHostUUID
 	} else {
@@ -838,6 +863,12 @@ func convertClientConfig(agentConfig *Config) (*clientconfig.Config, error) {
 	}
 	conf.Artifact = artifactConfig
 
+	outputConfig, err := clientconfig.OutputConfigFromAgent(agentConfig.Client.Output)
+	if err != nil {
+		return nil, fmt.Errorf("invalid output config: %v", err)
+	}
+	conf.Output = outputConfig
+
 	return conf, nil
 }
 
@@ -1287,8 +1318,7 @@ func (a *Agent) Reload(newConfig *Config) error {
 		}
 	}
 	// Allow auditor to call reopen regardless of config changes
-	// This is primarily for enterprise audit logging to allow the underlying
-	// file to be reopened if necessary
+	// This allows the underlying audit log files to be reopened if necessary
 	if err := a.auditor.Reopen(); err != nil {
 		return err
 	}
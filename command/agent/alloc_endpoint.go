@@ -210,6 +210,9 @@ func (s *HTTPServer) ClientAllocRequest(resp http.ResponseWriter, req *http.Requ
 	// tokenize the suffix of the path to get the alloc id and find the action
 	// invoked on the alloc id
 	tokens := strings.Split(reqSuffix, "/")
+	if len(tokens) == 3 && tokens[1] == "stats" && tokens[2] == "history" {
+		return s.allocStatsHistory(tokens[0], resp, req)
+	}
 	if len(tokens) != 2 {
 		return nil, CodedError(404, resourceNotFoundErr)
 	}
@@ -230,8 +233,16 @@ func (s *HTTPServer) ClientAllocRequest(resp http.ResponseWriter, req *http.Requ
 		return s.allocRestart(allocID, resp, req)
 	case "gc":
 		return s.allocGC(allocID, resp, req)
+	case "pin":
+		return s.allocPin(allocID, true, resp, req)
+	case "unpin":
+		return s.allocPin(allocID, false, resp, req)
 	case "signal":
 		return s.allocSignal(allocID, resp, req)
+	case "pause":
+		return s.allocPause(allocID, "Pause", resp, req)
+	case "resume":
+		return s.allocPause(allocID, "Resume", resp, req)
 	}
 
 	return nil, CodedError(404, resourceNotFoundErr)
@@ -350,6 +361,43 @@ func (s *HTTPServer) allocGC(allocID string, resp http.ResponseWriter, req *http
 	return nil, rpcErr
 }
 
+func (s *HTTPServer) allocPin(allocID string, pinned bool, resp http.ResponseWriter, req *http.Request) (interface{}, error) {
+	if !(req.Method == "POST" || req.Method == "PUT") {
+		return nil, CodedError(405, ErrInvalidMethod)
+	}
+
+	// Build the request and parse the ACL token
+	args := structs.AllocPinRequest{
+		AllocID: allocID,
+		Pinned:  pinned,
+	}
+	s.parse(resp, req, &args.QueryOptions.Region, &args.QueryOptions)
+
+	// Determine the handler to use
+	useLocalClient, useClientRPC, useServerRPC := s.rpcHandlerForAlloc(allocID)
+
+	// Make the RPC
+	var reply structs.GenericResponse
+	var rpcErr error
+	if useLocalClient {
+		rpcErr = s.agent.Client().ClientRPC("Allocations.Pin", &args, &reply)
+	} else if useClientRPC {
+		rpcErr = s.agent.Client().RPC("ClientAllocations.Pin", &args, &reply)
+	} else if useServerRPC {
+		rpcErr = s.agent.Server().RPC("ClientAllocations.Pin", &args, &reply)
+	} else {
+		rpcErr = CodedError(400, "No local Node and node_id not provided")
+	}
+
+	if rpcErr != nil {
+		if structs.IsErrNoNodeConn(rpcErr) || structs.IsErrUnknownAllocation(rpcErr) {
+			rpcErr = CodedError(404, rpcErr.Error())
+		}
+	}
+
+	return reply, rpcErr
+}
+
 func (s *HTTPServer) allocSignal(allocID string, resp http.ResponseWriter, req *http.Request) (interface{}, error) {
 	if !(req.Method == "POST" || req.Method == "PUT") {
 		return nil, CodedError(405, ErrInvalidMethod)
@@ -389,6 +437,47 @@ func (s *HTTPServer) allocSignal(allocID string, resp http.ResponseWriter, req *
 	return reply, rpcErr
 }
 
+// allocPause handles pausing and resuming the tasks of an allocation, with
+// method being the name of the RPC to make.
+func (s *HTTPServer) allocPause(allocID, method string, resp http.ResponseWriter, req *http.Request) (interface{}, error) {
+	if !(req.Method == "POST" || req.Method == "PUT") {
+		return nil, CodedError(405, ErrInvalidMethod)
+	}
+
+	// Build the request and parse the ACL token
+	args := structs.AllocPauseRequest{}
+	err := decodeBody(req, &args)
+	if err != nil {
+		return nil, CodedError(400, fmt.Sprintf("Failed to decode body: %v", err))
+	}
+	s.parse(resp, req, &args.QueryOptions.Region, &args.QueryOptions)
+	args.AllocID = allocID
+
+	// Determine the handler to use
+	useLocalClient, useClientRPC, useServerRPC := s.rpcHandlerForAlloc(allocID)
+
+	// Make the RPC
+	var reply structs.GenericResponse
+	var rpcErr error
+	if useLocalClient {
+		rpcErr = s.agent.Client().ClientRPC("Allocations."+method, &args, &reply)
+	} else if useClientRPC {
+		rpcErr = s.agent.Client().RPC("ClientAllocations."+method, &args, &reply)
+	} else if useServerRPC {
+		rpcErr = s.agent.Server().RPC("ClientAllocations."+method, &args, &reply)
+	} else {
+		rpcErr = CodedError(400, "No local Node and node_id not provided")
+	}
+
+	if rpcErr != nil {
+		if structs.IsErrNoNodeConn(rpcErr) || structs.IsErrUnknownAllocation(rpcErr) {
+			rpcErr = CodedError(404, rpcErr.Error())
+		}
+	}
+
+	return reply, rpcErr
+}
+
 func (s *HTTPServer) allocSnapshot(allocID string, resp http.ResponseWriter, req *http.Request) (interface{}, error) {
 	var secret string
 	s.parseToken(req, &secret)
@@ -441,6 +530,48 @@ func (s *HTTPServer) allocStats(allocID string, resp http.ResponseWriter, req *h
 	return reply.Stats, rpcErr
 }
 
+func (s *HTTPServer) allocStatsHistory(allocID string, resp http.ResponseWriter, req *http.Request) (interface{}, error) {
+
+	// Build the request and parse the ACL token
+	args := cstructs.AllocStatsHistoryRequest{
+		AllocID: allocID,
+		Task:    req.URL.Query().Get("task"),
+	}
+	if since := req.URL.Query().Get("since"); since != "" {
+		v, err := strconv.ParseInt(since, 10, 64)
+		if err != nil {
+			return nil, CodedError(400, fmt.Sprintf("Failed to parse since: %v", err))
+		}
+		args.Since = v
+	}
+	s.parse(resp, req, &args.QueryOptions.Region, &args.QueryOptions)
+
+	// Determine the handler to use
+	useLocalClient, useClientRPC, useServerRPC := s.rpcHandlerForAlloc(allocID)
+
+	// Make the RPC
+	var reply cstructs.AllocStatsHistoryResponse
+	var rpcErr error
+	switch {
+	case useLocalClient:
+		rpcErr = s.agent.Client().ClientRPC("Allocations.StatsHistory", &args, &reply)
+	case useClientRPC:
+		rpcErr = s.agent.Client().RPC("ClientAllocations.StatsHistory", &args, &reply)
+	case useServerRPC:
+		rpcErr = s.agent.Server().RPC("ClientAllocations.StatsHistory", &args, &reply)
+	default:
+		rpcErr = CodedError(400, "No local Node and node_id not provided")
+	}
+
+	if rpcErr != nil {
+		if structs.IsErrNoNodeConn(rpcErr) || structs.IsErrUnknownAllocation(rpcErr) {
+			rpcErr = CodedError(404, rpcErr.Error())
+		}
+	}
+
+	return reply.Tasks, rpcErr
+}
+
 func (s *HTTPServer) allocChecks(allocID string, resp http.ResponseWriter, req *http.Request) (any, error) {
 	// Build the request and parse the ACL token
 	args := cstructs.AllocChecksRequest{
@@ -21,6 +21,7 @@ import (
 	"github.com/hashicorp/nomad/nomad/mock"
 	"github.com/hashicorp/nomad/nomad/structs"
 	"github.com/hashicorp/nomad/testutil"
+	"github.com/shoenig/test/must"
 	"github.com/stretchr/testify/require"
 )
 
@@ -610,6 +611,33 @@ func TestHTTP_AllocStats(t *testing.T) {
 	})
 }
 
+func TestHTTP_AllocStatsHistory(t *testing.T) {
+	ci.Parallel(t)
+
+	httpTest(t, nil, func(s *TestAgent) {
+		// Local node, local resp
+		{
+			req, err := http.NewRequest("GET", fmt.Sprintf("/v1/client/allocation/%s/stats/history", uuid.Generate()), nil)
+			must.NoError(t, err)
+
+			respW := httptest.NewRecorder()
+			_, err = s.Server.ClientAllocRequest(respW, req)
+			must.Error(t, err)
+			must.True(t, structs.IsErrUnknownAllocation(err))
+		}
+
+		// Invalid since parameter
+		{
+			req, err := http.NewRequest("GET", fmt.Sprintf("/v1/client/allocation/%s/stats/history?since=yesterday", uuid.Generate()), nil)
+			must.NoError(t, err)
+
+			respW := httptest.NewRecorder()
+			_, err = s.Server.ClientAllocRequest(respW, req)
+			must.ErrorContains(t, err, "Failed to parse since")
+		}
+	})
+}
+
 func TestHTTP_AllocStats_ACL(t *testing.T) {
 	ci.Parallel(t)
 	require := require.New(t)
@@ -0,0 +1,653 @@
+package agent
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/hashicorp/go-hclog"
+	"github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/nomad/command/agent/event"
+	"github.com/hashicorp/nomad/helper/uuid"
+	"github.com/hashicorp/nomad/nomad/structs"
+	"github.com/hashicorp/nomad/nomad/structs/config"
+	"github.com/ryanuber/go-glob"
+)
+
+const (
+	auditEventType = "audit"
+	auditVersion   = 1
+
+	// auditStageReceived events are written before a request is handled,
+	// and auditStageComplete events after.
+	auditStageReceived = "OperationReceived"
+	auditStageComplete = "OperationComplete"
+
+	auditFilterTypeHTTP = "HTTPEvent"
+
+	auditSinkTypeFile       = "file"
+	auditSinkFormatJSON     = "json"
+	auditDeliveryEnforced   = "enforced"
+	auditDeliveryBestEffort = "best-effort"
+
+	defaultAuditRotateDuration = 24 * time.Hour
+	defaultAuditFileMode       = 0600
+)
+
+var (
+	// auditSensitiveKeys are the fields of request bodies that are replaced
+	// by their HMAC before being written to the audit log. The values of
+	// map fields, such as variable items and CSI volume secrets, are
+	// replaced individually so their keys are kept.
+	auditSensitiveKeys = map[string]struct{}{
+		"BootstrapSecret":  {},
+		"ConsulToken":      {},
+		"Items":            {},
+		"LDAPBindPassword": {},
+		"LoginToken":       {},
+		"OIDCClientSecret": {},
+		"OneTimeSecretID":  {},
+		"Password":         {},
+		"SecretID":         {},
+		"Secrets":          {},
+		"VaultToken":       {},
+	}
+)
+
+// auditor writes audit events to file sinks. It implements event.Auditor.
+type auditor struct {
+	logger  hclog.Logger
+	enabled atomic.Bool
+
+	lock     sync.RWMutex
+	sinks    []*auditSink
+	filters  []*config.AuditFilter
+	hmacKey  []byte
+	enforced bool
+}
+
+// Ensure auditor is an Auditor
+var _ event.Auditor = &auditor{}
+
+type auditSink struct {
+	name     string
+	enforced bool
+	file     *logFile
+}
+
+// newAuditor returns an auditor for the audit config. Sinks default to a
+// single file in the audit directory of the data dir.
+func newAuditor(conf *config.AuditConfig, dataDir string, logger hclog.Logger) (*auditor, error) {
+	a := &auditor{logger: logger.Named("audit")}
+	if err := a.configure(conf, dataDir); err != nil {
+		return nil, err
+	}
+	return a, nil
+}
+
+// configure replaces the sinks and filters of the auditor.
+func (a *auditor) configure(conf *config.AuditConfig, dataDir string) error {
+	if conf == nil {
+		conf = &config.AuditConfig{}
+	}
+	enabled := conf.Enabled != nil && *conf.Enabled
+
+	var sinks []*auditSink
+	if enabled {
+		sinkConfs := conf.Sinks
+		if len(sinkConfs) == 0 {
+			sinkConfs = []*config.AuditSink{{Name: "audit"}}
+		}
+		for _, sc := range sinkConfs {
+			sink, err := newAuditSink(sc, dataDir)
+			if err != nil {
+				return fmt.Errorf("invalid audit sink %q: %v", sc.Name, err)
+			}
+			sinks = append(sinks, sink)
+		}
+	}
+
+	for _, f := range conf.Filters {
+		if f.Type != "" && f.Type != auditFilterTypeHTTP {
+			return fmt.Errorf("invalid audit filter %q: type must be %q", f.Name, auditFilterTypeHTTP)
+		}
+	}
+
+	hmacKey := []byte(conf.HMACKey)
+	if len(hmacKey) == 0 {
+		a.lock.RLock()
+		hmacKey = a.hmacKey
+		a.lock.RUnlock()
+	}
+	if len(hmacKey) == 0 {
+		hmacKey = make([]byte, 32)
+		if _, err := rand.Read(hmacKey); err != nil {
+			return fmt.Errorf("failed to generate audit HMAC key: %v", err)
+		}
+	}
+
+	a.lock.Lock()
+	old := a.sinks
+	a.sinks = sinks
+	a.filters = conf.Copy().Filters
+	a.hmacKey = hmacKey
+	a.enforced = false
+	for _, sink := range sinks {
+		a.enforced = a.enforced || sink.enforced
+	}
+	a.lock.Unlock()
+
+	for _, sink := range old {
+		_ = sink.file.Close()
+	}
+
+	a.enabled.Store(enabled)
+	return nil
+}
+
+func newAuditSink(conf *config.AuditSink, dataDir string) (*auditSink, error) {
+	if conf.Type != "" && conf.Type != auditSinkTypeFile {
+		return nil, fmt.Errorf("type must be %q", auditSinkTypeFile)
+	}
+	if conf.Format != "" && conf.Format != auditSinkFormatJSON {
+		return nil, fmt.Errorf("format must be %q", auditSinkFormatJSON)
+	}
+
+	enforced := true
+	switch conf.DeliveryGuarantee {
+	case "", auditDeliveryEnforced:
+	case auditDeliveryBestEffort:
+		enforced = false
+	default:
+		return nil, fmt.Errorf("delivery_guarantee must be %q or %q", auditDeliveryEnforced, auditDeliveryBestEffort)
+	}
+
+	path := conf.Path
+	if path == "" {
+		if dataDir == "" {
+			return nil, errors.New("path must be set when the agent has no data_dir")
+		}
+		path = filepath.Join(dataDir, "audit", "audit.log")
+	}
+
+	mode := os.FileMode(defaultAuditFileMode)
+	if conf.Mode != "" {
+		m, err := strconv.ParseUint(conf.Mode, 8, 32)
+		if err != nil {
+			return nil, fmt.Errorf("mode %q is not an octal file mode", conf.Mode)
+		}
+		mode = os.FileMode(m)
+	}
+
+	duration := conf.RotateDuration
+	if duration == 0 {
+		duration = defaultAuditRotateDuration
+	}
+
+	if err := os.MkdirAll(filepath.Dir(path), 0700); err != nil {
+		return nil, fmt.Errorf("failed to create directory: %v", err)
+	}
+
+	return &auditSink{
+		name:     conf.Name,
+		enforced: enforced,
+		file: &logFile{
+			fileName: filepath.Base(path),
+			logPath:  filepath.Dir(path),
+			duration: duration,
+			MaxBytes: conf.RotateBytes,
+			MaxFiles: conf.RotateMaxFiles,
+			mode:     mode,
+		},
+	}, nil
+}
+
+// Event writes the payload to the sinks unless it is filtered out. An error
+// is returned if it could not be written to a sink with enforced delivery.
+func (a *auditor) Event(ctx context.Context, eventType string, payload interface{}) error {
+	if !a.Enabled() {
+		return nil
+	}
+
+	a.lock.RLock()
+	defer a.lock.RUnlock()
+
+	if p, ok := payload.(*auditPayload); ok && a.filtered(p) {
+		return nil
+	}
+
+	line, err := json.Marshal(&auditEvent{
+		CreatedAt: time.Now(),
+		EventType: eventType,
+		Payload:   payload,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to encode audit event: %v", err)
+	}
+	line = append(line, '\n')
+
+	var mErr *multierror.Error
+	for _, sink := range a.sinks {
+		if _, err := sink.file.Write(line); err != nil {
+			if sink.enforced {
+				mErr = multierror.Append(mErr, fmt.Errorf("failed to write to audit sink %q: %v", sink.name, err))
+				continue
+			}
+			a.logger.Warn("failed to write to best-effort audit sink", "sink", sink.name, "error", err)
+		}
+	}
+	return mErr.ErrorOrNil()
+}
+
+// filtered returns whether the payload matches a filter and must not be
+// written. Query parameters are ignored when matching endpoints.
+func (a *auditor) filtered(p *auditPayload) bool {
+	if p.Request == nil {
+		return false
+	}
+	endpoint, _, _ := strings.Cut(p.Request.Endpoint, "?")
+
+	matchAny := func(patterns []string, s string) bool {
+		for _, pattern := range patterns {
+			if glob.Glob(pattern, s) {
+				return true
+			}
+		}
+		return false
+	}
+
+	for _, f := range a.filters {
+		if matchAny(f.Endpoints, endpoint) &&
+			matchAny(f.Stages, p.Stage) &&
+			matchAny(f.Operations, p.Request.Operation) {
+			return true
+		}
+	}
+	return false
+}
+
+func (a *auditor) Enabled() bool {
+	return a.enabled.Load()
+}
+
+func (a *auditor) SetEnabled(enabled bool) {
+	a.enabled.Store(enabled)
+}
+
+// Reopen closes the files of the sinks so they are reopened on the next
+// event, such as after they have been moved by an external log rotation.
+func (a *auditor) Reopen() error {
+	a.lock.RLock()
+	defer a.lock.RUnlock()
+
+	var mErr *multierror.Error
+	for _, sink := range a.sinks {
+		if err := sink.file.Close(); err != nil {
+			mErr = multierror.Append(mErr, err)
+		}
+	}
+	return mErr.ErrorOrNil()
+}
+
+func (a *auditor) DeliveryEnforced() bool {
+	a.lock.RLock()
+	defer a.lock.RUnlock()
+	return a.enforced
+}
+
+// redact returns the decoded JSON value with the values of sensitive fields
+// replaced by their HMAC. The keys of variable items are kept so that the
+// changed items are visible.
+func (a *auditor) redact(v interface{}) interface{} {
+	switch v := v.(type) {
+	case map[string]interface{}:
+		out := make(map[string]interface{}, len(v))
+		for k, val := range v {
+			if _, ok := auditSensitiveKeys[k]; !ok {
+				out[k] = a.redact(val)
+				continue
+			}
+			if items, ok := val.(map[string]interface{}); ok {
+				hashed := make(map[string]interface{}, len(items))
+				for ik, iv := range items {
+					hashed[ik] = a.hmac(iv)
+				}
+				out[k] = hashed
+				continue
+			}
+			if val == nil || val == "" {
+				out[k] = val
+				continue
+			}
+			out[k] = a.hmac(val)
+		}
+		return out
+	case []interface{}:
+		out := make([]interface{}, len(v))
+		for i, val := range v {
+			out[i] = a.redact(val)
+		}
+		return out
+	default:
+		return v
+	}
+}
+
+func (a *auditor) hmac(v interface{}) string {
+	b, _ := json.Marshal(v)
+
+	a.lock.RLock()
+	mac := hmac.New(sha256.New, a.hmacKey)
+	a.lock.RUnlock()
+
+	mac.Write(b)
+	return "hmac-sha256:" + hex.EncodeToString(mac.Sum(nil))
+}
+
+// auditEvent is a line of the audit log
+type auditEvent struct {
+	CreatedAt time.Time   `json:"created_at"`
+	EventType string      `json:"event_type"`
+	Payload   interface{} `json:"payload"`
+}
+
+// auditPayload is an audit event for a stage of an HTTP request
+type auditPayload struct {
+	ID        string         `json:"id"`
+	Stage     string         `json:"stage"`
+	Type      string         `json:"type"`
+	Timestamp time.Time      `json:"timestamp"`
+	Version   int            `json:"version"`
+	Auth      *auditAuth     `json:"auth,omitempty"`
+	Request   *auditRequest  `json:"request"`
+	Response  *auditResponse `json:"response,omitempty"`
+}
+
+// auditAuth is the identity making a request: an ACL token, a workload
+// identity or a client.
+type auditAuth struct {
+	AccessorID string     `json:"accessor_id,omitempty"`
+	Name       string     `json:"name,omitempty"`
+	Type       string     `json:"type,omitempty"`
+	Policies   []string   `json:"policies,omitempty"`
+	RoleIDs    []string   `json:"role_ids,omitempty"`
+	Global     bool       `json:"global,omitempty"`
+	CreateTime *time.Time `json:"create_time,omitempty"`
+
+	Namespace    string `json:"namespace,omitempty"`
+	JobID        string `json:"job_id,omitempty"`
+	AllocationID string `json:"allocation_id,omitempty"`
+	TaskName     string `json:"task_name,omitempty"`
+
+	ClientID string `json:"client_id,omitempty"`
+}
+
+func newAuditAuth(identity *structs.AuthenticatedIdentity) *auditAuth {
+	if identity == nil {
+		return nil
+	}
+
+	auth := &auditAuth{ClientID: identity.ClientID}
+	if token := identity.ACLToken; token != nil {
+		auth.AccessorID = token.AccessorID
+		auth.Name = token.Name
+		auth.Type = token.Type
+		auth.Policies = token.Policies
+		auth.Global = token.Global
+		createTime := token.CreateTime
+		auth.CreateTime = &createTime
+		for _, role := range token.Roles {
+			auth.RoleIDs = append(auth.RoleIDs, role.ID)
+		}
+	}
+	if claims := identity.Claims; claims != nil {
+		auth.Namespace = claims.Namespace
+		auth.JobID = claims.JobID
+		auth.AllocationID = claims.AllocationID
+		auth.TaskName = claims.TaskName
+	}
+	return auth
+}
+
+type auditRequest struct {
+	ID          string           `json:"id"`
+	Operation   string           `json:"operation"`
+	Endpoint    string           `json:"endpoint"`
+	Namespace   auditNamespace   `json:"namespace"`
+	Object      *auditObject     `json:"object,omitempty"`
+	RequestMeta auditRequestMeta `json:"request_meta"`
+	NodeMeta    auditNodeMeta    `json:"node_meta"`
+	Body        interface{}      `json:"body,omitempty"`
+}
+
+type auditNamespace struct {
+	ID string `json:"id"`
+}
+
+// auditObject is the object a request is made for, such as a job
+type auditObject struct {
+	Type string `json:"type"`
+	ID   string `json:"id"`
+}
+
+type auditRequestMeta struct {
+	RemoteAddress string `json:"remote_address"`
+	UserAgent     string `json:"user_agent"`
+}
+
+type auditNodeMeta struct {
+	IP string `json:"ip"`
+}
+
+type auditResponse struct {
+	StatusCode int    `json:"status_code"`
+	Error      string `json:"error,omitempty"`
+}
+
+// auditObjectPaths map endpoint prefixes to the type of object named by the
+// following path segment. Longer prefixes must come first.
+var auditObjectPaths = []struct {
+	prefix string
+	typ    string
+
+	// skip is the number of path segments before the object ID
+	skip int
+
+	// rest is set if the object ID is the rest of the path
+	rest bool
+}{
+	{prefix: "/v1/client/allocation/", typ: "allocation"},
+	{prefix: "/v1/client/fs/", typ: "allocation", skip: 1},
+	{prefix: "/v1/allocation/", typ: "allocation"},
+	{prefix: "/v1/job/", typ: "job"},
+	{prefix: "/v1/node/", typ: "node"},
+	{prefix: "/v1/evaluation/", typ: "evaluation"},
+	{prefix: "/v1/deployment/", typ: "deployment"},
+	{prefix: "/v1/var/", typ: "variable", rest: true},
+	{prefix: "/v1/acl/token/", typ: "acl_token"},
+	{prefix: "/v1/acl/policy/", typ: "acl_policy"},
+	{prefix: "/v1/acl/role/", typ: "acl_role"},
+	{prefix: "/v1/acl/auth-method/", typ: "acl_auth_method"},
+	{prefix: "/v1/acl/binding-rule/", typ: "acl_binding_rule"},
+	{prefix: "/v1/namespace/", typ: "namespace"},
+	{prefix: "/v1/volume/csi/", typ: "csi_volume"},
+	{prefix: "/v1/service/", typ: "service"},
+	{prefix: "/v1/scaling/policy/", typ: "scaling_policy"},
+	{prefix: "/v1/event/sink/", typ: "event_sink"},
+}
+
+// auditObjectFromPath returns the object named by the request path, if any.
+func auditObjectFromPath(path string) *auditObject {
+	for _, p := range auditObjectPaths {
+		rest, ok := strings.CutPrefix(path, p.prefix)
+		if !ok {
+			continue
+		}
+
+		segments := strings.SplitN(rest, "/", p.skip+2)
+		if len(segments) <= p.skip {
+			return nil
+		}
+		id := segments[p.skip]
+		if p.rest {
+			id = strings.Join(segments[p.skip:], "/")
+		}
+		if id == "" {
+			return nil
+		}
+		return &auditObject{Type: p.typ, ID: id}
+	}
+	return nil
+}
+
+// maxAuditBodySize is the largest request body written to the audit log.
+// Larger bodies are omitted.
+const maxAuditBodySize = 1024 * 1024
+
+// auditRequestReceived writes the OperationReceived event for the request.
+// It returns the payload to pass to auditRequestComplete, or nil if the
+// request isn't audited.
+func (s *HTTPServer) auditRequestReceived(req *http.Request) (*auditPayload, error) {
+	a, ok := s.eventAuditor.(*auditor)
+	if !ok || !a.Enabled() {
+		return nil, nil
+	}
+
+	namespace := ""
+	parseNamespace(req, &namespace)
+
+	payload := &auditPayload{
+		ID:        uuid.Generate(),
+		Stage:     auditStageReceived,
+		Type:      auditEventType,
+		Timestamp: time.Now(),
+		Version:   auditVersion,
+		Auth:      newAuditAuth(s.auditIdentity(req)),
+		Request: &auditRequest{
+			ID:        uuid.Generate(),
+			Operation: req.Method,
+			Endpoint:  req.URL.String(),
+			Namespace: auditNamespace{ID: namespace},
+			Object:    auditObjectFromPath(req.URL.Path),
+			RequestMeta: auditRequestMeta{
+				RemoteAddress: req.RemoteAddr,
+				UserAgent:     req.UserAgent(),
+			},
+			NodeMeta: auditNodeMeta{IP: s.Addr},
+			Body:     a.requestBody(req),
+		},
+	}
+
+	if err := a.Event(req.Context(), auditEventType, payload); err != nil {
+		s.logger.Error("failed to write audit event", "stage", auditStageReceived, "error", err)
+		return nil, CodedError(500, "failed to write audit event")
+	}
+	return payload, nil
+}
+
+// auditRequestComplete writes the OperationComplete event for a request with
+// the response status and error. It shares its ID with the OperationReceived
+// event.
+func (s *HTTPServer) auditRequestComplete(req *http.Request, payload *auditPayload, code int, errMsg string) error {
+	if payload == nil {
+		return nil
+	}
+
+	complete := *payload
+	complete.Stage = auditStageComplete
+	complete.Response = &auditResponse{StatusCode: code, Error: errMsg}
+
+	if err := s.eventAuditor.Event(req.Context(), auditEventType, &complete); err != nil {
+		s.logger.Error("failed to write audit event", "stage", auditStageComplete, "error", err)
+		return CodedError(500, "failed to write audit event")
+	}
+	return nil
+}
+
+// auditIdentity returns the identity of the request's token, or nil if it
+// can't be resolved. Requests with invalid tokens are still audited.
+func (s *HTTPServer) auditIdentity(req *http.Request) *structs.AuthenticatedIdentity {
+	var secret string
+	s.parseToken(req, &secret)
+
+	if srv := s.agent.Server(); srv != nil {
+		args := &structs.GenericRequest{QueryOptions: structs.QueryOptions{AuthToken: secret}}
+		_ = srv.Authenticate(nil, args)
+		return args.GetIdentity()
+	}
+	if client := s.agent.Client(); client != nil {
+		identity, _ := client.ResolveIdentity(secret)
+		return identity
+	}
+	return nil
+}
+
+// requestBody returns the redacted JSON body of a write request, restoring
+// the body for the handler. Bodies which are too large or aren't JSON are
+// omitted.
+func (a *auditor) requestBody(req *http.Request) interface{} {
+	if req.Body == nil || req.Body == http.NoBody || req.Method == http.MethodGet {
+		return nil
+	}
+	if req.ContentLength > maxAuditBodySize {
+		return nil
+	}
+
+	buf, err := io.ReadAll(io.LimitReader(req.Body, maxAuditBodySize+1))
+	req.Body = struct {
+		io.Reader
+		io.Closer
+	}{io.MultiReader(bytes.NewReader(buf), req.Body), req.Body}
+	if err != nil || len(buf) == 0 || len(buf) > maxAuditBodySize {
+		return nil
+	}
+
+	var body interface{}
+	if err := json.Unmarshal(buf, &body); err != nil {
+		return nil
+	}
+	return a.redact(body)
+}
+
+// auditResponseWriter records the status code of responses written by
+// handlers which don't return errors, such as the UI.
+type auditResponseWriter struct {
+	http.ResponseWriter
+	code int
+}
+
+func (w *auditResponseWriter) WriteHeader(code int) {
+	if w.code == 0 {
+		w.code = code
+	}
+	w.ResponseWriter.WriteHeader(code)
+}
+
+func (w *auditResponseWriter) Write(b []byte) (int, error) {
+	if w.code == 0 {
+		w.code = http.StatusOK
+	}
+	return w.ResponseWriter.Write(b)
+}
+
+// Flush implements http.Flusher for streaming responses.
+func (w *auditResponseWriter) Flush() {
+	if f, ok := w.ResponseWriter.(http.Flusher); ok {
+		f.Flush()
+	}
+}
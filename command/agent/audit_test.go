@@ -0,0 +1,218 @@
+package agent
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/go-hclog"
+	"github.com/hashicorp/nomad/ci"
+	"github.com/hashicorp/nomad/helper/pointer"
+	"github.com/hashicorp/nomad/nomad/mock"
+	"github.com/hashicorp/nomad/nomad/structs/config"
+	"github.com/shoenig/test/must"
+)
+
+// readAuditLog returns the events written to an audit log file
+func readAuditLog(t *testing.T, path string) []map[string]any {
+	t.Helper()
+
+	f, err := os.Open(path)
+	must.NoError(t, err)
+	defer f.Close()
+
+	var events []map[string]any
+	scanner := bufio.NewScanner(f)
+	for scanner.Scan() {
+		var event map[string]any
+		must.NoError(t, json.Unmarshal(scanner.Bytes(), &event))
+		events = append(events, event)
+	}
+	must.NoError(t, scanner.Err())
+	return events
+}
+
+func TestAuditor_Event(t *testing.T) {
+	ci.Parallel(t)
+
+	dir := t.TempDir()
+	path := filepath.Join(dir, "audit.log")
+	a, err := newAuditor(&config.AuditConfig{
+		Enabled: pointer.Of(true),
+		Sinks:   []*config.AuditSink{{Name: "file", Path: path}},
+		Filters: []*config.AuditFilter{{
+			Name:       "metrics",
+			Type:       auditFilterTypeHTTP,
+			Endpoints:  []string{"/v1/metrics"},
+			Stages:     []string{"*"},
+			Operations: []string{"*"},
+		}},
+	}, dir, hclog.NewNullLogger())
+	must.NoError(t, err)
+	must.True(t, a.Enabled())
+	must.True(t, a.DeliveryEnforced())
+
+	event := func(endpoint string) *auditPayload {
+		return &auditPayload{
+			Stage:   auditStageReceived,
+			Request: &auditRequest{Operation: "GET", Endpoint: endpoint},
+		}
+	}
+
+	must.NoError(t, a.Event(context.Background(), auditEventType, event("/v1/jobs")))
+	must.NoError(t, a.Event(context.Background(), auditEventType, event("/v1/metrics?format=prometheus")))
+
+	a.SetEnabled(false)
+	must.NoError(t, a.Event(context.Background(), auditEventType, event("/v1/nodes")))
+
+	events := readAuditLog(t, path)
+	must.Len(t, 1, events)
+	must.Eq(t, auditEventType, events[0]["event_type"])
+	payload := events[0]["payload"].(map[string]any)
+	must.Eq(t, "/v1/jobs", payload["request"].(map[string]any)["endpoint"])
+
+	info, err := os.Stat(path)
+	must.NoError(t, err)
+	must.Eq(t, os.FileMode(0600), info.Mode().Perm())
+}
+
+func TestAuditor_configure(t *testing.T) {
+	ci.Parallel(t)
+
+	dir := t.TempDir()
+
+	// Sinks default to a file in the data dir
+	a, err := newAuditor(&config.AuditConfig{Enabled: pointer.Of(true)}, dir, hclog.NewNullLogger())
+	must.NoError(t, err)
+	must.NoError(t, a.Event(context.Background(), auditEventType, &auditPayload{}))
+	must.FileExists(t, filepath.Join(dir, "audit", "audit.log"))
+
+	// Best-effort sinks don't enforce delivery
+	must.NoError(t, a.configure(&config.AuditConfig{
+		Enabled: pointer.Of(true),
+		Sinks: []*config.AuditSink{{
+			Name:              "best-effort",
+			DeliveryGuarantee: auditDeliveryBestEffort,
+		}},
+	}, dir))
+	must.False(t, a.DeliveryEnforced())
+
+	// Reloading a disabled config disables the auditor
+	must.NoError(t, a.configure(&config.AuditConfig{Enabled: pointer.Of(false)}, dir))
+	must.False(t, a.Enabled())
+
+	for _, sink := range []*config.AuditSink{
+		{Name: "type", Type: "syslog"},
+		{Name: "format", Format: "text"},
+		{Name: "delivery", DeliveryGuarantee: "sometimes"},
+		{Name: "mode", Mode: "rw"},
+	} {
+		_, err := newAuditor(&config.AuditConfig{
+			Enabled: pointer.Of(true),
+			Sinks:   []*config.AuditSink{sink},
+		}, dir, hclog.NewNullLogger())
+		must.ErrorContains(t, err, `invalid audit sink "`+sink.Name+`"`)
+	}
+}
+
+func TestAuditor_redact(t *testing.T) {
+	ci.Parallel(t)
+
+	a, err := newAuditor(&config.AuditConfig{HMACKey: "key"}, "", hclog.NewNullLogger())
+	must.NoError(t, err)
+
+	var body any
+	must.NoError(t, json.Unmarshal([]byte(`{
+		"Path": "secret/db",
+		"Items": {"password": "hunter2"},
+		"Tokens": [{"Name": "ci", "SecretID": "abc"}],
+		"OneTimeSecretID": "def",
+		"Volumes": [{"ID": "db", "Secrets": {"password": "swordfish"}}]
+	}`), &body))
+
+	redacted := a.redact(body).(map[string]any)
+	must.Eq(t, "secret/db", redacted["Path"])
+
+	password := redacted["Items"].(map[string]any)["password"].(string)
+	must.StrHasPrefix(t, "hmac-sha256:", password)
+	must.StrNotContains(t, password, "hunter2")
+	must.Eq(t, a.hmac("hunter2"), password)
+
+	token := redacted["Tokens"].([]any)[0].(map[string]any)
+	must.Eq(t, "ci", token["Name"])
+	must.Eq[any](t, a.hmac("abc"), token["SecretID"])
+
+	must.Eq[any](t, a.hmac("def"), redacted["OneTimeSecretID"])
+
+	volume := redacted["Volumes"].([]any)[0].(map[string]any)
+	must.Eq[any](t, "db", volume["ID"])
+	must.Eq[any](t, a.hmac("swordfish"), volume["Secrets"].(map[string]any)["password"])
+}
+
+func TestAuditObjectFromPath(t *testing.T) {
+	ci.Parallel(t)
+
+	cases := []struct {
+		path     string
+		expected *auditObject
+	}{
+		{"/v1/jobs", nil},
+		{"/v1/job/", nil},
+		{"/v1/job/example", &auditObject{Type: "job", ID: "example"}},
+		{"/v1/job/example/allocations", &auditObject{Type: "job", ID: "example"}},
+		{"/v1/client/fs/cat/1234", &auditObject{Type: "allocation", ID: "1234"}},
+		{"/v1/client/allocation/1234/stats", &auditObject{Type: "allocation", ID: "1234"}},
+		{"/v1/var/secret/db", &auditObject{Type: "variable", ID: "secret/db"}},
+		{"/v1/acl/token/self", &auditObject{Type: "acl_token", ID: "self"}},
+	}
+	for _, tc := range cases {
+		t.Run(tc.path, func(t *testing.T) {
+			must.Eq(t, tc.expected, auditObjectFromPath(tc.path))
+		})
+	}
+}
+
+func TestHTTPServer_Audit(t *testing.T) {
+	ci.Parallel(t)
+
+	httpTest(t, func(c *Config) {
+		c.Audit = &config.AuditConfig{Enabled: pointer.Of(true)}
+	}, func(s *TestAgent) {
+		sv := mock.Variable()
+		buf := encodeReq(sv)
+		req, err := http.NewRequest("PUT", "/v1/var/"+sv.Path+"?namespace=default", buf)
+		must.NoError(t, err)
+		req.Header.Set("User-Agent", "audit-test")
+		respW := httptest.NewRecorder()
+		s.Server.mux.ServeHTTP(respW, req)
+		must.Eq(t, http.StatusOK, respW.Code)
+
+		events := readAuditLog(t, filepath.Join(s.DataDir, "audit", "audit.log"))
+		must.Len(t, 2, events)
+
+		received := events[0]["payload"].(map[string]any)
+		must.Eq(t, auditStageReceived, received["stage"])
+		request := received["request"].(map[string]any)
+		must.Eq(t, "PUT", request["operation"])
+		must.Eq(t, "audit-test", request["request_meta"].(map[string]any)["user_agent"])
+		must.Eq[any](t, map[string]any{"type": "variable", "id": sv.Path}, request["object"])
+
+		// Variable items are redacted
+		items := request["body"].(map[string]any)["Items"].(map[string]any)
+		for k, v := range items {
+			must.StrHasPrefix(t, "hmac-sha256:", v.(string))
+			must.StrNotContains(t, v.(string), sv.Items[k])
+		}
+		must.False(t, strings.Contains(respW.Body.String(), "hmac-sha256:"))
+
+		complete := events[1]["payload"].(map[string]any)
+		must.Eq(t, auditStageComplete, complete["stage"])
+		must.Eq[any](t, float64(200), complete["response"].(map[string]any)["status_code"])
+	})
+}
@@ -281,6 +281,19 @@ type ClientConfig struct {
 	// before garbage collection is triggered.
 	GCMaxAllocs int `hcl:"gc_max_allocs"`
 
+	// GCFailedAllocRetention is how long failed or OOM killed allocations
+	// are retained before they are eligible for garbage collection.
+	GCFailedAllocRetention    time.Duration
+	GCFailedAllocRetentionHCL string `hcl:"gc_failed_alloc_retention" json:"-"`
+
+	// GCMaxFailedAllocs is the maximum number of failed or OOM killed
+	// allocations to retain.
+	GCMaxFailedAllocs int `hcl:"gc_max_failed_allocs"`
+
+	// StatsHistorySize is the number of resource usage samples the client
+	// keeps for each task. A value of -1 disables the history.
+	StatsHistorySize int `hcl:"stats_history_size"`
+
 	// NoHostUUID disables using the host's UUID and will force generation of a
 	// random UUID.
 	NoHostUUID *bool `hcl:"no_host_uuid"`
@@ -341,6 +354,9 @@ type ClientConfig struct {
 	// Artifact contains the configuration for artifacts.
 	Artifact *config.ArtifactConfig `hcl:"artifact"`
 
+	// Output contains the configuration for uploading task outputs.
+	Output *config.OutputConfig `hcl:"output"`
+
 	// ExtraKeysHCL is used by hcl to surface unexpected keys
 	ExtraKeysHCL []string `hcl:",unusedKeys" json:"-"`
 }
@@ -363,6 +379,7 @@ func (c *ClientConfig) Copy() *ClientConfig {
 	nc.HostNetworks = helper.CopySlice(c.HostNetworks)
 	nc.NomadServiceDiscovery = pointer.Copy(c.NomadServiceDiscovery)
 	nc.Artifact = c.Artifact.Copy()
+	nc.Output = c.Output.Copy()
 	nc.ExtraKeysHCL = slices.Clone(c.ExtraKeysHCL)
 	return &nc
 }
@@ -409,6 +426,12 @@ type ACLConfig struct {
 	TokenMaxExpirationTTL    time.Duration
 	TokenMaxExpirationTTLHCL string `hcl:"token_max_expiration_ttl" json:"-"`
 
+	// TokenExpirationLeadTime is how long before an ACL token expires the
+	// leader publishes an ACLTokenExpiring event for it and counts it in the
+	// expiring tokens metric.
+	TokenExpirationLeadTime    time.Duration
+	TokenExpirationLeadTimeHCL string `hcl:"token_expiration_lead_time" json:"-"`
+
 	// ExtraKeysHCL is used by hcl to surface unexpected keys
 	ExtraKeysHCL []string `hcl:",unusedKeys" json:"-"`
 }
@@ -654,6 +677,14 @@ type ServerConfig struct {
 
 	// JobMaxPriority is an upper bound on the Job priority.
 	JobMaxPriority *int `hcl:"job_max_priority"`
+
+	// AdmissionWebhooks are the external admission webhooks called when
+	// jobs are registered, planned or validated.
+	AdmissionWebhooks []*config.AdmissionWebhookConfig `hcl:"admission_webhook"`
+
+	// RPCRateLimit configures per-identity limits on the rate of RPCs
+	// handled by this server.
+	RPCRateLimit *config.RPCRateLimitConfig `hcl:"rpc_rate_limit"`
 }
 
 func (s *ServerConfig) Copy() *ServerConfig {
@@ -681,6 +712,8 @@ func (s *ServerConfig) Copy() *ServerConfig {
 	ns.RaftTrailingLogs = pointer.Copy(s.RaftTrailingLogs)
 	ns.JobDefaultPriority = pointer.Copy(s.JobDefaultPriority)
 	ns.JobMaxPriority = pointer.Copy(s.JobMaxPriority)
+	ns.AdmissionWebhooks = helper.CopySlice(s.AdmissionWebhooks)
+	ns.RPCRateLimit = s.RPCRateLimit.Copy()
 	return &ns
 }
 
@@ -1267,6 +1300,7 @@ func DefaultConfig() *Config {
 			GCDiskUsageThreshold:  80,
 			GCInodeUsageThreshold: 70,
 			GCMaxAllocs:           50,
+			StatsHistorySize:      600,
 			NoHostUUID:            pointer.Of(true),
 			DisableRemoteExec:     false,
 			ServerJoin: &ServerJoin{
@@ -1283,6 +1317,7 @@ func DefaultConfig() *Config {
 			CNIConfigDir:                   "/opt/cni/config",
 			NomadServiceDiscovery:          pointer.Of(true),
 			Artifact:                       config.DefaultArtifactConfig(),
+			Output:                         config.DefaultOutputConfig(),
 		},
 		Server: &ServerConfig{
 			Enabled:           false,
@@ -1835,6 +1870,12 @@ func (a *ACLConfig) Merge(b *ACLConfig) *ACLConfig {
 	if b.TokenMaxExpirationTTLHCL != "" {
 		result.TokenMaxExpirationTTLHCL = b.TokenMaxExpirationTTLHCL
 	}
+	if b.TokenExpirationLeadTime != 0 {
+		result.TokenExpirationLeadTime = b.TokenExpirationLeadTime
+	}
+	if b.TokenExpirationLeadTimeHCL != "" {
+		result.TokenExpirationLeadTimeHCL = b.TokenExpirationLeadTimeHCL
+	}
 	if b.ReplicationToken != "" {
 		result.ReplicationToken = b.ReplicationToken
 	}
@@ -1885,6 +1926,12 @@ func (s *ServerConfig) Merge(b *ServerConfig) *ServerConfig {
 	if b.JobMaxPriority != nil {
 		result.JobMaxPriority = pointer.Of(*b.JobMaxPriority)
 	}
+	if len(b.AdmissionWebhooks) != 0 {
+		result.AdmissionWebhooks = config.AdmissionWebhookSetMerge(s.AdmissionWebhooks, b.AdmissionWebhooks)
+	}
+	if b.RPCRateLimit != nil {
+		result.RPCRateLimit = result.RPCRateLimit.Merge(b.RPCRateLimit)
+	}
 	if b.EvalGCThreshold != "" {
 		result.EvalGCThreshold = b.EvalGCThreshold
 	}
@@ -2099,6 +2146,18 @@ func (a *ClientConfig) Merge(b *ClientConfig) *ClientConfig {
 	if b.GCMaxAllocs != 0 {
 		result.GCMaxAllocs = b.GCMaxAllocs
 	}
+	if b.GCFailedAllocRetention != 0 {
+		result.GCFailedAllocRetention = b.GCFailedAllocRetention
+	}
+	if b.GCFailedAllocRetentionHCL != "" {
+		result.GCFailedAllocRetentionHCL = b.GCFailedAllocRetentionHCL
+	}
+	if b.GCMaxFailedAllocs != 0 {
+		result.GCMaxFailedAllocs = b.GCMaxFailedAllocs
+	}
+	if b.StatsHistorySize != 0 {
+		result.StatsHistorySize = b.StatsHistorySize
+	}
 	// NoHostUUID defaults to true, merge if false
 	if b.NoHostUUID != nil {
 		result.NoHostUUID = b.NoHostUUID
@@ -2187,6 +2246,7 @@ func (a *ClientConfig) Merge(b *ClientConfig) *ClientConfig {
 	}
 
 	result.Artifact = a.Artifact.Merge(b.Artifact)
+	result.Output = a.Output.Merge(b.Output)
 
 	return &result
 }
@@ -64,11 +64,13 @@ func ParseConfigFile(path string) (*Config, error) {
 	// convert strings to time.Durations
 	tds := []durationConversionMap{
 		{"gc_interval", &c.Client.GCInterval, &c.Client.GCIntervalHCL, nil},
+		{"gc_failed_alloc_retention", &c.Client.GCFailedAllocRetention, &c.Client.GCFailedAllocRetentionHCL, nil},
 		{"acl.token_ttl", &c.ACL.TokenTTL, &c.ACL.TokenTTLHCL, nil},
 		{"acl.policy_ttl", &c.ACL.PolicyTTL, &c.ACL.PolicyTTLHCL, nil},
 		{"acl.policy_ttl", &c.ACL.RoleTTL, &c.ACL.RoleTTLHCL, nil},
 		{"acl.token_min_expiration_ttl", &c.ACL.TokenMinExpirationTTL, &c.ACL.TokenMinExpirationTTLHCL, nil},
 		{"acl.token_max_expiration_ttl", &c.ACL.TokenMaxExpirationTTL, &c.ACL.TokenMaxExpirationTTLHCL, nil},
+		{"acl.token_expiration_lead_time", &c.ACL.TokenExpirationLeadTime, &c.ACL.TokenExpirationLeadTimeHCL, nil},
 		{"client.server_join.retry_interval", &c.Client.ServerJoin.RetryInterval, &c.Client.ServerJoin.RetryIntervalHCL, nil},
 		{"server.heartbeat_grace", &c.Server.HeartbeatGrace, &c.Server.HeartbeatGraceHCL, nil},
 		{"server.min_heartbeat_ttl", &c.Server.MinHeartbeatTTL, &c.Server.MinHeartbeatTTLHCL, nil},
@@ -244,6 +246,24 @@ func extraKeys(c *Config) error {
 		helper.RemoveEqualFold(&c.Audit.ExtraKeysHCL, "sink")
 	}
 
+	// Remove AdmissionWebhook extra keys
+	for _, w := range c.Server.AdmissionWebhooks {
+		helper.RemoveEqualFold(&c.Server.ExtraKeysHCL, w.Name)
+		helper.RemoveEqualFold(&c.Server.ExtraKeysHCL, "admission_webhook")
+	}
+
+	// Remove RPCRateLimit extra keys
+	if c.Server.RPCRateLimit != nil {
+		for _, e := range c.Server.RPCRateLimit.Endpoints {
+			helper.RemoveEqualFold(&c.Server.RPCRateLimit.ExtraKeysHCL, e.Name)
+			helper.RemoveEqualFold(&c.Server.RPCRateLimit.ExtraKeysHCL, "endpoint")
+		}
+		for _, o := range c.Server.RPCRateLimit.Overrides {
+			helper.RemoveEqualFold(&c.Server.RPCRateLimit.ExtraKeysHCL, o.Name)
+			helper.RemoveEqualFold(&c.Server.RPCRateLimit.ExtraKeysHCL, "override")
+		}
+	}
+
 	for _, k := range []string{"enabled_schedulers", "start_join", "retry_join", "server_join"} {
 		helper.RemoveEqualFold(&c.ExtraKeysHCL, k)
 		helper.RemoveEqualFold(&c.ExtraKeysHCL, "server")
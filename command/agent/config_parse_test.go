@@ -2,6 +2,7 @@ package agent
 
 import (
 	"fmt"
+	"os"
 	"path/filepath"
 	"sort"
 	"testing"
@@ -76,14 +77,17 @@ var basicConfig = &Config{
 			DiskMB:        10,
 			ReservedPorts: "1,100,10-12",
 		},
-		GCInterval:            6 * time.Second,
-		GCIntervalHCL:         "6s",
-		GCParallelDestroys:    6,
-		GCDiskUsageThreshold:  82,
-		GCInodeUsageThreshold: 91,
-		GCMaxAllocs:           50,
-		NoHostUUID:            pointer.Of(false),
-		DisableRemoteExec:     true,
+		GCInterval:                6 * time.Second,
+		GCIntervalHCL:             "6s",
+		GCParallelDestroys:        6,
+		GCDiskUsageThreshold:      82,
+		GCInodeUsageThreshold:     91,
+		GCMaxAllocs:               50,
+		GCFailedAllocRetention:    24 * time.Hour,
+		GCFailedAllocRetentionHCL: "24h",
+		GCMaxFailedAllocs:         10,
+		NoHostUUID:                pointer.Of(false),
+		DisableRemoteExec:         true,
 		HostVolumes: []*structs.ClientHostVolumeConfig{
 			{Name: "tmp", Path: "/tmp"},
 		},
@@ -153,18 +157,20 @@ var basicConfig = &Config{
 		JobMaxPriority:     pointer.Of(200),
 	},
 	ACL: &ACLConfig{
-		Enabled:                  true,
-		TokenTTL:                 60 * time.Second,
-		TokenTTLHCL:              "60s",
-		PolicyTTL:                60 * time.Second,
-		PolicyTTLHCL:             "60s",
-		RoleTTLHCL:               "60s",
-		RoleTTL:                  60 * time.Second,
-		TokenMinExpirationTTLHCL: "1h",
-		TokenMinExpirationTTL:    1 * time.Hour,
-		TokenMaxExpirationTTLHCL: "100h",
-		TokenMaxExpirationTTL:    100 * time.Hour,
-		ReplicationToken:         "foobar",
+		Enabled:                    true,
+		TokenTTL:                   60 * time.Second,
+		TokenTTLHCL:                "60s",
+		PolicyTTL:                  60 * time.Second,
+		PolicyTTLHCL:               "60s",
+		RoleTTLHCL:                 "60s",
+		RoleTTL:                    60 * time.Second,
+		TokenMinExpirationTTLHCL:   "1h",
+		TokenMinExpirationTTL:      1 * time.Hour,
+		TokenMaxExpirationTTLHCL:   "100h",
+		TokenMaxExpirationTTL:      100 * time.Hour,
+		TokenExpirationLeadTimeHCL: "2h",
+		TokenExpirationLeadTime:    2 * time.Hour,
+		ReplicationToken:           "foobar",
 	},
 	Audit: &config.AuditConfig{
 		Enabled: pointer.Of(true),
@@ -901,3 +907,46 @@ func permutations(arr []string) [][]string {
 	helper(arr, len(arr))
 	return res
 }
+
+func TestConfig_ParseRPCRateLimit(t *testing.T) {
+	ci.Parallel(t)
+
+	path := filepath.Join(t.TempDir(), "rate_limit.hcl")
+	require.NoError(t, os.WriteFile(path, []byte(`
+server {
+  rpc_rate_limit {
+    read  = 100
+    write = 10
+
+    endpoint "job" {
+      write = 5
+    }
+
+    override "ci" {
+      accessor_ids = ["a9b4b9c4-2cc4-4c8a-8d06-7d4c5e5b33ae"]
+      roles        = ["ci"]
+      list         = 0
+    }
+  }
+}
+`), 0o600))
+
+	c, err := ParseConfigFile(path)
+	require.NoError(t, err)
+	require.Equal(t, &config.RPCRateLimitConfig{
+		RateLimits: config.RateLimits{
+			Read:  pointer.Of(100),
+			Write: pointer.Of(10),
+		},
+		Endpoints: []*config.RPCRateLimitEndpoint{{
+			Name:       "job",
+			RateLimits: config.RateLimits{Write: pointer.Of(5)},
+		}},
+		Overrides: []*config.RPCRateLimitOverride{{
+			Name:        "ci",
+			AccessorIDs: []string{"a9b4b9c4-2cc4-4c8a-8d06-7d4c5e5b33ae"},
+			Roles:       []string{"ci"},
+			RateLimits:  config.RateLimits{List: pointer.Of(0)},
+		}},
+	}, c.Server.RPCRateLimit)
+}
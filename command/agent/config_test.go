@@ -154,13 +154,14 @@ func TestConfig_Merge(t *testing.T) {
 			},
 		},
 		ACL: &ACLConfig{
-			Enabled:               true,
-			TokenTTL:              60 * time.Second,
-			PolicyTTL:             60 * time.Second,
-			RoleTTL:               60 * time.Second,
-			TokenMinExpirationTTL: 60 * time.Second,
-			TokenMaxExpirationTTL: 60 * time.Second,
-			ReplicationToken:      "foo",
+			Enabled:                 true,
+			TokenTTL:                60 * time.Second,
+			PolicyTTL:               60 * time.Second,
+			RoleTTL:                 60 * time.Second,
+			TokenMinExpirationTTL:   60 * time.Second,
+			TokenMaxExpirationTTL:   60 * time.Second,
+			TokenExpirationLeadTime: 60 * time.Second,
+			ReplicationToken:        "foo",
 		},
 		Ports: &Ports{
 			HTTP: 4646,
@@ -361,13 +362,14 @@ func TestConfig_Merge(t *testing.T) {
 			JobDefaultPriority: pointer.Of(100),
 		},
 		ACL: &ACLConfig{
-			Enabled:               true,
-			TokenTTL:              20 * time.Second,
-			PolicyTTL:             20 * time.Second,
-			RoleTTL:               20 * time.Second,
-			TokenMinExpirationTTL: 20 * time.Second,
-			TokenMaxExpirationTTL: 20 * time.Second,
-			ReplicationToken:      "foobar",
+			Enabled:                 true,
+			TokenTTL:                20 * time.Second,
+			PolicyTTL:               20 * time.Second,
+			RoleTTL:                 20 * time.Second,
+			TokenMinExpirationTTL:   20 * time.Second,
+			TokenMaxExpirationTTL:   20 * time.Second,
+			TokenExpirationLeadTime: 20 * time.Second,
+			ReplicationToken:        "foobar",
 		},
 		Ports: &Ports{
 			HTTP: 20000,
@@ -0,0 +1,162 @@
+package agent
+
+import (
+	"net/http"
+	"strings"
+
+	"github.com/hashicorp/nomad/nomad/structs"
+)
+
+func (s *HTTPServer) EventSinksRequest(resp http.ResponseWriter, req *http.Request) (interface{}, error) {
+	if req.Method != "GET" {
+		return nil, CodedError(405, ErrInvalidMethod)
+	}
+
+	args := structs.EventSinkListRequest{}
+	if s.parse(resp, req, &args.Region, &args.QueryOptions) {
+		return nil, nil
+	}
+
+	var out structs.EventSinkListResponse
+	if err := s.agent.RPC("EventSink.List", &args, &out); err != nil {
+		return nil, err
+	}
+
+	setMeta(resp, &out.QueryMeta)
+	if out.Sinks == nil {
+		out.Sinks = make([]*structs.EventSink, 0)
+	}
+	return out.Sinks, nil
+}
+
+func (s *HTTPServer) EventSinksStatusRequest(resp http.ResponseWriter, req *http.Request) (interface{}, error) {
+	if req.Method != "GET" {
+		return nil, CodedError(405, ErrInvalidMethod)
+	}
+
+	out, err := s.eventSinkStatus(resp, req, "")
+	if out == nil || err != nil {
+		return nil, err
+	}
+	return out.Statuses, nil
+}
+
+func (s *HTTPServer) EventSinkSpecificRequest(resp http.ResponseWriter, req *http.Request) (interface{}, error) {
+	path := strings.TrimPrefix(req.URL.Path, "/v1/event/sink/")
+	if id, ok := strings.CutSuffix(path, "/status"); ok {
+		if req.Method != "GET" {
+			return nil, CodedError(405, ErrInvalidMethod)
+		}
+		return s.eventSinkStatusQuery(resp, req, id)
+	}
+
+	if len(path) == 0 {
+		return nil, CodedError(400, "Missing event sink ID")
+	}
+	switch req.Method {
+	case "GET":
+		return s.eventSinkQuery(resp, req, path)
+	case "PUT", "POST":
+		return s.eventSinkUpdate(resp, req, path)
+	case "DELETE":
+		return s.eventSinkDelete(resp, req, path)
+	default:
+		return nil, CodedError(405, ErrInvalidMethod)
+	}
+}
+
+func (s *HTTPServer) eventSinkQuery(resp http.ResponseWriter, req *http.Request, id string) (interface{}, error) {
+	args := structs.EventSinkSpecificRequest{
+		ID: id,
+	}
+	if s.parse(resp, req, &args.Region, &args.QueryOptions) {
+		return nil, nil
+	}
+
+	var out structs.EventSinkResponse
+	if err := s.agent.RPC("EventSink.Get", &args, &out); err != nil {
+		return nil, err
+	}
+
+	setMeta(resp, &out.QueryMeta)
+	if out.Sink == nil {
+		return nil, CodedError(404, "event sink not found")
+	}
+	return out.Sink, nil
+}
+
+func (s *HTTPServer) eventSinkStatusQuery(resp http.ResponseWriter, req *http.Request, id string) (interface{}, error) {
+	if len(id) == 0 {
+		return nil, CodedError(400, "Missing event sink ID")
+	}
+
+	out, err := s.eventSinkStatus(resp, req, id)
+	if out == nil || err != nil {
+		return nil, err
+	}
+	if len(out.Statuses) == 0 {
+		return nil, CodedError(404, "event sink not found")
+	}
+	return out.Statuses[0], nil
+}
+
+// eventSinkStatus returns the status of the event sink with the given ID, or
+// of all event sinks if the ID is empty.
+func (s *HTTPServer) eventSinkStatus(resp http.ResponseWriter, req *http.Request, id string) (*structs.EventSinkStatusResponse, error) {
+	args := structs.EventSinkStatusRequest{
+		ID: id,
+	}
+	if s.parse(resp, req, &args.Region, &args.QueryOptions) {
+		return nil, nil
+	}
+
+	var out structs.EventSinkStatusResponse
+	if err := s.agent.RPC("EventSink.Status", &args, &out); err != nil {
+		return nil, err
+	}
+
+	setMeta(resp, &out.QueryMeta)
+	if out.Statuses == nil {
+		out.Statuses = make([]*structs.EventSinkStatus, 0)
+	}
+	return &out, nil
+}
+
+func (s *HTTPServer) eventSinkUpdate(resp http.ResponseWriter, req *http.Request, id string) (interface{}, error) {
+	var sink structs.EventSink
+	if err := decodeBody(req, &sink); err != nil {
+		return nil, CodedError(http.StatusBadRequest, err.Error())
+	}
+
+	if sink.ID == "" {
+		sink.ID = id
+	} else if sink.ID != id {
+		return nil, CodedError(400, "Event sink ID does not match request path")
+	}
+
+	args := structs.EventSinkUpsertRequest{
+		Sinks: []*structs.EventSink{&sink},
+	}
+	s.parseWriteRequest(req, &args.WriteRequest)
+
+	var out structs.GenericResponse
+	if err := s.agent.RPC("EventSink.Upsert", &args, &out); err != nil {
+		return nil, err
+	}
+	setIndex(resp, out.Index)
+	return nil, nil
+}
+
+func (s *HTTPServer) eventSinkDelete(resp http.ResponseWriter, req *http.Request, id string) (interface{}, error) {
+	args := structs.EventSinkDeleteRequest{
+		IDs: []string{id},
+	}
+	s.parseWriteRequest(req, &args.WriteRequest)
+
+	var out structs.GenericResponse
+	if err := s.agent.RPC("EventSink.Delete", &args, &out); err != nil {
+		return nil, err
+	}
+	setIndex(resp, out.Index)
+	return nil, nil
+}
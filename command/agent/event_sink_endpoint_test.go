@@ -0,0 +1,65 @@
+package agent
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/hashicorp/nomad/ci"
+	"github.com/hashicorp/nomad/nomad/mock"
+	"github.com/hashicorp/nomad/nomad/structs"
+	"github.com/shoenig/test/must"
+)
+
+func TestHTTP_EventSinkCRUD(t *testing.T) {
+	ci.Parallel(t)
+	httpTest(t, nil, func(s *TestAgent) {
+		sink := mock.EventSink()
+
+		// The ID of the sink defaults to the one in the path
+		body := *sink
+		body.ID = ""
+		req, err := http.NewRequest("PUT", "/v1/event/sink/"+sink.ID, encodeReq(body))
+		must.NoError(t, err)
+		respW := httptest.NewRecorder()
+		_, err = s.Server.EventSinkSpecificRequest(respW, req)
+		must.NoError(t, err)
+		must.NotEq(t, "", respW.Header().Get("X-Nomad-Index"))
+
+		req, err = http.NewRequest("GET", "/v1/event/sink/"+sink.ID, nil)
+		must.NoError(t, err)
+		respW = httptest.NewRecorder()
+		obj, err := s.Server.EventSinkSpecificRequest(respW, req)
+		must.NoError(t, err)
+		must.Eq(t, sink.Address, obj.(*structs.EventSink).Address)
+
+		req, err = http.NewRequest("GET", "/v1/event/sinks", nil)
+		must.NoError(t, err)
+		respW = httptest.NewRecorder()
+		obj, err = s.Server.EventSinksRequest(respW, req)
+		must.NoError(t, err)
+		must.Len(t, 1, obj.([]*structs.EventSink))
+
+		req, err = http.NewRequest("GET", "/v1/event/sink/"+sink.ID+"/status", nil)
+		must.NoError(t, err)
+		respW = httptest.NewRecorder()
+		obj, err = s.Server.EventSinkSpecificRequest(respW, req)
+		must.NoError(t, err)
+		must.Eq(t, sink.ID, obj.(*structs.EventSinkStatus).ID)
+
+		req, err = http.NewRequest("DELETE", "/v1/event/sink/"+sink.ID, nil)
+		must.NoError(t, err)
+		respW = httptest.NewRecorder()
+		_, err = s.Server.EventSinkSpecificRequest(respW, req)
+		must.NoError(t, err)
+
+		// Unknown sinks are not found
+		for _, path := range []string{"/v1/event/sink/" + sink.ID, "/v1/event/sink/" + sink.ID + "/status"} {
+			req, err = http.NewRequest("GET", path, nil)
+			must.NoError(t, err)
+			respW = httptest.NewRecorder()
+			_, err = s.Server.EventSinkSpecificRequest(respW, req)
+			must.ErrorContains(t, err, "event sink not found")
+		}
+	})
+}
@@ -38,6 +38,8 @@ func (s *HTTPServer) FsRequest(resp http.ResponseWriter, req *http.Request) (int
 		return s.wrapUntrustedContent(s.FileCatRequest)(resp, req)
 	case strings.HasPrefix(path, "stream/"):
 		return s.Stream(resp, req)
+	case strings.HasPrefix(path, "archive/"):
+		return s.Archive(resp, req)
 	case strings.HasPrefix(path, "logs/"):
 		// Logs are *trusted* content because the endpoint
 		// explicitly sets the Content-Type to text/plain or
@@ -254,6 +256,58 @@ func (s *HTTPServer) Stream(resp http.ResponseWriter, req *http.Request) (interf
 	return s.fsStreamImpl(resp, req, "FileSystem.Stream", fsReq, fsReq.AllocID)
 }
 
+// Archive streams a tar archive of a directory. The parameters are:
+//   - path: path to the directory to archive.
+//   - compress: A boolean of whether to gzip the archive.
+//   - limit: The maximum number of bytes of files to archive.
+func (s *HTTPServer) Archive(resp http.ResponseWriter, req *http.Request) (interface{}, error) {
+	var allocID, path string
+	var compress bool
+	var limit int64
+	var err error
+
+	q := req.URL.Query()
+
+	if allocID = strings.TrimPrefix(req.URL.Path, "/v1/client/fs/archive/"); allocID == "" {
+		return nil, allocIDNotPresentErr
+	}
+	if path = q.Get("path"); path == "" {
+		path = "/"
+	}
+
+	if compressStr := q.Get("compress"); compressStr != "" {
+		if compress, err = strconv.ParseBool(compressStr); err != nil {
+			return nil, CodedError(400, fmt.Sprintf("failed to parse compress field to boolean: %v", err))
+		}
+	}
+
+	if limitStr := q.Get("limit"); limitStr != "" {
+		if limit, err = strconv.ParseInt(limitStr, 10, 64); err != nil {
+			return nil, CodedError(400, fmt.Sprintf("error parsing limit: %v", err))
+		}
+	}
+
+	// Create the request arguments
+	fsReq := &cstructs.FsArchiveRequest{
+		AllocID:  allocID,
+		Path:     path,
+		Compress: compress,
+		Limit:    limit,
+	}
+	s.parse(resp, req, &fsReq.QueryOptions.Region, &fsReq.QueryOptions)
+
+	// Force the Content-Type to avoid Go's http.ResponseWriter from
+	// detecting an incorrect or unsafe one.
+	if compress {
+		resp.Header().Set("Content-Type", "application/gzip")
+	} else {
+		resp.Header().Set("Content-Type", "application/x-tar")
+	}
+
+	// Make the request
+	return s.fsStreamImpl(resp, req, "FileSystem.Archive", fsReq, fsReq.AllocID)
+}
+
 // Logs streams the content of a log blocking on EOF. The parameters are:
 //   - task: task name to stream logs for.
 //   - type: stdout/stderr to stream.
@@ -1,6 +1,8 @@
 package agent
 
 import (
+	"archive/tar"
+	"compress/gzip"
 	"encoding/base64"
 	"fmt"
 	"io"
@@ -374,6 +376,39 @@ func TestHTTP_FS_Cat(t *testing.T) {
 	})
 }
 
+func TestHTTP_FS_Archive(t *testing.T) {
+	ci.Parallel(t)
+	httpTest(t, nil, func(s *TestAgent) {
+		a := mockFSAlloc(s.client.NodeID(), nil)
+		addAllocToClient(s, a, terminalClientAlloc)
+
+		path := fmt.Sprintf("/v1/client/fs/archive/%s?path=alloc/logs&compress=true", a.ID)
+
+		req, err := http.NewRequest("GET", path, nil)
+		require.NoError(t, err)
+		respW := httptest.NewRecorder()
+		_, err = s.Server.Archive(respW, req)
+		require.NoError(t, err)
+		require.Equal(t, "application/gzip", respW.Result().Header.Get("Content-Type"))
+
+		gz, err := gzip.NewReader(respW.Result().Body)
+		require.NoError(t, err)
+		tr := tar.NewReader(gz)
+		for {
+			hdr, err := tr.Next()
+			require.NoError(t, err, "stdout log not found in archive")
+			if hdr.Name != "alloc/logs/web.stdout.0" {
+				continue
+			}
+
+			output, err := io.ReadAll(tr)
+			require.NoError(t, err)
+			require.Equal(t, defaultLoggerMockDriverStdout, string(output))
+			return
+		}
+	})
+}
+
 // TestHTTP_FS_Cat_XSS asserts that the cat API is safe from XSS.
 func TestHTTP_FS_Cat_XSS(t *testing.T) {
 	ci.Parallel(t)
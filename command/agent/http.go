@@ -7,6 +7,7 @@ import (
 	"encoding/json"
 	"errors"
 	"fmt"
+	"math"
 	"net"
 	"net/http"
 	"net/http/pprof"
@@ -92,8 +93,7 @@ type RPCer interface {
 type HTTPServer struct {
 	agent RPCer
 
-	// eventAuditor is the enterprise audit log feature which is needed by the
-	// HTTP server.
+	// eventAuditor writes the audit log of requests to the HTTP server.
 	eventAuditor event.Auditor
 
 	mux        *http.ServeMux
@@ -407,6 +407,7 @@ func (s *HTTPServer) registerHandlers(enableDebug bool) {
 	s.mux.HandleFunc("/v1/acl/token/onetime/exchange", s.wrap(s.ExchangeOneTimeToken))
 	s.mux.HandleFunc("/v1/acl/bootstrap", s.wrap(s.ACLTokenBootstrap))
 	s.mux.HandleFunc("/v1/acl/tokens", s.wrap(s.ACLTokensRequest))
+	s.mux.HandleFunc("/v1/acl/tokens/expiring", s.wrap(s.ACLTokensExpiringRequest))
 	s.mux.HandleFunc("/v1/acl/token", s.wrap(s.ACLTokenSpecificRequest))
 	s.mux.HandleFunc("/v1/acl/token/", s.wrap(s.ACLTokenSpecificRequest))
 
@@ -430,11 +431,15 @@ func (s *HTTPServer) registerHandlers(enableDebug bool) {
 	s.mux.HandleFunc("/v1/acl/oidc/complete-auth", s.wrap(s.ACLOIDCCompleteAuthRequest))
 	s.mux.HandleFunc("/v1/acl/login", s.wrap(s.ACLLoginRequest))
 
+	// Register our ACL authorization check handler.
+	s.mux.HandleFunc("/v1/acl/authorize", s.wrap(s.ACLAuthorizeRequest))
+
 	s.mux.Handle("/v1/client/fs/", wrapCORS(s.wrap(s.FsRequest)))
 	s.mux.HandleFunc("/v1/client/gc", s.wrap(s.ClientGCRequest))
 	s.mux.Handle("/v1/client/stats", wrapCORS(s.wrap(s.ClientStatsRequest)))
 	s.mux.Handle("/v1/client/allocation/", wrapCORS(s.wrap(s.ClientAllocRequest)))
 	s.mux.Handle("/v1/client/metadata", wrapCORS(s.wrap(s.NodeMetaRequest)))
+	s.mux.Handle("/v1/client/plugin/reload", wrapCORS(s.wrap(s.NodePluginReloadRequest)))
 
 	s.mux.HandleFunc("/v1/agent/self", s.wrap(s.AgentSelfRequest))
 	s.mux.HandleFunc("/v1/agent/join", s.wrap(s.AgentJoinRequest))
@@ -487,6 +492,9 @@ func (s *HTTPServer) registerHandlers(enableDebug bool) {
 	s.mux.HandleFunc("/v1/operator/scheduler/configuration", s.wrap(s.OperatorSchedulerConfiguration))
 
 	s.mux.HandleFunc("/v1/event/stream", s.wrap(s.EventStream))
+	s.mux.HandleFunc("/v1/event/sinks", s.wrap(s.EventSinksRequest))
+	s.mux.HandleFunc("/v1/event/sinks/status", s.wrap(s.EventSinksStatusRequest))
+	s.mux.HandleFunc("/v1/event/sink/", s.wrap(s.EventSinkSpecificRequest))
 
 	s.mux.HandleFunc("/v1/namespaces", s.wrap(s.NamespacesRequest))
 	s.mux.HandleFunc("/v1/namespace", s.wrap(s.NamespaceCreateRequest))
@@ -691,6 +699,21 @@ func errCodeFromHandler(err error) (int, string) {
 	return code, errMsg
 }
 
+// setRetryAfter sets the Retry-After header of responses to requests
+// rejected by a rate limit, in whole seconds as required by RFC 9110.
+func setRetryAfter(resp http.ResponseWriter, code int, errMsg string) {
+	if code != http.StatusTooManyRequests {
+		return
+	}
+	if retryAfter, ok := structs.RetryAfterFromRateLimitedErr(errMsg); ok {
+		seconds := int64(math.Ceil(retryAfter.Seconds()))
+		if seconds < 1 {
+			seconds = 1
+		}
+		resp.Header().Set("Retry-After", strconv.FormatInt(seconds, 10))
+	}
+}
+
 // wrap is used to wrap functions to make them more convenient
 func (s *HTTPServer) wrap(handler func(resp http.ResponseWriter, req *http.Request) (interface{}, error)) func(resp http.ResponseWriter, req *http.Request) {
 	f := func(resp http.ResponseWriter, req *http.Request) {
@@ -730,6 +753,7 @@ func (s *HTTPServer) wrap(handler func(resp http.ResponseWriter, req *http.Reque
 				}
 			}
 
+			setRetryAfter(resp, code, errMsg)
 			resp.WriteHeader(code)
 			resp.Write([]byte(errMsg))
 			if isAPIClientError(code) {
@@ -788,6 +812,7 @@ func (s *HTTPServer) wrapNonJSON(handler func(resp http.ResponseWriter, req *htt
 		// Check for an error
 		if err != nil {
 			code, errMsg := errCodeFromHandler(err)
+			setRetryAfter(resp, code, errMsg)
 			resp.WriteHeader(code)
 			resp.Write([]byte(errMsg))
 			if isAPIClientError(code) {
@@ -27,17 +27,69 @@ func (s *HTTPServer) entOnly(resp http.ResponseWriter, req *http.Request) (inter
 	return nil, CodedError(501, ErrEntOnly)
 }
 
-// auditHandler wraps the passed handlerFn
+// auditHandler wraps the passed handlerFn, writing audit events before and
+// after the request is handled.
 func (s *HTTPServer) auditHandler(h handlerFn) handlerFn {
-	return h
+	return func(resp http.ResponseWriter, req *http.Request) (interface{}, error) {
+		payload, err := s.auditRequestReceived(req)
+		if err != nil {
+			return nil, err
+		}
+
+		obj, rspErr := h(resp, req)
+		code, errMsg := errCodeFromHandler(rspErr)
+		if code == 0 {
+			code = http.StatusOK
+		}
+		if err := s.auditRequestComplete(req, payload, code, errMsg); err != nil {
+			return nil, err
+		}
+		return obj, rspErr
+	}
 }
 
-// auditHTTPHandler wraps  the passed handlerByteFn
+// auditNonJSONHandler wraps the passed handlerByteFn, writing audit events
+// before and after the request is handled.
 func (s *HTTPServer) auditNonJSONHandler(h handlerByteFn) handlerByteFn {
-	return h
+	return func(resp http.ResponseWriter, req *http.Request) ([]byte, error) {
+		payload, err := s.auditRequestReceived(req)
+		if err != nil {
+			return nil, err
+		}
+
+		obj, rspErr := h(resp, req)
+		code, errMsg := errCodeFromHandler(rspErr)
+		if code == 0 {
+			code = http.StatusOK
+		}
+		if err := s.auditRequestComplete(req, payload, code, errMsg); err != nil {
+			return nil, err
+		}
+		return obj, rspErr
+	}
 }
 
-// auditHTTPHandler wraps the passed http.Handler
+// auditHTTPHandler wraps the passed http.Handler, writing audit events before
+// and after the request is handled.
 func (s *HTTPServer) auditHTTPHandler(h http.Handler) http.Handler {
-	return h
+	return http.HandlerFunc(func(resp http.ResponseWriter, req *http.Request) {
+		payload, err := s.auditRequestReceived(req)
+		if err != nil {
+			resp.WriteHeader(http.StatusInternalServerError)
+			resp.Write([]byte(err.Error()))
+			return
+		}
+		if payload == nil {
+			h.ServeHTTP(resp, req)
+			return
+		}
+
+		w := &auditResponseWriter{ResponseWriter: resp}
+		h.ServeHTTP(w, req)
+		if w.code == 0 {
+			w.code = http.StatusOK
+		}
+		// The response has already been written, so failures are only logged
+		_ = s.auditRequestComplete(req, payload, w.code, "")
+	})
 }
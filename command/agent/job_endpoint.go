@@ -1152,8 +1152,9 @@ func ApiTaskToStructsTask(job *structs.Job, group *structs.TaskGroup,
 
 	if apiTask.Identity != nil {
 		structsTask.Identity = &structs.WorkloadIdentity{
-			Env:  apiTask.Identity.Env,
-			File: apiTask.Identity.File,
+			Env:        apiTask.Identity.Env,
+			File:       apiTask.Identity.File,
+			AuthMethod: apiTask.Identity.AuthMethod,
 		}
 	}
 
@@ -1213,6 +1214,20 @@ func ApiTaskToStructsTask(job *structs.Job, group *structs.TaskGroup,
 		}
 	}
 
+	if len(apiTask.Outputs) > 0 {
+		structsTask.Outputs = []*structs.TaskOutput{}
+		for _, to := range apiTask.Outputs {
+			structsTask.Outputs = append(structsTask.Outputs,
+				&structs.TaskOutput{
+					Source:      *to.Source,
+					Destination: *to.Destination,
+					Options:     maps.Clone(to.Options),
+					Headers:     maps.Clone(to.Headers),
+					When:        *to.When,
+				})
+		}
+	}
+
 	if apiTask.Vault != nil {
 		structsTask.Vault = &structs.Vault{
 			Policies:     apiTask.Vault.Policies,
@@ -1260,6 +1275,36 @@ func ApiTaskToStructsTask(job *structs.Job, group *structs.TaskGroup,
 			Sidecar: apiTask.Lifecycle.Sidecar,
 		}
 	}
+
+	structsTask.PreStop = apiTaskPreStopToStructs(apiTask.PreStop)
+}
+
+// apiTaskPreStopToStructs is a copy and type conversion between the API
+// representation of a TaskPreStop and the struct representation.
+func apiTaskPreStopToStructs(preStop *api.TaskPreStop) *structs.TaskPreStop {
+	if preStop == nil {
+		return nil
+	}
+
+	out := &structs.TaskPreStop{
+		Timeout: *preStop.Timeout,
+	}
+	if preStop.Exec != nil {
+		out.Exec = &structs.TaskPreStopExec{
+			Command: preStop.Exec.Command,
+			Args:    preStop.Exec.Args,
+		}
+	}
+	if preStop.HTTP != nil {
+		out.HTTP = &structs.TaskPreStopHTTP{
+			Port:     preStop.HTTP.Port,
+			Path:     preStop.HTTP.Path,
+			Method:   preStop.HTTP.Method,
+			Protocol: preStop.HTTP.Protocol,
+			Header:   preStop.HTTP.Header,
+		}
+	}
+	return out
 }
 
 // apiWaitConfigToStructsWaitConfig is a copy and type conversion between the API
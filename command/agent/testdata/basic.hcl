@@ -84,13 +84,15 @@ client {
     collection_interval = "5s"
   }
 
-  gc_interval              = "6s"
-  gc_parallel_destroys     = 6
-  gc_disk_usage_threshold  = 82
-  gc_inode_usage_threshold = 91
-  gc_max_allocs            = 50
-  no_host_uuid             = false
-  disable_remote_exec      = true
+  gc_interval               = "6s"
+  gc_parallel_destroys      = 6
+  gc_disk_usage_threshold   = 82
+  gc_inode_usage_threshold  = 91
+  gc_max_allocs             = 50
+  gc_failed_alloc_retention = "24h"
+  gc_max_failed_allocs      = 10
+  no_host_uuid              = false
+  disable_remote_exec       = true
 
   host_volume "tmp" {
     path = "/tmp"
@@ -163,13 +165,14 @@ server {
 }
 
 acl {
-  enabled                  = true
-  token_ttl                = "60s"
-  policy_ttl               = "60s"
-  role_ttl                 = "60s"
-  token_min_expiration_ttl = "1h"
-  token_max_expiration_ttl = "100h"
-  replication_token        = "foobar"
+  enabled                    = true
+  token_ttl                  = "60s"
+  policy_ttl                 = "60s"
+  role_ttl                   = "60s"
+  token_min_expiration_ttl   = "1h"
+  token_max_expiration_ttl   = "100h"
+  token_expiration_lead_time = "2h"
+  replication_token          = "foobar"
 }
 
 audit {
@@ -75,6 +75,11 @@ FS Specific Options:
 
   -c
     Sets the tail location in number of bytes relative to the end of the file.
+
+  -archive
+    Write a gzipped tar archive of the directory at the given path to stdout.
+    The secrets directories of tasks are only included when the token has the
+    'alloc-exec' capability.
 `
 	return strings.TrimSpace(helpText)
 }
@@ -94,6 +99,7 @@ func (f *AllocFSCommand) AutocompleteFlags() complete.Flags {
 			"-tail":    complete.PredictNothing,
 			"-n":       complete.PredictAnything,
 			"-c":       complete.PredictAnything,
+			"-archive": complete.PredictNothing,
 		})
 }
 
@@ -115,7 +121,7 @@ func (f *AllocFSCommand) AutocompleteArgs() complete.Predictor {
 func (f *AllocFSCommand) Name() string { return "alloc fs" }
 
 func (f *AllocFSCommand) Run(args []string) int {
-	var verbose, machine, job, stat, tail, follow bool
+	var verbose, machine, job, stat, tail, follow, archive bool
 	var numLines, numBytes int64
 
 	flags := f.Meta.FlagSet(f.Name(), FlagSetClient)
@@ -128,6 +134,7 @@ func (f *AllocFSCommand) Run(args []string) int {
 	flags.BoolVar(&tail, "tail", false, "")
 	flags.Int64Var(&numLines, "n", -1, "")
 	flags.Int64Var(&numBytes, "c", -1, "")
+	flags.BoolVar(&archive, "archive", false, "")
 
 	if err := flags.Parse(args); err != nil {
 		return 1
@@ -150,6 +157,12 @@ func (f *AllocFSCommand) Run(args []string) int {
 		return 1
 	}
 
+	if archive && (stat || follow || tail) {
+		f.Ui.Error("The -archive flag can't be used with -stat, -f or -tail")
+		f.Ui.Error(commandErrorText(f))
+		return 1
+	}
+
 	path := "/"
 	if len(args) == 2 {
 		path = args[1]
@@ -206,6 +219,22 @@ func (f *AllocFSCommand) Run(args []string) int {
 		return 1
 	}
 
+	// If we want an archive, stream it to stdout and exit.
+	if archive {
+		r, err := client.AllocFS().Archive(alloc, path, true, 0, nil)
+		if err != nil {
+			f.Ui.Error(fmt.Sprintf("Error archiving alloc dir: %s", err))
+			return 1
+		}
+		defer r.Close()
+
+		if _, err := io.Copy(os.Stdout, r); err != nil {
+			f.Ui.Error(fmt.Sprintf("Error archiving alloc dir: %s", err))
+			return 1
+		}
+		return 0
+	}
+
 	// Get file stat info
 	file, _, err := client.AllocFS().Stat(alloc, path, nil)
 	if err != nil {
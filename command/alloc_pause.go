@@ -0,0 +1,177 @@
+package command
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/nomad/api"
+	"github.com/hashicorp/nomad/api/contexts"
+	"github.com/posener/complete"
+)
+
+type AllocPauseCommand struct {
+	Meta
+}
+
+func (c *AllocPauseCommand) Help() string {
+	helpText := `
+Usage: nomad alloc pause [options] <allocation> <task>
+
+  Pause an existing allocation. This command freezes the processes of the
+  allocation's running tasks, keeping their memory and state, until they are
+  resumed with "nomad alloc resume". If no task is provided then all of the
+  allocation's running tasks are paused. The task driver must support pausing
+  tasks.
+
+  When ACLs are enabled, this command requires a token with the
+  'alloc-lifecycle', 'read-job', and 'list-jobs' capabilities for the
+  allocation's namespace.
+
+General Options:
+
+  ` + generalOptionsUsage(usageOptsDefault) + `
+
+Pause Specific Options:
+
+  -task <task-name>
+    Specify the individual task to pause. If task name is given with both an
+    argument and the '-task' option, preference is given to the '-task'
+    option.
+
+  -verbose
+    Show full information.
+`
+	return strings.TrimSpace(helpText)
+}
+
+func (c *AllocPauseCommand) Name() string { return "alloc pause" }
+
+func (c *AllocPauseCommand) Run(args []string) int {
+	var verbose bool
+	var task string
+
+	flags := c.Meta.FlagSet(c.Name(), FlagSetClient)
+	flags.Usage = func() { c.Ui.Output(c.Help()) }
+	flags.BoolVar(&verbose, "verbose", false, "")
+	flags.StringVar(&task, "task", "", "")
+
+	if err := flags.Parse(args); err != nil {
+		return 1
+	}
+
+	client, alloc, task, code := lookupPauseAlloc(&c.Meta, c, flags.Args(), task, verbose)
+	if code != 0 {
+		return code
+	}
+
+	if err := client.Allocations().Pause(alloc, task, nil); err != nil {
+		c.Ui.Error(fmt.Sprintf("Error pausing allocation: %s", err))
+		return 1
+	}
+
+	return 0
+}
+
+func (c *AllocPauseCommand) Synopsis() string {
+	return "Pause a running allocation"
+}
+
+func (c *AllocPauseCommand) AutocompleteFlags() complete.Flags {
+	return mergeAutocompleteFlags(c.Meta.AutocompleteFlags(FlagSetClient),
+		complete.Flags{
+			"-task":    complete.PredictAnything,
+			"-verbose": complete.PredictNothing,
+		})
+}
+
+func (c *AllocPauseCommand) AutocompleteArgs() complete.Predictor {
+	return complete.PredictFunc(func(a complete.Args) []string {
+		client, err := c.Meta.Client()
+		if err != nil {
+			return nil
+		}
+
+		resp, _, err := client.Search().PrefixSearch(a.Last, contexts.Allocs, nil)
+		if err != nil {
+			return []string{}
+		}
+		return resp.Matches[contexts.Allocs]
+	})
+}
+
+// lookupPauseAlloc looks up the allocation of the "<allocation> <task>"
+// arguments of the alloc pause and resume commands. The task of the -task
+// option takes precedence over the argument. A non-zero exit code is returned
+// if the arguments are invalid or the allocation cannot be found.
+func lookupPauseAlloc(m *Meta, cmd NamedCommand, args []string, task string, verbose bool) (*api.Client, *api.Allocation, string, int) {
+	// Check that we got exactly one alloc
+	if len(args) < 1 || len(args) > 2 {
+		m.Ui.Error("This command takes up to two arguments: <alloc-id> <task>")
+		m.Ui.Error(commandErrorText(cmd))
+		return nil, nil, "", 1
+	}
+
+	allocID := args[0]
+
+	// Truncate the id unless full length is requested
+	length := shortId
+	if verbose {
+		length = fullId
+	}
+
+	// Query the allocation info
+	if len(allocID) == 1 {
+		m.Ui.Error("Alloc ID must contain at least two characters.")
+		return nil, nil, "", 1
+	}
+
+	allocID = sanitizeUUIDPrefix(allocID)
+
+	// Get the HTTP client
+	client, err := m.Client()
+	if err != nil {
+		m.Ui.Error(fmt.Sprintf("Error initializing client: %s", err))
+		return nil, nil, "", 1
+	}
+
+	allocs, _, err := client.Allocations().PrefixList(allocID)
+	if err != nil {
+		m.Ui.Error(fmt.Sprintf("Error querying allocation: %v", err))
+		return nil, nil, "", 1
+	}
+
+	if len(allocs) == 0 {
+		m.Ui.Error(fmt.Sprintf("No allocation(s) with prefix or id %q found", allocID))
+		return nil, nil, "", 1
+	}
+
+	if len(allocs) > 1 {
+		// Format the allocs
+		out := formatAllocListStubs(allocs, verbose, length)
+		m.Ui.Error(fmt.Sprintf("Prefix matched multiple allocations\n\n%s", out))
+		return nil, nil, "", 1
+	}
+
+	// Prefix lookup matched a single allocation
+	q := &api.QueryOptions{Namespace: allocs[0].Namespace}
+	alloc, _, err := client.Allocations().Info(allocs[0].ID, q)
+	if err != nil {
+		m.Ui.Error(fmt.Sprintf("Error querying allocation: %s", err))
+		return nil, nil, "", 1
+	}
+
+	// If -task isn't provided fallback to reading the task name
+	// from args.
+	if task == "" && len(args) >= 2 {
+		task = args[1]
+	}
+
+	if task != "" {
+		if err := validateTaskExistsInAllocation(task, alloc); err != nil {
+			m.Ui.Error(err.Error())
+			return nil, nil, "", 1
+		}
+	}
+
+	return client, alloc, task, 0
+}
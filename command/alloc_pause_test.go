@@ -0,0 +1,112 @@
+package command
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/nomad/api"
+	"github.com/hashicorp/nomad/ci"
+	"github.com/hashicorp/nomad/testutil"
+	"github.com/mitchellh/cli"
+	"github.com/shoenig/test/must"
+)
+
+func TestAllocPauseCommand_Implements(t *testing.T) {
+	ci.Parallel(t)
+	var _ cli.Command = &AllocPauseCommand{}
+}
+
+func TestAllocPauseCommand_Fails(t *testing.T) {
+	ci.Parallel(t)
+	srv, _, url := testServer(t, false, nil)
+	defer srv.Shutdown()
+
+	ui := cli.NewMockUi()
+	cmd := &AllocPauseCommand{Meta: Meta{Ui: ui}}
+
+	// Fails on lack of alloc ID
+	code := cmd.Run([]string{})
+	must.One(t, code)
+	must.StrContains(t, ui.ErrorWriter.String(), "This command takes up to two arguments")
+	ui.ErrorWriter.Reset()
+
+	// Fails on misuse
+	code = cmd.Run([]string{"some", "bad", "args"})
+	must.One(t, code)
+	must.StrContains(t, ui.ErrorWriter.String(), "This command takes up to two arguments")
+	ui.ErrorWriter.Reset()
+
+	// Fails on connection failure
+	code = cmd.Run([]string{"-address=nope", "foobar"})
+	must.One(t, code)
+	must.StrContains(t, ui.ErrorWriter.String(), "Error querying allocation")
+	ui.ErrorWriter.Reset()
+
+	// Fails on missing alloc
+	code = cmd.Run([]string{"-address=" + url, "26470238-5CF2-438F-8772-DC67CFB0705C"})
+	must.One(t, code)
+	must.StrContains(t, ui.ErrorWriter.String(), "No allocation(s) with prefix or id")
+	ui.ErrorWriter.Reset()
+
+	// Fail on identifier with too few characters
+	code = cmd.Run([]string{"-address=" + url, "2"})
+	must.One(t, code)
+	must.StrContains(t, ui.ErrorWriter.String(), "must contain at least two characters.")
+}
+
+func TestAllocPauseCommand_Run(t *testing.T) {
+	ci.Parallel(t)
+
+	srv, client, url := testServer(t, true, nil)
+	defer srv.Shutdown()
+
+	// Wait for a node to be ready
+	waitForNodes(t, client)
+
+	ui := cli.NewMockUi()
+
+	jobID := "job1_sfx"
+	job1 := testJob(jobID)
+	job1.TaskGroups[0].Tasks[0].Config["run_for"] = "30s"
+	resp, _, err := client.Jobs().Register(job1, nil)
+	must.NoError(t, err)
+
+	code := waitForSuccess(ui, client, fullId, t, resp.EvalID)
+	must.Zero(t, code)
+
+	// Get an alloc id
+	allocID := getAllocFromJob(t, client, jobID)
+
+	// Wait for alloc to be running
+	waitForAllocRunning(t, client, allocID)
+
+	waitForTaskState := func(state string) {
+		testutil.WaitForResult(func() (bool, error) {
+			alloc, _, err := client.Allocations().Info(allocID, nil)
+			if err != nil {
+				return false, err
+			}
+			if s := alloc.TaskStates["task1"].State; s != state {
+				return false, fmt.Errorf("expected task state %q, got %q", state, s)
+			}
+			return true, nil
+		}, func(err error) {
+			t.Fatal(err)
+		})
+	}
+
+	pause := &AllocPauseCommand{Meta: Meta{Ui: ui}}
+	code = pause.Run([]string{"-address=" + url, allocID, "task1"})
+	must.Zero(t, code, must.Sprint(ui.ErrorWriter.String()))
+	waitForTaskState("paused")
+
+	// Paused allocations are still running
+	alloc, _, err := client.Allocations().Info(allocID, nil)
+	must.NoError(t, err)
+	must.Eq(t, api.AllocClientStatusRunning, alloc.ClientStatus)
+
+	resume := &AllocResumeCommand{Meta: Meta{Ui: ui}}
+	code = resume.Run([]string{"-address=" + url, allocID})
+	must.Zero(t, code, must.Sprint(ui.ErrorWriter.String()))
+	waitForTaskState("running")
+}
@@ -0,0 +1,155 @@
+package command
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/nomad/api"
+	"github.com/hashicorp/nomad/api/contexts"
+	"github.com/posener/complete"
+)
+
+type AllocPinCommand struct {
+	Meta
+}
+
+func (c *AllocPinCommand) Help() string {
+	helpText := `
+Usage: nomad alloc pin [options] <allocation>
+
+  Pin a terminal allocation to keep its allocation directory on the client
+  for investigation. Pinned allocations are not garbage collected by the
+  client until they are unpinned. The pin is kept across client restarts.
+
+  When ACLs are enabled, this command requires a token with the 'submit-job',
+  'read-job', and 'list-jobs' capabilities for the allocation's namespace.
+
+General Options:
+
+  ` + generalOptionsUsage(usageOptsDefault) + `
+
+Pin Specific Options:
+
+  -unpin
+    Unpin the allocation, making it eligible for garbage collection again.
+
+  -verbose
+    Show full information.
+`
+	return strings.TrimSpace(helpText)
+}
+
+func (c *AllocPinCommand) Name() string { return "alloc pin" }
+
+func (c *AllocPinCommand) Run(args []string) int {
+	var verbose, unpin bool
+
+	flags := c.Meta.FlagSet(c.Name(), FlagSetClient)
+	flags.Usage = func() { c.Ui.Output(c.Help()) }
+	flags.BoolVar(&verbose, "verbose", false, "")
+	flags.BoolVar(&unpin, "unpin", false, "")
+
+	if err := flags.Parse(args); err != nil {
+		return 1
+	}
+
+	// Check that we got exactly one alloc
+	args = flags.Args()
+	if len(args) != 1 {
+		c.Ui.Error("This command takes one argument: <alloc-id>")
+		c.Ui.Error(commandErrorText(c))
+		return 1
+	}
+
+	allocID := args[0]
+
+	// Truncate the id unless full length is requested
+	length := shortId
+	if verbose {
+		length = fullId
+	}
+
+	// Query the allocation info
+	if len(allocID) == 1 {
+		c.Ui.Error("Alloc ID must contain at least two characters.")
+		return 1
+	}
+
+	allocID = sanitizeUUIDPrefix(allocID)
+
+	// Get the HTTP client
+	client, err := c.Meta.Client()
+	if err != nil {
+		c.Ui.Error(fmt.Sprintf("Error initializing client: %s", err))
+		return 1
+	}
+
+	allocs, _, err := client.Allocations().PrefixList(allocID)
+	if err != nil {
+		c.Ui.Error(fmt.Sprintf("Error querying allocation: %v", err))
+		return 1
+	}
+
+	if len(allocs) == 0 {
+		c.Ui.Error(fmt.Sprintf("No allocation(s) with prefix or id %q found", allocID))
+		return 1
+	}
+
+	if len(allocs) > 1 {
+		// Format the allocs
+		out := formatAllocListStubs(allocs, verbose, length)
+		c.Ui.Error(fmt.Sprintf("Prefix matched multiple allocations\n\n%s", out))
+		return 1
+	}
+
+	// Prefix lookup matched a single allocation
+	q := &api.QueryOptions{Namespace: allocs[0].Namespace}
+	alloc, _, err := client.Allocations().Info(allocs[0].ID, q)
+	if err != nil {
+		c.Ui.Error(fmt.Sprintf("Error querying allocation: %s", err))
+		return 1
+	}
+
+	if unpin {
+		if err := client.Allocations().Unpin(alloc, q); err != nil {
+			c.Ui.Error(fmt.Sprintf("Error unpinning allocation: %s", err))
+			return 1
+		}
+		c.Ui.Output(fmt.Sprintf("Allocation %q unpinned", limit(alloc.ID, length)))
+		return 0
+	}
+
+	if err := client.Allocations().Pin(alloc, q); err != nil {
+		c.Ui.Error(fmt.Sprintf("Error pinning allocation: %s", err))
+		return 1
+	}
+	c.Ui.Output(fmt.Sprintf("Allocation %q pinned", limit(alloc.ID, length)))
+	return 0
+}
+
+func (c *AllocPinCommand) Synopsis() string {
+	return "Exempt a terminal allocation from garbage collection"
+}
+
+func (c *AllocPinCommand) AutocompleteFlags() complete.Flags {
+	return mergeAutocompleteFlags(c.Meta.AutocompleteFlags(FlagSetClient),
+		complete.Flags{
+			"-unpin":   complete.PredictNothing,
+			"-verbose": complete.PredictNothing,
+		})
+}
+
+func (c *AllocPinCommand) AutocompleteArgs() complete.Predictor {
+	return complete.PredictFunc(func(a complete.Args) []string {
+		client, err := c.Meta.Client()
+		if err != nil {
+			return nil
+		}
+
+		resp, _, err := client.Search().PrefixSearch(a.Last, contexts.Allocs, nil)
+		if err != nil {
+			return []string{}
+		}
+		return resp.Matches[contexts.Allocs]
+	})
+}
@@ -0,0 +1,94 @@
+package command
+
+import (
+	"testing"
+
+	"github.com/hashicorp/nomad/api"
+	"github.com/hashicorp/nomad/ci"
+	"github.com/mitchellh/cli"
+	"github.com/shoenig/test/must"
+)
+
+func TestAllocPinCommand_Implements(t *testing.T) {
+	ci.Parallel(t)
+	var _ cli.Command = &AllocPinCommand{}
+}
+
+func TestAllocPinCommand_Fails(t *testing.T) {
+	ci.Parallel(t)
+	srv, _, url := testServer(t, false, nil)
+	defer srv.Shutdown()
+
+	ui := cli.NewMockUi()
+	cmd := &AllocPinCommand{Meta: Meta{Ui: ui}}
+
+	// Fails on lack of alloc ID
+	code := cmd.Run([]string{})
+	must.One(t, code)
+	must.StrContains(t, ui.ErrorWriter.String(), "This command takes one argument")
+	ui.ErrorWriter.Reset()
+
+	// Fails on misuse
+	code = cmd.Run([]string{"some", "bad", "args"})
+	must.One(t, code)
+	must.StrContains(t, ui.ErrorWriter.String(), "This command takes one argument")
+	ui.ErrorWriter.Reset()
+
+	// Fails on connection failure
+	code = cmd.Run([]string{"-address=nope", "foobar"})
+	must.One(t, code)
+	must.StrContains(t, ui.ErrorWriter.String(), "Error querying allocation")
+	ui.ErrorWriter.Reset()
+
+	// Fails on missing alloc
+	code = cmd.Run([]string{"-address=" + url, "26470238-5CF2-438F-8772-DC67CFB0705C"})
+	must.One(t, code)
+	must.StrContains(t, ui.ErrorWriter.String(), "No allocation(s) with prefix or id")
+	ui.ErrorWriter.Reset()
+
+	// Fail on identifier with too few characters
+	code = cmd.Run([]string{"-address=" + url, "2"})
+	must.One(t, code)
+	must.StrContains(t, ui.ErrorWriter.String(), "must contain at least two characters.")
+}
+
+func TestAllocPinCommand_Run(t *testing.T) {
+	ci.Parallel(t)
+
+	srv, client, url := testServer(t, true, nil)
+	defer srv.Shutdown()
+
+	// Wait for a node to be ready
+	waitForNodes(t, client)
+
+	ui := cli.NewMockUi()
+	cmd := &AllocPinCommand{Meta: Meta{Ui: ui}}
+
+	jobID := "job1_sfx"
+	job1 := testJob(jobID)
+	resp, _, err := client.Jobs().Register(job1, nil)
+	must.NoError(t, err)
+
+	code := waitForSuccess(ui, client, fullId, t, resp.EvalID)
+	must.Zero(t, code)
+
+	allocID := getAllocFromJob(t, client, jobID)
+
+	// Running allocations cannot be pinned
+	waitForAllocRunning(t, client, allocID)
+	code = cmd.Run([]string{"-address=" + url, allocID})
+	must.One(t, code)
+	must.StrContains(t, ui.ErrorWriter.String(), "is not terminal")
+	ui.ErrorWriter.Reset()
+
+	waitForAllocStatus(t, client, allocID, api.AllocClientStatusComplete)
+
+	code = cmd.Run([]string{"-address=" + url, allocID})
+	must.Zero(t, code, must.Sprint(ui.ErrorWriter.String()))
+	must.StrContains(t, ui.OutputWriter.String(), "pinned")
+	ui.OutputWriter.Reset()
+
+	code = cmd.Run([]string{"-address=" + url, "-unpin", allocID})
+	must.Zero(t, code)
+	must.StrContains(t, ui.OutputWriter.String(), "unpinned")
+}
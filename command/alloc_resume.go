@@ -0,0 +1,97 @@
+package command
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/nomad/api/contexts"
+	"github.com/posener/complete"
+)
+
+type AllocResumeCommand struct {
+	Meta
+}
+
+func (c *AllocResumeCommand) Help() string {
+	helpText := `
+Usage: nomad alloc resume [options] <allocation> <task>
+
+  Resume a paused allocation. This command thaws the processes of the
+  allocation's tasks paused with "nomad alloc pause". If no task is provided
+  then all of the allocation's paused tasks are resumed.
+
+  When ACLs are enabled, this command requires a token with the
+  'alloc-lifecycle', 'read-job', and 'list-jobs' capabilities for the
+  allocation's namespace.
+
+General Options:
+
+  ` + generalOptionsUsage(usageOptsDefault) + `
+
+Resume Specific Options:
+
+  -task <task-name>
+    Specify the individual task to resume. If task name is given with both an
+    argument and the '-task' option, preference is given to the '-task'
+    option.
+
+  -verbose
+    Show full information.
+`
+	return strings.TrimSpace(helpText)
+}
+
+func (c *AllocResumeCommand) Name() string { return "alloc resume" }
+
+func (c *AllocResumeCommand) Run(args []string) int {
+	var verbose bool
+	var task string
+
+	flags := c.Meta.FlagSet(c.Name(), FlagSetClient)
+	flags.Usage = func() { c.Ui.Output(c.Help()) }
+	flags.BoolVar(&verbose, "verbose", false, "")
+	flags.StringVar(&task, "task", "", "")
+
+	if err := flags.Parse(args); err != nil {
+		return 1
+	}
+
+	client, alloc, task, code := lookupPauseAlloc(&c.Meta, c, flags.Args(), task, verbose)
+	if code != 0 {
+		return code
+	}
+
+	if err := client.Allocations().Resume(alloc, task, nil); err != nil {
+		c.Ui.Error(fmt.Sprintf("Error resuming allocation: %s", err))
+		return 1
+	}
+
+	return 0
+}
+
+func (c *AllocResumeCommand) Synopsis() string {
+	return "Resume a paused allocation"
+}
+
+func (c *AllocResumeCommand) AutocompleteFlags() complete.Flags {
+	return mergeAutocompleteFlags(c.Meta.AutocompleteFlags(FlagSetClient),
+		complete.Flags{
+			"-task":    complete.PredictAnything,
+			"-verbose": complete.PredictNothing,
+		})
+}
+
+func (c *AllocResumeCommand) AutocompleteArgs() complete.Predictor {
+	return complete.PredictFunc(func(a complete.Args) []string {
+		client, err := c.Meta.Client()
+		if err != nil {
+			return nil
+		}
+
+		resp, _, err := client.Search().PrefixSearch(a.Last, contexts.Allocs, nil)
+		if err != nil {
+			return []string{}
+		}
+		return resp.Matches[contexts.Allocs]
+	})
+}
@@ -0,0 +1,34 @@
+package command
+
+import (
+	"testing"
+
+	"github.com/hashicorp/nomad/ci"
+	"github.com/mitchellh/cli"
+	"github.com/shoenig/test/must"
+)
+
+func TestAllocResumeCommand_Implements(t *testing.T) {
+	ci.Parallel(t)
+	var _ cli.Command = &AllocResumeCommand{}
+}
+
+func TestAllocResumeCommand_Fails(t *testing.T) {
+	ci.Parallel(t)
+	srv, _, url := testServer(t, false, nil)
+	defer srv.Shutdown()
+
+	ui := cli.NewMockUi()
+	cmd := &AllocResumeCommand{Meta: Meta{Ui: ui}}
+
+	// Fails on lack of alloc ID
+	code := cmd.Run([]string{})
+	must.One(t, code)
+	must.StrContains(t, ui.ErrorWriter.String(), "This command takes up to two arguments")
+	ui.ErrorWriter.Reset()
+
+	// Fails on missing alloc
+	code = cmd.Run([]string{"-address=" + url, "26470238-5CF2-438F-8772-DC67CFB0705C"})
+	must.One(t, code)
+	must.StrContains(t, ui.ErrorWriter.String(), "No allocation(s) with prefix or id")
+}
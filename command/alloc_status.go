@@ -42,7 +42,8 @@ Alloc Status Options:
     Display short output. Shows only the most recent task event.
 
   -stats
-    Display detailed resource usage statistics.
+    Display detailed resource usage statistics, along with the recent resource
+    usage history kept by the client for each task.
 
   -verbose
     Show full information.
@@ -226,7 +227,18 @@ func (c *AllocStatusCommand) Run(args []string) int {
 				c.Ui.Output("Omitting resource statistics since the node is down.")
 			}
 		}
-		c.outputTaskDetails(alloc, stats, displayStats, verbose)
+		var history api.AllocStatsHistory
+		if displayStats && statsErr == nil {
+			history, err = client.Allocations().StatsHistory(alloc, "", time.Time{}, nil)
+			if err != nil {
+				c.Ui.Output("")
+				c.Ui.Error(fmt.Sprintf("Couldn't retrieve stats history: %v", err))
+			}
+		}
+		if displayStats && stats != nil && stats.DiskUsage != nil {
+			c.outputAllocDiskUsage(stats.DiskUsage)
+		}
+		c.outputTaskDetails(alloc, stats, history, displayStats, verbose)
 	}
 
 	// Format the detailed status
@@ -398,9 +410,22 @@ func futureEvalTimePretty(evalID string, client *api.Client) string {
 	return prettyTimeDiff(evaluation.WaitUntil, time.Now())
 }
 
+// outputAllocDiskUsage prints the usage of the allocation's ephemeral disk
+func (c *AllocStatusCommand) outputAllocDiskUsage(usage *api.AllocDiskUsage) {
+	c.Ui.Output(c.Colorize().Color("\n[bold]Ephemeral Disk[reset]"))
+	out := []string{
+		"Used|Size|Enforcement",
+		fmt.Sprintf("%s|%s|%s",
+			humanize.IBytes(usage.UsedBytes),
+			humanize.IBytes(usage.SizeBytes),
+			usage.Enforcement),
+	}
+	c.Ui.Output(formatList(out))
+}
+
 // outputTaskDetails prints task details for each task in the allocation,
 // optionally printing verbose statistics if displayStats is set
-func (c *AllocStatusCommand) outputTaskDetails(alloc *api.Allocation, stats *api.AllocResourceUsage, displayStats bool, verbose bool) {
+func (c *AllocStatusCommand) outputTaskDetails(alloc *api.Allocation, stats *api.AllocResourceUsage, history api.AllocStatsHistory, displayStats bool, verbose bool) {
 	taskLifecycles := map[string]*api.TaskLifecycle{}
 	for _, t := range alloc.Job.LookupTaskGroup(alloc.TaskGroup).Tasks {
 		taskLifecycles[t.Name] = t.Lifecycle
@@ -415,7 +440,7 @@ func (c *AllocStatusCommand) outputTaskDetails(alloc *api.Allocation, stats *api
 		}
 
 		c.Ui.Output(c.Colorize().Color(fmt.Sprintf("\n[bold]Task %q%v is %q[reset]", task, lcIndicator, state.State)))
-		c.outputTaskResources(alloc, task, stats, displayStats)
+		c.outputTaskResources(alloc, task, stats, history[task], displayStats)
 		c.Ui.Output("")
 		c.outputTaskVolumes(alloc, task, verbose)
 		c.outputTaskStatus(state)
@@ -575,7 +600,7 @@ func buildDisplayMessage(event *api.TaskEvent) string {
 
 // outputTaskResources prints the task resources for the passed task and if
 // displayStats is set, verbose resource usage statistics
-func (c *AllocStatusCommand) outputTaskResources(alloc *api.Allocation, task string, stats *api.AllocResourceUsage, displayStats bool) {
+func (c *AllocStatusCommand) outputTaskResources(alloc *api.Allocation, task string, stats *api.AllocResourceUsage, history []*api.ResourceUsageSample, displayStats bool) {
 	resource, ok := alloc.TaskResources[task]
 	if !ok {
 		return
@@ -656,6 +681,107 @@ func (c *AllocStatusCommand) outputTaskResources(alloc *api.Allocation, task str
 			c.outputVerboseResourceUsage(task, ru.ResourceUsage)
 		}
 	}
+
+	if displayStats && len(history) > 1 {
+		c.Ui.Output("")
+		c.outputResourceUsageHistory(history)
+	}
+}
+
+// outputResourceUsageHistory outputs a sparkline for each resource tracked in
+// the passed task's resource usage history
+func (c *AllocStatusCommand) outputResourceUsageHistory(history []*api.ResourceUsageSample) {
+	first := time.Unix(0, history[0].Timestamp)
+	last := time.Unix(0, history[len(history)-1].Timestamp)
+	c.Ui.Output(fmt.Sprintf("Resource Usage History (%d samples over %v)",
+		len(history), last.Sub(first).Round(time.Second)))
+
+	var cpu, mem, throttled, ioRead, ioWrite []float64
+	for i, s := range history {
+		cpu = append(cpu, s.CpuPercent)
+		mem = append(mem, float64(s.MemoryRSS))
+
+		// Throttling and IO are reported as counters, so graph the delta
+		// between consecutive samples
+		if i == 0 {
+			continue
+		}
+		prev := history[i-1]
+		throttled = append(throttled, float64(counterDelta(prev.ThrottledPeriods, s.ThrottledPeriods)))
+		ioRead = append(ioRead, float64(counterDelta(prev.IOReadBytes, s.IOReadBytes)))
+		ioWrite = append(ioWrite, float64(counterDelta(prev.IOWriteBytes, s.IOWriteBytes)))
+	}
+
+	cpuMax := maxFloat(cpu)
+	out := []string{
+		"Resource|History|Max",
+		fmt.Sprintf("CPU|%s|%s%%", formatSparkline(cpu, sparklineWidth),
+			strconv.FormatFloat(cpuMax, 'f', 2, 64)),
+		fmt.Sprintf("Memory RSS|%s|%s", formatSparkline(mem, sparklineWidth),
+			humanize.IBytes(uint64(maxFloat(mem)))),
+		fmt.Sprintf("Throttled Periods|%s|%v", formatSparkline(throttled, sparklineWidth),
+			uint64(maxFloat(throttled))),
+		fmt.Sprintf("IO Read|%s|%s", formatSparkline(ioRead, sparklineWidth),
+			humanize.IBytes(uint64(maxFloat(ioRead)))),
+		fmt.Sprintf("IO Write|%s|%s", formatSparkline(ioWrite, sparklineWidth),
+			humanize.IBytes(uint64(maxFloat(ioWrite)))),
+	}
+	c.Ui.Output(formatList(out))
+}
+
+// sparklineWidth is the maximum number of characters used to render a
+// resource usage history sparkline
+const sparklineWidth = 40
+
+var sparklineTicks = []rune("▁▂▃▄▅▆▇█")
+
+// formatSparkline renders values as a sparkline of at most width characters.
+// When there are more values than width, each character shows the maximum of
+// the values it covers so that short spikes remain visible.
+func formatSparkline(values []float64, width int) string {
+	if len(values) == 0 || width <= 0 {
+		return ""
+	}
+
+	buckets := values
+	if len(values) > width {
+		buckets = make([]float64, width)
+		for i := range buckets {
+			start := i * len(values) / width
+			end := (i + 1) * len(values) / width
+			buckets[i] = maxFloat(values[start:end])
+		}
+	}
+
+	top := maxFloat(buckets)
+	var b strings.Builder
+	for _, v := range buckets {
+		idx := 0
+		if top > 0 {
+			idx = int(v / top * float64(len(sparklineTicks)-1))
+		}
+		b.WriteRune(sparklineTicks[idx])
+	}
+	return b.String()
+}
+
+// counterDelta returns the increase of a monotonic counter between two
+// samples, treating a reset of the counter as no increase.
+func counterDelta(prev, cur uint64) uint64 {
+	if cur < prev {
+		return 0
+	}
+	return cur - prev
+}
+
+func maxFloat(values []float64) float64 {
+	var m float64
+	for _, v := range values {
+		if v > m {
+			m = v
+		}
+	}
+	return m
 }
 
 // outputVerboseResourceUsage outputs the verbose resource usage for the passed
@@ -729,6 +855,33 @@ func (c *AllocStatusCommand) outputVerboseResourceUsage(task string, resourceUsa
 		c.Ui.Output(formatList(out))
 	}
 
+	if ioStats := resourceUsage.IOStats; ioStats != nil && len(ioStats.Measured) > 0 {
+		c.Ui.Output("")
+		c.Ui.Output("IO Stats")
+
+		// Sort the measured stats
+		sort.Strings(ioStats.Measured)
+
+		var measuredStats []string
+		for _, measured := range ioStats.Measured {
+			switch measured {
+			case "Read Bytes":
+				measuredStats = append(measuredStats, humanize.IBytes(ioStats.ReadBytes))
+			case "Write Bytes":
+				measuredStats = append(measuredStats, humanize.IBytes(ioStats.WriteBytes))
+			case "Read Ops":
+				measuredStats = append(measuredStats, fmt.Sprintf("%v", ioStats.ReadOps))
+			case "Write Ops":
+				measuredStats = append(measuredStats, fmt.Sprintf("%v", ioStats.WriteOps))
+			}
+		}
+
+		out := make([]string, 2)
+		out[0] = strings.Join(ioStats.Measured, "|")
+		out[1] = strings.Join(measuredStats, "|")
+		c.Ui.Output(formatList(out))
+	}
+
 	if len(deviceStats) > 0 {
 		c.Ui.Output("")
 		c.Ui.Output("Device Stats")
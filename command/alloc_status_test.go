@@ -481,3 +481,14 @@ func TestAllocStatusCommand_NSD_Checks(t *testing.T) {
 	must.RegexMatch(t, regexp.MustCompile(`Service\s+Task\s+Name\s+Mode\s+Status`), out)
 	must.RegexMatch(t, regexp.MustCompile(`service1\s+\(group\)\s+check1\s+healthiness\s+(pending|failure)`), out)
 }
+
+func TestAllocStatusCommand_formatSparkline(t *testing.T) {
+	ci.Parallel(t)
+
+	must.Eq(t, "", formatSparkline(nil, 10))
+	must.Eq(t, "▁▁▁", formatSparkline([]float64{0, 0, 0}, 10))
+	must.Eq(t, "▁▄█", formatSparkline([]float64{0, 50, 100}, 10))
+
+	// Downsampling keeps the maximum of each bucket so spikes stay visible
+	must.Eq(t, "▁█", formatSparkline([]float64{0, 0, 0, 100}, 2))
+}
@@ -202,6 +202,11 @@ func Commands(metaPtr *Meta, agentUi cli.Ui) map[string]cli.CommandFactory {
 				Meta: meta,
 			}, nil
 		},
+		"acl token can-i": func() (cli.Command, error) {
+			return &ACLTokenCanICommand{
+				Meta: meta,
+			}, nil
+		},
 		"acl token create": func() (cli.Command, error) {
 			return &ACLTokenCreateCommand{
 				Meta: meta,
@@ -217,6 +222,11 @@ func Commands(metaPtr *Meta, agentUi cli.Ui) map[string]cli.CommandFactory {
 				Meta: meta,
 			}, nil
 		},
+		"acl token rotate": func() (cli.Command, error) {
+			return &ACLTokenRotateCommand{
+				Meta: meta,
+			}, nil
+		},
 		"acl token info": func() (cli.Command, error) {
 			return &ACLTokenInfoCommand{
 				Meta: meta,
@@ -242,6 +252,21 @@ func Commands(metaPtr *Meta, agentUi cli.Ui) map[string]cli.CommandFactory {
 				Meta: meta,
 			}, nil
 		},
+		"alloc pause": func() (cli.Command, error) {
+			return &AllocPauseCommand{
+				Meta: meta,
+			}, nil
+		},
+		"alloc pin": func() (cli.Command, error) {
+			return &AllocPinCommand{
+				Meta: meta,
+			}, nil
+		},
+		"alloc resume": func() (cli.Command, error) {
+			return &AllocResumeCommand{
+				Meta: meta,
+			}, nil
+		},
 		"alloc signal": func() (cli.Command, error) {
 			return &AllocSignalCommand{
 				Meta: meta,
@@ -380,6 +405,36 @@ func Commands(metaPtr *Meta, agentUi cli.Ui) map[string]cli.CommandFactory {
 				Meta: meta,
 			}, nil
 		},
+		"event": func() (cli.Command, error) {
+			return &EventCommand{
+				Meta: meta,
+			}, nil
+		},
+		"event sink": func() (cli.Command, error) {
+			return &EventSinkCommand{
+				Meta: meta,
+			}, nil
+		},
+		"event sink deregister": func() (cli.Command, error) {
+			return &EventSinkDeregisterCommand{
+				Meta: meta,
+			}, nil
+		},
+		"event sink list": func() (cli.Command, error) {
+			return &EventSinkListCommand{
+				Meta: meta,
+			}, nil
+		},
+		"event sink register": func() (cli.Command, error) {
+			return &EventSinkRegisterCommand{
+				Meta: meta,
+			}, nil
+		},
+		"event sink status": func() (cli.Command, error) {
+			return &EventSinkStatusCommand{
+				Meta: meta,
+			}, nil
+		},
 		"exec": func() (cli.Command, error) {
 			return &AllocExecCommand{
 				Meta: meta,
@@ -603,6 +658,16 @@ func Commands(metaPtr *Meta, agentUi cli.Ui) map[string]cli.CommandFactory {
 				Meta: meta,
 			}, nil
 		},
+		"node plugin": func() (cli.Command, error) {
+			return &NodePluginCommand{
+				Meta: meta,
+			}, nil
+		},
+		"node plugin reload": func() (cli.Command, error) {
+			return &NodePluginReloadCommand{
+				Meta: meta,
+			}, nil
+		},
 		"node-status": func() (cli.Command, error) {
 			return &NodeStatusCommand{
 				Meta: meta,
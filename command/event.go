@@ -21,7 +21,9 @@ Usage: nomad event <subcommand> [options] [args]
 
   This command groups subcommands for interacting with Nomad event sinks.
   Nomad's event sinks system can be used to subscribe to the event stream for
-  events that match specific topics.
+  events that match specific topics. The leader delivers the events to each
+  sink at least once, resuming from the last acknowledged event after a
+  leader election.
 
   Register or update an event sink:
 
@@ -40,8 +42,12 @@ Usage: nomad event <subcommand> [options] [args]
   List event sinks:
 
       $ nomad event sink list
-      ID         Type     Address           Topics    LatestIndex
-      my-sink    webhook  http://127.0.0.1  *[*]      0
+      ID       Type     Address                Topics  Healthy  Latest Index  Lag
+      my-sink  webhook  http://127.0.0.1:8080  *[*]    true     42            0
+
+  View the delivery status of an event sink:
+
+      $ nomad event sink status my-sink
 
   Deregister an event sink:
 
@@ -53,6 +59,8 @@ Usage: nomad event <subcommand> [options] [args]
 	return strings.TrimSpace(helpText)
 }
 
+func (e *EventCommand) Name() string { return "event" }
+
 func (e *EventCommand) Run(args []string) int {
 	return cli.RunResultHelp
 }
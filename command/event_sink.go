@@ -0,0 +1,89 @@
+package command
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/nomad/api"
+	"github.com/mitchellh/cli"
+	"github.com/posener/complete"
+)
+
+type EventSinkCommand struct {
+	Meta
+}
+
+func (c *EventSinkCommand) Help() string {
+	helpText := `
+Usage: nomad event sink <subcommand> [options] [args]
+
+  This command groups subcommands for interacting with event sinks. Event
+  sinks are registered with the servers, and the leader delivers the events
+  matching their topics and filter to them. Users can register, list, inspect
+  the status of and deregister event sinks.
+
+  Register or update an event sink:
+
+      $ nomad event sink register sink.json
+
+  List event sinks:
+
+      $ nomad event sink list
+
+  View the delivery status of an event sink:
+
+      $ nomad event sink status <id>
+
+  Deregister an event sink:
+
+      $ nomad event sink deregister <id>
+
+  Please see the individual subcommand help for detailed usage information.
+`
+	return strings.TrimSpace(helpText)
+}
+
+func (c *EventSinkCommand) Synopsis() string {
+	return "Interact with event sinks"
+}
+
+func (c *EventSinkCommand) Name() string { return "event sink" }
+
+func (c *EventSinkCommand) Run(args []string) int {
+	return cli.RunResultHelp
+}
+
+// EventSinkPredictor returns an event sink predictor
+func EventSinkPredictor(factory ApiClientFactory) complete.Predictor {
+	return complete.PredictFunc(func(a complete.Args) []string {
+		client, err := factory()
+		if err != nil {
+			return nil
+		}
+
+		sinks, _, err := client.EventSinks().List(nil)
+		if err != nil {
+			return nil
+		}
+
+		var ids []string
+		for _, sink := range sinks {
+			if strings.HasPrefix(sink.ID, a.Last) {
+				ids = append(ids, sink.ID)
+			}
+		}
+		return ids
+	})
+}
+
+// formatEventSinkTopics formats the topics of an event sink in the same way
+// as the topics are passed to the event stream, such as "Job[*], Node[web]".
+func formatEventSinkTopics(topics map[api.Topic][]string) string {
+	formatted := make([]string, 0, len(topics))
+	for topic, keys := range topics {
+		formatted = append(formatted, fmt.Sprintf("%s[%s]", topic, strings.Join(keys, ",")))
+	}
+	sort.Strings(formatted)
+	return strings.Join(formatted, ", ")
+}
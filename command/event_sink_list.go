@@ -0,0 +1,151 @@
+package command
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/nomad/api"
+	"github.com/posener/complete"
+)
+
+type EventSinkListCommand struct {
+	Meta
+}
+
+func (c *EventSinkListCommand) Help() string {
+	helpText := `
+Usage: nomad event sink list [options]
+
+  List is used to list the registered event sinks, along with their health
+  and how many Raft indexes each of them lags behind the latest event.
+
+  If ACLs are enabled, this command requires a management ACL token.
+
+General Options:
+
+  ` + generalOptionsUsage(usageOptsDefault|usageOptsNoNamespace) + `
+
+List Options:
+
+  -json
+    Output the event sinks in a JSON format.
+
+  -t
+    Format and display the event sinks using a Go template.
+`
+	return strings.TrimSpace(helpText)
+}
+
+func (c *EventSinkListCommand) AutocompleteFlags() complete.Flags {
+	return mergeAutocompleteFlags(c.Meta.AutocompleteFlags(FlagSetClient),
+		complete.Flags{
+			"-json": complete.PredictNothing,
+			"-t":    complete.PredictAnything,
+		})
+}
+
+func (c *EventSinkListCommand) AutocompleteArgs() complete.Predictor {
+	return complete.PredictNothing
+}
+
+func (c *EventSinkListCommand) Synopsis() string {
+	return "List event sinks"
+}
+
+func (c *EventSinkListCommand) Name() string { return "event sink list" }
+
+func (c *EventSinkListCommand) Run(args []string) int {
+	var json bool
+	var tmpl string
+
+	flags := c.Meta.FlagSet(c.Name(), FlagSetClient)
+	flags.Usage = func() { c.Ui.Output(c.Help()) }
+	flags.BoolVar(&json, "json", false, "")
+	flags.StringVar(&tmpl, "t", "", "")
+
+	if err := flags.Parse(args); err != nil {
+		return 1
+	}
+
+	// Check that we got no arguments
+	args = flags.Args()
+	if l := len(args); l != 0 {
+		c.Ui.Error("This command takes no arguments")
+		c.Ui.Error(commandErrorText(c))
+		return 1
+	}
+
+	// Get the HTTP client
+	client, err := c.Meta.Client()
+	if err != nil {
+		c.Ui.Error(fmt.Sprintf("Error initializing client: %s", err))
+		return 1
+	}
+
+	sinks, _, err := client.EventSinks().List(nil)
+	if err != nil {
+		c.Ui.Error(fmt.Sprintf("Error retrieving event sinks: %s", err))
+		return 1
+	}
+
+	if json || len(tmpl) > 0 {
+		out, err := Format(json, tmpl, sinks)
+		if err != nil {
+			c.Ui.Error(err.Error())
+			return 1
+		}
+
+		c.Ui.Output(out)
+		return 0
+	}
+
+	if len(sinks) == 0 {
+		c.Ui.Output("No event sinks found")
+		return 0
+	}
+
+	statuses, _, err := client.EventSinks().Statuses(nil)
+	if err != nil {
+		c.Ui.Error(fmt.Sprintf("Error retrieving event sink statuses: %s", err))
+		return 1
+	}
+
+	c.Ui.Output(formatEventSinks(sinks, statuses))
+	return 0
+}
+
+func formatEventSinks(sinks []*api.EventSink, statuses []*api.EventSinkStatus) string {
+	byID := make(map[string]*api.EventSinkStatus, len(statuses))
+	for _, status := range statuses {
+		byID[status.ID] = status
+	}
+
+	rows := make([]string, len(sinks)+1)
+	rows[0] = "ID|Type|Address|Topics|Healthy|Latest Index|Lag"
+	for i, sink := range sinks {
+		healthy, latest, lag := "<unknown>", fmt.Sprint(sink.LatestIndex), "<unknown>"
+		if status, ok := byID[sink.ID]; ok {
+			healthy = formatEventSinkHealth(status)
+			latest = fmt.Sprint(status.LatestIndex)
+			lag = fmt.Sprint(status.Lag)
+		}
+		rows[i+1] = fmt.Sprintf("%s|%s|%s|%s|%s|%s|%s",
+			sink.ID,
+			sink.Type,
+			sink.Address,
+			formatEventSinkTopics(sink.Topics),
+			healthy,
+			latest,
+			lag)
+	}
+	return formatList(rows)
+}
+
+// formatEventSinkHealth returns the health of a sink, or "<unknown>" if the
+// leader isn't delivering events to it.
+func formatEventSinkHealth(status *api.EventSinkStatus) string {
+	if !status.Running {
+		return "<unknown>"
+	}
+	return fmt.Sprint(status.Healthy)
+}
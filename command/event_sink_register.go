@@ -0,0 +1,126 @@
+package command
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+
+	"github.com/hashicorp/nomad/api"
+	"github.com/posener/complete"
+)
+
+type EventSinkRegisterCommand struct {
+	Meta
+
+	// testStdin is used for tests
+	testStdin io.Reader
+}
+
+func (c *EventSinkRegisterCommand) Help() string {
+	helpText := `
+Usage: nomad event sink register [options] <path>
+
+  Register is used to register a new event sink or update an existing one.
+  The sink is read from a JSON file, or from stdin if the path is "-".
+
+  The leader POSTs batches of events matching the sink's topics, namespace
+  and filter to the sink's address, retrying until it responds with a 2xx
+  status code.
+
+  If ACLs are enabled, this command requires a management ACL token.
+
+General Options:
+
+  ` + generalOptionsUsage(usageOptsDefault|usageOptsNoNamespace) + `
+
+Example sink:
+
+  {
+    "ID": "my-sink",
+    "Type": "webhook",
+    "Address": "https://example.com/nomad/events",
+    "Topics": {
+      "Job": ["*"],
+      "Deployment": ["*"]
+    },
+    "Namespace": "*",
+    "Filter": "Job.Type == \"service\"",
+    "BatchSize": 100
+  }
+`
+	return strings.TrimSpace(helpText)
+}
+
+func (c *EventSinkRegisterCommand) AutocompleteFlags() complete.Flags {
+	return c.Meta.AutocompleteFlags(FlagSetClient)
+}
+
+func (c *EventSinkRegisterCommand) AutocompleteArgs() complete.Predictor {
+	return complete.PredictFiles("*.json")
+}
+
+func (c *EventSinkRegisterCommand) Synopsis() string {
+	return "Register or update an event sink"
+}
+
+func (c *EventSinkRegisterCommand) Name() string { return "event sink register" }
+
+func (c *EventSinkRegisterCommand) Run(args []string) int {
+	flags := c.Meta.FlagSet(c.Name(), FlagSetClient)
+	flags.Usage = func() { c.Ui.Output(c.Help()) }
+
+	if err := flags.Parse(args); err != nil {
+		return 1
+	}
+
+	// Check that we got one argument
+	args = flags.Args()
+	if l := len(args); l != 1 {
+		c.Ui.Error("This command takes one argument: <path>")
+		c.Ui.Error(commandErrorText(c))
+		return 1
+	}
+
+	var raw []byte
+	var err error
+	if path := args[0]; path == "-" {
+		var stdin io.Reader = os.Stdin
+		if c.testStdin != nil {
+			stdin = c.testStdin
+		}
+		raw, err = io.ReadAll(stdin)
+	} else {
+		raw, err = os.ReadFile(path)
+	}
+	if err != nil {
+		c.Ui.Error(fmt.Sprintf("Error reading event sink: %s", err))
+		return 1
+	}
+
+	var sink api.EventSink
+	if err := json.Unmarshal(raw, &sink); err != nil {
+		c.Ui.Error(fmt.Sprintf("Error parsing event sink: %s", err))
+		return 1
+	}
+	if sink.ID == "" {
+		c.Ui.Error("Event sink must have an ID")
+		return 1
+	}
+
+	// Get the HTTP client
+	client, err := c.Meta.Client()
+	if err != nil {
+		c.Ui.Error(fmt.Sprintf("Error initializing client: %s", err))
+		return 1
+	}
+
+	if _, err := client.EventSinks().Register(&sink, nil); err != nil {
+		c.Ui.Error(fmt.Sprintf("Error registering event sink: %s", err))
+		return 1
+	}
+
+	c.Ui.Output(fmt.Sprintf("Successfully registered %q event sink!", sink.ID))
+	return 0
+}
@@ -0,0 +1,145 @@
+package command
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/posener/complete"
+)
+
+type EventSinkStatusCommand struct {
+	Meta
+}
+
+func (c *EventSinkStatusCommand) Help() string {
+	helpText := `
+Usage: nomad event sink status [options] <id>
+
+  Status is used to view the configuration and delivery status of an event
+  sink. The delivery status is tracked by the leader, and includes whether
+  the last delivery attempt succeeded and how many Raft indexes the sink lags
+  behind the latest event.
+
+  If ACLs are enabled, this command requires a management ACL token.
+
+General Options:
+
+  ` + generalOptionsUsage(usageOptsDefault|usageOptsNoNamespace) + `
+
+Status Options:
+
+  -json
+    Output the event sink status in a JSON format.
+
+  -t
+    Format and display the event sink status using a Go template.
+`
+	return strings.TrimSpace(helpText)
+}
+
+func (c *EventSinkStatusCommand) AutocompleteFlags() complete.Flags {
+	return mergeAutocompleteFlags(c.Meta.AutocompleteFlags(FlagSetClient),
+		complete.Flags{
+			"-json": complete.PredictNothing,
+			"-t":    complete.PredictAnything,
+		})
+}
+
+func (c *EventSinkStatusCommand) AutocompleteArgs() complete.Predictor {
+	return EventSinkPredictor(c.Meta.Client)
+}
+
+func (c *EventSinkStatusCommand) Synopsis() string {
+	return "Display the status of an event sink"
+}
+
+func (c *EventSinkStatusCommand) Name() string { return "event sink status" }
+
+func (c *EventSinkStatusCommand) Run(args []string) int {
+	var json bool
+	var tmpl string
+
+	flags := c.Meta.FlagSet(c.Name(), FlagSetClient)
+	flags.Usage = func() { c.Ui.Output(c.Help()) }
+	flags.BoolVar(&json, "json", false, "")
+	flags.StringVar(&tmpl, "t", "", "")
+
+	if err := flags.Parse(args); err != nil {
+		return 1
+	}
+
+	// Check that we got one argument
+	args = flags.Args()
+	if l := len(args); l != 1 {
+		c.Ui.Error("This command takes one argument: <id>")
+		c.Ui.Error(commandErrorText(c))
+		return 1
+	}
+
+	id := args[0]
+
+	// Get the HTTP client
+	client, err := c.Meta.Client()
+	if err != nil {
+		c.Ui.Error(fmt.Sprintf("Error initializing client: %s", err))
+		return 1
+	}
+
+	sink, _, err := client.EventSinks().Info(id, nil)
+	if err != nil {
+		c.Ui.Error(fmt.Sprintf("Error retrieving event sink: %s", err))
+		return 1
+	}
+
+	status, _, err := client.EventSinks().Status(id, nil)
+	if err != nil {
+		c.Ui.Error(fmt.Sprintf("Error retrieving event sink status: %s", err))
+		return 1
+	}
+
+	if json || len(tmpl) > 0 {
+		out, err := Format(json, tmpl, status)
+		if err != nil {
+			c.Ui.Error(err.Error())
+			return 1
+		}
+
+		c.Ui.Output(out)
+		return 0
+	}
+
+	lastSuccess, lastFailure := "<none>", "<none>"
+	if !status.LastSuccess.IsZero() {
+		lastSuccess = formatTime(status.LastSuccess)
+	}
+	if !status.LastFailure.IsZero() {
+		lastFailure = formatTime(status.LastFailure)
+	}
+	filter := sink.Filter
+	if filter == "" {
+		filter = "<none>"
+	}
+
+	basic := []string{
+		fmt.Sprintf("ID|%s", sink.ID),
+		fmt.Sprintf("Type|%s", sink.Type),
+		fmt.Sprintf("Address|%s", sink.Address),
+		fmt.Sprintf("Topics|%s", formatEventSinkTopics(sink.Topics)),
+		fmt.Sprintf("Namespace|%s", sink.Namespace),
+		fmt.Sprintf("Filter|%s", filter),
+		fmt.Sprintf("Batch Size|%d", sink.BatchSize),
+		fmt.Sprintf("Healthy|%s", formatEventSinkHealth(status)),
+		fmt.Sprintf("Lagging|%t", status.Lagging),
+		fmt.Sprintf("Latest Index|%d", status.LatestIndex),
+		fmt.Sprintf("Lag|%d", status.Lag),
+		fmt.Sprintf("Failures|%d", status.Failures),
+		fmt.Sprintf("Last Success|%s", lastSuccess),
+		fmt.Sprintf("Last Failure|%s", lastFailure),
+	}
+	if status.LastError != "" {
+		basic = append(basic, fmt.Sprintf("Last Error|%s", status.LastError))
+	}
+
+	c.Ui.Output(formatKV(basic))
+	return 0
+}
@@ -0,0 +1,67 @@
+package command
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/nomad/ci"
+	"github.com/mitchellh/cli"
+	"github.com/shoenig/test/must"
+)
+
+func TestEventSinkCommands(t *testing.T) {
+	ci.Parallel(t)
+
+	srv, _, url := testServer(t, false, nil)
+	defer srv.Shutdown()
+
+	path := filepath.Join(t.TempDir(), "sink.json")
+	must.NoError(t, os.WriteFile(path, []byte(`{
+  "ID": "my-sink",
+  "Type": "webhook",
+  "Address": "http://127.0.0.1:8080",
+  "Topics": {"Job": ["*"]}
+}`), 0644))
+
+	ui := cli.NewMockUi()
+	register := &EventSinkRegisterCommand{Meta: Meta{Ui: ui}}
+	must.Zero(t, register.Run([]string{"-address=" + url, path}))
+	must.StrContains(t, ui.OutputWriter.String(), `Successfully registered "my-sink" event sink!`)
+
+	// Invalid sinks are rejected by the servers
+	ui = cli.NewMockUi()
+	register = &EventSinkRegisterCommand{
+		Meta:      Meta{Ui: ui},
+		testStdin: strings.NewReader(`{"ID": "invalid", "Address": "ftp://example.com"}`),
+	}
+	must.One(t, register.Run([]string{"-address=" + url, "-"}))
+	must.StrContains(t, ui.ErrorWriter.String(), "scheme must be http or https")
+
+	ui = cli.NewMockUi()
+	list := &EventSinkListCommand{Meta: Meta{Ui: ui}}
+	must.Zero(t, list.Run([]string{"-address=" + url}))
+	out := ui.OutputWriter.String()
+	must.StrContains(t, out, "Healthy")
+	must.StrContains(t, out, "my-sink")
+	must.StrContains(t, out, "Job[*]")
+
+	ui = cli.NewMockUi()
+	status := &EventSinkStatusCommand{Meta: Meta{Ui: ui}}
+	must.Zero(t, status.Run([]string{"-address=" + url, "my-sink"}))
+	out = ui.OutputWriter.String()
+	must.StrContains(t, out, "http://127.0.0.1:8080")
+	must.StrContains(t, out, "Lag")
+	must.StrContains(t, out, "Lagging")
+
+	ui = cli.NewMockUi()
+	deregister := &EventSinkDeregisterCommand{Meta: Meta{Ui: ui}}
+	must.Zero(t, deregister.Run([]string{"-address=" + url, "my-sink"}))
+	must.StrContains(t, ui.OutputWriter.String(), `Successfully deregistered "my-sink" event sink!`)
+
+	ui = cli.NewMockUi()
+	list = &EventSinkListCommand{Meta: Meta{Ui: ui}}
+	must.Zero(t, list.Run([]string{"-address=" + url}))
+	must.StrContains(t, ui.OutputWriter.String(), "No event sinks found")
+}
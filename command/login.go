@@ -26,6 +26,7 @@ type LoginCommand struct {
 	authMethodName string
 	callbackAddr   string
 	loginToken     string
+	username       string
 
 	template string
 	json     bool
@@ -55,7 +56,12 @@ Login Options:
 
   -login-token
     Login token used for authentication that will be exchanged for a Nomad ACL
-    Token. It is only required if using auth method type other than OIDC. 
+    Token. It is only required if using the JWT auth method type.
+
+  -username
+    The username used to login with LDAP auth methods. The username is
+    prompted for if it's not set. The password is always prompted for, so it
+    is not recorded in the shell history.
 
   -json
     Output the ACL token in JSON format.
@@ -77,6 +83,7 @@ func (l *LoginCommand) AutocompleteFlags() complete.Flags {
 			"-method":             complete.PredictAnything,
 			"-oidc-callback-addr": complete.PredictAnything,
 			"-login-token":        complete.PredictAnything,
+			"-username":           complete.PredictAnything,
 			"-json":               complete.PredictNothing,
 			"-t":                  complete.PredictAnything,
 		})
@@ -93,6 +100,7 @@ func (l *LoginCommand) Run(args []string) int {
 	flags.StringVar(&l.authMethodName, "method", "", "")
 	flags.StringVar(&l.authMethodType, "type", "", "")
 	flags.StringVar(&l.loginToken, "login-token", "", "")
+	flags.StringVar(&l.username, "username", "", "")
 	flags.StringVar(&l.callbackAddr, "oidc-callback-addr", "localhost:4649", "")
 	flags.BoolVar(&l.json, "json", false, "")
 	flags.StringVar(&l.template, "t", "", "")
@@ -161,8 +169,8 @@ func (l *LoginCommand) Run(args []string) int {
 		}
 	}
 
-	// Make sure we got the login token if we're not using OIDC
-	if methodType != api.ACLAuthMethodTypeOIDC && l.loginToken == "" {
+	// Make sure we got the login token if we're using JWT
+	if methodType == api.ACLAuthMethodTypeJWT && l.loginToken == "" {
 		l.Ui.Error("You need to provide a login token.")
 		return 1
 	}
@@ -177,6 +185,8 @@ func (l *LoginCommand) Run(args []string) int {
 		authFn = l.loginOIDC
 	case api.ACLAuthMethodTypeJWT:
 		authFn = l.loginJWT
+	case api.ACLAuthMethodTypeLDAP:
+		authFn = l.loginLDAP
 	default:
 		l.Ui.Error(fmt.Sprintf("Unsupported authentication type %q", methodType))
 		return 1
@@ -268,6 +278,29 @@ func (l *LoginCommand) loginJWT(ctx context.Context, client *api.Client) (*api.A
 	return token, err
 }
 
+func (l *LoginCommand) loginLDAP(_ context.Context, client *api.Client) (*api.ACLToken, error) {
+	if l.username == "" {
+		username, err := l.Ui.Ask("Username:")
+		if err != nil {
+			return nil, err
+		}
+		l.username = strings.TrimSpace(username)
+	}
+
+	password, err := l.Ui.AskSecret("Password:")
+	if err != nil {
+		return nil, err
+	}
+
+	authArgs := api.ACLLoginRequest{
+		AuthMethodName: l.authMethodName,
+		Username:       l.username,
+		Password:       password,
+	}
+	token, _, err := client.ACLAuth().Login(&authArgs, nil)
+	return token, err
+}
+
 const (
 	// oidcErrorVisitURLMsg is a message to show users when opening the OIDC
 	// provider URL automatically fails. This type of message is otherwise not
@@ -1,10 +1,14 @@
 package command
 
 import (
+	"strings"
 	"testing"
+	"testing/iotest"
 
 	"github.com/hashicorp/nomad/ci"
 	"github.com/hashicorp/nomad/command/agent"
+	"github.com/hashicorp/nomad/lib/auth/ldap"
+	"github.com/hashicorp/nomad/nomad/mock"
 	"github.com/hashicorp/nomad/nomad/structs"
 	"github.com/hashicorp/nomad/testutil"
 	"github.com/mitchellh/cli"
@@ -74,3 +78,63 @@ func TestLoginCommand_Run(t *testing.T) {
 	// TODO(jrasell) find a way to test the full login flow from the CLI
 	//  perspective.
 }
+
+func TestLoginCommand_LDAP(t *testing.T) {
+	ci.Parallel(t)
+
+	srv, _, agentURL := testServer(t, false, func(c *agent.Config) {
+		c.ACL.Enabled = true
+	})
+	defer srv.Shutdown()
+	testutil.WaitForLeader(t, srv.Agent.RPC)
+
+	ldapServer := ldap.NewTestServer(t,
+		&ldap.TestEntry{
+			DN:       "cn=admin,dc=example,dc=com",
+			Password: "very secret password",
+		},
+		&ldap.TestEntry{
+			DN:         "uid=alice,ou=users,dc=example,dc=com",
+			Password:   "alice-password",
+			Attributes: map[string][]string{"uid": {"alice"}},
+		},
+		&ldap.TestEntry{
+			DN: "cn=engineering,ou=groups,dc=example,dc=com",
+			Attributes: map[string][]string{
+				"cn":     {"engineering"},
+				"member": {"uid=alice,ou=users,dc=example,dc=com"},
+			},
+		},
+	)
+
+	state := srv.Agent.Server().State()
+	method := mock.ACLLDAPAuthMethod()
+	method.Config.LDAPURL = ldapServer.URL()
+	must.NoError(t, state.UpsertACLAuthMethods(1000, []*structs.ACLAuthMethod{method}))
+
+	policy := mock.ACLPolicy()
+	must.NoError(t, state.UpsertACLPolicies(structs.MsgTypeTestSetup, 1001, []*structs.ACLPolicy{policy}))
+
+	rule := mock.ACLBindingRule()
+	rule.AuthMethod = method.Name
+	rule.BindType = structs.ACLBindingRuleBindTypePolicy
+	rule.Selector = "engineering in list.groups"
+	rule.BindName = policy.Name
+	must.NoError(t, state.UpsertACLBindingRules(1002, []*structs.ACLBindingRule{rule}, true))
+
+	// The password is prompted for
+	ui := cli.NewMockUi()
+	ui.InputReader = strings.NewReader("wrong-password\n")
+	cmd := &LoginCommand{Meta: Meta{Ui: ui}}
+	must.Eq(t, 1, cmd.Run([]string{"-address=" + agentURL, "-method=" + method.Name, "-username=alice"}))
+	must.StrContains(t, ui.ErrorWriter.String(), "unable to validate provided credentials")
+
+	// The username is prompted for too if it isn't set. The mock UI buffers
+	// its input on every prompt, so feed it one byte at a time.
+	ui = cli.NewMockUi()
+	ui.InputReader = iotest.OneByteReader(strings.NewReader("alice\nalice-password\n"))
+	cmd = &LoginCommand{Meta: Meta{Ui: ui}}
+	must.Eq(t, 0, cmd.Run([]string{"-address=" + agentURL, "-method=" + method.Name}))
+	must.StrContains(t, ui.OutputWriter.String(), "Successfully logged in via LDAP and "+method.Name)
+	must.StrContains(t, ui.OutputWriter.String(), policy.Name)
+}
@@ -0,0 +1,38 @@
+package command
+
+import (
+	"strings"
+
+	"github.com/mitchellh/cli"
+)
+
+type NodePluginCommand struct {
+	Meta
+}
+
+func (c *NodePluginCommand) Help() string {
+	helpText := `
+Usage: nomad node plugin <subcommand> [options] [args]
+
+  This command groups subcommands for interacting with the external task driver
+  and device plugins of a node. All commands interact directly with a client
+  and allow setting a custom target with the -node-id option.
+
+  Reload an external plugin after upgrading its binary:
+
+      $ nomad node plugin reload <name>
+
+  Please see the individual subcommand help for detailed usage information.
+`
+	return strings.TrimSpace(helpText)
+}
+
+func (c *NodePluginCommand) Synopsis() string {
+	return "Interact with node plugins"
+}
+
+func (c *NodePluginCommand) Name() string { return "node plugin" }
+
+func (c *NodePluginCommand) Run(args []string) int {
+	return cli.RunResultHelp
+}
@@ -0,0 +1,109 @@
+package command
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/nomad/api"
+	"github.com/posener/complete"
+)
+
+type NodePluginReloadCommand struct {
+	Meta
+}
+
+func (c *NodePluginReloadCommand) Help() string {
+	helpText := `
+Usage: nomad node plugin reload [-node-id ...] <name>
+
+  Reload an external task driver or device plugin of a node. The client rescans
+  its plugin directory and launches the plugin binary found there with the
+  plugin configuration of the agent. Once the new plugin has fingerprinted, it
+  replaces the running plugin and the tasks of a reloaded task driver are
+  recovered by the new plugin. If the new plugin fails to fingerprint, the
+  running plugin is kept.
+
+  Plugins built into the agent can't be reloaded.
+
+  When ACLs are enabled, this command requires a token with the 'node:write'
+  capability.
+
+General Options:
+
+  ` + generalOptionsUsage(usageOptsDefault|usageOptsNoNamespace) + `
+
+Node Plugin Reload Options:
+
+  -node-id
+    Reloads the plugin on the specified node. If not specified the node
+    receiving the request will be used by default.
+`
+	return strings.TrimSpace(helpText)
+}
+
+func (c *NodePluginReloadCommand) Synopsis() string {
+	return "Reload an external plugin of a node"
+}
+
+func (c *NodePluginReloadCommand) Name() string { return "node plugin reload" }
+
+func (c *NodePluginReloadCommand) Run(args []string) int {
+	var nodeID string
+
+	flags := c.Meta.FlagSet(c.Name(), FlagSetClient)
+	flags.Usage = func() { c.Ui.Output(c.Help()) }
+	flags.StringVar(&nodeID, "node-id", "", "")
+
+	if err := flags.Parse(args); err != nil {
+		return 1
+	}
+	args = flags.Args()
+
+	if len(args) != 1 {
+		c.Ui.Error("This command takes one argument: <name>")
+		c.Ui.Error(commandErrorText(c))
+		return 1
+	}
+
+	// Get the HTTP client
+	client, err := c.Meta.Client()
+	if err != nil {
+		c.Ui.Error(fmt.Sprintf("Error initializing client: %s", err))
+		return 1
+	}
+
+	// Lookup nodeID
+	if nodeID != "" {
+		nodeID, err = lookupNodeID(client.Nodes(), nodeID)
+		if err != nil {
+			c.Ui.Error(err.Error())
+			return 1
+		}
+	}
+
+	req := api.NodePluginReloadRequest{
+		NodeID: nodeID,
+		Name:   args[0],
+	}
+
+	resp, err := client.Nodes().Plugins().Reload(&req, nil)
+	if err != nil {
+		c.Ui.Error(fmt.Sprintf("Error reloading plugin: %s", err))
+		return 1
+	}
+
+	c.Ui.Output(fmt.Sprintf("Reloaded %s plugin %q from version %q to %q",
+		resp.Type, resp.Name, resp.PreviousVersion, resp.Version))
+	return 0
+}
+
+func (c *NodePluginReloadCommand) AutocompleteFlags() complete.Flags {
+	return mergeAutocompleteFlags(c.Meta.AutocompleteFlags(FlagSetClient),
+		complete.Flags{
+			"-node-id": complete.PredictNothing,
+		})
+}
+
+func (c *NodePluginReloadCommand) AutocompleteArgs() complete.Predictor {
+	return complete.PredictAnything
+}
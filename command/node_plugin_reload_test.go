@@ -0,0 +1,53 @@
+package command
+
+import (
+	"testing"
+
+	"github.com/hashicorp/nomad/ci"
+	"github.com/mitchellh/cli"
+	"github.com/shoenig/test/must"
+)
+
+func TestNodePluginReloadCommand_Implements(t *testing.T) {
+	ci.Parallel(t)
+	var _ cli.Command = &NodePluginReloadCommand{}
+}
+
+func TestNodePluginReloadCommand_Fails(t *testing.T) {
+	ci.Parallel(t)
+
+	ui := cli.NewMockUi()
+	cmd := &NodePluginReloadCommand{Meta: Meta{Ui: ui}}
+
+	// Fails on misuse
+	code := cmd.Run([]string{"some", "bad", "args"})
+	must.One(t, code)
+	must.StrContains(t, ui.ErrorWriter.String(), commandErrorText(cmd))
+	ui.ErrorWriter.Reset()
+
+	// Fails on connection failure
+	code = cmd.Run([]string{"-address=nope", "mock_driver"})
+	must.One(t, code)
+	must.StrContains(t, ui.ErrorWriter.String(), "Error reloading plugin")
+}
+
+func TestNodePluginReloadCommand_Run(t *testing.T) {
+	ci.Parallel(t)
+	srv, client, url := testServer(t, true, nil)
+	defer srv.Shutdown()
+	waitForNodes(t, client)
+
+	ui := cli.NewMockUi()
+	cmd := &NodePluginReloadCommand{Meta: Meta{Ui: ui}}
+
+	// Fails on unknown plugins
+	code := cmd.Run([]string{"-address=" + url, "unknown"})
+	must.One(t, code)
+	must.StrContains(t, ui.ErrorWriter.String(), `plugin "unknown" not found`)
+	ui.ErrorWriter.Reset()
+
+	// Fails on plugins built into the agent
+	code = cmd.Run([]string{"-address=" + url, "mock_driver"})
+	must.One(t, code)
+	must.StrContains(t, ui.ErrorWriter.String(), "can't be reloaded")
+}
@@ -0,0 +1,393 @@
+package generic
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	log "github.com/hashicorp/go-hclog"
+	"github.com/hashicorp/nomad/helper/pluginutils/hclutils"
+	"github.com/hashicorp/nomad/helper/pluginutils/loader"
+	"github.com/hashicorp/nomad/plugins/base"
+	"github.com/hashicorp/nomad/plugins/device"
+	"github.com/hashicorp/nomad/plugins/shared/hclspec"
+	"github.com/hashicorp/nomad/plugins/shared/structs"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	// pluginName is the name of the plugin
+	pluginName = "generic-device"
+
+	// defaultPermissions are the cgroup device permissions given to tasks
+	// when a device block doesn't set its own.
+	defaultPermissions = "rwm"
+)
+
+var (
+	// PluginID is the generic device plugin metadata registered in the plugin
+	// catalog.
+	PluginID = loader.PluginID{
+		Name:       pluginName,
+		PluginType: base.PluginTypeDevice,
+	}
+
+	// PluginConfig is the generic device factory function registered in the
+	// plugin catalog.
+	PluginConfig = &loader.InternalPluginConfig{
+		Config:  map[string]interface{}{},
+		Factory: func(ctx context.Context, l log.Logger) interface{} { return NewGenericDevice(l) },
+	}
+
+	// pluginInfo describes the plugin
+	pluginInfo = &base.PluginInfoResponse{
+		Type:              base.PluginTypeDevice,
+		PluginApiVersions: []string{device.ApiVersion010},
+		PluginVersion:     "0.1.0",
+		Name:              pluginName,
+	}
+
+	// configSpec is the specification of the plugin's configuration
+	configSpec = hclspec.NewObject(map[string]*hclspec.Spec{
+		"fingerprint_period": hclspec.NewDefault(
+			hclspec.NewAttr("fingerprint_period", "string", false),
+			hclspec.NewLiteral("\"1m\""),
+		),
+		"device": hclspec.NewBlockList("device", hclspec.NewObject(map[string]*hclspec.Spec{
+			"vendor": hclspec.NewAttr("vendor", "string", true),
+			"type":   hclspec.NewAttr("type", "string", true),
+			"name":   hclspec.NewAttr("name", "string", true),
+			"paths":  hclspec.NewAttr("paths", "list(string)", true),
+			"permissions": hclspec.NewDefault(
+				hclspec.NewAttr("permissions", "string", false),
+				hclspec.NewLiteral(fmt.Sprintf("%q", defaultPermissions)),
+			),
+			"attributes": hclspec.NewAttr("attributes", "list(map(string))", false),
+		})),
+	})
+)
+
+// Config contains configuration information for the plugin.
+type Config struct {
+	FingerprintPeriod string          `codec:"fingerprint_period"`
+	Devices           []*DeviceConfig `codec:"device"`
+}
+
+// DeviceConfig configures a group of host devices exposed by the plugin.
+type DeviceConfig struct {
+	// Vendor, Type and Name identify the device group, and are what jobs
+	// request with the device block, eg. "nomad/fuse/fuse".
+	Vendor string `codec:"vendor"`
+	Type   string `codec:"type"`
+	Name   string `codec:"name"`
+
+	// Paths are glob patterns of the host devices in the group.
+	Paths []string `codec:"paths"`
+
+	// Permissions are the cgroup device permissions given to tasks using the
+	// devices. It is a combination of "r" (read), "w" (write) and "m" (mknod).
+	Permissions string `codec:"permissions"`
+
+	// Attributes are arbitrary attributes of the device group that can be
+	// used in device constraints and affinities.
+	Attributes hclutils.MapStrStr `codec:"attributes"`
+}
+
+// deviceGroup is a configured device group with its parsed attributes.
+type deviceGroup struct {
+	vendor      string
+	deviceType  string
+	name        string
+	paths       []string
+	permissions string
+	attributes  map[string]*structs.Attribute
+}
+
+// GenericDevice is a device plugin that exposes host character and block
+// devices that match a set of path globs, eg. /dev/fuse, /dev/kvm or
+// /dev/ttyUSB*. Each device is identified by its host path and is mounted at
+// the same path in the task.
+type GenericDevice struct {
+	logger log.Logger
+
+	// groups are the configured device groups
+	groups []*deviceGroup
+
+	// fingerprintPeriod is how often the device paths are rescanned
+	fingerprintPeriod time.Duration
+
+	// devices maps the path of each detected device to its group
+	devices    map[string]*deviceGroup
+	deviceLock sync.RWMutex
+}
+
+// NewGenericDevice returns a new generic device plugin.
+func NewGenericDevice(log log.Logger) *GenericDevice {
+	return &GenericDevice{
+		logger:  log.Named(pluginName),
+		devices: make(map[string]*deviceGroup),
+	}
+}
+
+// PluginInfo returns information describing the plugin.
+func (d *GenericDevice) PluginInfo() (*base.PluginInfoResponse, error) {
+	return pluginInfo, nil
+}
+
+// ConfigSchema returns the plugins configuration schema.
+func (d *GenericDevice) ConfigSchema() (*hclspec.Spec, error) {
+	return configSpec, nil
+}
+
+// SetConfig is used to set the configuration of the plugin.
+func (d *GenericDevice) SetConfig(c *base.Config) error {
+	var config Config
+	if len(c.PluginConfig) != 0 {
+		if err := base.MsgPackDecode(c.PluginConfig, &config); err != nil {
+			return err
+		}
+	}
+
+	period := time.Minute
+	if config.FingerprintPeriod != "" {
+		var err error
+		period, err = time.ParseDuration(config.FingerprintPeriod)
+		if err != nil {
+			return fmt.Errorf("failed to parse fingerprint period %q: %v", config.FingerprintPeriod, err)
+		}
+	}
+	if period <= 0 {
+		return fmt.Errorf("fingerprint period must be positive")
+	}
+	d.fingerprintPeriod = period
+
+	groups := make([]*deviceGroup, 0, len(config.Devices))
+	for i, dc := range config.Devices {
+		group, err := parseDeviceConfig(dc)
+		if err != nil {
+			return fmt.Errorf("invalid device block %d: %v", i, err)
+		}
+		groups = append(groups, group)
+	}
+	d.groups = groups
+
+	return nil
+}
+
+// parseDeviceConfig validates the device block and parses its attributes.
+func parseDeviceConfig(dc *DeviceConfig) (*deviceGroup, error) {
+	group := &deviceGroup{
+		vendor:      dc.Vendor,
+		deviceType:  dc.Type,
+		name:        dc.Name,
+		paths:       dc.Paths,
+		permissions: dc.Permissions,
+		attributes:  make(map[string]*structs.Attribute),
+	}
+
+	if group.vendor == "" || group.deviceType == "" || group.name == "" {
+		return nil, fmt.Errorf("vendor, type and name must be set")
+	}
+	if len(group.paths) == 0 {
+		return nil, fmt.Errorf("at least one path must be set")
+	}
+	for _, p := range group.paths {
+		if !filepath.IsAbs(p) {
+			return nil, fmt.Errorf("path %q must be absolute", p)
+		}
+		if _, err := filepath.Match(p, ""); err != nil {
+			return nil, fmt.Errorf("invalid path glob %q: %v", p, err)
+		}
+	}
+
+	if group.permissions == "" {
+		group.permissions = defaultPermissions
+	}
+	if strings.Trim(group.permissions, "rwm") != "" {
+		return nil, fmt.Errorf("permissions %q must only contain the characters 'r', 'w' and 'm'", group.permissions)
+	}
+
+	for k, v := range dc.Attributes {
+		attr := structs.ParseAttribute(v)
+		if err := attr.Validate(); err != nil {
+			return nil, fmt.Errorf("attribute %q invalid: %v", k, err)
+		}
+		group.attributes[k] = attr
+	}
+
+	return group, nil
+}
+
+// Fingerprint streams detected devices. The device paths are rescanned
+// periodically and messages are emitted when devices are added or removed.
+func (d *GenericDevice) Fingerprint(ctx context.Context) (<-chan *device.FingerprintResponse, error) {
+	if len(d.groups) == 0 {
+		return nil, device.ErrPluginDisabled
+	}
+
+	outCh := make(chan *device.FingerprintResponse)
+	go d.fingerprint(ctx, outCh)
+	return outCh, nil
+}
+
+// fingerprint is the long running goroutine that detects devices
+func (d *GenericDevice) fingerprint(ctx context.Context, devices chan *device.FingerprintResponse) {
+	defer close(devices)
+
+	// Create a timer that will fire immediately for the first detection
+	ticker := time.NewTimer(0)
+	first := true
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			ticker.Reset(d.fingerprintPeriod)
+		}
+
+		groups, changed := d.scan()
+		if !changed && !first {
+			continue
+		}
+		first = false
+
+		select {
+		case <-ctx.Done():
+			return
+		case devices <- device.NewFingerprint(groups...):
+		}
+	}
+}
+
+// scan globs the device paths of each group and returns the detected device
+// groups, along with whether the detected devices have changed since the
+// last scan.
+func (d *GenericDevice) scan() ([]*device.DeviceGroup, bool) {
+	detected := make(map[string]*deviceGroup)
+	var groups []*device.DeviceGroup
+
+	for _, group := range d.groups {
+		var paths []string
+		for _, pattern := range group.paths {
+			matches, err := filepath.Glob(pattern)
+			if err != nil {
+				// The patterns are validated in SetConfig
+				d.logger.Error("failed to glob device path", "path", pattern, "error", err)
+				continue
+			}
+
+			for _, path := range matches {
+				if owner, ok := detected[path]; ok {
+					if owner != group {
+						d.logger.Warn("skipping device matched by multiple device groups",
+							"device", path, "group", groupID(group), "existing_group", groupID(owner))
+					}
+					continue
+				}
+
+				if !isDevice(path) {
+					d.logger.Trace("skipping path that isn't a device", "path", path)
+					continue
+				}
+
+				detected[path] = group
+				paths = append(paths, path)
+			}
+		}
+
+		if len(paths) == 0 {
+			continue
+		}
+
+		sort.Strings(paths)
+		instances := make([]*device.Device, 0, len(paths))
+		for _, path := range paths {
+			instances = append(instances, &device.Device{
+				ID:      path,
+				Healthy: true,
+			})
+		}
+
+		groups = append(groups, &device.DeviceGroup{
+			Vendor:     group.vendor,
+			Type:       group.deviceType,
+			Name:       group.name,
+			Devices:    instances,
+			Attributes: group.attributes,
+		})
+	}
+
+	d.deviceLock.Lock()
+	defer d.deviceLock.Unlock()
+
+	changed := len(detected) != len(d.devices)
+	for path, group := range detected {
+		if d.devices[path] != group {
+			changed = true
+			break
+		}
+	}
+	d.devices = detected
+
+	return groups, changed
+}
+
+// isDevice returns whether the path is a character or block device.
+func isDevice(path string) bool {
+	fi, err := os.Stat(path)
+	if err != nil {
+		return false
+	}
+	return fi.Mode()&os.ModeDevice != 0
+}
+
+// groupID returns the vendor/type/name identifier of the device group.
+func groupID(group *deviceGroup) string {
+	return fmt.Sprintf("%s/%s/%s", group.vendor, group.deviceType, group.name)
+}
+
+// Reserve returns how to mount the given devices into the task. Each device is
+// mounted at its host path with the cgroup permissions of its group.
+func (d *GenericDevice) Reserve(deviceIDs []string) (*device.ContainerReservation, error) {
+	if len(deviceIDs) == 0 {
+		return &device.ContainerReservation{}, nil
+	}
+
+	d.deviceLock.RLock()
+	defer d.deviceLock.RUnlock()
+
+	resp := &device.ContainerReservation{}
+	for _, id := range deviceIDs {
+		group, ok := d.devices[id]
+		if !ok {
+			return nil, status.Newf(codes.InvalidArgument, "unknown device %q", id).Err()
+		}
+
+		resp.Devices = append(resp.Devices, &device.DeviceSpec{
+			TaskPath:    id,
+			HostPath:    id,
+			CgroupPerms: group.permissions,
+		})
+	}
+
+	return resp, nil
+}
+
+// Stats streams statistics for the detected devices. Host devices don't
+// expose any generic statistics, so the stream is only closed once the
+// context is done.
+func (d *GenericDevice) Stats(ctx context.Context, interval time.Duration) (<-chan *device.StatsResponse, error) {
+	outCh := make(chan *device.StatsResponse)
+	go func() {
+		<-ctx.Done()
+		close(outCh)
+	}()
+	return outCh, nil
+}
@@ -0,0 +1,163 @@
+package generic
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	log "github.com/hashicorp/go-hclog"
+	"github.com/hashicorp/nomad/ci"
+	"github.com/hashicorp/nomad/helper/pluginutils/hclspecutils"
+	"github.com/hashicorp/nomad/helper/pluginutils/hclutils"
+	"github.com/hashicorp/nomad/plugins/base"
+	"github.com/hashicorp/nomad/plugins/device"
+	"github.com/shoenig/test/must"
+	"github.com/zclconf/go-cty/cty/msgpack"
+)
+
+// newTestDevice returns a generic device plugin configured with the given
+// config, parsed the same way the plugin loader parses agent configuration.
+func newTestDevice(t *testing.T, config map[string]interface{}) (*GenericDevice, error) {
+	spec, diag := hclspecutils.Convert(configSpec)
+	must.False(t, diag.HasErrors())
+
+	val, diag, diagErrs := hclutils.ParseHclInterface(config, spec, nil)
+	must.False(t, diag.HasErrors(), must.Sprintf("%v", diagErrs))
+
+	data, err := msgpack.Marshal(val, val.Type())
+	must.NoError(t, err)
+
+	d := NewGenericDevice(log.NewNullLogger())
+	return d, d.SetConfig(&base.Config{PluginConfig: data})
+}
+
+func TestGenericDevice_Disabled(t *testing.T) {
+	ci.Parallel(t)
+
+	d, err := newTestDevice(t, map[string]interface{}{})
+	must.NoError(t, err)
+
+	_, err = d.Fingerprint(context.Background())
+	must.ErrorIs(t, err, device.ErrPluginDisabled)
+}
+
+func TestGenericDevice_SetConfig_Invalid(t *testing.T) {
+	ci.Parallel(t)
+
+	cases := []struct {
+		name   string
+		device map[string]interface{}
+		err    string
+	}{
+		{
+			name: "relative path",
+			device: map[string]interface{}{
+				"vendor": "nomad", "type": "null", "name": "null",
+				"paths": []interface{}{"dev/null"},
+			},
+			err: "must be absolute",
+		},
+		{
+			name: "bad glob",
+			device: map[string]interface{}{
+				"vendor": "nomad", "type": "null", "name": "null",
+				"paths": []interface{}{"/dev/[null"},
+			},
+			err: "invalid path glob",
+		},
+		{
+			name: "bad permissions",
+			device: map[string]interface{}{
+				"vendor": "nomad", "type": "null", "name": "null",
+				"paths":       []interface{}{"/dev/null"},
+				"permissions": "rwx",
+			},
+			err: "permissions",
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			_, err := newTestDevice(t, map[string]interface{}{
+				"device": []interface{}{tc.device},
+			})
+			must.ErrorContains(t, err, tc.err)
+		})
+	}
+}
+
+func TestGenericDevice_FingerprintReserve(t *testing.T) {
+	ci.Parallel(t)
+
+	if _, err := os.Stat("/dev/null"); err != nil {
+		t.Skip("requires /dev/null")
+	}
+
+	// Regular files matched by the globs aren't devices
+	dir := t.TempDir()
+	must.NoError(t, os.WriteFile(filepath.Join(dir, "file"), nil, 0644))
+
+	d, err := newTestDevice(t, map[string]interface{}{
+		"device": []interface{}{
+			map[string]interface{}{
+				"vendor":      "nomad",
+				"type":        "null",
+				"name":        "null",
+				"paths":       []interface{}{"/dev/nul*", filepath.Join(dir, "*")},
+				"permissions": "rw",
+				"attributes": []map[string]interface{}{
+					{"speed": "10 MB/s"},
+				},
+			},
+			map[string]interface{}{
+				"vendor": "nomad",
+				"type":   "zero",
+				"name":   "zero",
+				"paths":  []interface{}{"/dev/zero", "/dev/null"},
+			},
+			map[string]interface{}{
+				"vendor": "nomad",
+				"type":   "missing",
+				"name":   "missing",
+				"paths":  []interface{}{filepath.Join(dir, "missing*")},
+			},
+		},
+	})
+	must.NoError(t, err)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	ch, err := d.Fingerprint(ctx)
+	must.NoError(t, err)
+
+	var resp *device.FingerprintResponse
+	select {
+	case resp = <-ch:
+	case <-time.After(5 * time.Second):
+		t.Fatal("timeout waiting for fingerprint")
+	}
+	must.NoError(t, resp.Error)
+
+	// The missing group is omitted and /dev/null is only part of the first
+	// group that matched it
+	must.Len(t, 2, resp.Devices)
+	null, zero := resp.Devices[0], resp.Devices[1]
+	must.Eq(t, "null", null.Name)
+	must.Eq(t, []*device.Device{{ID: "/dev/null", Healthy: true}}, null.Devices)
+	must.Eq(t, "10MB/s", null.Attributes["speed"].GoString())
+	must.Eq(t, "zero", zero.Name)
+	must.Eq(t, []*device.Device{{ID: "/dev/zero", Healthy: true}}, zero.Devices)
+
+	res, err := d.Reserve([]string{"/dev/null", "/dev/zero"})
+	must.NoError(t, err)
+	must.Eq(t, []*device.DeviceSpec{
+		{TaskPath: "/dev/null", HostPath: "/dev/null", CgroupPerms: "rw"},
+		{TaskPath: "/dev/zero", HostPath: "/dev/zero", CgroupPerms: "rwm"},
+	}, res.Devices)
+
+	_, err = d.Reserve([]string{filepath.Join(dir, "file")})
+	must.ErrorContains(t, err, "unknown device")
+}
@@ -418,6 +418,8 @@ var (
 		},
 		MustInitiateNetwork: true,
 		MountConfigs:        drivers.MountConfigSupportAll,
+		UpdateTaskResources: true,
+		PauseTask:           true,
 	}
 )
 
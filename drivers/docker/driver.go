@@ -1630,6 +1630,75 @@ func (d *Driver) SignalTask(taskID string, signal string) error {
 	return h.Signal(context.Background(), sig)
 }
 
+// UpdateTaskResources changes the memory and CPU limits of the container of
+// the task, computed the same way as when the container was created.
+func (d *Driver) UpdateTaskResources(taskID string, resources *drivers.Resources) error {
+	h, ok := d.tasks.Get(taskID)
+	if !ok {
+		return drivers.ErrTaskNotFound
+	}
+	if resources == nil || resources.NomadResources == nil || resources.LinuxResources == nil {
+		return fmt.Errorf("task resources are required")
+	}
+
+	var driverConfig TaskConfig
+	if err := h.task.DecodeDriverConfig(&driverConfig); err != nil {
+		return fmt.Errorf("failed to decode driver config: %v", err)
+	}
+
+	memory, memoryReservation := memoryLimits(driverConfig.MemoryHardLimit, resources.NomadResources.Memory)
+	opts := docker.UpdateContainerOptions{
+		Memory:            int(memory),
+		MemoryReservation: int(memoryReservation),
+		CPUShares:         int(resources.LinuxResources.CPUShares),
+	}
+
+	// Windows does not support MemorySwap
+	if runtime.GOOS != "windows" {
+		opts.MemorySwap = int(memory)
+	}
+
+	if driverConfig.CPUHardLimit {
+		period := driverConfig.CPUCFSPeriod
+		if period == 0 {
+			period = resources.LinuxResources.CPUPeriod
+		}
+		opts.CPUPeriod = int(period)
+		opts.CPUQuota = int(resources.LinuxResources.PercentTicks*float64(period)) * runtime.NumCPU()
+	}
+
+	if err := h.client.UpdateContainer(h.containerID, opts); err != nil {
+		return fmt.Errorf("failed to update container %s: %v", h.containerID, err)
+	}
+	return nil
+}
+
+// PauseTask pauses the container of the task with the docker pause API.
+func (d *Driver) PauseTask(taskID string) error {
+	h, ok := d.tasks.Get(taskID)
+	if !ok {
+		return drivers.ErrTaskNotFound
+	}
+
+	if err := h.client.PauseContainer(h.containerID); err != nil {
+		return fmt.Errorf("failed to pause container %s: %v", h.containerID, err)
+	}
+	return nil
+}
+
+// ResumeTask unpauses the container of a task paused by PauseTask.
+func (d *Driver) ResumeTask(taskID string) error {
+	h, ok := d.tasks.Get(taskID)
+	if !ok {
+		return drivers.ErrTaskNotFound
+	}
+
+	if err := h.client.UnpauseContainer(h.containerID); err != nil {
+		return fmt.Errorf("failed to unpause container %s: %v", h.containerID, err)
+	}
+	return nil
+}
+
 func (d *Driver) ExecTask(taskID string, cmd []string, timeout time.Duration) (*drivers.ExecTaskResult, error) {
 	h, ok := d.tasks.Get(taskID)
 	if !ok {
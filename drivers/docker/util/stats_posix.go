@@ -4,6 +4,7 @@ package util
 
 import (
 	"runtime"
+	"strings"
 
 	docker "github.com/fsouza/go-dockerclient"
 	cstructs "github.com/hashicorp/nomad/client/structs"
@@ -16,6 +17,8 @@ var (
 	// cgroup-v2 only exposes a subset of memory stats
 	DockerCgroupV1MeasuredMemStats = []string{"RSS", "Cache", "Swap", "Usage", "Max Usage"}
 	DockerCgroupV2MeasuredMemStats = []string{"Cache", "Swap", "Usage"}
+
+	DockerMeasuredIOStats = []string{"Read Bytes", "Write Bytes", "Read Ops", "Write Ops"}
 )
 
 func DockerStatsToTaskResourceUsage(s *docker.Stats) *cstructs.TaskResourceUsage {
@@ -55,11 +58,33 @@ func DockerStatsToTaskResourceUsage(s *docker.Stats) *cstructs.TaskResourceUsage
 		s.CPUStats.CPUUsage.TotalUsage, s.PreCPUStats.CPUUsage.TotalUsage, runtime.NumCPU())
 	cs.TotalTicks = (cs.Percent / 100) * float64(stats.TotalTicksAvailable()) / float64(runtime.NumCPU())
 
+	is := &cstructs.IOStats{
+		Measured: DockerMeasuredIOStats,
+	}
+	is.ReadBytes, is.WriteBytes = sumBlkioEntries(s.BlkioStats.IOServiceBytesRecursive)
+	is.ReadOps, is.WriteOps = sumBlkioEntries(s.BlkioStats.IOServicedRecursive)
+
 	return &cstructs.TaskResourceUsage{
 		ResourceUsage: &cstructs.ResourceUsage{
 			MemoryStats: ms,
 			CpuStats:    cs,
+			IOStats:     is,
 		},
 		Timestamp: s.Read.UTC().UnixNano(),
 	}
 }
+
+// sumBlkioEntries totals the read and write values of the given blkio entries
+// across all block devices. cgroup-v1 reports the operation as "Read" and
+// cgroup-v2 as "read".
+func sumBlkioEntries(entries []docker.BlkioStatsEntry) (read, write uint64) {
+	for _, entry := range entries {
+		switch strings.ToLower(entry.Op) {
+		case "read":
+			read += entry.Value
+		case "write":
+			write += entry.Value
+		}
+	}
+	return
+}
@@ -15,7 +15,10 @@ import (
 	"github.com/hashicorp/nomad/drivers/shared/capabilities"
 	"github.com/hashicorp/nomad/drivers/shared/eventer"
 	"github.com/hashicorp/nomad/drivers/shared/executor"
+	"github.com/hashicorp/nomad/drivers/shared/ociimage"
 	"github.com/hashicorp/nomad/drivers/shared/resolvconf"
+	"github.com/hashicorp/nomad/drivers/shared/seccomp"
+	"github.com/hashicorp/nomad/helper/escapingfs"
 	"github.com/hashicorp/nomad/helper/pluginutils/loader"
 	"github.com/hashicorp/nomad/helper/pointer"
 	"github.com/hashicorp/nomad/plugins/base"
@@ -35,6 +38,10 @@ const (
 	// taskHandleVersion is the version of task handle which this driver sets
 	// and understands how to decode driver state
 	taskHandleVersion = 1
+
+	// imageCacheDirName is the name of the default image cache directory in
+	// the allocation directory of the client
+	imageCacheDirName = ".exec_images"
 )
 
 var (
@@ -78,17 +85,29 @@ var (
 			hclspec.NewAttr("allow_caps", "list(string)", false),
 			hclspec.NewLiteral(capabilities.HCLSpecLiteral),
 		),
+		"default_seccomp_profile": hclspec.NewDefault(
+			hclspec.NewAttr("default_seccomp_profile", "string", false),
+			hclspec.NewLiteral(`"unconfined"`),
+		),
+		"image_cache_dir": hclspec.NewAttr("image_cache_dir", "string", false),
+		"user_namespace": hclspec.NewBlock("user_namespace", false, hclspec.NewObject(map[string]*hclspec.Spec{
+			"uid_start": hclspec.NewAttr("uid_start", "number", true),
+			"gid_start": hclspec.NewAttr("gid_start", "number", true),
+			"size":      hclspec.NewAttr("size", "number", true),
+		})),
 	})
 
 	// taskConfigSpec is the hcl specification for the driver config section of
 	// a task within a job. It is returned in the TaskConfigSchema RPC
 	taskConfigSpec = hclspec.NewObject(map[string]*hclspec.Spec{
-		"command":  hclspec.NewAttr("command", "string", true),
-		"args":     hclspec.NewAttr("args", "list(string)", false),
-		"pid_mode": hclspec.NewAttr("pid_mode", "string", false),
-		"ipc_mode": hclspec.NewAttr("ipc_mode", "string", false),
-		"cap_add":  hclspec.NewAttr("cap_add", "list(string)", false),
-		"cap_drop": hclspec.NewAttr("cap_drop", "list(string)", false),
+		"command":         hclspec.NewAttr("command", "string", true),
+		"args":            hclspec.NewAttr("args", "list(string)", false),
+		"pid_mode":        hclspec.NewAttr("pid_mode", "string", false),
+		"ipc_mode":        hclspec.NewAttr("ipc_mode", "string", false),
+		"cap_add":         hclspec.NewAttr("cap_add", "list(string)", false),
+		"cap_drop":        hclspec.NewAttr("cap_drop", "list(string)", false),
+		"seccomp_profile": hclspec.NewAttr("seccomp_profile", "string", false),
+		"image":           hclspec.NewAttr("image", "string", false),
 	})
 
 	// driverCapabilities represents the RPC response for what features are
@@ -101,7 +120,9 @@ var (
 			drivers.NetIsolationModeHost,
 			drivers.NetIsolationModeGroup,
 		},
-		MountConfigs: drivers.MountConfigSupportAll,
+		MountConfigs:        drivers.MountConfigSupportAll,
+		UpdateTaskResources: true,
+		PauseTask:           true,
 	}
 )
 
@@ -151,6 +172,19 @@ type Config struct {
 	// AllowCaps configures which Linux Capabilities are enabled for tasks
 	// running on this node.
 	AllowCaps []string `codec:"allow_caps"`
+
+	// DefaultSeccompProfile is the seccomp profile applied to tasks which do
+	// not set their own.
+	DefaultSeccompProfile string `codec:"default_seccomp_profile"`
+
+	// UserNamespace is the range of host user and group IDs given to the
+	// user namespaces tasks run in. Tasks run in the user namespace of the
+	// host if it is not set.
+	UserNamespace *executor.UserNamespaceConfig `codec:"user_namespace"`
+
+	// ImageCacheDir is the directory the layers of task images are unpacked
+	// into. Defaults to a directory in the allocation directory of the client.
+	ImageCacheDir string `codec:"image_cache_dir"`
 }
 
 func (c *Config) validate() error {
@@ -171,6 +205,28 @@ func (c *Config) validate() error {
 		return fmt.Errorf("allow_caps configured with capabilities not supported by system: %s", badCaps)
 	}
 
+	if err := seccomp.Validate(c.DefaultSeccompProfile); err != nil {
+		return fmt.Errorf("invalid default_seccomp_profile: %v", err)
+	}
+	switch c.DefaultSeccompProfile {
+	case "", seccomp.ProfileUnconfined:
+	default:
+		if !seccomp.Supported() {
+			return fmt.Errorf("default_seccomp_profile %q requires Nomad to be built with seccomp support", c.DefaultSeccompProfile)
+		}
+		if _, err := seccomp.Load(c.DefaultSeccompProfile, nil); err != nil {
+			return fmt.Errorf("invalid default_seccomp_profile: %v", err)
+		}
+	}
+
+	if err := c.UserNamespace.Validate(); err != nil {
+		return fmt.Errorf("invalid user_namespace: %v", err)
+	}
+
+	if c.ImageCacheDir != "" && !filepath.IsAbs(c.ImageCacheDir) {
+		return fmt.Errorf("image_cache_dir must be an absolute path, got %q", c.ImageCacheDir)
+	}
+
 	return nil
 }
 
@@ -195,6 +251,15 @@ type TaskConfig struct {
 
 	// CapDrop is a set of linux capabilities to disable.
 	CapDrop []string `codec:"cap_drop"`
+
+	// SeccompProfile is the seccomp profile applied to the task: "default",
+	// "unconfined" or the path to a profile in the Docker or OCI format.
+	SeccompProfile string `codec:"seccomp_profile"`
+
+	// Image is the path in the task directory of an OCI image layout or
+	// `docker save` archive the root filesystem of the task is made of,
+	// instead of the chroot of the task directory.
+	Image string `codec:"image"`
 }
 
 func (tc *TaskConfig) validate() error {
@@ -220,6 +285,14 @@ func (tc *TaskConfig) validate() error {
 		return fmt.Errorf("cap_drop configured with capabilities not supported by system: %s", badDrops)
 	}
 
+	if err := seccomp.Validate(tc.SeccompProfile); err != nil {
+		return fmt.Errorf("invalid seccomp_profile: %v", err)
+	}
+
+	if filepath.IsAbs(tc.Image) {
+		return fmt.Errorf("image must be a path relative to the task directory, got %q", tc.Image)
+	}
+
 	return nil
 }
 
@@ -231,6 +304,10 @@ type TaskState struct {
 	TaskConfig     *drivers.TaskConfig
 	Pid            int
 	StartedAt      time.Time
+
+	// SeccompLog is true if the seccomp profile of the task logs syscalls
+	// which should be watched for.
+	SeccompLog bool
 }
 
 // NewExecDriver returns a new DrivePlugin implementation
@@ -300,7 +377,13 @@ func (d *Driver) TaskConfigSchema() (*hclspec.Spec, error) {
 // Capabilities is returned by the Capabilities RPC and indicates what
 // optional features this driver supports
 func (d *Driver) Capabilities() (*drivers.Capabilities, error) {
-	return driverCapabilities, nil
+	if d.config.UserNamespace == nil {
+		return driverCapabilities, nil
+	}
+
+	caps := *driverCapabilities
+	caps.UserNamespaceIDs = d.config.UserNamespace.IDMapping()
+	return &caps, nil
 }
 
 func (d *Driver) Fingerprint(ctx context.Context) (<-chan *drivers.Fingerprint, error) {
@@ -366,6 +449,8 @@ func (d *Driver) buildFingerprint() *drivers.Fingerprint {
 	}
 
 	fp.Attributes["driver.exec"] = pstructs.NewBoolAttribute(true)
+	fp.Attributes["driver.exec.user_namespaces"] = pstructs.NewBoolAttribute(executor.UserNamespacesSupported())
+	fp.Attributes["driver.exec.seccomp"] = pstructs.NewBoolAttribute(seccomp.Supported())
 	d.setFingerprintSuccess()
 	return fp
 }
@@ -414,11 +499,15 @@ func (d *Driver) RecoverTask(handle *drivers.TaskHandle) error {
 		startedAt:    taskState.StartedAt,
 		exitResult:   &drivers.ExitResult{},
 		logger:       d.logger,
+		doneCh:       make(chan struct{}),
 	}
 
 	d.tasks.Set(taskState.TaskConfig.ID, h)
 
 	go h.run()
+	if taskState.SeccompLog {
+		go h.watchSeccomp(d.eventer)
+	}
 	return nil
 }
 
@@ -440,6 +529,23 @@ func (d *Driver) StartTask(cfg *drivers.TaskConfig) (*drivers.TaskHandle, *drive
 	handle := drivers.NewTaskHandle(taskHandleVersion)
 	handle.Config = cfg
 
+	var rootfsLayers []string
+	if driverConfig.Image != "" {
+		layers, err := d.unpackImage(cfg, driverConfig.Image)
+		if err != nil {
+			return nil, nil, err
+		}
+		rootfsLayers = layers
+	}
+
+	// Release the layers of the image if the task fails to start
+	started := false
+	defer func() {
+		if !started && rootfsLayers != nil {
+			d.releaseImage(cfg)
+		}
+	}()
+
 	pluginLogFile := filepath.Join(cfg.TaskDir().Dir, "executor.out")
 	executorConfig := &executor.ExecutorConfig{
 		LogFile:     pluginLogFile,
@@ -475,6 +581,17 @@ func (d *Driver) StartTask(cfg *drivers.TaskConfig) (*drivers.TaskHandle, *drive
 	}
 	d.logger.Debug("task capabilities", "capabilities", caps)
 
+	seccompProfile := seccomp.Profile(d.config.DefaultSeccompProfile, driverConfig.SeccompProfile)
+	profile, err := seccomp.Load(seccompProfile, caps)
+	if err != nil {
+		pluginClient.Kill()
+		return nil, nil, err
+	}
+	if profile != nil && !seccomp.Supported() {
+		pluginClient.Kill()
+		return nil, nil, fmt.Errorf("seccomp profile %q cannot be applied: Nomad was built without seccomp support", seccompProfile)
+	}
+
 	execCmd := &executor.ExecCommand{
 		Cmd:              driverConfig.Command,
 		Args:             driverConfig.Args,
@@ -492,6 +609,9 @@ func (d *Driver) StartTask(cfg *drivers.TaskConfig) (*drivers.TaskHandle, *drive
 		ModePID:          executor.IsolationMode(d.config.DefaultModePID, driverConfig.ModePID),
 		ModeIPC:          executor.IsolationMode(d.config.DefaultModeIPC, driverConfig.ModeIPC),
 		Capabilities:     caps,
+		SeccompProfile:   seccompProfile,
+		UserNamespace:    cfg.UserNamespace,
+		RootfsLayers:     rootfsLayers,
 	}
 
 	ps, err := exec.Launch(execCmd)
@@ -508,6 +628,7 @@ func (d *Driver) StartTask(cfg *drivers.TaskConfig) (*drivers.TaskHandle, *drive
 		procState:    drivers.TaskStateRunning,
 		startedAt:    time.Now().Round(time.Millisecond),
 		logger:       d.logger,
+		doneCh:       make(chan struct{}),
 	}
 
 	driverState := TaskState{
@@ -515,6 +636,7 @@ func (d *Driver) StartTask(cfg *drivers.TaskConfig) (*drivers.TaskHandle, *drive
 		Pid:            ps.Pid,
 		TaskConfig:     cfg,
 		StartedAt:      h.startedAt,
+		SeccompLog:     seccomp.LogsDenials(profile),
 	}
 
 	if err := handle.SetDriverState(&driverState); err != nil {
@@ -524,11 +646,54 @@ func (d *Driver) StartTask(cfg *drivers.TaskConfig) (*drivers.TaskHandle, *drive
 		return nil, nil, fmt.Errorf("failed to set driver state: %v", err)
 	}
 
+	started = true
 	d.tasks.Set(cfg.ID, h)
 	go h.run()
+	if driverState.SeccompLog {
+		go h.watchSeccomp(d.eventer)
+	}
 	return handle, nil, nil
 }
 
+// unpackImage unpacks the layers of the image at path in the task directory
+// into the image cache, returning their directories.
+func (d *Driver) unpackImage(cfg *drivers.TaskConfig, path string) ([]string, error) {
+	taskDir := cfg.TaskDir().Dir
+	escapes, err := escapingfs.PathEscapesAllocDir(taskDir, "", path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to check image path: %v", err)
+	}
+	if escapes {
+		return nil, fmt.Errorf("image %q escapes the task directory", path)
+	}
+
+	layers, err := d.imageStore(cfg).Unpack(cfg.ID, filepath.Join(taskDir, path))
+	if err != nil {
+		return nil, fmt.Errorf("failed to unpack image %q: %v", path, err)
+	}
+	return layers, nil
+}
+
+// releaseImage releases the image layers referenced by the task, removing the
+// layers no other task references.
+func (d *Driver) releaseImage(cfg *drivers.TaskConfig) {
+	if err := d.imageStore(cfg).Release(cfg.ID); err != nil {
+		d.logger.Warn("failed to release image layers", "task_id", cfg.ID, "error", err)
+	}
+}
+
+// imageStore returns the store the layers of the images of tasks are unpacked
+// into.
+func (d *Driver) imageStore(cfg *drivers.TaskConfig) *ociimage.Store {
+	cacheDir := d.config.ImageCacheDir
+	if cacheDir == "" {
+		// The allocation directories of the client are the siblings of the
+		// one of the task
+		cacheDir = filepath.Join(filepath.Dir(cfg.AllocDir), imageCacheDirName)
+	}
+	return ociimage.NewStore(cacheDir)
+}
+
 func (d *Driver) WaitTask(ctx context.Context, taskID string) (<-chan *drivers.ExitResult, error) {
 	handle, ok := d.tasks.Get(taskID)
 	if !ok {
@@ -621,6 +786,8 @@ func (d *Driver) DestroyTask(taskID string, force bool) error {
 	// workaround for the case where DestroyTask was issued on task restart
 	d.resetCgroup(handle)
 
+	d.releaseImage(handle.taskConfig)
+
 	d.tasks.Delete(taskID)
 	return nil
 }
@@ -663,6 +830,37 @@ func (d *Driver) SignalTask(taskID string, signal string) error {
 	return handle.exec.Signal(sig)
 }
 
+// UpdateTaskResources changes the resource limits enforced on the task by the
+// executor.
+func (d *Driver) UpdateTaskResources(taskID string, resources *drivers.Resources) error {
+	handle, ok := d.tasks.Get(taskID)
+	if !ok {
+		return drivers.ErrTaskNotFound
+	}
+
+	return handle.exec.UpdateResources(resources)
+}
+
+// PauseTask freezes the processes of the task with the freezer cgroup.
+func (d *Driver) PauseTask(taskID string) error {
+	handle, ok := d.tasks.Get(taskID)
+	if !ok {
+		return drivers.ErrTaskNotFound
+	}
+
+	return handle.exec.Pause()
+}
+
+// ResumeTask thaws the processes of a task frozen by PauseTask.
+func (d *Driver) ResumeTask(taskID string) error {
+	handle, ok := d.tasks.Get(taskID)
+	if !ok {
+		return drivers.ErrTaskNotFound
+	}
+
+	return handle.exec.Resume()
+}
+
 func (d *Driver) ExecTask(taskID string, cmd []string, timeout time.Duration) (*drivers.ExecTaskResult, error) {
 	if len(cmd) == 0 {
 		return nil, fmt.Errorf("error cmd must have at least one value")
@@ -19,6 +19,7 @@ import (
 	"github.com/hashicorp/nomad/client/lib/cgutil"
 	ctestutils "github.com/hashicorp/nomad/client/testutil"
 	"github.com/hashicorp/nomad/drivers/shared/executor"
+	"github.com/hashicorp/nomad/drivers/shared/seccomp"
 	"github.com/hashicorp/nomad/helper/pluginutils/hclutils"
 	"github.com/hashicorp/nomad/helper/testlog"
 	"github.com/hashicorp/nomad/helper/testtask"
@@ -812,6 +813,61 @@ func TestDriver_Config_validate(t *testing.T) {
 			}).validate())
 		}
 	})
+	t.Run("default_seccomp_profile", func(t *testing.T) {
+		for _, tc := range []struct {
+			profile string
+			exp     error
+		}{
+			{profile: "", exp: nil},
+			{profile: "unconfined", exp: nil},
+			{profile: "seccomp.json", exp: errors.New(`invalid default_seccomp_profile: seccomp profile must be "default", "unconfined" or an absolute path, got "seccomp.json"`)},
+		} {
+			require.Equal(t, tc.exp, (&Config{
+				DefaultModePID:        "private",
+				DefaultModeIPC:        "private",
+				DefaultSeccompProfile: tc.profile,
+			}).validate())
+		}
+
+		// Other profiles are only valid when they can be applied
+		valid := filepath.Join(t.TempDir(), "seccomp.json")
+		require.NoError(t, os.WriteFile(valid, []byte(`{"defaultAction": "SCMP_ACT_ALLOW"}`), 0644))
+		missing := filepath.Join(t.TempDir(), "missing.json")
+
+		for _, profile := range []string{"default", valid, missing} {
+			err := (&Config{
+				DefaultModePID:        "private",
+				DefaultModeIPC:        "private",
+				DefaultSeccompProfile: profile,
+			}).validate()
+			switch {
+			case !seccomp.Supported():
+				require.EqualError(t, err, fmt.Sprintf("default_seccomp_profile %q requires Nomad to be built with seccomp support", profile))
+			case profile == missing:
+				require.ErrorContains(t, err, "invalid default_seccomp_profile: failed to read seccomp profile")
+			default:
+				require.NoError(t, err)
+			}
+		}
+	})
+	t.Run("user_namespace", func(t *testing.T) {
+		for _, tc := range []struct {
+			userns *executor.UserNamespaceConfig
+			exp    error
+		}{
+			{userns: nil, exp: nil},
+			{userns: &executor.UserNamespaceConfig{UIDStart: 100000, GIDStart: 100000, Size: 655360}, exp: nil},
+			{userns: &executor.UserNamespaceConfig{UIDStart: 0, GIDStart: 100000, Size: 655360}, exp: errors.New("invalid user_namespace: uid_start and gid_start must be greater than 0")},
+			{userns: &executor.UserNamespaceConfig{UIDStart: 100000, GIDStart: 100000, Size: 1000}, exp: errors.New("invalid user_namespace: size must be at least 65536, got 1000")},
+			{userns: &executor.UserNamespaceConfig{UIDStart: 4294900000, GIDStart: 100000, Size: 655360}, exp: errors.New("invalid user_namespace: range of 655360 IDs overflows the ID space")},
+		} {
+			require.Equal(t, tc.exp, (&Config{
+				DefaultModePID: "private",
+				DefaultModeIPC: "private",
+				UserNamespace:  tc.userns,
+			}).validate())
+		}
+	})
 }
 
 func TestDriver_TaskConfig_validate(t *testing.T) {
@@ -871,4 +927,34 @@ func TestDriver_TaskConfig_validate(t *testing.T) {
 			}).validate())
 		}
 	})
+	t.Run("seccomp_profile", func(t *testing.T) {
+		for _, tc := range []struct {
+			profile string
+			exp     error
+		}{
+			{profile: "", exp: nil},
+			{profile: "default", exp: nil},
+			{profile: "unconfined", exp: nil},
+			{profile: "/etc/nomad/seccomp.json", exp: nil},
+			{profile: "seccomp.json", exp: errors.New(`invalid seccomp_profile: seccomp profile must be "default", "unconfined" or an absolute path, got "seccomp.json"`)},
+		} {
+			require.Equal(t, tc.exp, (&TaskConfig{
+				SeccompProfile: tc.profile,
+			}).validate())
+		}
+	})
+	t.Run("image", func(t *testing.T) {
+		for _, tc := range []struct {
+			image string
+			exp   error
+		}{
+			{image: "", exp: nil},
+			{image: "local/image.tar", exp: nil},
+			{image: "/opt/images/app", exp: errors.New(`image must be a path relative to the task directory, got "/opt/images/app"`)},
+		} {
+			require.Equal(t, tc.exp, (&TaskConfig{
+				Image: tc.image,
+			}).validate())
+		}
+	})
 }
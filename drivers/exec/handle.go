@@ -8,7 +8,9 @@ import (
 
 	hclog "github.com/hashicorp/go-hclog"
 	plugin "github.com/hashicorp/go-plugin"
+	"github.com/hashicorp/nomad/drivers/shared/eventer"
 	"github.com/hashicorp/nomad/drivers/shared/executor"
+	"github.com/hashicorp/nomad/drivers/shared/seccomp"
 	"github.com/hashicorp/nomad/plugins/drivers"
 )
 
@@ -18,6 +20,9 @@ type taskHandle struct {
 	pluginClient *plugin.Client
 	logger       hclog.Logger
 
+	// doneCh is closed when the task exits
+	doneCh chan struct{}
+
 	// stateLock syncs access to all fields below
 	stateLock sync.RWMutex
 
@@ -52,6 +57,8 @@ func (h *taskHandle) IsRunning() bool {
 }
 
 func (h *taskHandle) run() {
+	defer close(h.doneCh)
+
 	h.stateLock.Lock()
 	if h.exitResult == nil {
 		h.exitResult = &drivers.ExitResult{}
@@ -77,3 +84,25 @@ func (h *taskHandle) run() {
 
 	// TODO: detect if the task OOMed
 }
+
+// watchSeccomp emits a task event the first time each syscall is logged by the
+// seccomp profile of the task, until the task exits.
+func (h *taskHandle) watchSeccomp(e *eventer.Eventer) {
+	ctx, cancel := context.WithCancel(context.Background())
+	go func() {
+		<-h.doneCh
+		cancel()
+	}()
+
+	seen := make(map[int]struct{})
+	seccomp.WatchDenials(ctx, h.logger, h.pid, func(denial *seccomp.Denial) {
+		if _, ok := seen[denial.Syscall]; ok {
+			return
+		}
+		seen[denial.Syscall] = struct{}{}
+
+		if err := e.EmitEvent(denial.TaskEvent(h.taskConfig)); err != nil {
+			h.logger.Warn("failed to emit seccomp task event", "error", err)
+		}
+	})
+}
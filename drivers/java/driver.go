@@ -17,6 +17,7 @@ import (
 	"github.com/hashicorp/nomad/drivers/shared/eventer"
 	"github.com/hashicorp/nomad/drivers/shared/executor"
 	"github.com/hashicorp/nomad/drivers/shared/resolvconf"
+	"github.com/hashicorp/nomad/drivers/shared/seccomp"
 	"github.com/hashicorp/nomad/helper/pluginutils/loader"
 	"github.com/hashicorp/nomad/plugins/base"
 	"github.com/hashicorp/nomad/plugins/drivers"
@@ -78,6 +79,15 @@ var (
 			hclspec.NewAttr("allow_caps", "list(string)", false),
 			hclspec.NewLiteral(capabilities.HCLSpecLiteral),
 		),
+		"default_seccomp_profile": hclspec.NewDefault(
+			hclspec.NewAttr("default_seccomp_profile", "string", false),
+			hclspec.NewLiteral(`"unconfined"`),
+		),
+		"user_namespace": hclspec.NewBlock("user_namespace", false, hclspec.NewObject(map[string]*hclspec.Spec{
+			"uid_start": hclspec.NewAttr("uid_start", "number", true),
+			"gid_start": hclspec.NewAttr("gid_start", "number", true),
+			"size":      hclspec.NewAttr("size", "number", true),
+		})),
 	})
 
 	// taskConfigSpec is the hcl specification for the driver config section of
@@ -86,15 +96,16 @@ var (
 		// It's required for either `class` or `jar_path` to be set,
 		// but that's not expressable in hclspec.  Marking both as optional
 		// and setting checking explicitly later
-		"class":       hclspec.NewAttr("class", "string", false),
-		"class_path":  hclspec.NewAttr("class_path", "string", false),
-		"jar_path":    hclspec.NewAttr("jar_path", "string", false),
-		"jvm_options": hclspec.NewAttr("jvm_options", "list(string)", false),
-		"args":        hclspec.NewAttr("args", "list(string)", false),
-		"pid_mode":    hclspec.NewAttr("pid_mode", "string", false),
-		"ipc_mode":    hclspec.NewAttr("ipc_mode", "string", false),
-		"cap_add":     hclspec.NewAttr("cap_add", "list(string)", false),
-		"cap_drop":    hclspec.NewAttr("cap_drop", "list(string)", false),
+		"class":           hclspec.NewAttr("class", "string", false),
+		"class_path":      hclspec.NewAttr("class_path", "string", false),
+		"jar_path":        hclspec.NewAttr("jar_path", "string", false),
+		"jvm_options":     hclspec.NewAttr("jvm_options", "list(string)", false),
+		"args":            hclspec.NewAttr("args", "list(string)", false),
+		"pid_mode":        hclspec.NewAttr("pid_mode", "string", false),
+		"ipc_mode":        hclspec.NewAttr("ipc_mode", "string", false),
+		"cap_add":         hclspec.NewAttr("cap_add", "list(string)", false),
+		"cap_drop":        hclspec.NewAttr("cap_drop", "list(string)", false),
+		"seccomp_profile": hclspec.NewAttr("seccomp_profile", "string", false),
 	})
 
 	// driverCapabilities is returned by the Capabilities RPC and indicates what
@@ -107,7 +118,9 @@ var (
 			drivers.NetIsolationModeHost,
 			drivers.NetIsolationModeGroup,
 		},
-		MountConfigs: drivers.MountConfigSupportNone,
+		MountConfigs:        drivers.MountConfigSupportNone,
+		UpdateTaskResources: true,
+		PauseTask:           true,
 	}
 
 	_ drivers.DriverPlugin = (*Driver)(nil)
@@ -133,6 +146,15 @@ type Config struct {
 	// AllowCaps configures which Linux Capabilities are enabled for tasks
 	// running on this node.
 	AllowCaps []string `codec:"allow_caps"`
+
+	// DefaultSeccompProfile is the seccomp profile applied to tasks which do
+	// not set their own.
+	DefaultSeccompProfile string `codec:"default_seccomp_profile"`
+
+	// UserNamespace is the range of host user and group IDs given to the
+	// user namespaces tasks run in. Tasks run in the user namespace of the
+	// host if it is not set.
+	UserNamespace *executor.UserNamespaceConfig `codec:"user_namespace"`
 }
 
 func (c *Config) validate() error {
@@ -153,6 +175,24 @@ func (c *Config) validate() error {
 		return fmt.Errorf("allow_caps configured with capabilities not supported by system: %s", badCaps)
 	}
 
+	if err := seccomp.Validate(c.DefaultSeccompProfile); err != nil {
+		return fmt.Errorf("invalid default_seccomp_profile: %v", err)
+	}
+	switch c.DefaultSeccompProfile {
+	case "", seccomp.ProfileUnconfined:
+	default:
+		if !seccomp.Supported() {
+			return fmt.Errorf("default_seccomp_profile %q requires Nomad to be built with seccomp support", c.DefaultSeccompProfile)
+		}
+		if _, err := seccomp.Load(c.DefaultSeccompProfile, nil); err != nil {
+			return fmt.Errorf("invalid default_seccomp_profile: %v", err)
+		}
+	}
+
+	if err := c.UserNamespace.Validate(); err != nil {
+		return fmt.Errorf("invalid user_namespace: %v", err)
+	}
+
 	return nil
 }
 
@@ -186,6 +226,10 @@ type TaskConfig struct {
 
 	// CapDrop is a set of linux capabilities to disable.
 	CapDrop []string `codec:"cap_drop"`
+
+	// SeccompProfile is the seccomp profile applied to the task: "default",
+	// "unconfined" or the path to a profile in the Docker or OCI format.
+	SeccompProfile string `codec:"seccomp_profile"`
 }
 
 func (tc *TaskConfig) validate() error {
@@ -212,6 +256,10 @@ func (tc *TaskConfig) validate() error {
 		return fmt.Errorf("cap_drop configured with capabilities not supported by system: %s", badDrops)
 	}
 
+	if err := seccomp.Validate(tc.SeccompProfile); err != nil {
+		return fmt.Errorf("invalid seccomp_profile: %v", err)
+	}
+
 	return nil
 }
 
@@ -223,6 +271,10 @@ type TaskState struct {
 	TaskConfig     *drivers.TaskConfig
 	Pid            int
 	StartedAt      time.Time
+
+	// SeccompLog is true if the seccomp profile of the task logs syscalls
+	// which should be watched for.
+	SeccompLog bool
 }
 
 // Driver is a driver for running images via Java
@@ -290,7 +342,13 @@ func (d *Driver) TaskConfigSchema() (*hclspec.Spec, error) {
 }
 
 func (d *Driver) Capabilities() (*drivers.Capabilities, error) {
-	return driverCapabilities, nil
+	if d.config.UserNamespace == nil {
+		return driverCapabilities, nil
+	}
+
+	caps := *driverCapabilities
+	caps.UserNamespaceIDs = d.config.UserNamespace.IDMapping()
+	return &caps, nil
 }
 
 func (d *Driver) Fingerprint(ctx context.Context) (<-chan *drivers.Fingerprint, error) {
@@ -369,6 +427,8 @@ func (d *Driver) buildFingerprint() *drivers.Fingerprint {
 	fp.Attributes[driverVersionAttr] = pstructs.NewStringAttribute(version)
 	fp.Attributes["driver.java.runtime"] = pstructs.NewStringAttribute(jdkJRE)
 	fp.Attributes["driver.java.vm"] = pstructs.NewStringAttribute(vm)
+	fp.Attributes["driver.java.user_namespaces"] = pstructs.NewBoolAttribute(executor.UserNamespacesSupported())
+	fp.Attributes["driver.java.seccomp"] = pstructs.NewBoolAttribute(seccomp.Supported())
 
 	return fp
 }
@@ -415,11 +475,15 @@ func (d *Driver) RecoverTask(handle *drivers.TaskHandle) error {
 		startedAt:    taskState.StartedAt,
 		exitResult:   &drivers.ExitResult{},
 		logger:       d.logger,
+		doneCh:       make(chan struct{}),
 	}
 
 	d.tasks.Set(taskState.TaskConfig.ID, h)
 
 	go h.run()
+	if taskState.SeccompLog {
+		go h.watchSeccomp(d.eventer)
+	}
 	return nil
 }
 
@@ -488,6 +552,17 @@ func (d *Driver) StartTask(cfg *drivers.TaskConfig) (*drivers.TaskHandle, *drive
 	}
 	d.logger.Debug("task capabilities", "capabilities", caps)
 
+	seccompProfile := seccomp.Profile(d.config.DefaultSeccompProfile, driverConfig.SeccompProfile)
+	profile, err := seccomp.Load(seccompProfile, caps)
+	if err != nil {
+		pluginClient.Kill()
+		return nil, nil, err
+	}
+	if profile != nil && !seccomp.Supported() {
+		pluginClient.Kill()
+		return nil, nil, fmt.Errorf("seccomp profile %q cannot be applied: Nomad was built without seccomp support", seccompProfile)
+	}
+
 	execCmd := &executor.ExecCommand{
 		Cmd:              absPath,
 		Args:             args,
@@ -504,6 +579,8 @@ func (d *Driver) StartTask(cfg *drivers.TaskConfig) (*drivers.TaskHandle, *drive
 		ModePID:          executor.IsolationMode(d.config.DefaultModePID, driverConfig.ModePID),
 		ModeIPC:          executor.IsolationMode(d.config.DefaultModeIPC, driverConfig.ModeIPC),
 		Capabilities:     caps,
+		SeccompProfile:   seccompProfile,
+		UserNamespace:    cfg.UserNamespace,
 	}
 
 	ps, err := exec.Launch(execCmd)
@@ -520,6 +597,7 @@ func (d *Driver) StartTask(cfg *drivers.TaskConfig) (*drivers.TaskHandle, *drive
 		procState:    drivers.TaskStateRunning,
 		startedAt:    time.Now().Round(time.Millisecond),
 		logger:       d.logger,
+		doneCh:       make(chan struct{}),
 	}
 
 	driverState := TaskState{
@@ -527,6 +605,7 @@ func (d *Driver) StartTask(cfg *drivers.TaskConfig) (*drivers.TaskHandle, *drive
 		Pid:            ps.Pid,
 		TaskConfig:     cfg,
 		StartedAt:      h.startedAt,
+		SeccompLog:     seccomp.LogsDenials(profile),
 	}
 
 	if err := handle.SetDriverState(&driverState); err != nil {
@@ -538,6 +617,9 @@ func (d *Driver) StartTask(cfg *drivers.TaskConfig) (*drivers.TaskHandle, *drive
 
 	d.tasks.Set(cfg.ID, h)
 	go h.run()
+	if driverState.SeccompLog {
+		go h.watchSeccomp(d.eventer)
+	}
 	return handle, nil, nil
 }
 
@@ -684,6 +766,37 @@ func (d *Driver) SignalTask(taskID string, signal string) error {
 	return handle.exec.Signal(sig)
 }
 
+// UpdateTaskResources changes the resource limits enforced on the task by the
+// executor.
+func (d *Driver) UpdateTaskResources(taskID string, resources *drivers.Resources) error {
+	handle, ok := d.tasks.Get(taskID)
+	if !ok {
+		return drivers.ErrTaskNotFound
+	}
+
+	return handle.exec.UpdateResources(resources)
+}
+
+// PauseTask freezes the processes of the task with the freezer cgroup.
+func (d *Driver) PauseTask(taskID string) error {
+	handle, ok := d.tasks.Get(taskID)
+	if !ok {
+		return drivers.ErrTaskNotFound
+	}
+
+	return handle.exec.Pause()
+}
+
+// ResumeTask thaws the processes of a task frozen by PauseTask.
+func (d *Driver) ResumeTask(taskID string) error {
+	handle, ok := d.tasks.Get(taskID)
+	if !ok {
+		return drivers.ErrTaskNotFound
+	}
+
+	return handle.exec.Resume()
+}
+
 func (d *Driver) ExecTask(taskID string, cmd []string, timeout time.Duration) (*drivers.ExecTaskResult, error) {
 	if len(cmd) == 0 {
 		return nil, fmt.Errorf("error cmd must have at least one value")
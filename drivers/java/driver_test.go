@@ -14,6 +14,8 @@ import (
 
 	"github.com/hashicorp/nomad/ci"
 	ctestutil "github.com/hashicorp/nomad/client/testutil"
+	"github.com/hashicorp/nomad/drivers/shared/executor"
+	"github.com/hashicorp/nomad/drivers/shared/seccomp"
 	"github.com/hashicorp/nomad/helper/pluginutils/hclutils"
 	"github.com/hashicorp/nomad/helper/testlog"
 	"github.com/hashicorp/nomad/helper/uuid"
@@ -452,6 +454,59 @@ func TestDriver_Config_validate(t *testing.T) {
 			}).validate())
 		}
 	})
+	t.Run("default_seccomp_profile", func(t *testing.T) {
+		for _, tc := range []struct {
+			profile string
+			exp     error
+		}{
+			{profile: "", exp: nil},
+			{profile: "unconfined", exp: nil},
+			{profile: "seccomp.json", exp: errors.New(`invalid default_seccomp_profile: seccomp profile must be "default", "unconfined" or an absolute path, got "seccomp.json"`)},
+		} {
+			require.Equal(t, tc.exp, (&Config{
+				DefaultModePID:        "private",
+				DefaultModeIPC:        "private",
+				DefaultSeccompProfile: tc.profile,
+			}).validate())
+		}
+
+		// Other profiles are only valid when they can be applied
+		valid := filepath.Join(t.TempDir(), "seccomp.json")
+		require.NoError(t, os.WriteFile(valid, []byte(`{"defaultAction": "SCMP_ACT_ALLOW"}`), 0644))
+		missing := filepath.Join(t.TempDir(), "missing.json")
+
+		for _, profile := range []string{"default", valid, missing} {
+			err := (&Config{
+				DefaultModePID:        "private",
+				DefaultModeIPC:        "private",
+				DefaultSeccompProfile: profile,
+			}).validate()
+			switch {
+			case !seccomp.Supported():
+				require.EqualError(t, err, fmt.Sprintf("default_seccomp_profile %q requires Nomad to be built with seccomp support", profile))
+			case profile == missing:
+				require.ErrorContains(t, err, "invalid default_seccomp_profile: failed to read seccomp profile")
+			default:
+				require.NoError(t, err)
+			}
+		}
+	})
+	t.Run("user_namespace", func(t *testing.T) {
+		for _, tc := range []struct {
+			userns *executor.UserNamespaceConfig
+			exp    error
+		}{
+			{userns: nil, exp: nil},
+			{userns: &executor.UserNamespaceConfig{UIDStart: 100000, GIDStart: 100000, Size: 655360}, exp: nil},
+			{userns: &executor.UserNamespaceConfig{UIDStart: 100000, GIDStart: 100000, Size: 1000}, exp: errors.New("invalid user_namespace: size must be at least 65536, got 1000")},
+		} {
+			require.Equal(t, tc.exp, (&Config{
+				DefaultModePID: "private",
+				DefaultModeIPC: "private",
+				UserNamespace:  tc.userns,
+			}).validate())
+		}
+	})
 }
 
 func TestDriver_TaskConfig_validate(t *testing.T) {
@@ -512,4 +567,20 @@ func TestDriver_TaskConfig_validate(t *testing.T) {
 			}).validate())
 		}
 	})
+	t.Run("seccomp_profile", func(t *testing.T) {
+		for _, tc := range []struct {
+			profile string
+			exp     error
+		}{
+			{profile: "", exp: nil},
+			{profile: "default", exp: nil},
+			{profile: "unconfined", exp: nil},
+			{profile: "/etc/nomad/seccomp.json", exp: nil},
+			{profile: "seccomp.json", exp: errors.New(`invalid seccomp_profile: seccomp profile must be "default", "unconfined" or an absolute path, got "seccomp.json"`)},
+		} {
+			require.Equal(t, tc.exp, (&TaskConfig{
+				SeccompProfile: tc.profile,
+			}).validate())
+		}
+	})
 }
@@ -149,10 +149,12 @@ func NewMockDriver(ctx context.Context, logger hclog.Logger) drivers.DriverPlugi
 	logger = logger.Named(pluginName)
 
 	capabilities := &drivers.Capabilities{
-		SendSignals:  true,
-		Exec:         true,
-		FSIsolation:  drivers.FSIsolationNone,
-		MountConfigs: drivers.MountConfigSupportNone,
+		SendSignals:         true,
+		Exec:                true,
+		FSIsolation:         drivers.FSIsolationNone,
+		MountConfigs:        drivers.MountConfigSupportNone,
+		UpdateTaskResources: true,
+		PauseTask:           true,
 	}
 
 	return &Driver{
@@ -609,6 +611,30 @@ func (d *Driver) SignalTask(taskID string, signal string) error {
 	return errors.New(h.command.SignalErr)
 }
 
+func (d *Driver) UpdateTaskResources(taskID string, resources *drivers.Resources) error {
+	if _, ok := d.tasks.Get(taskID); !ok {
+		return drivers.ErrTaskNotFound
+	}
+
+	return nil
+}
+
+func (d *Driver) PauseTask(taskID string) error {
+	if _, ok := d.tasks.Get(taskID); !ok {
+		return drivers.ErrTaskNotFound
+	}
+
+	return nil
+}
+
+func (d *Driver) ResumeTask(taskID string) error {
+	if _, ok := d.tasks.Get(taskID); !ok {
+		return drivers.ErrTaskNotFound
+	}
+
+	return nil
+}
+
 func (d *Driver) ExecTask(taskID string, cmd []string, timeout time.Duration) (*drivers.ExecTaskResult, error) {
 	h, ok := d.tasks.Get(taskID)
 	if !ok {
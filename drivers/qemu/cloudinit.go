@@ -0,0 +1,282 @@
+package qemu
+
+import (
+	"bytes"
+	"encoding/binary"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+	"unicode/utf16"
+
+	"github.com/hashicorp/nomad/helper/args"
+)
+
+const (
+	// cloudInitSeedName is the name of the NoCloud seed image written to the
+	// task directory.
+	cloudInitSeedName = "cloud-init.iso"
+
+	// cloudInitVolumeID is the volume label cloud-init's NoCloud datasource
+	// looks for.
+	cloudInitVolumeID = "cidata"
+
+	// isoSectorSize is the logical block size of an ISO 9660 image.
+	isoSectorSize = 2048
+)
+
+// CloudInitConfig is the cloud-init configuration of a task. Its contents are
+// written to a NoCloud seed image attached to the guest as a CD-ROM.
+type CloudInitConfig struct {
+	UserData      string `codec:"user_data"`
+	MetaData      string `codec:"meta_data"`
+	NetworkConfig string `codec:"network_config"`
+}
+
+// seedFiles returns the files of the NoCloud seed, rendered with the task
+// environment env. The meta-data file is required by cloud-init, so a default
+// one identifying the instance by its allocation is used when none is given.
+func (c *CloudInitConfig) seedFiles(instanceID, hostname string, env map[string]string) map[string][]byte {
+	metaData := args.ReplaceEnv(c.MetaData, env)
+	if metaData == "" {
+		metaData = fmt.Sprintf("instance-id: %s\nlocal-hostname: %s\n", instanceID, hostname)
+	}
+
+	files := map[string][]byte{
+		"meta-data": []byte(metaData),
+		"user-data": []byte(args.ReplaceEnv(c.UserData, env)),
+	}
+	if c.NetworkConfig != "" {
+		files["network-config"] = []byte(args.ReplaceEnv(c.NetworkConfig, env))
+	}
+	return files
+}
+
+// buildSeedISO returns an ISO 9660 image labelled for cloud-init's NoCloud
+// datasource containing the given files in its root directory. A Joliet
+// supplementary volume descriptor carries the original file names, since
+// names like "user-data" are not valid plain ISO 9660 names.
+func buildSeedISO(files map[string][]byte, now time.Time) []byte {
+	names := make([]string, 0, len(files))
+	for name := range files {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	// Layout: 16 reserved sectors, the primary, Joliet and terminator volume
+	// descriptors, four path tables, two root directories, then file data.
+	const (
+		pvdSector        = 16
+		svdSector        = 17
+		terminatorSector = 18
+		lPathSector      = 19
+		mPathSector      = 20
+		jolietLPath      = 21
+		jolietMPath      = 22
+		rootSector       = 23
+		jolietRootSector = 24
+		dataSector       = 25
+	)
+
+	extents := make(map[string]uint32, len(names))
+	next := uint32(dataSector)
+	for _, name := range names {
+		extents[name] = next
+		next += sectorsFor(len(files[name]))
+	}
+	total := next
+
+	img := make([]byte, int(total)*isoSectorSize)
+	sector := func(n uint32) []byte {
+		return img[int(n)*isoSectorSize : int(n+1)*isoSectorSize]
+	}
+
+	// Root directories
+	writeRoot := func(dst []byte, self uint32, joliet bool) {
+		var buf bytes.Buffer
+		buf.Write(dirRecord([]byte{0}, self, isoSectorSize, true, now))
+		buf.Write(dirRecord([]byte{1}, self, isoSectorSize, true, now))
+		for _, name := range names {
+			id := isoFileID(name)
+			if joliet {
+				id = jolietFileID(name)
+			}
+			buf.Write(dirRecord(id, extents[name], uint32(len(files[name])), false, now))
+		}
+		copy(dst, buf.Bytes())
+	}
+	writeRoot(sector(rootSector), rootSector, false)
+	writeRoot(sector(jolietRootSector), jolietRootSector, true)
+
+	// Path tables only contain the root directory
+	copy(sector(lPathSector), pathTableRecord(rootSector, binary.LittleEndian))
+	copy(sector(mPathSector), pathTableRecord(rootSector, binary.BigEndian))
+	copy(sector(jolietLPath), pathTableRecord(jolietRootSector, binary.LittleEndian))
+	copy(sector(jolietMPath), pathTableRecord(jolietRootSector, binary.BigEndian))
+
+	// Volume descriptors
+	writeVolumeDescriptor(sector(pvdSector), 1, total, lPathSector, mPathSector, rootSector, now)
+	writeVolumeDescriptor(sector(svdSector), 2, total, jolietLPath, jolietMPath, jolietRootSector, now)
+	terminator := sector(terminatorSector)
+	terminator[0] = 255
+	copy(terminator[1:6], "CD001")
+	terminator[6] = 1
+
+	// File data
+	for _, name := range names {
+		copy(img[int(extents[name])*isoSectorSize:], files[name])
+	}
+
+	return img
+}
+
+// writeVolumeDescriptor writes a primary (type 1) or Joliet supplementary
+// (type 2) volume descriptor.
+func writeVolumeDescriptor(dst []byte, typ byte, total, lPath, mPath, root uint32, now time.Time) {
+	joliet := typ == 2
+	dst[0] = typ
+	copy(dst[1:6], "CD001")
+	dst[6] = 1
+
+	if joliet {
+		fillUCS2(dst[8:40], "")
+		fillUCS2(dst[40:72], cloudInitVolumeID)
+		// UCS-2 level 3 escape sequence
+		copy(dst[88:91], "%/E")
+	} else {
+		fillA(dst[8:40], "")
+		fillA(dst[40:72], strings.ToUpper(cloudInitVolumeID))
+	}
+
+	putBoth32(dst[80:88], total)
+	putBoth16(dst[120:124], 1)
+	putBoth16(dst[124:128], 1)
+	putBoth16(dst[128:132], isoSectorSize)
+	putBoth32(dst[132:140], uint32(len(pathTableRecord(root, binary.LittleEndian))))
+	binary.LittleEndian.PutUint32(dst[140:144], lPath)
+	binary.BigEndian.PutUint32(dst[148:152], mPath)
+	copy(dst[156:190], dirRecord([]byte{0}, root, isoSectorSize, true, now))
+
+	// Volume set, publisher, preparer, application and file identifiers
+	for _, field := range [][2]int{{190, 318}, {318, 446}, {446, 574}, {574, 702}, {702, 739}, {739, 776}, {776, 813}} {
+		if joliet {
+			fillUCS2(dst[field[0]:field[1]], "")
+		} else {
+			fillA(dst[field[0]:field[1]], "")
+		}
+	}
+
+	stamp := decDateTime(now)
+	copy(dst[813:830], stamp)
+	copy(dst[830:847], stamp)
+	copy(dst[847:864], decDateTime(time.Time{}))
+	copy(dst[864:881], stamp)
+	dst[881] = 1
+}
+
+// dirRecord returns an ISO 9660 directory record.
+func dirRecord(id []byte, extent, size uint32, dir bool, now time.Time) []byte {
+	length := 33 + len(id)
+	if length%2 == 1 {
+		length++
+	}
+
+	rec := make([]byte, length)
+	rec[0] = byte(length)
+	putBoth32(rec[2:10], extent)
+	putBoth32(rec[10:18], size)
+
+	now = now.UTC()
+	rec[18] = byte(now.Year() - 1900)
+	rec[19] = byte(now.Month())
+	rec[20] = byte(now.Day())
+	rec[21] = byte(now.Hour())
+	rec[22] = byte(now.Minute())
+	rec[23] = byte(now.Second())
+
+	if dir {
+		rec[25] = 2
+	}
+	putBoth16(rec[28:32], 1)
+	rec[32] = byte(len(id))
+	copy(rec[33:], id)
+	return rec
+}
+
+// pathTableRecord returns a path table containing only the root directory.
+func pathTableRecord(root uint32, order binary.ByteOrder) []byte {
+	rec := make([]byte, 10)
+	rec[0] = 1
+	order.PutUint32(rec[2:6], root)
+	order.PutUint16(rec[6:8], 1)
+	return rec
+}
+
+// isoFileID returns a level 1 ISO 9660 file identifier for name.
+func isoFileID(name string) []byte {
+	id := strings.Map(func(r rune) rune {
+		switch {
+		case r >= 'a' && r <= 'z':
+			return r - 'a' + 'A'
+		case r >= 'A' && r <= 'Z', r >= '0' && r <= '9':
+			return r
+		default:
+			return '_'
+		}
+	}, name)
+	if len(id) > 8 {
+		id = id[:8]
+	}
+	return []byte(id + ".;1")
+}
+
+// jolietFileID returns the UCS-2 big endian form of name.
+func jolietFileID(name string) []byte {
+	codes := utf16.Encode([]rune(name))
+	id := make([]byte, len(codes)*2)
+	for i, c := range codes {
+		binary.BigEndian.PutUint16(id[i*2:], c)
+	}
+	return id
+}
+
+// decDateTime returns the 17 byte volume descriptor form of t. The zero time
+// is encoded as "not specified".
+func decDateTime(t time.Time) []byte {
+	if t.IsZero() {
+		return append(bytes.Repeat([]byte{'0'}, 16), 0)
+	}
+	t = t.UTC()
+	s := fmt.Sprintf("%04d%02d%02d%02d%02d%02d%02d",
+		t.Year(), t.Month(), t.Day(), t.Hour(), t.Minute(), t.Second(), t.Nanosecond()/10_000_000)
+	return append([]byte(s), 0)
+}
+
+func fillA(dst []byte, s string) {
+	n := copy(dst, s)
+	for i := n; i < len(dst); i++ {
+		dst[i] = ' '
+	}
+}
+
+func fillUCS2(dst []byte, s string) {
+	id := jolietFileID(s)
+	n := copy(dst, id)
+	for i := n; i+1 < len(dst); i += 2 {
+		dst[i], dst[i+1] = 0, ' '
+	}
+}
+
+func putBoth16(dst []byte, v uint16) {
+	binary.LittleEndian.PutUint16(dst[0:2], v)
+	binary.BigEndian.PutUint16(dst[2:4], v)
+}
+
+func putBoth32(dst []byte, v uint32) {
+	binary.LittleEndian.PutUint32(dst[0:4], v)
+	binary.BigEndian.PutUint32(dst[4:8], v)
+}
+
+func sectorsFor(size int) uint32 {
+	return uint32((size + isoSectorSize - 1) / isoSectorSize)
+}
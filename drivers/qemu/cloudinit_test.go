@@ -0,0 +1,112 @@
+package qemu
+
+import (
+	"encoding/binary"
+	"strings"
+	"testing"
+	"time"
+	"unicode/utf16"
+
+	"github.com/hashicorp/nomad/ci"
+	"github.com/shoenig/test/must"
+)
+
+// readSeedISO is a minimal ISO 9660 reader returning the volume label and
+// files of the root directory described by the volume descriptor at sector.
+func readSeedISO(t *testing.T, img []byte, sector int) (string, map[string]string) {
+	t.Helper()
+
+	vd := img[sector*isoSectorSize : (sector+1)*isoSectorSize]
+	must.Eq(t, "CD001", string(vd[1:6]))
+	joliet := vd[0] == 2
+
+	decode := func(b []byte) string {
+		if !joliet {
+			return strings.TrimRight(string(b), " ")
+		}
+		codes := make([]uint16, len(b)/2)
+		for i := range codes {
+			codes[i] = binary.BigEndian.Uint16(b[i*2:])
+		}
+		return strings.TrimRight(string(utf16.Decode(codes)), " ")
+	}
+
+	label := decode(vd[40:72])
+	must.Eq(t, uint32(len(img)/isoSectorSize), binary.LittleEndian.Uint32(vd[80:84]))
+
+	root := vd[156:190]
+	extent := binary.LittleEndian.Uint32(root[2:6])
+	dir := img[int(extent)*isoSectorSize : int(extent+1)*isoSectorSize]
+
+	files := map[string]string{}
+	for off := 0; off < len(dir) && dir[off] != 0; off += int(dir[off]) {
+		rec := dir[off : off+int(dir[off])]
+		id := rec[33 : 33+int(rec[32])]
+		if rec[25]&2 != 0 {
+			// . and ..
+			continue
+		}
+		start := int(binary.LittleEndian.Uint32(rec[2:6])) * isoSectorSize
+		size := int(binary.LittleEndian.Uint32(rec[10:14]))
+		files[decode(id)] = string(img[start : start+size])
+	}
+	return label, files
+}
+
+func TestCloudInit_SeedISO(t *testing.T) {
+	ci.Parallel(t)
+
+	config := &CloudInitConfig{
+		UserData:      "#cloud-config\npackages: [nginx]\n",
+		NetworkConfig: strings.Repeat("#", 3000),
+	}
+	files := config.seedFiles("alloc-id", "web", nil)
+	img := buildSeedISO(files, time.Date(2023, 4, 5, 6, 7, 8, 0, time.UTC))
+	must.Zero(t, len(img)%isoSectorSize)
+
+	// The Joliet volume carries the names cloud-init expects
+	label, got := readSeedISO(t, img, 17)
+	must.Eq(t, "cidata", label)
+	must.Eq(t, map[string]string{
+		"meta-data":      "instance-id: alloc-id\nlocal-hostname: web\n",
+		"user-data":      config.UserData,
+		"network-config": config.NetworkConfig,
+	}, got)
+
+	// The primary volume has the same contents under ISO 9660 names
+	label, got = readSeedISO(t, img, 16)
+	must.Eq(t, "CIDATA", label)
+	must.MapContainsKeys(t, got, []string{"META_DAT.;1", "USER_DAT.;1", "NETWORK_.;1"})
+	must.Eq(t, config.UserData, got["USER_DAT.;1"])
+
+	// The descriptor set is terminated
+	must.Eq(t, byte(255), img[18*isoSectorSize])
+}
+
+func TestCloudInit_SeedFiles(t *testing.T) {
+	ci.Parallel(t)
+
+	config := &CloudInitConfig{MetaData: "instance-id: custom\n"}
+	files := config.seedFiles("alloc-id", "web", nil)
+	must.MapLen(t, 2, files)
+	must.Eq(t, "instance-id: custom\n", string(files["meta-data"]))
+	must.Eq(t, "", string(files["user-data"]))
+}
+
+func TestCloudInit_SeedFiles_Env(t *testing.T) {
+	ci.Parallel(t)
+
+	config := &CloudInitConfig{
+		UserData: "#cloud-config\nruncmd:\n  - echo ${NOMAD_ALLOC_ID} ${UNKNOWN}\n",
+		MetaData: "instance-id: ${NOMAD_ALLOC_ID}\nlocal-hostname: ${NOMAD_TASK_NAME}\n",
+	}
+	env := map[string]string{
+		"NOMAD_ALLOC_ID":  "alloc-id",
+		"NOMAD_TASK_NAME": "web",
+	}
+
+	// Variables of the task environment are rendered and others are kept
+	files := config.seedFiles("alloc-id", "web", env)
+	must.Eq(t, "#cloud-config\nruncmd:\n  - echo alloc-id ${UNKNOWN}\n", string(files["user-data"]))
+	must.Eq(t, "instance-id: alloc-id\nlocal-hostname: web\n", string(files["meta-data"]))
+}
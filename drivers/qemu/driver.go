@@ -19,6 +19,7 @@ import (
 	"github.com/hashicorp/nomad/drivers/shared/executor"
 	"github.com/hashicorp/nomad/helper/pluginutils/hclutils"
 	"github.com/hashicorp/nomad/helper/pluginutils/loader"
+	"github.com/hashicorp/nomad/helper/users"
 	"github.com/hashicorp/nomad/plugins/base"
 	"github.com/hashicorp/nomad/plugins/drivers"
 	"github.com/hashicorp/nomad/plugins/shared/hclspec"
@@ -92,6 +93,18 @@ var (
 		"guest_agent":       hclspec.NewAttr("guest_agent", "bool", false),
 		"args":              hclspec.NewAttr("args", "list(string)", false),
 		"port_map":          hclspec.NewAttr("port_map", "list(map(number))", false),
+		"cloud_init": hclspec.NewBlock("cloud_init", false, hclspec.NewObject(map[string]*hclspec.Spec{
+			"user_data":      hclspec.NewAttr("user_data", "string", false),
+			"meta_data":      hclspec.NewAttr("meta_data", "string", false),
+			"network_config": hclspec.NewAttr("network_config", "string", false),
+		})),
+		"shared_dirs": hclspec.NewBlock("shared_dirs", false, hclspec.NewObject(map[string]*hclspec.Spec{
+			"mode": hclspec.NewDefault(
+				hclspec.NewAttr("mode", "string", false),
+				hclspec.NewLiteral(`"virtiofs"`),
+			),
+			"dirs": hclspec.NewAttr("dirs", "list(string)", true),
+		})),
 	})
 
 	// capabilities is returned by the Capabilities RPC and indicates what
@@ -119,6 +132,8 @@ type TaskConfig struct {
 	GracefulShutdown bool               `codec:"graceful_shutdown"`
 	DriveInterface   string             `codec:"drive_interface"` // Use interface for image
 	GuestAgent       bool               `codec:"guest_agent"`
+	CloudInit        *CloudInitConfig   `codec:"cloud_init"`  // NoCloud seed attached as a CD-ROM
+	SharedDirs       *SharedDirsConfig  `codec:"shared_dirs"` // task directories shared with the guest
 }
 
 // TaskState is the state which is encoded in the handle returned in StartTask.
@@ -129,6 +144,9 @@ type TaskState struct {
 	TaskConfig     *drivers.TaskConfig
 	Pid            int
 	StartedAt      time.Time
+
+	// VirtiofsdPids are the pids of the virtiofsd daemons of the task
+	VirtiofsdPids []int
 }
 
 // Config is the driver configuration set by SetConfig RPC call
@@ -282,8 +300,14 @@ func (d *Driver) RecoverTask(handle *drivers.TaskHandle) error {
 		return fmt.Errorf("failed to decode taskConfig state from handle: %v", err)
 	}
 
+	// The virtiofsd daemons of the task are killed if it can't be recovered,
+	// as the task is restarted with new ones
+	taskDir := filepath.Join(handle.Config.AllocDir, handle.Config.Name)
+	virtiofsd := recoverVirtiofsd(taskDir, taskState.VirtiofsdPids)
+
 	plugRC, err := pstructs.ReattachConfigToGoPlugin(taskState.ReattachConfig)
 	if err != nil {
+		killProcesses(virtiofsd)
 		d.logger.Error("failed to build ReattachConfig from taskConfig state", "error", err, "task_id", handle.Config.ID)
 		return fmt.Errorf("failed to build ReattachConfig from taskConfig state: %v", err)
 	}
@@ -291,12 +315,12 @@ func (d *Driver) RecoverTask(handle *drivers.TaskHandle) error {
 	execImpl, pluginClient, err := executor.ReattachToExecutor(plugRC,
 		d.logger.With("task_name", handle.Config.Name, "alloc_id", handle.Config.AllocID))
 	if err != nil {
+		killProcesses(virtiofsd)
 		d.logger.Error("failed to reattach to executor", "error", err, "task_id", handle.Config.ID)
 		return fmt.Errorf("failed to reattach to executor: %v", err)
 	}
 
 	// Try to restore monitor socket path.
-	taskDir := filepath.Join(handle.Config.AllocDir, handle.Config.Name)
 	possiblePaths := []string{
 		filepath.Join(taskDir, qemuMonitorSocketName),
 		// Support restoring tasks that used the old socket name.
@@ -321,6 +345,7 @@ func (d *Driver) RecoverTask(handle *drivers.TaskHandle) error {
 		procState:    drivers.TaskStateRunning,
 		startedAt:    taskState.StartedAt,
 		exitResult:   &drivers.ExitResult{},
+		virtiofsd:    virtiofsd,
 		logger:       d.logger,
 	}
 
@@ -498,6 +523,32 @@ func (d *Driver) StartTask(cfg *drivers.TaskConfig) (*drivers.TaskHandle, *drive
 		args = append(args, "-device", "virtserialport,chardev=qga0,name=org.qemu.guest_agent.0")
 	}
 
+	if driverConfig.CloudInit != nil {
+		// Write the NoCloud seed to the task directory and attach it as a
+		// CD-ROM for cloud-init to find by its label
+		seedPath := filepath.Join(taskDir, cloudInitSeedName)
+		seed := buildSeedISO(driverConfig.CloudInit.seedFiles(cfg.AllocID, cfg.Name, cfg.Env), time.Now())
+		if err := users.WriteFileFor(seedPath, seed, cfg.User); err != nil {
+			return nil, nil, fmt.Errorf("failed to write cloud-init seed: %v", err)
+		}
+		args = append(args, "-drive", "file="+seedPath+",format=raw,media=cdrom,readonly=on")
+	}
+
+	var virtiofsDirs []sharedDir
+	if driverConfig.SharedDirs != nil {
+		dirs, err := driverConfig.SharedDirs.resolve(cfg.TaskDir())
+		if err != nil {
+			return nil, nil, err
+		}
+		if driverConfig.SharedDirs.Mode == sharedDirsNinep {
+			args = append(args, ninepArgs(dirs)...)
+		} else {
+			// virtiofsd is started right before QEMU
+			virtiofsDirs = dirs
+			args = append(args, virtiofsArgs(taskDir, mem, dirs)...)
+		}
+	}
+
 	// Add pass through arguments to qemu executable. A user can specify
 	// these arguments in driver task configuration. These arguments are
 	// passed directly to the qemu driver as command line options.
@@ -578,8 +629,19 @@ func (d *Driver) StartTask(cfg *drivers.TaskConfig) (*drivers.TaskHandle, *drive
 		StderrPath:       cfg.StderrPath,
 		NetworkIsolation: cfg.NetworkIsolation,
 	}
+
+	var virtiofsd []*os.Process
+	if len(virtiofsDirs) > 0 {
+		virtiofsd, err = startVirtiofsd(d.logger, taskDir, cfg.User, virtiofsDirs)
+		if err != nil {
+			pluginClient.Kill()
+			return nil, nil, err
+		}
+	}
+
 	ps, err := execImpl.Launch(execCmd)
 	if err != nil {
+		killProcesses(virtiofsd)
 		pluginClient.Kill()
 		return nil, nil, err
 	}
@@ -593,6 +655,7 @@ func (d *Driver) StartTask(cfg *drivers.TaskConfig) (*drivers.TaskHandle, *drive
 		taskConfig:   cfg,
 		procState:    drivers.TaskStateRunning,
 		startedAt:    time.Now().Round(time.Millisecond),
+		virtiofsd:    virtiofsd,
 		logger:       d.logger,
 	}
 
@@ -601,11 +664,13 @@ func (d *Driver) StartTask(cfg *drivers.TaskConfig) (*drivers.TaskHandle, *drive
 		Pid:            ps.Pid,
 		TaskConfig:     cfg,
 		StartedAt:      h.startedAt,
+		VirtiofsdPids:  processPids(virtiofsd),
 	}
 
 	if err := handle.SetDriverState(&qemuDriverState); err != nil {
 		d.logger.Error("failed to start task, error setting driver state", "error", err)
 		execImpl.Shutdown("", 0)
+		killProcesses(virtiofsd)
 		pluginClient.Kill()
 		return nil, nil, fmt.Errorf("failed to set driver state: %v", err)
 	}
@@ -680,6 +745,9 @@ func (d *Driver) DestroyTask(taskID string, force bool) error {
 		handle.pluginClient.Kill()
 	}
 
+	// virtiofsd exits once QEMU disconnects, so this is only a precaution
+	killProcesses(handle.virtiofsd)
+
 	d.tasks.Delete(taskID)
 	return nil
 }
@@ -710,6 +778,18 @@ func (d *Driver) SignalTask(taskID string, signal string) error {
 	return fmt.Errorf("Qemu driver can't signal commands")
 }
 
+func (d *Driver) UpdateTaskResources(taskID string, resources *drivers.Resources) error {
+	return fmt.Errorf("Qemu driver can't update task resources")
+}
+
+func (d *Driver) PauseTask(taskID string) error {
+	return fmt.Errorf("Qemu driver can't pause tasks")
+}
+
+func (d *Driver) ResumeTask(taskID string) error {
+	return fmt.Errorf("Qemu driver can't resume tasks")
+}
+
 func (d *Driver) ExecTask(taskID string, cmdArgs []string, timeout time.Duration) (*drivers.ExecTaskResult, error) {
 	return nil, fmt.Errorf("Qemu driver can't execute commands")
 
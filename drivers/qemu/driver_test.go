@@ -284,6 +284,12 @@ config {
     https = 443
   }
   graceful_shutdown = true
+  cloud_init {
+    user_data = "#cloud-config"
+  }
+  shared_dirs {
+    dirs = ["alloc", "local"]
+  }
 }`
 
 	expected := &TaskConfig{
@@ -296,6 +302,13 @@ config {
 			"https": 443,
 		},
 		GracefulShutdown: true,
+		CloudInit: &CloudInitConfig{
+			UserData: "#cloud-config",
+		},
+		SharedDirs: &SharedDirsConfig{
+			Mode: "virtiofs",
+			Dirs: []string{"alloc", "local"},
+		},
 	}
 
 	var tc *TaskConfig
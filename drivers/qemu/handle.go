@@ -2,6 +2,7 @@ package qemu
 
 import (
 	"context"
+	"os"
 	"strconv"
 	"sync"
 	"time"
@@ -19,6 +20,9 @@ type taskHandle struct {
 	logger       hclog.Logger
 	monitorPath  string
 
+	// virtiofsd are the virtiofsd daemons started for the task
+	virtiofsd []*os.Process
+
 	// stateLock syncs access to all fields below
 	stateLock sync.RWMutex
 
@@ -0,0 +1,239 @@
+package qemu
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"runtime"
+	"time"
+
+	hclog "github.com/hashicorp/go-hclog"
+	"github.com/hashicorp/nomad/client/allocdir"
+	"github.com/hashicorp/nomad/helper/users"
+)
+
+const (
+	// sharedDirsVirtiofs shares directories with the guest using virtiofs,
+	// which requires a virtiofsd daemon per directory.
+	sharedDirsVirtiofs = "virtiofs"
+
+	// sharedDirsNinep shares directories with the guest using 9p.
+	sharedDirsNinep = "9p"
+
+	// virtiofsdSocketTimeout is how long to wait for virtiofsd to create its
+	// socket before giving up.
+	virtiofsdSocketTimeout = 5 * time.Second
+)
+
+var (
+	// virtiofsdPaths are the locations virtiofsd is commonly installed to
+	// outside of $PATH.
+	virtiofsdPaths = []string{
+		"/usr/libexec/virtiofsd",
+		"/usr/lib/qemu/virtiofsd",
+	}
+)
+
+// SharedDirsConfig is the configuration of the task directories shared with
+// the guest.
+type SharedDirsConfig struct {
+	// Mode is either "virtiofs" or "9p"
+	Mode string `codec:"mode"`
+
+	// Dirs are the task directories to share: "alloc", "local" or "secrets".
+	// The guest mounts each of them using its name as the mount tag.
+	Dirs []string `codec:"dirs"`
+}
+
+// sharedDir is a host directory shared with the guest.
+type sharedDir struct {
+	tag  string
+	path string
+}
+
+// resolve validates the configuration and returns the host directories to
+// share.
+func (c *SharedDirsConfig) resolve(taskDir *allocdir.TaskDir) ([]sharedDir, error) {
+	switch c.Mode {
+	case sharedDirsVirtiofs, sharedDirsNinep:
+	default:
+		return nil, fmt.Errorf("shared_dirs mode must be %q or %q", sharedDirsVirtiofs, sharedDirsNinep)
+	}
+	if runtime.GOOS != "linux" {
+		return nil, errors.New("QEMU shared_dirs are only supported on Linux")
+	}
+
+	seen := make(map[string]struct{}, len(c.Dirs))
+	dirs := make([]sharedDir, 0, len(c.Dirs))
+	for _, tag := range c.Dirs {
+		if _, ok := seen[tag]; ok {
+			return nil, fmt.Errorf("shared_dirs contains %q more than once", tag)
+		}
+		seen[tag] = struct{}{}
+
+		var path string
+		switch tag {
+		case "alloc":
+			path = taskDir.SharedAllocDir
+		case "local":
+			path = taskDir.LocalDir
+		case "secrets":
+			path = taskDir.SecretsDir
+		default:
+			return nil, fmt.Errorf("shared_dirs may only contain %q, %q or %q, not %q",
+				"alloc", "local", "secrets", tag)
+		}
+		dirs = append(dirs, sharedDir{tag: tag, path: path})
+	}
+	return dirs, nil
+}
+
+// ninepArgs returns the QEMU arguments sharing dirs over 9p.
+func ninepArgs(dirs []sharedDir) []string {
+	var args []string
+	for _, dir := range dirs {
+		args = append(args, "-virtfs",
+			fmt.Sprintf("local,path=%s,mount_tag=%s,security_model=none,id=fs-%s", dir.path, dir.tag, dir.tag))
+	}
+	return args
+}
+
+// virtiofsSocketPath returns the path of the virtiofsd socket for dir.
+func virtiofsSocketPath(taskDir string, dir sharedDir) string {
+	return filepath.Join(taskDir, "vfs-"+dir.tag+".sock")
+}
+
+// virtiofsArgs returns the QEMU arguments sharing dirs over virtiofs. The
+// guest memory must be shared with virtiofsd, so it is backed by a memfd of
+// the same size as the guest memory.
+func virtiofsArgs(taskDir, mem string, dirs []sharedDir) []string {
+	args := []string{
+		"-object", fmt.Sprintf("memory-backend-memfd,id=mem,size=%s,share=on", mem),
+		"-numa", "node,memdev=mem",
+	}
+	for _, dir := range dirs {
+		args = append(args,
+			"-chardev", fmt.Sprintf("socket,id=char-%s,path=%s", dir.tag, virtiofsSocketPath(taskDir, dir)),
+			"-device", fmt.Sprintf("vhost-user-fs-pci,chardev=char-%s,tag=%s", dir.tag, dir.tag),
+		)
+	}
+	return args
+}
+
+// startVirtiofsd starts a virtiofsd daemon for each of dirs and waits for
+// their sockets to be created. virtiofsd exits once QEMU disconnects from it,
+// but the returned processes should be killed if QEMU fails to start.
+func startVirtiofsd(logger hclog.Logger, taskDir, user string, dirs []sharedDir) ([]*os.Process, error) {
+	bin, err := findVirtiofsd()
+	if err != nil {
+		return nil, err
+	}
+
+	var procs []*os.Process
+	for _, dir := range dirs {
+		sock := virtiofsSocketPath(taskDir, dir)
+		if err := validateSocketPath(sock); err != nil {
+			killProcesses(procs)
+			return nil, err
+		}
+		_ = os.Remove(sock)
+
+		cmd := exec.Command(bin, "--socket-path="+sock, "--shared-dir="+dir.path)
+		if err := cmd.Start(); err != nil {
+			killProcesses(procs)
+			return nil, fmt.Errorf("failed to start virtiofsd for %q: %v", dir.tag, err)
+		}
+		procs = append(procs, cmd.Process)
+
+		// Reap the daemon once it exits
+		go func(tag string) {
+			err := cmd.Wait()
+			logger.Debug("virtiofsd exited", "dir", tag, "error", err)
+		}(dir.tag)
+
+		if err := waitForSocket(sock, virtiofsdSocketTimeout); err != nil {
+			killProcesses(procs)
+			return nil, fmt.Errorf("virtiofsd for %q did not start: %v", dir.tag, err)
+		}
+
+		// QEMU runs as the task user, which must be able to connect
+		if user != "" {
+			uid, err := users.UIDforUser(user)
+			if err == nil {
+				err = os.Chown(sock, uid, -1)
+			}
+			if err != nil {
+				killProcesses(procs)
+				return nil, fmt.Errorf("failed to set owner of virtiofsd socket: %v", err)
+			}
+		}
+	}
+
+	return procs, nil
+}
+
+// recoverVirtiofsd returns the virtiofsd daemons of the task with the given
+// pids, which were started before the driver was restarted. Pids which no
+// longer run virtiofsd for the task, such as pids reused by other processes,
+// are ignored.
+func recoverVirtiofsd(taskDir string, pids []int) []*os.Process {
+	var procs []*os.Process
+	for _, pid := range pids {
+		cmdline, err := os.ReadFile(fmt.Sprintf("/proc/%d/cmdline", pid))
+		if err != nil {
+			continue
+		}
+		if !bytes.Contains(cmdline, []byte("--socket-path="+filepath.Join(taskDir, "vfs-"))) {
+			continue
+		}
+		proc, err := os.FindProcess(pid)
+		if err != nil {
+			continue
+		}
+		procs = append(procs, proc)
+	}
+	return procs
+}
+
+// processPids returns the pids of procs.
+func processPids(procs []*os.Process) []int {
+	pids := make([]int, 0, len(procs))
+	for _, p := range procs {
+		pids = append(pids, p.Pid)
+	}
+	return pids
+}
+
+func findVirtiofsd() (string, error) {
+	if path, err := GetAbsolutePath("virtiofsd"); err == nil {
+		return path, nil
+	}
+	for _, path := range virtiofsdPaths {
+		if _, err := os.Stat(path); err == nil {
+			return path, nil
+		}
+	}
+	return "", errors.New("failed to find virtiofsd executable")
+}
+
+func waitForSocket(path string, timeout time.Duration) error {
+	deadline := time.Now().Add(timeout)
+	for {
+		if _, err := os.Stat(path); err == nil {
+			return nil
+		}
+		if time.Now().After(deadline) {
+			return fmt.Errorf("timed out waiting for socket %s", path)
+		}
+		time.Sleep(50 * time.Millisecond)
+	}
+}
+
+func killProcesses(procs []*os.Process) {
+	for _, p := range procs {
+		_ = p.Kill()
+	}
+}
@@ -0,0 +1,88 @@
+package qemu
+
+import (
+	"os"
+	"os/exec"
+	"path/filepath"
+	"runtime"
+	"testing"
+
+	"github.com/hashicorp/nomad/ci"
+	"github.com/hashicorp/nomad/client/allocdir"
+	"github.com/shoenig/test/must"
+)
+
+func TestSharedDirs_Args(t *testing.T) {
+	ci.Parallel(t)
+	if runtime.GOOS != "linux" {
+		t.Skip("shared_dirs are only supported on Linux")
+	}
+
+	taskDir := &allocdir.TaskDir{
+		Dir:            "/alloc/web",
+		SharedAllocDir: "/alloc/alloc",
+		LocalDir:       "/alloc/web/local",
+		SecretsDir:     "/alloc/web/secrets",
+	}
+
+	config := &SharedDirsConfig{Mode: sharedDirsNinep, Dirs: []string{"alloc", "secrets"}}
+	dirs, err := config.resolve(taskDir)
+	must.NoError(t, err)
+	must.Eq(t, []string{
+		"-virtfs", "local,path=/alloc/alloc,mount_tag=alloc,security_model=none,id=fs-alloc",
+		"-virtfs", "local,path=/alloc/web/secrets,mount_tag=secrets,security_model=none,id=fs-secrets",
+	}, ninepArgs(dirs))
+
+	config = &SharedDirsConfig{Mode: sharedDirsVirtiofs, Dirs: []string{"local"}}
+	dirs, err = config.resolve(taskDir)
+	must.NoError(t, err)
+	must.Eq(t, []string{
+		"-object", "memory-backend-memfd,id=mem,size=512M,share=on",
+		"-numa", "node,memdev=mem",
+		"-chardev", "socket,id=char-local,path=/alloc/web/vfs-local.sock",
+		"-device", "vhost-user-fs-pci,chardev=char-local,tag=local",
+	}, virtiofsArgs(taskDir.Dir, "512M", dirs))
+}
+
+func TestSharedDirs_Invalid(t *testing.T) {
+	ci.Parallel(t)
+	if runtime.GOOS != "linux" {
+		t.Skip("shared_dirs are only supported on Linux")
+	}
+
+	taskDir := &allocdir.TaskDir{}
+
+	_, err := (&SharedDirsConfig{Mode: "nfs", Dirs: []string{"local"}}).resolve(taskDir)
+	must.ErrorContains(t, err, "mode must be")
+
+	_, err = (&SharedDirsConfig{Mode: sharedDirsNinep, Dirs: []string{"tmp"}}).resolve(taskDir)
+	must.ErrorContains(t, err, `not "tmp"`)
+
+	_, err = (&SharedDirsConfig{Mode: sharedDirsNinep, Dirs: []string{"local", "local"}}).resolve(taskDir)
+	must.ErrorContains(t, err, "more than once")
+}
+
+func TestSharedDirs_RecoverVirtiofsd(t *testing.T) {
+	ci.Parallel(t)
+	if runtime.GOOS != "linux" {
+		t.Skip("shared_dirs are only supported on Linux")
+	}
+
+	taskDir := t.TempDir()
+	start := func(socketPath string) *os.Process {
+		cmd := exec.Command("/bin/sh", "-c", "sleep 30", "virtiofsd", "--socket-path="+socketPath)
+		must.NoError(t, cmd.Start())
+		t.Cleanup(func() {
+			_ = cmd.Process.Kill()
+			_ = cmd.Wait()
+		})
+		return cmd.Process
+	}
+
+	daemon := start(filepath.Join(taskDir, "vfs-local.sock"))
+	other := start(filepath.Join(t.TempDir(), "vfs-local.sock"))
+
+	// Only the daemons of the task are recovered
+	procs := recoverVirtiofsd(taskDir, []int{daemon.Pid, other.Pid, 0})
+	must.Eq(t, []int{daemon.Pid}, processPids(procs))
+}
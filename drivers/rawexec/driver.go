@@ -19,6 +19,7 @@ import (
 	"github.com/hashicorp/nomad/plugins/drivers"
 	"github.com/hashicorp/nomad/plugins/shared/hclspec"
 	pstructs "github.com/hashicorp/nomad/plugins/shared/structs"
+	"github.com/shoenig/go-landlock"
 )
 
 const (
@@ -82,6 +83,10 @@ var (
 			hclspec.NewAttr("no_cgroups", "bool", false),
 			hclspec.NewLiteral("false"),
 		),
+		"unveil_mandatory": hclspec.NewDefault(
+			hclspec.NewAttr("unveil_mandatory", "bool", false),
+			hclspec.NewLiteral("false"),
+		),
 	})
 
 	// taskConfigSpec is the hcl specification for the driver config section of
@@ -89,6 +94,7 @@ var (
 	taskConfigSpec = hclspec.NewObject(map[string]*hclspec.Spec{
 		"command": hclspec.NewAttr("command", "string", true),
 		"args":    hclspec.NewAttr("args", "list(string)", false),
+		"unveil":  hclspec.NewAttr("unveil", "list(string)", false),
 	})
 
 	// capabilities is returned by the Capabilities RPC and indicates what
@@ -138,12 +144,29 @@ type Config struct {
 
 	// Enabled is set to true to enable the raw_exec driver
 	Enabled bool `codec:"enabled"`
+
+	// UnveilMandatory is set to true to sandbox all tasks with Landlock,
+	// including the ones without unveil paths
+	UnveilMandatory bool `codec:"unveil_mandatory"`
 }
 
 // TaskConfig is the driver configuration of a task within a job
 type TaskConfig struct {
 	Command string   `codec:"command"`
 	Args    []string `codec:"args"`
+
+	// Unveil are the host paths the task may access in addition to its task
+	// and shared alloc directories, in the "mode:path" form. The task has
+	// access to the whole host filesystem if it is empty and the sandbox is
+	// not mandatory.
+	Unveil []string `codec:"unveil"`
+}
+
+func (tc *TaskConfig) validate() error {
+	if err := executor.ValidateUnveil(tc.Unveil); err != nil {
+		return fmt.Errorf("invalid unveil: %v", err)
+	}
+	return nil
 }
 
 // TaskState is the state which is encoded in the handle returned in
@@ -307,6 +330,16 @@ func (d *Driver) StartTask(cfg *drivers.TaskConfig) (*drivers.TaskHandle, *drive
 	if err := cfg.DecodeDriverConfig(&driverConfig); err != nil {
 		return nil, nil, fmt.Errorf("failed to decode driver config: %v", err)
 	}
+	if err := driverConfig.validate(); err != nil {
+		return nil, nil, fmt.Errorf("failed driver config validation: %v", err)
+	}
+
+	// Sandbox the task when it unveils paths, or when the operator requires
+	// all tasks to be sandboxed
+	useLandlock := d.config.UnveilMandatory || len(driverConfig.Unveil) > 0
+	if useLandlock && !landlock.Available() {
+		return nil, nil, fmt.Errorf("unveil requires landlock, which is not available on this client")
+	}
 
 	d.logger.Info("starting task", "driver_cfg", hclog.Fmt("%+v", driverConfig))
 	handle := drivers.NewTaskHandle(taskHandleVersion)
@@ -338,6 +371,8 @@ func (d *Driver) StartTask(cfg *drivers.TaskConfig) (*drivers.TaskHandle, *drive
 		StdoutPath:         cfg.StdoutPath,
 		StderrPath:         cfg.StderrPath,
 		NetworkIsolation:   cfg.NetworkIsolation,
+		Landlock:           useLandlock,
+		Unveil:             driverConfig.Unveil,
 	}
 
 	ps, err := exec.Launch(execCmd)
@@ -494,6 +529,20 @@ func (d *Driver) SignalTask(taskID string, signal string) error {
 	return handle.exec.Signal(sig)
 }
 
+// UpdateTaskResources is not supported, as raw_exec tasks are not run in
+// cgroups whose limits the executor can change.
+func (d *Driver) UpdateTaskResources(taskID string, resources *drivers.Resources) error {
+	return fmt.Errorf("Raw exec driver can't update task resources")
+}
+
+func (d *Driver) PauseTask(taskID string) error {
+	return fmt.Errorf("Raw exec driver can't pause tasks")
+}
+
+func (d *Driver) ResumeTask(taskID string) error {
+	return fmt.Errorf("Raw exec driver can't resume tasks")
+}
+
 func (d *Driver) ExecTask(taskID string, cmd []string, timeout time.Duration) (*drivers.ExecTaskResult, error) {
 	if len(cmd) == 0 {
 		return nil, fmt.Errorf("error cmd must have at least one value")
@@ -97,6 +97,13 @@ func TestRawExecDriver_SetConfig(t *testing.T) {
 	bconfig.PluginConfig = data
 	require.NoError(harness.SetConfig(bconfig))
 	require.Exactly(config, d.(*Driver).config)
+	// Enable raw_exec, require the landlock sandbox.
+	config.UnveilMandatory = true
+	data = []byte{}
+	require.NoError(basePlug.MsgPackEncode(&data, config))
+	bconfig.PluginConfig = data
+	require.NoError(harness.SetConfig(bconfig))
+	require.Exactly(config, d.(*Driver).config)
 }
 
 func TestRawExecDriver_Fingerprint(t *testing.T) {
@@ -553,11 +560,13 @@ func TestConfig_ParseAllHCL(t *testing.T) {
 config {
   command = "/bin/bash"
   args = ["-c", "echo hello"]
+  unveil = ["rx:/usr/bin", "rwc:/srv/data"]
 }`
 
 	expected := &TaskConfig{
 		Command: "/bin/bash",
 		Args:    []string{"-c", "echo hello"},
+		Unveil:  []string{"rx:/usr/bin", "rwc:/srv/data"},
 	}
 
 	var tc *TaskConfig
@@ -587,3 +596,14 @@ func TestRawExecDriver_Disabled(t *testing.T) {
 	require.Contains(err.Error(), errDisabledDriver.Error())
 	require.Nil(handle)
 }
+
+func TestTaskConfig_validate(t *testing.T) {
+	ci.Parallel(t)
+
+	require.NoError(t, (&TaskConfig{}).validate())
+	require.NoError(t, (&TaskConfig{Unveil: []string{"r:/etc/ssl", "rwc:/srv/data"}}).validate())
+
+	err := (&TaskConfig{Unveil: []string{"r:/etc/ssl", "rw:srv/data"}}).validate()
+	require.ErrorContains(t, err, "invalid unveil")
+	require.ErrorContains(t, err, "must be absolute")
+}
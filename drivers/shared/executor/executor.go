@@ -71,6 +71,13 @@ type Executor interface {
 	// constraints if supported.
 	UpdateResources(*drivers.Resources) error
 
+	// Pause freezes the user process and its children, keeping their memory
+	// and state until Resume thaws them.
+	Pause() error
+
+	// Resume thaws the processes frozen by Pause.
+	Resume() error
+
 	// Version returns the executor API version
 	Version() (*ExecutorVersion, error)
 
@@ -153,6 +160,29 @@ type ExecCommand struct {
 
 	// Capabilities are the linux capabilities to be enabled by the task driver.
 	Capabilities []string
+
+	// SeccompProfile is the seccomp profile to apply to the task: "default",
+	// "unconfined" or the path to a profile file.
+	SeccompProfile string
+
+	// UserNamespace is the range of host IDs the user and group IDs of the
+	// task are mapped to. The task runs in the user namespace of the host if
+	// it is nil.
+	UserNamespace *drivers.IDMapping
+
+	// RootfsLayers are the directories of the image layers the root
+	// filesystem of the task is made of, from the lowest layer to the top
+	// one. The task runs in the chroot of its task directory if it is empty.
+	RootfsLayers []string
+
+	// Landlock restricts the filesystem access of the task to its task and
+	// shared alloc directories, and to the Unveil paths.
+	Landlock bool
+
+	// Unveil are the additional paths the task may access when Landlock is
+	// set, in the "mode:path" form where mode is made of the r, w, c and x
+	// permissions.
+	Unveil []string
 }
 
 // SetWriters sets the writer for the process stdout and stderr. This should
@@ -331,11 +361,15 @@ func (e *UniversalExecutor) Launch(command *ExecCommand) (*ProcessState, error)
 		return nil, err
 	}
 
-	path := absPath
+	// Run the command through the Landlock sandbox if required
+	path, args, err := landlockCommand(command, absPath, command.Args)
+	if err != nil {
+		return nil, err
+	}
 
 	// Set the commands arguments
 	e.childCmd.Path = path
-	e.childCmd.Args = append([]string{e.childCmd.Path}, command.Args...)
+	e.childCmd.Args = append([]string{e.childCmd.Path}, args...)
 	e.childCmd.Env = e.commandCfg.Env
 
 	// Start the process
@@ -352,6 +386,11 @@ func (e *UniversalExecutor) Launch(command *ExecCommand) (*ProcessState, error)
 func (e *UniversalExecutor) Exec(deadline time.Time, name string, args []string) ([]byte, int, error) {
 	ctx, cancel := context.WithDeadline(context.Background(), deadline)
 	defer cancel()
+
+	name, args, err := landlockCommand(e.commandCfg, name, args)
+	if err != nil {
+		return nil, 0, err
+	}
 	return ExecScript(ctx, e.childCmd.Dir, e.commandCfg.Env, e.childCmd.SysProcAttr, e.commandCfg.NetworkIsolation, name, args)
 }
 
@@ -400,7 +439,11 @@ func (e *UniversalExecutor) ExecStreaming(ctx context.Context, command []string,
 		return fmt.Errorf("command is required")
 	}
 
-	cmd := exec.CommandContext(ctx, command[0], command[1:]...)
+	name, args, err := landlockCommand(e.commandCfg, command[0], command[1:])
+	if err != nil {
+		return err
+	}
+	cmd := exec.CommandContext(ctx, name, args...)
 
 	cmd.Dir = "/"
 	cmd.Env = e.childCmd.Env
@@ -462,6 +505,17 @@ func (e *UniversalExecutor) UpdateResources(resources *drivers.Resources) error
 	return nil
 }
 
+// Pause is not supported by the universal executor, as the executor process
+// may share the cgroup of the user process.
+func (e *UniversalExecutor) Pause() error {
+	return fmt.Errorf("pausing tasks is not supported by this executor")
+}
+
+// Resume is not supported by the universal executor.
+func (e *UniversalExecutor) Resume() error {
+	return fmt.Errorf("resuming tasks is not supported by this executor")
+}
+
 func (e *UniversalExecutor) wait() {
 	defer close(e.processExited)
 	defer e.commandCfg.Close()
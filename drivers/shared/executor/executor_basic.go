@@ -31,3 +31,7 @@ func withNetworkIsolation(f func() error, _ *drivers.NetworkIsolationSpec) error
 }
 
 func setCmdUser(*exec.Cmd, string) error { return nil }
+
+// UserNamespacesSupported returns false because user namespaces are only
+// supported on Linux.
+func UserNamespacesSupported() bool { return false }
@@ -11,11 +11,13 @@ import (
 	"os/exec"
 	"path"
 	"path/filepath"
+	"strconv"
 	"strings"
 	"syscall"
 	"time"
 
 	"github.com/armon/circbuf"
+	securejoin "github.com/cyphar/filepath-securejoin"
 	"github.com/hashicorp/consul-template/signals"
 	hclog "github.com/hashicorp/go-hclog"
 	"github.com/hashicorp/nomad/client/allocdir"
@@ -24,6 +26,7 @@ import (
 	"github.com/hashicorp/nomad/client/stats"
 	cstructs "github.com/hashicorp/nomad/client/structs"
 	"github.com/hashicorp/nomad/drivers/shared/capabilities"
+	"github.com/hashicorp/nomad/drivers/shared/seccomp"
 	shelpers "github.com/hashicorp/nomad/helper/stats"
 	"github.com/hashicorp/nomad/helper/uuid"
 	"github.com/hashicorp/nomad/nomad/structs"
@@ -48,8 +51,15 @@ var (
 
 	// ExecutorCgroupMeasuredCpuStats is the list of CPU stats captures by the executor
 	ExecutorCgroupMeasuredCpuStats = []string{"System Mode", "User Mode", "Throttled Periods", "Throttled Time", "Percent"}
+
+	// ExecutorCgroupMeasuredIOStats is the list of block IO stats captured by the executor
+	ExecutorCgroupMeasuredIOStats = []string{"Read Bytes", "Write Bytes", "Read Ops", "Write Ops"}
 )
 
+// imageRootfsDir is the directory of the task directory in which the
+// writable layer of the root filesystem of tasks run from images is kept.
+const imageRootfsDir = "rootfs"
+
 // LibcontainerExecutor implements an Executor with the runc/libcontainer api
 type LibcontainerExecutor struct {
 	id      string
@@ -124,8 +134,10 @@ func (l *LibcontainerExecutor) Launch(command *ExecCommand) (*ProcessState, erro
 	if err != nil {
 		return nil, err
 	}
-	if err := makeExecutable(hostPath); err != nil {
-		return nil, err
+	if hostPath != "" {
+		if err := makeExecutable(hostPath); err != nil {
+			return nil, err
+		}
 	}
 
 	combined := append([]string{taskPath}, command.Args...)
@@ -260,6 +272,14 @@ func (l *LibcontainerExecutor) Shutdown(signal string, grace time.Duration) erro
 		return nil
 	}
 
+	// Frozen processes cannot handle signals, so thaw a paused container
+	// before stopping it
+	if status == libcontainer.Paused {
+		if err := l.container.Resume(); err != nil {
+			return fmt.Errorf("failed to resume paused container: %v", err)
+		}
+	}
+
 	if grace > 0 {
 		if signal == "" {
 			signal = "SIGINT"
@@ -304,6 +324,53 @@ func (l *LibcontainerExecutor) Shutdown(signal string, grace time.Duration) erro
 
 // UpdateResources updates the resource isolation with new values to be enforced
 func (l *LibcontainerExecutor) UpdateResources(resources *drivers.Resources) error {
+	if resources == nil || resources.NomadResources == nil {
+		return nil
+	}
+
+	// Only the limits of tasks launched with resource limits can be changed
+	if l.container == nil || !l.command.ResourceLimits {
+		return fmt.Errorf("task was not launched with resource limits")
+	}
+
+	// The cgroup of the container config is copied, as it is shared with
+	// the config of the container
+	config := l.container.Config()
+	cgroup := *config.Cgroups
+	cgroupResources := *cgroup.Resources
+	if err := configureCgroupResources(&cgroupResources, resources.NomadResources); err != nil {
+		return err
+	}
+	cgroup.Resources = &cgroupResources
+	config.Cgroups = &cgroup
+
+	if err := l.container.Set(config); err != nil {
+		return fmt.Errorf("failed to update resource limits: %v", err)
+	}
+	return nil
+}
+
+// Pause freezes the processes of the container with the freezer cgroup
+func (l *LibcontainerExecutor) Pause() error {
+	if l.container == nil {
+		return fmt.Errorf("container has not started")
+	}
+
+	if err := l.container.Pause(); err != nil {
+		return fmt.Errorf("failed to pause container: %v", err)
+	}
+	return nil
+}
+
+// Resume thaws the processes of a container frozen by Pause
+func (l *LibcontainerExecutor) Resume() error {
+	if l.container == nil {
+		return fmt.Errorf("container has not started")
+	}
+
+	if err := l.container.Resume(); err != nil {
+		return fmt.Errorf("failed to resume container: %v", err)
+	}
 	return nil
 }
 
@@ -386,10 +453,19 @@ func (l *LibcontainerExecutor) handleStats(ch chan *cstructs.TaskResourceUsage,
 			TotalTicks:       l.systemCpuStats.TicksConsumed(totalPercent),
 			Measured:         ExecutorCgroupMeasuredCpuStats,
 		}
+
+		// Block IO Related Stats
+		is := &cstructs.IOStats{
+			Measured: ExecutorCgroupMeasuredIOStats,
+		}
+		is.ReadBytes, is.WriteBytes = sumBlkioEntries(stats.BlkioStats.IoServiceBytesRecursive)
+		is.ReadOps, is.WriteOps = sumBlkioEntries(stats.BlkioStats.IoServicedRecursive)
+
 		taskResUsage := cstructs.TaskResourceUsage{
 			ResourceUsage: &cstructs.ResourceUsage{
 				MemoryStats: ms,
 				CpuStats:    cs,
+				IOStats:     is,
 			},
 			Timestamp: ts.UTC().UnixNano(),
 			Pids:      pidStats,
@@ -404,6 +480,20 @@ func (l *LibcontainerExecutor) handleStats(ch chan *cstructs.TaskResourceUsage,
 	}
 }
 
+// sumBlkioEntries totals the read and write values of the given blkio
+// entries across all block devices.
+func sumBlkioEntries(entries []cgroups.BlkioStatEntry) (read, write uint64) {
+	for _, entry := range entries {
+		switch strings.ToLower(entry.Op) {
+		case "read":
+			read += entry.Value
+		case "write":
+			write += entry.Value
+		}
+	}
+	return
+}
+
 // Signal sends a signal to the process managed by the executor
 func (l *LibcontainerExecutor) Signal(s os.Signal) error {
 	return l.userProc.Signal(s)
@@ -541,6 +631,24 @@ func configureCapabilities(cfg *lconfigs.Config, command *ExecCommand) {
 	}
 }
 
+// configureSeccomp applies the seccomp profile of the task, which may depend on
+// the capabilities it was granted.
+func configureSeccomp(cfg *lconfigs.Config, command *ExecCommand) error {
+	profile, err := seccomp.Load(command.SeccompProfile, cfg.Capabilities.Bounding)
+	if err != nil {
+		return err
+	}
+	if profile == nil {
+		return nil
+	}
+
+	cfg.Seccomp, err = specconv.SetupSeccomp(profile)
+	if err != nil {
+		return fmt.Errorf("failed to configure seccomp profile: %v", err)
+	}
+	return nil
+}
+
 func configureNamespaces(pidMode, ipcMode string) lconfigs.Namespaces {
 	namespaces := lconfigs.Namespaces{{Type: lconfigs.NEWNS}}
 	if pidMode == IsolationModePrivate {
@@ -640,13 +748,134 @@ func configureIsolation(cfg *lconfigs.Config, command *ExecCommand) error {
 		},
 	}
 
+	if len(command.RootfsLayers) > 0 {
+		if err := configureImageRootfs(cfg, command); err != nil {
+			return err
+		}
+	}
+
 	if len(command.Mounts) > 0 {
 		cfg.Mounts = append(cfg.Mounts, cmdMounts(command.Mounts)...)
 	}
 
+	if command.UserNamespace != nil {
+		configureUserNamespace(cfg, command)
+	}
+
 	return nil
 }
 
+// configureImageRootfs makes the layers of the image of the task its root
+// filesystem, keeping the writable layer in the task directory, and binds the
+// directories of the task directory into it.
+func configureImageRootfs(cfg *lconfigs.Config, command *ExecCommand) error {
+	rootfsDir := filepath.Join(command.TaskDir, imageRootfsDir, "merged")
+	upperDir := filepath.Join(command.TaskDir, imageRootfsDir, "upper")
+	workDir := filepath.Join(command.TaskDir, imageRootfsDir, "work")
+	for _, dir := range []string{rootfsDir, upperDir, workDir} {
+		if err := os.MkdirAll(dir, 0755); err != nil {
+			return fmt.Errorf("failed to create image rootfs directory: %v", err)
+		}
+	}
+
+	// overlayfs lists the lower directories from the top one down
+	lowerDirs := make([]string, 0, len(command.RootfsLayers))
+	for i := len(command.RootfsLayers) - 1; i >= 0; i-- {
+		lowerDirs = append(lowerDirs, command.RootfsLayers[i])
+	}
+	data := fmt.Sprintf("lowerdir=%s,upperdir=%s,workdir=%s", strings.Join(lowerDirs, ":"), upperDir, workDir)
+	if len(data) >= os.Getpagesize() {
+		return fmt.Errorf("image has too many layers to be mounted: %d", len(lowerDirs))
+	}
+
+	// The image is mounted in the mount namespace of the container only,
+	// before anything else is mounted into it so that it hides nothing
+	cfg.Rootfs = rootfsDir
+	rootfs := &lconfigs.Mount{
+		Source:      "overlay",
+		Destination: "/",
+		Device:      "overlay",
+		Data:        data,
+	}
+	cfg.Mounts = append([]*lconfigs.Mount{rootfs}, cfg.Mounts...)
+
+	for _, dir := range []string{allocdir.SharedAllocName, allocdir.TaskLocal, allocdir.TaskSecrets, allocdir.TmpDirName} {
+		cfg.Mounts = append(cfg.Mounts, &lconfigs.Mount{
+			Source:      filepath.Join(command.TaskDir, dir),
+			Destination: "/" + dir,
+			Device:      "bind",
+			Flags:       unix.MS_BIND | unix.MS_REC,
+		})
+	}
+	return nil
+}
+
+// UserNamespacesSupported returns true if the kernel supports the user
+// namespaces tasks with remapped users run in.
+func UserNamespacesSupported() bool {
+	if _, err := os.Stat("/proc/self/ns/user"); err != nil {
+		return false
+	}
+	return userNamespacesEnabled("/proc/sys")
+}
+
+// userNamespacesEnabled returns true if the sysctls under procSys allow
+// creating user namespaces. Besides user.max_user_namespaces, some
+// distributions restrict user namespaces with kernel.unprivileged_userns_clone
+// (Debian) or kernel.apparmor_restrict_unprivileged_userns (Ubuntu).
+func userNamespacesEnabled(procSys string) bool {
+	readSysctl := func(name string) (int, bool) {
+		b, err := os.ReadFile(filepath.Join(procSys, name))
+		if err != nil {
+			return 0, false
+		}
+		v, err := strconv.Atoi(strings.TrimSpace(string(b)))
+		return v, err == nil
+	}
+
+	if max, ok := readSysctl("user/max_user_namespaces"); !ok || max <= 0 {
+		return false
+	}
+	if clone, ok := readSysctl("kernel/unprivileged_userns_clone"); ok && clone == 0 {
+		return false
+	}
+	if restrict, ok := readSysctl("kernel/apparmor_restrict_unprivileged_userns"); ok && restrict != 0 {
+		return false
+	}
+	return true
+}
+
+// configureUserNamespace runs the task in a new user namespace, in which its
+// user and group IDs are mapped to the range of host IDs of the task.
+func configureUserNamespace(cfg *lconfigs.Config, command *ExecCommand) {
+	cfg.Namespaces = append(cfg.Namespaces, lconfigs.Namespace{Type: lconfigs.NEWUSER})
+	cfg.UidMappings = []lconfigs.IDMap{{
+		ContainerID: 0,
+		HostID:      int(command.UserNamespace.UIDStart),
+		Size:        int(command.UserNamespace.Size),
+	}}
+	cfg.GidMappings = []lconfigs.IDMap{{
+		ContainerID: 0,
+		HostID:      int(command.UserNamespace.GIDStart),
+		Size:        int(command.UserNamespace.Size),
+	}}
+
+	// A user namespace may only mount sysfs and mqueue if it also owns the
+	// network and IPC namespaces, so bind mount them from the host otherwise
+	for _, m := range cfg.Mounts {
+		switch {
+		case m.Device == "sysfs" && !cfg.Namespaces.Contains(lconfigs.NEWNET):
+			m.Source = "/sys"
+			m.Device = "bind"
+			m.Flags |= syscall.MS_BIND | syscall.MS_REC
+		case m.Device == "mqueue" && !cfg.Namespaces.Contains(lconfigs.NEWIPC):
+			m.Source = "/dev/mqueue"
+			m.Device = "bind"
+			m.Flags |= syscall.MS_BIND
+		}
+	}
+}
+
 func configureCgroups(cfg *lconfigs.Config, command *ExecCommand) error {
 	// If resources are not limited then manually create cgroups needed
 	if !command.ResourceLimits {
@@ -674,8 +903,25 @@ func configureCgroups(cfg *lconfigs.Config, command *ExecCommand) error {
 		return nil
 	}
 
+	if err := configureCgroupResources(cfg.Cgroups.Resources, command.Resources.NomadResources); err != nil {
+		return err
+	}
+
+	if command.Resources.LinuxResources != nil && command.Resources.LinuxResources.CpusetCgroupPath != "" {
+		cfg.Hooks = lconfigs.Hooks{
+			lconfigs.CreateRuntime: lconfigs.HookList{
+				newSetCPUSetCgroupHook(command.Resources.LinuxResources.CpusetCgroupPath),
+			},
+		}
+	}
+
+	return nil
+}
+
+// configureCgroupResources sets the memory and CPU limits of res in the
+// cgroup resources cr.
+func configureCgroupResources(cr *lconfigs.Resources, res *structs.AllocatedTaskResources) error {
 	// Total amount of memory allowed to consume
-	res := command.Resources.NomadResources
 	memHard, memSoft := res.Memory.MemoryMaxMB, res.Memory.MemoryMB
 	if memHard <= 0 {
 		memHard = res.Memory.MemoryMB
@@ -683,12 +929,12 @@ func configureCgroups(cfg *lconfigs.Config, command *ExecCommand) error {
 	}
 
 	if memHard > 0 {
-		cfg.Cgroups.Resources.Memory = memHard * 1024 * 1024
-		cfg.Cgroups.Resources.MemoryReservation = memSoft * 1024 * 1024
+		cr.Memory = memHard * 1024 * 1024
+		cr.MemoryReservation = memSoft * 1024 * 1024
 
 		// Disable swap to avoid issues on the machine
 		var memSwappiness uint64
-		cfg.Cgroups.Resources.MemorySwappiness = &memSwappiness
+		cr.MemorySwappiness = &memSwappiness
 	}
 
 	cpuShares := res.Cpu.CpuShares
@@ -697,17 +943,8 @@ func configureCgroups(cfg *lconfigs.Config, command *ExecCommand) error {
 	}
 
 	// Set the relative CPU shares for this cgroup, and convert for cgroupv2
-	cfg.Cgroups.Resources.CpuShares = uint64(cpuShares)
-	cfg.Cgroups.Resources.CpuWeight = cgroups.ConvertCPUSharesToCgroupV2Value(uint64(cpuShares))
-
-	if command.Resources.LinuxResources != nil && command.Resources.LinuxResources.CpusetCgroupPath != "" {
-		cfg.Hooks = lconfigs.Hooks{
-			lconfigs.CreateRuntime: lconfigs.HookList{
-				newSetCPUSetCgroupHook(command.Resources.LinuxResources.CpusetCgroupPath),
-			},
-		}
-	}
-
+	cr.CpuShares = uint64(cpuShares)
+	cr.CpuWeight = cgroups.ConvertCPUSharesToCgroupV2Value(uint64(cpuShares))
 	return nil
 }
 
@@ -727,6 +964,10 @@ func newLibcontainerConfig(command *ExecCommand) (*lconfigs.Config, error) {
 
 	configureCapabilities(cfg, command)
 
+	if err := configureSeccomp(cfg, command); err != nil {
+		return nil, err
+	}
+
 	// children should not inherit Nomad agent oom_score_adj value
 	oomScoreAdj := 0
 	cfg.OomScoreAdj = &oomScoreAdj
@@ -819,6 +1060,11 @@ func lookupTaskBin(command *ExecCommand) (string, string, error) {
 		return taskPath, hostPath, nil
 	}
 
+	// The task directory is hidden by the root filesystem of images
+	if len(command.RootfsLayers) > 0 {
+		return lookupImageBin(command)
+	}
+
 	// Check at the root of the task's directory
 	taskPath, hostPath, err = getPathInTaskDir(command.TaskDir, command.TaskDir, bin)
 	if err == nil {
@@ -860,6 +1106,41 @@ func lookupTaskBin(command *ExecCommand) (string, string, error) {
 	return "", "", fmt.Errorf("file %s not found under path", bin)
 }
 
+// lookupImageBin finds the file `bin` in the mounts or image layers of the
+// task, searching the PATH-like directories of lookupTaskBin if it is a name.
+// Only the path inside the container is returned for files of the image, as
+// symlinks of their layers cannot be resolved on the host.
+func lookupImageBin(command *ExecCommand) (string, string, error) {
+	bin := command.Cmd
+
+	for _, mount := range command.Mounts {
+		taskPath, hostPath, err := getPathInMount(mount.HostPath, mount.TaskPath, bin)
+		if err == nil {
+			return taskPath, hostPath, nil
+		}
+	}
+
+	// Paths are left for the container to resolve
+	if strings.Contains(bin, "/") {
+		return filepath.Clean("/" + bin), "", nil
+	}
+
+	for _, dir := range []string{"/usr/local/bin", "/usr/bin", "/bin"} {
+		taskPath := filepath.Join(dir, bin)
+		for i := len(command.RootfsLayers) - 1; i >= 0; i-- {
+			hostPath, err := securejoin.SecureJoin(command.RootfsLayers[i], taskPath)
+			if err != nil {
+				continue
+			}
+			if _, err := os.Lstat(hostPath); err == nil {
+				return taskPath, "", nil
+			}
+		}
+	}
+
+	return "", "", fmt.Errorf("file %s not found in image", bin)
+}
+
 // getPathInTaskDir searches for the binary in the task directory and nested
 // search directory. It returns the absolute path rooted inside the container
 // and the absolute path on the host.
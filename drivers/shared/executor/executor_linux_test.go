@@ -21,6 +21,7 @@ import (
 	"github.com/hashicorp/nomad/nomad/mock"
 	"github.com/hashicorp/nomad/plugins/drivers"
 	tu "github.com/hashicorp/nomad/testutil"
+	"github.com/opencontainers/runc/libcontainer"
 	"github.com/opencontainers/runc/libcontainer/cgroups"
 	lconfigs "github.com/opencontainers/runc/libcontainer/configs"
 	"github.com/opencontainers/runc/libcontainer/devices"
@@ -129,6 +130,152 @@ func TestExecutor_configureNamespaces(t *testing.T) {
 	})
 }
 
+func TestExecutor_configureSeccomp(t *testing.T) {
+	ci.Parallel(t)
+
+	t.Run("unconfined", func(t *testing.T) {
+		cfg := &lconfigs.Config{Capabilities: &lconfigs.Capabilities{}}
+		require.NoError(t, configureSeccomp(cfg, &ExecCommand{SeccompProfile: "unconfined"}))
+		require.Nil(t, cfg.Seccomp)
+	})
+
+	t.Run("default", func(t *testing.T) {
+		cfg := &lconfigs.Config{Capabilities: &lconfigs.Capabilities{}}
+		require.NoError(t, configureSeccomp(cfg, &ExecCommand{SeccompProfile: "default"}))
+		require.NotNil(t, cfg.Seccomp)
+		require.Equal(t, lconfigs.Allow, cfg.Seccomp.DefaultAction)
+		require.NotEmpty(t, cfg.Seccomp.Syscalls)
+	})
+
+	t.Run("invalid", func(t *testing.T) {
+		cfg := &lconfigs.Config{Capabilities: &lconfigs.Capabilities{}}
+		require.Error(t, configureSeccomp(cfg, &ExecCommand{SeccompProfile: "profile.json"}))
+	})
+}
+
+func TestExecutor_configureUserNamespace(t *testing.T) {
+	ci.Parallel(t)
+
+	userns := &drivers.IDMapping{UIDStart: 100000, GIDStart: 200000, Size: 65536}
+
+	t.Run("host network", func(t *testing.T) {
+		cfg := &lconfigs.Config{}
+		require.NoError(t, configureIsolation(cfg, &ExecCommand{
+			ModePID:       "private",
+			ModeIPC:       "host",
+			UserNamespace: userns,
+		}))
+
+		require.True(t, cfg.Namespaces.Contains(lconfigs.NEWUSER))
+		require.Equal(t, []lconfigs.IDMap{{ContainerID: 0, HostID: 100000, Size: 65536}}, cfg.UidMappings)
+		require.Equal(t, []lconfigs.IDMap{{ContainerID: 0, HostID: 200000, Size: 65536}}, cfg.GidMappings)
+
+		// sysfs and mqueue cannot be mounted without owning the namespaces
+		for _, m := range cfg.Mounts {
+			switch m.Destination {
+			case "/sys":
+				require.Equal(t, "bind", m.Device)
+				require.Equal(t, "/sys", m.Source)
+			case "/dev/mqueue":
+				require.Equal(t, "bind", m.Device)
+				require.Equal(t, "/dev/mqueue", m.Source)
+			}
+		}
+	})
+
+	t.Run("private namespaces", func(t *testing.T) {
+		cfg := &lconfigs.Config{}
+		require.NoError(t, configureIsolation(cfg, &ExecCommand{
+			ModePID:          "private",
+			ModeIPC:          "private",
+			NetworkIsolation: &drivers.NetworkIsolationSpec{Path: "/var/run/netns/task"},
+			UserNamespace:    userns,
+		}))
+
+		require.True(t, cfg.Namespaces.Contains(lconfigs.NEWUSER))
+		for _, m := range cfg.Mounts {
+			switch m.Destination {
+			case "/sys":
+				require.Equal(t, "sysfs", m.Device)
+			case "/dev/mqueue":
+				require.Equal(t, "mqueue", m.Device)
+			}
+		}
+	})
+
+	t.Run("disabled", func(t *testing.T) {
+		cfg := &lconfigs.Config{}
+		require.NoError(t, configureIsolation(cfg, &ExecCommand{
+			ModePID: "private",
+			ModeIPC: "private",
+		}))
+		require.False(t, cfg.Namespaces.Contains(lconfigs.NEWUSER))
+		require.Nil(t, cfg.UidMappings)
+	})
+}
+
+func TestExecutor_configureImageRootfs(t *testing.T) {
+	ci.Parallel(t)
+
+	taskDir := t.TempDir()
+	cfg := &lconfigs.Config{}
+	require.NoError(t, configureIsolation(cfg, &ExecCommand{
+		TaskDir:      taskDir,
+		RootfsLayers: []string{"/images/base", "/images/top"},
+		Mounts:       []*drivers.MountConfig{{TaskPath: "/srv", HostPath: "/data"}},
+	}))
+
+	// The image is mounted first, with the top layer listed first
+	require.Equal(t, filepath.Join(taskDir, "rootfs", "merged"), cfg.Rootfs)
+	root := cfg.Mounts[0]
+	require.Equal(t, "/", root.Destination)
+	require.Equal(t, "overlay", root.Device)
+	require.Equal(t, fmt.Sprintf("lowerdir=/images/top:/images/base,upperdir=%[1]s/rootfs/upper,workdir=%[1]s/rootfs/work", taskDir), root.Data)
+	require.DirExists(t, filepath.Join(taskDir, "rootfs", "upper"))
+
+	// The task directories are bound into the image before task mounts
+	var destinations []string
+	for _, m := range cfg.Mounts[1:] {
+		if m.Device == "bind" {
+			destinations = append(destinations, m.Destination)
+		}
+	}
+	require.Equal(t, []string{"/alloc", "/local", "/secrets", "/tmp", "/srv"}, destinations)
+}
+
+func TestExecutor_ImageRootfs(t *testing.T) {
+	ci.Parallel(t)
+	testutil.ExecCompatible(t)
+	r := require.New(t)
+
+	// The chroot of a task makes for the layer of an image
+	image := testExecutorCommandWithChroot(t)
+	defer image.allocDir.Destroy()
+
+	testExecCmd := testExecutorCommandWithChroot(t)
+	execCmd, allocDir := testExecCmd.command, testExecCmd.allocDir
+	defer allocDir.Destroy()
+	r.NoError(os.RemoveAll(filepath.Join(execCmd.TaskDir, "etc")))
+	r.NoError(os.WriteFile(filepath.Join(execCmd.TaskDir, "local", "hello"), []byte("hello"), 0644))
+
+	execCmd.RootfsLayers = []string{image.command.TaskDir}
+	execCmd.Cmd = "ls"
+	execCmd.Args = []string{"/etc/passwd", "/local/hello"}
+
+	executor := NewExecutorWithIsolation(testlog.HCLogger(t))
+	defer executor.Shutdown("SIGKILL", 0)
+
+	_, err := executor.Launch(execCmd)
+	r.NoError(err)
+
+	estate, err := executor.Wait(context.Background())
+	r.NoError(err)
+	r.Zero(estate.ExitCode, testExecCmd.stderr.String())
+
+	testExecCmd.outputCopyDone.Wait()
+	r.Equal("/etc/passwd\n/local/hello\n", testExecCmd.stdout.String())
+}
+
 func TestExecutor_Isolation_PID_and_IPC_hostMode(t *testing.T) {
 	ci.Parallel(t)
 	r := require.New(t)
@@ -264,6 +411,81 @@ passwd`
 
 // TestExecutor_CgroupPaths asserts that process starts with independent cgroups
 // hierarchy created for this process
+func TestExecutor_UpdateResources(t *testing.T) {
+	ci.Parallel(t)
+	testutil.ExecCompatible(t)
+	r := require.New(t)
+
+	testExecCmd := testExecutorCommandWithChroot(t)
+	execCmd, allocDir := testExecCmd.command, testExecCmd.allocDir
+	execCmd.Cmd = "/bin/sleep"
+	execCmd.Args = []string{"10"}
+	execCmd.ResourceLimits = true
+	defer allocDir.Destroy()
+
+	executor := NewExecutorWithIsolation(testlog.HCLogger(t))
+	defer executor.Shutdown("SIGKILL", 0)
+
+	_, err := executor.Launch(execCmd)
+	r.NoError(err)
+
+	memoryLimit := func() uint64 {
+		lstats, err := executor.(*LibcontainerExecutor).container.Stats()
+		r.NoError(err)
+		return lstats.CgroupStats.MemoryStats.Usage.Limit
+	}
+	r.Equal(uint64(256*1024*1024), memoryLimit())
+
+	resources := execCmd.Resources.Copy()
+	resources.NomadResources.Memory.MemoryMB = 512
+	resources.NomadResources.Cpu.CpuShares = 1000
+	r.NoError(executor.UpdateResources(resources))
+	r.Equal(uint64(512*1024*1024), memoryLimit())
+
+	// The limits of tasks launched without resource limits can't be changed
+	noLimits := &LibcontainerExecutor{command: &ExecCommand{}}
+	r.EqualError(noLimits.UpdateResources(resources), "task was not launched with resource limits")
+}
+
+func TestExecutor_PauseResume(t *testing.T) {
+	ci.Parallel(t)
+	testutil.ExecCompatible(t)
+	r := require.New(t)
+
+	testExecCmd := testExecutorCommandWithChroot(t)
+	execCmd, allocDir := testExecCmd.command, testExecCmd.allocDir
+	execCmd.Cmd = "/bin/sleep"
+	execCmd.Args = []string{"10"}
+	execCmd.ResourceLimits = true
+	defer allocDir.Destroy()
+
+	executor := NewExecutorWithIsolation(testlog.HCLogger(t))
+	defer executor.Shutdown("SIGKILL", 0)
+
+	_, err := executor.Launch(execCmd)
+	r.NoError(err)
+
+	status := func() libcontainer.Status {
+		s, err := executor.(*LibcontainerExecutor).container.Status()
+		r.NoError(err)
+		return s
+	}
+
+	r.NoError(executor.Pause())
+	r.Equal(libcontainer.Paused, status())
+
+	r.NoError(executor.Resume())
+	r.Equal(libcontainer.Running, status())
+
+	// A paused task is thawed so it can be stopped gracefully
+	r.NoError(executor.Pause())
+	r.NoError(executor.Shutdown("SIGTERM", 5*time.Second))
+
+	ps, err := executor.Wait(context.Background())
+	r.NoError(err)
+	r.Equal(int(unix.SIGTERM), ps.Signal)
+}
+
 func TestExecutor_CgroupPaths(t *testing.T) {
 	ci.Parallel(t)
 	testutil.ExecCompatible(t)
@@ -882,3 +1104,71 @@ func TestUniversalExecutor_NoCgroup(t *testing.T) {
 	})
 
 }
+
+func TestExecutor_userNamespacesEnabled(t *testing.T) {
+	ci.Parallel(t)
+
+	for _, tc := range []struct {
+		name    string
+		sysctls map[string]string
+		exp     bool
+	}{
+		{
+			name:    "missing",
+			sysctls: map[string]string{},
+			exp:     false,
+		},
+		{
+			name:    "max_user_namespaces",
+			sysctls: map[string]string{"user/max_user_namespaces": "63432\n"},
+			exp:     true,
+		},
+		{
+			name:    "no user namespaces",
+			sysctls: map[string]string{"user/max_user_namespaces": "0\n"},
+			exp:     false,
+		},
+		{
+			name: "unprivileged_userns_clone disabled",
+			sysctls: map[string]string{
+				"user/max_user_namespaces":         "63432\n",
+				"kernel/unprivileged_userns_clone": "0\n",
+			},
+			exp: false,
+		},
+		{
+			name: "unprivileged_userns_clone enabled",
+			sysctls: map[string]string{
+				"user/max_user_namespaces":         "63432\n",
+				"kernel/unprivileged_userns_clone": "1\n",
+			},
+			exp: true,
+		},
+		{
+			name: "apparmor restricted",
+			sysctls: map[string]string{
+				"user/max_user_namespaces":                     "63432\n",
+				"kernel/apparmor_restrict_unprivileged_userns": "1\n",
+			},
+			exp: false,
+		},
+		{
+			name: "apparmor unrestricted",
+			sysctls: map[string]string{
+				"user/max_user_namespaces":                     "63432\n",
+				"kernel/apparmor_restrict_unprivileged_userns": "0\n",
+			},
+			exp: true,
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			procSys := t.TempDir()
+			for name, value := range tc.sysctls {
+				path := filepath.Join(procSys, name)
+				must.NoError(t, os.MkdirAll(filepath.Dir(path), 0755))
+				must.NoError(t, os.WriteFile(path, []byte(value), 0644))
+			}
+			must.Eq(t, tc.exp, userNamespacesEnabled(procSys))
+		})
+	}
+}
@@ -50,6 +50,11 @@ func (c *grpcExecutorClient) Launch(cmd *ExecCommand) (*ProcessState, error) {
 		DefaultPidMode:     cmd.ModePID,
 		DefaultIpcMode:     cmd.ModeIPC,
 		Capabilities:       cmd.Capabilities,
+		SeccompProfile:     cmd.SeccompProfile,
+		UserNamespace:      drivers.IDMappingToProto(cmd.UserNamespace),
+		RootfsLayers:       cmd.RootfsLayers,
+		Landlock:           cmd.Landlock,
+		Unveil:             cmd.Unveil,
 	}
 	resp, err := c.client.Launch(ctx, req)
 	if err != nil {
@@ -103,6 +108,24 @@ func (c *grpcExecutorClient) UpdateResources(r *drivers.Resources) error {
 	return nil
 }
 
+func (c *grpcExecutorClient) Pause() error {
+	ctx := context.Background()
+	if _, err := c.client.Pause(ctx, &proto.PauseRequest{}); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (c *grpcExecutorClient) Resume() error {
+	ctx := context.Background()
+	if _, err := c.client.Resume(ctx, &proto.ResumeRequest{}); err != nil {
+		return err
+	}
+
+	return nil
+}
+
 func (c *grpcExecutorClient) Version() (*ExecutorVersion, error) {
 	ctx := context.Background()
 	resp, err := c.client.Version(ctx, &proto.VersionRequest{})
@@ -39,6 +39,11 @@ func (s *grpcExecutorServer) Launch(ctx context.Context, req *proto.LaunchReques
 		ModePID:            req.DefaultPidMode,
 		ModeIPC:            req.DefaultIpcMode,
 		Capabilities:       req.Capabilities,
+		SeccompProfile:     req.SeccompProfile,
+		UserNamespace:      drivers.IDMappingFromProto(req.UserNamespace),
+		RootfsLayers:       req.RootfsLayers,
+		Landlock:           req.Landlock,
+		Unveil:             req.Unveil,
 	})
 
 	if err != nil {
@@ -87,6 +92,22 @@ func (s *grpcExecutorServer) UpdateResources(ctx context.Context, req *proto.Upd
 	return &proto.UpdateResourcesResponse{}, nil
 }
 
+func (s *grpcExecutorServer) Pause(context.Context, *proto.PauseRequest) (*proto.PauseResponse, error) {
+	if err := s.impl.Pause(); err != nil {
+		return nil, err
+	}
+
+	return &proto.PauseResponse{}, nil
+}
+
+func (s *grpcExecutorServer) Resume(context.Context, *proto.ResumeRequest) (*proto.ResumeResponse, error) {
+	if err := s.impl.Resume(); err != nil {
+		return nil, err
+	}
+
+	return &proto.ResumeResponse{}, nil
+}
+
 func (s *grpcExecutorServer) Version(context.Context, *proto.VersionRequest) (*proto.VersionResponse, error) {
 	v, err := s.impl.Version()
 	if err != nil {
@@ -0,0 +1,69 @@
+package executor
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/shoenig/go-landlock"
+)
+
+// LandlockCommand is the first argument to the clone of the nomad agent
+// process that sandboxes a task with Landlock before exec'ing into it.
+const LandlockCommand = "landlock-exec"
+
+// landlockConfig is the configuration passed to the Landlock sandbox process.
+type landlockConfig struct {
+	TaskDir string   `json:"task_dir"`
+	Unveil  []string `json:"unveil"`
+}
+
+// ParseUnveil splits an unveil entry of the "mode:path" form into its mode
+// and its absolute path.
+func ParseUnveil(s string) (string, string, error) {
+	mode, path, ok := strings.Cut(s, ":")
+	if !ok {
+		return "", "", fmt.Errorf("%q must be of the form mode:path", s)
+	}
+	if !landlock.IsProperMode(mode) {
+		return "", "", fmt.Errorf("%q has mode %q, which must be made of r, w, c and x", s, mode)
+	}
+	if !filepath.IsAbs(path) {
+		return "", "", fmt.Errorf("%q has path %q, which must be absolute", s, path)
+	}
+	return mode, filepath.Clean(path), nil
+}
+
+// ValidateUnveil returns an error if any of the unveil entries is invalid.
+func ValidateUnveil(unveil []string) error {
+	for _, s := range unveil {
+		if _, _, err := ParseUnveil(s); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// landlockCommand returns the path and arguments running the command name
+// through the Landlock sandbox process, when command requires it.
+func landlockCommand(command *ExecCommand, name string, args []string) (string, []string, error) {
+	if !command.Landlock {
+		return name, args, nil
+	}
+
+	config, err := json.Marshal(&landlockConfig{
+		TaskDir: command.TaskDir,
+		Unveil:  command.Unveil,
+	})
+	if err != nil {
+		return "", nil, fmt.Errorf("unable to create landlock config: %v", err)
+	}
+	bin, err := os.Executable()
+	if err != nil {
+		return "", nil, fmt.Errorf("unable to find the nomad binary: %v", err)
+	}
+
+	return bin, append([]string{LandlockCommand, string(config), name}, args...), nil
+}
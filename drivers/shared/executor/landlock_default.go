@@ -0,0 +1,10 @@
+//go:build !linux
+
+package executor
+
+import "errors"
+
+// landlockExec returns an error because Landlock is only available on Linux.
+func landlockExec(*landlockConfig, string, []string) error {
+	return errors.New("landlock is only available on linux")
+}
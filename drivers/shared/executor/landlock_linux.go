@@ -0,0 +1,56 @@
+//go:build linux
+
+package executor
+
+import (
+	"fmt"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"syscall"
+
+	"github.com/shoenig/go-landlock"
+)
+
+// landlockExec restricts the filesystem access of this process and of its
+// children to the paths of config, then replaces it with the command name.
+func landlockExec(config *landlockConfig, name string, args []string) error {
+	path, err := exec.LookPath(name)
+	if err != nil {
+		return err
+	}
+	if path, err = filepath.Abs(path); err != nil {
+		return err
+	}
+
+	// The standard streams are not unveiled, as the task inherits them
+	// already open
+	paths := []*landlock.Path{
+		landlock.Shared(),
+		landlock.DNS(),
+		landlock.Certs(),
+		landlock.File(path, "rx"),
+		landlock.Dir(config.TaskDir, "rwcx"),
+		landlock.Dir(filepath.Join(filepath.Dir(config.TaskDir), "alloc"), "rwcx"),
+	}
+	for _, s := range config.Unveil {
+		mode, p, err := ParseUnveil(s)
+		if err != nil {
+			return err
+		}
+		fi, err := os.Stat(p)
+		if err != nil {
+			return fmt.Errorf("failed to unveil %s: %w", p, err)
+		}
+		if fi.IsDir() {
+			paths = append(paths, landlock.Dir(p, mode))
+		} else {
+			paths = append(paths, landlock.File(p, mode))
+		}
+	}
+
+	if err := landlock.New(paths...).Lock(landlock.Mandatory); err != nil {
+		return err
+	}
+	return syscall.Exec(path, append([]string{name}, args...), os.Environ())
+}
@@ -0,0 +1,61 @@
+//go:build linux
+
+package executor
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/hashicorp/nomad/ci"
+	"github.com/hashicorp/nomad/helper/testlog"
+	"github.com/shoenig/go-landlock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestUniversalExecutor_Landlock(t *testing.T) {
+	ci.Parallel(t)
+	if !landlock.Available() {
+		t.Skip("landlock is not available")
+	}
+
+	dir := t.TempDir()
+	hostFile := filepath.Join(dir, "hello")
+	require.NoError(t, os.WriteFile(hostFile, []byte("hello"), 0644))
+
+	run := func(t *testing.T, unveil []string) (*ProcessState, *testExecCmd) {
+		testExecCmd := testExecutorCommand(t)
+		execCmd, allocDir := testExecCmd.command, testExecCmd.allocDir
+		t.Cleanup(func() { allocDir.Destroy() })
+		require.NoError(t, os.WriteFile(filepath.Join(execCmd.TaskDir, "local", "hello"), []byte("local"), 0644))
+
+		execCmd.Cmd = "/bin/cat"
+		execCmd.Args = []string{"local/hello", hostFile}
+		execCmd.Landlock = true
+		execCmd.Unveil = unveil
+
+		executor := NewExecutor(testlog.HCLogger(t))
+		t.Cleanup(func() { executor.Shutdown("SIGKILL", 0) })
+
+		_, err := executor.Launch(execCmd)
+		require.NoError(t, err)
+		ps, err := executor.Wait(context.Background())
+		require.NoError(t, err)
+		testExecCmd.outputCopyDone.Wait()
+		return ps, testExecCmd
+	}
+
+	t.Run("sandboxed", func(t *testing.T) {
+		ps, testExecCmd := run(t, nil)
+		require.NotZero(t, ps.ExitCode)
+		require.Equal(t, "local", testExecCmd.stdout.String())
+		require.Contains(t, testExecCmd.stderr.String(), "Permission denied")
+	})
+
+	t.Run("unveiled", func(t *testing.T) {
+		ps, testExecCmd := run(t, []string{"r:" + dir})
+		require.Zero(t, ps.ExitCode, testExecCmd.stderr.String())
+		require.Equal(t, "localhello", testExecCmd.stdout.String())
+	})
+}
@@ -0,0 +1,37 @@
+package executor
+
+import (
+	"testing"
+
+	"github.com/hashicorp/nomad/ci"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseUnveil(t *testing.T) {
+	ci.Parallel(t)
+
+	for _, tc := range []struct {
+		unveil, mode, path, err string
+	}{
+		{unveil: "r:/etc/ssl", mode: "r", path: "/etc/ssl"},
+		{unveil: "rwcx:/srv/data/", mode: "rwcx", path: "/srv/data"},
+		{unveil: "rx:/opt/app:v2", mode: "rx", path: "/opt/app:v2"},
+		{unveil: "/etc/ssl", err: "must be of the form mode:path"},
+		{unveil: ":/etc/ssl", err: "which must be made of r, w, c and x"},
+		{unveil: "rwd:/etc/ssl", err: "which must be made of r, w, c and x"},
+		{unveil: "r:etc/ssl", err: "which must be absolute"},
+		{unveil: "r:", err: "which must be absolute"},
+	} {
+		t.Run(tc.unveil, func(t *testing.T) {
+			mode, path, err := ParseUnveil(tc.unveil)
+			if tc.err != "" {
+				require.ErrorContains(t, err, tc.err)
+				require.ErrorContains(t, ValidateUnveil([]string{"r:/etc", tc.unveil}), tc.err)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.mode, mode)
+			require.Equal(t, tc.path, path)
+		})
+	}
+}
@@ -46,6 +46,11 @@ type LaunchRequest struct {
 	CpusetCgroup         string                       `protobuf:"bytes,17,opt,name=cpuset_cgroup,json=cpusetCgroup,proto3" json:"cpuset_cgroup,omitempty"`
 	AllowCaps            []string                     `protobuf:"bytes,18,rep,name=allow_caps,json=allowCaps,proto3" json:"allow_caps,omitempty"`
 	Capabilities         []string                     `protobuf:"bytes,19,rep,name=capabilities,proto3" json:"capabilities,omitempty"`
+	SeccompProfile       string                       `protobuf:"bytes,20,opt,name=seccomp_profile,json=seccompProfile,proto3" json:"seccomp_profile,omitempty"`
+	UserNamespace        *proto1.IDMapping            `protobuf:"bytes,21,opt,name=user_namespace,json=userNamespace,proto3" json:"user_namespace,omitempty"`
+	RootfsLayers         []string                     `protobuf:"bytes,22,rep,name=rootfs_layers,json=rootfsLayers,proto3" json:"rootfs_layers,omitempty"`
+	Landlock             bool                         `protobuf:"varint,23,opt,name=landlock,proto3" json:"landlock,omitempty"`
+	Unveil               []string                     `protobuf:"bytes,24,rep,name=unveil,proto3" json:"unveil,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}                     `json:"-"`
 	XXX_unrecognized     []byte                       `json:"-"`
 	XXX_sizecache        int32                        `json:"-"`
@@ -209,6 +214,41 @@ func (m *LaunchRequest) GetCapabilities() []string {
 	return nil
 }
 
+func (m *LaunchRequest) GetSeccompProfile() string {
+	if m != nil {
+		return m.SeccompProfile
+	}
+	return ""
+}
+
+func (m *LaunchRequest) GetUserNamespace() *proto1.IDMapping {
+	if m != nil {
+		return m.UserNamespace
+	}
+	return nil
+}
+
+func (m *LaunchRequest) GetRootfsLayers() []string {
+	if m != nil {
+		return m.RootfsLayers
+	}
+	return nil
+}
+
+func (m *LaunchRequest) GetLandlock() bool {
+	if m != nil {
+		return m.Landlock
+	}
+	return false
+}
+
+func (m *LaunchRequest) GetUnveil() []string {
+	if m != nil {
+		return m.Unveil
+	}
+	return nil
+}
+
 type LaunchResponse struct {
 	Process              *ProcessState `protobuf:"bytes,1,opt,name=process,proto3" json:"process,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
@@ -466,6 +506,130 @@ func (m *UpdateResourcesResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_UpdateResourcesResponse proto.InternalMessageInfo
 
+type PauseRequest struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *PauseRequest) Reset()         { *m = PauseRequest{} }
+func (m *PauseRequest) String() string { return proto.CompactTextString(m) }
+func (*PauseRequest) ProtoMessage()    {}
+func (*PauseRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_66b85426380683f3, []int{8}
+}
+
+func (m *PauseRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_PauseRequest.Unmarshal(m, b)
+}
+func (m *PauseRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_PauseRequest.Marshal(b, m, deterministic)
+}
+func (m *PauseRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PauseRequest.Merge(m, src)
+}
+func (m *PauseRequest) XXX_Size() int {
+	return xxx_messageInfo_PauseRequest.Size(m)
+}
+func (m *PauseRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_PauseRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PauseRequest proto.InternalMessageInfo
+
+type PauseResponse struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *PauseResponse) Reset()         { *m = PauseResponse{} }
+func (m *PauseResponse) String() string { return proto.CompactTextString(m) }
+func (*PauseResponse) ProtoMessage()    {}
+func (*PauseResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_66b85426380683f3, []int{9}
+}
+
+func (m *PauseResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_PauseResponse.Unmarshal(m, b)
+}
+func (m *PauseResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_PauseResponse.Marshal(b, m, deterministic)
+}
+func (m *PauseResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PauseResponse.Merge(m, src)
+}
+func (m *PauseResponse) XXX_Size() int {
+	return xxx_messageInfo_PauseResponse.Size(m)
+}
+func (m *PauseResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_PauseResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PauseResponse proto.InternalMessageInfo
+
+type ResumeRequest struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ResumeRequest) Reset()         { *m = ResumeRequest{} }
+func (m *ResumeRequest) String() string { return proto.CompactTextString(m) }
+func (*ResumeRequest) ProtoMessage()    {}
+func (*ResumeRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_66b85426380683f3, []int{10}
+}
+
+func (m *ResumeRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ResumeRequest.Unmarshal(m, b)
+}
+func (m *ResumeRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ResumeRequest.Marshal(b, m, deterministic)
+}
+func (m *ResumeRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ResumeRequest.Merge(m, src)
+}
+func (m *ResumeRequest) XXX_Size() int {
+	return xxx_messageInfo_ResumeRequest.Size(m)
+}
+func (m *ResumeRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ResumeRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ResumeRequest proto.InternalMessageInfo
+
+type ResumeResponse struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ResumeResponse) Reset()         { *m = ResumeResponse{} }
+func (m *ResumeResponse) String() string { return proto.CompactTextString(m) }
+func (*ResumeResponse) ProtoMessage()    {}
+func (*ResumeResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_66b85426380683f3, []int{11}
+}
+
+func (m *ResumeResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ResumeResponse.Unmarshal(m, b)
+}
+func (m *ResumeResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ResumeResponse.Marshal(b, m, deterministic)
+}
+func (m *ResumeResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ResumeResponse.Merge(m, src)
+}
+func (m *ResumeResponse) XXX_Size() int {
+	return xxx_messageInfo_ResumeResponse.Size(m)
+}
+func (m *ResumeResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ResumeResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ResumeResponse proto.InternalMessageInfo
+
 type VersionRequest struct {
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
@@ -476,7 +640,7 @@ func (m *VersionRequest) Reset()         { *m = VersionRequest{} }
 func (m *VersionRequest) String() string { return proto.CompactTextString(m) }
 func (*VersionRequest) ProtoMessage()    {}
 func (*VersionRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_66b85426380683f3, []int{8}
+	return fileDescriptor_66b85426380683f3, []int{12}
 }
 
 func (m *VersionRequest) XXX_Unmarshal(b []byte) error {
@@ -508,7 +672,7 @@ func (m *VersionResponse) Reset()         { *m = VersionResponse{} }
 func (m *VersionResponse) String() string { return proto.CompactTextString(m) }
 func (*VersionResponse) ProtoMessage()    {}
 func (*VersionResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_66b85426380683f3, []int{9}
+	return fileDescriptor_66b85426380683f3, []int{13}
 }
 
 func (m *VersionResponse) XXX_Unmarshal(b []byte) error {
@@ -547,7 +711,7 @@ func (m *StatsRequest) Reset()         { *m = StatsRequest{} }
 func (m *StatsRequest) String() string { return proto.CompactTextString(m) }
 func (*StatsRequest) ProtoMessage()    {}
 func (*StatsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_66b85426380683f3, []int{10}
+	return fileDescriptor_66b85426380683f3, []int{14}
 }
 
 func (m *StatsRequest) XXX_Unmarshal(b []byte) error {
@@ -586,7 +750,7 @@ func (m *StatsResponse) Reset()         { *m = StatsResponse{} }
 func (m *StatsResponse) String() string { return proto.CompactTextString(m) }
 func (*StatsResponse) ProtoMessage()    {}
 func (*StatsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_66b85426380683f3, []int{11}
+	return fileDescriptor_66b85426380683f3, []int{15}
 }
 
 func (m *StatsResponse) XXX_Unmarshal(b []byte) error {
@@ -625,7 +789,7 @@ func (m *SignalRequest) Reset()         { *m = SignalRequest{} }
 func (m *SignalRequest) String() string { return proto.CompactTextString(m) }
 func (*SignalRequest) ProtoMessage()    {}
 func (*SignalRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_66b85426380683f3, []int{12}
+	return fileDescriptor_66b85426380683f3, []int{16}
 }
 
 func (m *SignalRequest) XXX_Unmarshal(b []byte) error {
@@ -663,7 +827,7 @@ func (m *SignalResponse) Reset()         { *m = SignalResponse{} }
 func (m *SignalResponse) String() string { return proto.CompactTextString(m) }
 func (*SignalResponse) ProtoMessage()    {}
 func (*SignalResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_66b85426380683f3, []int{13}
+	return fileDescriptor_66b85426380683f3, []int{17}
 }
 
 func (m *SignalResponse) XXX_Unmarshal(b []byte) error {
@@ -697,7 +861,7 @@ func (m *ExecRequest) Reset()         { *m = ExecRequest{} }
 func (m *ExecRequest) String() string { return proto.CompactTextString(m) }
 func (*ExecRequest) ProtoMessage()    {}
 func (*ExecRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_66b85426380683f3, []int{14}
+	return fileDescriptor_66b85426380683f3, []int{18}
 }
 
 func (m *ExecRequest) XXX_Unmarshal(b []byte) error {
@@ -751,7 +915,7 @@ func (m *ExecResponse) Reset()         { *m = ExecResponse{} }
 func (m *ExecResponse) String() string { return proto.CompactTextString(m) }
 func (*ExecResponse) ProtoMessage()    {}
 func (*ExecResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_66b85426380683f3, []int{15}
+	return fileDescriptor_66b85426380683f3, []int{19}
 }
 
 func (m *ExecResponse) XXX_Unmarshal(b []byte) error {
@@ -800,7 +964,7 @@ func (m *ProcessState) Reset()         { *m = ProcessState{} }
 func (m *ProcessState) String() string { return proto.CompactTextString(m) }
 func (*ProcessState) ProtoMessage()    {}
 func (*ProcessState) Descriptor() ([]byte, []int) {
-	return fileDescriptor_66b85426380683f3, []int{16}
+	return fileDescriptor_66b85426380683f3, []int{20}
 }
 
 func (m *ProcessState) XXX_Unmarshal(b []byte) error {
@@ -858,6 +1022,10 @@ func init() {
 	proto.RegisterType((*ShutdownResponse)(nil), "hashicorp.nomad.plugins.executor.proto.ShutdownResponse")
 	proto.RegisterType((*UpdateResourcesRequest)(nil), "hashicorp.nomad.plugins.executor.proto.UpdateResourcesRequest")
 	proto.RegisterType((*UpdateResourcesResponse)(nil), "hashicorp.nomad.plugins.executor.proto.UpdateResourcesResponse")
+	proto.RegisterType((*PauseRequest)(nil), "hashicorp.nomad.plugins.executor.proto.PauseRequest")
+	proto.RegisterType((*PauseResponse)(nil), "hashicorp.nomad.plugins.executor.proto.PauseResponse")
+	proto.RegisterType((*ResumeRequest)(nil), "hashicorp.nomad.plugins.executor.proto.ResumeRequest")
+	proto.RegisterType((*ResumeResponse)(nil), "hashicorp.nomad.plugins.executor.proto.ResumeResponse")
 	proto.RegisterType((*VersionRequest)(nil), "hashicorp.nomad.plugins.executor.proto.VersionRequest")
 	proto.RegisterType((*VersionResponse)(nil), "hashicorp.nomad.plugins.executor.proto.VersionResponse")
 	proto.RegisterType((*StatsRequest)(nil), "hashicorp.nomad.plugins.executor.proto.StatsRequest")
@@ -874,74 +1042,83 @@ func init() {
 }
 
 var fileDescriptor_66b85426380683f3 = []byte{
-	// 1058 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x55, 0x6d, 0x6f, 0x1b, 0x45,
-	0x10, 0xee, 0xc5, 0x89, 0x5f, 0xc6, 0x76, 0xe2, 0x2e, 0xa8, 0x5c, 0x8d, 0x50, 0xcd, 0x21, 0x51,
-	0x0b, 0xca, 0x25, 0x4a, 0xdf, 0x90, 0x90, 0x28, 0x22, 0x2d, 0xa8, 0x52, 0x1a, 0x45, 0x97, 0x42,
-	0x25, 0x3e, 0x70, 0x6c, 0xee, 0xb6, 0xf6, 0x2a, 0xf6, 0xed, 0xb2, 0xbb, 0xe7, 0x04, 0x09, 0x89,
-	0x4f, 0xfc, 0x03, 0x90, 0xf8, 0x31, 0xfc, 0x38, 0xb4, 0x6f, 0x17, 0x3b, 0x2d, 0xd5, 0xb9, 0x88,
-	0x4f, 0xbe, 0x1d, 0x3f, 0xcf, 0xcc, 0xec, 0xce, 0xcc, 0x33, 0x70, 0x27, 0x17, 0x74, 0x41, 0x84,
-	0xdc, 0x95, 0x53, 0x2c, 0x48, 0xbe, 0x4b, 0x2e, 0x48, 0x56, 0x2a, 0x26, 0x76, 0xb9, 0x60, 0x8a,
-	0x55, 0xc7, 0xd8, 0x1c, 0xd1, 0xc7, 0x53, 0x2c, 0xa7, 0x34, 0x63, 0x82, 0xc7, 0x05, 0x9b, 0xe3,
-	0x3c, 0xe6, 0xb3, 0x72, 0x42, 0x0b, 0x19, 0xaf, 0xe2, 0x86, 0xb7, 0x26, 0x8c, 0x4d, 0x66, 0xc4,
-	0x3a, 0x39, 0x2d, 0x5f, 0xee, 0x2a, 0x3a, 0x27, 0x52, 0xe1, 0x39, 0x77, 0x80, 0xc8, 0x11, 0x77,
-	0x7d, 0x78, 0x1b, 0xce, 0x9e, 0x2c, 0x26, 0xfa, 0xbb, 0x09, 0xfd, 0x43, 0x5c, 0x16, 0xd9, 0x34,
-	0x21, 0x3f, 0x97, 0x44, 0x2a, 0x34, 0x80, 0x46, 0x36, 0xcf, 0xc3, 0x60, 0x14, 0x8c, 0x3b, 0x89,
-	0xfe, 0x44, 0x08, 0x36, 0xb1, 0x98, 0xc8, 0x70, 0x63, 0xd4, 0x18, 0x77, 0x12, 0xf3, 0x8d, 0x8e,
-	0xa0, 0x23, 0x88, 0x64, 0xa5, 0xc8, 0x88, 0x0c, 0x1b, 0xa3, 0x60, 0xdc, 0xdd, 0xdf, 0x8b, 0xff,
-	0x2d, 0x71, 0x17, 0xdf, 0x86, 0x8c, 0x13, 0xcf, 0x4b, 0x2e, 0x5d, 0xa0, 0x5b, 0xd0, 0x95, 0x2a,
-	0x67, 0xa5, 0x4a, 0x39, 0x56, 0xd3, 0x70, 0xd3, 0x44, 0x07, 0x6b, 0x3a, 0xc6, 0x6a, 0xea, 0x00,
-	0x44, 0x08, 0x0b, 0xd8, 0xaa, 0x00, 0x44, 0x08, 0x03, 0x18, 0x40, 0x83, 0x14, 0x8b, 0xb0, 0x69,
-	0x92, 0xd4, 0x9f, 0x3a, 0xef, 0x52, 0x12, 0x11, 0xb6, 0x0c, 0xd6, 0x7c, 0xa3, 0x9b, 0xd0, 0x56,
-	0x58, 0x9e, 0xa5, 0x39, 0x15, 0x61, 0xdb, 0xd8, 0x5b, 0xfa, 0xfc, 0x98, 0x0a, 0x74, 0x1b, 0x76,
-	0x7c, 0x3e, 0xe9, 0x8c, 0xce, 0xa9, 0x92, 0x61, 0x67, 0x14, 0x8c, 0xdb, 0xc9, 0xb6, 0x37, 0x1f,
-	0x1a, 0x2b, 0xda, 0x83, 0x77, 0x4f, 0xb1, 0xa4, 0x59, 0xca, 0x05, 0xcb, 0x88, 0x94, 0x69, 0x36,
-	0x11, 0xac, 0xe4, 0x21, 0x18, 0x34, 0x32, 0xff, 0x1d, 0xdb, 0xbf, 0x0e, 0xcc, 0x3f, 0xe8, 0x31,
-	0x34, 0xe7, 0xac, 0x2c, 0x94, 0x0c, 0xbb, 0xa3, 0xc6, 0xb8, 0xbb, 0x7f, 0xa7, 0xe6, 0x53, 0x3d,
-	0xd3, 0xa4, 0xc4, 0x71, 0xd1, 0xb7, 0xd0, 0xca, 0xc9, 0x82, 0xea, 0x17, 0xef, 0x19, 0x37, 0x9f,
-	0xd5, 0x74, 0xf3, 0xd8, 0xb0, 0x12, 0xcf, 0x46, 0x53, 0xb8, 0x5e, 0x10, 0x75, 0xce, 0xc4, 0x59,
-	0x4a, 0x25, 0x9b, 0x61, 0x45, 0x59, 0x11, 0xf6, 0x4d, 0x11, 0xbf, 0xa8, 0xe9, 0xf2, 0xc8, 0xf2,
-	0x9f, 0x7a, 0xfa, 0x09, 0x27, 0x59, 0x32, 0x28, 0xae, 0x58, 0x51, 0x04, 0xfd, 0x82, 0xa5, 0x9c,
-	0x2e, 0x98, 0x4a, 0x05, 0x63, 0x2a, 0xdc, 0x36, 0x6f, 0xd4, 0x2d, 0xd8, 0xb1, 0xb6, 0x25, 0x8c,
-	0x29, 0x34, 0x86, 0x41, 0x4e, 0x5e, 0xe2, 0x72, 0xa6, 0x52, 0x4e, 0xf3, 0x74, 0xce, 0x72, 0x12,
-	0xee, 0x98, 0xd2, 0x6c, 0x3b, 0xfb, 0x31, 0xcd, 0x9f, 0xb1, 0x9c, 0x2c, 0x23, 0x29, 0xcf, 0x2c,
-	0x72, 0xb0, 0x82, 0x7c, 0xca, 0x33, 0x83, 0xfc, 0x08, 0xfa, 0x19, 0x2f, 0x25, 0x51, 0xbe, 0x36,
-	0xd7, 0x0d, 0xac, 0x67, 0x8d, 0xae, 0x2a, 0x1f, 0x00, 0xe0, 0xd9, 0x8c, 0x9d, 0xa7, 0x19, 0xe6,
-	0x32, 0x44, 0xa6, 0x71, 0x3a, 0xc6, 0x72, 0x80, 0xb9, 0x44, 0x11, 0xf4, 0x32, 0xcc, 0xf1, 0x29,
-	0x9d, 0x51, 0x45, 0x89, 0x0c, 0xdf, 0x31, 0x80, 0x15, 0x5b, 0xf4, 0x13, 0x6c, 0xfb, 0xe9, 0x91,
-	0x9c, 0x15, 0x92, 0xa0, 0x23, 0x68, 0xb9, 0xb6, 0x30, 0x23, 0xd4, 0xdd, 0xbf, 0x17, 0xd7, 0x9b,
-	0xe7, 0xd8, 0xb5, 0xcc, 0x89, 0xc2, 0x8a, 0x24, 0xde, 0x49, 0xd4, 0x87, 0xee, 0x0b, 0x4c, 0x95,
-	0x9b, 0xce, 0xe8, 0x47, 0xe8, 0xd9, 0xe3, 0xff, 0x14, 0xee, 0x10, 0x76, 0x4e, 0xa6, 0xa5, 0xca,
-	0xd9, 0x79, 0xe1, 0x05, 0xe1, 0x06, 0x34, 0x25, 0x9d, 0x14, 0x78, 0xe6, 0x34, 0xc1, 0x9d, 0xd0,
-	0x87, 0xd0, 0x9b, 0x08, 0x9c, 0x91, 0x94, 0x13, 0x41, 0x59, 0x1e, 0x6e, 0x8c, 0x82, 0x71, 0x23,
-	0xe9, 0x1a, 0xdb, 0xb1, 0x31, 0x45, 0x08, 0x06, 0x97, 0xde, 0x6c, 0xc6, 0xd1, 0x14, 0x6e, 0x7c,
-	0xc7, 0x73, 0x1d, 0xb4, 0xd2, 0x01, 0x17, 0x68, 0x45, 0x53, 0x82, 0xff, 0xac, 0x29, 0xd1, 0x4d,
-	0x78, 0xef, 0x95, 0x48, 0x2e, 0x89, 0x01, 0x6c, 0x7f, 0x4f, 0x84, 0xa4, 0xcc, 0xdf, 0x32, 0xfa,
-	0x14, 0x76, 0x2a, 0x8b, 0x7b, 0xdb, 0x10, 0x5a, 0x0b, 0x6b, 0x72, 0x37, 0xf7, 0xc7, 0xe8, 0x13,
-	0xe8, 0xe9, 0x77, 0xab, 0x32, 0x1f, 0x42, 0x9b, 0x16, 0x8a, 0x88, 0x85, 0x7b, 0xa4, 0x46, 0x52,
-	0x9d, 0xa3, 0x17, 0xd0, 0x77, 0x58, 0xe7, 0xf6, 0x1b, 0xd8, 0x92, 0xda, 0xb0, 0xe6, 0x15, 0x9f,
-	0x63, 0x79, 0x66, 0x1d, 0x59, 0x7a, 0x74, 0x1b, 0xfa, 0x27, 0xa6, 0x12, 0xaf, 0x2f, 0xd4, 0x96,
-	0x2f, 0x94, 0xbe, 0xac, 0x07, 0xba, 0xeb, 0x9f, 0x41, 0xf7, 0xc9, 0x05, 0xc9, 0x3c, 0xf1, 0x01,
-	0xb4, 0x73, 0x82, 0xf3, 0x19, 0x2d, 0x88, 0x4b, 0x6a, 0x18, 0xdb, 0xe5, 0x12, 0xfb, 0xe5, 0x12,
-	0x3f, 0xf7, 0xcb, 0x25, 0xa9, 0xb0, 0x7e, 0x55, 0x6c, 0xbc, 0xba, 0x2a, 0x1a, 0x97, 0xab, 0x22,
-	0x3a, 0x80, 0x9e, 0x0d, 0xe6, 0xee, 0x7f, 0x03, 0x9a, 0xac, 0x54, 0xbc, 0x54, 0x26, 0x56, 0x2f,
-	0x71, 0x27, 0xf4, 0x3e, 0x74, 0xc8, 0x05, 0x55, 0x69, 0xa6, 0xc7, 0x7a, 0xc3, 0xdc, 0xa0, 0xad,
-	0x0d, 0x07, 0x2c, 0x27, 0xd1, 0xef, 0x01, 0xf4, 0x96, 0x3b, 0x56, 0xc7, 0xe6, 0x34, 0x77, 0x37,
-	0xd5, 0x9f, 0x6f, 0xe4, 0x2f, 0xbd, 0x4d, 0x63, 0xf9, 0x6d, 0x50, 0x0c, 0x9b, 0x7a, 0x6d, 0x9a,
-	0x85, 0xf3, 0xe6, 0x6b, 0x1b, 0xdc, 0xfe, 0x9f, 0x1d, 0x68, 0x3f, 0x71, 0x83, 0x84, 0x7e, 0x81,
-	0xa6, 0x9d, 0x7e, 0x74, 0xbf, 0xee, 0xd4, 0xad, 0xec, 0xda, 0xe1, 0x83, 0x75, 0x69, 0xae, 0x7e,
-	0xd7, 0x90, 0x84, 0x4d, 0xad, 0x03, 0xe8, 0x6e, 0x5d, 0x0f, 0x4b, 0x22, 0x32, 0xbc, 0xb7, 0x1e,
-	0xa9, 0x0a, 0xfa, 0x1b, 0xb4, 0xfd, 0x38, 0xa3, 0x87, 0x75, 0x7d, 0x5c, 0x91, 0x93, 0xe1, 0xe7,
-	0xeb, 0x13, 0xab, 0x04, 0xfe, 0x08, 0x60, 0xe7, 0xca, 0x48, 0xa3, 0x2f, 0xeb, 0xfa, 0x7b, 0xbd,
-	0xea, 0x0c, 0x1f, 0xbd, 0x35, 0xbf, 0x4a, 0xeb, 0x57, 0x68, 0x39, 0xed, 0x40, 0xb5, 0x2b, 0xba,
-	0x2a, 0x3f, 0xc3, 0x87, 0x6b, 0xf3, 0xaa, 0xe8, 0x17, 0xb0, 0x65, 0x74, 0x01, 0xd5, 0x2e, 0xeb,
-	0xb2, 0x76, 0x0d, 0xef, 0xaf, 0xc9, 0xf2, 0x71, 0xf7, 0x02, 0xdd, 0xff, 0x56, 0x58, 0xea, 0xf7,
-	0xff, 0x8a, 0x62, 0xd5, 0xef, 0xff, 0x2b, 0xfa, 0x65, 0xfa, 0x5f, 0x8f, 0x61, 0xfd, 0xfe, 0x5f,
-	0xd2, 0xbb, 0xfa, 0xfd, 0xbf, 0xac, 0x5b, 0xd1, 0x35, 0xf4, 0x57, 0x00, 0x7d, 0x6d, 0x3a, 0x51,
-	0x82, 0xe0, 0x39, 0x2d, 0x26, 0xe8, 0x51, 0x4d, 0xf1, 0xd6, 0x2c, 0x2b, 0xe0, 0x8e, 0xe9, 0x53,
-	0xf9, 0xea, 0xed, 0x1d, 0xf8, 0xb4, 0xc6, 0xc1, 0x5e, 0xf0, 0x75, 0xeb, 0x87, 0x2d, 0xab, 0x59,
-	0x4d, 0xf3, 0x73, 0xf7, 0x9f, 0x00, 0x00, 0x00, 0xff, 0xff, 0x2e, 0x63, 0xc4, 0xd3, 0x74, 0x0c,
-	0x00, 0x00,
+	// 1205 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x56, 0x6d, 0x6f, 0x1b, 0x45,
+	0x17, 0x7d, 0x1c, 0x27, 0xb6, 0x73, 0xfd, 0xda, 0x79, 0x4a, 0xba, 0x5d, 0x84, 0x1a, 0x16, 0x89,
+	0x5a, 0x50, 0x36, 0x51, 0xda, 0xa6, 0x48, 0x48, 0x14, 0x91, 0x14, 0x54, 0x29, 0x8d, 0xac, 0x4d,
+	0xa1, 0x12, 0x1f, 0x58, 0xa6, 0xbb, 0x13, 0x7b, 0x94, 0xf5, 0xce, 0x32, 0x33, 0xeb, 0xa6, 0x12,
+	0x12, 0x9f, 0x90, 0xf8, 0x01, 0x48, 0xf0, 0x73, 0xd1, 0xbc, 0x6d, 0xed, 0xb4, 0xc0, 0xba, 0x88,
+	0x4f, 0xd9, 0x7b, 0x7c, 0xce, 0xbd, 0x77, 0xee, 0xcc, 0x9c, 0x09, 0xdc, 0x49, 0x39, 0x5d, 0x10,
+	0x2e, 0xf6, 0xc4, 0x0c, 0x73, 0x92, 0xee, 0x91, 0x4b, 0x92, 0x94, 0x92, 0xf1, 0xbd, 0x82, 0x33,
+	0xc9, 0xaa, 0x30, 0xd4, 0x21, 0xfa, 0x70, 0x86, 0xc5, 0x8c, 0x26, 0x8c, 0x17, 0x61, 0xce, 0xe6,
+	0x38, 0x0d, 0x8b, 0xac, 0x9c, 0xd2, 0x5c, 0x84, 0xab, 0x3c, 0xff, 0xd6, 0x94, 0xb1, 0x69, 0x46,
+	0x4c, 0x92, 0xe7, 0xe5, 0xf9, 0x9e, 0xa4, 0x73, 0x22, 0x24, 0x9e, 0x17, 0x96, 0x10, 0x58, 0xe1,
+	0x9e, 0x2b, 0x6f, 0xca, 0x99, 0xc8, 0x70, 0x82, 0xdf, 0x3b, 0xd0, 0x3f, 0xc1, 0x65, 0x9e, 0xcc,
+	0x22, 0xf2, 0x63, 0x49, 0x84, 0x44, 0x23, 0x68, 0x26, 0xf3, 0xd4, 0x6b, 0xec, 0x36, 0xc6, 0xdb,
+	0x91, 0xfa, 0x44, 0x08, 0x36, 0x31, 0x9f, 0x0a, 0x6f, 0x63, 0xb7, 0x39, 0xde, 0x8e, 0xf4, 0x37,
+	0x3a, 0x85, 0x6d, 0x4e, 0x04, 0x2b, 0x79, 0x42, 0x84, 0xd7, 0xdc, 0x6d, 0x8c, 0xbb, 0x07, 0xfb,
+	0xe1, 0x5f, 0x35, 0x6e, 0xeb, 0x9b, 0x92, 0x61, 0xe4, 0x74, 0xd1, 0xab, 0x14, 0xe8, 0x16, 0x74,
+	0x85, 0x4c, 0x59, 0x29, 0xe3, 0x02, 0xcb, 0x99, 0xb7, 0xa9, 0xab, 0x83, 0x81, 0x26, 0x58, 0xce,
+	0x2c, 0x81, 0x70, 0x6e, 0x08, 0x5b, 0x15, 0x81, 0x70, 0xae, 0x09, 0x23, 0x68, 0x92, 0x7c, 0xe1,
+	0xb5, 0x74, 0x93, 0xea, 0x53, 0xf5, 0x5d, 0x0a, 0xc2, 0xbd, 0xb6, 0xe6, 0xea, 0x6f, 0x74, 0x13,
+	0x3a, 0x12, 0x8b, 0x8b, 0x38, 0xa5, 0xdc, 0xeb, 0x68, 0xbc, 0xad, 0xe2, 0x63, 0xca, 0xd1, 0x6d,
+	0x18, 0xba, 0x7e, 0xe2, 0x8c, 0xce, 0xa9, 0x14, 0xde, 0xf6, 0x6e, 0x63, 0xdc, 0x89, 0x06, 0x0e,
+	0x3e, 0xd1, 0x28, 0xda, 0x87, 0xeb, 0xcf, 0xb1, 0xa0, 0x49, 0x5c, 0x70, 0x96, 0x10, 0x21, 0xe2,
+	0x64, 0xca, 0x59, 0x59, 0x78, 0xa0, 0xd9, 0x48, 0xff, 0x36, 0x31, 0x3f, 0x1d, 0xe9, 0x5f, 0xd0,
+	0x31, 0xb4, 0xe6, 0xac, 0xcc, 0xa5, 0xf0, 0xba, 0xbb, 0xcd, 0x71, 0xf7, 0xe0, 0x4e, 0xcd, 0x51,
+	0x3d, 0x51, 0xa2, 0xc8, 0x6a, 0xd1, 0xd7, 0xd0, 0x4e, 0xc9, 0x82, 0xaa, 0x89, 0xf7, 0x74, 0x9a,
+	0x4f, 0x6a, 0xa6, 0x39, 0xd6, 0xaa, 0xc8, 0xa9, 0xd1, 0x0c, 0xae, 0xe5, 0x44, 0xbe, 0x60, 0xfc,
+	0x22, 0xa6, 0x82, 0x65, 0x58, 0x52, 0x96, 0x7b, 0x7d, 0xbd, 0x89, 0x9f, 0xd5, 0x4c, 0x79, 0x6a,
+	0xf4, 0x8f, 0x9d, 0xfc, 0xac, 0x20, 0x49, 0x34, 0xca, 0xaf, 0xa0, 0x28, 0x80, 0x7e, 0xce, 0xe2,
+	0x82, 0x2e, 0x98, 0x8c, 0x39, 0x63, 0xd2, 0x1b, 0xe8, 0x19, 0x75, 0x73, 0x36, 0x51, 0x58, 0xc4,
+	0x98, 0x44, 0x63, 0x18, 0xa5, 0xe4, 0x1c, 0x97, 0x99, 0x8c, 0x0b, 0x9a, 0xc6, 0x73, 0x96, 0x12,
+	0x6f, 0xa8, 0xb7, 0x66, 0x60, 0xf1, 0x09, 0x4d, 0x9f, 0xb0, 0x94, 0x2c, 0x33, 0x69, 0x91, 0x18,
+	0xe6, 0x68, 0x85, 0xf9, 0xb8, 0x48, 0x34, 0xf3, 0x03, 0xe8, 0x27, 0x45, 0x29, 0x88, 0x74, 0x7b,
+	0x73, 0x4d, 0xd3, 0x7a, 0x06, 0xb4, 0xbb, 0xf2, 0x1e, 0x00, 0xce, 0x32, 0xf6, 0x22, 0x4e, 0x70,
+	0x21, 0x3c, 0xa4, 0x0f, 0xce, 0xb6, 0x46, 0x8e, 0x70, 0x21, 0x50, 0x00, 0xbd, 0x04, 0x17, 0xf8,
+	0x39, 0xcd, 0xa8, 0xa4, 0x44, 0x78, 0xff, 0xd7, 0x84, 0x15, 0x4c, 0x9d, 0x19, 0x41, 0x92, 0x84,
+	0xcd, 0x0b, 0x75, 0x18, 0xce, 0x69, 0x46, 0xbc, 0xeb, 0xa6, 0x21, 0x0b, 0x4f, 0x0c, 0x8a, 0x9e,
+	0xc1, 0x40, 0x9d, 0xbf, 0x38, 0xc7, 0x73, 0x22, 0x0a, 0x9c, 0x10, 0xef, 0x9d, 0xb5, 0x2e, 0xcd,
+	0xe3, 0xe3, 0x27, 0xb8, 0x28, 0x68, 0x3e, 0x8d, 0xfa, 0x2a, 0xcf, 0xa9, 0x4b, 0xa3, 0x56, 0xaa,
+	0x06, 0x7b, 0x2e, 0xe2, 0x0c, 0xbf, 0x24, 0x5c, 0x78, 0x3b, 0xa6, 0x4d, 0x03, 0x9e, 0x68, 0x0c,
+	0xf9, 0xd0, 0xc9, 0x70, 0x9e, 0x66, 0x2c, 0xb9, 0xf0, 0x6e, 0xe8, 0x1d, 0xa8, 0x62, 0xb4, 0x03,
+	0xad, 0x32, 0x5f, 0x10, 0x9a, 0x79, 0x9e, 0x56, 0xda, 0x28, 0xf8, 0x01, 0x06, 0xce, 0x18, 0x44,
+	0xc1, 0x72, 0x41, 0xd0, 0x29, 0xb4, 0xed, 0x89, 0xd7, 0xee, 0xd0, 0x3d, 0xb8, 0x17, 0xd6, 0xb3,
+	0xaa, 0xd0, 0xde, 0x86, 0x33, 0x89, 0x25, 0x89, 0x5c, 0x92, 0xa0, 0x0f, 0xdd, 0x67, 0x98, 0x4a,
+	0x6b, 0x3c, 0xc1, 0xf7, 0xd0, 0x33, 0xe1, 0x7f, 0x54, 0xee, 0x04, 0x86, 0x67, 0xb3, 0x52, 0xa6,
+	0xec, 0x45, 0xee, 0xbc, 0x6e, 0x07, 0x5a, 0x82, 0x4e, 0x73, 0x9c, 0x59, 0xbb, 0xb3, 0x11, 0x7a,
+	0x1f, 0x7a, 0x53, 0x8e, 0x13, 0x12, 0x17, 0x84, 0x53, 0x96, 0x7a, 0x1b, 0xbb, 0x8d, 0x71, 0x33,
+	0xea, 0x6a, 0x6c, 0xa2, 0xa1, 0x00, 0xc1, 0xe8, 0x55, 0x36, 0xd3, 0x71, 0x30, 0x83, 0x9d, 0x6f,
+	0x8a, 0x54, 0x15, 0xad, 0x2c, 0xce, 0x16, 0x5a, 0xb1, 0xcb, 0xc6, 0xbf, 0xb6, 0xcb, 0xe0, 0x26,
+	0xdc, 0x78, 0xad, 0x92, 0x6d, 0x62, 0x00, 0xbd, 0x09, 0x2e, 0x05, 0x71, 0x63, 0x1d, 0x42, 0xdf,
+	0xc6, 0x96, 0x30, 0x84, 0x7e, 0x44, 0x44, 0x39, 0xaf, 0x18, 0x23, 0x18, 0x38, 0xc0, 0x52, 0x46,
+	0x30, 0xf8, 0x96, 0x70, 0x41, 0x99, 0x9b, 0x54, 0xf0, 0x31, 0x0c, 0x2b, 0xc4, 0xee, 0x8f, 0x07,
+	0xed, 0x85, 0x81, 0xec, 0xf4, 0x5c, 0x18, 0x7c, 0x04, 0x3d, 0x35, 0xfb, 0x6a, 0xf5, 0x3e, 0x74,
+	0x68, 0x2e, 0x09, 0x5f, 0xd8, 0x41, 0x37, 0xa3, 0x2a, 0x0e, 0x9e, 0x41, 0xdf, 0x72, 0x6d, 0xda,
+	0xaf, 0x60, 0x4b, 0x28, 0x60, 0xcd, 0x31, 0x3d, 0xc5, 0xe2, 0xc2, 0x24, 0x32, 0xf2, 0xe0, 0x36,
+	0xf4, 0xcf, 0xf4, 0x6e, 0xbe, 0x79, 0xb3, 0xb7, 0xdc, 0x66, 0xab, 0xc5, 0x3a, 0xa2, 0x5d, 0xfe,
+	0x05, 0x74, 0x1f, 0x5d, 0x92, 0xc4, 0x09, 0x0f, 0xa1, 0x93, 0x12, 0x9c, 0x66, 0x34, 0x27, 0xb6,
+	0x29, 0x3f, 0x34, 0x6f, 0x6f, 0xe8, 0xde, 0xde, 0xf0, 0xa9, 0x7b, 0x7b, 0xa3, 0x8a, 0xeb, 0x5e,
+	0xd2, 0x8d, 0xd7, 0x5f, 0xd2, 0xe6, 0xab, 0x97, 0x34, 0x38, 0x82, 0x9e, 0x29, 0x66, 0xd7, 0xbf,
+	0x03, 0x2d, 0x56, 0xca, 0xa2, 0x94, 0xba, 0x56, 0x2f, 0xb2, 0x11, 0x7a, 0x17, 0xb6, 0xc9, 0x25,
+	0x95, 0x71, 0xa2, 0x5c, 0x6f, 0x43, 0xaf, 0xa0, 0xa3, 0x80, 0x23, 0x96, 0x92, 0xe0, 0x97, 0x06,
+	0xf4, 0x96, 0x4f, 0xbd, 0xaa, 0x5d, 0xd0, 0xd4, 0xae, 0x54, 0x7d, 0xfe, 0xad, 0x7e, 0x69, 0x36,
+	0xcd, 0xe5, 0xd9, 0xa0, 0x10, 0x36, 0xd5, 0x7f, 0x15, 0xde, 0xe6, 0x3f, 0x2e, 0x5b, 0xf3, 0x0e,
+	0x7e, 0xed, 0x42, 0xe7, 0x91, 0xbd, 0x8c, 0xe8, 0x25, 0xb4, 0x8c, 0x83, 0xa0, 0xfb, 0x75, 0x6f,
+	0xee, 0xca, 0xbf, 0x22, 0xfe, 0xe1, 0xba, 0x32, 0xbb, 0x7f, 0xff, 0x43, 0x02, 0x36, 0x95, 0x97,
+	0xa0, 0xbb, 0x75, 0x33, 0x2c, 0x19, 0x91, 0x7f, 0x6f, 0x3d, 0x51, 0x55, 0xf4, 0x67, 0xe8, 0x38,
+	0x4b, 0x40, 0x0f, 0xea, 0xe6, 0xb8, 0x62, 0x49, 0xfe, 0xa7, 0xeb, 0x0b, 0xab, 0x06, 0x7e, 0x6b,
+	0xc0, 0xf0, 0x8a, 0x2d, 0xa0, 0xcf, 0xeb, 0xe6, 0x7b, 0xb3, 0x73, 0xf9, 0x0f, 0xdf, 0x5a, 0x5f,
+	0xb5, 0xb5, 0x80, 0x2d, 0xed, 0x40, 0xa8, 0xbe, 0x81, 0x2f, 0x19, 0x98, 0x7f, 0x7f, 0x4d, 0x55,
+	0x55, 0xf7, 0x25, 0xb4, 0x8c, 0xaf, 0xd5, 0x3f, 0x7f, 0x2b, 0xc6, 0xe8, 0x1f, 0xae, 0x2b, 0xab,
+	0x4a, 0xff, 0x04, 0x6d, 0x6b, 0x97, 0xa8, 0x76, 0x92, 0x55, 0xc7, 0xf5, 0x1f, 0xac, 0xad, 0xab,
+	0xaa, 0x5f, 0xc2, 0x96, 0xb6, 0xc2, 0xfa, 0x03, 0x5f, 0xb6, 0x6b, 0xff, 0xfe, 0x9a, 0x2a, 0x57,
+	0x77, 0xbf, 0xa1, 0x46, 0x6e, 0xbc, 0xb4, 0xfe, 0xc8, 0x57, 0x4c, 0xda, 0x3f, 0x5c, 0x57, 0xb6,
+	0x7c, 0xe5, 0x95, 0xf3, 0xd4, 0xbf, 0xf2, 0x4b, 0x16, 0xef, 0xdf, 0x5b, 0x4f, 0x54, 0x15, 0xfd,
+	0xa3, 0x01, 0x7d, 0x05, 0x9d, 0x49, 0x4e, 0xf0, 0x9c, 0xe6, 0x53, 0xf4, 0xb0, 0xe6, 0x7b, 0xa5,
+	0x54, 0xe6, 0xcd, 0xb2, 0x4a, 0xd7, 0xca, 0x17, 0x6f, 0x9f, 0xc0, 0xb5, 0x35, 0x6e, 0xec, 0x37,
+	0xbe, 0x6c, 0x7f, 0xb7, 0x65, 0x6c, 0xba, 0xa5, 0xff, 0xdc, 0xfd, 0x73, 0x00, 0x14, 0x81, 0x53,
+	0xcd, 0x86, 0x0e, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -960,6 +1137,8 @@ type ExecutorClient interface {
 	Wait(ctx context.Context, in *WaitRequest, opts ...grpc.CallOption) (*WaitResponse, error)
 	Shutdown(ctx context.Context, in *ShutdownRequest, opts ...grpc.CallOption) (*ShutdownResponse, error)
 	UpdateResources(ctx context.Context, in *UpdateResourcesRequest, opts ...grpc.CallOption) (*UpdateResourcesResponse, error)
+	Pause(ctx context.Context, in *PauseRequest, opts ...grpc.CallOption) (*PauseResponse, error)
+	Resume(ctx context.Context, in *ResumeRequest, opts ...grpc.CallOption) (*ResumeResponse, error)
 	Version(ctx context.Context, in *VersionRequest, opts ...grpc.CallOption) (*VersionResponse, error)
 	Stats(ctx context.Context, in *StatsRequest, opts ...grpc.CallOption) (Executor_StatsClient, error)
 	Signal(ctx context.Context, in *SignalRequest, opts ...grpc.CallOption) (*SignalResponse, error)
@@ -1012,6 +1191,24 @@ func (c *executorClient) UpdateResources(ctx context.Context, in *UpdateResource
 	return out, nil
 }
 
+func (c *executorClient) Pause(ctx context.Context, in *PauseRequest, opts ...grpc.CallOption) (*PauseResponse, error) {
+	out := new(PauseResponse)
+	err := c.cc.Invoke(ctx, "/hashicorp.nomad.plugins.executor.proto.Executor/Pause", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *executorClient) Resume(ctx context.Context, in *ResumeRequest, opts ...grpc.CallOption) (*ResumeResponse, error) {
+	out := new(ResumeResponse)
+	err := c.cc.Invoke(ctx, "/hashicorp.nomad.plugins.executor.proto.Executor/Resume", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *executorClient) Version(ctx context.Context, in *VersionRequest, opts ...grpc.CallOption) (*VersionResponse, error) {
 	out := new(VersionResponse)
 	err := c.cc.Invoke(ctx, "/hashicorp.nomad.plugins.executor.proto.Executor/Version", in, out, opts...)
@@ -1108,6 +1305,8 @@ type ExecutorServer interface {
 	Wait(context.Context, *WaitRequest) (*WaitResponse, error)
 	Shutdown(context.Context, *ShutdownRequest) (*ShutdownResponse, error)
 	UpdateResources(context.Context, *UpdateResourcesRequest) (*UpdateResourcesResponse, error)
+	Pause(context.Context, *PauseRequest) (*PauseResponse, error)
+	Resume(context.Context, *ResumeRequest) (*ResumeResponse, error)
 	Version(context.Context, *VersionRequest) (*VersionResponse, error)
 	Stats(*StatsRequest, Executor_StatsServer) error
 	Signal(context.Context, *SignalRequest) (*SignalResponse, error)
@@ -1132,6 +1331,12 @@ func (*UnimplementedExecutorServer) Shutdown(ctx context.Context, req *ShutdownR
 func (*UnimplementedExecutorServer) UpdateResources(ctx context.Context, req *UpdateResourcesRequest) (*UpdateResourcesResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UpdateResources not implemented")
 }
+func (*UnimplementedExecutorServer) Pause(ctx context.Context, req *PauseRequest) (*PauseResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Pause not implemented")
+}
+func (*UnimplementedExecutorServer) Resume(ctx context.Context, req *ResumeRequest) (*ResumeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Resume not implemented")
+}
 func (*UnimplementedExecutorServer) Version(ctx context.Context, req *VersionRequest) (*VersionResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Version not implemented")
 }
@@ -1224,6 +1429,42 @@ func _Executor_UpdateResources_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Executor_Pause_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PauseRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ExecutorServer).Pause(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/hashicorp.nomad.plugins.executor.proto.Executor/Pause",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ExecutorServer).Pause(ctx, req.(*PauseRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Executor_Resume_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ResumeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ExecutorServer).Resume(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/hashicorp.nomad.plugins.executor.proto.Executor/Resume",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ExecutorServer).Resume(ctx, req.(*ResumeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Executor_Version_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(VersionRequest)
 	if err := dec(in); err != nil {
@@ -1345,6 +1586,14 @@ var _Executor_serviceDesc = grpc.ServiceDesc{
 			MethodName: "UpdateResources",
 			Handler:    _Executor_UpdateResources_Handler,
 		},
+		{
+			MethodName: "Pause",
+			Handler:    _Executor_Pause_Handler,
+		},
+		{
+			MethodName: "Resume",
+			Handler:    _Executor_Resume_Handler,
+		},
 		{
 			MethodName: "Version",
 			Handler:    _Executor_Version_Handler,
@@ -3,6 +3,7 @@ package executor
 import (
 	"encoding/json"
 	"fmt"
+	"math"
 	"os"
 	"os/exec"
 
@@ -11,6 +12,7 @@ import (
 	plugin "github.com/hashicorp/go-plugin"
 	"github.com/hashicorp/nomad/drivers/shared/executor/proto"
 	"github.com/hashicorp/nomad/plugins/base"
+	"github.com/hashicorp/nomad/plugins/drivers"
 )
 
 const (
@@ -136,3 +138,45 @@ func IsolationMode(plugin, task string) string {
 	}
 	return plugin
 }
+
+// UserNamespaceIDsPerAlloc is the number of host user and group IDs the
+// client gives to the user namespace of each allocation.
+const UserNamespaceIDsPerAlloc = 65536
+
+// UserNamespaceConfig is the agent plugin configuration of the range of host
+// user and group IDs the tasks of exec-based task drivers are mapped to.
+type UserNamespaceConfig struct {
+	UIDStart uint32 `codec:"uid_start"`
+	GIDStart uint32 `codec:"gid_start"`
+	Size     uint32 `codec:"size"`
+}
+
+// Validate returns an error if the range cannot be given to allocations.
+func (c *UserNamespaceConfig) Validate() error {
+	if c == nil {
+		return nil
+	}
+	if c.UIDStart == 0 || c.GIDStart == 0 {
+		return fmt.Errorf("uid_start and gid_start must be greater than 0")
+	}
+	if c.Size < UserNamespaceIDsPerAlloc {
+		return fmt.Errorf("size must be at least %d, got %d", UserNamespaceIDsPerAlloc, c.Size)
+	}
+	if uint64(c.UIDStart)+uint64(c.Size) > math.MaxUint32 || uint64(c.GIDStart)+uint64(c.Size) > math.MaxUint32 {
+		return fmt.Errorf("range of %d IDs overflows the ID space", c.Size)
+	}
+	return nil
+}
+
+// IDMapping returns the range as the user namespace IDs capability of a
+// driver.
+func (c *UserNamespaceConfig) IDMapping() *drivers.IDMapping {
+	if c == nil {
+		return nil
+	}
+	return &drivers.IDMapping{
+		UIDStart: c.UIDStart,
+		GIDStart: c.GIDStart,
+		Size:     c.Size,
+	}
+}
@@ -0,0 +1,29 @@
+package executor
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+)
+
+// Install the cli handler of the Landlock sandbox process, which the executor
+// runs the commands of sandboxed tasks through.
+func init() {
+	if len(os.Args) > 1 && os.Args[1] == LandlockCommand {
+		if len(os.Args) < 4 {
+			fmt.Fprintln(os.Stderr, "landlock configuration and command not provided")
+			os.Exit(1)
+		}
+
+		var config landlockConfig
+		if err := json.Unmarshal([]byte(os.Args[2]), &config); err != nil {
+			fmt.Fprintf(os.Stderr, "failed to read landlock configuration: %v\n", err)
+			os.Exit(1)
+		}
+
+		// landlockExec only returns if the task could not be started
+		err := landlockExec(&config, os.Args[3], os.Args[4:])
+		fmt.Fprintf(os.Stderr, "failed to sandbox task: %v\n", err)
+		os.Exit(1)
+	}
+}
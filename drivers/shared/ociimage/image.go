@@ -0,0 +1,527 @@
+// Package ociimage unpacks the layers of OCI and Docker images into a store
+// shared by the tasks which run from them.
+package ociimage
+
+import (
+	"archive/tar"
+	"bufio"
+	"bytes"
+	"compress/gzip"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"net/url"
+	"os"
+	"path"
+	"path/filepath"
+	"runtime"
+	"sync"
+
+	multierror "github.com/hashicorp/go-multierror"
+	digest "github.com/opencontainers/go-digest"
+	v1 "github.com/opencontainers/image-spec/specs-go/v1"
+)
+
+const (
+	// layoutIndex is the entry point of an OCI image layout
+	layoutIndex = "index.json"
+
+	// dockerManifest is the entry point of a `docker save` archive
+	dockerManifest = "manifest.json"
+
+	// Media types of the Docker image manifests, which OCI image indexes may
+	// reference
+	mediaTypeDockerManifest     = "application/vnd.docker.distribution.manifest.v2+json"
+	mediaTypeDockerManifestList = "application/vnd.docker.distribution.manifest.list.v2+json"
+)
+
+var (
+	gzipMagic = []byte{0x1f, 0x8b}
+	zstdMagic = []byte{0x28, 0xb5, 0x2f, 0xfd}
+)
+
+// Store is a directory of unpacked image layers. Layers are addressed by the
+// digest of their uncompressed content, so that images sharing layers and
+// the tasks running from them share their directories. Layers are referenced
+// by the owners, such as tasks, they are unpacked for, and removed once they
+// are no longer referenced.
+type Store struct {
+	dir string
+}
+
+// storeLock serializes updating the references of the stores and removing
+// their unreferenced layers.
+var storeLock sync.Mutex
+
+// NewStore returns the store of image layers in dir.
+func NewStore(dir string) *Store {
+	return &Store{dir: dir}
+}
+
+// Unpack returns the directories of the layers of the image at path, from
+// the lowest layer to the top one. Layers not already in the store are
+// unpacked into it. The layers are referenced by owner, replacing the layers
+// it referenced before, until it is released. The image is either an OCI image
+// layout or a `docker save` archive, as a directory or an uncompressed or
+// gzipped tarball.
+func (s *Store) Unpack(owner, path string) ([]string, error) {
+	fsys, err := openImage(path)
+	if err != nil {
+		return nil, err
+	}
+
+	img, err := readImage(fsys)
+	if err != nil {
+		return nil, err
+	}
+
+	// The layers are referenced before being unpacked, so that they aren't
+	// removed while unpacking them for another owner
+	if err := s.reference(owner, img.layers); err != nil {
+		return nil, fmt.Errorf("failed to reference layers: %w", err)
+	}
+
+	dirs := make([]string, 0, len(img.layers))
+	for _, l := range img.layers {
+		dir, err := s.unpackLayer(fsys, l)
+		if err != nil {
+			_ = s.Release(owner)
+			return nil, fmt.Errorf("failed to unpack layer %s: %w", l.diffID, err)
+		}
+		dirs = append(dirs, dir)
+	}
+	return dirs, nil
+}
+
+// Release removes the references of owner, and the layers which are no
+// longer referenced by any owner.
+func (s *Store) Release(owner string) error {
+	storeLock.Lock()
+	defer storeLock.Unlock()
+
+	err := os.Remove(s.refPath(owner))
+	if errors.Is(err, fs.ErrNotExist) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	return s.removeUnreferenced()
+}
+
+// reference records the layers as referenced by owner.
+func (s *Store) reference(owner string, layers []*layer) error {
+	storeLock.Lock()
+	defer storeLock.Unlock()
+
+	diffIDs := make([]digest.Digest, 0, len(layers))
+	for _, l := range layers {
+		diffIDs = append(diffIDs, l.diffID)
+	}
+	b, err := json.Marshal(diffIDs)
+	if err != nil {
+		return err
+	}
+
+	refPath := s.refPath(owner)
+	if err := os.MkdirAll(filepath.Dir(refPath), 0711); err != nil {
+		return err
+	}
+	tmp := refPath + ".tmp"
+	if err := os.WriteFile(tmp, b, 0600); err != nil {
+		return err
+	}
+	return os.Rename(tmp, refPath)
+}
+
+// removeUnreferenced removes the layers which aren't referenced by any owner.
+// The store lock must be held.
+func (s *Store) removeUnreferenced() error {
+	refsDir := filepath.Join(s.dir, "refs")
+	entries, err := os.ReadDir(refsDir)
+	if err != nil && !errors.Is(err, fs.ErrNotExist) {
+		return err
+	}
+
+	referenced := map[string]struct{}{}
+	for _, e := range entries {
+		if filepath.Ext(e.Name()) == ".tmp" {
+			continue
+		}
+		b, err := os.ReadFile(filepath.Join(refsDir, e.Name()))
+		if err != nil {
+			return err
+		}
+		var diffIDs []digest.Digest
+		if err := json.Unmarshal(b, &diffIDs); err != nil {
+			return fmt.Errorf("failed to decode references %s: %w", e.Name(), err)
+		}
+		for _, d := range diffIDs {
+			referenced[s.layerDir(d)] = struct{}{}
+		}
+	}
+
+	layers, err := filepath.Glob(filepath.Join(s.dir, "layers", "*", "*"))
+	if err != nil {
+		return err
+	}
+	var mErr *multierror.Error
+	for _, dir := range layers {
+		if _, ok := referenced[dir]; ok {
+			continue
+		}
+		if err := os.RemoveAll(dir); err != nil {
+			mErr = multierror.Append(mErr, err)
+		}
+	}
+	return mErr.ErrorOrNil()
+}
+
+// refPath returns the path of the file listing the layers referenced by
+// owner.
+func (s *Store) refPath(owner string) string {
+	return filepath.Join(s.dir, "refs", url.PathEscape(owner))
+}
+
+// layerDir returns the directory of the layer with the digest diffID.
+func (s *Store) layerDir(diffID digest.Digest) string {
+	return filepath.Join(s.dir, "layers", diffID.Algorithm().String(), diffID.Encoded())
+}
+
+// unpackLayer unpacks the layer into the store, unless it is already, and
+// returns its directory.
+func (s *Store) unpackLayer(fsys fs.FS, l *layer) (string, error) {
+	dir := s.layerDir(l.diffID)
+	if _, err := os.Stat(dir); err == nil {
+		return dir, nil
+	}
+
+	// Layers are unpacked in a temporary directory moved into place once
+	// complete, so that tasks never see a partially unpacked layer
+	tmpDir := filepath.Join(s.dir, "tmp")
+	for _, d := range []string{s.dir, tmpDir, filepath.Dir(dir)} {
+		if err := os.MkdirAll(d, 0711); err != nil {
+			return "", err
+		}
+	}
+	tmp, err := os.MkdirTemp(tmpDir, "layer-")
+	if err != nil {
+		return "", err
+	}
+	defer os.RemoveAll(tmp)
+	if err := os.Chmod(tmp, 0755); err != nil {
+		return "", err
+	}
+
+	f, err := fsys.Open(l.path)
+	if err != nil {
+		return "", err
+	}
+	defer f.Close()
+
+	r, err := decompress(f)
+	if err != nil {
+		return "", err
+	}
+
+	verifier := l.diffID.Verifier()
+	tr := io.TeeReader(r, verifier)
+	if err := extractLayer(tr, tmp); err != nil {
+		return "", err
+	}
+
+	// Read the padding at the end of the archive, which is part of the
+	// content the digest is computed from
+	if _, err := io.Copy(io.Discard, tr); err != nil {
+		return "", err
+	}
+	if !verifier.Verified() {
+		return "", errors.New("content does not match the digest of the layer")
+	}
+
+	if err := os.Rename(tmp, dir); err != nil {
+		// Another task may have unpacked the same layer concurrently
+		if _, statErr := os.Stat(dir); statErr == nil {
+			return dir, nil
+		}
+		return "", err
+	}
+	return dir, nil
+}
+
+// image is the part of the manifest and configuration of an image needed to
+// unpack it.
+type image struct {
+	layers []*layer
+}
+
+// layer is a layer of an image.
+type layer struct {
+	// path is the path of the layer archive in the image
+	path string
+
+	// diffID is the digest of the uncompressed layer archive
+	diffID digest.Digest
+}
+
+// readImage reads the image in fsys.
+func readImage(fsys fs.FS) (*image, error) {
+	if _, err := fs.Stat(fsys, layoutIndex); err == nil {
+		return readLayout(fsys)
+	}
+	if _, err := fs.Stat(fsys, dockerManifest); err == nil {
+		return readDockerArchive(fsys)
+	}
+	return nil, fmt.Errorf("neither an OCI image layout nor a docker archive: %s or %s not found", layoutIndex, dockerManifest)
+}
+
+// readLayout reads the image of an OCI image layout.
+func readLayout(fsys fs.FS) (*image, error) {
+	var index v1.Index
+	if err := readJSON(fsys, layoutIndex, &index); err != nil {
+		return nil, err
+	}
+
+	desc, err := selectManifest(fsys, index.Manifests)
+	if err != nil {
+		return nil, err
+	}
+
+	var manifest v1.Manifest
+	if err := readBlob(fsys, desc.Digest, &manifest); err != nil {
+		return nil, fmt.Errorf("failed to read manifest: %w", err)
+	}
+
+	var config v1.Image
+	if err := readBlob(fsys, manifest.Config.Digest, &config); err != nil {
+		return nil, fmt.Errorf("failed to read configuration: %w", err)
+	}
+
+	paths := make([]string, 0, len(manifest.Layers))
+	for _, l := range manifest.Layers {
+		p, err := blobPath(l.Digest)
+		if err != nil {
+			return nil, err
+		}
+		paths = append(paths, p)
+	}
+	return newImage(&config, paths)
+}
+
+// selectManifest returns the descriptor of the manifest of the image for the
+// platform of the client among descs, following nested indexes.
+func selectManifest(fsys fs.FS, descs []v1.Descriptor) (*v1.Descriptor, error) {
+	var desc *v1.Descriptor
+	switch len(descs) {
+	case 0:
+		return nil, errors.New("image index has no manifests")
+	case 1:
+		desc = &descs[0]
+	default:
+		for i, d := range descs {
+			if d.Platform != nil && d.Platform.OS == "linux" && d.Platform.Architecture == runtime.GOARCH {
+				desc = &descs[i]
+				break
+			}
+		}
+		if desc == nil {
+			return nil, fmt.Errorf("image index has no manifest for linux/%s", runtime.GOARCH)
+		}
+	}
+
+	switch desc.MediaType {
+	case v1.MediaTypeImageManifest, mediaTypeDockerManifest:
+		return desc, nil
+	case v1.MediaTypeImageIndex, mediaTypeDockerManifestList:
+		var index v1.Index
+		if err := readBlob(fsys, desc.Digest, &index); err != nil {
+			return nil, fmt.Errorf("failed to read image index: %w", err)
+		}
+		return selectManifest(fsys, index.Manifests)
+	default:
+		return nil, fmt.Errorf("unsupported manifest media type %q", desc.MediaType)
+	}
+}
+
+// dockerArchiveImage is an image listed in the manifest of a `docker save`
+// archive.
+type dockerArchiveImage struct {
+	Config string
+	Layers []string
+}
+
+// readDockerArchive reads the image of a `docker save` archive.
+func readDockerArchive(fsys fs.FS) (*image, error) {
+	var manifest []dockerArchiveImage
+	if err := readJSON(fsys, dockerManifest, &manifest); err != nil {
+		return nil, err
+	}
+	if len(manifest) != 1 {
+		return nil, fmt.Errorf("docker archive must contain exactly one image, found %d", len(manifest))
+	}
+
+	var config v1.Image
+	if err := readJSON(fsys, path.Clean(manifest[0].Config), &config); err != nil {
+		return nil, fmt.Errorf("failed to read configuration: %w", err)
+	}
+
+	paths := make([]string, 0, len(manifest[0].Layers))
+	for _, p := range manifest[0].Layers {
+		paths = append(paths, path.Clean(p))
+	}
+	return newImage(&config, paths)
+}
+
+// newImage returns the image of the configuration and the paths of its
+// layers.
+func newImage(config *v1.Image, paths []string) (*image, error) {
+	if config.OS != "" && config.OS != "linux" {
+		return nil, fmt.Errorf("image is built for %s, not linux", config.OS)
+	}
+	if config.Architecture != "" && config.Architecture != runtime.GOARCH {
+		return nil, fmt.Errorf("image is built for the %s architecture, not %s", config.Architecture, runtime.GOARCH)
+	}
+
+	diffIDs := config.RootFS.DiffIDs
+	if len(diffIDs) != len(paths) {
+		return nil, fmt.Errorf("image has %d layers but its configuration lists %d", len(paths), len(diffIDs))
+	}
+
+	img := &image{layers: make([]*layer, 0, len(paths))}
+	for i, p := range paths {
+		if !fs.ValidPath(p) {
+			return nil, fmt.Errorf("invalid layer path %q", p)
+		}
+		if err := diffIDs[i].Validate(); err != nil {
+			return nil, fmt.Errorf("invalid layer digest %q: %w", diffIDs[i], err)
+		}
+		img.layers = append(img.layers, &layer{
+			path:   p,
+			diffID: diffIDs[i],
+		})
+	}
+	return img, nil
+}
+
+// blobPath returns the path of the blob with the digest d in an OCI image
+// layout.
+func blobPath(d digest.Digest) (string, error) {
+	if err := d.Validate(); err != nil {
+		return "", fmt.Errorf("invalid digest %q: %w", d, err)
+	}
+	return path.Join("blobs", d.Algorithm().String(), d.Encoded()), nil
+}
+
+// readBlob decodes the JSON blob with the digest d of an OCI image layout
+// into v, after verifying its content.
+func readBlob(fsys fs.FS, d digest.Digest, v interface{}) error {
+	p, err := blobPath(d)
+	if err != nil {
+		return err
+	}
+	b, err := fs.ReadFile(fsys, p)
+	if err != nil {
+		return err
+	}
+	if d.Algorithm().FromBytes(b) != d {
+		return fmt.Errorf("content of blob %s does not match its digest", d)
+	}
+	return json.Unmarshal(b, v)
+}
+
+// readJSON decodes the JSON file at p into v.
+func readJSON(fsys fs.FS, p string, v interface{}) error {
+	b, err := fs.ReadFile(fsys, p)
+	if err != nil {
+		return err
+	}
+	if err := json.Unmarshal(b, v); err != nil {
+		return fmt.Errorf("failed to decode %s: %w", p, err)
+	}
+	return nil
+}
+
+// decompress returns the uncompressed content of the layer archive r.
+func decompress(r io.Reader) (io.Reader, error) {
+	br := bufio.NewReader(r)
+	magic, err := br.Peek(len(zstdMagic))
+	if err != nil && err != io.EOF {
+		return nil, err
+	}
+
+	switch {
+	case bytes.HasPrefix(magic, gzipMagic):
+		return gzip.NewReader(br)
+	case bytes.HasPrefix(magic, zstdMagic):
+		return nil, errors.New("zstd compressed layers are not supported")
+	default:
+		return br, nil
+	}
+}
+
+// openImage returns the file system of the image at p, which is either a
+// directory or a tarball.
+func openImage(p string) (fs.FS, error) {
+	fi, err := os.Stat(p)
+	if err != nil {
+		return nil, err
+	}
+	if fi.IsDir() {
+		return os.DirFS(p), nil
+	}
+	return &tarFS{path: p}, nil
+}
+
+// tarFS is the file system of a tarball. Each file is opened by scanning the
+// tarball from its start, which is cheap for the few files of an image.
+type tarFS struct {
+	path string
+}
+
+func (t *tarFS) Open(name string) (fs.File, error) {
+	if !fs.ValidPath(name) {
+		return nil, &fs.PathError{Op: "open", Path: name, Err: fs.ErrInvalid}
+	}
+
+	f, err := os.Open(t.path)
+	if err != nil {
+		return nil, err
+	}
+
+	r, err := decompress(f)
+	if err != nil {
+		f.Close()
+		return nil, err
+	}
+
+	tr := tar.NewReader(r)
+	for {
+		hdr, err := tr.Next()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			f.Close()
+			return nil, fmt.Errorf("failed to read %s: %w", t.path, err)
+		}
+		if hdr.Typeflag == tar.TypeReg && path.Clean(hdr.Name) == name {
+			return &tarFile{Reader: tr, f: f, hdr: hdr}, nil
+		}
+	}
+
+	f.Close()
+	return nil, &fs.PathError{Op: "open", Path: name, Err: fs.ErrNotExist}
+}
+
+// tarFile is a file of a tarFS.
+type tarFile struct {
+	*tar.Reader
+	f   *os.File
+	hdr *tar.Header
+}
+
+func (t *tarFile) Stat() (fs.FileInfo, error) { return t.hdr.FileInfo(), nil }
+
+func (t *tarFile) Close() error { return t.f.Close() }
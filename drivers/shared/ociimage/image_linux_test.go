@@ -0,0 +1,375 @@
+//go:build linux
+
+package ociimage
+
+import (
+	"archive/tar"
+	"bytes"
+	"compress/gzip"
+	"encoding/json"
+	"io"
+	"os"
+	"path/filepath"
+	"runtime"
+	"testing"
+
+	"github.com/hashicorp/nomad/ci"
+	ctestutil "github.com/hashicorp/nomad/client/testutil"
+	digest "github.com/opencontainers/go-digest"
+	"github.com/opencontainers/image-spec/specs-go"
+	v1 "github.com/opencontainers/image-spec/specs-go/v1"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/sys/unix"
+)
+
+// testLayers returns the archives of the layers of a test image.
+func testLayers(t *testing.T) [][]byte {
+	base := buildTar(t, []*tar.Header{
+		{Name: "etc/", Typeflag: tar.TypeDir, Mode: 0755},
+		{Name: "etc/hello", Typeflag: tar.TypeReg, Mode: 0644, Size: 5},
+		{Name: "etc/hello-link", Typeflag: tar.TypeLink, Linkname: "etc/hello"},
+		{Name: "usr/bin/", Typeflag: tar.TypeDir, Mode: 0755},
+		{Name: "usr/bin/tool", Typeflag: tar.TypeReg, Mode: 04755, Size: 5},
+		{Name: "bin", Typeflag: tar.TypeSymlink, Linkname: "usr/bin"},
+		{Name: "var/cache/", Typeflag: tar.TypeDir, Mode: 0755},
+		{Name: "var/cache/old", Typeflag: tar.TypeReg, Mode: 0644, Size: 5},
+	})
+	top := buildTar(t, []*tar.Header{
+		{Name: "etc/.wh.hello", Typeflag: tar.TypeReg, Mode: 0644},
+		{Name: "var/cache/", Typeflag: tar.TypeDir, Mode: 0755},
+		{Name: "var/cache/.wh..wh..opq", Typeflag: tar.TypeReg, Mode: 0644},
+		{Name: "bin/new", Typeflag: tar.TypeReg, Mode: 0755, Size: 5},
+	})
+	return [][]byte{base, top}
+}
+
+// buildTar returns an archive of the entries, with "hello" as the content of
+// regular files.
+func buildTar(t *testing.T, hdrs []*tar.Header) []byte {
+	var buf bytes.Buffer
+	tw := tar.NewWriter(&buf)
+	for _, hdr := range hdrs {
+		require.NoError(t, tw.WriteHeader(hdr))
+		if hdr.Size > 0 {
+			_, err := tw.Write([]byte("hello")[:hdr.Size])
+			require.NoError(t, err)
+		}
+	}
+	require.NoError(t, tw.Close())
+	return buf.Bytes()
+}
+
+// testConfig returns the configuration of an image of the layers.
+func testConfig(t *testing.T, layers [][]byte) []byte {
+	config := v1.Image{
+		OS:           "linux",
+		Architecture: runtime.GOARCH,
+		RootFS:       v1.RootFS{Type: "layers"},
+	}
+	for _, l := range layers {
+		config.RootFS.DiffIDs = append(config.RootFS.DiffIDs, digest.FromBytes(l))
+	}
+	b, err := json.Marshal(config)
+	require.NoError(t, err)
+	return b
+}
+
+// writeLayout writes an OCI image layout of the layers, compressed with gzip,
+// into dir.
+func writeLayout(t *testing.T, dir string, layers [][]byte) {
+	writeBlob := func(b []byte) digest.Digest {
+		d := digest.FromBytes(b)
+		p := filepath.Join(dir, "blobs", d.Algorithm().String(), d.Encoded())
+		require.NoError(t, os.MkdirAll(filepath.Dir(p), 0755))
+		require.NoError(t, os.WriteFile(p, b, 0644))
+		return d
+	}
+	writeJSON := func(v interface{}) (digest.Digest, int64) {
+		b, err := json.Marshal(v)
+		require.NoError(t, err)
+		return writeBlob(b), int64(len(b))
+	}
+
+	configBlob := testConfig(t, layers)
+	manifest := v1.Manifest{
+		Versioned: specs.Versioned{SchemaVersion: 2},
+		MediaType: v1.MediaTypeImageManifest,
+		Config: v1.Descriptor{
+			MediaType: v1.MediaTypeImageConfig,
+			Digest:    writeBlob(configBlob),
+			Size:      int64(len(configBlob)),
+		},
+	}
+	for _, l := range layers {
+		var buf bytes.Buffer
+		zw := gzip.NewWriter(&buf)
+		_, err := zw.Write(l)
+		require.NoError(t, err)
+		require.NoError(t, zw.Close())
+		manifest.Layers = append(manifest.Layers, v1.Descriptor{
+			MediaType: v1.MediaTypeImageLayerGzip,
+			Digest:    writeBlob(buf.Bytes()),
+			Size:      int64(buf.Len()),
+		})
+	}
+	manifestDigest, manifestSize := writeJSON(manifest)
+
+	// The manifest of another platform must not be selected
+	other := v1.Manifest{Versioned: specs.Versioned{SchemaVersion: 2}}
+	otherDigest, otherSize := writeJSON(other)
+
+	index := v1.Index{
+		Versioned: specs.Versioned{SchemaVersion: 2},
+		Manifests: []v1.Descriptor{
+			{
+				MediaType: v1.MediaTypeImageManifest,
+				Digest:    otherDigest,
+				Size:      otherSize,
+				Platform:  &v1.Platform{OS: "windows", Architecture: runtime.GOARCH},
+			},
+			{
+				MediaType: v1.MediaTypeImageManifest,
+				Digest:    manifestDigest,
+				Size:      manifestSize,
+				Platform:  &v1.Platform{OS: "linux", Architecture: runtime.GOARCH},
+			},
+		},
+	}
+	b, err := json.Marshal(index)
+	require.NoError(t, err)
+	require.NoError(t, os.WriteFile(filepath.Join(dir, layoutIndex), b, 0644))
+	require.NoError(t, os.WriteFile(filepath.Join(dir, v1.ImageLayoutFile), []byte(`{"imageLayoutVersion":"1.0.0"}`), 0644))
+}
+
+// writeDockerArchive writes a `docker save` archive of the layers at p.
+func writeDockerArchive(t *testing.T, p string, layers [][]byte) {
+	f, err := os.Create(p)
+	require.NoError(t, err)
+	defer f.Close()
+
+	tw := tar.NewWriter(f)
+	writeFile := func(name string, b []byte) {
+		require.NoError(t, tw.WriteHeader(&tar.Header{
+			Name:     name,
+			Typeflag: tar.TypeReg,
+			Mode:     0644,
+			Size:     int64(len(b)),
+		}))
+		_, err := tw.Write(b)
+		require.NoError(t, err)
+	}
+
+	image := dockerArchiveImage{Config: "config.json"}
+	writeFile(image.Config, testConfig(t, layers))
+	for _, l := range layers {
+		name := digest.FromBytes(l).Encoded() + "/layer.tar"
+		writeFile(name, l)
+		image.Layers = append(image.Layers, name)
+	}
+	b, err := json.Marshal([]dockerArchiveImage{image})
+	require.NoError(t, err)
+	writeFile(dockerManifest, b)
+	require.NoError(t, tw.Close())
+}
+
+func TestStore_Unpack(t *testing.T) {
+	ci.Parallel(t)
+	ctestutil.RequireRoot(t)
+
+	layers := testLayers(t)
+	store := NewStore(t.TempDir())
+
+	layout := t.TempDir()
+	writeLayout(t, layout, layers)
+	dirs, err := store.Unpack("task1", layout)
+	require.NoError(t, err)
+	require.Len(t, dirs, 2)
+
+	// Files, links and modes of the base layer are unpacked
+	b, err := os.ReadFile(filepath.Join(dirs[0], "etc", "hello"))
+	require.NoError(t, err)
+	require.Equal(t, "hello", string(b))
+
+	hello, err := os.Stat(filepath.Join(dirs[0], "etc", "hello"))
+	require.NoError(t, err)
+	link, err := os.Stat(filepath.Join(dirs[0], "etc", "hello-link"))
+	require.NoError(t, err)
+	require.True(t, os.SameFile(hello, link))
+
+	tool, err := os.Stat(filepath.Join(dirs[0], "usr", "bin", "tool"))
+	require.NoError(t, err)
+	require.Equal(t, os.ModeSetuid|0755, tool.Mode())
+
+	target, err := os.Readlink(filepath.Join(dirs[0], "bin"))
+	require.NoError(t, err)
+	require.Equal(t, "usr/bin", target)
+
+	// Whiteouts of the top layer are converted to the ones of overlayfs
+	var st unix.Stat_t
+	require.NoError(t, unix.Lstat(filepath.Join(dirs[1], "etc", "hello"), &st))
+	require.Equal(t, uint32(unix.S_IFCHR), st.Mode&unix.S_IFMT)
+	require.Zero(t, st.Rdev)
+
+	opaque := make([]byte, 1)
+	_, err = unix.Getxattr(filepath.Join(dirs[1], "var", "cache"), "trusted.overlay.opaque", opaque)
+	require.NoError(t, err)
+	require.Equal(t, "y", string(opaque))
+
+	// Files of the top layer created through a symlink of the base layer
+	// are unpacked within the top layer
+	require.FileExists(t, filepath.Join(dirs[1], "bin", "new"))
+
+	// The layers of the same image saved by docker are shared
+	archive := filepath.Join(t.TempDir(), "image.tar")
+	writeDockerArchive(t, archive, layers)
+	dockerDirs, err := store.Unpack("task2", archive)
+	require.NoError(t, err)
+	require.Equal(t, dirs, dockerDirs)
+}
+
+func TestStore_Unpack_DockerArchiveDir(t *testing.T) {
+	ci.Parallel(t)
+	ctestutil.RequireRoot(t)
+
+	// Archives fetched as artifacts are unpacked into a directory
+	layers := testLayers(t)
+	archive := filepath.Join(t.TempDir(), "image.tar")
+	writeDockerArchive(t, archive, layers)
+
+	dir := t.TempDir()
+	f, err := os.Open(archive)
+	require.NoError(t, err)
+	defer f.Close()
+	tr := tar.NewReader(f)
+	for {
+		hdr, err := tr.Next()
+		if err != nil {
+			break
+		}
+		p := filepath.Join(dir, hdr.Name)
+		require.NoError(t, os.MkdirAll(filepath.Dir(p), 0755))
+		b, err := io.ReadAll(tr)
+		require.NoError(t, err)
+		require.NoError(t, os.WriteFile(p, b, 0644))
+	}
+
+	dirs, err := NewStore(t.TempDir()).Unpack("task", dir)
+	require.NoError(t, err)
+	require.Len(t, dirs, 2)
+	require.FileExists(t, filepath.Join(dirs[0], "etc", "hello"))
+}
+
+func TestStore_Unpack_Invalid(t *testing.T) {
+	ci.Parallel(t)
+	ctestutil.RequireRoot(t)
+
+	t.Run("digest mismatch", func(t *testing.T) {
+		layers := testLayers(t)
+		layout := t.TempDir()
+		writeLayout(t, layout, layers)
+
+		// Corrupt the configuration of the image
+		var index v1.Index
+		b, err := os.ReadFile(filepath.Join(layout, layoutIndex))
+		require.NoError(t, err)
+		require.NoError(t, json.Unmarshal(b, &index))
+		var manifest v1.Manifest
+		require.NoError(t, readBlob(os.DirFS(layout), index.Manifests[1].Digest, &manifest))
+		p, err := blobPath(manifest.Config.Digest)
+		require.NoError(t, err)
+		require.NoError(t, os.WriteFile(filepath.Join(layout, p), []byte("{}"), 0644))
+
+		_, err = NewStore(t.TempDir()).Unpack("task", layout)
+		require.ErrorContains(t, err, "does not match its digest")
+
+		// Layers are verified against the digests of the configuration
+		archive := filepath.Join(t.TempDir(), "image.tar")
+		writeDockerArchive(t, archive, layers)
+		config := testConfig(t, [][]byte{layers[1], layers[0]})
+		f, err := os.OpenFile(archive, os.O_RDWR, 0)
+		require.NoError(t, err)
+		b, err = io.ReadAll(f)
+		require.NoError(t, err)
+		require.NoError(t, f.Close())
+		i := bytes.Index(b, testConfig(t, layers))
+		require.Positive(t, i)
+		copy(b[i:], config)
+		require.NoError(t, os.WriteFile(archive, b, 0644))
+
+		_, err = NewStore(t.TempDir()).Unpack("task", archive)
+		require.ErrorContains(t, err, "content does not match the digest of the layer")
+	})
+
+	t.Run("not an image", func(t *testing.T) {
+		_, err := NewStore(t.TempDir()).Unpack("task", t.TempDir())
+		require.ErrorContains(t, err, "neither an OCI image layout nor a docker archive")
+	})
+
+	t.Run("escaping entries", func(t *testing.T) {
+		outside := t.TempDir()
+		layer := buildTar(t, []*tar.Header{
+			{Name: "../../escaped", Typeflag: tar.TypeReg, Mode: 0644, Size: 5},
+			{Name: "evil", Typeflag: tar.TypeSymlink, Linkname: outside},
+			{Name: "evil/escaped", Typeflag: tar.TypeReg, Mode: 0644, Size: 5},
+		})
+		archive := filepath.Join(t.TempDir(), "image.tar")
+		writeDockerArchive(t, archive, [][]byte{layer})
+
+		dirs, err := NewStore(t.TempDir()).Unpack("task", archive)
+		require.NoError(t, err)
+		require.FileExists(t, filepath.Join(dirs[0], "escaped"))
+		require.NoFileExists(t, filepath.Join(outside, "escaped"))
+	})
+
+	t.Run("device nodes", func(t *testing.T) {
+		layer := buildTar(t, []*tar.Header{
+			{Name: "dev/", Typeflag: tar.TypeDir, Mode: 0755},
+			{Name: "dev/sda", Typeflag: tar.TypeBlock, Mode: 0660, Devmajor: 8},
+			{Name: "dev/mem", Typeflag: tar.TypeChar, Mode: 0640, Devmajor: 1, Devminor: 1},
+			{Name: "dev/fifo", Typeflag: tar.TypeFifo, Mode: 0644},
+		})
+		archive := filepath.Join(t.TempDir(), "image.tar")
+		writeDockerArchive(t, archive, [][]byte{layer})
+
+		dirs, err := NewStore(t.TempDir()).Unpack("task", archive)
+		require.NoError(t, err)
+		require.NoFileExists(t, filepath.Join(dirs[0], "dev", "sda"))
+		require.NoFileExists(t, filepath.Join(dirs[0], "dev", "mem"))
+		require.FileExists(t, filepath.Join(dirs[0], "dev", "fifo"))
+	})
+}
+
+func TestStore_Release(t *testing.T) {
+	ci.Parallel(t)
+	ctestutil.RequireRoot(t)
+
+	layers := testLayers(t)
+	other := buildTar(t, []*tar.Header{
+		{Name: "other", Typeflag: tar.TypeReg, Mode: 0644, Size: 5},
+	})
+
+	shared := filepath.Join(t.TempDir(), "shared.tar")
+	writeDockerArchive(t, shared, layers)
+	single := filepath.Join(t.TempDir(), "single.tar")
+	writeDockerArchive(t, single, [][]byte{layers[0], other})
+
+	store := NewStore(t.TempDir())
+	dirs1, err := store.Unpack("task1", shared)
+	require.NoError(t, err)
+	dirs2, err := store.Unpack("task2", single)
+	require.NoError(t, err)
+	require.Equal(t, dirs1[0], dirs2[0])
+
+	// Layers still referenced by another task are kept
+	require.NoError(t, store.Release("task1"))
+	require.DirExists(t, dirs1[0])
+	require.NoDirExists(t, dirs1[1])
+	require.DirExists(t, dirs2[1])
+
+	// Releasing an owner twice is a no-op
+	require.NoError(t, store.Release("task1"))
+
+	require.NoError(t, store.Release("task2"))
+	require.NoDirExists(t, dirs2[0])
+	require.NoDirExists(t, dirs2[1])
+}
@@ -0,0 +1,14 @@
+//go:build !linux
+
+package ociimage
+
+import (
+	"errors"
+	"io"
+)
+
+// extractLayer returns an error because image layers are only unpacked for
+// the overlay mounts of Linux.
+func extractLayer(io.Reader, string) error {
+	return errors.New("image layers can only be unpacked on linux")
+}
@@ -0,0 +1,156 @@
+//go:build linux
+
+package ociimage
+
+import (
+	"archive/tar"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path"
+	"path/filepath"
+	"strings"
+
+	securejoin "github.com/cyphar/filepath-securejoin"
+	"golang.org/x/sys/unix"
+)
+
+const (
+	// whiteoutPrefix marks files of lower layers deleted by a layer
+	whiteoutPrefix = ".wh."
+
+	// whiteoutOpaque marks directories whose content in lower layers is
+	// hidden by a layer
+	whiteoutOpaque = whiteoutPrefix + whiteoutPrefix + ".opq"
+
+	// paxXattrPrefix is the prefix of the PAX records of extended attributes
+	paxXattrPrefix = "SCHILY.xattr."
+)
+
+// extractLayer unpacks the layer archive r into dir. The whiteouts of the
+// OCI format are converted into the ones of overlayfs, so that dir can be
+// used as a lower directory of an overlay mount.
+func extractLayer(r io.Reader, dir string) error {
+	tr := tar.NewReader(r)
+	for {
+		hdr, err := tr.Next()
+		if err == io.EOF {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+
+		// Rooting the name keeps ".." from escaping dir, and symlinks in its
+		// parents are resolved within dir
+		name := path.Clean("/" + hdr.Name)
+		if name == "/" {
+			continue
+		}
+		parent, err := securejoin.SecureJoin(dir, path.Dir(name))
+		if err != nil {
+			return err
+		}
+		if err := os.MkdirAll(parent, 0755); err != nil {
+			return err
+		}
+
+		base := path.Base(name)
+		switch {
+		case base == whiteoutOpaque:
+			err = unix.Setxattr(parent, "trusted.overlay.opaque", []byte("y"), 0)
+		case strings.HasPrefix(base, whiteoutPrefix):
+			err = unix.Mknod(filepath.Join(parent, strings.TrimPrefix(base, whiteoutPrefix)), unix.S_IFCHR, 0)
+		default:
+			err = extractEntry(tr, hdr, dir, filepath.Join(parent, base))
+		}
+		if err != nil {
+			return fmt.Errorf("failed to extract %s: %w", hdr.Name, err)
+		}
+	}
+}
+
+// extractEntry creates the file of the archive entry hdr at target.
+func extractEntry(tr *tar.Reader, hdr *tar.Header, dir, target string) error {
+	// Entries replace the files already at their path, except directories
+	// which are merged
+	if fi, err := os.Lstat(target); err == nil && !(fi.IsDir() && hdr.Typeflag == tar.TypeDir) {
+		if err := os.RemoveAll(target); err != nil {
+			return err
+		}
+	}
+
+	mode := uint32(hdr.Mode & 07777)
+	switch hdr.Typeflag {
+	case tar.TypeDir:
+		if err := os.Mkdir(target, 0755); err != nil && !errors.Is(err, os.ErrExist) {
+			return err
+		}
+	case tar.TypeReg, tar.TypeRegA:
+		f, err := os.OpenFile(target, os.O_CREATE|os.O_EXCL|os.O_WRONLY, 0600)
+		if err != nil {
+			return err
+		}
+		if _, err := io.Copy(f, tr); err != nil {
+			f.Close()
+			return err
+		}
+		if err := f.Close(); err != nil {
+			return err
+		}
+	case tar.TypeSymlink:
+		if err := os.Symlink(hdr.Linkname, target); err != nil {
+			return err
+		}
+	case tar.TypeLink:
+		source, err := securejoin.SecureJoin(dir, path.Clean("/"+hdr.Linkname))
+		if err != nil {
+			return err
+		}
+		if err := os.Link(source, target); err != nil {
+			return err
+		}
+	case tar.TypeChar, tar.TypeBlock:
+		// Device nodes of images would give tasks access to the devices of
+		// the host, which are only exposed through the devices of the task
+		return nil
+	case tar.TypeFifo:
+		if err := unix.Mkfifo(target, mode); err != nil {
+			return err
+		}
+	default:
+		// Other entries, such as PAX global headers, have no file
+		return nil
+	}
+
+	// Hard links share the metadata of the file they link to
+	if hdr.Typeflag == tar.TypeLink {
+		return nil
+	}
+
+	if err := os.Lchown(target, hdr.Uid, hdr.Gid); err != nil {
+		return err
+	}
+
+	for key, value := range hdr.PAXRecords {
+		if !strings.HasPrefix(key, paxXattrPrefix) {
+			continue
+		}
+		err := unix.Lsetxattr(target, strings.TrimPrefix(key, paxXattrPrefix), []byte(value), 0)
+		if err != nil && !errors.Is(err, unix.ENOTSUP) {
+			return err
+		}
+	}
+
+	if hdr.Typeflag == tar.TypeSymlink {
+		return nil
+	}
+
+	// The mode is set after the owner, as changing the owner clears the
+	// setuid and setgid bits
+	if err := os.Chmod(target, hdr.FileInfo().Mode()); err != nil {
+		return err
+	}
+	return os.Chtimes(target, hdr.ModTime, hdr.ModTime)
+}
@@ -0,0 +1,24 @@
+//go:build !linux
+
+package seccomp
+
+import (
+	"errors"
+
+	specs "github.com/opencontainers/runtime-spec/specs-go"
+)
+
+// Supported returns false because seccomp is only supported on Linux.
+func Supported() bool {
+	return false
+}
+
+// Load returns the seccomp profile to apply to a task. Seccomp is only
+// supported on Linux, so only "unconfined" is allowed.
+func Load(profile string, _ []string) (*specs.LinuxSeccomp, error) {
+	switch profile {
+	case "", ProfileUnconfined:
+		return nil, nil
+	}
+	return nil, errors.New("seccomp profiles are only supported on Linux")
+}
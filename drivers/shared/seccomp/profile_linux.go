@@ -0,0 +1,64 @@
+//go:build linux
+
+package seccomp
+
+import (
+	_ "embed"
+	"fmt"
+	"os"
+
+	dseccomp "github.com/docker/docker/profiles/seccomp"
+	lseccomp "github.com/opencontainers/runc/libcontainer/seccomp"
+	specs "github.com/opencontainers/runtime-spec/specs-go"
+)
+
+// defaultProfile is the built-in profile. It allows all syscalls except for
+// those which are dangerous or only useful to privileged workloads, some of
+// which are allowed when the task is granted the required capability.
+//
+//go:embed default.json
+var defaultProfile string
+
+// Supported returns true if Nomad was built with support for applying seccomp
+// profiles, which requires cgo and the seccomp build tag.
+func Supported() bool {
+	return lseccomp.Enabled
+}
+
+// Load returns the seccomp profile to apply to a task with the given bounding
+// set of capabilities. The profile is "default", "unconfined" or the path of a
+// profile in the Docker or OCI format. A nil profile is returned if syscalls
+// should not be filtered.
+func Load(profile string, capabilities []string) (*specs.LinuxSeccomp, error) {
+	var body string
+	switch profile {
+	case "", ProfileUnconfined:
+		return nil, nil
+	case ProfileDefault:
+		body = defaultProfile
+	default:
+		if err := Validate(profile); err != nil {
+			return nil, err
+		}
+		b, err := os.ReadFile(profile)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read seccomp profile: %v", err)
+		}
+		body = string(b)
+	}
+
+	// Rules of Docker profiles may depend on the capabilities of the task
+	rs := &specs.Spec{
+		Process: &specs.Process{
+			Capabilities: &specs.LinuxCapabilities{
+				Bounding: capabilities,
+			},
+		},
+	}
+
+	p, err := dseccomp.LoadProfile(body, rs)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load seccomp profile %q: %v", profile, err)
+	}
+	return p, nil
+}
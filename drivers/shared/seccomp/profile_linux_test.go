@@ -0,0 +1,87 @@
+//go:build linux
+
+package seccomp
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/hashicorp/nomad/ci"
+	specs "github.com/opencontainers/runtime-spec/specs-go"
+	"github.com/stretchr/testify/require"
+)
+
+// denied returns true if the profile has a rule denying the syscall.
+func denied(p *specs.LinuxSeccomp, name string) bool {
+	for _, s := range p.Syscalls {
+		for _, n := range s.Names {
+			if n == name && s.Action == specs.ActErrno {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+func TestLoad_Unconfined(t *testing.T) {
+	ci.Parallel(t)
+
+	p, err := Load(ProfileUnconfined, nil)
+	require.NoError(t, err)
+	require.Nil(t, p)
+
+	p, err = Load("", nil)
+	require.NoError(t, err)
+	require.Nil(t, p)
+}
+
+func TestLoad_Default(t *testing.T) {
+	ci.Parallel(t)
+
+	p, err := Load(ProfileDefault, []string{"CAP_CHOWN"})
+	require.NoError(t, err)
+	require.NotNil(t, p)
+	require.Equal(t, specs.ActAllow, p.DefaultAction)
+	require.True(t, denied(p, "keyctl"))
+	require.True(t, denied(p, "mount"))
+	require.True(t, denied(p, "ptrace"))
+	require.False(t, denied(p, "read"))
+
+	// Granting a capability allows the syscalls which require it
+	p, err = Load(ProfileDefault, []string{"CAP_SYS_ADMIN"})
+	require.NoError(t, err)
+	require.True(t, denied(p, "keyctl"))
+	require.False(t, denied(p, "mount"))
+	require.True(t, denied(p, "ptrace"))
+}
+
+func TestLoad_File(t *testing.T) {
+	ci.Parallel(t)
+
+	path := filepath.Join(t.TempDir(), "profile.json")
+	body := `{
+  "defaultAction": "SCMP_ACT_ERRNO",
+  "syscalls": [
+    {"names": ["read", "write"], "action": "SCMP_ACT_ALLOW"},
+    {"names": ["socket"], "action": "SCMP_ACT_LOG"}
+  ]
+}`
+	require.NoError(t, os.WriteFile(path, []byte(body), 0o644))
+
+	p, err := Load(path, nil)
+	require.NoError(t, err)
+	require.Equal(t, specs.ActErrno, p.DefaultAction)
+	require.Len(t, p.Syscalls, 2)
+	require.True(t, LogsDenials(p))
+
+	_, err = Load(filepath.Join(t.TempDir(), "missing.json"), nil)
+	require.ErrorContains(t, err, "failed to read seccomp profile")
+
+	require.NoError(t, os.WriteFile(path, []byte("{"), 0o644))
+	_, err = Load(path, nil)
+	require.ErrorContains(t, err, "failed to load seccomp profile")
+
+	_, err = Load("profile.json", nil)
+	require.Error(t, err)
+}
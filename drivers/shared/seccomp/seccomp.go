@@ -0,0 +1,100 @@
+// Package seccomp loads the seccomp profiles of tasks run by the exec-based
+// task drivers and watches for the syscalls they log.
+package seccomp
+
+import (
+	"fmt"
+	"path/filepath"
+	"strconv"
+	"time"
+
+	"github.com/hashicorp/nomad/plugins/drivers"
+	specs "github.com/opencontainers/runtime-spec/specs-go"
+)
+
+const (
+	// ProfileDefault selects the built-in default profile.
+	ProfileDefault = "default"
+
+	// ProfileUnconfined disables syscall filtering.
+	ProfileUnconfined = "unconfined"
+)
+
+// Profile returns the profile to use for a task, given the default profile
+// of the driver plugin and the profile of the task, if any.
+func Profile(defaultProfile, taskProfile string) string {
+	switch {
+	case taskProfile != "":
+		return taskProfile
+	case defaultProfile != "":
+		return defaultProfile
+	default:
+		return ProfileUnconfined
+	}
+}
+
+// Validate returns an error if profile is not "default", "unconfined" or an
+// absolute path to a profile file. An empty profile is valid.
+func Validate(profile string) error {
+	switch profile {
+	case "", ProfileDefault, ProfileUnconfined:
+		return nil
+	}
+	if !filepath.IsAbs(profile) {
+		return fmt.Errorf("seccomp profile must be %q, %q or an absolute path, got %q",
+			ProfileDefault, ProfileUnconfined, profile)
+	}
+	return nil
+}
+
+// LogsDenials returns true if the profile logs any syscalls instead of
+// denying them, in which case those syscalls should be watched for.
+func LogsDenials(profile *specs.LinuxSeccomp) bool {
+	if profile == nil {
+		return false
+	}
+	if profile.DefaultAction == specs.ActLog {
+		return true
+	}
+	for _, syscall := range profile.Syscalls {
+		if syscall.Action == specs.ActLog {
+			return true
+		}
+	}
+	return false
+}
+
+// Denial is a syscall made by a task which was logged by its seccomp
+// profile.
+type Denial struct {
+	// Pid is the host pid of the process making the syscall.
+	Pid int
+
+	// Comm is the command name of the process making the syscall.
+	Comm string
+
+	// Arch is the audit architecture of the syscall, such as c000003e for
+	// x86_64.
+	Arch string
+
+	// Syscall is the number of the syscall.
+	Syscall int
+}
+
+// TaskEvent returns the event to emit for the denial of a syscall made by the
+// task.
+func (d *Denial) TaskEvent(task *drivers.TaskConfig) *drivers.TaskEvent {
+	return &drivers.TaskEvent{
+		TaskID:    task.ID,
+		TaskName:  task.Name,
+		AllocID:   task.AllocID,
+		Timestamp: time.Now(),
+		Message:   fmt.Sprintf("Seccomp profile logged syscall %d made by %q", d.Syscall, d.Comm),
+		Annotations: map[string]string{
+			"pid":     strconv.Itoa(d.Pid),
+			"comm":    d.Comm,
+			"arch":    d.Arch,
+			"syscall": strconv.Itoa(d.Syscall),
+		},
+	}
+}
@@ -0,0 +1,70 @@
+package seccomp
+
+import (
+	"testing"
+
+	"github.com/hashicorp/nomad/ci"
+	"github.com/hashicorp/nomad/plugins/drivers"
+	specs "github.com/opencontainers/runtime-spec/specs-go"
+	"github.com/stretchr/testify/require"
+)
+
+func TestProfile(t *testing.T) {
+	ci.Parallel(t)
+
+	require.Equal(t, ProfileUnconfined, Profile("", ""))
+	require.Equal(t, ProfileDefault, Profile(ProfileDefault, ""))
+	require.Equal(t, "/etc/task.json", Profile(ProfileDefault, "/etc/task.json"))
+	require.Equal(t, ProfileUnconfined, Profile(ProfileDefault, ProfileUnconfined))
+}
+
+func TestValidate(t *testing.T) {
+	ci.Parallel(t)
+
+	for _, profile := range []string{"", ProfileDefault, ProfileUnconfined, "/etc/task.json"} {
+		require.NoError(t, Validate(profile), profile)
+	}
+	for _, profile := range []string{"Default", "task.json", "./task.json"} {
+		require.Error(t, Validate(profile), profile)
+	}
+}
+
+func TestLogsDenials(t *testing.T) {
+	ci.Parallel(t)
+
+	require.False(t, LogsDenials(nil))
+	require.False(t, LogsDenials(&specs.LinuxSeccomp{
+		DefaultAction: specs.ActAllow,
+		Syscalls: []specs.LinuxSyscall{
+			{Names: []string{"mount"}, Action: specs.ActErrno},
+		},
+	}))
+	require.True(t, LogsDenials(&specs.LinuxSeccomp{
+		DefaultAction: specs.ActLog,
+	}))
+	require.True(t, LogsDenials(&specs.LinuxSeccomp{
+		DefaultAction: specs.ActAllow,
+		Syscalls: []specs.LinuxSyscall{
+			{Names: []string{"mount"}, Action: specs.ActLog},
+		},
+	}))
+}
+
+func TestDenial_TaskEvent(t *testing.T) {
+	ci.Parallel(t)
+
+	task := &drivers.TaskConfig{ID: "id", Name: "web", AllocID: "alloc"}
+	denial := &Denial{Pid: 42, Comm: "curl", Arch: "c000003e", Syscall: 41}
+
+	event := denial.TaskEvent(task)
+	require.Equal(t, "id", event.TaskID)
+	require.Equal(t, "web", event.TaskName)
+	require.Equal(t, "alloc", event.AllocID)
+	require.Equal(t, `Seccomp profile logged syscall 41 made by "curl"`, event.Message)
+	require.Equal(t, map[string]string{
+		"pid":     "42",
+		"comm":    "curl",
+		"arch":    "c000003e",
+		"syscall": "41",
+	}, event.Annotations)
+}
@@ -0,0 +1,12 @@
+//go:build !linux
+
+package seccomp
+
+import (
+	"context"
+
+	hclog "github.com/hashicorp/go-hclog"
+)
+
+// WatchDenials does nothing because seccomp is only supported on Linux.
+func WatchDenials(_ context.Context, _ hclog.Logger, _ int, _ func(*Denial)) {}
@@ -0,0 +1,162 @@
+//go:build linux
+
+package seccomp
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"regexp"
+	"strconv"
+	"syscall"
+
+	hclog "github.com/hashicorp/go-hclog"
+)
+
+// kmsgPath is the kernel log device seccomp audit records are read from.
+const kmsgPath = "/dev/kmsg"
+
+// auditSeccompType is the type of the audit records the kernel writes for
+// syscalls logged by seccomp.
+const auditSeccompType = "1326"
+
+var (
+	auditTypeRe = regexp.MustCompile(`\btype=(\d+)\b`)
+	auditPidRe  = regexp.MustCompile(`\bpid=(\d+)\b`)
+	auditCommRe = regexp.MustCompile(`\bcomm="([^"]*)"`)
+	auditArchRe = regexp.MustCompile(`\barch=([0-9a-f]+)\b`)
+	auditCallRe = regexp.MustCompile(`\bsyscall=(\d+)\b`)
+)
+
+// WatchDenials calls fn for each syscall logged by the seccomp profile of the
+// process pid or its descendants, until ctx is done. Syscalls are read from
+// the audit records in the kernel log, so they are not seen if auditd
+// consumes the records instead.
+func WatchDenials(ctx context.Context, logger hclog.Logger, pid int, fn func(*Denial)) {
+	f, err := os.OpenFile(kmsgPath, os.O_RDONLY|syscall.O_NONBLOCK, 0)
+	if err != nil {
+		logger.Warn("failed to watch for seccomp denials", "error", err)
+		return
+	}
+
+	// Closing the kernel log interrupts the blocked read below
+	go func() {
+		<-ctx.Done()
+		f.Close()
+	}()
+
+	// Only watch for syscalls logged from now on
+	if _, err := f.Seek(0, io.SeekEnd); err != nil {
+		logger.Warn("failed to watch for seccomp denials", "error", err)
+		return
+	}
+
+	// Each read returns a single record
+	buf := make([]byte, 8192)
+	for {
+		n, err := f.Read(buf)
+		if err != nil {
+			if ctx.Err() != nil || errors.Is(err, os.ErrClosed) {
+				return
+			}
+			if errors.Is(err, syscall.EPIPE) {
+				// Records were overwritten before they could be read
+				continue
+			}
+			logger.Warn("failed to read kernel log", "error", err)
+			return
+		}
+
+		denial, ok := parseRecord(buf[:n])
+		if !ok || !isDescendant(denial.Pid, pid) {
+			continue
+		}
+		fn(denial)
+	}
+}
+
+// parseRecord returns the denial in a kernel log record, if it is a seccomp
+// audit record.
+func parseRecord(record []byte) (*Denial, bool) {
+	// Records are formatted as "<prefix>;<message>\n<continuation lines>"
+	if i := bytes.IndexByte(record, ';'); i >= 0 {
+		record = record[i+1:]
+	}
+	if i := bytes.IndexByte(record, '\n'); i >= 0 {
+		record = record[:i]
+	}
+
+	if m := auditTypeRe.FindSubmatch(record); m == nil || string(m[1]) != auditSeccompType {
+		return nil, false
+	}
+
+	m := auditPidRe.FindSubmatch(record)
+	if m == nil {
+		return nil, false
+	}
+	pid, err := strconv.Atoi(string(m[1]))
+	if err != nil {
+		return nil, false
+	}
+
+	m = auditCallRe.FindSubmatch(record)
+	if m == nil {
+		return nil, false
+	}
+	nr, err := strconv.Atoi(string(m[1]))
+	if err != nil {
+		return nil, false
+	}
+
+	denial := &Denial{
+		Pid:     pid,
+		Syscall: nr,
+	}
+	if m := auditCommRe.FindSubmatch(record); m != nil {
+		denial.Comm = string(m[1])
+	}
+	if m := auditArchRe.FindSubmatch(record); m != nil {
+		denial.Arch = string(m[1])
+	}
+	return denial, true
+}
+
+// isDescendant returns true if pid is ancestor or one of its descendants.
+func isDescendant(pid, ancestor int) bool {
+	for {
+		if pid == ancestor {
+			return true
+		}
+		if pid <= 1 {
+			return false
+		}
+		ppid, err := parentPid(pid)
+		if err != nil {
+			return false
+		}
+		pid = ppid
+	}
+}
+
+// parentPid returns the parent of the process pid.
+func parentPid(pid int) (int, error) {
+	b, err := os.ReadFile(fmt.Sprintf("/proc/%d/stat", pid))
+	if err != nil {
+		return 0, err
+	}
+
+	// The command name may contain spaces and parentheses, so the fields
+	// are parsed from its closing parenthesis: ") <state> <ppid> ..."
+	i := bytes.LastIndexByte(b, ')')
+	if i < 0 {
+		return 0, fmt.Errorf("invalid stat of process %d", pid)
+	}
+	fields := bytes.Fields(b[i+1:])
+	if len(fields) < 2 {
+		return 0, fmt.Errorf("invalid stat of process %d", pid)
+	}
+	return strconv.Atoi(string(fields[1]))
+}
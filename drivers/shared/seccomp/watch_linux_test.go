@@ -0,0 +1,43 @@
+//go:build linux
+
+package seccomp
+
+import (
+	"os"
+	"testing"
+
+	"github.com/hashicorp/nomad/ci"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseRecord(t *testing.T) {
+	ci.Parallel(t)
+
+	record := `6,1432,4521887,-;audit: type=1326 audit(1697712345.123:42): auid=4294967295 uid=0 gid=0 ses=4294967295 subj=unconfined pid=4242 comm="curl" exe="/usr/bin/curl" sig=0 arch=c000003e syscall=41 compat=0 ip=0x7f2 code=0x7ffc0000
+ SUBSYSTEM=audit
+`
+	denial, ok := parseRecord([]byte(record))
+	require.True(t, ok)
+	require.Equal(t, &Denial{
+		Pid:     4242,
+		Comm:    "curl",
+		Arch:    "c000003e",
+		Syscall: 41,
+	}, denial)
+
+	// Other audit records are ignored
+	_, ok = parseRecord([]byte(`6,1433,4521890,-;audit: type=1400 audit(1697712345.123:43): apparmor="DENIED" pid=4242 comm="curl"`))
+	require.False(t, ok)
+
+	_, ok = parseRecord([]byte(`6,1434,4521891,-;eth0: link up`))
+	require.False(t, ok)
+}
+
+func TestIsDescendant(t *testing.T) {
+	ci.Parallel(t)
+
+	pid := os.Getpid()
+	require.True(t, isDescendant(pid, pid))
+	require.True(t, isDescendant(pid, os.Getppid()))
+	require.False(t, isDescendant(os.Getppid(), pid))
+}
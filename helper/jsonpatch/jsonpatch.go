@@ -0,0 +1,293 @@
+// Package jsonpatch applies RFC 6902 JSON Patch documents.
+package jsonpatch
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+)
+
+// Operation is a single JSON Patch operation.
+type Operation struct {
+	Op    string          `json:"op"`
+	Path  string          `json:"path"`
+	From  string          `json:"from,omitempty"`
+	Value json.RawMessage `json:"value,omitempty"`
+}
+
+// Apply applies the patch to the JSON document and returns the patched
+// document. Numbers are preserved exactly, so integers too large to be
+// represented as a float64 survive the round trip.
+func Apply(doc []byte, patch []Operation) ([]byte, error) {
+	root, err := decode(doc)
+	if err != nil {
+		return nil, fmt.Errorf("invalid document: %v", err)
+	}
+
+	for i, op := range patch {
+		root, err = applyOp(root, op)
+		if err != nil {
+			return nil, fmt.Errorf("patch operation %d (%s %s) failed: %v", i, op.Op, op.Path, err)
+		}
+	}
+
+	return json.Marshal(root)
+}
+
+func applyOp(root interface{}, op Operation) (interface{}, error) {
+	switch op.Op {
+	case "add":
+		v, err := op.value()
+		if err != nil {
+			return nil, err
+		}
+		return add(root, op.Path, v)
+	case "remove":
+		out, _, err := remove(root, op.Path)
+		return out, err
+	case "replace":
+		v, err := op.value()
+		if err != nil {
+			return nil, err
+		}
+		if _, err := get(root, op.Path); err != nil {
+			return nil, err
+		}
+		if op.Path == "" {
+			return v, nil
+		}
+		return update(root, op.Path, func(parent interface{}, key string) (interface{}, error) {
+			switch p := parent.(type) {
+			case map[string]interface{}:
+				p[key] = v
+				return p, nil
+			case []interface{}:
+				i, err := index(key, len(p), false)
+				if err != nil {
+					return nil, err
+				}
+				p[i] = v
+				return p, nil
+			default:
+				return nil, fmt.Errorf("cannot replace a member of a %T", parent)
+			}
+		})
+	case "move":
+		if strings.HasPrefix(op.Path, op.From+"/") {
+			return nil, fmt.Errorf("cannot move %q into itself", op.From)
+		}
+		out, v, err := remove(root, op.From)
+		if err != nil {
+			return nil, err
+		}
+		return add(out, op.Path, v)
+	case "copy":
+		v, err := get(root, op.From)
+		if err != nil {
+			return nil, err
+		}
+		v, err = deepCopy(v)
+		if err != nil {
+			return nil, err
+		}
+		return add(root, op.Path, v)
+	case "test":
+		expected, err := op.value()
+		if err != nil {
+			return nil, err
+		}
+		actual, err := get(root, op.Path)
+		if err != nil {
+			return nil, err
+		}
+		if !reflect.DeepEqual(expected, actual) {
+			return nil, fmt.Errorf("test failed")
+		}
+		return root, nil
+	default:
+		return nil, fmt.Errorf("unknown operation %q", op.Op)
+	}
+}
+
+func (op Operation) value() (interface{}, error) {
+	if len(op.Value) == 0 {
+		return nil, fmt.Errorf("missing value")
+	}
+	return decode(op.Value)
+}
+
+func add(root interface{}, path string, v interface{}) (interface{}, error) {
+	if path == "" {
+		return v, nil
+	}
+	return update(root, path, func(parent interface{}, key string) (interface{}, error) {
+		switch p := parent.(type) {
+		case map[string]interface{}:
+			p[key] = v
+			return p, nil
+		case []interface{}:
+			i, err := index(key, len(p), true)
+			if err != nil {
+				return nil, err
+			}
+			p = append(p, nil)
+			copy(p[i+1:], p[i:])
+			p[i] = v
+			return p, nil
+		default:
+			return nil, fmt.Errorf("cannot add a member to a %T", parent)
+		}
+	})
+}
+
+func remove(root interface{}, path string) (interface{}, interface{}, error) {
+	if path == "" {
+		return nil, nil, fmt.Errorf("cannot remove the root")
+	}
+	var removed interface{}
+	out, err := update(root, path, func(parent interface{}, key string) (interface{}, error) {
+		switch p := parent.(type) {
+		case map[string]interface{}:
+			v, ok := p[key]
+			if !ok {
+				return nil, fmt.Errorf("member %q not found", key)
+			}
+			removed = v
+			delete(p, key)
+			return p, nil
+		case []interface{}:
+			i, err := index(key, len(p), false)
+			if err != nil {
+				return nil, err
+			}
+			removed = p[i]
+			return append(p[:i], p[i+1:]...), nil
+		default:
+			return nil, fmt.Errorf("cannot remove a member of a %T", parent)
+		}
+	})
+	return out, removed, err
+}
+
+func get(root interface{}, path string) (interface{}, error) {
+	tokens, err := parse(path)
+	if err != nil {
+		return nil, err
+	}
+	node := root
+	for _, token := range tokens {
+		switch n := node.(type) {
+		case map[string]interface{}:
+			v, ok := n[token]
+			if !ok {
+				return nil, fmt.Errorf("member %q not found", token)
+			}
+			node = v
+		case []interface{}:
+			i, err := index(token, len(n), false)
+			if err != nil {
+				return nil, err
+			}
+			node = n[i]
+		default:
+			return nil, fmt.Errorf("cannot traverse a %T", node)
+		}
+	}
+	return node, nil
+}
+
+// update walks to the parent of the last path token and replaces it with the
+// result of fn, rebuilding every container along the way since appending to
+// or removing from an array may reallocate it.
+func update(root interface{}, path string, fn func(parent interface{}, key string) (interface{}, error)) (interface{}, error) {
+	tokens, err := parse(path)
+	if err != nil {
+		return nil, err
+	}
+
+	var walk func(node interface{}, tokens []string) (interface{}, error)
+	walk = func(node interface{}, tokens []string) (interface{}, error) {
+		if len(tokens) == 1 {
+			return fn(node, tokens[0])
+		}
+		switch n := node.(type) {
+		case map[string]interface{}:
+			child, ok := n[tokens[0]]
+			if !ok {
+				return nil, fmt.Errorf("member %q not found", tokens[0])
+			}
+			child, err := walk(child, tokens[1:])
+			if err != nil {
+				return nil, err
+			}
+			n[tokens[0]] = child
+			return n, nil
+		case []interface{}:
+			i, err := index(tokens[0], len(n), false)
+			if err != nil {
+				return nil, err
+			}
+			child, err := walk(n[i], tokens[1:])
+			if err != nil {
+				return nil, err
+			}
+			n[i] = child
+			return n, nil
+		default:
+			return nil, fmt.Errorf("cannot traverse a %T", node)
+		}
+	}
+	return walk(root, tokens)
+}
+
+// parse splits a JSON Pointer into its unescaped reference tokens.
+func parse(path string) ([]string, error) {
+	if path == "" {
+		return nil, nil
+	}
+	if !strings.HasPrefix(path, "/") {
+		return nil, fmt.Errorf("path %q must start with /", path)
+	}
+	tokens := strings.Split(path[1:], "/")
+	for i, t := range tokens {
+		tokens[i] = strings.ReplaceAll(strings.ReplaceAll(t, "~1", "/"), "~0", "~")
+	}
+	return tokens, nil
+}
+
+// index parses an array index. If end is true the index may be one past the
+// end of the array, which "-" refers to.
+func index(token string, length int, end bool) (int, error) {
+	if token == "-" && end {
+		return length, nil
+	}
+	i, err := strconv.Atoi(token)
+	if err != nil || i < 0 || (token != "0" && strings.HasPrefix(token, "0")) {
+		return 0, fmt.Errorf("invalid array index %q", token)
+	}
+	if i > length || (i == length && !end) {
+		return 0, fmt.Errorf("array index %d out of bounds", i)
+	}
+	return i, nil
+}
+
+func decode(b []byte) (interface{}, error) {
+	dec := json.NewDecoder(bytes.NewReader(b))
+	dec.UseNumber()
+	var v interface{}
+	if err := dec.Decode(&v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+func deepCopy(v interface{}) (interface{}, error) {
+	b, err := json.Marshal(v)
+	if err != nil {
+		return nil, err
+	}
+	return decode(b)
+}
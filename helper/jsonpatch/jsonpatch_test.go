@@ -0,0 +1,93 @@
+package jsonpatch
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/hashicorp/nomad/ci"
+	"github.com/shoenig/test/must"
+)
+
+func TestApply(t *testing.T) {
+	ci.Parallel(t)
+
+	doc := `{"Name":"web","Meta":{"a/b":"1"},"Tags":["x","z"],"SubmitTime":1680000000123456789}`
+
+	cases := []struct {
+		name   string
+		patch  string
+		exp    string
+		expErr string
+	}{
+		{
+			name:  "add member",
+			patch: `[{"op":"add","path":"/Meta/owner","value":"team"}]`,
+			exp:   `{"Name":"web","Meta":{"a/b":"1","owner":"team"},"Tags":["x","z"],"SubmitTime":1680000000123456789}`,
+		},
+		{
+			name:  "add array element",
+			patch: `[{"op":"add","path":"/Tags/1","value":"y"},{"op":"add","path":"/Tags/-","value":"end"}]`,
+			exp:   `{"Name":"web","Meta":{"a/b":"1"},"Tags":["x","y","z","end"],"SubmitTime":1680000000123456789}`,
+		},
+		{
+			name:  "escaped pointer",
+			patch: `[{"op":"replace","path":"/Meta/a~1b","value":"2"}]`,
+			exp:   `{"Name":"web","Meta":{"a/b":"2"},"Tags":["x","z"],"SubmitTime":1680000000123456789}`,
+		},
+		{
+			name:  "remove",
+			patch: `[{"op":"remove","path":"/Tags/0"},{"op":"remove","path":"/Meta"}]`,
+			exp:   `{"Name":"web","Tags":["z"],"SubmitTime":1680000000123456789}`,
+		},
+		{
+			name:  "move and copy",
+			patch: `[{"op":"copy","from":"/Name","path":"/Meta/name"},{"op":"move","from":"/Tags","path":"/Labels"}]`,
+			exp:   `{"Name":"web","Meta":{"a/b":"1","name":"web"},"Labels":["x","z"],"SubmitTime":1680000000123456789}`,
+		},
+		{
+			name:  "test",
+			patch: `[{"op":"test","path":"/Name","value":"web"},{"op":"replace","path":"/Name","value":"api"}]`,
+			exp:   `{"Name":"api","Meta":{"a/b":"1"},"Tags":["x","z"],"SubmitTime":1680000000123456789}`,
+		},
+		{
+			name:   "failed test",
+			patch:  `[{"op":"test","path":"/Name","value":"api"}]`,
+			expErr: "test failed",
+		},
+		{
+			name:   "replace missing",
+			patch:  `[{"op":"replace","path":"/Missing","value":1}]`,
+			expErr: `member "Missing" not found`,
+		},
+		{
+			name:   "out of bounds",
+			patch:  `[{"op":"add","path":"/Tags/5","value":"a"}]`,
+			expErr: "out of bounds",
+		},
+		{
+			name:   "unknown op",
+			patch:  `[{"op":"merge","path":"/Name"}]`,
+			expErr: `unknown operation "merge"`,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			var patch []Operation
+			must.NoError(t, json.Unmarshal([]byte(tc.patch), &patch))
+
+			out, err := Apply([]byte(doc), patch)
+			if tc.expErr != "" {
+				must.ErrorContains(t, err, tc.expErr)
+				return
+			}
+			must.NoError(t, err)
+
+			var exp, got map[string]interface{}
+			must.NoError(t, json.Unmarshal([]byte(tc.exp), &exp))
+			must.NoError(t, json.Unmarshal(out, &got))
+			must.Eq(t, exp, got)
+			must.StrContains(t, string(out), "1680000000123456789")
+		})
+	}
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"os/exec"
+	"sync"
 
 	log "github.com/hashicorp/go-hclog"
 	plugin "github.com/hashicorp/go-plugin"
@@ -29,6 +30,25 @@ type PluginCatalog interface {
 	Catalog() map[string][]*base.PluginInfoResponse
 }
 
+// PluginReloader is a plugin catalog whose plugins can be rescanned and
+// replaced while the agent is running.
+type PluginReloader interface {
+	PluginCatalog
+
+	// Rescan rescans the plugin directory and fingerprints the plugins using
+	// the given plugin configs. The plugins are returned as a new catalog, so
+	// a plugin can be tested before it replaces the plugin of this catalog.
+	Rescan(configs []*config.PluginConfig) (PluginReloader, error)
+
+	// IsExternal returns whether the plugin is launched from a binary in the
+	// plugin directory.
+	IsExternal(id PluginID) bool
+
+	// Update replaces the plugin with the given ID by the plugin of a catalog
+	// returned by Rescan.
+	Update(id PluginID, from PluginReloader) error
+}
+
 // InternalPluginConfig is used to configure launching an internal plugin.
 type InternalPluginConfig struct {
 	Config  map[string]interface{}
@@ -86,8 +106,13 @@ type PluginLoader struct {
 	// pluginDir is the directory containing plugin binaries
 	pluginDir string
 
+	// config is the config the loader was created with, used to rescan the
+	// plugins
+	config *PluginLoaderConfig
+
 	// plugins maps a plugin to information required to launch it
-	plugins map[PluginID]*pluginInfo
+	plugins     map[PluginID]*pluginInfo
+	pluginsLock sync.RWMutex
 }
 
 // pluginInfo captures the necessary information to launch and configure a
@@ -129,6 +154,7 @@ func NewPluginLoader(config *PluginLoaderConfig) (*PluginLoader, error) {
 		logger:            logger,
 		supportedVersions: supportedVersions,
 		pluginDir:         config.PluginDir,
+		config:            config,
 		plugins:           make(map[PluginID]*pluginInfo),
 	}
 
@@ -146,7 +172,9 @@ func (l *PluginLoader) Dispense(name, pluginType string, config *base.AgentConfi
 		Name:       name,
 		PluginType: pluginType,
 	}
+	l.pluginsLock.RLock()
 	pinfo, ok := l.plugins[id]
+	l.pluginsLock.RUnlock()
 	if !ok {
 		return nil, fmt.Errorf("unknown plugin with name %q and type %q", name, pluginType)
 	}
@@ -279,9 +307,57 @@ func getPluginMap(pluginType string, logger log.Logger) map[string]plugin.Plugin
 
 // Catalog returns the catalog of all plugins
 func (l *PluginLoader) Catalog() map[string][]*base.PluginInfoResponse {
+	l.pluginsLock.RLock()
+	defer l.pluginsLock.RUnlock()
+
 	c := make(map[string][]*base.PluginInfoResponse, 3)
 	for id, info := range l.plugins {
 		c[id.PluginType] = append(c[id.PluginType], info.baseInfo)
 	}
 	return c
 }
+
+// Rescan returns a new plugin loader with the plugins found by rescanning the
+// plugin directory and fingerprinting the plugins with the given configs.
+func (l *PluginLoader) Rescan(configs []*config.PluginConfig) (PluginReloader, error) {
+	c := *l.config
+	c.Configs = configs
+	return NewPluginLoader(&c)
+}
+
+// IsExternal returns whether the plugin is launched from a binary in the
+// plugin directory rather than from an internal factory.
+func (l *PluginLoader) IsExternal(id PluginID) bool {
+	l.pluginsLock.RLock()
+	defer l.pluginsLock.RUnlock()
+
+	pinfo, ok := l.plugins[id]
+	return ok && pinfo.factory == nil
+}
+
+// Update replaces the plugin with the given ID by the plugin of the rescanned
+// plugin loader. Plugins dispensed afterwards are launched from the new
+// plugin's binary and config.
+func (l *PluginLoader) Update(id PluginID, from PluginReloader) error {
+	other, ok := from.(*PluginLoader)
+	if !ok {
+		return fmt.Errorf("unsupported plugin catalog %T", from)
+	}
+
+	other.pluginsLock.RLock()
+	pinfo, ok := other.plugins[id]
+	other.pluginsLock.RUnlock()
+	if !ok {
+		return fmt.Errorf("unknown plugin %s", id)
+	}
+
+	l.pluginsLock.Lock()
+	defer l.pluginsLock.Unlock()
+
+	if old, ok := l.plugins[id]; ok {
+		l.logger.Info("updating plugin", "plugin", id.Name, "type", id.PluginType,
+			"previous_version", old.version, "version", pinfo.version)
+	}
+	l.plugins[id] = pinfo
+	return nil
+}
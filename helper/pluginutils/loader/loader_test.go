@@ -1099,6 +1099,61 @@ func TestPluginLoader_Dispense_NoConfigSchema_Internal(t *testing.T) {
 	require.True(ok)
 }
 
+func TestPluginLoader_Rescan_Update(t *testing.T) {
+	ci.Parallel(t)
+	require := require.New(t)
+
+	plugin := "mock-device"
+	h := newHarness(t, []string{plugin})
+	id := PluginID{Name: plugin, PluginType: base.PluginTypeDevice}
+
+	pluginConfig := func(version, resKey string) []*config.PluginConfig {
+		return []*config.PluginConfig{
+			{
+				Name: plugin,
+				Args: []string{"-plugin", "-name", plugin,
+					"-type", base.PluginTypeDevice, "-version", version, "-api-version", device.ApiVersion010},
+				Config: map[string]interface{}{
+					"res_key": resKey,
+				},
+			},
+		}
+	}
+
+	logger := testlog.HCLogger(t)
+	lconfig := &PluginLoaderConfig{
+		Logger:            logger,
+		PluginDir:         h.pluginDir(),
+		SupportedVersions: supportedApiVersions,
+		Configs:           pluginConfig("v0.0.1", "old"),
+	}
+
+	l, err := NewPluginLoader(lconfig)
+	require.NoError(err)
+	require.True(l.IsExternal(id))
+
+	// Rescanning doesn't change the plugins of the loader
+	staged, err := l.Rescan(pluginConfig("v0.0.2", "new"))
+	require.NoError(err)
+	require.Equal("v0.0.2", staged.Catalog()[base.PluginTypeDevice][0].PluginVersion)
+	require.Equal("v0.0.1", l.Catalog()[base.PluginTypeDevice][0].PluginVersion)
+
+	require.NoError(l.Update(id, staged))
+	require.Equal("v0.0.2", l.Catalog()[base.PluginTypeDevice][0].PluginVersion)
+
+	// Dispensed plugins use the new config
+	p, err := l.Dispense(plugin, base.PluginTypeDevice, nil, logger)
+	require.NoError(err)
+	defer p.Kill()
+
+	res, err := p.Plugin().(device.DevicePlugin).Reserve([]string{"fake"})
+	require.NoError(err)
+	require.Contains(res.Envs, "new")
+
+	// Unknown plugins can't be updated
+	require.Error(l.Update(PluginID{Name: "unknown", PluginType: base.PluginTypeDevice}, staged))
+}
+
 func TestPluginLoader_Reattach_External(t *testing.T) {
 	ci.Parallel(t)
 	require := require.New(t)
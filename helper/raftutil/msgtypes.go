@@ -63,6 +63,10 @@ var msgTypeNames = map[structs.MessageType]string{
 	structs.ACLAuthMethodsDeleteRequestType:              "ACLAuthMethodsDeleteRequestType",
 	structs.ACLBindingRulesUpsertRequestType:             "ACLBindingRulesUpsertRequestType",
 	structs.ACLBindingRulesDeleteRequestType:             "ACLBindingRulesDeleteRequestType",
+	structs.EventSinkRegisterRequestType:                 "EventSinkRegisterRequestType",
+	structs.EventSinkDeregisterRequestType:               "EventSinkDeregisterRequestType",
+	structs.EventSinkProgressRequestType:                 "EventSinkProgressRequestType",
+	structs.ACLTokenExpiryNotificationsRequestType:       "ACLTokenExpiryNotificationsRequestType",
 	structs.NamespaceUpsertRequestType:                   "NamespaceUpsertRequestType",
 	structs.NamespaceDeleteRequestType:                   "NamespaceDeleteRequestType",
 }
@@ -1034,30 +1034,11 @@ func parseChecks(service *api.Service, checkObjs *ast.ObjectList) error {
 			return err
 		}
 
-		// HCL allows repeating blocks so merge 'header' into a single
-		// map[string][]string.
 		if headerI, ok := cm["header"]; ok {
-			headerRaw, ok := headerI.([]map[string]interface{})
-			if !ok {
-				return fmt.Errorf("check -> header -> expected a []map[string][]string but found %T", headerI)
-			}
-			m := map[string][]string{}
-			for _, rawm := range headerRaw {
-				for k, vI := range rawm {
-					vs, ok := vI.([]interface{})
-					if !ok {
-						return fmt.Errorf("check -> header -> %q expected a []string but found %T", k, vI)
-					}
-					for _, vI := range vs {
-						v, ok := vI.(string)
-						if !ok {
-							return fmt.Errorf("check -> header -> %q expected a string but found %T", k, vI)
-						}
-						m[k] = append(m[k], v)
-					}
-				}
+			m, err := parseHeaderBlocks(headerI)
+			if err != nil {
+				return multierror.Prefix(err, "check ->")
 			}
-
 			check.Header = m
 
 			// Remove "header" as it has been parsed
@@ -1134,3 +1115,29 @@ func parseCheckRestart(cro *ast.ObjectItem) (*api.CheckRestart, error) {
 
 	return &checkRestart, nil
 }
+
+// parseHeaderBlocks merges repeated 'header' blocks, which HCL allows, into a
+// single map[string][]string.
+func parseHeaderBlocks(headerI interface{}) (map[string][]string, error) {
+	headerRaw, ok := headerI.([]map[string]interface{})
+	if !ok {
+		return nil, fmt.Errorf("header -> expected a []map[string][]string but found %T", headerI)
+	}
+	m := map[string][]string{}
+	for _, rawm := range headerRaw {
+		for k, vI := range rawm {
+			vs, ok := vI.([]interface{})
+			if !ok {
+				return nil, fmt.Errorf("header -> %q expected a []string but found %T", k, vI)
+			}
+			for _, vI := range vs {
+				v, ok := vI.(string)
+				if !ok {
+					return nil, fmt.Errorf("header -> %q expected a string but found %T", k, vI)
+				}
+				m[k] = append(m[k], v)
+			}
+		}
+	}
+	return m, nil
+}
@@ -36,6 +36,8 @@ var (
 		"identity",
 		"lifecycle",
 		"leader",
+		"output",
+		"prestop",
 		"restart",
 		"service",
 		"template",
@@ -104,10 +106,12 @@ func parseTask(item *ast.ObjectItem, keys []string) (*api.Task, error) {
 	delete(m, "affinity")
 	delete(m, "dispatch_payload")
 	delete(m, "lifecycle")
+	delete(m, "prestop")
 	delete(m, "env")
 	delete(m, "identity")
 	delete(m, "logs")
 	delete(m, "meta")
+	delete(m, "output")
 	delete(m, "resources")
 	delete(m, "restart")
 	delete(m, "service")
@@ -283,6 +287,13 @@ func parseTask(item *ast.ObjectItem, keys []string) (*api.Task, error) {
 		}
 	}
 
+	// Parse outputs
+	if o := listVal.Filter("output"); len(o.Items) > 0 {
+		if err := parseOutputs(&t.Outputs, o); err != nil {
+			return nil, multierror.Prefix(err, "output ->")
+		}
+	}
+
 	// Parse identity
 	if o := listVal.Filter("identity"); len(o.Items) > 0 {
 		v := &api.WorkloadIdentity{}
@@ -373,9 +384,97 @@ func parseTask(item *ast.ObjectItem, keys []string) (*api.Task, error) {
 			return nil, err
 		}
 	}
+
+	// If we have a prestop block parse that
+	if o := listVal.Filter("prestop"); len(o.Items) > 0 {
+		if len(o.Items) > 1 {
+			return nil, fmt.Errorf("only one prestop block is allowed in a task. Number of prestop blocks found: %d", len(o.Items))
+		}
+
+		preStop, err := parsePreStop(o.Items[0])
+		if err != nil {
+			return nil, multierror.Prefix(err, "prestop ->")
+		}
+		t.PreStop = preStop
+	}
 	return &t, nil
 }
 
+func parsePreStop(item *ast.ObjectItem) (*api.TaskPreStop, error) {
+	valid := []string{
+		"exec",
+		"http",
+		"timeout",
+	}
+	if err := checkHCLKeys(item.Val, valid); err != nil {
+		return nil, err
+	}
+
+	var m map[string]interface{}
+	if err := hcl.DecodeObject(&m, item.Val); err != nil {
+		return nil, err
+	}
+	delete(m, "exec")
+	delete(m, "http")
+
+	var preStop api.TaskPreStop
+	dec, err := mapstructure.NewDecoder(&mapstructure.DecoderConfig{
+		DecodeHook:       mapstructure.StringToTimeDurationHookFunc(),
+		WeaklyTypedInput: true,
+		Result:           &preStop,
+	})
+	if err != nil {
+		return nil, err
+	}
+	if err := dec.Decode(m); err != nil {
+		return nil, err
+	}
+
+	listVal := item.Val.(*ast.ObjectType).List
+	if o := listVal.Filter("exec"); len(o.Items) > 0 {
+		execBlock := o.Items[0]
+		if err := checkHCLKeys(execBlock.Val, []string{"command", "args"}); err != nil {
+			return nil, multierror.Prefix(err, "exec ->")
+		}
+
+		var m map[string]interface{}
+		if err := hcl.DecodeObject(&m, execBlock.Val); err != nil {
+			return nil, err
+		}
+		preStop.Exec = &api.TaskPreStopExec{}
+		if err := mapstructure.WeakDecode(m, preStop.Exec); err != nil {
+			return nil, err
+		}
+	}
+
+	if o := listVal.Filter("http"); len(o.Items) > 0 {
+		httpBlock := o.Items[0]
+		valid := []string{"port", "path", "method", "protocol", "header"}
+		if err := checkHCLKeys(httpBlock.Val, valid); err != nil {
+			return nil, multierror.Prefix(err, "http ->")
+		}
+
+		var m map[string]interface{}
+		if err := hcl.DecodeObject(&m, httpBlock.Val); err != nil {
+			return nil, err
+		}
+		preStop.HTTP = &api.TaskPreStopHTTP{}
+		if headerI, ok := m["header"]; ok {
+			header, err := parseHeaderBlocks(headerI)
+			if err != nil {
+				return nil, multierror.Prefix(err, "http ->")
+			}
+			preStop.HTTP.Header = header
+			delete(m, "header")
+		}
+		if err := mapstructure.WeakDecode(m, preStop.HTTP); err != nil {
+			return nil, err
+		}
+	}
+
+	return &preStop, nil
+}
+
 func parseArtifacts(result *[]*api.TaskArtifact, list *ast.ObjectList) error {
 	for _, o := range list.Elem().Items {
 		// Check for invalid keys
@@ -444,6 +543,62 @@ func parseArtifactOption(result map[string]string, list *ast.ObjectList) error {
 	return nil
 }
 
+func parseOutputs(result *[]*api.TaskOutput, list *ast.ObjectList) error {
+	for _, o := range list.Elem().Items {
+		// Check for invalid keys
+		valid := []string{
+			"source",
+			"destination",
+			"options",
+			"headers",
+			"when",
+		}
+		if err := checkHCLKeys(o.Val, valid); err != nil {
+			return err
+		}
+
+		var m map[string]interface{}
+		if err := hcl.DecodeObject(&m, o.Val); err != nil {
+			return err
+		}
+
+		delete(m, "options")
+
+		var to api.TaskOutput
+		if err := mapstructure.WeakDecode(m, &to); err != nil {
+			return err
+		}
+
+		var optionList *ast.ObjectList
+		if ot, ok := o.Val.(*ast.ObjectType); ok {
+			optionList = ot.List
+		} else {
+			return fmt.Errorf("output should be an object")
+		}
+
+		if oo := optionList.Filter("options"); len(oo.Items) > 0 {
+			if len(oo.Elem().Items) > 1 {
+				return fmt.Errorf("only one 'options' block allowed per output")
+			}
+
+			var om map[string]interface{}
+			if err := hcl.DecodeObject(&om, oo.Elem().Items[0].Val); err != nil {
+				return multierror.Prefix(err, "options: ")
+			}
+
+			options := make(map[string]string)
+			if err := mapstructure.WeakDecode(om, &options); err != nil {
+				return multierror.Prefix(err, "options: ")
+			}
+			to.Options = options
+		}
+
+		*result = append(*result, &to)
+	}
+
+	return nil
+}
+
 func parseTemplates(result *[]*api.Template, list *ast.ObjectList) error {
 	for _, o := range list.Elem().Items {
 		// we'll need a list of all ast objects for later
@@ -774,6 +929,7 @@ func parseIdentity(out *api.WorkloadIdentity, list *ast.ObjectList) error {
 	valid := []string{
 		"env",
 		"file",
+		"auth_method",
 	}
 
 	if err := checkHCLKeys(listVal, valid); err != nil {
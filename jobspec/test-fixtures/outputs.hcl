@@ -0,0 +1,26 @@
+job "binstore-storagelocker" {
+  group "binsl" {
+    task "binstore" {
+      driver = "docker"
+
+      output {
+        source      = "local/results/*.csv"
+        destination = "s3://results/${NOMAD_JOB_ID}/"
+
+        options {
+          region = "us-east-1"
+        }
+      }
+
+      output {
+        source      = "local/debug.log"
+        destination = "https://example.com/upload/debug.log"
+        when        = "on_failure"
+
+        headers {
+          Authorization = "Bearer secret"
+        }
+      }
+    }
+  }
+}
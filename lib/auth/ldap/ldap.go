@@ -0,0 +1,262 @@
+package ldap
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
+	"fmt"
+	"net"
+	"net/url"
+	"strings"
+	"text/template"
+	"time"
+
+	goldap "github.com/go-ldap/ldap/v3"
+
+	"github.com/hashicorp/nomad/nomad/structs"
+)
+
+const (
+	// defaultTimeout is the timeout of LDAP requests when the context has no
+	// deadline.
+	defaultTimeout = 30 * time.Second
+)
+
+var (
+	// ErrInvalidCredentials is returned when the user doesn't exist or the
+	// password is wrong. Both cases return the same error so the response
+	// doesn't reveal which users exist.
+	ErrInvalidCredentials = errors.New("invalid username or password")
+)
+
+// filterData is the data used to render the user and group filters.
+type filterData struct {
+	Username string
+	UserDN   string
+}
+
+// Authenticate verifies the username and password of a user against the LDAP
+// server of the auth method, and returns the claims of the user. The claims
+// contain the "username" and "dn" of the user, the names of the user's
+// "groups", and the "attributes" of the user's entry. They can be mapped to
+// binding rule selector values using the auth method's claim mappings.
+func Authenticate(
+	ctx context.Context, username, password string, conf *structs.ACLAuthMethodConfig) (map[string]any, error) {
+
+	if username == "" || password == "" {
+		return nil, ErrInvalidCredentials
+	}
+
+	conn, err := dial(ctx, conf)
+	if err != nil {
+		return nil, err
+	}
+	defer conn.Close()
+
+	if err := bindService(conn, conf); err != nil {
+		return nil, err
+	}
+
+	user, err := searchUser(conn, username, conf)
+	if err != nil {
+		return nil, err
+	}
+
+	// Verify the password by binding as the user. Bind never allows empty
+	// passwords, which would otherwise result in an unauthenticated bind
+	// that always succeeds.
+	if err := conn.Bind(user.DN, password); err != nil {
+		if goldap.IsErrorWithCode(err, goldap.LDAPResultInvalidCredentials) {
+			return nil, ErrInvalidCredentials
+		}
+		return nil, fmt.Errorf("failed to bind as user: %w", err)
+	}
+
+	var groups []any
+	if conf.LDAPGroupBaseDN != "" {
+		// Search for groups using the service account, as users may not be
+		// allowed to read group entries.
+		if err := bindService(conn, conf); err != nil {
+			return nil, err
+		}
+		groups, err = searchGroups(conn, username, user.DN, conf)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	attributes := make(map[string]any, len(user.Attributes))
+	for _, attr := range user.Attributes {
+		switch len(attr.Values) {
+		case 0:
+		case 1:
+			attributes[attr.Name] = attr.Values[0]
+		default:
+			values := make([]any, len(attr.Values))
+			for i, v := range attr.Values {
+				values[i] = v
+			}
+			attributes[attr.Name] = values
+		}
+	}
+
+	return map[string]any{
+		"username":   username,
+		"dn":         user.DN,
+		"groups":     groups,
+		"attributes": attributes,
+	}, nil
+}
+
+// dial connects to the LDAP server, upgrading the connection to TLS if
+// StartTLS is enabled.
+func dial(ctx context.Context, conf *structs.ACLAuthMethodConfig) (*goldap.Conn, error) {
+	timeout := defaultTimeout
+	if deadline, ok := ctx.Deadline(); ok {
+		timeout = time.Until(deadline)
+	}
+
+	u, err := url.Parse(conf.LDAPURL)
+	if err != nil {
+		return nil, fmt.Errorf("invalid LDAP URL: %w", err)
+	}
+
+	tlsConf, err := tlsConfig(u, conf)
+	if err != nil {
+		return nil, err
+	}
+
+	conn, err := goldap.DialURL(conf.LDAPURL,
+		goldap.DialWithDialer(&net.Dialer{Timeout: timeout}),
+		goldap.DialWithTLSConfig(tlsConf),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to connect to LDAP server: %w", err)
+	}
+	conn.SetTimeout(timeout)
+
+	if conf.LDAPStartTLS && u.Scheme == "ldap" {
+		if err := conn.StartTLS(tlsConf); err != nil {
+			conn.Close()
+			return nil, fmt.Errorf("failed to start TLS: %w", err)
+		}
+	}
+	return conn, nil
+}
+
+// tlsConfig returns the TLS config used for ldaps URLs and StartTLS.
+func tlsConfig(u *url.URL, conf *structs.ACLAuthMethodConfig) (*tls.Config, error) {
+	tlsConf := &tls.Config{
+		ServerName:         u.Hostname(),
+		InsecureSkipVerify: conf.LDAPInsecureSkipVerify,
+		MinVersion:         tls.VersionTLS12,
+	}
+
+	if conf.LDAPCACert != "" {
+		pool := x509.NewCertPool()
+		if !pool.AppendCertsFromPEM([]byte(conf.LDAPCACert)) {
+			return nil, errors.New("failed to parse LDAP CA cert")
+		}
+		tlsConf.RootCAs = pool
+	}
+	return tlsConf, nil
+}
+
+// bindService binds using the service account of the auth method, or
+// anonymously if it has none.
+func bindService(conn *goldap.Conn, conf *structs.ACLAuthMethodConfig) error {
+	var err error
+	if conf.LDAPBindDN != "" {
+		err = conn.Bind(conf.LDAPBindDN, conf.LDAPBindPassword)
+	} else {
+		err = conn.UnauthenticatedBind("")
+	}
+	if err != nil {
+		return fmt.Errorf("failed to bind to LDAP server: %w", err)
+	}
+	return nil
+}
+
+// searchUser returns the entry of the user. The user must match exactly one
+// entry.
+func searchUser(conn *goldap.Conn, username string, conf *structs.ACLAuthMethodConfig) (*goldap.Entry, error) {
+	filter, err := renderFilter(
+		conf.LDAPUserFilter, structs.ACLAuthMethodLDAPDefaultUserFilter, filterData{Username: username})
+	if err != nil {
+		return nil, fmt.Errorf("invalid user filter: %w", err)
+	}
+
+	req := goldap.NewSearchRequest(
+		conf.LDAPUserBaseDN, goldap.ScopeWholeSubtree, goldap.NeverDerefAliases,
+		2, 0, false, filter, conf.LDAPUserAttributes, nil)
+
+	res, err := conn.Search(req)
+	if err != nil && !goldap.IsErrorWithCode(err, goldap.LDAPResultSizeLimitExceeded) {
+		return nil, fmt.Errorf("failed to search for user: %w", err)
+	}
+
+	switch {
+	case res == nil || len(res.Entries) == 0:
+		return nil, ErrInvalidCredentials
+	case len(res.Entries) > 1:
+		return nil, fmt.Errorf("user filter matched multiple entries for %q", username)
+	default:
+		return res.Entries[0], nil
+	}
+}
+
+// searchGroups returns the names of the groups of the user.
+func searchGroups(conn *goldap.Conn, username, userDN string, conf *structs.ACLAuthMethodConfig) ([]any, error) {
+	filter, err := renderFilter(
+		conf.LDAPGroupFilter, structs.ACLAuthMethodLDAPDefaultGroupFilter,
+		filterData{Username: username, UserDN: userDN})
+	if err != nil {
+		return nil, fmt.Errorf("invalid group filter: %w", err)
+	}
+
+	attr := conf.LDAPGroupAttribute
+	if attr == "" {
+		attr = structs.ACLAuthMethodLDAPDefaultGroupAttribute
+	}
+
+	req := goldap.NewSearchRequest(
+		conf.LDAPGroupBaseDN, goldap.ScopeWholeSubtree, goldap.NeverDerefAliases,
+		0, 0, false, filter, []string{attr}, nil)
+
+	res, err := conn.Search(req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to search for groups: %w", err)
+	}
+
+	groups := make([]any, 0, len(res.Entries))
+	for _, entry := range res.Entries {
+		if name := entry.GetEqualFoldAttributeValue(attr); name != "" {
+			groups = append(groups, name)
+		}
+	}
+	return groups, nil
+}
+
+// renderFilter renders a filter template, escaping the values of the data.
+func renderFilter(filter, defaultFilter string, data filterData) (string, error) {
+	if filter == "" {
+		filter = defaultFilter
+	}
+
+	tmpl, err := template.New("filter").Option("missingkey=error").Parse(filter)
+	if err != nil {
+		return "", err
+	}
+
+	escaped := filterData{
+		Username: goldap.EscapeFilter(data.Username),
+		UserDN:   goldap.EscapeFilter(data.UserDN),
+	}
+
+	var b strings.Builder
+	if err := tmpl.Execute(&b, escaped); err != nil {
+		return "", err
+	}
+	return b.String(), nil
+}
@@ -0,0 +1,199 @@
+package ldap
+
+import (
+	"context"
+	"testing"
+
+	"github.com/shoenig/test/must"
+
+	"github.com/hashicorp/nomad/ci"
+	"github.com/hashicorp/nomad/nomad/structs"
+)
+
+func testServer(t *testing.T) *TestServer {
+	return NewTestServer(t,
+		&TestEntry{
+			DN:       "cn=admin,dc=example,dc=com",
+			Password: "admin-password",
+		},
+		&TestEntry{
+			DN:       "uid=alice,ou=users,dc=example,dc=com",
+			Password: "alice-password",
+			Attributes: map[string][]string{
+				"uid":  {"alice"},
+				"mail": {"alice@example.com"},
+				"role": {"engineering", "oncall"},
+			},
+		},
+		&TestEntry{
+			DN:       "uid=bob*,ou=users,dc=example,dc=com",
+			Password: "bob-password",
+			Attributes: map[string][]string{
+				"uid": {"bob*"},
+			},
+		},
+		&TestEntry{
+			DN: "cn=engineering,ou=groups,dc=example,dc=com",
+			Attributes: map[string][]string{
+				"cn":     {"engineering"},
+				"member": {"uid=alice,ou=users,dc=example,dc=com"},
+			},
+		},
+		&TestEntry{
+			DN: "cn=admins,ou=groups,dc=example,dc=com",
+			Attributes: map[string][]string{
+				"cn":        {"admins"},
+				"memberUid": {"alice"},
+			},
+		},
+		&TestEntry{
+			DN: "cn=sales,ou=groups,dc=example,dc=com",
+			Attributes: map[string][]string{
+				"cn":     {"sales"},
+				"member": {"uid=carol,ou=users,dc=example,dc=com"},
+			},
+		},
+	)
+}
+
+func TestAuthenticate(t *testing.T) {
+	ci.Parallel(t)
+
+	srv := testServer(t)
+	conf := &structs.ACLAuthMethodConfig{
+		LDAPURL:          srv.URL(),
+		LDAPBindDN:       "cn=admin,dc=example,dc=com",
+		LDAPBindPassword: "admin-password",
+		LDAPUserBaseDN:   "ou=users,dc=example,dc=com",
+		LDAPGroupBaseDN:  "ou=groups,dc=example,dc=com",
+	}
+
+	claims, err := Authenticate(context.Background(), "alice", "alice-password", conf)
+	must.NoError(t, err)
+	must.Eq(t, map[string]any{
+		"username": "alice",
+		"dn":       "uid=alice,ou=users,dc=example,dc=com",
+		"groups":   []any{"engineering", "admins"},
+		"attributes": map[string]any{
+			"uid":  "alice",
+			"mail": "alice@example.com",
+			"role": []any{"engineering", "oncall"},
+		},
+	}, claims)
+
+	// Groups are searched for using the service account
+	must.Eq(t, []string{
+		"cn=admin,dc=example,dc=com",
+		"uid=alice,ou=users,dc=example,dc=com",
+		"cn=admin,dc=example,dc=com",
+	}, srv.Binds())
+}
+
+func TestAuthenticate_Attributes(t *testing.T) {
+	ci.Parallel(t)
+
+	srv := testServer(t)
+	conf := &structs.ACLAuthMethodConfig{
+		LDAPURL:            srv.URL(),
+		LDAPUserBaseDN:     "ou=users,dc=example,dc=com",
+		LDAPUserFilter:     "(&(uid={{.Username}})(mail=*))",
+		LDAPUserAttributes: []string{"mail"},
+	}
+
+	// Without a bind DN the user is searched for anonymously, and without a
+	// group base DN no groups are returned.
+	claims, err := Authenticate(context.Background(), "alice", "alice-password", conf)
+	must.NoError(t, err)
+	must.Nil(t, claims["groups"])
+	must.Eq[any](t, map[string]any{"mail": "alice@example.com"}, claims["attributes"])
+	must.Eq(t, []string{"", "uid=alice,ou=users,dc=example,dc=com"}, srv.Binds())
+}
+
+func TestAuthenticate_InvalidCredentials(t *testing.T) {
+	ci.Parallel(t)
+
+	srv := testServer(t)
+	conf := &structs.ACLAuthMethodConfig{
+		LDAPURL:        srv.URL(),
+		LDAPUserBaseDN: "ou=users,dc=example,dc=com",
+	}
+
+	testCases := []struct {
+		name     string
+		username string
+		password string
+	}{
+		{
+			name:     "wrong password",
+			username: "alice",
+			password: "bob-password",
+		},
+		{
+			name:     "unknown user",
+			username: "carol",
+			password: "carol-password",
+		},
+		{
+			name:     "empty password",
+			username: "alice",
+			password: "",
+		},
+		{
+			name:     "filter injection",
+			username: "*",
+			password: "alice-password",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			_, err := Authenticate(context.Background(), tc.username, tc.password, conf)
+			must.ErrorIs(t, err, ErrInvalidCredentials)
+		})
+	}
+
+	// Special characters in the username are escaped rather than matching
+	// other users.
+	claims, err := Authenticate(context.Background(), "bob*", "bob-password", conf)
+	must.NoError(t, err)
+	must.Eq[any](t, "uid=bob*,ou=users,dc=example,dc=com", claims["dn"])
+}
+
+func TestAuthenticate_MultipleUsers(t *testing.T) {
+	ci.Parallel(t)
+
+	srv := testServer(t)
+	conf := &structs.ACLAuthMethodConfig{
+		LDAPURL:        srv.URL(),
+		LDAPUserBaseDN: "ou=users,dc=example,dc=com",
+		LDAPUserFilter: "(|(uid={{.Username}})(uid=bob*))",
+	}
+
+	_, err := Authenticate(context.Background(), "alice", "alice-password", conf)
+	must.ErrorContains(t, err, "matched multiple entries")
+}
+
+func TestAuthenticate_StartTLS(t *testing.T) {
+	ci.Parallel(t)
+
+	srv := testServer(t)
+	conf := &structs.ACLAuthMethodConfig{
+		LDAPURL:        srv.URL(),
+		LDAPStartTLS:   true,
+		LDAPUserBaseDN: "ou=users,dc=example,dc=com",
+	}
+
+	// The server certificate isn't trusted without the CA cert
+	_, err := Authenticate(context.Background(), "alice", "alice-password", conf)
+	must.ErrorContains(t, err, "failed to start TLS")
+
+	conf.LDAPCACert = srv.CACert
+	claims, err := Authenticate(context.Background(), "alice", "alice-password", conf)
+	must.NoError(t, err)
+	must.Eq[any](t, "alice", claims["username"])
+
+	conf.LDAPCACert = ""
+	conf.LDAPInsecureSkipVerify = true
+	_, err = Authenticate(context.Background(), "alice", "alice-password", conf)
+	must.NoError(t, err)
+}
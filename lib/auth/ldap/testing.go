@@ -0,0 +1,388 @@
+package ldap
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"math/big"
+	"net"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	ber "github.com/go-asn1-ber/asn1-ber"
+	goldap "github.com/go-ldap/ldap/v3"
+)
+
+// startTLSOID is the OID of the StartTLS extended operation.
+const startTLSOID = "1.3.6.1.4.1.1466.20037"
+
+// TestEntry is an entry of a TestServer.
+type TestEntry struct {
+	// DN is the distinguished name of the entry
+	DN string
+
+	// Password is the password used to bind as the entry. Binding as entries
+	// without a password always fails.
+	Password string
+
+	// Attributes are the attributes of the entry
+	Attributes map[string][]string
+}
+
+// TestServer is an in-process LDAP server used to test LDAP auth methods. It
+// supports simple binds, StartTLS, and subtree searches using and, or, not,
+// equality, substrings and presence filters.
+type TestServer struct {
+	// CACert is the PEM encoded certificate the server uses for StartTLS
+	CACert string
+
+	listener  net.Listener
+	tlsConfig *tls.Config
+
+	l       sync.Mutex
+	entries []*TestEntry
+	binds   []string
+}
+
+// NewTestServer starts a TestServer with the given entries. The server is
+// stopped when the test completes.
+func NewTestServer(t testing.TB, entries ...*TestEntry) *TestServer {
+	t.Helper()
+
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatalf("failed to listen: %v", err)
+	}
+
+	cert, certPEM := testCertificate(t)
+	s := &TestServer{
+		CACert:    certPEM,
+		listener:  listener,
+		tlsConfig: &tls.Config{Certificates: []tls.Certificate{cert}},
+		entries:   entries,
+	}
+	t.Cleanup(func() { _ = listener.Close() })
+
+	go s.serve()
+	return s
+}
+
+// URL returns the ldap:// URL of the server.
+func (s *TestServer) URL() string {
+	return "ldap://" + s.listener.Addr().String()
+}
+
+// AddEntry adds an entry to the server.
+func (s *TestServer) AddEntry(entry *TestEntry) {
+	s.l.Lock()
+	defer s.l.Unlock()
+	s.entries = append(s.entries, entry)
+}
+
+// Binds returns the DNs of the successful binds, in order. Anonymous binds
+// are recorded as empty strings.
+func (s *TestServer) Binds() []string {
+	s.l.Lock()
+	defer s.l.Unlock()
+	return append([]string(nil), s.binds...)
+}
+
+func (s *TestServer) serve() {
+	for {
+		conn, err := s.listener.Accept()
+		if err != nil {
+			return
+		}
+		go s.handle(conn)
+	}
+}
+
+func (s *TestServer) handle(conn net.Conn) {
+	defer func() { _ = conn.Close() }()
+
+	for {
+		packet, err := ber.ReadPacket(conn)
+		if err != nil || len(packet.Children) < 2 {
+			return
+		}
+		id, _ := packet.Children[0].Value.(int64)
+		op := packet.Children[1]
+
+		switch op.Tag {
+		case goldap.ApplicationBindRequest:
+			code := s.bind(op)
+			_, err = conn.Write(response(id, result(goldap.ApplicationBindResponse, code)))
+
+		case goldap.ApplicationSearchRequest:
+			err = s.search(conn, id, op)
+
+		case goldap.ApplicationExtendedRequest:
+			if len(op.Children) == 0 || op.Children[0].Data.String() != startTLSOID {
+				_, err = conn.Write(response(id,
+					result(goldap.ApplicationExtendedResponse, goldap.LDAPResultProtocolError)))
+				break
+			}
+			if _, err = conn.Write(response(id,
+				result(goldap.ApplicationExtendedResponse, goldap.LDAPResultSuccess))); err != nil {
+				break
+			}
+			tlsConn := tls.Server(conn, s.tlsConfig)
+			err = tlsConn.Handshake()
+			conn = tlsConn
+
+		default:
+			// Unbind and unsupported requests close the connection
+			return
+		}
+
+		if err != nil {
+			return
+		}
+	}
+}
+
+// bind handles a simple bind request and returns its result code.
+func (s *TestServer) bind(op *ber.Packet) uint16 {
+	if len(op.Children) < 3 {
+		return goldap.LDAPResultProtocolError
+	}
+	dn := op.Children[1].Data.String()
+	password := op.Children[2].Data.String()
+
+	s.l.Lock()
+	defer s.l.Unlock()
+
+	// Unauthenticated binds are allowed
+	if password == "" {
+		s.binds = append(s.binds, "")
+		return goldap.LDAPResultSuccess
+	}
+
+	for _, entry := range s.entries {
+		if strings.EqualFold(entry.DN, dn) && entry.Password != "" && entry.Password == password {
+			s.binds = append(s.binds, entry.DN)
+			return goldap.LDAPResultSuccess
+		}
+	}
+	return goldap.LDAPResultInvalidCredentials
+}
+
+// search handles a search request, writing the matching entries and the
+// search result.
+func (s *TestServer) search(conn net.Conn, id int64, op *ber.Packet) error {
+	if len(op.Children) < 8 {
+		_, err := conn.Write(response(id,
+			result(goldap.ApplicationSearchResultDone, goldap.LDAPResultProtocolError)))
+		return err
+	}
+
+	baseDN := strings.ToLower(op.Children[0].Data.String())
+	sizeLimit, _ := op.Children[3].Value.(int64)
+	filter := op.Children[6]
+
+	var attrs []string
+	for _, attr := range op.Children[7].Children {
+		attrs = append(attrs, attr.Data.String())
+	}
+
+	s.l.Lock()
+	var matches []*TestEntry
+	for _, entry := range s.entries {
+		dn := strings.ToLower(entry.DN)
+		if dn != baseDN && !strings.HasSuffix(dn, ","+baseDN) {
+			continue
+		}
+		if matchFilter(filter, entry) {
+			matches = append(matches, entry)
+		}
+	}
+	s.l.Unlock()
+
+	code := uint16(goldap.LDAPResultSuccess)
+	if sizeLimit > 0 && int64(len(matches)) > sizeLimit {
+		matches = matches[:sizeLimit]
+		code = goldap.LDAPResultSizeLimitExceeded
+	}
+
+	for _, entry := range matches {
+		if _, err := conn.Write(response(id, searchEntry(entry, attrs))); err != nil {
+			return err
+		}
+	}
+	_, err := conn.Write(response(id, result(goldap.ApplicationSearchResultDone, code)))
+	return err
+}
+
+// matchFilter returns whether the entry matches the compiled filter.
+func matchFilter(filter *ber.Packet, entry *TestEntry) bool {
+	switch filter.Tag {
+	case goldap.FilterAnd:
+		for _, child := range filter.Children {
+			if !matchFilter(child, entry) {
+				return false
+			}
+		}
+		return true
+
+	case goldap.FilterOr:
+		for _, child := range filter.Children {
+			if matchFilter(child, entry) {
+				return true
+			}
+		}
+		return false
+
+	case goldap.FilterNot:
+		return len(filter.Children) == 1 && !matchFilter(filter.Children[0], entry)
+
+	case goldap.FilterEqualityMatch:
+		if len(filter.Children) != 2 {
+			return false
+		}
+		expected := filter.Children[1].Data.String()
+		for _, v := range attributeValues(entry, filter.Children[0].Data.String()) {
+			if strings.EqualFold(v, expected) {
+				return true
+			}
+		}
+		return false
+
+	case goldap.FilterPresent:
+		return len(attributeValues(entry, filter.Data.String())) > 0
+
+	case goldap.FilterSubstrings:
+		if len(filter.Children) != 2 {
+			return false
+		}
+		for _, v := range attributeValues(entry, filter.Children[0].Data.String()) {
+			if matchSubstrings(filter.Children[1].Children, strings.ToLower(v)) {
+				return true
+			}
+		}
+		return false
+
+	default:
+		return false
+	}
+}
+
+// matchSubstrings returns whether the value matches the substrings of a
+// substrings filter.
+func matchSubstrings(substrings []*ber.Packet, value string) bool {
+	for _, sub := range substrings {
+		part := strings.ToLower(sub.Data.String())
+		switch sub.Tag {
+		case goldap.FilterSubstringsInitial:
+			if !strings.HasPrefix(value, part) {
+				return false
+			}
+			value = value[len(part):]
+		case goldap.FilterSubstringsAny:
+			i := strings.Index(value, part)
+			if i < 0 {
+				return false
+			}
+			value = value[i+len(part):]
+		case goldap.FilterSubstringsFinal:
+			if !strings.HasSuffix(value, part) {
+				return false
+			}
+		}
+	}
+	return true
+}
+
+// attributeValues returns the values of an attribute of the entry, matching
+// the attribute name case insensitively.
+func attributeValues(entry *TestEntry, attr string) []string {
+	for name, values := range entry.Attributes {
+		if strings.EqualFold(name, attr) {
+			return values
+		}
+	}
+	return nil
+}
+
+func response(id int64, op *ber.Packet) []byte {
+	packet := ber.Encode(ber.ClassUniversal, ber.TypeConstructed, ber.TagSequence, nil, "LDAP Response")
+	packet.AppendChild(ber.NewInteger(ber.ClassUniversal, ber.TypePrimitive, ber.TagInteger, id, "MessageID"))
+	packet.AppendChild(op)
+	return packet.Bytes()
+}
+
+func result(tag ber.Tag, code uint16) *ber.Packet {
+	packet := ber.Encode(ber.ClassApplication, ber.TypeConstructed, tag, nil, "Result")
+	packet.AppendChild(ber.NewInteger(ber.ClassUniversal, ber.TypePrimitive, ber.TagEnumerated, int64(code), "Result Code"))
+	packet.AppendChild(ber.NewString(ber.ClassUniversal, ber.TypePrimitive, ber.TagOctetString, "", "Matched DN"))
+	packet.AppendChild(ber.NewString(ber.ClassUniversal, ber.TypePrimitive, ber.TagOctetString, "", "Diagnostic Message"))
+	return packet
+}
+
+func searchEntry(entry *TestEntry, attrs []string) *ber.Packet {
+	packet := ber.Encode(ber.ClassApplication, ber.TypeConstructed, goldap.ApplicationSearchResultEntry, nil, "Search Result Entry")
+	packet.AppendChild(ber.NewString(ber.ClassUniversal, ber.TypePrimitive, ber.TagOctetString, entry.DN, "DN"))
+
+	attributes := ber.Encode(ber.ClassUniversal, ber.TypeConstructed, ber.TagSequence, nil, "Attributes")
+	for name, values := range entry.Attributes {
+		if len(attrs) > 0 && !containsFold(attrs, name) {
+			continue
+		}
+
+		attribute := ber.Encode(ber.ClassUniversal, ber.TypeConstructed, ber.TagSequence, nil, "Attribute")
+		attribute.AppendChild(ber.NewString(ber.ClassUniversal, ber.TypePrimitive, ber.TagOctetString, name, "Type"))
+		set := ber.Encode(ber.ClassUniversal, ber.TypeConstructed, ber.TagSet, nil, "Values")
+		for _, value := range values {
+			set.AppendChild(ber.NewString(ber.ClassUniversal, ber.TypePrimitive, ber.TagOctetString, value, "Value"))
+		}
+		attribute.AppendChild(set)
+		attributes.AppendChild(attribute)
+	}
+	packet.AppendChild(attributes)
+	return packet
+}
+
+func containsFold(list []string, s string) bool {
+	for _, item := range list {
+		if strings.EqualFold(item, s) {
+			return true
+		}
+	}
+	return false
+}
+
+// testCertificate returns a self-signed certificate for 127.0.0.1 and its PEM
+// encoding.
+func testCertificate(t testing.TB) (tls.Certificate, string) {
+	t.Helper()
+
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatalf("failed to generate key: %v", err)
+	}
+
+	template := &x509.Certificate{
+		SerialNumber:          big.NewInt(1),
+		Subject:               pkix.Name{CommonName: "127.0.0.1"},
+		IPAddresses:           []net.IP{net.ParseIP("127.0.0.1")},
+		NotBefore:             time.Now().Add(-time.Minute),
+		NotAfter:              time.Now().Add(time.Hour),
+		KeyUsage:              x509.KeyUsageDigitalSignature | x509.KeyUsageCertSign,
+		ExtKeyUsage:           []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth},
+		BasicConstraintsValid: true,
+		IsCA:                  true,
+	}
+
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	if err != nil {
+		t.Fatalf("failed to create certificate: %v", err)
+	}
+
+	return tls.Certificate{Certificate: [][]byte{der}, PrivateKey: key},
+		string(pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der}))
+}
@@ -35,8 +35,15 @@ func (s *Server) Authenticate(ctx *RPCContext, args structs.RequestWithIdentity)
 
 	switch {
 	case err == nil:
-		// If ACLs are disabled or we have a non-anonymous token, return that.
-		if aclToken == nil || aclToken != structs.AnonymousACLToken {
+		// If ACLs are disabled there's no token, but the caller is still
+		// identified for RPC rate limits.
+		if aclToken == nil {
+			args.SetIdentity(s.identityWithoutACLs(ctx, secretID))
+			return nil
+		}
+
+		// If we have a non-anonymous token, return that.
+		if aclToken != structs.AnonymousACLToken {
 			args.SetIdentity(&structs.AuthenticatedIdentity{ACLToken: aclToken})
 			return nil
 		}
@@ -131,6 +138,44 @@ func (s *Server) Authenticate(ctx *RPCContext, args structs.RequestWithIdentity)
 	return nil
 }
 
+// identityWithoutACLs returns the identity of a request when ACLs are
+// disabled. It has no ACL token, but the leader's own requests, clients and
+// remote addresses are identified as they would be with ACLs enabled, so that
+// RPC rate limits don't apply to the cluster itself and other callers don't
+// share a single limit.
+func (s *Server) identityWithoutACLs(ctx *RPCContext, secretID string) *structs.AuthenticatedIdentity {
+	identity := &structs.AuthenticatedIdentity{}
+	if leaderAcl := s.getLeaderAcl(); leaderAcl != "" && secretID == leaderAcl {
+		identity.ACLToken = structs.LeaderACLToken
+		return identity
+	}
+	if ctx == nil {
+		return identity
+	}
+
+	if ctx.NodeID != "" {
+		identity.ClientID = ctx.NodeID
+		return identity
+	}
+	if helper.IsUUID(secretID) {
+		node, err := s.State().NodeBySecretID(nil, secretID)
+		if err == nil && node != nil {
+			identity.ClientID = node.ID
+			return identity
+		}
+	}
+
+	if ctx.TLS {
+		identity.TLSName = ctx.Certificate().Subject.CommonName
+	}
+	remoteIP, err := s.remoteIPFromRPCContext(ctx)
+	if err != nil {
+		s.logger.Error("could not determine remote address", "error", err)
+	}
+	identity.RemoteIP = remoteIP
+	return identity
+}
+
 func (s *Server) remoteIPFromRPCContext(ctx *RPCContext) (net.IP, error) {
 	var remoteAddr *net.TCPAddr
 	var ok bool
@@ -312,6 +357,23 @@ func resolveACLFromToken(snap *state.StateSnapshot, cache *structs.ACLCache[*acl
 		return acl.ManagementACL, nil
 	}
 
+	policies, err := resolvePoliciesFromToken(snap, token)
+	if err != nil {
+		return nil, err
+	}
+
+	// Compile and cache the ACL object
+	aclObj, err := structs.CompileACLObject(cache, policies)
+	if err != nil {
+		return nil, err
+	}
+	return aclObj, nil
+}
+
+// resolvePoliciesFromToken returns the ACL policies of a client token,
+// including the policies of its roles.
+func resolvePoliciesFromToken(snap *state.StateSnapshot, token *structs.ACLToken) ([]*structs.ACLPolicy, error) {
+
 	// Store all policies detailed in the token request, this includes the
 	// named policies and those referenced within the role link.
 	policies := make([]*structs.ACLPolicy, 0, len(token.Policies)+len(token.Roles))
@@ -367,12 +429,7 @@ func resolveACLFromToken(snap *state.StateSnapshot, cache *structs.ACLCache[*acl
 		}
 	}
 
-	// Compile and cache the ACL object
-	aclObj, err := structs.CompileACLObject(cache, policies)
-	if err != nil {
-		return nil, err
-	}
-	return aclObj, nil
+	return policies, nil
 }
 
 // ResolveSecretToken is used to translate an ACL Token Secret ID into
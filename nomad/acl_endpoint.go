@@ -7,6 +7,7 @@ import (
 	"net/http"
 	"os"
 	"path/filepath"
+	"sort"
 	"strings"
 	"time"
 
@@ -18,13 +19,16 @@ import (
 
 	policy "github.com/hashicorp/nomad/acl"
 	"github.com/hashicorp/nomad/helper"
+	"github.com/hashicorp/nomad/helper/pointer"
 	"github.com/hashicorp/nomad/helper/uuid"
 	"github.com/hashicorp/nomad/lib/auth"
 	"github.com/hashicorp/nomad/lib/auth/jwt"
+	"github.com/hashicorp/nomad/lib/auth/ldap"
 	"github.com/hashicorp/nomad/lib/auth/oidc"
 	"github.com/hashicorp/nomad/nomad/state"
 	"github.com/hashicorp/nomad/nomad/state/paginator"
 	"github.com/hashicorp/nomad/nomad/structs"
+	"golang.org/x/exp/slices"
 )
 
 var (
@@ -84,7 +88,9 @@ func (a *ACL) UpsertPolicies(args *structs.ACLPolicyUpsertRequest, reply *struct
 	if done, err := a.srv.forward("ACL.UpsertPolicies", args, args, reply); done {
 		return err
 	}
-	a.srv.MeasureRPCRate("acl", structs.RateMetricWrite, args)
+	if err := a.srv.MeasureRPCRate("acl", structs.RateMetricWrite, args); err != nil {
+		return err
+	}
 	if authErr != nil {
 		return structs.ErrPermissionDenied
 	}
@@ -133,7 +139,9 @@ func (a *ACL) DeletePolicies(args *structs.ACLPolicyDeleteRequest, reply *struct
 	if done, err := a.srv.forward("ACL.DeletePolicies", args, args, reply); done {
 		return err
 	}
-	a.srv.MeasureRPCRate("acl", structs.RateMetricWrite, args)
+	if err := a.srv.MeasureRPCRate("acl", structs.RateMetricWrite, args); err != nil {
+		return err
+	}
 	if authErr != nil {
 		return structs.ErrPermissionDenied
 	}
@@ -171,7 +179,9 @@ func (a *ACL) ListPolicies(args *structs.ACLPolicyListRequest, reply *structs.AC
 	if done, err := a.srv.forward("ACL.ListPolicies", args, args, reply); done {
 		return err
 	}
-	a.srv.MeasureRPCRate("acl", structs.RateMetricList, args)
+	if err := a.srv.MeasureRPCRate("acl", structs.RateMetricList, args); err != nil {
+		return err
+	}
 	if authErr != nil {
 		return structs.ErrPermissionDenied
 	}
@@ -265,7 +275,9 @@ func (a *ACL) GetPolicy(args *structs.ACLPolicySpecificRequest, reply *structs.S
 	if done, err := a.srv.forward("ACL.GetPolicy", args, args, reply); done {
 		return err
 	}
-	a.srv.MeasureRPCRate("acl", structs.RateMetricRead, args)
+	if err := a.srv.MeasureRPCRate("acl", structs.RateMetricRead, args); err != nil {
+		return err
+	}
 	if authErr != nil {
 		return structs.ErrPermissionDenied
 	}
@@ -362,7 +374,9 @@ func (a *ACL) GetPolicies(args *structs.ACLPolicySetRequest, reply *structs.ACLP
 	if done, err := a.srv.forward("ACL.GetPolicies", args, args, reply); done {
 		return err
 	}
-	a.srv.MeasureRPCRate("acl", structs.RateMetricList, args)
+	if err := a.srv.MeasureRPCRate("acl", structs.RateMetricList, args); err != nil {
+		return err
+	}
 	if authErr != nil {
 		return structs.ErrPermissionDenied
 	}
@@ -429,7 +443,9 @@ func (a *ACL) GetClaimPolicies(args *structs.GenericRequest, reply *structs.ACLP
 	if done, err := a.srv.forward("ACL.GetClaimPolicies", args, args, reply); done {
 		return err
 	}
-	a.srv.MeasureRPCRate("acl", structs.RateMetricList, args)
+	if err := a.srv.MeasureRPCRate("acl", structs.RateMetricList, args); err != nil {
+		return err
+	}
 	if authErr != nil {
 		return structs.ErrPermissionDenied
 	}
@@ -478,7 +494,9 @@ func (a *ACL) Bootstrap(args *structs.ACLTokenBootstrapRequest, reply *structs.A
 	if done, err := a.srv.forward("ACL.Bootstrap", args, args, reply); done {
 		return err
 	}
-	a.srv.MeasureRPCRate("acl", structs.RateMetricWrite, args)
+	if err := a.srv.MeasureRPCRate("acl", structs.RateMetricWrite, args); err != nil {
+		return err
+	}
 	defer metrics.MeasureSince([]string{"nomad", "acl", "bootstrap"}, time.Now())
 
 	// Always ignore the reset index from the arguments
@@ -617,7 +635,9 @@ func (a *ACL) UpsertTokens(args *structs.ACLTokenUpsertRequest, reply *structs.A
 	if done, err := a.srv.forward(structs.ACLUpsertTokensRPCMethod, args, args, reply); done {
 		return err
 	}
-	a.srv.MeasureRPCRate("acl", structs.RateMetricWrite, args)
+	if err := a.srv.MeasureRPCRate("acl", structs.RateMetricWrite, args); err != nil {
+		return err
+	}
 	if authErr != nil {
 		return structs.ErrPermissionDenied
 	}
@@ -759,7 +779,9 @@ func (a *ACL) DeleteTokens(args *structs.ACLTokenDeleteRequest, reply *structs.G
 	if done, err := a.srv.forward("ACL.DeleteTokens", args, args, reply); done {
 		return err
 	}
-	a.srv.MeasureRPCRate("acl", structs.RateMetricWrite, args)
+	if err := a.srv.MeasureRPCRate("acl", structs.RateMetricWrite, args); err != nil {
+		return err
+	}
 	if authErr != nil {
 		return structs.ErrPermissionDenied
 	}
@@ -828,6 +850,160 @@ func (a *ACL) DeleteTokens(args *structs.ACLTokenDeleteRequest, reply *structs.G
 	return nil
 }
 
+// RotateToken is used to replace a token with a new token which has the same
+// policies, roles and TTL. The rotated token stays valid for a grace period,
+// so its users can switch to the new token, and is then garbage collected
+// like any expired token.
+func (a *ACL) RotateToken(args *structs.ACLTokenRotateRequest, reply *structs.ACLTokenRotateResponse) error {
+	// Ensure ACLs are enabled, and always flow modification requests to the authoritative region
+	if !a.srv.config.ACLEnabled {
+		return aclDisabled
+	}
+	authErr := a.srv.Authenticate(a.ctx, args)
+	if args.AccessorID == "" {
+		return structs.NewErrRPCCoded(http.StatusBadRequest, "missing token accessor ID")
+	}
+	if args.GracePeriod < 0 {
+		return structs.NewErrRPCCodedf(http.StatusBadRequest,
+			"grace period '%s' should not be negative", args.GracePeriod)
+	}
+
+	if done, err := a.srv.forward(structs.ACLRotateTokenRPCMethod, args, args, reply); done {
+		return err
+	}
+	if err := a.srv.MeasureRPCRate("acl", structs.RateMetricWrite, args); err != nil {
+		return err
+	}
+	if authErr != nil {
+		return structs.ErrPermissionDenied
+	}
+	defer metrics.MeasureSince([]string{"nomad", "acl", "rotate_token"}, time.Now())
+
+	stateSnapshot, err := a.srv.State().Snapshot()
+	if err != nil {
+		return err
+	}
+	existing, err := stateSnapshot.ACLTokenByAccessorID(nil, args.AccessorID)
+	if err != nil {
+		return structs.NewErrRPCCodedf(http.StatusInternalServerError, "token lookup failed: %v", err)
+	}
+
+	// Tokens can be rotated by management tokens, or by themselves. Other
+	// tokens can't tell whether the token exists.
+	caller := args.GetIdentity().GetACLToken()
+	if caller == nil {
+		return structs.ErrPermissionDenied
+	}
+	if caller.Type != structs.ACLManagementToken &&
+		(existing == nil || existing.AccessorID != caller.AccessorID) {
+		return structs.ErrPermissionDenied
+	}
+	if existing == nil {
+		return structs.NewErrRPCCodedf(http.StatusNotFound, "cannot find token %s", args.AccessorID)
+	}
+
+	// Global tokens must be rotated within the authoritative region.
+	if existing.Global && a.srv.config.Region != a.srv.config.AuthoritativeRegion {
+		args.Region = a.srv.config.AuthoritativeRegion
+		_, err := a.srv.forward(structs.ACLRotateTokenRPCMethod, args, args, reply)
+		return err
+	}
+
+	now := time.Now().UTC()
+	if existing.IsExpired(now) {
+		return structs.NewErrRPCCodedf(http.StatusBadRequest, "token %s is expired", args.AccessorID)
+	}
+
+	// Tokens created by an ACL login are bound to the identity and TTL of
+	// the login, so a new token must be obtained by logging in again.
+	if existing.IsLoginToken() {
+		return structs.NewErrRPCCodedf(http.StatusBadRequest,
+			"token %s was created by an auth method login and cannot be rotated", args.AccessorID)
+	}
+
+	// Tokens created with an expiration time rather than a TTL keep the
+	// lifetime they were created with.
+	ttl := existing.ExpirationTTL
+	if ttl == 0 && existing.ExpirationTime != nil {
+		ttl = existing.ExpirationTime.Sub(existing.CreateTime)
+	}
+
+	token := &structs.ACLToken{
+		Name:          existing.Name,
+		Type:          existing.Type,
+		Policies:      slices.Clone(existing.Policies),
+		Global:        existing.Global,
+		ExpirationTTL: ttl,
+	}
+	for _, roleLink := range existing.Roles {
+		token.Roles = append(token.Roles, &structs.ACLTokenRoleLink{ID: roleLink.ID})
+	}
+
+	// A token rotating itself can't extend its own lifetime, so the new
+	// token expires when the rotated token would have. Management tokens
+	// give the new token a full TTL.
+	if caller.Type != structs.ACLManagementToken && existing.ExpirationTime != nil {
+		token.ExpirationTTL = 0
+		token.ExpirationTime = pointer.Of(*existing.ExpirationTime)
+	}
+
+	// Canonicalize sets information needed by the validation function, so
+	// this order must be maintained.
+	token.Canonicalize()
+	if err := token.Validate(a.srv.config.ACLTokenMinExpirationTTL,
+		a.srv.config.ACLTokenMaxExpirationTTL, nil); err != nil {
+		return structs.NewErrRPCCodedf(http.StatusBadRequest, "new token invalid: %v", err)
+	}
+	token.SetHash()
+
+	// The grace period is limited like a token TTL, so rotating a token can't
+	// mint tokens which outlive the maximum token lifetime.
+	gracePeriod := args.GracePeriod
+	if gracePeriod == 0 {
+		gracePeriod = structs.DefaultACLTokenRotateGracePeriod
+	}
+	if maxTTL := a.srv.config.ACLTokenMaxExpirationTTL; gracePeriod > maxTTL {
+		return structs.NewErrRPCCodedf(http.StatusBadRequest,
+			"grace period '%s' should not be more than the maximum token expiration TTL '%s'", gracePeriod, maxTTL)
+	}
+
+	// The rotated token expires once the grace period ends, unless it
+	// expires before then.
+	rotated := existing.Copy()
+	expiration := now.Add(gracePeriod)
+	if rotated.ExpirationTime == nil || expiration.Before(*rotated.ExpirationTime) {
+		rotated.ExpirationTime = &expiration
+	}
+	rotated.SetHash()
+
+	// Both tokens are written at once, so the new token is never missing
+	// while the grace period of the rotated token applies.
+	req := &structs.ACLTokenUpsertRequest{
+		Tokens:       []*structs.ACLToken{token, rotated},
+		WriteRequest: args.WriteRequest,
+	}
+	_, index, err := a.srv.raftApply(structs.ACLTokenUpsertRequestType, req)
+	if err != nil {
+		return err
+	}
+
+	// Populate the response. We do a lookup against the state to pick up the
+	// proper create / modify times.
+	stateSnapshot, err = a.srv.State().Snapshot()
+	if err != nil {
+		return err
+	}
+	if reply.Token, err = stateSnapshot.ACLTokenByAccessorID(nil, token.AccessorID); err != nil {
+		return structs.NewErrRPCCodedf(http.StatusInternalServerError, "token lookup failed: %v", err)
+	}
+	if reply.Rotated, err = stateSnapshot.ACLTokenByAccessorID(nil, rotated.AccessorID); err != nil {
+		return structs.NewErrRPCCodedf(http.StatusInternalServerError, "token lookup failed: %v", err)
+	}
+
+	reply.Index = index
+	return nil
+}
+
 // ListTokens is used to list the tokens
 func (a *ACL) ListTokens(args *structs.ACLTokenListRequest, reply *structs.ACLTokenListResponse) error {
 	if !a.srv.config.ACLEnabled {
@@ -837,7 +1013,9 @@ func (a *ACL) ListTokens(args *structs.ACLTokenListRequest, reply *structs.ACLTo
 	if done, err := a.srv.forward("ACL.ListTokens", args, args, reply); done {
 		return err
 	}
-	a.srv.MeasureRPCRate("acl", structs.RateMetricList, args)
+	if err := a.srv.MeasureRPCRate("acl", structs.RateMetricList, args); err != nil {
+		return err
+	}
 	if authErr != nil {
 		return structs.ErrPermissionDenied
 	}
@@ -917,6 +1095,78 @@ func (a *ACL) ListTokens(args *structs.ACLTokenListRequest, reply *structs.ACLTo
 	return a.srv.blockingRPC(&opts)
 }
 
+// ListExpiringTokens is used to list the tokens which expire within a time
+// window, ordered by their expiration time.
+func (a *ACL) ListExpiringTokens(args *structs.ACLTokenExpiringListRequest, reply *structs.ACLTokenListResponse) error {
+	if !a.srv.config.ACLEnabled {
+		return aclDisabled
+	}
+	authErr := a.srv.Authenticate(a.ctx, args)
+	if done, err := a.srv.forward(structs.ACLListExpiringTokensRPCMethod, args, args, reply); done {
+		return err
+	}
+	if err := a.srv.MeasureRPCRate("acl", structs.RateMetricList, args); err != nil {
+		return err
+	}
+	if authErr != nil {
+		return structs.ErrPermissionDenied
+	}
+	defer metrics.MeasureSince([]string{"nomad", "acl", "list_expiring_tokens"}, time.Now())
+
+	if args.Within < 0 {
+		return structs.NewErrRPCCodedf(http.StatusBadRequest,
+			"expiration window '%s' should not be negative", args.Within)
+	}
+
+	// Check management level permissions
+	if acl, err := a.srv.ResolveACL(args); err != nil {
+		return err
+	} else if acl == nil || !acl.IsManagement() {
+		return structs.ErrPermissionDenied
+	}
+
+	within := args.Within
+	if within == 0 {
+		within = a.srv.config.ACLTokenExpirationLeadTime
+	}
+
+	// Setup the blocking query
+	opts := blockingOptions{
+		queryOpts: &args.QueryOptions,
+		queryMeta: &reply.QueryMeta,
+		run: func(ws memdb.WatchSet, state *state.StateStore) error {
+			now := time.Now().UTC()
+
+			localTokens, err := expiringACLTokens(ws, state, false, now, now.Add(within))
+			if err != nil {
+				return err
+			}
+			globalTokens, err := expiringACLTokens(ws, state, true, now, now.Add(within))
+			if err != nil {
+				return err
+			}
+
+			tokens := append(localTokens, globalTokens...)
+			sort.SliceStable(tokens, func(i, j int) bool {
+				return tokens[i].ExpirationTime.Before(*tokens[j].ExpirationTime)
+			})
+
+			reply.Tokens = make([]*structs.ACLTokenListStub, 0, len(tokens))
+			for _, token := range tokens {
+				reply.Tokens = append(reply.Tokens, token.Stub())
+			}
+
+			// Use the last index that affected the token table
+			index, err := state.Index("acl_token")
+			if err != nil {
+				return err
+			}
+			reply.Index = index
+			return nil
+		}}
+	return a.srv.blockingRPC(&opts)
+}
+
 // GetToken is used to get a specific token
 func (a *ACL) GetToken(args *structs.ACLTokenSpecificRequest, reply *structs.SingleACLTokenResponse) error {
 	if !a.srv.config.ACLEnabled {
@@ -926,7 +1176,9 @@ func (a *ACL) GetToken(args *structs.ACLTokenSpecificRequest, reply *structs.Sin
 	if done, err := a.srv.forward("ACL.GetToken", args, args, reply); done {
 		return err
 	}
-	a.srv.MeasureRPCRate("acl", structs.RateMetricRead, args)
+	if err := a.srv.MeasureRPCRate("acl", structs.RateMetricRead, args); err != nil {
+		return err
+	}
 	if authErr != nil {
 		return authErr
 	}
@@ -992,7 +1244,9 @@ func (a *ACL) GetTokens(args *structs.ACLTokenSetRequest, reply *structs.ACLToke
 	if done, err := a.srv.forward("ACL.GetTokens", args, args, reply); done {
 		return err
 	}
-	a.srv.MeasureRPCRate("acl", structs.RateMetricList, args)
+	if err := a.srv.MeasureRPCRate("acl", structs.RateMetricList, args); err != nil {
+		return err
+	}
 	if authErr != nil {
 		return authErr
 	}
@@ -1048,7 +1302,9 @@ func (a *ACL) ResolveToken(args *structs.ResolveACLTokenRequest, reply *structs.
 	if done, err := a.srv.forward("ACL.ResolveToken", args, args, reply); done {
 		return err
 	}
-	a.srv.MeasureRPCRate("acl", structs.RateMetricRead, args)
+	if err := a.srv.MeasureRPCRate("acl", structs.RateMetricRead, args); err != nil {
+		return err
+	}
 	defer metrics.MeasureSince([]string{"nomad", "acl", "resolve_token"}, time.Now())
 
 	// Setup the query meta
@@ -1199,7 +1455,9 @@ func (a *ACL) ExpireOneTimeTokens(args *structs.OneTimeTokenExpireRequest, reply
 		"ACL.ExpireOneTimeTokens", args, args, reply); done {
 		return err
 	}
-	a.srv.MeasureRPCRate("acl", structs.RateMetricWrite, args)
+	if err := a.srv.MeasureRPCRate("acl", structs.RateMetricWrite, args); err != nil {
+		return err
+	}
 	if authErr != nil {
 		return structs.ErrPermissionDenied
 	}
@@ -1248,7 +1506,9 @@ func (a *ACL) UpsertRoles(
 	if done, err := a.srv.forward(structs.ACLUpsertRolesRPCMethod, args, args, reply); done {
 		return err
 	}
-	a.srv.MeasureRPCRate("acl", structs.RateMetricWrite, args)
+	if err := a.srv.MeasureRPCRate("acl", structs.RateMetricWrite, args); err != nil {
+		return err
+	}
 	if authErr != nil {
 		return structs.ErrPermissionDenied
 	}
@@ -1392,7 +1652,9 @@ func (a *ACL) DeleteRolesByID(
 	if done, err := a.srv.forward(structs.ACLDeleteRolesByIDRPCMethod, args, args, reply); done {
 		return err
 	}
-	a.srv.MeasureRPCRate("acl", structs.RateMetricWrite, args)
+	if err := a.srv.MeasureRPCRate("acl", structs.RateMetricWrite, args); err != nil {
+		return err
+	}
 	if authErr != nil {
 		return structs.ErrPermissionDenied
 	}
@@ -1440,7 +1702,9 @@ func (a *ACL) ListRoles(
 	if done, err := a.srv.forward(structs.ACLListRolesRPCMethod, args, args, reply); done {
 		return err
 	}
-	a.srv.MeasureRPCRate("acl", structs.RateMetricList, args)
+	if err := a.srv.MeasureRPCRate("acl", structs.RateMetricList, args); err != nil {
+		return err
+	}
 	if authErr != nil {
 		return structs.ErrPermissionDenied
 	}
@@ -1538,7 +1802,9 @@ func (a *ACL) GetRolesByID(args *structs.ACLRolesByIDRequest, reply *structs.ACL
 	if done, err := a.srv.forward(structs.ACLGetRolesByIDRPCMethod, args, args, reply); done {
 		return err
 	}
-	a.srv.MeasureRPCRate("acl", structs.RateMetricList, args)
+	if err := a.srv.MeasureRPCRate("acl", structs.RateMetricList, args); err != nil {
+		return err
+	}
 	if authErr != nil {
 		return structs.ErrPermissionDenied
 	}
@@ -1597,7 +1863,9 @@ func (a *ACL) GetRoleByID(
 	if done, err := a.srv.forward(structs.ACLGetRoleByIDRPCMethod, args, args, reply); done {
 		return err
 	}
-	a.srv.MeasureRPCRate("acl", structs.RateMetricRead, args)
+	if err := a.srv.MeasureRPCRate("acl", structs.RateMetricRead, args); err != nil {
+		return err
+	}
 	if authErr != nil {
 		return structs.ErrPermissionDenied
 	}
@@ -1686,7 +1954,9 @@ func (a *ACL) GetRoleByName(
 	if done, err := a.srv.forward(structs.ACLGetRoleByNameRPCMethod, args, args, reply); done {
 		return err
 	}
-	a.srv.MeasureRPCRate("acl", structs.RateMetricRead, args)
+	if err := a.srv.MeasureRPCRate("acl", structs.RateMetricRead, args); err != nil {
+		return err
+	}
 	if authErr != nil {
 		return structs.ErrPermissionDenied
 	}
@@ -1833,7 +2103,9 @@ func (a *ACL) UpsertAuthMethods(
 	if done, err := a.srv.forward(structs.ACLUpsertAuthMethodsRPCMethod, args, args, reply); done {
 		return err
 	}
-	a.srv.MeasureRPCRate("acl", structs.RateMetricWrite, args)
+	if err := a.srv.MeasureRPCRate("acl", structs.RateMetricWrite, args); err != nil {
+		return err
+	}
 	if authErr != nil {
 		return structs.ErrPermissionDenied
 	}
@@ -1936,7 +2208,9 @@ func (a *ACL) DeleteAuthMethods(
 		structs.ACLDeleteAuthMethodsRPCMethod, args, args, reply); done {
 		return err
 	}
-	a.srv.MeasureRPCRate("acl", structs.RateMetricWrite, args)
+	if err := a.srv.MeasureRPCRate("acl", structs.RateMetricWrite, args); err != nil {
+		return err
+	}
 	if authErr != nil {
 		return structs.ErrPermissionDenied
 	}
@@ -2032,7 +2306,9 @@ func (a *ACL) GetAuthMethod(
 		structs.ACLGetAuthMethodRPCMethod, args, args, reply); done {
 		return err
 	}
-	a.srv.MeasureRPCRate("acl", structs.RateMetricRead, args)
+	if err := a.srv.MeasureRPCRate("acl", structs.RateMetricRead, args); err != nil {
+		return err
+	}
 	if authErr != nil {
 		return structs.ErrPermissionDenied
 	}
@@ -2091,7 +2367,9 @@ func (a *ACL) GetAuthMethods(
 		structs.ACLGetAuthMethodsRPCMethod, args, args, reply); done {
 		return err
 	}
-	a.srv.MeasureRPCRate("acl", structs.RateMetricList, args)
+	if err := a.srv.MeasureRPCRate("acl", structs.RateMetricList, args); err != nil {
+		return err
+	}
 	if authErr != nil {
 		return structs.ErrPermissionDenied
 	}
@@ -2145,7 +2423,9 @@ func (a *ACL) WhoAmI(args *structs.GenericRequest, reply *structs.ACLWhoAmIRespo
 	if done, err := a.srv.forward("ACL.WhoAmI", args, args, reply); done {
 		return err
 	}
-	a.srv.MeasureRPCRate("acl", structs.RateMetricRead, args)
+	if err := a.srv.MeasureRPCRate("acl", structs.RateMetricRead, args); err != nil {
+		return err
+	}
 	if authErr != nil {
 		return authErr
 	}
@@ -2180,7 +2460,9 @@ func (a *ACL) UpsertBindingRules(
 	if done, err := a.srv.forward(structs.ACLUpsertBindingRulesRPCMethod, args, args, reply); done {
 		return err
 	}
-	a.srv.MeasureRPCRate("acl", structs.RateMetricWrite, args)
+	if err := a.srv.MeasureRPCRate("acl", structs.RateMetricWrite, args); err != nil {
+		return err
+	}
 	if authErr != nil {
 		return structs.ErrPermissionDenied
 	}
@@ -2307,7 +2589,9 @@ func (a *ACL) DeleteBindingRules(
 	if done, err := a.srv.forward(structs.ACLDeleteBindingRulesRPCMethod, args, args, reply); done {
 		return err
 	}
-	a.srv.MeasureRPCRate("acl", structs.RateMetricWrite, args)
+	if err := a.srv.MeasureRPCRate("acl", structs.RateMetricWrite, args); err != nil {
+		return err
+	}
 	if authErr != nil {
 		return structs.ErrPermissionDenied
 	}
@@ -2356,7 +2640,9 @@ func (a *ACL) ListBindingRules(
 	if done, err := a.srv.forward(structs.ACLListBindingRulesRPCMethod, args, args, reply); done {
 		return err
 	}
-	a.srv.MeasureRPCRate("acl", structs.RateMetricList, args)
+	if err := a.srv.MeasureRPCRate("acl", structs.RateMetricList, args); err != nil {
+		return err
+	}
 	if authErr != nil {
 		return structs.ErrPermissionDenied
 	}
@@ -2415,7 +2701,9 @@ func (a *ACL) GetBindingRules(
 	if done, err := a.srv.forward(structs.ACLGetBindingRulesRPCMethod, args, args, reply); done {
 		return err
 	}
-	a.srv.MeasureRPCRate("acl", structs.RateMetricRead, args)
+	if err := a.srv.MeasureRPCRate("acl", structs.RateMetricRead, args); err != nil {
+		return err
+	}
 	if authErr != nil {
 		return structs.ErrPermissionDenied
 	}
@@ -2470,7 +2758,9 @@ func (a *ACL) GetBindingRule(
 	if done, err := a.srv.forward(structs.ACLGetBindingRuleRPCMethod, args, args, reply); done {
 		return err
 	}
-	a.srv.MeasureRPCRate("acl", structs.RateMetricRead, args)
+	if err := a.srv.MeasureRPCRate("acl", structs.RateMetricRead, args); err != nil {
+		return err
+	}
 	if authErr != nil {
 		return structs.ErrPermissionDenied
 	}
@@ -2858,6 +3148,9 @@ func (a *ACL) Login(args *structs.ACLLoginRequest, reply *structs.ACLLoginRespon
 	// Validate the token depending on its method type
 	switch authMethod.Type {
 	case structs.ACLAuthMethodTypeJWT:
+		if args.LoginToken == "" {
+			return structs.NewErrRPCCoded(http.StatusBadRequest, "invalid login request: missing login token")
+		}
 		claims, err = jwt.Validate(ctx, args.LoginToken, authMethod.Config)
 		if err != nil {
 			return structs.NewErrRPCCodedf(
@@ -2866,6 +3159,30 @@ func (a *ACL) Login(args *structs.ACLLoginRequest, reply *structs.ACLLoginRespon
 				err,
 			)
 		}
+	case structs.ACLAuthMethodTypeLDAP:
+		if args.Username == "" || args.Password == "" {
+			return structs.NewErrRPCCoded(http.StatusBadRequest, "invalid login request: missing username or password")
+		}
+		claims, err = ldap.Authenticate(ctx, args.Username, args.Password, authMethod.Config)
+		if err != nil {
+			return structs.NewErrRPCCodedf(
+				http.StatusUnauthorized,
+				"unable to validate provided credentials: %v",
+				err,
+			)
+		}
+	case structs.ACLAuthMethodTypeWorkload:
+		if args.LoginToken == "" {
+			return structs.NewErrRPCCoded(http.StatusBadRequest, "invalid login request: missing login token")
+		}
+		claims, err = a.workloadIdentityClaims(stateSnapshot, args.LoginToken)
+		if err != nil {
+			return structs.NewErrRPCCodedf(
+				http.StatusUnauthorized,
+				"unable to validate provided token: %v",
+				err,
+			)
+		}
 	default:
 		return structs.NewErrRPCCodedf(
 			http.StatusBadRequest,
@@ -2898,7 +3215,7 @@ func (a *ACL) Login(args *structs.ACLLoginRequest, reply *structs.ACLLoginRespon
 	// future we should try and extract out the logic into an interface, or at
 	// least a separate function.
 	token := structs.ACLToken{
-		Name:          "JWT-" + authMethod.Name,
+		Name:          authMethod.Type + "-" + authMethod.Name,
 		Global:        authMethod.TokenLocalityIsGlobal(),
 		ExpirationTTL: authMethod.MaxTokenTTL,
 	}
@@ -2932,3 +3249,173 @@ func (a *ACL) Login(args *structs.ACLLoginRequest, reply *structs.ACLLoginRespon
 
 	return nil
 }
+
+// workloadIdentityClaims verifies a workload identity signed by the keyring
+// and returns its claims. The task group of the allocation isn't part of the
+// identity, so it's added from the state store for binding rules to select on.
+func (a *ACL) workloadIdentityClaims(snap *state.StateSnapshot, token string) (map[string]interface{}, error) {
+	claims, err := a.srv.VerifyClaim(token)
+	if err != nil {
+		return nil, err
+	}
+	if claims.TaskName == "" {
+		return nil, errors.New("identity is not for a task")
+	}
+
+	alloc, err := snap.AllocByID(nil, claims.AllocationID)
+	if err != nil {
+		return nil, err
+	}
+	if alloc == nil || alloc.TerminalStatus() {
+		return nil, errors.New("allocation is not running")
+	}
+
+	return map[string]interface{}{
+		"nomad_namespace":     claims.Namespace,
+		"nomad_job_id":        claims.JobID,
+		"nomad_task_group":    alloc.TaskGroup,
+		"nomad_task":          claims.TaskName,
+		"nomad_allocation_id": claims.AllocationID,
+	}, nil
+}
+
+// Authorize checks whether an operation on a resource is allowed, and explains
+// which ACL policy rules allowed or denied it. Any token can check its own
+// access, while management tokens can also check the access of other tokens,
+// roles and policies.
+func (a *ACL) Authorize(args *structs.ACLAuthorizeRequest, reply *structs.ACLAuthorizeResponse) error {
+	if !a.srv.config.ACLEnabled {
+		return aclDisabled
+	}
+
+	authErr := a.srv.Authenticate(a.ctx, args)
+	if done, err := a.srv.forward(structs.ACLAuthorizeRPCMethod, args, args, reply); done {
+		return err
+	}
+	if err := a.srv.MeasureRPCRate("acl", structs.RateMetricRead, args); err != nil {
+		return err
+	}
+	if authErr != nil {
+		return structs.ErrPermissionDenied
+	}
+	defer metrics.MeasureSince([]string{"nomad", "acl", "authorize"}, time.Now())
+
+	if err := args.Validate(); err != nil {
+		return structs.NewErrRPCCodedf(http.StatusBadRequest, "invalid authorize request: %v", err)
+	}
+
+	// Only ACL tokens have policies which can be explained, so workload
+	// identities can't use this endpoint.
+	token := args.GetIdentity().GetACLToken()
+	if token == nil {
+		return structs.ErrPermissionDenied
+	}
+
+	stateSnapshot, err := a.srv.fsm.State().Snapshot()
+	if err != nil {
+		return err
+	}
+
+	if args.AccessorID != "" || len(args.Roles) > 0 || len(args.Policies) > 0 {
+		if token.Type != structs.ACLManagementToken {
+			return structs.ErrPermissionDenied
+		}
+		if token, err = a.authorizeTarget(stateSnapshot, args); err != nil {
+			return err
+		}
+	}
+
+	a.srv.setQueryMeta(&reply.QueryMeta)
+
+	// Management tokens are allowed to do anything, without any policies, but
+	// the request is still checked for invalid resources and capabilities.
+	if token.Type == structs.ACLManagementToken {
+		if _, err := policy.Explain(nil, args.Resource, args.RequestNamespace(), args.Name, args.Capability); err != nil {
+			return structs.NewErrRPCCodedf(http.StatusBadRequest, "invalid authorize request: %v", err)
+		}
+		reply.Allowed = true
+		reply.Reason = "management token"
+		return nil
+	}
+
+	policies, err := resolvePoliciesFromToken(stateSnapshot, token)
+	if err != nil {
+		return err
+	}
+
+	// Policies can be linked by the token and several of its roles, so only
+	// explain each of them once.
+	named := make([]*policy.NamedPolicy, 0, len(policies))
+	seen := set.New[string](len(policies))
+	for _, p := range policies {
+		if !seen.Insert(p.Name) {
+			continue
+		}
+		parsed, err := policy.Parse(p.Rules)
+		if err != nil {
+			return fmt.Errorf("failed to parse %q: %v", p.Name, err)
+		}
+		named = append(named, &policy.NamedPolicy{Name: p.Name, Policy: parsed})
+	}
+	sort.Slice(named, func(i, j int) bool { return named[i].Name < named[j].Name })
+
+	explanation, err := policy.Explain(
+		named, args.Resource, args.RequestNamespace(), args.Name, args.Capability)
+	if err != nil {
+		return structs.NewErrRPCCodedf(http.StatusBadRequest, "invalid authorize request: %v", err)
+	}
+
+	reply.Allowed = explanation.Allowed
+	reply.Reason = explanation.Reason
+	for _, rule := range explanation.Rules {
+		reply.Rules = append(reply.Rules, &structs.ACLAuthorizeRule{
+			Policy:       rule.Policy,
+			Rule:         rule.Rule,
+			Capabilities: rule.Capabilities,
+		})
+	}
+	return nil
+}
+
+// authorizeTarget returns the token whose access is checked by an authorize
+// request for another token, or for a set of roles and policies.
+func (a *ACL) authorizeTarget(snap *state.StateSnapshot, args *structs.ACLAuthorizeRequest) (*structs.ACLToken, error) {
+	if args.AccessorID != "" {
+		token, err := snap.ACLTokenByAccessorID(nil, args.AccessorID)
+		if err != nil {
+			return nil, err
+		}
+		if token == nil {
+			return nil, structs.NewErrRPCCodedf(http.StatusNotFound, "ACL token %q not found", args.AccessorID)
+		}
+		return token, nil
+	}
+
+	// Build a client token with the roles and policies, so they are resolved
+	// exactly like the roles and policies of a real token.
+	token := &structs.ACLToken{Type: structs.ACLClientToken}
+
+	for _, roleName := range args.Roles {
+		role, err := snap.GetACLRoleByName(nil, roleName)
+		if err != nil {
+			return nil, err
+		}
+		if role == nil {
+			return nil, structs.NewErrRPCCodedf(http.StatusNotFound, "ACL role %q not found", roleName)
+		}
+		token.Roles = append(token.Roles, &structs.ACLTokenRoleLink{ID: role.ID, Name: role.Name})
+	}
+
+	for _, policyName := range args.Policies {
+		p, err := snap.ACLPolicyByName(nil, policyName)
+		if err != nil {
+			return nil, err
+		}
+		if p == nil {
+			return nil, structs.NewErrRPCCodedf(http.StatusNotFound, "ACL policy %q not found", policyName)
+		}
+		token.Policies = append(token.Policies, policyName)
+	}
+
+	return token, nil
+}
@@ -12,8 +12,11 @@ import (
 	capOIDC "github.com/hashicorp/cap/oidc"
 	"github.com/hashicorp/go-memdb"
 	msgpackrpc "github.com/hashicorp/net-rpc-msgpackrpc"
+	"github.com/hashicorp/nomad/acl"
 	"github.com/hashicorp/nomad/ci"
+	"github.com/hashicorp/nomad/helper/pointer"
 	"github.com/hashicorp/nomad/helper/uuid"
+	"github.com/hashicorp/nomad/lib/auth/ldap"
 	"github.com/hashicorp/nomad/nomad/mock"
 	"github.com/hashicorp/nomad/nomad/structs"
 	"github.com/hashicorp/nomad/testutil"
@@ -3886,3 +3889,427 @@ func TestACL_Login(t *testing.T) {
 	must.Len(t, 0, completeAuthResp5.ACLToken.Roles)
 	must.Eq(t, structs.ACLManagementToken, completeAuthResp5.ACLToken.Type)
 }
+
+func TestACL_Login_LDAP(t *testing.T) {
+	ci.Parallel(t)
+
+	testServer, _, testServerCleanupFn := TestACLServer(t, nil)
+	defer testServerCleanupFn()
+	codec := rpcClient(t, testServer)
+	testutil.WaitForLeader(t, testServer.RPC)
+
+	ldapServer := ldap.NewTestServer(t,
+		&ldap.TestEntry{
+			DN:       "cn=admin,dc=example,dc=com",
+			Password: "very secret password",
+		},
+		&ldap.TestEntry{
+			DN:         "uid=alice,ou=users,dc=example,dc=com",
+			Password:   "alice-password",
+			Attributes: map[string][]string{"uid": {"alice"}},
+		},
+		&ldap.TestEntry{
+			DN: "cn=engineering,ou=groups,dc=example,dc=com",
+			Attributes: map[string][]string{
+				"cn":     {"engineering"},
+				"member": {"uid=alice,ou=users,dc=example,dc=com"},
+			},
+		},
+	)
+
+	mockedAuthMethod := mock.ACLLDAPAuthMethod()
+	mockedAuthMethod.Config.LDAPURL = ldapServer.URL()
+	must.NoError(t, testServer.fsm.State().UpsertACLAuthMethods(10, []*structs.ACLAuthMethod{mockedAuthMethod}))
+
+	mockACLPolicy := mock.ACLPolicy()
+	must.NoError(t, testServer.fsm.State().UpsertACLPolicies(
+		structs.MsgTypeTestSetup, 20, []*structs.ACLPolicy{mockACLPolicy}))
+
+	mockBindingRule := mock.ACLBindingRule()
+	mockBindingRule.AuthMethod = mockedAuthMethod.Name
+	mockBindingRule.BindType = structs.ACLBindingRuleBindTypePolicy
+	mockBindingRule.Selector = "engineering in list.groups and value.username == alice"
+	mockBindingRule.BindName = mockACLPolicy.Name
+	must.NoError(t, testServer.fsm.State().UpsertACLBindingRules(
+		30, []*structs.ACLBindingRule{mockBindingRule}, true))
+
+	// A login token can't be used with LDAP auth methods
+	loginReq1 := structs.ACLLoginRequest{
+		AuthMethodName: mockedAuthMethod.Name,
+		LoginToken:     "token",
+		WriteRequest:   structs.WriteRequest{Region: DefaultRegion},
+	}
+	var loginResp1 structs.ACLLoginResponse
+	err := msgpackrpc.CallWithCodec(codec, structs.ACLLoginRPCMethod, &loginReq1, &loginResp1)
+	must.ErrorContains(t, err, "400")
+	must.ErrorContains(t, err, "missing username or password")
+
+	// Login with the wrong password
+	loginReq2 := structs.ACLLoginRequest{
+		AuthMethodName: mockedAuthMethod.Name,
+		Username:       "alice",
+		Password:       "wrong-password",
+		WriteRequest:   structs.WriteRequest{Region: DefaultRegion},
+	}
+	var loginResp2 structs.ACLLoginResponse
+	err = msgpackrpc.CallWithCodec(codec, structs.ACLLoginRPCMethod, &loginReq2, &loginResp2)
+	must.ErrorContains(t, err, "401")
+	must.ErrorContains(t, err, "unable to validate provided credentials")
+
+	// Login with the right password
+	loginReq3 := structs.ACLLoginRequest{
+		AuthMethodName: mockedAuthMethod.Name,
+		Username:       "alice",
+		Password:       "alice-password",
+		WriteRequest:   structs.WriteRequest{Region: DefaultRegion},
+	}
+	var loginResp3 structs.ACLLoginResponse
+	must.NoError(t, msgpackrpc.CallWithCodec(codec, structs.ACLLoginRPCMethod, &loginReq3, &loginResp3))
+	must.NotNil(t, loginResp3.ACLToken)
+	must.Eq(t, "LDAP-"+mockedAuthMethod.Name, loginResp3.ACLToken.Name)
+	must.Eq(t, []string{mockACLPolicy.Name}, loginResp3.ACLToken.Policies)
+}
+
+func TestACL_Login_Workload(t *testing.T) {
+	ci.Parallel(t)
+
+	testServer, _, testServerCleanupFn := TestACLServer(t, nil)
+	defer testServerCleanupFn()
+	codec := rpcClient(t, testServer)
+	testutil.WaitForLeader(t, testServer.RPC)
+
+	mockedAuthMethod := mock.ACLWorkloadAuthMethod()
+	must.NoError(t, testServer.fsm.State().UpsertACLAuthMethods(10, []*structs.ACLAuthMethod{mockedAuthMethod}))
+
+	mockACLPolicy := mock.ACLPolicy()
+	must.NoError(t, testServer.fsm.State().UpsertACLPolicies(
+		structs.MsgTypeTestSetup, 20, []*structs.ACLPolicy{mockACLPolicy}))
+
+	// Only the web task of the web group can login
+	mockBindingRule := mock.ACLBindingRule()
+	mockBindingRule.AuthMethod = mockedAuthMethod.Name
+	mockBindingRule.BindType = structs.ACLBindingRuleBindTypePolicy
+	mockBindingRule.Selector = "value.namespace == default and value.task_group == web and value.task == web"
+	mockBindingRule.BindName = mockACLPolicy.Name
+	must.NoError(t, testServer.fsm.State().UpsertACLBindingRules(
+		30, []*structs.ACLBindingRule{mockBindingRule}, true))
+
+	alloc1 := mock.Alloc()
+	alloc1.ClientStatus = structs.AllocClientStatusRunning
+	alloc2 := mock.Alloc()
+	alloc2.ClientStatus = structs.AllocClientStatusComplete
+	must.NoError(t, testServer.fsm.State().UpsertAllocs(
+		structs.MsgTypeTestSetup, 40, []*structs.Allocation{alloc1, alloc2}))
+
+	login := func(token string) (*structs.ACLLoginResponse, error) {
+		req := structs.ACLLoginRequest{
+			AuthMethodName: mockedAuthMethod.Name,
+			LoginToken:     token,
+			WriteRequest:   structs.WriteRequest{Region: DefaultRegion},
+		}
+		var resp structs.ACLLoginResponse
+		err := msgpackrpc.CallWithCodec(codec, structs.ACLLoginRPCMethod, &req, &resp)
+		return &resp, err
+	}
+
+	// Tokens which aren't signed by the keyring are rejected
+	jwtToken, _, err := mock.SampleJWTokenWithKeys(nil, nil)
+	must.NoError(t, err)
+	_, err = login(jwtToken)
+	must.ErrorContains(t, err, "401")
+	must.ErrorContains(t, err, "unable to validate provided token")
+
+	// The identity of a running task bound by the rule can login
+	token, _, err := testServer.encrypter.SignClaims(alloc1.ToTaskIdentityClaims(alloc1.Job, "web"))
+	must.NoError(t, err)
+	resp, err := login(token)
+	must.NoError(t, err)
+	must.NotNil(t, resp.ACLToken)
+	must.Eq(t, "WORKLOAD-"+mockedAuthMethod.Name, resp.ACLToken.Name)
+	must.Eq(t, []string{mockACLPolicy.Name}, resp.ACLToken.Policies)
+	must.NotNil(t, resp.ACLToken.ExpirationTime)
+
+	// Other tasks don't match the binding rule
+	token, _, err = testServer.encrypter.SignClaims(alloc1.ToTaskIdentityClaims(alloc1.Job, "sidecar"))
+	must.NoError(t, err)
+	_, err = login(token)
+	must.ErrorContains(t, err, "no role or policy bindings matched")
+
+	// Terminal allocations can't login
+	token, _, err = testServer.encrypter.SignClaims(alloc2.ToTaskIdentityClaims(alloc2.Job, "web"))
+	must.NoError(t, err)
+	_, err = login(token)
+	must.ErrorContains(t, err, "401")
+}
+
+func TestACL_Authorize(t *testing.T) {
+	ci.Parallel(t)
+
+	testServer, rootToken, testServerCleanupFn := TestACLServer(t, nil)
+	defer testServerCleanupFn()
+	codec := rpcClient(t, testServer)
+	testutil.WaitForLeader(t, testServer.RPC)
+
+	// Create two policies, a role which links to one of them, and a token
+	// which links to the role and the other policy.
+	policy1 := mock.ACLPolicy()
+	policy1.Name = "prod-read"
+	policy1.Rules = `namespace "prod" { policy = "read" }`
+	policy1.SetHash()
+	policy2 := mock.ACLPolicy()
+	policy2.Name = "web-deploy"
+	policy2.Rules = `namespace "prod" { job "web-*" { capabilities = ["submit-job"] } }`
+	policy2.SetHash()
+	must.NoError(t, testServer.fsm.State().UpsertACLPolicies(
+		structs.MsgTypeTestSetup, 10, []*structs.ACLPolicy{policy1, policy2}))
+
+	role := mock.ACLRole()
+	role.Policies = []*structs.ACLRolePolicyLink{{Name: policy2.Name}}
+	must.NoError(t, testServer.fsm.State().UpsertACLRoles(
+		structs.MsgTypeTestSetup, 20, []*structs.ACLRole{role}, true))
+
+	token := mock.ACLToken()
+	token.Policies = []string{policy1.Name}
+	token.Roles = []*structs.ACLTokenRoleLink{{ID: role.ID}}
+	must.NoError(t, testServer.fsm.State().UpsertACLTokens(
+		structs.MsgTypeTestSetup, 30, []*structs.ACLToken{token}))
+
+	// The token is allowed to submit the job by the policy of its role
+	req := &structs.ACLAuthorizeRequest{
+		Resource:   acl.ResourceJob,
+		Name:       "web-api",
+		Capability: acl.NamespaceCapabilitySubmitJob,
+		QueryOptions: structs.QueryOptions{
+			Region:    DefaultRegion,
+			Namespace: "prod",
+			AuthToken: token.SecretID,
+		},
+	}
+	var resp structs.ACLAuthorizeResponse
+	must.NoError(t, msgpackrpc.CallWithCodec(codec, structs.ACLAuthorizeRPCMethod, req, &resp))
+	must.True(t, resp.Allowed)
+	must.Eq(t, `granted by namespace "prod" job "web-*"`, resp.Reason)
+	must.Eq(t, []*structs.ACLAuthorizeRule{{
+		Policy:       policy2.Name,
+		Rule:         `namespace "prod" job "web-*"`,
+		Capabilities: []string{"submit-job"},
+	}}, resp.Rules)
+
+	// But not allowed to submit other jobs
+	req.Name = "api"
+	resp = structs.ACLAuthorizeResponse{}
+	must.NoError(t, msgpackrpc.CallWithCodec(codec, structs.ACLAuthorizeRPCMethod, req, &resp))
+	must.False(t, resp.Allowed)
+	must.Eq(t, `namespace "prod" does not grant "submit-job"`, resp.Reason)
+
+	// Only management tokens can check the access of other policies
+	req.Policies = []string{policy2.Name}
+	err := msgpackrpc.CallWithCodec(codec, structs.ACLAuthorizeRPCMethod, req, &resp)
+	must.EqError(t, err, structs.ErrPermissionDenied.Error())
+
+	req.AuthToken = rootToken.SecretID
+	req.Name = "web-api"
+	resp = structs.ACLAuthorizeResponse{}
+	must.NoError(t, msgpackrpc.CallWithCodec(codec, structs.ACLAuthorizeRPCMethod, req, &resp))
+	must.True(t, resp.Allowed)
+
+	// Check the access of a role
+	req.Policies = nil
+	req.Roles = []string{role.Name}
+	req.Capability = acl.NamespaceCapabilityReadJob
+	resp = structs.ACLAuthorizeResponse{}
+	must.NoError(t, msgpackrpc.CallWithCodec(codec, structs.ACLAuthorizeRPCMethod, req, &resp))
+	must.False(t, resp.Allowed)
+	must.Eq(t, `namespace "prod" job "web-*" and namespace "prod" do not grant "read-job"`, resp.Reason)
+
+	// Check the access of the token by its accessor ID
+	req.Roles = nil
+	req.AccessorID = token.AccessorID
+	resp = structs.ACLAuthorizeResponse{}
+	must.NoError(t, msgpackrpc.CallWithCodec(codec, structs.ACLAuthorizeRPCMethod, req, &resp))
+	must.True(t, resp.Allowed)
+	must.Eq(t, `granted by namespace "prod"`, resp.Reason)
+
+	// Unknown targets are not found
+	req.AccessorID = uuid.Generate()
+	err = msgpackrpc.CallWithCodec(codec, structs.ACLAuthorizeRPCMethod, req, &resp)
+	must.ErrorContains(t, err, "404")
+
+	// The management token is allowed to do anything
+	req.AccessorID = ""
+	resp = structs.ACLAuthorizeResponse{}
+	must.NoError(t, msgpackrpc.CallWithCodec(codec, structs.ACLAuthorizeRPCMethod, req, &resp))
+	must.True(t, resp.Allowed)
+	must.Eq(t, "management token", resp.Reason)
+
+	// Invalid capabilities are rejected
+	req.Capability = "fly"
+	err = msgpackrpc.CallWithCodec(codec, structs.ACLAuthorizeRPCMethod, req, &resp)
+	must.ErrorContains(t, err, `invalid job capability "fly"`)
+}
+
+func TestACL_RotateToken(t *testing.T) {
+	ci.Parallel(t)
+
+	testServer, rootToken, testServerCleanupFn := TestACLServer(t, nil)
+	defer testServerCleanupFn()
+	codec := rpcClient(t, testServer)
+	testutil.WaitForLeader(t, testServer.RPC)
+
+	role := mock.ACLRole()
+	must.NoError(t, testServer.fsm.State().UpsertACLRoles(
+		structs.MsgTypeTestSetup, 10, []*structs.ACLRole{role}, true))
+
+	token := mock.ACLToken()
+	token.Roles = []*structs.ACLTokenRoleLink{{ID: role.ID}}
+	token.ExpirationTTL = 2 * time.Hour
+	token.ExpirationTime = pointer.Of(token.CreateTime.Add(token.ExpirationTTL))
+	otherToken := mock.ACLToken()
+	must.NoError(t, testServer.fsm.State().UpsertACLTokens(
+		structs.MsgTypeTestSetup, 20, []*structs.ACLToken{token, otherToken}))
+
+	// Other tokens can't rotate the token, or find out whether it exists
+	req := &structs.ACLTokenRotateRequest{
+		AccessorID: token.AccessorID,
+		WriteRequest: structs.WriteRequest{
+			Region:    DefaultRegion,
+			AuthToken: otherToken.SecretID,
+		},
+	}
+	var resp structs.ACLTokenRotateResponse
+	err := msgpackrpc.CallWithCodec(codec, structs.ACLRotateTokenRPCMethod, req, &resp)
+	must.EqError(t, err, structs.ErrPermissionDenied.Error())
+
+	req.AccessorID = uuid.Generate()
+	err = msgpackrpc.CallWithCodec(codec, structs.ACLRotateTokenRPCMethod, req, &resp)
+	must.EqError(t, err, structs.ErrPermissionDenied.Error())
+
+	// The token can rotate itself, which creates a token with the same
+	// policies, roles and expiration time, and shortens the expiration of the
+	// rotated token to the grace period.
+	req.AccessorID = token.AccessorID
+	req.AuthToken = token.SecretID
+	req.GracePeriod = 10 * time.Minute
+	before := time.Now().UTC()
+	must.NoError(t, msgpackrpc.CallWithCodec(codec, structs.ACLRotateTokenRPCMethod, req, &resp))
+	must.NotNil(t, resp.Token)
+	must.NotNil(t, resp.Rotated)
+	must.NotEq(t, token.AccessorID, resp.Token.AccessorID)
+	must.NotEq(t, token.SecretID, resp.Token.SecretID)
+	must.Eq(t, token.Name, resp.Token.Name)
+	must.Eq(t, token.Type, resp.Token.Type)
+	must.Eq(t, token.Policies, resp.Token.Policies)
+	must.Eq(t, []*structs.ACLTokenRoleLink{{ID: role.ID, Name: role.Name}}, resp.Token.Roles)
+	must.Eq(t, *token.ExpirationTime, *resp.Token.ExpirationTime)
+	must.Eq(t, token.AccessorID, resp.Rotated.AccessorID)
+	must.True(t, resp.Rotated.ExpirationTime.Before(before.Add(11*time.Minute)))
+	must.True(t, resp.Rotated.ExpirationTime.After(before.Add(9*time.Minute)))
+	must.Eq(t, resp.Index, resp.Token.CreateIndex)
+	must.Eq(t, resp.Index, resp.Rotated.ModifyIndex)
+
+	// Both tokens are valid during the grace period
+	for _, secretID := range []string{token.SecretID, resp.Token.SecretID} {
+		resolved, err := testServer.ResolveSecretToken(secretID)
+		must.NoError(t, err)
+		must.NotNil(t, resolved)
+	}
+
+	// The grace period never extends the expiration of the rotated token, and
+	// management tokens give the new token the full TTL of the rotated token
+	rotated := resp.Rotated
+	req.AccessorID = rotated.AccessorID
+	req.AuthToken = rootToken.SecretID
+	req.GracePeriod = time.Hour
+	resp = structs.ACLTokenRotateResponse{}
+	before = time.Now().UTC()
+	must.NoError(t, msgpackrpc.CallWithCodec(codec, structs.ACLRotateTokenRPCMethod, req, &resp))
+	must.Eq(t, *rotated.ExpirationTime, *resp.Rotated.ExpirationTime)
+	must.Eq(t, token.ExpirationTTL, resp.Token.ExpirationTTL)
+	must.True(t, resp.Token.ExpirationTime.After(before.Add(token.ExpirationTTL-time.Second)))
+
+	// Tokens created by an auth method login can't be rotated
+	loginToken := mock.ACLToken()
+	loginToken.Name = structs.ACLAuthMethodTypeOIDC + "-example"
+	must.NoError(t, testServer.fsm.State().UpsertACLTokens(
+		structs.MsgTypeTestSetup, 30, []*structs.ACLToken{loginToken}))
+
+	req.AccessorID = loginToken.AccessorID
+	req.AuthToken = loginToken.SecretID
+	err = msgpackrpc.CallWithCodec(codec, structs.ACLRotateTokenRPCMethod, req, &resp)
+	must.ErrorContains(t, err, "cannot be rotated")
+	req.AuthToken = rootToken.SecretID
+
+	// Management tokens get a not found error for missing tokens
+	req.AccessorID = uuid.Generate()
+	err = msgpackrpc.CallWithCodec(codec, structs.ACLRotateTokenRPCMethod, req, &resp)
+	must.ErrorContains(t, err, "cannot find token")
+
+	req.AccessorID = otherToken.AccessorID
+	req.GracePeriod = -time.Minute
+	err = msgpackrpc.CallWithCodec(codec, structs.ACLRotateTokenRPCMethod, req, &resp)
+	must.ErrorContains(t, err, "should not be negative")
+
+	// The grace period can't be longer than the maximum token TTL
+	req.GracePeriod = testServer.config.ACLTokenMaxExpirationTTL + time.Minute
+	err = msgpackrpc.CallWithCodec(codec, structs.ACLRotateTokenRPCMethod, req, &resp)
+	must.ErrorContains(t, err, "should not be more than the maximum token expiration TTL")
+}
+
+func TestACL_ListExpiringTokens(t *testing.T) {
+	ci.Parallel(t)
+
+	testServer, rootToken, testServerCleanupFn := TestACLServer(t, nil)
+	defer testServerCleanupFn()
+	codec := rpcClient(t, testServer)
+	testutil.WaitForLeader(t, testServer.RPC)
+
+	now := time.Now().UTC()
+	soonToken := mock.ACLToken()
+	soonToken.ExpirationTime = pointer.Of(now.Add(10 * time.Minute))
+	globalToken := mock.ACLToken()
+	globalToken.Global = true
+	globalToken.ExpirationTime = pointer.Of(now.Add(30 * time.Minute))
+	laterToken := mock.ACLToken()
+	laterToken.ExpirationTime = pointer.Of(now.Add(3 * time.Hour))
+	expiredToken := mock.ACLToken()
+	expiredToken.ExpirationTime = pointer.Of(now.Add(-time.Minute))
+	must.NoError(t, testServer.fsm.State().UpsertACLTokens(
+		structs.MsgTypeTestSetup, 10, []*structs.ACLToken{
+			laterToken, globalToken, soonToken, expiredToken}))
+
+	// Only management tokens can list expiring tokens
+	req := &structs.ACLTokenExpiringListRequest{
+		QueryOptions: structs.QueryOptions{
+			Region:    DefaultRegion,
+			AuthToken: soonToken.SecretID,
+		},
+	}
+	var resp structs.ACLTokenListResponse
+	err := msgpackrpc.CallWithCodec(codec, structs.ACLListExpiringTokensRPCMethod, req, &resp)
+	must.EqError(t, err, structs.ErrPermissionDenied.Error())
+
+	accessorIDs := func(stubs []*structs.ACLTokenListStub) []string {
+		ids := make([]string, 0, len(stubs))
+		for _, stub := range stubs {
+			ids = append(ids, stub.AccessorID)
+		}
+		return ids
+	}
+
+	// The window defaults to the expiration lead time of the server
+	req.AuthToken = rootToken.SecretID
+	must.NoError(t, msgpackrpc.CallWithCodec(codec, structs.ACLListExpiringTokensRPCMethod, req, &resp))
+	must.Eq(t, []string{soonToken.AccessorID, globalToken.AccessorID}, accessorIDs(resp.Tokens))
+	must.Eq(t, uint64(10), resp.Index)
+
+	req.Within = 4 * time.Hour
+	resp = structs.ACLTokenListResponse{}
+	must.NoError(t, msgpackrpc.CallWithCodec(codec, structs.ACLListExpiringTokensRPCMethod, req, &resp))
+	must.Eq(t, []string{soonToken.AccessorID, globalToken.AccessorID, laterToken.AccessorID},
+		accessorIDs(resp.Tokens))
+
+	req.Within = 5 * time.Minute
+	resp = structs.ACLTokenListResponse{}
+	must.NoError(t, msgpackrpc.CallWithCodec(codec, structs.ACLListExpiringTokensRPCMethod, req, &resp))
+	must.SliceEmpty(t, resp.Tokens)
+}
@@ -34,7 +34,9 @@ func (a *Alloc) List(args *structs.AllocListRequest, reply *structs.AllocListRes
 	if done, err := a.srv.forward("Alloc.List", args, args, reply); done {
 		return err
 	}
-	a.srv.MeasureRPCRate("alloc", structs.RateMetricList, args)
+	if err := a.srv.MeasureRPCRate("alloc", structs.RateMetricList, args); err != nil {
+		return err
+	}
 	if authErr != nil {
 		return structs.ErrPermissionDenied
 	}
@@ -144,7 +146,9 @@ func (a *Alloc) GetAlloc(args *structs.AllocSpecificRequest,
 	if done, err := a.srv.forward("Alloc.GetAlloc", args, args, reply); done {
 		return err
 	}
-	a.srv.MeasureRPCRate("alloc", structs.RateMetricRead, args)
+	if err := a.srv.MeasureRPCRate("alloc", structs.RateMetricRead, args); err != nil {
+		return err
+	}
 	if authErr != nil {
 		return structs.ErrPermissionDenied
 	}
@@ -207,7 +211,9 @@ func (a *Alloc) GetAllocs(args *structs.AllocsGetRequest,
 	if done, err := a.srv.forward("Alloc.GetAllocs", args, args, reply); done {
 		return err
 	}
-	a.srv.MeasureRPCRate("alloc", structs.RateMetricList, args)
+	if err := a.srv.MeasureRPCRate("alloc", structs.RateMetricList, args); err != nil {
+		return err
+	}
 	if authErr != nil {
 		return structs.ErrPermissionDenied
 	}
@@ -277,7 +283,9 @@ func (a *Alloc) Stop(args *structs.AllocStopRequest, reply *structs.AllocStopRes
 	if done, err := a.srv.forward("Alloc.Stop", args, args, reply); done {
 		return err
 	}
-	a.srv.MeasureRPCRate("alloc", structs.RateMetricWrite, args)
+	if err := a.srv.MeasureRPCRate("alloc", structs.RateMetricWrite, args); err != nil {
+		return err
+	}
 	if authErr != nil {
 		return structs.ErrPermissionDenied
 	}
@@ -290,11 +298,11 @@ func (a *Alloc) Stop(args *structs.AllocStopRequest, reply *structs.AllocStopRes
 	}
 
 	// Check for namespace alloc-lifecycle permissions.
-	allowNsOp := acl.NamespaceValidator(acl.NamespaceCapabilityAllocLifecycle)
+	allowJobOp := acl.JobValidator(acl.NamespaceCapabilityAllocLifecycle)
 	aclObj, err := a.srv.ResolveACL(args)
 	if err != nil {
 		return err
-	} else if !allowNsOp(aclObj, alloc.Namespace) {
+	} else if !allowJobOp(aclObj, alloc.Namespace, alloc.JobID) {
 		return structs.ErrPermissionDenied
 	}
 
@@ -342,7 +350,9 @@ func (a *Alloc) UpdateDesiredTransition(args *structs.AllocUpdateDesiredTransiti
 	if done, err := a.srv.forward("Alloc.UpdateDesiredTransition", args, args, reply); done {
 		return err
 	}
-	a.srv.MeasureRPCRate("alloc", structs.RateMetricWrite, args)
+	if err := a.srv.MeasureRPCRate("alloc", structs.RateMetricWrite, args); err != nil {
+		return err
+	}
 	if authErr != nil {
 		return structs.ErrPermissionDenied
 	}
@@ -383,7 +393,9 @@ func (a *Alloc) GetServiceRegistrations(
 	if done, err := a.srv.forward(structs.AllocServiceRegistrationsRPCMethod, args, args, reply); done {
 		return err
 	}
-	a.srv.MeasureRPCRate("alloc", structs.RateMetricList, args)
+	if err := a.srv.MeasureRPCRate("alloc", structs.RateMetricList, args); err != nil {
+		return err
+	}
 	if authErr != nil {
 		return structs.ErrPermissionDenied
 	}
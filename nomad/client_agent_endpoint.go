@@ -36,7 +36,9 @@ func (a *Agent) register() {
 
 func (a *Agent) Profile(args *structs.AgentPprofRequest, reply *structs.AgentPprofResponse) error {
 	authErr := a.srv.Authenticate(nil, args)
-	a.srv.MeasureRPCRate("agent", structs.RateMetricRead, args)
+	if err := a.srv.MeasureRPCRate("agent", structs.RateMetricRead, args); err != nil {
+		return err
+	}
 	if authErr != nil {
 		return structs.ErrPermissionDenied
 	}
@@ -135,7 +137,10 @@ func (a *Agent) monitor(conn io.ReadWriteCloser) {
 		return
 	}
 	authErr := a.srv.Authenticate(nil, &args)
-	a.srv.MeasureRPCRate("agent", structs.RateMetricRead, &args)
+	if err := a.srv.MeasureRPCRate("agent", structs.RateMetricRead, &args); err != nil {
+		handleStreamResultError(err, pointer.Of(int64(429)), encoder)
+		return
+	}
 	if authErr != nil {
 		handleStreamResultError(structs.ErrPermissionDenied, nil, encoder)
 		return
@@ -411,7 +416,9 @@ func (a *Agent) forwardProfileClient(args *structs.AgentPprofRequest, reply *str
 // Host returns data about the agent's host system for the `debug` command.
 func (a *Agent) Host(args *structs.HostDataRequest, reply *structs.HostDataResponse) error {
 	authErr := a.srv.Authenticate(nil, args)
-	a.srv.MeasureRPCRate("agent", structs.RateMetricRead, args)
+	if err := a.srv.MeasureRPCRate("agent", structs.RateMetricRead, args); err != nil {
+		return err
+	}
 	if authErr != nil {
 		return structs.ErrPermissionDenied
 	}
@@ -45,7 +45,9 @@ func (a *ClientAllocations) GarbageCollectAll(args *structs.NodeSpecificRequest,
 	if done, err := a.srv.forward("ClientAllocations.GarbageCollectAll", args, args, reply); done {
 		return err
 	}
-	a.srv.MeasureRPCRate("client_allocations", structs.RateMetricWrite, args)
+	if err := a.srv.MeasureRPCRate("client_allocations", structs.RateMetricWrite, args); err != nil {
+		return err
+	}
 	if authErr != nil {
 		return structs.ErrPermissionDenied
 	}
@@ -97,7 +99,9 @@ func (a *ClientAllocations) Signal(args *structs.AllocSignalRequest, reply *stru
 	if done, err := a.srv.forward("ClientAllocations.Signal", args, args, reply); done {
 		return err
 	}
-	a.srv.MeasureRPCRate("client_allocations", structs.RateMetricWrite, args)
+	if err := a.srv.MeasureRPCRate("client_allocations", structs.RateMetricWrite, args); err != nil {
+		return err
+	}
 	if authErr != nil {
 		return structs.ErrPermissionDenied
 	}
@@ -122,7 +126,7 @@ func (a *ClientAllocations) Signal(args *structs.AllocSignalRequest, reply *stru
 	// Check namespace alloc-lifecycle permission.
 	if aclObj, err := a.srv.ResolveACL(args); err != nil {
 		return err
-	} else if aclObj != nil && !aclObj.AllowNsOp(alloc.Namespace, acl.NamespaceCapabilityAllocLifecycle) {
+	} else if aclObj != nil && !aclObj.AllowJobOp(alloc.Namespace, alloc.JobID, acl.NamespaceCapabilityAllocLifecycle) {
 		return structs.ErrPermissionDenied
 	}
 
@@ -155,7 +159,9 @@ func (a *ClientAllocations) GarbageCollect(args *structs.AllocSpecificRequest, r
 	if done, err := a.srv.forward("ClientAllocations.GarbageCollect", args, args, reply); done {
 		return err
 	}
-	a.srv.MeasureRPCRate("client_allocations", structs.RateMetricWrite, args)
+	if err := a.srv.MeasureRPCRate("client_allocations", structs.RateMetricWrite, args); err != nil {
+		return err
+	}
 	if authErr != nil {
 		return structs.ErrPermissionDenied
 	}
@@ -180,7 +186,7 @@ func (a *ClientAllocations) GarbageCollect(args *structs.AllocSpecificRequest, r
 	// Check namespace submit-job permission.
 	if aclObj, err := a.srv.ResolveACL(args); err != nil {
 		return err
-	} else if aclObj != nil && !aclObj.AllowNsOp(alloc.Namespace, acl.NamespaceCapabilitySubmitJob) {
+	} else if aclObj != nil && !aclObj.AllowJobOp(alloc.Namespace, alloc.JobID, acl.NamespaceCapabilitySubmitJob) {
 		return structs.ErrPermissionDenied
 	}
 
@@ -200,6 +206,67 @@ func (a *ClientAllocations) GarbageCollect(args *structs.AllocSpecificRequest, r
 	return NodeRpc(state.Session, "Allocations.GarbageCollect", args, reply)
 }
 
+// Pin is used to pin or unpin a terminal allocation on a client, exempting it
+// from garbage collection.
+func (a *ClientAllocations) Pin(args *structs.AllocPinRequest, reply *structs.GenericResponse) error {
+	// We only allow stale reads since the only potentially stale information is
+	// the Node registration and the cost is fairly high for adding another hop
+	// in the forwarding chain.
+	args.QueryOptions.AllowStale = true
+
+	authErr := a.srv.Authenticate(nil, args)
+
+	// Potentially forward to a different region.
+	if done, err := a.srv.forward("ClientAllocations.Pin", args, args, reply); done {
+		return err
+	}
+	if err := a.srv.MeasureRPCRate("client_allocations", structs.RateMetricWrite, args); err != nil {
+		return err
+	}
+	if authErr != nil {
+		return structs.ErrPermissionDenied
+	}
+	defer metrics.MeasureSince([]string{"nomad", "client_allocations", "pin"}, time.Now())
+
+	// Verify the arguments.
+	if args.AllocID == "" {
+		return errors.New("missing AllocID")
+	}
+
+	// Find the allocation
+	snap, err := a.srv.State().Snapshot()
+	if err != nil {
+		return err
+	}
+
+	alloc, err := getAlloc(snap, args.AllocID)
+	if err != nil {
+		return err
+	}
+
+	// Check namespace submit-job permission.
+	if aclObj, err := a.srv.ResolveACL(args); err != nil {
+		return err
+	} else if aclObj != nil && !aclObj.AllowJobOp(alloc.Namespace, alloc.JobID, acl.NamespaceCapabilitySubmitJob) {
+		return structs.ErrPermissionDenied
+	}
+
+	// Make sure Node is valid and new enough to support RPC
+	_, err = getNodeForRpc(snap, alloc.NodeID)
+	if err != nil {
+		return err
+	}
+
+	// Get the connection to the client
+	state, ok := a.srv.getNodeConn(alloc.NodeID)
+	if !ok {
+		return findNodeConnAndForward(a.srv, alloc.NodeID, "ClientAllocations.Pin", args, reply)
+	}
+
+	// Make the RPC
+	return NodeRpc(state.Session, "Allocations.Pin", args, reply)
+}
+
 // Restart is used to trigger a restart of an allocation or a subtask on a client.
 func (a *ClientAllocations) Restart(args *structs.AllocRestartRequest, reply *structs.GenericResponse) error {
 	// We only allow stale reads since the only potentially stale information is
@@ -213,7 +280,9 @@ func (a *ClientAllocations) Restart(args *structs.AllocRestartRequest, reply *st
 	if done, err := a.srv.forward("ClientAllocations.Restart", args, args, reply); done {
 		return err
 	}
-	a.srv.MeasureRPCRate("client_allocations", structs.RateMetricWrite, args)
+	if err := a.srv.MeasureRPCRate("client_allocations", structs.RateMetricWrite, args); err != nil {
+		return err
+	}
 	if authErr != nil {
 		return structs.ErrPermissionDenied
 	}
@@ -233,7 +302,7 @@ func (a *ClientAllocations) Restart(args *structs.AllocRestartRequest, reply *st
 	// Check for namespace alloc-lifecycle permissions.
 	if aclObj, err := a.srv.ResolveACL(args); err != nil {
 		return err
-	} else if aclObj != nil && !aclObj.AllowNsOp(alloc.Namespace, acl.NamespaceCapabilityAllocLifecycle) {
+	} else if aclObj != nil && !aclObj.AllowJobOp(alloc.Namespace, alloc.JobID, acl.NamespaceCapabilityAllocLifecycle) {
 		return structs.ErrPermissionDenied
 	}
 
@@ -253,6 +322,117 @@ func (a *ClientAllocations) Restart(args *structs.AllocRestartRequest, reply *st
 	return NodeRpc(state.Session, "Allocations.Restart", args, reply)
 }
 
+// Pause is used to freeze the processes of an allocation or a subtask on a client.
+func (a *ClientAllocations) Pause(args *structs.AllocPauseRequest, reply *structs.GenericResponse) error {
+	// We only allow stale reads since the only potentially stale information is
+	// the Node registration and the cost is fairly high for adding another hop
+	// in the forwarding chain.
+	args.QueryOptions.AllowStale = true
+
+	authErr := a.srv.Authenticate(nil, args)
+
+	// Potentially forward to a different region.
+	if done, err := a.srv.forward("ClientAllocations.Pause", args, args, reply); done {
+		return err
+	}
+	if err := a.srv.MeasureRPCRate("client_allocations", structs.RateMetricWrite, args); err != nil {
+		return err
+	}
+	if authErr != nil {
+		return structs.ErrPermissionDenied
+	}
+	defer metrics.MeasureSince([]string{"nomad", "client_allocations", "pause"}, time.Now())
+
+	// Find the allocation
+	snap, err := a.srv.State().Snapshot()
+	if err != nil {
+		return err
+	}
+
+	alloc, err := getAlloc(snap, args.AllocID)
+	if err != nil {
+		return err
+	}
+
+	// Check for namespace alloc-lifecycle permissions.
+	if aclObj, err := a.srv.ResolveACL(args); err != nil {
+		return err
+	} else if aclObj != nil && !aclObj.AllowJobOp(alloc.Namespace, alloc.JobID, acl.NamespaceCapabilityAllocLifecycle) {
+		return structs.ErrPermissionDenied
+	}
+
+	// Make sure Node is valid and new enough to support RPC
+	_, err = getNodeForRpc(snap, alloc.NodeID)
+	if err != nil {
+		return err
+	}
+
+	// Get the connection to the client
+	state, ok := a.srv.getNodeConn(alloc.NodeID)
+	if !ok {
+		return findNodeConnAndForward(a.srv, alloc.NodeID, "ClientAllocations.Pause", args, reply)
+	}
+
+	// Make the RPC
+	return NodeRpc(state.Session, "Allocations.Pause", args, reply)
+}
+
+// Resume is used to thaw the processes of a paused allocation or subtask on a
+// client.
+func (a *ClientAllocations) Resume(args *structs.AllocPauseRequest, reply *structs.GenericResponse) error {
+	// We only allow stale reads since the only potentially stale information is
+	// the Node registration and the cost is fairly high for adding another hop
+	// in the forwarding chain.
+	args.QueryOptions.AllowStale = true
+
+	authErr := a.srv.Authenticate(nil, args)
+
+	// Potentially forward to a different region.
+	if done, err := a.srv.forward("ClientAllocations.Resume", args, args, reply); done {
+		return err
+	}
+	if err := a.srv.MeasureRPCRate("client_allocations", structs.RateMetricWrite, args); err != nil {
+		return err
+	}
+	if authErr != nil {
+		return structs.ErrPermissionDenied
+	}
+	defer metrics.MeasureSince([]string{"nomad", "client_allocations", "resume"}, time.Now())
+
+	// Find the allocation
+	snap, err := a.srv.State().Snapshot()
+	if err != nil {
+		return err
+	}
+
+	alloc, err := getAlloc(snap, args.AllocID)
+	if err != nil {
+		return err
+	}
+
+	// Check for namespace alloc-lifecycle permissions.
+	if aclObj, err := a.srv.ResolveACL(args); err != nil {
+		return err
+	} else if aclObj != nil && !aclObj.AllowJobOp(alloc.Namespace, alloc.JobID, acl.NamespaceCapabilityAllocLifecycle) {
+		return structs.ErrPermissionDenied
+	}
+
+	// Make sure Node is valid and new enough to support RPC
+	_, err = getNodeForRpc(snap, alloc.NodeID)
+	if err != nil {
+		return err
+	}
+
+	// Get the connection to the client
+	state, ok := a.srv.getNodeConn(alloc.NodeID)
+	if !ok {
+		return findNodeConnAndForward(a.srv, alloc.NodeID, "ClientAllocations.Resume", args, reply)
+	}
+
+	// Make the RPC
+	return NodeRpc(state.Session, "Allocations.Resume", args, reply)
+}
+
 // Stats is used to collect allocation statistics
 func (a *ClientAllocations) Stats(args *cstructs.AllocStatsRequest, reply *cstructs.AllocStatsResponse) error {
 	// We only allow stale reads since the only potentially stale information is
@@ -266,7 +446,9 @@ func (a *ClientAllocations) Stats(args *cstructs.AllocStatsRequest, reply *cstru
 	if done, err := a.srv.forward("ClientAllocations.Stats", args, args, reply); done {
 		return err
 	}
-	a.srv.MeasureRPCRate("client_allocations", structs.RateMetricRead, args)
+	if err := a.srv.MeasureRPCRate("client_allocations", structs.RateMetricRead, args); err != nil {
+		return err
+	}
 	if authErr != nil {
 		return structs.ErrPermissionDenied
 	}
@@ -286,7 +468,7 @@ func (a *ClientAllocations) Stats(args *cstructs.AllocStatsRequest, reply *cstru
 	// Check for namespace read-job permissions.
 	if aclObj, err := a.srv.ResolveACL(args); err != nil {
 		return err
-	} else if aclObj != nil && !aclObj.AllowNsOp(alloc.Namespace, acl.NamespaceCapabilityReadJob) {
+	} else if aclObj != nil && !aclObj.AllowJobOp(alloc.Namespace, alloc.JobID, acl.NamespaceCapabilityReadJob) {
 		return structs.ErrPermissionDenied
 	}
 
@@ -306,6 +488,62 @@ func (a *ClientAllocations) Stats(args *cstructs.AllocStatsRequest, reply *cstru
 	return NodeRpc(state.Session, "Allocations.Stats", args, reply)
 }
 
+// StatsHistory is used to collect the recent resource usage history of an
+// allocation
+func (a *ClientAllocations) StatsHistory(args *cstructs.AllocStatsHistoryRequest, reply *cstructs.AllocStatsHistoryResponse) error {
+	// We only allow stale reads since the only potentially stale information is
+	// the Node registration and the cost is fairly high for adding another hop
+	// in the forwarding chain.
+	args.QueryOptions.AllowStale = true
+
+	authErr := a.srv.Authenticate(nil, args)
+
+	// Potentially forward to a different region.
+	if done, err := a.srv.forward("ClientAllocations.StatsHistory", args, args, reply); done {
+		return err
+	}
+	if err := a.srv.MeasureRPCRate("client_allocations", structs.RateMetricRead, args); err != nil {
+		return err
+	}
+	if authErr != nil {
+		return structs.ErrPermissionDenied
+	}
+	defer metrics.MeasureSince([]string{"nomad", "client_allocations", "stats_history"}, time.Now())
+
+	// Find the allocation
+	snap, err := a.srv.State().Snapshot()
+	if err != nil {
+		return err
+	}
+
+	alloc, err := getAlloc(snap, args.AllocID)
+	if err != nil {
+		return err
+	}
+
+	// Check for namespace read-job permissions.
+	if aclObj, err := a.srv.ResolveACL(args); err != nil {
+		return err
+	} else if aclObj != nil && !aclObj.AllowJobOp(alloc.Namespace, alloc.JobID, acl.NamespaceCapabilityReadJob) {
+		return structs.ErrPermissionDenied
+	}
+
+	// Make sure Node is valid and new enough to support RPC
+	_, err = getNodeForRpc(snap, alloc.NodeID)
+	if err != nil {
+		return err
+	}
+
+	// Get the connection to the client
+	state, ok := a.srv.getNodeConn(alloc.NodeID)
+	if !ok {
+		return findNodeConnAndForward(a.srv, alloc.NodeID, "ClientAllocations.StatsHistory", args, reply)
+	}
+
+	// Make the RPC
+	return NodeRpc(state.Session, "Allocations.StatsHistory", args, reply)
+}
+
 // Checks is the server implementation of the allocation checks RPC. The
 // ultimate response is provided by the node running the allocation. This RPC
 // is needed to handle queries which hit the server agent API directly, or via
@@ -323,7 +561,9 @@ func (a *ClientAllocations) Checks(args *cstructs.AllocChecksRequest, reply *cst
 	if done, err := a.srv.forward("ClientAllocations.Checks", args, args, reply); done {
 		return err
 	}
-	a.srv.MeasureRPCRate("client_allocations", structs.RateMetricRead, args)
+	if err := a.srv.MeasureRPCRate("client_allocations", structs.RateMetricRead, args); err != nil {
+		return err
+	}
 	if authErr != nil {
 		return structs.ErrPermissionDenied
 	}
@@ -346,7 +586,7 @@ func (a *ClientAllocations) Checks(args *cstructs.AllocChecksRequest, reply *cst
 	// Check for namespace read-job permissions.
 	if aclObj, err := a.srv.ResolveACL(args); err != nil {
 		return err
-	} else if aclObj != nil && !aclObj.AllowNsOp(alloc.Namespace, acl.NamespaceCapabilityReadJob) {
+	} else if aclObj != nil && !aclObj.AllowJobOp(alloc.Namespace, alloc.JobID, acl.NamespaceCapabilityReadJob) {
 		return structs.ErrPermissionDenied
 	}
 
@@ -388,7 +628,10 @@ func (a *ClientAllocations) exec(conn io.ReadWriteCloser) {
 			args.AllocID, &args.QueryOptions)
 		return
 	}
-	a.srv.MeasureRPCRate("client_allocations", structs.RateMetricWrite, &args)
+	if err := a.srv.MeasureRPCRate("client_allocations", structs.RateMetricWrite, &args); err != nil {
+		handleStreamResultError(err, pointer.Of(int64(429)), encoder)
+		return
+	}
 	if authErr != nil {
 		handleStreamResultError(structs.ErrPermissionDenied, nil, encoder)
 		return
@@ -421,7 +664,7 @@ func (a *ClientAllocations) exec(conn io.ReadWriteCloser) {
 	if aclObj, err := a.srv.ResolveACL(&args); err != nil {
 		handleStreamResultError(err, nil, encoder)
 		return
-	} else if aclObj != nil && !aclObj.AllowNsOp(alloc.Namespace, acl.NamespaceCapabilityAllocExec) {
+	} else if aclObj != nil && !aclObj.AllowJobOp(alloc.Namespace, alloc.JobID, acl.NamespaceCapabilityAllocExec) {
 		// client ultimately checks if AllocNodeExec is required
 		handleStreamResultError(structs.ErrPermissionDenied, nil, encoder)
 		return
@@ -653,6 +653,86 @@ func TestClientAllocations_Stats_Local(t *testing.T) {
 	require.NotNil(resp2.Stats)
 }
 
+func TestClientAllocations_StatsHistory_Local(t *testing.T) {
+	ci.Parallel(t)
+	require := require.New(t)
+
+	// Start a server and client
+	s, cleanupS := TestServer(t, nil)
+	defer cleanupS()
+	codec := rpcClient(t, s)
+	testutil.WaitForLeader(t, s.RPC)
+
+	c, cleanupC := client.TestClient(t, func(c *config.Config) {
+		c.Servers = []string{s.config.RPCAddr.String()}
+	})
+	defer cleanupC()
+
+	// Force an allocation onto the node
+	a := mock.Alloc()
+	a.Job.Type = nstructs.JobTypeBatch
+	a.NodeID = c.NodeID()
+	a.Job.TaskGroups[0].Count = 1
+	a.Job.TaskGroups[0].Tasks[0] = &nstructs.Task{
+		Name:   "web",
+		Driver: "mock_driver",
+		Config: map[string]interface{}{
+			"run_for": "2s",
+		},
+		LogConfig: nstructs.DefaultLogConfig(),
+		Resources: &nstructs.Resources{
+			CPU:      500,
+			MemoryMB: 256,
+		},
+	}
+
+	testutil.WaitForResult(func() (bool, error) {
+		nodes := s.connectedNodes()
+		return len(nodes) == 1, nil
+	}, func(err error) {
+		t.Fatalf("should have a clients")
+	})
+
+	// Upsert the allocation
+	state := s.State()
+	require.Nil(state.UpsertJob(nstructs.MsgTypeTestSetup, 999, a.Job))
+	require.Nil(state.UpsertAllocs(nstructs.MsgTypeTestSetup, 1003, []*nstructs.Allocation{a}))
+
+	// Wait for the client to run the allocation
+	testutil.WaitForResult(func() (bool, error) {
+		alloc, err := state.AllocByID(nil, a.ID)
+		if err != nil {
+			return false, err
+		}
+		if alloc == nil {
+			return false, fmt.Errorf("unknown alloc")
+		}
+		if alloc.ClientStatus != nstructs.AllocClientStatusComplete {
+			return false, fmt.Errorf("alloc client status: %v", alloc.ClientStatus)
+		}
+
+		return true, nil
+	}, func(err error) {
+		t.Fatalf("Alloc on node %q not finished: %v", c.NodeID(), err)
+	})
+
+	// Make the request without having an alloc id
+	req := &cstructs.AllocStatsHistoryRequest{
+		QueryOptions: nstructs.QueryOptions{Region: "global"},
+	}
+
+	var resp cstructs.AllocStatsHistoryResponse
+	err := msgpackrpc.CallWithCodec(codec, "ClientAllocations.StatsHistory", req, &resp)
+	require.EqualError(err, nstructs.ErrMissingAllocID.Error())
+
+	// The history of the completed task is still available
+	req.AllocID = a.ID
+	var resp2 cstructs.AllocStatsHistoryResponse
+	err = msgpackrpc.CallWithCodec(codec, "ClientAllocations.StatsHistory", req, &resp2)
+	require.NoError(err)
+	require.NotEmpty(resp2.Tasks["web"])
+}
+
 func TestClientAllocations_Stats_Local_ACL(t *testing.T) {
 	ci.Parallel(t)
 
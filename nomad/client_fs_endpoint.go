@@ -32,6 +32,7 @@ func NewFileSystemEndpoint(srv *Server) *FileSystem {
 func (f *FileSystem) register() {
 	f.srv.streamingRpcs.Register("FileSystem.Logs", f.logs)
 	f.srv.streamingRpcs.Register("FileSystem.Stream", f.stream)
+	f.srv.streamingRpcs.Register("FileSystem.Archive", f.archive)
 }
 
 // handleStreamResultError is a helper for sending an error with a potential
@@ -112,7 +113,9 @@ func (f *FileSystem) List(args *cstructs.FsListRequest, reply *cstructs.FsListRe
 	if done, err := f.srv.forward("FileSystem.List", args, args, reply); done {
 		return err
 	}
-	f.srv.MeasureRPCRate("file_system", structs.RateMetricList, args)
+	if err := f.srv.MeasureRPCRate("file_system", structs.RateMetricList, args); err != nil {
+		return err
+	}
 	if authErr != nil {
 		return structs.ErrPermissionDenied
 	}
@@ -135,11 +138,11 @@ func (f *FileSystem) List(args *cstructs.FsListRequest, reply *cstructs.FsListRe
 	}
 
 	// Check namespace filesystem read permissions
-	allowNsOp := acl.NamespaceValidator(acl.NamespaceCapabilityReadFS)
+	allowJobOp := acl.JobValidator(acl.NamespaceCapabilityReadFS)
 	aclObj, err := f.srv.ResolveACL(args)
 	if err != nil {
 		return err
-	} else if !allowNsOp(aclObj, alloc.Namespace) {
+	} else if !allowJobOp(aclObj, alloc.Namespace, alloc.JobID) {
 		return structs.ErrPermissionDenied
 	}
 
@@ -172,7 +175,9 @@ func (f *FileSystem) Stat(args *cstructs.FsStatRequest, reply *cstructs.FsStatRe
 	if done, err := f.srv.forward("FileSystem.Stat", args, args, reply); done {
 		return err
 	}
-	f.srv.MeasureRPCRate("file_system", structs.RateMetricRead, args)
+	if err := f.srv.MeasureRPCRate("file_system", structs.RateMetricRead, args); err != nil {
+		return err
+	}
 	if authErr != nil {
 		return structs.ErrPermissionDenied
 	}
@@ -197,7 +202,7 @@ func (f *FileSystem) Stat(args *cstructs.FsStatRequest, reply *cstructs.FsStatRe
 	// Check filesystem read permissions
 	if aclObj, err := f.srv.ResolveACL(args); err != nil {
 		return err
-	} else if aclObj != nil && !aclObj.AllowNsOp(alloc.Namespace, acl.NamespaceCapabilityReadFS) {
+	} else if aclObj != nil && !aclObj.AllowJobOp(alloc.Namespace, alloc.JobID, acl.NamespaceCapabilityReadFS) {
 		return structs.ErrPermissionDenied
 	}
 
@@ -241,7 +246,10 @@ func (f *FileSystem) stream(conn io.ReadWriteCloser) {
 			args.AllocID, &args.QueryOptions)
 		return
 	}
-	f.srv.MeasureRPCRate("file_system", structs.RateMetricRead, &args)
+	if err := f.srv.MeasureRPCRate("file_system", structs.RateMetricRead, &args); err != nil {
+		handleStreamResultError(err, pointer.Of(int64(429)), encoder)
+		return
+	}
 	if authErr != nil {
 		handleStreamResultError(structs.ErrPermissionDenied, nil, encoder)
 		return
@@ -274,7 +282,7 @@ func (f *FileSystem) stream(conn io.ReadWriteCloser) {
 	if aclObj, err := f.srv.ResolveACL(&args); err != nil {
 		handleStreamResultError(err, nil, encoder)
 		return
-	} else if aclObj != nil && !aclObj.AllowNsOp(alloc.Namespace, acl.NamespaceCapabilityReadFS) {
+	} else if aclObj != nil && !aclObj.AllowJobOp(alloc.Namespace, alloc.JobID, acl.NamespaceCapabilityReadFS) {
 		handleStreamResultError(structs.ErrPermissionDenied, nil, encoder)
 		return
 	}
@@ -343,6 +351,134 @@ func (f *FileSystem) stream(conn io.ReadWriteCloser) {
 	structs.Bridge(conn, clientConn)
 }
 
+// archive is used to stream a tar archive of a directory in an allocation
+func (f *FileSystem) archive(conn io.ReadWriteCloser) {
+	defer conn.Close()
+	defer metrics.MeasureSince([]string{"nomad", "file_system", "archive"}, time.Now())
+
+	// Decode the arguments
+	var args cstructs.FsArchiveRequest
+	decoder := codec.NewDecoder(conn, structs.MsgpackHandle)
+	encoder := codec.NewEncoder(conn, structs.MsgpackHandle)
+
+	if err := decoder.Decode(&args); err != nil {
+		handleStreamResultError(err, pointer.Of(int64(500)), encoder)
+		return
+	}
+
+	authErr := f.srv.Authenticate(nil, &args)
+
+	// Check if we need to forward to a different region
+	if r := args.RequestRegion(); r != f.srv.Region() {
+		forwardRegionStreamingRpc(f.srv, conn, encoder, &args, "FileSystem.Archive",
+			args.AllocID, &args.QueryOptions)
+		return
+	}
+	if err := f.srv.MeasureRPCRate("file_system", structs.RateMetricRead, &args); err != nil {
+		handleStreamResultError(err, pointer.Of(int64(429)), encoder)
+		return
+	}
+	if authErr != nil {
+		handleStreamResultError(structs.ErrPermissionDenied, nil, encoder)
+		return
+	}
+
+	// Verify the arguments.
+	if args.AllocID == "" {
+		handleStreamResultError(errors.New("missing AllocID"), pointer.Of(int64(400)), encoder)
+		return
+	}
+
+	// Retrieve the allocation
+	snap, err := f.srv.State().Snapshot()
+	if err != nil {
+		handleStreamResultError(err, nil, encoder)
+		return
+	}
+
+	alloc, err := getAlloc(snap, args.AllocID)
+	if structs.IsErrUnknownAllocation(err) {
+		handleStreamResultError(structs.NewErrUnknownAllocation(args.AllocID), pointer.Of(int64(404)), encoder)
+		return
+	}
+	if err != nil {
+		handleStreamResultError(err, nil, encoder)
+		return
+	}
+
+	// Check namespace read-fs permissions.
+	if aclObj, err := f.srv.ResolveACL(&args); err != nil {
+		handleStreamResultError(err, nil, encoder)
+		return
+	} else if aclObj != nil && !aclObj.AllowJobOp(alloc.Namespace, alloc.JobID, acl.NamespaceCapabilityReadFS) {
+		handleStreamResultError(structs.ErrPermissionDenied, nil, encoder)
+		return
+	}
+
+	nodeID := alloc.NodeID
+
+	// Make sure Node is valid and new enough to support RPC
+	node, err := snap.NodeByID(nil, nodeID)
+	if err != nil {
+		handleStreamResultError(err, pointer.Of(int64(500)), encoder)
+		return
+	}
+
+	if node == nil {
+		err := fmt.Errorf("Unknown node %q", nodeID)
+		handleStreamResultError(err, pointer.Of(int64(400)), encoder)
+		return
+	}
+
+	if err := nodeSupportsRpc(node); err != nil {
+		handleStreamResultError(err, pointer.Of(int64(400)), encoder)
+		return
+	}
+
+	// Get the connection to the client either by forwarding to another server
+	// or creating a direct stream
+	var clientConn net.Conn
+	state, ok := f.srv.getNodeConn(nodeID)
+	if !ok {
+		// Determine the Server that has a connection to the node.
+		srv, err := f.srv.serverWithNodeConn(nodeID, f.srv.Region())
+		if err != nil {
+			var code *int64
+			if structs.IsErrNoNodeConn(err) {
+				code = pointer.Of(int64(404))
+			}
+			handleStreamResultError(err, code, encoder)
+			return
+		}
+
+		// Get a connection to the server
+		conn, err := f.srv.streamingRpc(srv, "FileSystem.Archive")
+		if err != nil {
+			handleStreamResultError(err, nil, encoder)
+			return
+		}
+
+		clientConn = conn
+	} else {
+		stream, err := NodeStreamingRpc(state.Session, "FileSystem.Archive")
+		if err != nil {
+			handleStreamResultError(err, nil, encoder)
+			return
+		}
+		clientConn = stream
+	}
+	defer clientConn.Close()
+
+	// Send the request.
+	outEncoder := codec.NewEncoder(clientConn, structs.MsgpackHandle)
+	if err := outEncoder.Encode(args); err != nil {
+		handleStreamResultError(err, nil, encoder)
+		return
+	}
+
+	structs.Bridge(conn, clientConn)
+}
+
 // logs is used to access an task's logs for a given allocation
 func (f *FileSystem) logs(conn io.ReadWriteCloser) {
 	defer conn.Close()
@@ -366,7 +502,10 @@ func (f *FileSystem) logs(conn io.ReadWriteCloser) {
 			args.AllocID, &args.QueryOptions)
 		return
 	}
-	f.srv.MeasureRPCRate("file_system", structs.RateMetricRead, &args)
+	if err := f.srv.MeasureRPCRate("file_system", structs.RateMetricRead, &args); err != nil {
+		handleStreamResultError(err, pointer.Of(int64(429)), encoder)
+		return
+	}
 	if authErr != nil {
 		handleStreamResultError(structs.ErrPermissionDenied, nil, encoder)
 		return
@@ -396,13 +535,13 @@ func (f *FileSystem) logs(conn io.ReadWriteCloser) {
 	}
 
 	// Check namespace read-logs *or* read-fs permissions.
-	allowNsOp := acl.NamespaceValidator(
+	allowJobOp := acl.JobValidator(
 		acl.NamespaceCapabilityReadFS, acl.NamespaceCapabilityReadLogs)
 	aclObj, err := f.srv.ResolveACL(&args)
 	if err != nil {
 		handleStreamResultError(err, nil, encoder)
 		return
-	} else if !allowNsOp(aclObj, alloc.Namespace) {
+	} else if !allowJobOp(aclObj, alloc.Namespace, alloc.JobID) {
 		handleStreamResultError(structs.ErrPermissionDenied, nil, encoder)
 		return
 	}
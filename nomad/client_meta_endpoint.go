@@ -33,7 +33,9 @@ func (n *NodeMeta) Apply(args *structs.NodeMetaApplyRequest, reply *structs.Node
 	if done, err := n.srv.forward(method, args, args, reply); done {
 		return err
 	}
-	n.srv.MeasureRPCRate("node_meta", nstructs.RateMetricRead, args)
+	if err := n.srv.MeasureRPCRate("node_meta", nstructs.RateMetricRead, args); err != nil {
+		return err
+	}
 	if authErr != nil {
 		return nstructs.ErrPermissionDenied
 	}
@@ -60,7 +62,9 @@ func (n *NodeMeta) Read(args *structs.NodeSpecificRequest, reply *structs.NodeMe
 	if done, err := n.srv.forward(method, args, args, reply); done {
 		return err
 	}
-	n.srv.MeasureRPCRate("node_meta", nstructs.RateMetricRead, args)
+	if err := n.srv.MeasureRPCRate("node_meta", nstructs.RateMetricRead, args); err != nil {
+		return err
+	}
 	if authErr != nil {
 		return nstructs.ErrPermissionDenied
 	}
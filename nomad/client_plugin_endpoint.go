@@ -0,0 +1,52 @@
+package nomad
+
+import (
+	"time"
+
+	metrics "github.com/armon/go-metrics"
+	log "github.com/hashicorp/go-hclog"
+	"github.com/hashicorp/nomad/nomad/structs"
+)
+
+// NodePlugin is used to forward plugin RPCs to client agents.
+type NodePlugin struct {
+	srv    *Server
+	logger log.Logger
+}
+
+func newNodePluginEndpoint(srv *Server) *NodePlugin {
+	n := &NodePlugin{
+		srv:    srv,
+		logger: srv.logger.Named("node_plugin"),
+	}
+	return n
+}
+
+func (n *NodePlugin) Reload(args *structs.NodePluginReloadRequest, reply *structs.NodePluginReloadResponse) error {
+	const method = "NodePlugin.Reload"
+
+	// Prevent infinite loop between leader and
+	// follower-with-the-target-node-connection.
+	args.QueryOptions.AllowStale = true
+
+	authErr := n.srv.Authenticate(nil, args)
+	if done, err := n.srv.forward(method, args, args, reply); done {
+		return err
+	}
+	if err := n.srv.MeasureRPCRate("node_plugin", structs.RateMetricWrite, args); err != nil {
+		return err
+	}
+	if authErr != nil {
+		return structs.ErrPermissionDenied
+	}
+	defer metrics.MeasureSince([]string{"nomad", "client_plugin", "reload"}, time.Now())
+
+	// Check node write permissions
+	if aclObj, err := n.srv.ResolveACL(args); err != nil {
+		return err
+	} else if aclObj != nil && !aclObj.AllowNodeWrite() {
+		return structs.ErrPermissionDenied
+	}
+
+	return n.srv.forwardClientRPC(method, args.NodeID, args, reply)
+}
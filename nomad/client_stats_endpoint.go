@@ -33,7 +33,9 @@ func (s *ClientStats) Stats(args *nstructs.NodeSpecificRequest, reply *structs.C
 	if done, err := s.srv.forward("ClientStats.Stats", args, args, reply); done {
 		return err
 	}
-	s.srv.MeasureRPCRate("client_stats", nstructs.RateMetricRead, args)
+	if err := s.srv.MeasureRPCRate("client_stats", nstructs.RateMetricRead, args); err != nil {
+		return err
+	}
 	if authErr != nil {
 		return nstructs.ErrPermissionDenied
 	}
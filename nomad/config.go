@@ -11,6 +11,7 @@ import (
 	"golang.org/x/exp/slices"
 
 	"github.com/hashicorp/memberlist"
+	"github.com/hashicorp/nomad/helper"
 	"github.com/hashicorp/nomad/helper/pointer"
 	"github.com/hashicorp/nomad/helper/uuid"
 	"github.com/hashicorp/nomad/nomad/deploymentwatcher"
@@ -211,6 +212,10 @@ type Config struct {
 	// must be to be collected by GC.
 	ACLTokenExpirationGCThreshold time.Duration
 
+	// ACLTokenExpirationNotifyInterval is how often the leader checks for ACL
+	// tokens which are about to expire.
+	ACLTokenExpirationNotifyInterval time.Duration
+
 	// RootKeyGCInterval is how often we dispatch a job to GC
 	// encryption key metadata
 	RootKeyGCInterval time.Duration
@@ -334,6 +339,10 @@ type Config struct {
 	// for ACL token expiration.
 	ACLTokenMaxExpirationTTL time.Duration
 
+	// ACLTokenExpirationLeadTime is how long before an ACL token expires the
+	// leader publishes an event for it and counts it as expiring.
+	ACLTokenExpirationLeadTime time.Duration
+
 	// SentinelGCInterval is the interval that we GC unused policies.
 	SentinelGCInterval time.Duration
 
@@ -408,6 +417,14 @@ type Config struct {
 
 	// JobMaxPriority is an upper bound on the Job priority.
 	JobMaxPriority int
+
+	// AdmissionWebhooks are the external admission webhooks called, in
+	// order, when jobs are registered, planned or validated.
+	AdmissionWebhooks []*config.AdmissionWebhookConfig
+
+	// RPCRateLimit configures per-identity limits on the rate of RPCs handled
+	// by this server.
+	RPCRateLimit *config.RPCRateLimitConfig
 }
 
 func (c *Config) Copy() *Config {
@@ -436,6 +453,8 @@ func (c *Config) Copy() *Config {
 	nc.AutopilotConfig = c.AutopilotConfig.Copy()
 	nc.LicenseConfig = c.LicenseConfig.Copy()
 	nc.SearchConfig = c.SearchConfig.Copy()
+	nc.AdmissionWebhooks = helper.CopySlice(c.AdmissionWebhooks)
+	nc.RPCRateLimit = c.RPCRateLimit.Copy()
 
 	return &nc
 }
@@ -477,6 +496,7 @@ func DefaultConfig() *Config {
 		OneTimeTokenGCInterval:           10 * time.Minute,
 		ACLTokenExpirationGCInterval:     5 * time.Minute,
 		ACLTokenExpirationGCThreshold:    1 * time.Hour,
+		ACLTokenExpirationNotifyInterval: 1 * time.Minute,
 		RootKeyGCInterval:                10 * time.Minute,
 		RootKeyGCThreshold:               1 * time.Hour,
 		RootKeyRotationThreshold:         720 * time.Hour, // 30 days
@@ -507,6 +527,7 @@ func DefaultConfig() *Config {
 		EventBufferSize:                  100,
 		ACLTokenMinExpirationTTL:         1 * time.Minute,
 		ACLTokenMaxExpirationTTL:         24 * time.Hour,
+		ACLTokenExpirationLeadTime:       1 * time.Hour,
 		AutopilotConfig: &structs.AutopilotConfig{
 			CleanupDeadServers:      true,
 			LastContactThreshold:    200 * time.Millisecond,
@@ -56,7 +56,9 @@ func (v *CSIVolume) List(args *structs.CSIVolumeListRequest, reply *structs.CSIV
 	if done, err := v.srv.forward("CSIVolume.List", args, args, reply); done {
 		return err
 	}
-	v.srv.MeasureRPCRate("csi_volume", structs.RateMetricList, args)
+	if err := v.srv.MeasureRPCRate("csi_volume", structs.RateMetricList, args); err != nil {
+		return err
+	}
 	if authErr != nil {
 		return structs.ErrPermissionDenied
 	}
@@ -174,7 +176,9 @@ func (v *CSIVolume) Get(args *structs.CSIVolumeGetRequest, reply *structs.CSIVol
 	if done, err := v.srv.forward("CSIVolume.Get", args, args, reply); done {
 		return err
 	}
-	v.srv.MeasureRPCRate("csi_volume", structs.RateMetricRead, args)
+	if err := v.srv.MeasureRPCRate("csi_volume", structs.RateMetricRead, args); err != nil {
+		return err
+	}
 	if authErr != nil {
 		return structs.ErrPermissionDenied
 	}
@@ -278,7 +282,9 @@ func (v *CSIVolume) Register(args *structs.CSIVolumeRegisterRequest, reply *stru
 	if done, err := v.srv.forward("CSIVolume.Register", args, args, reply); done {
 		return err
 	}
-	v.srv.MeasureRPCRate("csi_volume", structs.RateMetricWrite, args)
+	if err := v.srv.MeasureRPCRate("csi_volume", structs.RateMetricWrite, args); err != nil {
+		return err
+	}
 	if authErr != nil {
 		return structs.ErrPermissionDenied
 	}
@@ -372,7 +378,9 @@ func (v *CSIVolume) Deregister(args *structs.CSIVolumeDeregisterRequest, reply *
 	if done, err := v.srv.forward("CSIVolume.Deregister", args, args, reply); done {
 		return err
 	}
-	v.srv.MeasureRPCRate("csi_volume", structs.RateMetricWrite, args)
+	if err := v.srv.MeasureRPCRate("csi_volume", structs.RateMetricWrite, args); err != nil {
+		return err
+	}
 	if authErr != nil {
 		return structs.ErrPermissionDenied
 	}
@@ -412,7 +420,9 @@ func (v *CSIVolume) Claim(args *structs.CSIVolumeClaimRequest, reply *structs.CS
 	if done, err := v.srv.forward("CSIVolume.Claim", args, args, reply); done {
 		return err
 	}
-	v.srv.MeasureRPCRate("csi_volume", structs.RateMetricWrite, args)
+	if err := v.srv.MeasureRPCRate("csi_volume", structs.RateMetricWrite, args); err != nil {
+		return err
+	}
 	if authErr != nil {
 		return structs.ErrPermissionDenied
 	}
@@ -597,7 +607,9 @@ func (v *CSIVolume) Unpublish(args *structs.CSIVolumeUnpublishRequest, reply *st
 	if done, err := v.srv.forward("CSIVolume.Unpublish", args, args, reply); done {
 		return err
 	}
-	v.srv.MeasureRPCRate("csi_volume", structs.RateMetricWrite, args)
+	if err := v.srv.MeasureRPCRate("csi_volume", structs.RateMetricWrite, args); err != nil {
+		return err
+	}
 	if authErr != nil {
 		return structs.ErrPermissionDenied
 	}
@@ -937,7 +949,9 @@ func (v *CSIVolume) Create(args *structs.CSIVolumeCreateRequest, reply *structs.
 	if done, err := v.srv.forward("CSIVolume.Create", args, args, reply); done {
 		return err
 	}
-	v.srv.MeasureRPCRate("csi_volume", structs.RateMetricWrite, args)
+	if err := v.srv.MeasureRPCRate("csi_volume", structs.RateMetricWrite, args); err != nil {
+		return err
+	}
 	if authErr != nil {
 		return structs.ErrPermissionDenied
 	}
@@ -1063,7 +1077,9 @@ func (v *CSIVolume) Delete(args *structs.CSIVolumeDeleteRequest, reply *structs.
 	if done, err := v.srv.forward("CSIVolume.Delete", args, args, reply); done {
 		return err
 	}
-	v.srv.MeasureRPCRate("csi_volume", structs.RateMetricWrite, args)
+	if err := v.srv.MeasureRPCRate("csi_volume", structs.RateMetricWrite, args); err != nil {
+		return err
+	}
 	if authErr != nil {
 		return structs.ErrPermissionDenied
 	}
@@ -1144,7 +1160,9 @@ func (v *CSIVolume) ListExternal(args *structs.CSIVolumeExternalListRequest, rep
 	if done, err := v.srv.forward("CSIVolume.ListExternal", args, args, reply); done {
 		return err
 	}
-	v.srv.MeasureRPCRate("csi_volume", structs.RateMetricList, args)
+	if err := v.srv.MeasureRPCRate("csi_volume", structs.RateMetricList, args); err != nil {
+		return err
+	}
 	if authErr != nil {
 		return structs.ErrPermissionDenied
 	}
@@ -1209,7 +1227,9 @@ func (v *CSIVolume) CreateSnapshot(args *structs.CSISnapshotCreateRequest, reply
 	if done, err := v.srv.forward("CSIVolume.CreateSnapshot", args, args, reply); done {
 		return err
 	}
-	v.srv.MeasureRPCRate("csi_volume", structs.RateMetricWrite, args)
+	if err := v.srv.MeasureRPCRate("csi_volume", structs.RateMetricWrite, args); err != nil {
+		return err
+	}
 	if authErr != nil {
 		return structs.ErrPermissionDenied
 	}
@@ -1305,7 +1325,9 @@ func (v *CSIVolume) DeleteSnapshot(args *structs.CSISnapshotDeleteRequest, reply
 	if done, err := v.srv.forward("CSIVolume.DeleteSnapshot", args, args, reply); done {
 		return err
 	}
-	v.srv.MeasureRPCRate("csi_volume", structs.RateMetricWrite, args)
+	if err := v.srv.MeasureRPCRate("csi_volume", structs.RateMetricWrite, args); err != nil {
+		return err
+	}
 	if authErr != nil {
 		return structs.ErrPermissionDenied
 	}
@@ -1370,7 +1392,9 @@ func (v *CSIVolume) ListSnapshots(args *structs.CSISnapshotListRequest, reply *s
 	if done, err := v.srv.forward("CSIVolume.ListSnapshots", args, args, reply); done {
 		return err
 	}
-	v.srv.MeasureRPCRate("csi_volume", structs.RateMetricList, args)
+	if err := v.srv.MeasureRPCRate("csi_volume", structs.RateMetricList, args); err != nil {
+		return err
+	}
 	if authErr != nil {
 		return structs.ErrPermissionDenied
 	}
@@ -1448,7 +1472,9 @@ func (v *CSIPlugin) List(args *structs.CSIPluginListRequest, reply *structs.CSIP
 	if done, err := v.srv.forward("CSIPlugin.List", args, args, reply); done {
 		return err
 	}
-	v.srv.MeasureRPCRate("csi_plugin", structs.RateMetricList, args)
+	if err := v.srv.MeasureRPCRate("csi_plugin", structs.RateMetricList, args); err != nil {
+		return err
+	}
 	if authErr != nil {
 		return structs.ErrPermissionDenied
 	}
@@ -1507,7 +1533,9 @@ func (v *CSIPlugin) Get(args *structs.CSIPluginGetRequest, reply *structs.CSIPlu
 	if done, err := v.srv.forward("CSIPlugin.Get", args, args, reply); done {
 		return err
 	}
-	v.srv.MeasureRPCRate("csi_plugin", structs.RateMetricRead, args)
+	if err := v.srv.MeasureRPCRate("csi_plugin", structs.RateMetricRead, args); err != nil {
+		return err
+	}
 	if authErr != nil {
 		return structs.ErrPermissionDenied
 	}
@@ -1576,7 +1604,9 @@ func (v *CSIPlugin) Delete(args *structs.CSIPluginDeleteRequest, reply *structs.
 	if done, err := v.srv.forward("CSIPlugin.Delete", args, args, reply); done {
 		return err
 	}
-	v.srv.MeasureRPCRate("csi_plugin", structs.RateMetricWrite, args)
+	if err := v.srv.MeasureRPCRate("csi_plugin", structs.RateMetricWrite, args); err != nil {
+		return err
+	}
 	if authErr != nil {
 		return structs.ErrPermissionDenied
 	}
@@ -34,7 +34,9 @@ func (d *Deployment) GetDeployment(args *structs.DeploymentSpecificRequest,
 	if done, err := d.srv.forward("Deployment.GetDeployment", args, args, reply); done {
 		return err
 	}
-	d.srv.MeasureRPCRate("deployment", structs.RateMetricRead, args)
+	if err := d.srv.MeasureRPCRate("deployment", structs.RateMetricRead, args); err != nil {
+		return err
+	}
 	if authErr != nil {
 		return structs.ErrPermissionDenied
 	}
@@ -99,7 +101,9 @@ func (d *Deployment) Fail(args *structs.DeploymentFailRequest, reply *structs.De
 	if done, err := d.srv.forward("Deployment.Fail", args, args, reply); done {
 		return err
 	}
-	d.srv.MeasureRPCRate("deployment", structs.RateMetricWrite, args)
+	if err := d.srv.MeasureRPCRate("deployment", structs.RateMetricWrite, args); err != nil {
+		return err
+	}
 	if authErr != nil {
 		return structs.ErrPermissionDenied
 	}
@@ -146,7 +150,9 @@ func (d *Deployment) Pause(args *structs.DeploymentPauseRequest, reply *structs.
 	if done, err := d.srv.forward("Deployment.Pause", args, args, reply); done {
 		return err
 	}
-	d.srv.MeasureRPCRate("deployment", structs.RateMetricWrite, args)
+	if err := d.srv.MeasureRPCRate("deployment", structs.RateMetricWrite, args); err != nil {
+		return err
+	}
 	if authErr != nil {
 		return structs.ErrPermissionDenied
 	}
@@ -197,7 +203,9 @@ func (d *Deployment) Promote(args *structs.DeploymentPromoteRequest, reply *stru
 	if done, err := d.srv.forward("Deployment.Promote", args, args, reply); done {
 		return err
 	}
-	d.srv.MeasureRPCRate("deployment", structs.RateMetricWrite, args)
+	if err := d.srv.MeasureRPCRate("deployment", structs.RateMetricWrite, args); err != nil {
+		return err
+	}
 	if authErr != nil {
 		return structs.ErrPermissionDenied
 	}
@@ -244,7 +252,9 @@ func (d *Deployment) Run(args *structs.DeploymentRunRequest, reply *structs.Depl
 	if done, err := d.srv.forward("Deployment.Run", args, args, reply); done {
 		return err
 	}
-	d.srv.MeasureRPCRate("deployment", structs.RateMetricWrite, args)
+	if err := d.srv.MeasureRPCRate("deployment", structs.RateMetricWrite, args); err != nil {
+		return err
+	}
 	if authErr != nil {
 		return structs.ErrPermissionDenied
 	}
@@ -291,7 +301,9 @@ func (d *Deployment) Unblock(args *structs.DeploymentUnblockRequest, reply *stru
 	if done, err := d.srv.forward("Deployment.Unblock", args, args, reply); done {
 		return err
 	}
-	d.srv.MeasureRPCRate("deployment", structs.RateMetricWrite, args)
+	if err := d.srv.MeasureRPCRate("deployment", structs.RateMetricWrite, args); err != nil {
+		return err
+	}
 	if authErr != nil {
 		return structs.ErrPermissionDenied
 	}
@@ -338,7 +350,9 @@ func (d *Deployment) Cancel(args *structs.DeploymentCancelRequest, reply *struct
 	if done, err := d.srv.forward("Deployment.Cancel", args, args, reply); done {
 		return err
 	}
-	d.srv.MeasureRPCRate("deployment", structs.RateMetricWrite, args)
+	if err := d.srv.MeasureRPCRate("deployment", structs.RateMetricWrite, args); err != nil {
+		return err
+	}
 	if authErr != nil {
 		return structs.ErrPermissionDenied
 	}
@@ -386,7 +400,9 @@ func (d *Deployment) SetAllocHealth(args *structs.DeploymentAllocHealthRequest,
 	if done, err := d.srv.forward("Deployment.SetAllocHealth", args, args, reply); done {
 		return err
 	}
-	d.srv.MeasureRPCRate("deployment", structs.RateMetricWrite, args)
+	if err := d.srv.MeasureRPCRate("deployment", structs.RateMetricWrite, args); err != nil {
+		return err
+	}
 	if authErr != nil {
 		return structs.ErrPermissionDenied
 	}
@@ -437,7 +453,9 @@ func (d *Deployment) List(args *structs.DeploymentListRequest, reply *structs.De
 	if done, err := d.srv.forward("Deployment.List", args, args, reply); done {
 		return err
 	}
-	d.srv.MeasureRPCRate("deployment", structs.RateMetricList, args)
+	if err := d.srv.MeasureRPCRate("deployment", structs.RateMetricList, args); err != nil {
+		return err
+	}
 	if authErr != nil {
 		return structs.ErrPermissionDenied
 	}
@@ -529,7 +547,9 @@ func (d *Deployment) Allocations(args *structs.DeploymentSpecificRequest, reply
 	if done, err := d.srv.forward("Deployment.Allocations", args, args, reply); done {
 		return err
 	}
-	d.srv.MeasureRPCRate("deployment", structs.RateMetricList, args)
+	if err := d.srv.MeasureRPCRate("deployment", structs.RateMetricList, args); err != nil {
+		return err
+	}
 	if authErr != nil {
 		return structs.ErrPermissionDenied
 	}
@@ -600,7 +620,9 @@ func (d *Deployment) Reap(args *structs.DeploymentDeleteRequest,
 	if done, err := d.srv.forward("Deployment.Reap", args, args, reply); done {
 		return err
 	}
-	d.srv.MeasureRPCRate("deployment", structs.RateMetricWrite, args)
+	if err := d.srv.MeasureRPCRate("deployment", structs.RateMetricWrite, args); err != nil {
+		return err
+	}
 	if authErr != nil {
 		return structs.ErrPermissionDenied
 	}
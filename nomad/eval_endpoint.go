@@ -46,7 +46,9 @@ func (e *Eval) GetEval(args *structs.EvalSpecificRequest,
 	if done, err := e.srv.forward("Eval.GetEval", args, args, reply); done {
 		return err
 	}
-	e.srv.MeasureRPCRate("eval", structs.RateMetricRead, args)
+	if err := e.srv.MeasureRPCRate("eval", structs.RateMetricRead, args); err != nil {
+		return err
+	}
 	if authErr != nil {
 		return structs.ErrPermissionDenied
 	}
@@ -127,7 +129,9 @@ func (e *Eval) Dequeue(args *structs.EvalDequeueRequest,
 	if done, err := e.srv.forward("Eval.Dequeue", args, args, reply); done {
 		return err
 	}
-	e.srv.MeasureRPCRate("eval", structs.RateMetricWrite, args)
+	if err := e.srv.MeasureRPCRate("eval", structs.RateMetricWrite, args); err != nil {
+		return err
+	}
 	if authErr != nil {
 		return structs.ErrPermissionDenied
 	}
@@ -241,7 +245,9 @@ func (e *Eval) Ack(args *structs.EvalAckRequest,
 	if done, err := e.srv.forward("Eval.Ack", args, args, reply); done {
 		return err
 	}
-	e.srv.MeasureRPCRate("eval", structs.RateMetricWrite, args)
+	if err := e.srv.MeasureRPCRate("eval", structs.RateMetricWrite, args); err != nil {
+		return err
+	}
 	if authErr != nil {
 		return structs.ErrPermissionDenied
 	}
@@ -276,7 +282,9 @@ func (e *Eval) Nack(args *structs.EvalAckRequest,
 	if done, err := e.srv.forward("Eval.Nack", args, args, reply); done {
 		return err
 	}
-	e.srv.MeasureRPCRate("eval", structs.RateMetricWrite, args)
+	if err := e.srv.MeasureRPCRate("eval", structs.RateMetricWrite, args); err != nil {
+		return err
+	}
 	if authErr != nil {
 		return structs.ErrPermissionDenied
 	}
@@ -303,7 +311,9 @@ func (e *Eval) Update(args *structs.EvalUpdateRequest,
 	if done, err := e.srv.forward("Eval.Update", args, args, reply); done {
 		return err
 	}
-	e.srv.MeasureRPCRate("eval", structs.RateMetricWrite, args)
+	if err := e.srv.MeasureRPCRate("eval", structs.RateMetricWrite, args); err != nil {
+		return err
+	}
 	if authErr != nil {
 		return structs.ErrPermissionDenied
 	}
@@ -345,7 +355,9 @@ func (e *Eval) Create(args *structs.EvalUpdateRequest,
 	if done, err := e.srv.forward("Eval.Create", args, args, reply); done {
 		return err
 	}
-	e.srv.MeasureRPCRate("eval", structs.RateMetricWrite, args)
+	if err := e.srv.MeasureRPCRate("eval", structs.RateMetricWrite, args); err != nil {
+		return err
+	}
 	if authErr != nil {
 		return structs.ErrPermissionDenied
 	}
@@ -402,7 +414,9 @@ func (e *Eval) Reblock(args *structs.EvalUpdateRequest, reply *structs.GenericRe
 	if done, err := e.srv.forward("Eval.Reblock", args, args, reply); done {
 		return err
 	}
-	e.srv.MeasureRPCRate("eval", structs.RateMetricWrite, args)
+	if err := e.srv.MeasureRPCRate("eval", structs.RateMetricWrite, args); err != nil {
+		return err
+	}
 	if authErr != nil {
 		return structs.ErrPermissionDenied
 	}
@@ -456,7 +470,9 @@ func (e *Eval) Reap(args *structs.EvalReapRequest,
 	if done, err := e.srv.forward("Eval.Reap", args, args, reply); done {
 		return err
 	}
-	e.srv.MeasureRPCRate("eval", structs.RateMetricWrite, args)
+	if err := e.srv.MeasureRPCRate("eval", structs.RateMetricWrite, args); err != nil {
+		return err
+	}
 	if authErr != nil {
 		return structs.ErrPermissionDenied
 	}
@@ -484,7 +500,9 @@ func (e *Eval) Delete(
 	if done, err := e.srv.forward(structs.EvalDeleteRPCMethod, args, args, reply); done {
 		return err
 	}
-	e.srv.MeasureRPCRate("eval", structs.RateMetricWrite, args)
+	if err := e.srv.MeasureRPCRate("eval", structs.RateMetricWrite, args); err != nil {
+		return err
+	}
 	if authErr != nil {
 		return structs.ErrPermissionDenied
 	}
@@ -676,7 +694,9 @@ func (e *Eval) List(args *structs.EvalListRequest, reply *structs.EvalListRespon
 	if done, err := e.srv.forward("Eval.List", args, args, reply); done {
 		return err
 	}
-	e.srv.MeasureRPCRate("eval", structs.RateMetricList, args)
+	if err := e.srv.MeasureRPCRate("eval", structs.RateMetricList, args); err != nil {
+		return err
+	}
 	if authErr != nil {
 		return structs.ErrPermissionDenied
 	}
@@ -801,7 +821,9 @@ func (e *Eval) Count(args *structs.EvalCountRequest, reply *structs.EvalCountRes
 	if done, err := e.srv.forward("Eval.Count", args, args, reply); done {
 		return err
 	}
-	e.srv.MeasureRPCRate("eval", structs.RateMetricList, args)
+	if err := e.srv.MeasureRPCRate("eval", structs.RateMetricList, args); err != nil {
+		return err
+	}
 	if authErr != nil {
 		return structs.ErrPermissionDenied
 	}
@@ -906,7 +928,9 @@ func (e *Eval) Allocations(args *structs.EvalSpecificRequest,
 	if done, err := e.srv.forward("Eval.Allocations", args, args, reply); done {
 		return err
 	}
-	e.srv.MeasureRPCRate("eval", structs.RateMetricList, args)
+	if err := e.srv.MeasureRPCRate("eval", structs.RateMetricList, args); err != nil {
+		return err
+	}
 	if authErr != nil {
 		return structs.ErrPermissionDenied
 	}
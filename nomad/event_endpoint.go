@@ -2,6 +2,7 @@ package nomad
 
 import (
 	"context"
+	"errors"
 	"io"
 	"time"
 
@@ -47,7 +48,10 @@ func (e *Event) stream(conn io.ReadWriteCloser) {
 		return
 	}
 
-	e.srv.MeasureRPCRate("event", structs.RateMetricRead, &args)
+	if err := e.srv.MeasureRPCRate("event", structs.RateMetricRead, &args); err != nil {
+		handleJsonResultError(err, pointer.Of(int64(429)), encoder)
+		return
+	}
 	if authErr != nil {
 		handleJsonResultError(structs.ErrPermissionDenied, pointer.Of(int64(403)), encoder)
 	}
@@ -58,6 +62,7 @@ func (e *Event) stream(conn io.ReadWriteCloser) {
 		Topics:    args.Topics,
 		Index:     uint64(args.Index),
 		Namespace: args.Namespace,
+		Filter:    args.Filter,
 	}
 
 	// Get the servers broker and subscribe
@@ -80,7 +85,10 @@ func (e *Event) stream(conn io.ReadWriteCloser) {
 	} else {
 		subscription, subErr = publisher.Subscribe(subReq)
 	}
-	if subErr != nil {
+	if errors.Is(subErr, stream.ErrInvalidFilter) {
+		handleJsonResultError(subErr, pointer.Of(int64(400)), encoder)
+		return
+	} else if subErr != nil {
 		handleJsonResultError(subErr, pointer.Of(int64(500)), encoder)
 		return
 	}
@@ -0,0 +1,265 @@
+package nomad
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/armon/go-metrics"
+	"github.com/hashicorp/go-memdb"
+	"github.com/hashicorp/go-multierror"
+
+	"github.com/hashicorp/nomad/nomad/state"
+	"github.com/hashicorp/nomad/nomad/structs"
+)
+
+// EventSink endpoint is used for managing the event sinks the leader
+// delivers events to. All methods require a management token.
+type EventSink struct {
+	srv *Server
+	ctx *RPCContext
+}
+
+func NewEventSinkEndpoint(srv *Server, ctx *RPCContext) *EventSink {
+	return &EventSink{srv: srv, ctx: ctx}
+}
+
+// Upsert is used to register or update event sinks.
+func (e *EventSink) Upsert(args *structs.EventSinkUpsertRequest, reply *structs.GenericResponse) error {
+
+	authErr := e.srv.Authenticate(e.ctx, args)
+	if done, err := e.srv.forward("EventSink.Upsert", args, args, reply); done {
+		return err
+	}
+	if err := e.srv.MeasureRPCRate("event_sink", structs.RateMetricWrite, args); err != nil {
+		return err
+	}
+	if authErr != nil {
+		return structs.ErrPermissionDenied
+	}
+	defer metrics.MeasureSince([]string{"nomad", "event_sink", "upsert"}, time.Now())
+
+	// Event sinks can only be used once all servers in the region have been
+	// upgraded to a version which knows about them.
+	if !ServersMeetMinimumVersion(e.srv.Members(), e.srv.Region(), minEventSinkVersion, false) {
+		return fmt.Errorf("all servers should be running version %v or later to use event sinks",
+			minEventSinkVersion)
+	}
+
+	if err := e.checkManagement(args); err != nil {
+		return err
+	}
+
+	if len(args.Sinks) == 0 {
+		return errors.New("must specify at least one event sink")
+	}
+
+	var mErr multierror.Error
+	for _, sink := range args.Sinks {
+		sink.Canonicalize()
+		if err := sink.Validate(); err != nil {
+			_ = multierror.Append(&mErr, fmt.Errorf("invalid event sink %q: %v", sink.ID, err))
+		}
+	}
+	if err := mErr.ErrorOrNil(); err != nil {
+		return err
+	}
+
+	_, index, err := e.srv.raftApply(structs.EventSinkRegisterRequestType, args)
+	if err != nil {
+		return err
+	}
+
+	reply.Index = index
+	return nil
+}
+
+// Delete is used to deregister event sinks.
+func (e *EventSink) Delete(args *structs.EventSinkDeleteRequest, reply *structs.GenericResponse) error {
+
+	authErr := e.srv.Authenticate(e.ctx, args)
+	if done, err := e.srv.forward("EventSink.Delete", args, args, reply); done {
+		return err
+	}
+	if err := e.srv.MeasureRPCRate("event_sink", structs.RateMetricWrite, args); err != nil {
+		return err
+	}
+	if authErr != nil {
+		return structs.ErrPermissionDenied
+	}
+	defer metrics.MeasureSince([]string{"nomad", "event_sink", "delete"}, time.Now())
+
+	// Event sinks can only be used once all servers in the region have been
+	// upgraded to a version which knows about them.
+	if !ServersMeetMinimumVersion(e.srv.Members(), e.srv.Region(), minEventSinkVersion, false) {
+		return fmt.Errorf("all servers should be running version %v or later to use event sinks",
+			minEventSinkVersion)
+	}
+
+	if err := e.checkManagement(args); err != nil {
+		return err
+	}
+
+	if len(args.IDs) == 0 {
+		return errors.New("must specify at least one event sink to delete")
+	}
+
+	_, index, err := e.srv.raftApply(structs.EventSinkDeregisterRequestType, args)
+	if err != nil {
+		return err
+	}
+
+	reply.Index = index
+	return nil
+}
+
+// Get is used to return a single event sink.
+func (e *EventSink) Get(args *structs.EventSinkSpecificRequest, reply *structs.EventSinkResponse) error {
+
+	authErr := e.srv.Authenticate(e.ctx, args)
+	if done, err := e.srv.forward("EventSink.Get", args, args, reply); done {
+		return err
+	}
+	if err := e.srv.MeasureRPCRate("event_sink", structs.RateMetricRead, args); err != nil {
+		return err
+	}
+	if authErr != nil {
+		return structs.ErrPermissionDenied
+	}
+	defer metrics.MeasureSince([]string{"nomad", "event_sink", "get"}, time.Now())
+
+	if err := e.checkManagement(args); err != nil {
+		return err
+	}
+
+	opts := blockingOptions{
+		queryOpts: &args.QueryOptions,
+		queryMeta: &reply.QueryMeta,
+		run: func(ws memdb.WatchSet, s *state.StateStore) error {
+			out, err := s.EventSinkByID(ws, args.ID)
+			if err != nil {
+				return err
+			}
+
+			reply.Sink = out
+			if out != nil {
+				reply.Index = out.ModifyIndex
+				return nil
+			}
+			return e.srv.setReplyQueryMeta(s, state.TableEventSinks, &reply.QueryMeta)
+		}}
+	return e.srv.blockingRPC(&opts)
+}
+
+// List is used to list the event sinks.
+func (e *EventSink) List(args *structs.EventSinkListRequest, reply *structs.EventSinkListResponse) error {
+
+	authErr := e.srv.Authenticate(e.ctx, args)
+	if done, err := e.srv.forward("EventSink.List", args, args, reply); done {
+		return err
+	}
+	if err := e.srv.MeasureRPCRate("event_sink", structs.RateMetricList, args); err != nil {
+		return err
+	}
+	if authErr != nil {
+		return structs.ErrPermissionDenied
+	}
+	defer metrics.MeasureSince([]string{"nomad", "event_sink", "list"}, time.Now())
+
+	if err := e.checkManagement(args); err != nil {
+		return err
+	}
+
+	opts := blockingOptions{
+		queryOpts: &args.QueryOptions,
+		queryMeta: &reply.QueryMeta,
+		run: func(ws memdb.WatchSet, s *state.StateStore) error {
+			iter, err := s.EventSinks(ws)
+			if err != nil {
+				return err
+			}
+
+			sinks := []*structs.EventSink{}
+			for raw := iter.Next(); raw != nil; raw = iter.Next() {
+				sinks = append(sinks, raw.(*structs.EventSink))
+			}
+			reply.Sinks = sinks
+
+			return e.srv.setReplyQueryMeta(s, state.TableEventSinks, &reply.QueryMeta)
+		}}
+	return e.srv.blockingRPC(&opts)
+}
+
+// Status is used to return the delivery status of event sinks. The status is
+// tracked in memory by the leader, so the request is always forwarded to it.
+// No status is returned if the requested sink doesn't exist.
+func (e *EventSink) Status(args *structs.EventSinkStatusRequest, reply *structs.EventSinkStatusResponse) error {
+
+	authErr := e.srv.Authenticate(e.ctx, args)
+	args.AllowStale = false
+	if done, err := e.srv.forward("EventSink.Status", args, args, reply); done {
+		return err
+	}
+	if err := e.srv.MeasureRPCRate("event_sink", structs.RateMetricRead, args); err != nil {
+		return err
+	}
+	if authErr != nil {
+		return structs.ErrPermissionDenied
+	}
+	defer metrics.MeasureSince([]string{"nomad", "event_sink", "status"}, time.Now())
+
+	if err := e.checkManagement(args); err != nil {
+		return err
+	}
+
+	snap, err := e.srv.State().Snapshot()
+	if err != nil {
+		return err
+	}
+
+	var sinks []*structs.EventSink
+	if args.ID != "" {
+		sink, err := snap.EventSinkByID(nil, args.ID)
+		if err != nil {
+			return err
+		}
+		if sink != nil {
+			sinks = append(sinks, sink)
+		}
+	} else {
+		iter, err := snap.EventSinks(nil)
+		if err != nil {
+			return err
+		}
+		for raw := iter.Next(); raw != nil; raw = iter.Next() {
+			sinks = append(sinks, raw.(*structs.EventSink))
+		}
+	}
+
+	// The lag of the sinks is measured against the latest events published
+	// by the leader. Without an event broker no events are delivered.
+	var latestIndex uint64
+	if broker, err := e.srv.State().EventBroker(); err == nil {
+		latestIndex = broker.LatestIndex()
+	}
+
+	reply.Statuses = make([]*structs.EventSinkStatus, 0, len(sinks))
+	for _, sink := range sinks {
+		reply.Statuses = append(reply.Statuses, e.srv.eventSinkManager.status(sink, latestIndex))
+	}
+
+	return e.srv.setReplyQueryMeta(e.srv.State(), state.TableEventSinks, &reply.QueryMeta)
+}
+
+// checkManagement returns an error unless the request's token is a
+// management token.
+func (e *EventSink) checkManagement(args structs.RequestWithIdentity) error {
+	aclObj, err := e.srv.ResolveACL(args)
+	if err != nil {
+		return err
+	}
+	if aclObj != nil && !aclObj.IsManagement() {
+		return structs.ErrPermissionDenied
+	}
+	return nil
+}
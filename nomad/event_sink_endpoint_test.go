@@ -0,0 +1,148 @@
+package nomad
+
+import (
+	"testing"
+
+	msgpackrpc "github.com/hashicorp/net-rpc-msgpackrpc"
+	"github.com/hashicorp/nomad/ci"
+	"github.com/hashicorp/nomad/nomad/mock"
+	"github.com/hashicorp/nomad/nomad/structs"
+	"github.com/hashicorp/nomad/testutil"
+	"github.com/shoenig/test/must"
+)
+
+func TestEventSinkEndpoint_CRUD(t *testing.T) {
+	ci.Parallel(t)
+
+	s1, cleanupS1 := TestServer(t, nil)
+	defer cleanupS1()
+	codec := rpcClient(t, s1)
+	testutil.WaitForLeader(t, s1.RPC)
+
+	// Invalid sinks are rejected
+	invalid := mock.EventSink()
+	invalid.Address = "ftp://example.com"
+	upsertReq := &structs.EventSinkUpsertRequest{
+		Sinks:        []*structs.EventSink{invalid},
+		WriteRequest: structs.WriteRequest{Region: "global"},
+	}
+	var upsertResp structs.GenericResponse
+	err := msgpackrpc.CallWithCodec(codec, "EventSink.Upsert", upsertReq, &upsertResp)
+	must.ErrorContains(t, err, "scheme must be http or https")
+
+	// Sinks are canonicalized
+	sink := &structs.EventSink{ID: "my-sink", Address: "http://127.0.0.1:8080"}
+	upsertReq.Sinks = []*structs.EventSink{sink}
+	must.NoError(t, msgpackrpc.CallWithCodec(codec, "EventSink.Upsert", upsertReq, &upsertResp))
+	must.NonZero(t, upsertResp.Index)
+
+	getReq := &structs.EventSinkSpecificRequest{
+		ID:           sink.ID,
+		QueryOptions: structs.QueryOptions{Region: "global"},
+	}
+	var getResp structs.EventSinkResponse
+	must.NoError(t, msgpackrpc.CallWithCodec(codec, "EventSink.Get", getReq, &getResp))
+	must.NotNil(t, getResp.Sink)
+	must.Eq(t, structs.EventSinkWebhook, getResp.Sink.Type)
+	must.Eq(t, structs.EventSinkDefaultBatchSize, getResp.Sink.BatchSize)
+	must.Eq(t, upsertResp.Index, getResp.Index)
+
+	listReq := &structs.EventSinkListRequest{
+		QueryOptions: structs.QueryOptions{Region: "global"},
+	}
+	var listResp structs.EventSinkListResponse
+	must.NoError(t, msgpackrpc.CallWithCodec(codec, "EventSink.List", listReq, &listResp))
+	must.Len(t, 1, listResp.Sinks)
+
+	statusReq := &structs.EventSinkStatusRequest{
+		ID:           sink.ID,
+		QueryOptions: structs.QueryOptions{Region: "global"},
+	}
+	var statusResp structs.EventSinkStatusResponse
+	must.NoError(t, msgpackrpc.CallWithCodec(codec, "EventSink.Status", statusReq, &statusResp))
+	must.Len(t, 1, statusResp.Statuses)
+	must.Eq(t, sink.ID, statusResp.Statuses[0].ID)
+
+	// Unknown sinks have no status
+	statusReq.ID = "unknown"
+	must.NoError(t, msgpackrpc.CallWithCodec(codec, "EventSink.Status", statusReq, &statusResp))
+	must.Len(t, 0, statusResp.Statuses)
+
+	deleteReq := &structs.EventSinkDeleteRequest{
+		IDs:          []string{sink.ID},
+		WriteRequest: structs.WriteRequest{Region: "global"},
+	}
+	var deleteResp structs.GenericResponse
+	must.NoError(t, msgpackrpc.CallWithCodec(codec, "EventSink.Delete", deleteReq, &deleteResp))
+
+	must.NoError(t, msgpackrpc.CallWithCodec(codec, "EventSink.Get", getReq, &getResp))
+	must.Nil(t, getResp.Sink)
+}
+
+func TestEventSinkEndpoint_ACL(t *testing.T) {
+	ci.Parallel(t)
+
+	s1, root, cleanupS1 := TestACLServer(t, nil)
+	defer cleanupS1()
+	codec := rpcClient(t, s1)
+	testutil.WaitForLeader(t, s1.RPC)
+
+	token := mock.CreatePolicyAndToken(t, s1.State(), 1001, "read-all",
+		mock.NamespacePolicy("*", "read", nil)+mock.NodePolicy("read")+mock.AgentPolicy("read"))
+
+	sink := mock.EventSink()
+	upsertReq := &structs.EventSinkUpsertRequest{
+		Sinks:        []*structs.EventSink{sink},
+		WriteRequest: structs.WriteRequest{Region: "global", AuthToken: token.SecretID},
+	}
+	var upsertResp structs.GenericResponse
+	err := msgpackrpc.CallWithCodec(codec, "EventSink.Upsert", upsertReq, &upsertResp)
+	must.EqError(t, err, structs.ErrPermissionDenied.Error())
+
+	upsertReq.AuthToken = root.SecretID
+	must.NoError(t, msgpackrpc.CallWithCodec(codec, "EventSink.Upsert", upsertReq, &upsertResp))
+
+	listReq := &structs.EventSinkListRequest{
+		QueryOptions: structs.QueryOptions{Region: "global", AuthToken: token.SecretID},
+	}
+	var listResp structs.EventSinkListResponse
+	err = msgpackrpc.CallWithCodec(codec, "EventSink.List", listReq, &listResp)
+	must.EqError(t, err, structs.ErrPermissionDenied.Error())
+
+	listReq.AuthToken = root.SecretID
+	must.NoError(t, msgpackrpc.CallWithCodec(codec, "EventSink.List", listReq, &listResp))
+	must.Len(t, 1, listResp.Sinks)
+
+	statusReq := &structs.EventSinkStatusRequest{
+		QueryOptions: structs.QueryOptions{Region: "global", AuthToken: token.SecretID},
+	}
+	var statusResp structs.EventSinkStatusResponse
+	err = msgpackrpc.CallWithCodec(codec, "EventSink.Status", statusReq, &statusResp)
+	must.EqError(t, err, structs.ErrPermissionDenied.Error())
+}
+
+func TestEventSinkEndpoint_MinimumVersion(t *testing.T) {
+	ci.Parallel(t)
+
+	s1, cleanupS1 := TestServer(t, func(c *Config) {
+		c.Build = "1.5.2"
+	})
+	defer cleanupS1()
+	codec := rpcClient(t, s1)
+	testutil.WaitForLeader(t, s1.RPC)
+
+	upsertReq := &structs.EventSinkUpsertRequest{
+		Sinks:        []*structs.EventSink{mock.EventSink()},
+		WriteRequest: structs.WriteRequest{Region: "global"},
+	}
+	var upsertResp structs.GenericResponse
+	err := msgpackrpc.CallWithCodec(codec, "EventSink.Upsert", upsertReq, &upsertResp)
+	must.ErrorContains(t, err, "all servers should be running version")
+
+	deleteReq := &structs.EventSinkDeleteRequest{
+		IDs:          []string{upsertReq.Sinks[0].ID},
+		WriteRequest: structs.WriteRequest{Region: "global"},
+	}
+	err = msgpackrpc.CallWithCodec(codec, "EventSink.Delete", deleteReq, &upsertResp)
+	must.ErrorContains(t, err, "all servers should be running version")
+}
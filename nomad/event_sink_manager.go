@@ -0,0 +1,551 @@
+package nomad
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/hashicorp/go-cleanhttp"
+	log "github.com/hashicorp/go-hclog"
+	"github.com/hashicorp/go-memdb"
+	"github.com/hashicorp/go-msgpack/codec"
+	"github.com/hashicorp/nomad/helper"
+	"github.com/hashicorp/nomad/nomad/state"
+	"github.com/hashicorp/nomad/nomad/stream"
+	"github.com/hashicorp/nomad/nomad/structs"
+)
+
+const (
+	// eventSinkProgressInterval is how often the leader persists the latest
+	// index acknowledged by event sinks. Events delivered since the last
+	// persisted index are delivered again after a leader election.
+	eventSinkProgressInterval = 10 * time.Second
+
+	// eventSinkRequestTimeout is the timeout of requests to webhook sinks
+	eventSinkRequestTimeout = 10 * time.Second
+
+	// eventSinkMinBackoff and eventSinkMaxBackoff bound the time waited
+	// between failed delivery attempts.
+	eventSinkMinBackoff = time.Second
+	eventSinkMaxBackoff = time.Minute
+)
+
+// eventSinkManager runs on the leader and delivers events from the event
+// broker to the event sinks registered in state, retrying failed deliveries
+// until they succeed.
+type eventSinkManager struct {
+	logger log.Logger
+
+	// raftApplyFn is used to persist the progress of the sinks
+	raftApplyFn func(structs.MessageType, any) (any, uint64, error)
+
+	client *http.Client
+
+	// progressInterval and minBackoff can be lowered for testing
+	progressInterval time.Duration
+	minBackoff       time.Duration
+
+	enabled bool
+	state   *state.StateStore
+
+	// workers is the set of running workers, one per sink
+	workers map[string]*eventSinkWorker
+
+	// ctx and exitFn are used to stop the manager when leadership is lost
+	ctx    context.Context
+	exitFn context.CancelFunc
+
+	l sync.Mutex
+}
+
+// eventSinkWorker delivers events to a single sink.
+type eventSinkWorker struct {
+	sink  *structs.EventSink
+	state *state.StateStore
+
+	exitFn context.CancelFunc
+	doneCh chan struct{}
+
+	// l protects the fields below
+	l sync.Mutex
+
+	// acked is the index of the latest event acknowledged by the sink, and
+	// persisted the latest of those written to state.
+	acked     uint64
+	persisted uint64
+
+	healthy     bool
+	lastError   string
+	failures    int
+	lastSuccess time.Time
+	lastFailure time.Time
+
+	// lagging is set once events for the sink were dropped from the event
+	// buffer before they could be delivered, and missedError describes them.
+	lagging     bool
+	missedError string
+}
+
+func newEventSinkManager(logger log.Logger, raftApplyFn func(structs.MessageType, any) (any, uint64, error)) *eventSinkManager {
+	return &eventSinkManager{
+		logger:           logger.Named("event_sinks"),
+		raftApplyFn:      raftApplyFn,
+		client:           &http.Client{Transport: cleanhttp.DefaultPooledTransport(), Timeout: eventSinkRequestTimeout},
+		progressInterval: eventSinkProgressInterval,
+		minBackoff:       eventSinkMinBackoff,
+		workers:          make(map[string]*eventSinkWorker),
+	}
+}
+
+// SetEnabled is used to control if the manager is enabled. The manager
+// should only be enabled on the active leader.
+func (m *eventSinkManager) SetEnabled(enabled bool, state *state.StateStore) {
+	m.l.Lock()
+	defer m.l.Unlock()
+
+	wasEnabled := m.enabled
+	m.enabled = enabled
+	if state != nil {
+		m.state = state
+	}
+
+	if wasEnabled {
+		m.exitFn()
+		for id, w := range m.workers {
+			w.stop()
+			delete(m.workers, id)
+		}
+	}
+
+	if enabled {
+		m.ctx, m.exitFn = context.WithCancel(context.Background())
+		go m.watchSinks(m.ctx, m.state)
+		go m.persistProgressLoop(m.ctx)
+	}
+}
+
+// watchSinks starts and stops workers as sinks are registered, updated and
+// deregistered.
+func (m *eventSinkManager) watchSinks(ctx context.Context, store *state.StateStore) {
+	minIndex := uint64(1)
+	for {
+		raw, index, err := store.BlockingQuery(getEventSinks, minIndex, ctx)
+		if err != nil {
+			if ctx.Err() != nil {
+				return
+			}
+			m.logger.Error("failed to retrieve event sinks", "error", err)
+			select {
+			case <-ctx.Done():
+				return
+			case <-time.After(m.minBackoff):
+			}
+			continue
+		}
+
+		minIndex = index
+		m.reconcile(ctx, store, raw.([]*structs.EventSink))
+	}
+}
+
+// getEventSinks returns all event sinks, for use in blocking queries.
+func getEventSinks(ws memdb.WatchSet, store *state.StateStore) (any, uint64, error) {
+	iter, err := store.EventSinks(ws)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	var sinks []*structs.EventSink
+	for raw := iter.Next(); raw != nil; raw = iter.Next() {
+		sinks = append(sinks, raw.(*structs.EventSink))
+	}
+
+	index, err := store.Index(state.TableEventSinks)
+	if err != nil {
+		return nil, 0, err
+	}
+	return sinks, helper.Max(index, 1), nil
+}
+
+// reconcile starts a worker for each new or updated sink and stops the
+// workers of deregistered sinks.
+func (m *eventSinkManager) reconcile(ctx context.Context, store *state.StateStore, sinks []*structs.EventSink) {
+	m.l.Lock()
+	defer m.l.Unlock()
+
+	if !m.enabled || ctx.Err() != nil {
+		return
+	}
+
+	seen := make(map[string]struct{}, len(sinks))
+	for _, sink := range sinks {
+		seen[sink.ID] = struct{}{}
+
+		acked, persisted := sink.LatestIndex, sink.LatestIndex
+		if w, ok := m.workers[sink.ID]; ok {
+			if w.sink.ModifyIndex == sink.ModifyIndex {
+				continue
+			}
+
+			// The sink was updated, so restart its worker without losing
+			// the progress it made since it was last persisted.
+			w.stop()
+			workerAcked, workerPersisted := w.progress()
+			acked = helper.Max(acked, workerAcked)
+			persisted = helper.Max(persisted, workerPersisted)
+		}
+
+		m.workers[sink.ID] = m.startWorker(ctx, store, sink, acked, persisted)
+	}
+
+	for id, w := range m.workers {
+		if _, ok := seen[id]; !ok {
+			w.stop()
+			delete(m.workers, id)
+		}
+	}
+}
+
+// startWorker starts delivering events after the acknowledged index to the
+// sink. Sinks are healthy until a delivery attempt fails.
+func (m *eventSinkManager) startWorker(ctx context.Context, store *state.StateStore, sink *structs.EventSink, acked, persisted uint64) *eventSinkWorker {
+	ctx, cancel := context.WithCancel(ctx)
+	w := &eventSinkWorker{
+		sink:      sink,
+		state:     store,
+		exitFn:    cancel,
+		doneCh:    make(chan struct{}),
+		acked:     acked,
+		persisted: persisted,
+		healthy:   true,
+	}
+	go m.run(ctx, w)
+	return w
+}
+
+// run delivers events to the sink of the worker until the context is
+// canceled, resubscribing to the event broker if the subscription fails.
+func (m *eventSinkManager) run(ctx context.Context, w *eventSinkWorker) {
+	defer close(w.doneCh)
+
+	logger := m.logger.With("sink", w.sink.ID)
+	backoff := m.minBackoff
+	for {
+		err := m.deliver(ctx, w)
+		if ctx.Err() != nil {
+			return
+		}
+
+		logger.Warn("event sink subscription failed", "error", err, "retry", backoff)
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(backoff):
+		}
+		backoff = helper.Min(backoff*2, eventSinkMaxBackoff)
+	}
+}
+
+// deliver subscribes to the events after the latest index acknowledged by
+// the sink and sends them in batches. Events acknowledged by the sink are
+// skipped, as subscriptions may start before the requested index. If the
+// events after the acknowledged index are no longer in the event buffer, the
+// sink is marked as lagging and delivery resumes from the oldest buffered
+// event.
+func (m *eventSinkManager) deliver(ctx context.Context, w *eventSinkWorker) error {
+	broker, err := w.state.EventBroker()
+	if err != nil {
+		return err
+	}
+
+	acked, _ := w.progress()
+	sub, err := broker.Subscribe(&stream.SubscribeRequest{
+		Index:     acked + 1,
+		Namespace: w.sink.Namespace,
+		Topics:    w.sink.Topics,
+		Filter:    w.sink.Filter,
+	})
+	if err != nil {
+		return err
+	}
+	defer sub.Unsubscribe()
+
+	// New sinks start at the oldest buffered event, so they can't miss any
+	if acked > 0 && sub.Truncated() {
+		m.logger.Error("events for sink were dropped from the event buffer before they were delivered",
+			"sink", w.sink.ID, "index", acked)
+		w.recordMissed(acked)
+	}
+
+	batchSize := w.sink.BatchSize
+	var pending []structs.Event
+	for {
+		// Collect the events that are available without blocking. The
+		// latest index is read first, so that once no events are
+		// pending every event up to it was either delivered or didn't
+		// match the sink.
+		latest := broker.LatestIndex()
+		for len(pending) < batchSize {
+			events, err := sub.NextNoBlock()
+			if err != nil {
+				return err
+			}
+			if events == nil {
+				break
+			}
+			pending = appendUnacked(pending, events, acked)
+		}
+
+		if len(pending) == 0 {
+			acked = helper.Max(acked, latest)
+			w.acknowledge(acked)
+			events, err := sub.Next(ctx)
+			if err != nil {
+				return err
+			}
+			pending = appendUnacked(pending, events.Events, acked)
+			continue
+		}
+
+		n := helper.Min(len(pending), batchSize)
+		batch := pending[:n]
+		if err := m.send(ctx, w, batch); err != nil {
+			return err
+		}
+
+		// Only acknowledge an index once all of its events were delivered,
+		// as a batch may end in the middle of the events of one index.
+		index := batch[n-1].Index
+		if n < len(pending) && pending[n].Index == index {
+			index--
+		}
+		acked = helper.Max(acked, index)
+		w.acknowledge(acked)
+		pending = pending[n:]
+	}
+}
+
+// appendUnacked appends the events with an index later than acked.
+func appendUnacked(pending, events []structs.Event, acked uint64) []structs.Event {
+	for _, event := range events {
+		if event.Index > acked {
+			pending = append(pending, event)
+		}
+	}
+	return pending
+}
+
+// send delivers a batch of events to the sink, retrying with an exponential
+// backoff until it succeeds or the context is canceled.
+func (m *eventSinkManager) send(ctx context.Context, w *eventSinkWorker, batch []structs.Event) error {
+	var buf bytes.Buffer
+	payload := &structs.EventSinkPayload{
+		Sink:   w.sink.ID,
+		Index:  batch[len(batch)-1].Index,
+		Events: batch,
+	}
+	if err := codec.NewEncoder(&buf, structs.JsonHandleWithExtensions).Encode(payload); err != nil {
+		return fmt.Errorf("failed to encode events: %v", err)
+	}
+
+	backoff := m.minBackoff
+	for {
+		err := m.post(ctx, w.sink, payload.Index, buf.Bytes())
+		w.recordAttempt(err)
+		if err == nil {
+			return nil
+		}
+		if ctx.Err() != nil {
+			return ctx.Err()
+		}
+
+		m.logger.Warn("failed to deliver events to sink",
+			"sink", w.sink.ID, "index", payload.Index, "error", err, "retry", backoff)
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(backoff):
+		}
+		backoff = helper.Min(backoff*2, eventSinkMaxBackoff)
+	}
+}
+
+// post sends an encoded batch of events to a webhook sink. Any 2xx response
+// acknowledges the batch.
+func (m *eventSinkManager) post(ctx context.Context, sink *structs.EventSink, index uint64, body []byte) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, sink.Address, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("X-Nomad-Event-Sink", sink.ID)
+	req.Header.Set("X-Nomad-Index", strconv.FormatUint(index, 10))
+
+	resp, err := m.client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	_, _ = io.Copy(io.Discard, io.LimitReader(resp.Body, 64*1024))
+
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		return fmt.Errorf("unexpected response code %d", resp.StatusCode)
+	}
+	return nil
+}
+
+// persistProgressLoop periodically persists the progress of the sinks.
+func (m *eventSinkManager) persistProgressLoop(ctx context.Context) {
+	ticker := time.NewTicker(m.progressInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			if err := m.persistProgress(); err != nil {
+				m.logger.Error("failed to persist event sink progress", "error", err)
+			}
+		}
+	}
+}
+
+// persistProgress writes the latest index acknowledged by each sink to
+// state, if it changed since it was last persisted.
+func (m *eventSinkManager) persistProgress() error {
+	m.l.Lock()
+	workers := make(map[string]*eventSinkWorker, len(m.workers))
+	for id, w := range m.workers {
+		workers[id] = w
+	}
+	m.l.Unlock()
+
+	progress := make(map[string]uint64)
+	for id, w := range workers {
+		if acked, persisted := w.progress(); acked > persisted {
+			progress[id] = acked
+		}
+	}
+	if len(progress) == 0 {
+		return nil
+	}
+
+	// Progress is applied on the leader's own schedule, so servers which
+	// don't know the message type yet must ignore it rather than fail.
+	req := &structs.EventSinkProgressRequest{Progress: progress}
+	if _, _, err := m.raftApplyFn(
+		structs.EventSinkProgressRequestType|structs.IgnoreUnknownTypeFlag, req); err != nil {
+		return err
+	}
+
+	for id, index := range progress {
+		workers[id].persist(index)
+	}
+	return nil
+}
+
+// status returns the delivery status of the sink. Sinks without a running
+// worker report the progress stored in state.
+func (m *eventSinkManager) status(sink *structs.EventSink, latestIndex uint64) *structs.EventSinkStatus {
+	m.l.Lock()
+	w, ok := m.workers[sink.ID]
+	m.l.Unlock()
+
+	status := &structs.EventSinkStatus{
+		ID:          sink.ID,
+		LatestIndex: sink.LatestIndex,
+	}
+	if ok {
+		w.l.Lock()
+		status.Running = true
+		status.Healthy = w.healthy && !w.lagging
+		status.Lagging = w.lagging
+		status.LatestIndex = w.acked
+		status.LastError = w.lastError
+		if status.LastError == "" {
+			status.LastError = w.missedError
+		}
+		status.Failures = w.failures
+		status.LastSuccess = w.lastSuccess
+		status.LastFailure = w.lastFailure
+		w.l.Unlock()
+	}
+	if latestIndex > status.LatestIndex {
+		status.Lag = latestIndex - status.LatestIndex
+	}
+	return status
+}
+
+// stop stops the worker and waits for it to exit.
+func (w *eventSinkWorker) stop() {
+	w.exitFn()
+	<-w.doneCh
+}
+
+// progress returns the acknowledged and persisted indexes of the sink.
+func (w *eventSinkWorker) progress() (uint64, uint64) {
+	w.l.Lock()
+	defer w.l.Unlock()
+	return w.acked, w.persisted
+}
+
+// acknowledge records that all matching events up to the index were
+// delivered to the sink.
+func (w *eventSinkWorker) acknowledge(index uint64) {
+	w.l.Lock()
+	defer w.l.Unlock()
+	if index > w.acked {
+		w.acked = index
+	}
+}
+
+// persist records that the progress up to the index was written to state.
+func (w *eventSinkWorker) persist(index uint64) {
+	w.l.Lock()
+	defer w.l.Unlock()
+	if index > w.persisted {
+		w.persisted = index
+	}
+}
+
+// recordAttempt updates the health of the sink after a delivery attempt.
+// Attempts interrupted by the worker stopping aren't recorded.
+func (w *eventSinkWorker) recordAttempt(err error) {
+	if errors.Is(err, context.Canceled) {
+		return
+	}
+
+	w.l.Lock()
+	defer w.l.Unlock()
+
+	now := time.Now().UTC()
+	if err == nil {
+		w.healthy = true
+		w.lastError = ""
+		w.failures = 0
+		w.lastSuccess = now
+		return
+	}
+	w.healthy = false
+	w.lastError = err.Error()
+	w.failures++
+	w.lastFailure = now
+}
+
+// recordMissed marks the sink as lagging because the events after the index
+// were dropped from the event buffer before they were delivered. The sink
+// stays lagging until its worker is restarted.
+func (w *eventSinkWorker) recordMissed(index uint64) {
+	w.l.Lock()
+	defer w.l.Unlock()
+
+	w.lagging = true
+	w.missedError = fmt.Sprintf("events after index %d were dropped from the event buffer before they were delivered", index)
+}
@@ -0,0 +1,215 @@
+package nomad
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/nomad/ci"
+	"github.com/hashicorp/nomad/helper/testlog"
+	"github.com/hashicorp/nomad/nomad/mock"
+	"github.com/hashicorp/nomad/nomad/state"
+	"github.com/hashicorp/nomad/nomad/structs"
+	"github.com/hashicorp/nomad/testutil"
+	"github.com/shoenig/test/must"
+	"github.com/shoenig/test/wait"
+)
+
+// testEventSinkServer is a webhook event sink that fails the first requests
+// it receives.
+type testEventSinkServer struct {
+	*httptest.Server
+
+	l        sync.Mutex
+	failures int
+	payloads []*structs.EventSinkPayload
+}
+
+func newTestEventSinkServer(t *testing.T, failures int) *testEventSinkServer {
+	s := &testEventSinkServer{failures: failures}
+	s.Server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		s.l.Lock()
+		defer s.l.Unlock()
+
+		if s.failures > 0 {
+			s.failures--
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+
+		var payload structs.EventSinkPayload
+		if err := json.NewDecoder(r.Body).Decode(&payload); err != nil {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		s.payloads = append(s.payloads, &payload)
+	}))
+	t.Cleanup(s.Close)
+	return s
+}
+
+// events returns the events delivered to the sink.
+func (s *testEventSinkServer) events() []structs.Event {
+	s.l.Lock()
+	defer s.l.Unlock()
+
+	var events []structs.Event
+	for _, payload := range s.payloads {
+		events = append(events, payload.Events...)
+	}
+	return events
+}
+
+// testEventSinkManager returns an enabled event sink manager which persists
+// progress directly to the state store.
+func testEventSinkManager(t *testing.T, store *state.StateStore) *eventSinkManager {
+	var index uint64 = 1000
+	var l sync.Mutex
+	raftApplyFn := func(msgType structs.MessageType, msg any) (any, uint64, error) {
+		l.Lock()
+		defer l.Unlock()
+		if msgType != structs.EventSinkProgressRequestType|structs.IgnoreUnknownTypeFlag {
+			return nil, 0, fmt.Errorf("unexpected message type %d", msgType)
+		}
+		index++
+		req := msg.(*structs.EventSinkProgressRequest)
+		return nil, index, store.UpdateEventSinksProgress(index, req.Progress)
+	}
+
+	m := newEventSinkManager(testlog.HCLogger(t), raftApplyFn)
+	m.minBackoff = 10 * time.Millisecond
+	m.SetEnabled(true, store)
+	t.Cleanup(func() { m.SetEnabled(false, nil) })
+	return m
+}
+
+func TestEventSinkManager_Deliver(t *testing.T) {
+	ci.Parallel(t)
+
+	store := state.TestStateStoreCfg(t, state.TestStateStorePublisher(t))
+	m := testEventSinkManager(t, store)
+
+	// The sink fails twice before accepting events
+	srv := newTestEventSinkServer(t, 2)
+	sink := mock.EventSink()
+	sink.Address = srv.URL
+	sink.Topics = map[structs.Topic][]string{structs.TopicNamespace: {"*"}}
+	sink.BatchSize = 1
+	must.NoError(t, store.UpsertEventSinks(10, []*structs.EventSink{sink}))
+
+	// Two events at the same index are delivered in separate batches
+	ns1, ns2 := mock.Namespace(), mock.Namespace()
+	must.NoError(t, store.UpsertNamespaces(20, []*structs.Namespace{ns1, ns2}))
+
+	must.Wait(t, wait.InitialSuccess(
+		wait.BoolFunc(func() bool { return len(srv.events()) == 2 }),
+		wait.Timeout(5*time.Second),
+		wait.Gap(10*time.Millisecond),
+	))
+	for _, event := range srv.events() {
+		must.Eq(t, structs.TopicNamespace, event.Topic)
+		must.Eq(t, 20, event.Index)
+	}
+
+	status := m.status(sink, 20)
+	must.True(t, status.Running)
+	must.True(t, status.Healthy)
+	must.Eq(t, 20, status.LatestIndex)
+	must.Eq(t, 0, status.Lag)
+	must.Eq(t, 0, status.Failures)
+	must.False(t, status.LastFailure.IsZero())
+
+	// Progress is persisted to state
+	must.NoError(t, m.persistProgress())
+	out, err := store.EventSinkByID(nil, sink.ID)
+	must.NoError(t, err)
+	must.Eq(t, 20, out.LatestIndex)
+}
+
+func TestEventSinkManager_Resume(t *testing.T) {
+	ci.Parallel(t)
+
+	store := state.TestStateStoreCfg(t, state.TestStateStorePublisher(t))
+
+	srv := newTestEventSinkServer(t, 0)
+	sink := mock.EventSink()
+	sink.Address = srv.URL
+	must.NoError(t, store.UpsertEventSinks(10, []*structs.EventSink{sink}))
+
+	// The events up to the index acknowledged by the sink under a previous
+	// leader aren't delivered again
+	ns1, ns2 := mock.Namespace(), mock.Namespace()
+	must.NoError(t, store.UpsertNamespaces(20, []*structs.Namespace{ns1}))
+	must.NoError(t, store.UpsertNamespaces(30, []*structs.Namespace{ns2}))
+	must.NoError(t, store.UpdateEventSinksProgress(40, map[string]uint64{sink.ID: 20}))
+
+	broker, err := store.EventBroker()
+	must.NoError(t, err)
+	testutil.WaitForResult(func() (bool, error) {
+		return broker.LatestIndex() == 30, nil
+	}, func(err error) {
+		t.Fatalf("events were not published")
+	})
+
+	m := testEventSinkManager(t, store)
+
+	must.Wait(t, wait.InitialSuccess(
+		wait.BoolFunc(func() bool { return len(srv.events()) == 1 }),
+		wait.Timeout(5*time.Second),
+		wait.Gap(10*time.Millisecond),
+	))
+	must.Eq(t, 30, srv.events()[0].Index)
+	must.False(t, m.status(sink, 30).Lagging)
+
+	// Deregistering the sink stops its worker
+	must.NoError(t, store.DeleteEventSinks(50, []string{sink.ID}))
+	must.Wait(t, wait.InitialSuccess(
+		wait.BoolFunc(func() bool { return !m.status(sink, 0).Running }),
+		wait.Timeout(5*time.Second),
+		wait.Gap(10*time.Millisecond),
+	))
+}
+
+func TestEventSinkManager_Lagging(t *testing.T) {
+	ci.Parallel(t)
+
+	store := state.TestStateStoreCfg(t, state.TestStateStorePublisher(t))
+
+	srv := newTestEventSinkServer(t, 0)
+	sink := mock.EventSink()
+	sink.Address = srv.URL
+	must.NoError(t, store.UpsertEventSinks(10, []*structs.EventSink{sink}))
+
+	// The sink acknowledged an index older than every buffered event, so the
+	// events in between were missed
+	must.NoError(t, store.UpsertNamespaces(20, []*structs.Namespace{mock.Namespace()}))
+	must.NoError(t, store.UpdateEventSinksProgress(30, map[string]uint64{sink.ID: 5}))
+
+	broker, err := store.EventBroker()
+	must.NoError(t, err)
+	testutil.WaitForResult(func() (bool, error) {
+		return broker.LatestIndex() == 20, nil
+	}, func(err error) {
+		t.Fatalf("events were not published")
+	})
+
+	m := testEventSinkManager(t, store)
+
+	// Delivery resumes from the oldest buffered event
+	must.Wait(t, wait.InitialSuccess(
+		wait.BoolFunc(func() bool { return len(srv.events()) == 1 }),
+		wait.Timeout(5*time.Second),
+		wait.Gap(10*time.Millisecond),
+	))
+	must.Eq(t, 20, srv.events()[0].Index)
+
+	status := m.status(sink, 20)
+	must.True(t, status.Running)
+	must.True(t, status.Lagging)
+	must.False(t, status.Healthy)
+	must.StrContains(t, status.LastError, "events after index 5 were dropped")
+}
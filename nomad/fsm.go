@@ -61,6 +61,8 @@ const (
 	ACLRoleSnapshot                      SnapshotType = 25
 	ACLAuthMethodSnapshot                SnapshotType = 26
 	ACLBindingRuleSnapshot               SnapshotType = 27
+	EventSinksSnapshot                   SnapshotType = 28
+	ACLTokenExpiryNotificationSnapshot   SnapshotType = 29
 
 	// Namespace appliers were moved from enterprise and therefore start at 64
 	NamespaceSnapshot SnapshotType = 64
@@ -338,6 +340,14 @@ func (n *nomadFSM) Apply(log *raft.Log) interface{} {
 		return n.applyACLBindingRulesUpsert(buf[1:], log.Index)
 	case structs.ACLBindingRulesDeleteRequestType:
 		return n.applyACLBindingRulesDelete(buf[1:], log.Index)
+	case structs.EventSinkRegisterRequestType:
+		return n.applyEventSinksUpsert(buf[1:], log.Index)
+	case structs.EventSinkDeregisterRequestType:
+		return n.applyEventSinksDelete(buf[1:], log.Index)
+	case structs.EventSinkProgressRequestType:
+		return n.applyEventSinksProgress(buf[1:], log.Index)
+	case structs.ACLTokenExpiryNotificationsRequestType:
+		return n.applyACLTokenExpiryNotifications(msgType, buf[1:], log.Index)
 	}
 
 	// Check enterprise only message types.
@@ -1809,6 +1819,24 @@ func (n *nomadFSM) restoreImpl(old io.ReadCloser, filter *FSMFilter) error {
 				return err
 			}
 
+		case EventSinksSnapshot:
+			eventSink := new(structs.EventSink)
+			if err := dec.Decode(eventSink); err != nil {
+				return err
+			}
+			if err := restore.EventSinkRestore(eventSink); err != nil {
+				return err
+			}
+
+		case ACLTokenExpiryNotificationSnapshot:
+			notification := new(structs.ACLTokenExpiryNotification)
+			if err := dec.Decode(notification); err != nil {
+				return err
+			}
+			if err := restore.ACLTokenExpiryNotificationRestore(notification); err != nil {
+				return err
+			}
+
 		default:
 			// Check if this is an enterprise only object being restored
 			restorer, ok := n.enterpriseRestorers[snapType]
@@ -2158,6 +2186,66 @@ func (n *nomadFSM) applyACLBindingRulesDelete(buf []byte, index uint64) interfac
 	return nil
 }
 
+func (n *nomadFSM) applyEventSinksUpsert(buf []byte, index uint64) interface{} {
+	defer metrics.MeasureSince([]string{"nomad", "fsm", "apply_event_sink_upsert"}, time.Now())
+	var req structs.EventSinkUpsertRequest
+	if err := structs.Decode(buf, &req); err != nil {
+		panic(fmt.Errorf("failed to decode request: %v", err))
+	}
+
+	if err := n.state.UpsertEventSinks(index, req.Sinks); err != nil {
+		n.logger.Error("UpsertEventSinks failed", "error", err)
+		return err
+	}
+
+	return nil
+}
+
+func (n *nomadFSM) applyEventSinksDelete(buf []byte, index uint64) interface{} {
+	defer metrics.MeasureSince([]string{"nomad", "fsm", "apply_event_sink_delete"}, time.Now())
+	var req structs.EventSinkDeleteRequest
+	if err := structs.Decode(buf, &req); err != nil {
+		panic(fmt.Errorf("failed to decode request: %v", err))
+	}
+
+	if err := n.state.DeleteEventSinks(index, req.IDs); err != nil {
+		n.logger.Error("DeleteEventSinks failed", "error", err)
+		return err
+	}
+
+	return nil
+}
+
+func (n *nomadFSM) applyEventSinksProgress(buf []byte, index uint64) interface{} {
+	defer metrics.MeasureSince([]string{"nomad", "fsm", "apply_event_sink_progress"}, time.Now())
+	var req structs.EventSinkProgressRequest
+	if err := structs.Decode(buf, &req); err != nil {
+		panic(fmt.Errorf("failed to decode request: %v", err))
+	}
+
+	if err := n.state.UpdateEventSinksProgress(index, req.Progress); err != nil {
+		n.logger.Error("UpdateEventSinksProgress failed", "error", err)
+		return err
+	}
+
+	return nil
+}
+
+func (n *nomadFSM) applyACLTokenExpiryNotifications(msgType structs.MessageType, buf []byte, index uint64) interface{} {
+	defer metrics.MeasureSince([]string{"nomad", "fsm", "apply_acl_token_expiry_notifications"}, time.Now())
+	var req structs.ACLTokenExpiryNotificationsRequest
+	if err := structs.Decode(buf, &req); err != nil {
+		panic(fmt.Errorf("failed to decode request: %v", err))
+	}
+
+	if err := n.state.UpsertACLTokenExpiryNotifications(msgType, index, req.Notifications, req.Forget); err != nil {
+		n.logger.Error("UpsertACLTokenExpiryNotifications failed", "error", err)
+		return err
+	}
+
+	return nil
+}
+
 type FSMFilter struct {
 	evaluator *bexpr.Evaluator
 }
@@ -2371,6 +2459,14 @@ func (s *nomadSnapshot) Persist(sink raft.SnapshotSink) error {
 		sink.Cancel()
 		return err
 	}
+	if err := s.persistEventSinks(sink, encoder); err != nil {
+		sink.Cancel()
+		return err
+	}
+	if err := s.persistACLTokenExpiryNotifications(sink, encoder); err != nil {
+		sink.Cancel()
+		return err
+	}
 	return nil
 }
 
@@ -3066,6 +3162,42 @@ func (s *nomadSnapshot) persistACLBindingRules(sink raft.SnapshotSink, encoder *
 	return nil
 }
 
+func (s *nomadSnapshot) persistEventSinks(sink raft.SnapshotSink, encoder *codec.Encoder) error {
+	ws := memdb.NewWatchSet()
+	iter, err := s.snap.EventSinks(ws)
+	if err != nil {
+		return err
+	}
+
+	for raw := iter.Next(); raw != nil; raw = iter.Next() {
+		eventSink := raw.(*structs.EventSink)
+
+		sink.Write([]byte{byte(EventSinksSnapshot)})
+		if err := encoder.Encode(eventSink); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (s *nomadSnapshot) persistACLTokenExpiryNotifications(sink raft.SnapshotSink, encoder *codec.Encoder) error {
+	ws := memdb.NewWatchSet()
+	iter, err := s.snap.ACLTokenExpiryNotifications(ws)
+	if err != nil {
+		return err
+	}
+
+	for raw := iter.Next(); raw != nil; raw = iter.Next() {
+		notification := raw.(*structs.ACLTokenExpiryNotification)
+
+		sink.Write([]byte{byte(ACLTokenExpiryNotificationSnapshot)})
+		if err := encoder.Encode(notification); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
 // Release is a no-op, as we just need to GC the pointer
 // to the state store snapshot. There is nothing to explicitly
 // cleanup.
@@ -2739,6 +2739,77 @@ func TestFSM_SnapshotRestore_ACLBindingRules(t *testing.T) {
 	must.SliceContainsAll(t, restoredACLBindingRules, mockedACLBindingRoles)
 }
 
+func TestFSM_SnapshotRestore_EventSinks(t *testing.T) {
+	ci.Parallel(t)
+
+	fsm := testFSM(t)
+	testState := fsm.State()
+
+	sink1, sink2 := mock.EventSink(), mock.EventSink()
+	must.NoError(t, testState.UpsertEventSinks(10, []*structs.EventSink{sink1, sink2}))
+	must.NoError(t, testState.UpdateEventSinksProgress(20, map[string]uint64{sink1.ID: 15}))
+
+	restoredState := testSnapshotRestore(t, fsm).State()
+
+	out, err := restoredState.EventSinkByID(nil, sink1.ID)
+	must.NoError(t, err)
+	must.True(t, sink1.Equal(out))
+	must.Eq(t, 15, out.LatestIndex)
+
+	out, err = restoredState.EventSinkByID(nil, sink2.ID)
+	must.NoError(t, err)
+	must.True(t, sink2.Equal(out))
+}
+
+func TestFSM_SnapshotRestore_ACLTokenExpiryNotifications(t *testing.T) {
+	ci.Parallel(t)
+
+	fsm := testFSM(t)
+	testState := fsm.State()
+
+	notification := &structs.ACLTokenExpiryNotification{
+		AccessorID:     uuid.Generate(),
+		ExpirationTime: time.Now().UTC().Add(time.Hour),
+	}
+	must.NoError(t, testState.UpsertACLTokenExpiryNotifications(structs.MsgTypeTestSetup, 10,
+		[]*structs.ACLTokenExpiryNotification{notification}, nil))
+
+	restoredState := testSnapshotRestore(t, fsm).State()
+
+	iter, err := restoredState.ACLTokenExpiryNotifications(nil)
+	must.NoError(t, err)
+	raw := iter.Next()
+	must.NotNil(t, raw)
+	must.Eq(t, notification, raw.(*structs.ACLTokenExpiryNotification))
+	must.Nil(t, iter.Next())
+}
+
+func TestFSM_ApplyACLTokenExpiryNotifications(t *testing.T) {
+	ci.Parallel(t)
+
+	fsm := testFSM(t)
+	token := mock.ACLToken()
+	token.ExpirationTime = pointer.Of(time.Now().UTC().Add(time.Hour))
+	must.NoError(t, fsm.State().UpsertACLTokens(structs.MsgTypeTestSetup, 10, []*structs.ACLToken{token}))
+
+	req := structs.ACLTokenExpiryNotificationsRequest{
+		Notifications: []*structs.ACLTokenExpiryNotification{{
+			AccessorID:     token.AccessorID,
+			ExpirationTime: *token.ExpirationTime,
+		}},
+	}
+	buf, err := structs.Encode(
+		structs.ACLTokenExpiryNotificationsRequestType|structs.IgnoreUnknownTypeFlag, req)
+	must.NoError(t, err)
+	must.Nil(t, fsm.Apply(makeLog(buf)))
+
+	iter, err := fsm.State().ACLTokenExpiryNotifications(nil)
+	must.NoError(t, err)
+	raw := iter.Next()
+	must.NotNil(t, raw)
+	must.Eq(t, token.AccessorID, raw.(*structs.ACLTokenExpiryNotification).AccessorID)
+}
+
 func TestFSM_ReconcileSummaries(t *testing.T) {
 	ci.Parallel(t)
 	// Add some state
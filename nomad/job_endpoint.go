@@ -88,7 +88,9 @@ func (j *Job) Register(args *structs.JobRegisterRequest, reply *structs.JobRegis
 	if done, err := j.srv.forward("Job.Register", args, args, reply); done {
 		return err
 	}
-	j.srv.MeasureRPCRate("job", structs.RateMetricWrite, args)
+	if err := j.srv.MeasureRPCRate("job", structs.RateMetricWrite, args); err != nil {
+		return err
+	}
 	if authErr != nil {
 		return structs.ErrPermissionDenied
 	}
@@ -111,70 +113,18 @@ func (j *Job) Register(args *structs.JobRegisterRequest, reply *structs.JobRegis
 	}
 	args.Job = job
 
-	// Attach the Nomad token's accessor ID so that deploymentwatcher
-	// can reference the token later
-	nomadACLToken, err := j.srv.ResolveSecretToken(args.AuthToken)
-	if err != nil {
-		return err
-	}
-	if nomadACLToken != nil {
-		args.Job.NomadTokenID = nomadACLToken.AccessorID
-	}
-
-	// Set the warning message
-	reply.Warnings = helper.MergeMultierrorWarnings(warnings...)
-
 	// Check job submission permissions
 	aclObj, err := j.srv.ResolveACL(args)
 	if err != nil {
 		return err
 	} else if aclObj != nil {
-		if !aclObj.AllowNsOp(args.RequestNamespace(), acl.NamespaceCapabilitySubmitJob) {
+		if !aclObj.AllowJobOp(args.RequestNamespace(), args.Job.ID, acl.NamespaceCapabilitySubmitJob) {
 			return structs.ErrPermissionDenied
 		}
 
 		// Validate Volume Permissions
-		for _, tg := range args.Job.TaskGroups {
-			for _, vol := range tg.Volumes {
-				switch vol.Type {
-				case structs.VolumeTypeCSI:
-					if !allowCSIMount(aclObj, args.RequestNamespace()) {
-						return structs.ErrPermissionDenied
-					}
-				case structs.VolumeTypeHost:
-					// If a volume is readonly, then we allow access if the user has ReadOnly
-					// or ReadWrite access to the volume. Otherwise we only allow access if
-					// they have ReadWrite access.
-					if vol.ReadOnly {
-						if !aclObj.AllowHostVolumeOperation(vol.Source, acl.HostVolumeCapabilityMountReadOnly) &&
-							!aclObj.AllowHostVolumeOperation(vol.Source, acl.HostVolumeCapabilityMountReadWrite) {
-							return structs.ErrPermissionDenied
-						}
-					} else {
-						if !aclObj.AllowHostVolumeOperation(vol.Source, acl.HostVolumeCapabilityMountReadWrite) {
-							return structs.ErrPermissionDenied
-						}
-					}
-				default:
-					return structs.ErrPermissionDenied
-				}
-			}
-
-			for _, t := range tg.Tasks {
-				for _, vm := range t.VolumeMounts {
-					vol := tg.Volumes[vm.Volume]
-					if vm.PropagationMode == structs.VolumeMountPropagationBidirectional &&
-						!aclObj.AllowHostVolumeOperation(vol.Source, acl.HostVolumeCapabilityMountReadWrite) {
-						return structs.ErrPermissionDenied
-					}
-				}
-
-				if t.CSIPluginConfig != nil {
-					if !aclObj.AllowNsOp(args.RequestNamespace(), acl.NamespaceCapabilityCSIRegisterPlugin) {
-						return structs.ErrPermissionDenied
-					}
-				}
-			}
+		if !allowJobVolumes(aclObj, args.RequestNamespace(), args.Job) {
+			return structs.ErrPermissionDenied
 		}
 
 		// Check if override is set and we do not have permissions
@@ -192,6 +142,41 @@ func (j *Job) Register(args *structs.JobRegisterRequest, reply *structs.JobRegis
 		return structs.ErrJobRegistrationDisabled
 	}
 
+	// Run the external admission webhooks only once the caller is allowed to
+	// submit the job, so unauthorized requests never reach them
+	job, webhookWarnings, err := j.admissionWebhooks(args.Job, args.GetIdentity(), structs.AdmissionOperationRegister)
+	if err != nil {
+		return err
+	}
+
+	// A job patched by a mutating webhook goes through the admission
+	// controllers and volume permission checks again, so the webhooks can't
+	// admit an invalid job or one the caller isn't allowed to submit
+	if job != args.Job {
+		job, warnings, err = j.admissionControllers(job)
+		if err != nil {
+			return err
+		}
+		if aclObj != nil && !allowJobVolumes(aclObj, args.RequestNamespace(), job) {
+			return structs.ErrPermissionDenied
+		}
+	}
+	args.Job = job
+	warnings = append(warnings, webhookWarnings...)
+
+	// Attach the Nomad token's accessor ID so that deploymentwatcher
+	// can reference the token later
+	nomadACLToken, err := j.srv.ResolveSecretToken(args.AuthToken)
+	if err != nil {
+		return err
+	}
+	if nomadACLToken != nil {
+		args.Job.NomadTokenID = nomadACLToken.AccessorID
+	}
+
+	// Set the warning message
+	reply.Warnings = helper.MergeMultierrorWarnings(warnings...)
+
 	// Lookup the job
 	snap, err := j.srv.State().Snapshot()
 	if err != nil {
@@ -486,7 +471,9 @@ func (j *Job) Summary(args *structs.JobSummaryRequest, reply *structs.JobSummary
 	if done, err := j.srv.forward("Job.Summary", args, args, reply); done {
 		return err
 	}
-	j.srv.MeasureRPCRate("job", structs.RateMetricRead, args)
+	if err := j.srv.MeasureRPCRate("job", structs.RateMetricRead, args); err != nil {
+		return err
+	}
 	if authErr != nil {
 		return structs.ErrPermissionDenied
 	}
@@ -495,7 +482,7 @@ func (j *Job) Summary(args *structs.JobSummaryRequest, reply *structs.JobSummary
 	// Check for read-job permissions
 	if aclObj, err := j.srv.ResolveACL(args); err != nil {
 		return err
-	} else if aclObj != nil && !aclObj.AllowNsOp(args.RequestNamespace(), acl.NamespaceCapabilityReadJob) {
+	} else if aclObj != nil && !aclObj.AllowJobOp(args.RequestNamespace(), args.JobID, acl.NamespaceCapabilityReadJob) {
 		return structs.ErrPermissionDenied
 	}
 
@@ -539,7 +526,9 @@ func (j *Job) Validate(args *structs.JobValidateRequest, reply *structs.JobValid
 	if done, err := j.srv.forward("Job.Validate", args, args, reply); done {
 		return err
 	}
-	j.srv.MeasureRPCRate("job", structs.RateMetricWrite, args)
+	if err := j.srv.MeasureRPCRate("job", structs.RateMetricWrite, args); err != nil {
+		return err
+	}
 	if authErr != nil {
 		return structs.ErrPermissionDenied
 	}
@@ -559,10 +548,28 @@ func (j *Job) Validate(args *structs.JobValidateRequest, reply *structs.JobValid
 	// Check for read-job permissions
 	if aclObj, err := j.srv.ResolveACL(args); err != nil {
 		return err
-	} else if aclObj != nil && !aclObj.AllowNsOp(args.RequestNamespace(), acl.NamespaceCapabilityReadJob) {
+	} else if aclObj != nil && !aclObj.AllowJobOp(args.RequestNamespace(), args.Job.ID, acl.NamespaceCapabilityReadJob) {
 		return structs.ErrPermissionDenied
 	}
 
+	// Run the external admission webhooks, whose denials are reported as
+	// validation errors
+	job, webhookWarnings, err := j.admissionWebhooks(args.Job, args.GetIdentity(), structs.AdmissionOperationValidate)
+	if err != nil {
+		var denied *admissionDeniedError
+		if !errors.As(err, &denied) {
+			return err
+		}
+		for _, err := range denied.errs.Errors {
+			reply.ValidationErrors = append(reply.ValidationErrors, err.Error())
+		}
+		reply.Error = denied.Error()
+		reply.Warnings = helper.MergeMultierrorWarnings(mutateWarnings...)
+		return nil
+	}
+	args.Job = job
+	mutateWarnings = append(mutateWarnings, webhookWarnings...)
+
 	// Validate the job and capture any warnings
 	validateWarnings, err := j.admissionValidators(args.Job)
 	if err != nil {
@@ -591,7 +598,9 @@ func (j *Job) Revert(args *structs.JobRevertRequest, reply *structs.JobRegisterR
 	if done, err := j.srv.forward("Job.Revert", args, args, reply); done {
 		return err
 	}
-	j.srv.MeasureRPCRate("job", structs.RateMetricWrite, args)
+	if err := j.srv.MeasureRPCRate("job", structs.RateMetricWrite, args); err != nil {
+		return err
+	}
 	if authErr != nil {
 		return structs.ErrPermissionDenied
 	}
@@ -600,7 +609,7 @@ func (j *Job) Revert(args *structs.JobRevertRequest, reply *structs.JobRegisterR
 	// Check for submit-job permissions
 	if aclObj, err := j.srv.ResolveACL(args); err != nil {
 		return err
-	} else if aclObj != nil && !aclObj.AllowNsOp(args.RequestNamespace(), acl.NamespaceCapabilitySubmitJob) {
+	} else if aclObj != nil && !aclObj.AllowJobOp(args.RequestNamespace(), args.JobID, acl.NamespaceCapabilitySubmitJob) {
 		return structs.ErrPermissionDenied
 	}
 
@@ -664,7 +673,9 @@ func (j *Job) Stable(args *structs.JobStabilityRequest, reply *structs.JobStabil
 	if done, err := j.srv.forward("Job.Stable", args, args, reply); done {
 		return err
 	}
-	j.srv.MeasureRPCRate("job", structs.RateMetricWrite, args)
+	if err := j.srv.MeasureRPCRate("job", structs.RateMetricWrite, args); err != nil {
+		return err
+	}
 	if authErr != nil {
 		return structs.ErrPermissionDenied
 	}
@@ -673,7 +684,7 @@ func (j *Job) Stable(args *structs.JobStabilityRequest, reply *structs.JobStabil
 	// Check for read-job permissions
 	if aclObj, err := j.srv.ResolveACL(args); err != nil {
 		return err
-	} else if aclObj != nil && !aclObj.AllowNsOp(args.RequestNamespace(), acl.NamespaceCapabilitySubmitJob) {
+	} else if aclObj != nil && !aclObj.AllowJobOp(args.RequestNamespace(), args.JobID, acl.NamespaceCapabilitySubmitJob) {
 		return structs.ErrPermissionDenied
 	}
 
@@ -715,7 +726,9 @@ func (j *Job) Evaluate(args *structs.JobEvaluateRequest, reply *structs.JobRegis
 	if done, err := j.srv.forward("Job.Evaluate", args, args, reply); done {
 		return err
 	}
-	j.srv.MeasureRPCRate("job", structs.RateMetricWrite, args)
+	if err := j.srv.MeasureRPCRate("job", structs.RateMetricWrite, args); err != nil {
+		return err
+	}
 	if authErr != nil {
 		return structs.ErrPermissionDenied
 	}
@@ -724,7 +737,7 @@ func (j *Job) Evaluate(args *structs.JobEvaluateRequest, reply *structs.JobRegis
 	// Check for submit-job permissions
 	if aclObj, err := j.srv.ResolveACL(args); err != nil {
 		return err
-	} else if aclObj != nil && !aclObj.AllowNsOp(args.RequestNamespace(), acl.NamespaceCapabilitySubmitJob) {
+	} else if aclObj != nil && !aclObj.AllowJobOp(args.RequestNamespace(), args.JobID, acl.NamespaceCapabilitySubmitJob) {
 		return structs.ErrPermissionDenied
 	}
 
@@ -816,7 +829,9 @@ func (j *Job) Deregister(args *structs.JobDeregisterRequest, reply *structs.JobD
 	if done, err := j.srv.forward("Job.Deregister", args, args, reply); done {
 		return err
 	}
-	j.srv.MeasureRPCRate("job", structs.RateMetricWrite, args)
+	if err := j.srv.MeasureRPCRate("job", structs.RateMetricWrite, args); err != nil {
+		return err
+	}
 	if authErr != nil {
 		return structs.ErrPermissionDenied
 	}
@@ -825,7 +840,7 @@ func (j *Job) Deregister(args *structs.JobDeregisterRequest, reply *structs.JobD
 	// Check for submit-job permissions
 	if aclObj, err := j.srv.ResolveACL(args); err != nil {
 		return err
-	} else if aclObj != nil && !aclObj.AllowNsOp(args.RequestNamespace(), acl.NamespaceCapabilitySubmitJob) {
+	} else if aclObj != nil && !aclObj.AllowJobOp(args.RequestNamespace(), args.JobID, acl.NamespaceCapabilitySubmitJob) {
 		return structs.ErrPermissionDenied
 	}
 
@@ -913,7 +928,9 @@ func (j *Job) BatchDeregister(args *structs.JobBatchDeregisterRequest, reply *st
 	if done, err := j.srv.forward("Job.BatchDeregister", args, args, reply); done {
 		return err
 	}
-	j.srv.MeasureRPCRate("job", structs.RateMetricWrite, args)
+	if err := j.srv.MeasureRPCRate("job", structs.RateMetricWrite, args); err != nil {
+		return err
+	}
 	if authErr != nil {
 		return structs.ErrPermissionDenied
 	}
@@ -936,7 +953,7 @@ func (j *Job) BatchDeregister(args *structs.JobBatchDeregisterRequest, reply *st
 	// Loop through checking for permissions
 	for jobNS := range args.Jobs {
 		// Check for submit-job permissions
-		if aclObj != nil && !aclObj.AllowNsOp(jobNS.Namespace, acl.NamespaceCapabilitySubmitJob) {
+		if aclObj != nil && !aclObj.AllowJobOp(jobNS.Namespace, jobNS.ID, acl.NamespaceCapabilitySubmitJob) {
 			return structs.ErrPermissionDenied
 		}
 	}
@@ -1003,7 +1020,9 @@ func (j *Job) Scale(args *structs.JobScaleRequest, reply *structs.JobRegisterRes
 	if done, err := j.srv.forward("Job.Scale", args, args, reply); done {
 		return err
 	}
-	j.srv.MeasureRPCRate("job", structs.RateMetricWrite, args)
+	if err := j.srv.MeasureRPCRate("job", structs.RateMetricWrite, args); err != nil {
+		return err
+	}
 	if authErr != nil {
 		return structs.ErrPermissionDenied
 	}
@@ -1018,8 +1037,8 @@ func (j *Job) Scale(args *structs.JobScaleRequest, reply *structs.JobRegisterRes
 	}
 
 	if aclObj != nil {
-		hasScaleJob := aclObj.AllowNsOp(namespace, acl.NamespaceCapabilityScaleJob)
-		hasSubmitJob := aclObj.AllowNsOp(namespace, acl.NamespaceCapabilitySubmitJob)
+		hasScaleJob := aclObj.AllowJobOp(namespace, args.JobID, acl.NamespaceCapabilityScaleJob)
+		hasSubmitJob := aclObj.AllowJobOp(namespace, args.JobID, acl.NamespaceCapabilitySubmitJob)
 		if !(hasScaleJob || hasSubmitJob) {
 			return structs.ErrPermissionDenied
 		}
@@ -1190,7 +1209,9 @@ func (j *Job) GetJob(args *structs.JobSpecificRequest,
 	if done, err := j.srv.forward("Job.GetJob", args, args, reply); done {
 		return err
 	}
-	j.srv.MeasureRPCRate("job", structs.RateMetricRead, args)
+	if err := j.srv.MeasureRPCRate("job", structs.RateMetricRead, args); err != nil {
+		return err
+	}
 	if authErr != nil {
 		return structs.ErrPermissionDenied
 	}
@@ -1199,7 +1220,7 @@ func (j *Job) GetJob(args *structs.JobSpecificRequest,
 	// Check for read-job permissions
 	if aclObj, err := j.srv.ResolveACL(args); err != nil {
 		return err
-	} else if aclObj != nil && !aclObj.AllowNsOp(args.RequestNamespace(), acl.NamespaceCapabilityReadJob) {
+	} else if aclObj != nil && !aclObj.AllowJobOp(args.RequestNamespace(), args.JobID, acl.NamespaceCapabilityReadJob) {
 		return structs.ErrPermissionDenied
 	}
 
@@ -1241,7 +1262,9 @@ func (j *Job) GetJobVersions(args *structs.JobVersionsRequest,
 	if done, err := j.srv.forward("Job.GetJobVersions", args, args, reply); done {
 		return err
 	}
-	j.srv.MeasureRPCRate("job", structs.RateMetricRead, args)
+	if err := j.srv.MeasureRPCRate("job", structs.RateMetricRead, args); err != nil {
+		return err
+	}
 	if authErr != nil {
 		return structs.ErrPermissionDenied
 	}
@@ -1250,7 +1273,7 @@ func (j *Job) GetJobVersions(args *structs.JobVersionsRequest,
 	// Check for read-job permissions
 	if aclObj, err := j.srv.ResolveACL(args); err != nil {
 		return err
-	} else if aclObj != nil && !aclObj.AllowNsOp(args.RequestNamespace(), acl.NamespaceCapabilityReadJob) {
+	} else if aclObj != nil && !aclObj.AllowJobOp(args.RequestNamespace(), args.JobID, acl.NamespaceCapabilityReadJob) {
 		return structs.ErrPermissionDenied
 	}
 
@@ -1326,6 +1349,54 @@ func allowedNSes(aclObj *acl.ACL, state *state.StateStore, allow func(ns string)
 	return r, nil
 }
 
+// allowJobVolumes returns whether the ACL allows mounting the volumes of the
+// job and registering its CSI plugins.
+func allowJobVolumes(aclObj *acl.ACL, namespace string, job *structs.Job) bool {
+	for _, tg := range job.TaskGroups {
+		for _, vol := range tg.Volumes {
+			switch vol.Type {
+			case structs.VolumeTypeCSI:
+				if !allowCSIMount(aclObj, namespace) {
+					return false
+				}
+			case structs.VolumeTypeHost:
+				// If a volume is readonly, then we allow access if the user has ReadOnly
+				// or ReadWrite access to the volume. Otherwise we only allow access if
+				// they have ReadWrite access.
+				if vol.ReadOnly {
+					if !aclObj.AllowHostVolumeOperation(vol.Source, acl.HostVolumeCapabilityMountReadOnly) &&
+						!aclObj.AllowHostVolumeOperation(vol.Source, acl.HostVolumeCapabilityMountReadWrite) {
+						return false
+					}
+				} else {
+					if !aclObj.AllowHostVolumeOperation(vol.Source, acl.HostVolumeCapabilityMountReadWrite) {
+						return false
+					}
+				}
+			default:
+				return false
+			}
+		}
+
+		for _, t := range tg.Tasks {
+			for _, vm := range t.VolumeMounts {
+				vol := tg.Volumes[vm.Volume]
+				if vm.PropagationMode == structs.VolumeMountPropagationBidirectional &&
+					!aclObj.AllowHostVolumeOperation(vol.Source, acl.HostVolumeCapabilityMountReadWrite) {
+					return false
+				}
+			}
+
+			if t.CSIPluginConfig != nil {
+				if !aclObj.AllowNsOp(namespace, acl.NamespaceCapabilityCSIRegisterPlugin) {
+					return false
+				}
+			}
+		}
+	}
+	return true
+}
+
 // registrationsAreAllowed checks that the scheduler is not in
 // RejectJobRegistration mode for load-shedding.
 func registrationsAreAllowed(aclObj *acl.ACL, state *state.StateStore) (bool, error) {
@@ -1348,7 +1419,9 @@ func (j *Job) List(args *structs.JobListRequest, reply *structs.JobListResponse)
 	if done, err := j.srv.forward("Job.List", args, args, reply); done {
 		return err
 	}
-	j.srv.MeasureRPCRate("job", structs.RateMetricList, args)
+	if err := j.srv.MeasureRPCRate("job", structs.RateMetricList, args); err != nil {
+		return err
+	}
 	if authErr != nil {
 		return structs.ErrPermissionDenied
 	}
@@ -1356,15 +1429,18 @@ func (j *Job) List(args *structs.JobListRequest, reply *structs.JobListResponse)
 
 	namespace := args.RequestNamespace()
 
-	// Check for list-job permissions
+	// Check for list-job permissions. Tokens which can only list some of the
+	// jobs of a namespace are allowed, and the jobs are filtered below.
 	aclObj, err := j.srv.ResolveACL(args)
 	if err != nil {
 		return err
 	}
-	if !aclObj.AllowNsOp(namespace, acl.NamespaceCapabilityListJobs) {
+	if !aclObj.AllowNsOrJobOp(namespace, acl.NamespaceCapabilityListJobs) {
 		return structs.ErrPermissionDenied
 	}
-	allow := aclObj.AllowNsOpFunc(acl.NamespaceCapabilityListJobs)
+	allow := func(ns string) bool {
+		return aclObj.AllowNsOrJobOp(ns, acl.NamespaceCapabilityListJobs)
+	}
 
 	// Setup the blocking query
 	opts := blockingOptions{
@@ -1406,6 +1482,12 @@ func (j *Job) List(args *structs.JobListRequest, reply *structs.JobListResponse)
 					paginator.NamespaceFilter{
 						AllowableNamespaces: allowableNamespaces,
 					},
+					paginator.GenericFilter{
+						Allow: func(raw interface{}) (bool, error) {
+							job := raw.(*structs.Job)
+							return aclObj.AllowJobOp(job.Namespace, job.ID, acl.NamespaceCapabilityListJobs), nil
+						},
+					},
 				}
 
 				var jobs []*structs.JobListStub
@@ -1459,7 +1541,9 @@ func (j *Job) Allocations(args *structs.JobSpecificRequest,
 	if done, err := j.srv.forward("Job.Allocations", args, args, reply); done {
 		return err
 	}
-	j.srv.MeasureRPCRate("job", structs.RateMetricList, args)
+	if err := j.srv.MeasureRPCRate("job", structs.RateMetricList, args); err != nil {
+		return err
+	}
 	if authErr != nil {
 		return structs.ErrPermissionDenied
 	}
@@ -1468,7 +1552,7 @@ func (j *Job) Allocations(args *structs.JobSpecificRequest,
 	// Check for read-job permissions
 	if aclObj, err := j.srv.ResolveACL(args); err != nil {
 		return err
-	} else if aclObj != nil && !aclObj.AllowNsOp(args.RequestNamespace(), acl.NamespaceCapabilityReadJob) {
+	} else if aclObj != nil && !aclObj.AllowJobOp(args.RequestNamespace(), args.JobID, acl.NamespaceCapabilityReadJob) {
 		return structs.ErrPermissionDenied
 	}
 
@@ -1519,7 +1603,9 @@ func (j *Job) Evaluations(args *structs.JobSpecificRequest,
 	if done, err := j.srv.forward("Job.Evaluations", args, args, reply); done {
 		return err
 	}
-	j.srv.MeasureRPCRate("job", structs.RateMetricList, args)
+	if err := j.srv.MeasureRPCRate("job", structs.RateMetricList, args); err != nil {
+		return err
+	}
 	if authErr != nil {
 		return structs.ErrPermissionDenied
 	}
@@ -1528,7 +1614,7 @@ func (j *Job) Evaluations(args *structs.JobSpecificRequest,
 	// Check for read-job permissions
 	if aclObj, err := j.srv.ResolveACL(args); err != nil {
 		return err
-	} else if aclObj != nil && !aclObj.AllowNsOp(args.RequestNamespace(), acl.NamespaceCapabilityReadJob) {
+	} else if aclObj != nil && !aclObj.AllowJobOp(args.RequestNamespace(), args.JobID, acl.NamespaceCapabilityReadJob) {
 		return structs.ErrPermissionDenied
 	}
 
@@ -1566,7 +1652,9 @@ func (j *Job) Deployments(args *structs.JobSpecificRequest,
 	if done, err := j.srv.forward("Job.Deployments", args, args, reply); done {
 		return err
 	}
-	j.srv.MeasureRPCRate("job", structs.RateMetricList, args)
+	if err := j.srv.MeasureRPCRate("job", structs.RateMetricList, args); err != nil {
+		return err
+	}
 	if authErr != nil {
 		return structs.ErrPermissionDenied
 	}
@@ -1575,7 +1663,7 @@ func (j *Job) Deployments(args *structs.JobSpecificRequest,
 	// Check for read-job permissions
 	if aclObj, err := j.srv.ResolveACL(args); err != nil {
 		return err
-	} else if aclObj != nil && !aclObj.AllowNsOp(args.RequestNamespace(), acl.NamespaceCapabilityReadJob) {
+	} else if aclObj != nil && !aclObj.AllowJobOp(args.RequestNamespace(), args.JobID, acl.NamespaceCapabilityReadJob) {
 		return structs.ErrPermissionDenied
 	}
 
@@ -1613,7 +1701,9 @@ func (j *Job) LatestDeployment(args *structs.JobSpecificRequest,
 	if done, err := j.srv.forward("Job.LatestDeployment", args, args, reply); done {
 		return err
 	}
-	j.srv.MeasureRPCRate("job", structs.RateMetricRead, args)
+	if err := j.srv.MeasureRPCRate("job", structs.RateMetricRead, args); err != nil {
+		return err
+	}
 	if authErr != nil {
 		return structs.ErrPermissionDenied
 	}
@@ -1622,7 +1712,7 @@ func (j *Job) LatestDeployment(args *structs.JobSpecificRequest,
 	// Check for read-job permissions
 	if aclObj, err := j.srv.ResolveACL(args); err != nil {
 		return err
-	} else if aclObj != nil && !aclObj.AllowNsOp(args.RequestNamespace(), acl.NamespaceCapabilityReadJob) {
+	} else if aclObj != nil && !aclObj.AllowJobOp(args.RequestNamespace(), args.JobID, acl.NamespaceCapabilityReadJob) {
 		return structs.ErrPermissionDenied
 	}
 
@@ -1665,7 +1755,9 @@ func (j *Job) Plan(args *structs.JobPlanRequest, reply *structs.JobPlanResponse)
 	if done, err := j.srv.forward("Job.Plan", args, args, reply); done {
 		return err
 	}
-	j.srv.MeasureRPCRate("job", structs.RateMetricWrite, args)
+	if err := j.srv.MeasureRPCRate("job", structs.RateMetricWrite, args); err != nil {
+		return err
+	}
 	if authErr != nil {
 		return structs.ErrPermissionDenied
 	}
@@ -1683,14 +1775,11 @@ func (j *Job) Plan(args *structs.JobPlanRequest, reply *structs.JobPlanResponse)
 	}
 	args.Job = job
 
-	// Set the warning message
-	reply.Warnings = helper.MergeMultierrorWarnings(warnings...)
-
 	// Check job submission permissions, which we assume is the same for plan
 	if aclObj, err := j.srv.ResolveACL(args); err != nil {
 		return err
 	} else if aclObj != nil {
-		if !aclObj.AllowNsOp(args.RequestNamespace(), acl.NamespaceCapabilitySubmitJob) {
+		if !aclObj.AllowJobOp(args.RequestNamespace(), args.Job.ID, acl.NamespaceCapabilitySubmitJob) {
 			return structs.ErrPermissionDenied
 		}
 		// Check if override is set and we do not have permissions
@@ -1701,6 +1790,26 @@ func (j *Job) Plan(args *structs.JobPlanRequest, reply *structs.JobPlanResponse)
 		}
 	}
 
+	// Run the external admission webhooks once the plan is authorized
+	job, webhookWarnings, err := j.admissionWebhooks(args.Job, args.GetIdentity(), structs.AdmissionOperationPlan)
+	if err != nil {
+		return err
+	}
+
+	// Plan the job as it would be registered, so a job patched by a
+	// mutating webhook goes through the admission controllers again
+	if job != args.Job {
+		job, warnings, err = j.admissionControllers(job)
+		if err != nil {
+			return err
+		}
+	}
+	args.Job = job
+	warnings = append(warnings, webhookWarnings...)
+
+	// Set the warning message
+	reply.Warnings = helper.MergeMultierrorWarnings(warnings...)
+
 	// Acquire a snapshot of the state
 	snap, err := j.srv.fsm.State().Snapshot()
 	if err != nil {
@@ -1813,7 +1922,7 @@ func (j *Job) Plan(args *structs.JobPlanRequest, reply *structs.JobPlanResponse)
 			return fmt.Errorf("failed to create job diff: %v", err)
 		}
 
-		if err := scheduler.Annotate(jobDiff, annotations); err != nil {
+		if err := scheduler.Annotate(jobDiff, annotations, snap, args.RequestNamespace()); err != nil {
 			return fmt.Errorf("failed to annotate job diff: %v", err)
 		}
 		reply.Diff = jobDiff
@@ -1885,7 +1994,9 @@ func (j *Job) Dispatch(args *structs.JobDispatchRequest, reply *structs.JobDispa
 	if done, err := j.srv.forward("Job.Dispatch", args, args, reply); done {
 		return err
 	}
-	j.srv.MeasureRPCRate("job", structs.RateMetricWrite, args)
+	if err := j.srv.MeasureRPCRate("job", structs.RateMetricWrite, args); err != nil {
+		return err
+	}
 	if authErr != nil {
 		return structs.ErrPermissionDenied
 	}
@@ -1895,7 +2006,7 @@ func (j *Job) Dispatch(args *structs.JobDispatchRequest, reply *structs.JobDispa
 	aclObj, err := j.srv.ResolveACL(args)
 	if err != nil {
 		return err
-	} else if aclObj != nil && !aclObj.AllowNsOp(args.RequestNamespace(), acl.NamespaceCapabilityDispatchJob) {
+	} else if aclObj != nil && !aclObj.AllowJobOp(args.RequestNamespace(), args.JobID, acl.NamespaceCapabilityDispatchJob) {
 		return structs.ErrPermissionDenied
 	}
 
@@ -2121,7 +2232,9 @@ func (j *Job) ScaleStatus(args *structs.JobScaleStatusRequest,
 	if done, err := j.srv.forward("Job.ScaleStatus", args, args, reply); done {
 		return err
 	}
-	j.srv.MeasureRPCRate("job", structs.RateMetricRead, args)
+	if err := j.srv.MeasureRPCRate("job", structs.RateMetricRead, args); err != nil {
+		return err
+	}
 	if authErr != nil {
 		return structs.ErrPermissionDenied
 	}
@@ -2131,8 +2244,8 @@ func (j *Job) ScaleStatus(args *structs.JobScaleStatusRequest,
 	if aclObj, err := j.srv.ResolveACL(args); err != nil {
 		return err
 	} else if aclObj != nil {
-		hasReadJob := aclObj.AllowNsOp(args.RequestNamespace(), acl.NamespaceCapabilityReadJob)
-		hasReadJobScaling := aclObj.AllowNsOp(args.RequestNamespace(), acl.NamespaceCapabilityReadJobScaling)
+		hasReadJob := aclObj.AllowJobOp(args.RequestNamespace(), args.JobID, acl.NamespaceCapabilityReadJob)
+		hasReadJobScaling := aclObj.AllowJobOp(args.RequestNamespace(), args.JobID, acl.NamespaceCapabilityReadJobScaling)
 		if !(hasReadJob || hasReadJobScaling) {
 			return structs.ErrPermissionDenied
 		}
@@ -2241,7 +2354,9 @@ func (j *Job) GetServiceRegistrations(
 	if done, err := j.srv.forward(structs.JobServiceRegistrationsRPCMethod, args, args, reply); done {
 		return err
 	}
-	j.srv.MeasureRPCRate("job", structs.RateMetricList, args)
+	if err := j.srv.MeasureRPCRate("job", structs.RateMetricList, args); err != nil {
+		return err
+	}
 	if authErr != nil {
 		return structs.ErrPermissionDenied
 	}
@@ -2253,7 +2368,7 @@ func (j *Job) GetServiceRegistrations(
 	if err != nil {
 		return err
 	} else if aclObj != nil {
-		if !aclObj.AllowNsOp(args.RequestNamespace(), acl.NamespaceCapabilityReadJob) {
+		if !aclObj.AllowJobOp(args.RequestNamespace(), args.JobID, acl.NamespaceCapabilityReadJob) {
 			return structs.ErrPermissionDenied
 		}
 	}
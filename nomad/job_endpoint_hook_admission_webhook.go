@@ -0,0 +1,259 @@
+package nomad
+
+import (
+	"bytes"
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"time"
+
+	"github.com/hashicorp/go-cleanhttp"
+	"github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/nomad/helper/jsonpatch"
+	"github.com/hashicorp/nomad/nomad/structs"
+	"github.com/hashicorp/nomad/nomad/structs/config"
+	"golang.org/x/exp/slices"
+)
+
+const (
+	// maxAdmissionWebhookResponseSize bounds the size of webhook responses
+	maxAdmissionWebhookResponseSize = 10 * 1024 * 1024
+)
+
+// admissionWebhook is an external admission webhook configured on the
+// server. Unlike the built-in admission controllers it is given the identity
+// submitting the job.
+type admissionWebhook struct {
+	config  *config.AdmissionWebhookConfig
+	timeout time.Duration
+	client  *http.Client
+}
+
+// newAdmissionWebhooks returns the admission webhooks of the server
+// configuration, which must already have been validated.
+func newAdmissionWebhooks(configs []*config.AdmissionWebhookConfig) ([]*admissionWebhook, error) {
+	webhooks := make([]*admissionWebhook, 0, len(configs))
+	for _, c := range configs {
+		transport := cleanhttp.DefaultPooledTransport()
+		if c.CAFile != "" {
+			pem, err := os.ReadFile(c.CAFile)
+			if err != nil {
+				return nil, fmt.Errorf("failed to read CA file of admission webhook %q: %v", c.Name, err)
+			}
+			pool := x509.NewCertPool()
+			if !pool.AppendCertsFromPEM(pem) {
+				return nil, fmt.Errorf("failed to parse CA file of admission webhook %q", c.Name)
+			}
+			transport.TLSClientConfig = &tls.Config{
+				RootCAs:    pool,
+				MinVersion: tls.VersionTLS12,
+			}
+		}
+
+		webhooks = append(webhooks, &admissionWebhook{
+			config:  c.Copy(),
+			timeout: c.TimeoutDuration(),
+			client:  &http.Client{Transport: transport},
+		})
+	}
+	return webhooks, nil
+}
+
+func (w *admissionWebhook) Name() string {
+	return w.config.Name
+}
+
+func (w *admissionWebhook) failOpen() bool {
+	return w.config.FailOpen != nil && *w.config.FailOpen
+}
+
+// matches returns whether the job is selected by the webhook.
+func (w *admissionWebhook) matches(job *structs.Job) bool {
+	if len(w.config.Namespaces) > 0 && !slices.Contains(w.config.Namespaces, job.Namespace) {
+		return false
+	}
+	if len(w.config.JobTypes) > 0 && !slices.Contains(w.config.JobTypes, job.Type) {
+		return false
+	}
+	return true
+}
+
+// call POSTs the job to the webhook and returns its response.
+func (w *admissionWebhook) call(req *structs.AdmissionWebhookRequest) (*structs.AdmissionWebhookResponse, error) {
+	body, err := json.Marshal(req)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), w.timeout)
+	defer cancel()
+
+	httpReq, err := http.NewRequestWithContext(ctx, http.MethodPost, w.config.URL, bytes.NewReader(body))
+	if err != nil {
+		return nil, err
+	}
+	httpReq.Header.Set("Content-Type", "application/json")
+	for k, v := range w.config.Headers {
+		httpReq.Header.Set(k, v)
+	}
+
+	httpResp, err := w.client.Do(httpReq)
+	if err != nil {
+		return nil, err
+	}
+	defer httpResp.Body.Close()
+
+	if httpResp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("unexpected response status %s", httpResp.Status)
+	}
+
+	var resp structs.AdmissionWebhookResponse
+	dec := json.NewDecoder(io.LimitReader(httpResp.Body, maxAdmissionWebhookResponseSize))
+	if err := dec.Decode(&resp); err != nil {
+		return nil, fmt.Errorf("failed to decode response: %v", err)
+	}
+	return &resp, nil
+}
+
+// patch applies a JSON patch returned by a mutating webhook to the job.
+func (w *admissionWebhook) patch(job *structs.Job, patch []jsonpatch.Operation) (*structs.Job, error) {
+	doc, err := json.Marshal(job)
+	if err != nil {
+		return nil, err
+	}
+
+	doc, err = jsonpatch.Apply(doc, patch)
+	if err != nil {
+		return nil, err
+	}
+
+	var out *structs.Job
+	if err := json.Unmarshal(doc, &out); err != nil {
+		return nil, fmt.Errorf("patched job is invalid: %v", err)
+	}
+	if out == nil {
+		return nil, fmt.Errorf("patch removed the job")
+	}
+
+	// The job was authorized for its namespace, so it must not move
+	if out.ID != job.ID || out.Namespace != job.Namespace {
+		return nil, fmt.Errorf("patch may not change the job ID or namespace")
+	}
+
+	out.Canonicalize()
+	return out, nil
+}
+
+// admissionWebhooks sends the job to the external admission webhooks that
+// select it and returns the job as patched by mutating webhooks. Mutating
+// webhooks are called first, in order, so that validating webhooks see the
+// final job. A denial is returned as an error with the webhook's messages.
+func (j *Job) admissionWebhooks(job *structs.Job, identity *structs.AuthenticatedIdentity, operation string) (*structs.Job, []error, error) {
+	if len(j.srv.admissionWebhooks) == 0 {
+		return job, nil, nil
+	}
+
+	var warnings []error
+	webhookIdentity := structs.NewAdmissionWebhookIdentity(identity)
+
+	for _, kind := range []string{config.AdmissionWebhookMutating, config.AdmissionWebhookValidating} {
+		for _, webhook := range j.srv.admissionWebhooks {
+			if webhook.config.Kind != kind || !webhook.matches(job) {
+				continue
+			}
+
+			out, w, err := j.callAdmissionWebhook(webhook, job, webhookIdentity, operation)
+			warnings = append(warnings, w...)
+
+			var denied *admissionDeniedError
+			switch {
+			case err == nil:
+				job = out
+			case errors.As(err, &denied):
+				return nil, nil, err
+			case webhook.failOpen():
+				j.logger.Warn("admission webhook failed, admitting job", "webhook", webhook.Name(), "error", err)
+				warnings = append(warnings, fmt.Errorf("admission webhook %q failed and was skipped: %v", webhook.Name(), err))
+			default:
+				j.logger.Error("admission webhook failed, rejecting job", "webhook", webhook.Name(), "error", err)
+				return nil, nil, fmt.Errorf("admission webhook %q failed: %v", webhook.Name(), err)
+			}
+		}
+	}
+
+	return job, warnings, nil
+}
+
+// callAdmissionWebhook calls a single webhook and returns the job it
+// admitted, its warnings, and either an admissionDeniedError if it denied the
+// job or another error if it failed.
+func (j *Job) callAdmissionWebhook(webhook *admissionWebhook, job *structs.Job,
+	identity *structs.AdmissionWebhookIdentity, operation string) (*structs.Job, []error, error) {
+
+	resp, err := webhook.call(&structs.AdmissionWebhookRequest{
+		Webhook:   webhook.Name(),
+		Kind:      webhook.config.Kind,
+		Operation: operation,
+		Namespace: job.Namespace,
+		Job:       job,
+		Identity:  identity,
+	})
+	j.logger.Trace("admission webhook results", "webhook", webhook.Name(), "response", resp, "error", err)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var warnings []error
+	for _, w := range resp.Warnings {
+		warnings = append(warnings, fmt.Errorf("admission webhook %q: %s", webhook.Name(), w))
+	}
+
+	if !resp.Allowed {
+		return nil, warnings, newAdmissionDeniedError(webhook.Name(), resp.Messages)
+	}
+
+	if len(resp.Patch) == 0 {
+		return job, warnings, nil
+	}
+	if webhook.config.Kind != config.AdmissionWebhookMutating {
+		return nil, warnings, fmt.Errorf("validating webhook returned a patch")
+	}
+
+	out, err := webhook.patch(job, resp.Patch)
+	if err != nil {
+		return nil, warnings, fmt.Errorf("failed to apply patch: %v", err)
+	}
+	return out, warnings, nil
+}
+
+// admissionDeniedError is returned when an admission webhook denies a job.
+// It wraps a multierror of the webhook's messages so they are reported
+// individually, like the errors of the built-in validators.
+type admissionDeniedError struct {
+	errs *multierror.Error
+}
+
+func newAdmissionDeniedError(webhook string, messages []string) *admissionDeniedError {
+	if len(messages) == 0 {
+		messages = []string{"job denied"}
+	}
+	var errs *multierror.Error
+	for _, msg := range messages {
+		errs = multierror.Append(errs, fmt.Errorf("admission webhook %q denied the job: %s", webhook, msg))
+	}
+	return &admissionDeniedError{errs: errs}
+}
+
+func (e *admissionDeniedError) Error() string {
+	return e.errs.Error()
+}
+
+func (e *admissionDeniedError) Unwrap() error {
+	return e.errs
+}
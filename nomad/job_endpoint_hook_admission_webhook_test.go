@@ -0,0 +1,369 @@
+package nomad
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+
+	msgpackrpc "github.com/hashicorp/net-rpc-msgpackrpc"
+	"github.com/hashicorp/nomad/acl"
+	"github.com/hashicorp/nomad/ci"
+	"github.com/hashicorp/nomad/helper/jsonpatch"
+	"github.com/hashicorp/nomad/helper/pointer"
+	"github.com/hashicorp/nomad/helper/testlog"
+	"github.com/hashicorp/nomad/nomad/mock"
+	"github.com/hashicorp/nomad/nomad/structs"
+	"github.com/hashicorp/nomad/nomad/structs/config"
+	"github.com/hashicorp/nomad/testutil"
+	"github.com/shoenig/test/must"
+)
+
+// testAdmissionWebhook starts an HTTP server responding to admission requests
+// with fn, and returns its config.
+func testAdmissionWebhook(t *testing.T, name, kind string,
+	fn func(*structs.AdmissionWebhookRequest) *structs.AdmissionWebhookResponse) *config.AdmissionWebhookConfig {
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var req structs.AdmissionWebhookRequest
+		if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		_ = json.NewEncoder(w).Encode(fn(&req))
+	}))
+	t.Cleanup(srv.Close)
+
+	return &config.AdmissionWebhookConfig{
+		Name: name,
+		URL:  srv.URL,
+		Kind: kind,
+	}
+}
+
+func testAdmissionWebhookJob(t *testing.T, configs ...*config.AdmissionWebhookConfig) *Job {
+	webhooks, err := newAdmissionWebhooks(configs)
+	must.NoError(t, err)
+	return &Job{
+		srv:    &Server{admissionWebhooks: webhooks},
+		logger: testlog.HCLogger(t),
+	}
+}
+
+func TestJobEndpoint_admissionWebhooks(t *testing.T) {
+	ci.Parallel(t)
+
+	t.Run("none", func(t *testing.T) {
+		j := testAdmissionWebhookJob(t)
+		job := mock.Job()
+		out, warnings, err := j.admissionWebhooks(job, nil, structs.AdmissionOperationRegister)
+		must.NoError(t, err)
+		must.SliceEmpty(t, warnings)
+		must.Eq(t, job, out)
+	})
+
+	t.Run("mutate then validate", func(t *testing.T) {
+		var validated *structs.Job
+		validating := testAdmissionWebhook(t, "validate", config.AdmissionWebhookValidating,
+			func(req *structs.AdmissionWebhookRequest) *structs.AdmissionWebhookResponse {
+				validated = req.Job
+				return &structs.AdmissionWebhookResponse{Allowed: true, Warnings: []string{"looks odd"}}
+			})
+		mutating := testAdmissionWebhook(t, "mutate", config.AdmissionWebhookMutating,
+			func(req *structs.AdmissionWebhookRequest) *structs.AdmissionWebhookResponse {
+				must.Eq(t, structs.AdmissionOperationPlan, req.Operation)
+				must.Eq(t, "token", req.Identity.TokenName)
+				return &structs.AdmissionWebhookResponse{
+					Allowed: true,
+					Patch: []jsonpatch.Operation{{
+						Op:    "add",
+						Path:  "/Meta/team",
+						Value: json.RawMessage(`"infra"`),
+					}},
+				}
+			})
+
+		// The validating webhook is listed first but must see the patched job
+		j := testAdmissionWebhookJob(t, validating, mutating)
+		identity := &structs.AuthenticatedIdentity{ACLToken: &structs.ACLToken{Name: "token"}}
+		out, warnings, err := j.admissionWebhooks(mock.Job(), identity, structs.AdmissionOperationPlan)
+		must.NoError(t, err)
+		must.Eq(t, "infra", out.Meta["team"])
+		must.NotNil(t, validated)
+		must.Eq(t, "infra", validated.Meta["team"])
+		must.Len(t, 1, warnings)
+		must.ErrorContains(t, warnings[0], `admission webhook "validate": looks odd`)
+	})
+
+	t.Run("deny", func(t *testing.T) {
+		deny := testAdmissionWebhook(t, "deny", config.AdmissionWebhookValidating,
+			func(*structs.AdmissionWebhookRequest) *structs.AdmissionWebhookResponse {
+				return &structs.AdmissionWebhookResponse{Messages: []string{"no", "never"}}
+			})
+		j := testAdmissionWebhookJob(t, deny)
+		_, _, err := j.admissionWebhooks(mock.Job(), nil, structs.AdmissionOperationRegister)
+		must.ErrorContains(t, err, `admission webhook "deny" denied the job: no`)
+		must.ErrorContains(t, err, `admission webhook "deny" denied the job: never`)
+	})
+
+	t.Run("selectors", func(t *testing.T) {
+		deny := testAdmissionWebhook(t, "deny", config.AdmissionWebhookValidating,
+			func(*structs.AdmissionWebhookRequest) *structs.AdmissionWebhookResponse {
+				return &structs.AdmissionWebhookResponse{}
+			})
+		deny.Namespaces = []string{"prod"}
+		deny.JobTypes = []string{structs.JobTypeService}
+		j := testAdmissionWebhookJob(t, deny)
+
+		_, _, err := j.admissionWebhooks(mock.Job(), nil, structs.AdmissionOperationRegister)
+		must.NoError(t, err)
+
+		job := mock.Job()
+		job.Namespace = "prod"
+		_, _, err = j.admissionWebhooks(job, nil, structs.AdmissionOperationRegister)
+		must.Error(t, err)
+
+		job.Type = structs.JobTypeBatch
+		_, _, err = j.admissionWebhooks(job, nil, structs.AdmissionOperationRegister)
+		must.NoError(t, err)
+	})
+
+	t.Run("patch may not move job", func(t *testing.T) {
+		mutating := testAdmissionWebhook(t, "mutate", config.AdmissionWebhookMutating,
+			func(*structs.AdmissionWebhookRequest) *structs.AdmissionWebhookResponse {
+				return &structs.AdmissionWebhookResponse{
+					Allowed: true,
+					Patch: []jsonpatch.Operation{{
+						Op:    "replace",
+						Path:  "/Namespace",
+						Value: json.RawMessage(`"other"`),
+					}},
+				}
+			})
+		j := testAdmissionWebhookJob(t, mutating)
+		_, _, err := j.admissionWebhooks(mock.Job(), nil, structs.AdmissionOperationRegister)
+		must.ErrorContains(t, err, "may not change the job ID or namespace")
+	})
+
+	t.Run("validating webhook may not patch", func(t *testing.T) {
+		validating := testAdmissionWebhook(t, "validate", config.AdmissionWebhookValidating,
+			func(*structs.AdmissionWebhookRequest) *structs.AdmissionWebhookResponse {
+				return &structs.AdmissionWebhookResponse{
+					Allowed: true,
+					Patch:   []jsonpatch.Operation{{Op: "remove", Path: "/Meta"}},
+				}
+			})
+		j := testAdmissionWebhookJob(t, validating)
+		_, _, err := j.admissionWebhooks(mock.Job(), nil, structs.AdmissionOperationRegister)
+		must.ErrorContains(t, err, "validating webhook returned a patch")
+	})
+
+	t.Run("fail closed", func(t *testing.T) {
+		j := testAdmissionWebhookJob(t, &config.AdmissionWebhookConfig{
+			Name: "down",
+			URL:  "http://127.0.0.1:0",
+			Kind: config.AdmissionWebhookValidating,
+		})
+		_, _, err := j.admissionWebhooks(mock.Job(), nil, structs.AdmissionOperationRegister)
+		must.ErrorContains(t, err, `admission webhook "down" failed`)
+	})
+
+	t.Run("fail open", func(t *testing.T) {
+		j := testAdmissionWebhookJob(t, &config.AdmissionWebhookConfig{
+			Name:     "down",
+			URL:      "http://127.0.0.1:0",
+			Kind:     config.AdmissionWebhookValidating,
+			FailOpen: pointer.Of(true),
+		})
+		job := mock.Job()
+		out, warnings, err := j.admissionWebhooks(job, nil, structs.AdmissionOperationRegister)
+		must.NoError(t, err)
+		must.Eq(t, job, out)
+		must.Len(t, 1, warnings)
+		must.ErrorContains(t, warnings[0], `admission webhook "down" failed and was skipped`)
+	})
+}
+
+func TestJobEndpoint_Register_AdmissionWebhook(t *testing.T) {
+	ci.Parallel(t)
+
+	mutating := testAdmissionWebhook(t, "mutate", config.AdmissionWebhookMutating,
+		func(req *structs.AdmissionWebhookRequest) *structs.AdmissionWebhookResponse {
+			if req.Job.Meta["deny"] != "" {
+				return &structs.AdmissionWebhookResponse{Messages: []string{req.Job.Meta["deny"]}}
+			}
+			return &structs.AdmissionWebhookResponse{
+				Allowed:  true,
+				Warnings: []string{"meta added"},
+				Patch: []jsonpatch.Operation{{
+					Op:    "add",
+					Path:  "/Meta/admitted",
+					Value: json.RawMessage(`"true"`),
+				}},
+			}
+		})
+
+	s1, cleanupS1 := TestServer(t, func(c *Config) {
+		c.NumSchedulers = 0 // Prevent automatic dequeue
+		c.AdmissionWebhooks = []*config.AdmissionWebhookConfig{mutating}
+	})
+	defer cleanupS1()
+	codec := rpcClient(t, s1)
+	testutil.WaitForLeader(t, s1.RPC)
+
+	job := mock.Job()
+	req := &structs.JobRegisterRequest{
+		Job: job,
+		WriteRequest: structs.WriteRequest{
+			Region:    "global",
+			Namespace: job.Namespace,
+		},
+	}
+	var resp structs.JobRegisterResponse
+	must.NoError(t, msgpackrpc.CallWithCodec(codec, "Job.Register", req, &resp))
+	must.StrContains(t, resp.Warnings, "meta added")
+
+	out, err := s1.fsm.State().JobByID(nil, job.Namespace, job.ID)
+	must.NoError(t, err)
+	must.Eq(t, "true", out.Meta["admitted"])
+
+	// Denials fail registration and are reported as validation errors
+	job = mock.Job()
+	job.Meta["deny"] = "not today"
+	req.Job = job
+	err = msgpackrpc.CallWithCodec(codec, "Job.Register", req, &resp)
+	must.ErrorContains(t, err, "not today")
+
+	validateReq := &structs.JobValidateRequest{
+		Job: job,
+		WriteRequest: structs.WriteRequest{
+			Region:    "global",
+			Namespace: job.Namespace,
+		},
+	}
+	var validateResp structs.JobValidateResponse
+	must.NoError(t, msgpackrpc.CallWithCodec(codec, "Job.Validate", validateReq, &validateResp))
+	must.Len(t, 1, validateResp.ValidationErrors)
+	must.StrContains(t, validateResp.ValidationErrors[0], "not today")
+}
+
+func TestJobEndpoint_Register_AdmissionWebhook_ACL(t *testing.T) {
+	ci.Parallel(t)
+
+	var calls atomic.Int32
+	validating := testAdmissionWebhook(t, "validate", config.AdmissionWebhookValidating,
+		func(req *structs.AdmissionWebhookRequest) *structs.AdmissionWebhookResponse {
+			calls.Add(1)
+			return &structs.AdmissionWebhookResponse{Allowed: true}
+		})
+
+	s1, root, cleanupS1 := TestACLServer(t, func(c *Config) {
+		c.NumSchedulers = 0 // Prevent automatic dequeue
+		c.AdmissionWebhooks = []*config.AdmissionWebhookConfig{validating}
+	})
+	defer cleanupS1()
+	codec := rpcClient(t, s1)
+	testutil.WaitForLeader(t, s1.RPC)
+
+	readOnly := mock.CreatePolicyAndToken(t, s1.fsm.State(), 1001, "read-only",
+		mock.NamespacePolicy(structs.DefaultNamespace, "", []string{acl.NamespaceCapabilityReadJob}))
+
+	job := mock.Job()
+	req := &structs.JobRegisterRequest{
+		Job: job,
+		WriteRequest: structs.WriteRequest{
+			Region:    "global",
+			Namespace: job.Namespace,
+			AuthToken: readOnly.SecretID,
+		},
+	}
+
+	// Unauthorized submissions never reach the webhooks
+	var resp structs.JobRegisterResponse
+	err := msgpackrpc.CallWithCodec(codec, "Job.Register", req, &resp)
+	must.EqError(t, err, structs.ErrPermissionDenied.Error())
+
+	planReq := &structs.JobPlanRequest{
+		Job:          job,
+		WriteRequest: req.WriteRequest,
+	}
+	var planResp structs.JobPlanResponse
+	err = msgpackrpc.CallWithCodec(codec, "Job.Plan", planReq, &planResp)
+	must.EqError(t, err, structs.ErrPermissionDenied.Error())
+	must.Eq(t, 0, calls.Load())
+
+	req.AuthToken = root.SecretID
+	must.NoError(t, msgpackrpc.CallWithCodec(codec, "Job.Register", req, &resp))
+	must.Eq(t, 1, calls.Load())
+}
+
+func TestJobEndpoint_Register_AdmissionWebhook_PatchedJob(t *testing.T) {
+	ci.Parallel(t)
+
+	patches := map[string][]jsonpatch.Operation{
+		"volume": {{
+			Op:    "add",
+			Path:  "/TaskGroups/0/Volumes",
+			Value: json.RawMessage(`{"data": {"Name": "data", "Type": "host", "Source": "secrets"}}`),
+		}},
+		"driver": {{
+			Op:    "replace",
+			Path:  "/TaskGroups/0/Tasks/0/Driver",
+			Value: json.RawMessage(`""`),
+		}},
+	}
+	mutating := testAdmissionWebhook(t, "mutate", config.AdmissionWebhookMutating,
+		func(req *structs.AdmissionWebhookRequest) *structs.AdmissionWebhookResponse {
+			return &structs.AdmissionWebhookResponse{
+				Allowed: true,
+				Patch:   patches[req.Job.Meta["patch"]],
+			}
+		})
+
+	s1, root, cleanupS1 := TestACLServer(t, func(c *Config) {
+		c.NumSchedulers = 0 // Prevent automatic dequeue
+		c.AdmissionWebhooks = []*config.AdmissionWebhookConfig{mutating}
+	})
+	defer cleanupS1()
+	codec := rpcClient(t, s1)
+	testutil.WaitForLeader(t, s1.RPC)
+
+	submit := mock.CreatePolicyAndToken(t, s1.fsm.State(), 1001, "submit",
+		mock.NamespacePolicy(structs.DefaultNamespace, "", []string{acl.NamespaceCapabilitySubmitJob}))
+
+	// A webhook can't add a volume the caller isn't allowed to mount
+	job := mock.Job()
+	job.Meta["patch"] = "volume"
+	req := &structs.JobRegisterRequest{
+		Job: job,
+		WriteRequest: structs.WriteRequest{
+			Region:    "global",
+			Namespace: job.Namespace,
+			AuthToken: submit.SecretID,
+		},
+	}
+	var resp structs.JobRegisterResponse
+	err := msgpackrpc.CallWithCodec(codec, "Job.Register", req, &resp)
+	must.EqError(t, err, structs.ErrPermissionDenied.Error())
+
+	out, err := s1.fsm.State().JobByID(nil, job.Namespace, job.ID)
+	must.NoError(t, err)
+	must.Nil(t, out)
+
+	// A webhook can't make the job invalid
+	job = mock.Job()
+	job.Meta["patch"] = "driver"
+	req.Job = job
+	req.AuthToken = root.SecretID
+	err = msgpackrpc.CallWithCodec(codec, "Job.Register", req, &resp)
+	must.ErrorContains(t, err, "Missing task driver")
+
+	planReq := &structs.JobPlanRequest{
+		Job:          job,
+		WriteRequest: req.WriteRequest,
+	}
+	var planResp structs.JobPlanResponse
+	err = msgpackrpc.CallWithCodec(codec, "Job.Plan", planReq, &planResp)
+	must.ErrorContains(t, err, "Missing task driver")
+}
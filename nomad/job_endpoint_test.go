@@ -4991,6 +4991,154 @@ func TestJobEndpoint_ListJobs_WithACL(t *testing.T) {
 	require.Equal(job.ID, validResp.Jobs[0].ID)
 }
 
+func TestJobEndpoint_ListJobs_WithJobACL(t *testing.T) {
+	ci.Parallel(t)
+
+	s1, _, cleanupS1 := TestACLServer(t, func(c *Config) {
+		c.NumSchedulers = 0 // Prevent automatic dequeue
+	})
+	defer cleanupS1()
+	codec := rpcClient(t, s1)
+	testutil.WaitForLeader(t, s1.RPC)
+	state := s1.fsm.State()
+
+	teamA := mock.Job()
+	teamA.ID = "team-a-web"
+	must.NoError(t, state.UpsertJob(structs.MsgTypeTestSetup, 1000, teamA))
+	teamB := mock.Job()
+	teamB.ID = "team-b-web"
+	must.NoError(t, state.UpsertJob(structs.MsgTypeTestSetup, 1001, teamB))
+
+	policy := `namespace "default" {
+  job "team-a-*" {
+    capabilities = ["list-jobs", "submit-job"]
+  }
+}`
+	token := mock.CreatePolicyAndToken(t, state, 1002, "team-a", policy)
+
+	// Only the jobs matching the job rules are listed
+	for _, ns := range []string{structs.DefaultNamespace, structs.AllNamespacesSentinel} {
+		req := &structs.JobListRequest{
+			QueryOptions: structs.QueryOptions{
+				Region:    "global",
+				Namespace: ns,
+				AuthToken: token.SecretID,
+			},
+		}
+		var resp structs.JobListResponse
+		must.NoError(t, msgpackrpc.CallWithCodec(codec, "Job.List", req, &resp))
+		must.Len(t, 1, resp.Jobs)
+		must.Eq(t, teamA.ID, resp.Jobs[0].ID)
+	}
+
+	// The token may only submit the jobs matching the job rules
+	for _, job := range []*structs.Job{teamA, teamB} {
+		req := &structs.JobRegisterRequest{
+			Job: job.Copy(),
+			WriteRequest: structs.WriteRequest{
+				Region:    "global",
+				Namespace: job.Namespace,
+				AuthToken: token.SecretID,
+			},
+		}
+		var resp structs.JobRegisterResponse
+		err := msgpackrpc.CallWithCodec(codec, "Job.Register", req, &resp)
+		if job == teamA {
+			must.NoError(t, err)
+		} else {
+			must.EqError(t, err, structs.ErrPermissionDenied.Error())
+		}
+	}
+}
+
+func TestJobEndpoint_ReadJob_WithJobACL(t *testing.T) {
+	ci.Parallel(t)
+
+	s1, _, cleanupS1 := TestACLServer(t, func(c *Config) {
+		c.NumSchedulers = 0 // Prevent automatic dequeue
+	})
+	defer cleanupS1()
+	codec := rpcClient(t, s1)
+	testutil.WaitForLeader(t, s1.RPC)
+	state := s1.fsm.State()
+
+	teamA := mock.Job()
+	teamA.ID = "team-a-web"
+	must.NoError(t, state.UpsertJob(structs.MsgTypeTestSetup, 1000, teamA))
+	teamB := mock.Job()
+	teamB.ID = "team-b-web"
+	must.NoError(t, state.UpsertJob(structs.MsgTypeTestSetup, 1001, teamB))
+
+	policy := `namespace "default" {
+  job "team-a-*" {
+    capabilities = ["read-job"]
+  }
+}`
+	token := mock.CreatePolicyAndToken(t, state, 1002, "team-a", policy)
+
+	// The token may only read the jobs matching the job rules
+	for _, job := range []*structs.Job{teamA, teamB} {
+		opts := structs.QueryOptions{
+			Region:    "global",
+			Namespace: job.Namespace,
+			AuthToken: token.SecretID,
+		}
+		specific := &structs.JobSpecificRequest{JobID: job.ID, QueryOptions: opts}
+
+		calls := map[string]func() error{
+			"Job.GetJob": func() error {
+				var resp structs.SingleJobResponse
+				return msgpackrpc.CallWithCodec(codec, "Job.GetJob", specific, &resp)
+			},
+			"Job.GetJobVersions": func() error {
+				var resp structs.JobVersionsResponse
+				return msgpackrpc.CallWithCodec(codec, "Job.GetJobVersions",
+					&structs.JobVersionsRequest{JobID: job.ID, QueryOptions: opts}, &resp)
+			},
+			"Job.Summary": func() error {
+				var resp structs.JobSummaryResponse
+				return msgpackrpc.CallWithCodec(codec, "Job.Summary",
+					&structs.JobSummaryRequest{JobID: job.ID, QueryOptions: opts}, &resp)
+			},
+			"Job.Allocations": func() error {
+				var resp structs.JobAllocationsResponse
+				return msgpackrpc.CallWithCodec(codec, "Job.Allocations", specific, &resp)
+			},
+			"Job.Evaluations": func() error {
+				var resp structs.JobEvaluationsResponse
+				return msgpackrpc.CallWithCodec(codec, "Job.Evaluations", specific, &resp)
+			},
+			"Job.Deployments": func() error {
+				var resp structs.DeploymentListResponse
+				return msgpackrpc.CallWithCodec(codec, "Job.Deployments", specific, &resp)
+			},
+			"Job.LatestDeployment": func() error {
+				var resp structs.SingleDeploymentResponse
+				return msgpackrpc.CallWithCodec(codec, "Job.LatestDeployment", specific, &resp)
+			},
+			"Job.ScaleStatus": func() error {
+				var resp structs.JobScaleStatusResponse
+				return msgpackrpc.CallWithCodec(codec, "Job.ScaleStatus",
+					&structs.JobScaleStatusRequest{JobID: job.ID, QueryOptions: opts}, &resp)
+			},
+			"Job.GetServiceRegistrations": func() error {
+				var resp structs.JobServiceRegistrationsResponse
+				return msgpackrpc.CallWithCodec(codec, "Job.GetServiceRegistrations",
+					&structs.JobServiceRegistrationsRequest{JobID: job.ID, QueryOptions: opts}, &resp)
+			},
+		}
+
+		for method, call := range calls {
+			err := call()
+			if job == teamA {
+				must.NoError(t, err, must.Sprintf("%s of %s", method, job.ID))
+			} else {
+				must.EqError(t, err, structs.ErrPermissionDenied.Error(), must.Sprintf("%s of %s", method, job.ID))
+			}
+		}
+	}
+}
+
 func TestJobEndpoint_ListJobs_Blocking(t *testing.T) {
 	ci.Parallel(t)
 
@@ -32,7 +32,9 @@ func (k *Keyring) Rotate(args *structs.KeyringRotateRootKeyRequest, reply *struc
 	if done, err := k.srv.forward("Keyring.Rotate", args, args, reply); done {
 		return err
 	}
-	k.srv.MeasureRPCRate("keyring", structs.RateMetricWrite, args)
+	if err := k.srv.MeasureRPCRate("keyring", structs.RateMetricWrite, args); err != nil {
+		return err
+	}
 	if authErr != nil {
 		return structs.ErrPermissionDenied
 	}
@@ -102,7 +104,9 @@ func (k *Keyring) List(args *structs.KeyringListRootKeyMetaRequest, reply *struc
 	if done, err := k.srv.forward("Keyring.List", args, args, reply); done {
 		return err
 	}
-	k.srv.MeasureRPCRate("keyring", structs.RateMetricList, args)
+	if err := k.srv.MeasureRPCRate("keyring", structs.RateMetricList, args); err != nil {
+		return err
+	}
 	if authErr != nil {
 		return structs.ErrPermissionDenied
 	}
@@ -161,7 +165,9 @@ func (k *Keyring) Update(args *structs.KeyringUpdateRootKeyRequest, reply *struc
 	if done, err := k.srv.forward("Keyring.Update", args, args, reply); done {
 		return err
 	}
-	k.srv.MeasureRPCRate("keyring", structs.RateMetricWrite, args)
+	if err := k.srv.MeasureRPCRate("keyring", structs.RateMetricWrite, args); err != nil {
+		return err
+	}
 	if authErr != nil {
 		return structs.ErrPermissionDenied
 	}
@@ -246,7 +252,9 @@ func (k *Keyring) Get(args *structs.KeyringGetRootKeyRequest, reply *structs.Key
 	if done, err := k.srv.forward("Keyring.Get", args, args, reply); done {
 		return err
 	}
-	k.srv.MeasureRPCRate("keyring", structs.RateMetricRead, args)
+	if err := k.srv.MeasureRPCRate("keyring", structs.RateMetricRead, args); err != nil {
+		return err
+	}
 	if authErr != nil {
 		return structs.ErrPermissionDenied
 	}
@@ -311,7 +319,9 @@ func (k *Keyring) Delete(args *structs.KeyringDeleteRootKeyRequest, reply *struc
 	if done, err := k.srv.forward("Keyring.Delete", args, args, reply); done {
 		return err
 	}
-	k.srv.MeasureRPCRate("keyring", structs.RateMetricWrite, args)
+	if err := k.srv.MeasureRPCRate("keyring", structs.RateMetricWrite, args); err != nil {
+		return err
+	}
 	if authErr != nil {
 		return structs.ErrPermissionDenied
 	}
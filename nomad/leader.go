@@ -67,6 +67,11 @@ var minACLJWTAuthMethodVersion = version.Must(version.NewVersion("1.5.3"))
 // must meet before the feature can be used.
 var minACLBindingRuleVersion = version.Must(version.NewVersion("1.5.0-beta.1"))
 
+// minEventSinkVersion is the Nomad version at which the event sinks table was
+// introduced. It forms the minimum version all local servers must meet before
+// the feature can be used.
+var minEventSinkVersion = version.Must(version.NewVersion("1.5.3"))
+
 // minNomadServiceRegistrationVersion is the Nomad version at which the service
 // registrations table was introduced. It forms the minimum version all local
 // servers must meet before the feature can be used.
@@ -339,6 +344,9 @@ func (s *Server) establishLeadership(stopCh chan struct{}) error {
 	// Enable the volume watcher, since we are now the leader
 	s.volumeWatcher.SetEnabled(true, s.State(), s.getLeaderAcl())
 
+	// Start delivering events to event sinks
+	s.eventSinkManager.SetEnabled(true, s.State())
+
 	// Restore the eval broker state and blocked eval state. If these are
 	// currently paused, we do not need to do this.
 	if restoreEvals {
@@ -423,6 +431,9 @@ func (s *Server) establishLeadership(stopCh chan struct{}) error {
 			go s.replicateACLBindingRules(stopCh)
 			go s.replicateNamespaces(stopCh)
 		}
+
+		// Notify about ACL tokens which are about to expire.
+		go s.notifyExpiringACLTokens(stopCh)
 	}
 
 	// Setup any enterprise systems required.
@@ -1273,6 +1284,118 @@ func (s *Server) iterateJobStatusMetrics(jobs *memdb.ResultIterator) {
 	metrics.SetGauge([]string{"nomad", "job_status", "dead"}, float32(dead))
 }
 
+// notifyExpiringACLTokens periodically publishes an ACLTokenExpiring event
+// for each ACL token which expires within the configured lead time, and the
+// number of these tokens as a metric. The loop should only be run on the
+// leader. Global tokens are only handled within the authoritative region, so
+// they aren't notified about by every federated region.
+func (s *Server) notifyExpiringACLTokens(stopCh chan struct{}) {
+	timer := time.NewTimer(0)
+	defer timer.Stop()
+
+	for {
+		select {
+		case <-stopCh:
+			return
+		case <-timer.C:
+			timer.Reset(s.config.ACLTokenExpirationNotifyInterval)
+			if err := s.publishExpiringACLTokens(); err != nil {
+				s.logger.Error("failed to notify about expiring ACL tokens", "error", err)
+			}
+		}
+	}
+}
+
+// publishExpiringACLTokens publishes the events and metric for the ACL tokens
+// which expire within the configured lead time. The events are published
+// through raft, which records the tokens notified about, so every server
+// publishes them and each token is only notified about once.
+func (s *Server) publishExpiringACLTokens() error {
+	now := time.Now().UTC()
+	before := now.Add(s.config.ACLTokenExpirationLeadTime)
+	store := s.State()
+
+	tokens, err := expiringACLTokens(nil, store, false, now, before)
+	if err != nil {
+		return err
+	}
+	if s.config.Region == s.config.AuthoritativeRegion {
+		globalTokens, err := expiringACLTokens(nil, store, true, now, before)
+		if err != nil {
+			return err
+		}
+		tokens = append(tokens, globalTokens...)
+	}
+
+	metrics.SetGauge([]string{"nomad", "acl", "expiring_tokens"}, float32(len(tokens)))
+
+	iter, err := store.ACLTokenExpiryNotifications(nil)
+	if err != nil {
+		return err
+	}
+	notified := make(map[string]time.Time)
+	for raw := iter.Next(); raw != nil; raw = iter.Next() {
+		notification := raw.(*structs.ACLTokenExpiryNotification)
+		notified[notification.AccessorID] = notification.ExpirationTime
+	}
+
+	req := structs.ACLTokenExpiryNotificationsRequest{
+		WriteRequest: structs.WriteRequest{Region: s.config.Region},
+	}
+	for _, token := range tokens {
+
+		// Tokens which were notified about are notified again if their
+		// expiration time has changed.
+		expiry, ok := notified[token.AccessorID]
+		delete(notified, token.AccessorID)
+		if ok && expiry.Equal(*token.ExpirationTime) {
+			continue
+		}
+
+		req.Notifications = append(req.Notifications, &structs.ACLTokenExpiryNotification{
+			AccessorID:     token.AccessorID,
+			ExpirationTime: *token.ExpirationTime,
+		})
+	}
+
+	// Forget about tokens which expired, were deleted or don't expire soon
+	// anymore.
+	for accessorID := range notified {
+		req.Forget = append(req.Forget, accessorID)
+	}
+
+	if len(req.Notifications) == 0 && len(req.Forget) == 0 {
+		return nil
+	}
+
+	// Servers which don't know the message type yet ignore it, so upgrades
+	// only miss the events on those servers.
+	_, _, err = s.raftApply(
+		structs.ACLTokenExpiryNotificationsRequestType|structs.IgnoreUnknownTypeFlag, &req)
+	return err
+}
+
+// expiringACLTokens returns the local or global ACL tokens which expire in the
+// window between now and before, ordered by their expiration time.
+func expiringACLTokens(
+	ws memdb.WatchSet, store *state.StateStore, global bool, now, before time.Time) ([]*structs.ACLToken, error) {
+
+	iter, err := store.ACLTokensByExpiration(ws, global, now)
+	if err != nil {
+		return nil, err
+	}
+
+	var tokens []*structs.ACLToken
+	for raw := iter.Next(); raw != nil; raw = iter.Next() {
+		token := raw.(*structs.ACLToken)
+		if token.ExpirationTime.After(before) {
+			break
+		}
+		tokens = append(tokens, token)
+	}
+	return tokens, nil
+}
+
 // revokeLeadership is invoked once we step down as leader.
 // This is used to cleanup any state that may be specific to a leader.
 func (s *Server) revokeLeadership() error {
@@ -1312,6 +1435,9 @@ func (s *Server) revokeLeadership() error {
 	// Disable the volume watcher
 	s.volumeWatcher.SetEnabled(false, nil, "")
 
+	// Stop delivering events to event sinks
+	s.eventSinkManager.SetEnabled(false, nil)
+
 	// Disable any enterprise systems required.
 	if err := s.revokeEnterpriseLeadership(); err != nil {
 		return err
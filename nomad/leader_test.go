@@ -1,6 +1,7 @@
 package nomad
 
 import (
+	"context"
 	"errors"
 	"fmt"
 	"sort"
@@ -18,8 +19,10 @@ import (
 
 	"github.com/hashicorp/nomad/ci"
 	"github.com/hashicorp/nomad/helper"
+	"github.com/hashicorp/nomad/helper/pointer"
 	"github.com/hashicorp/nomad/nomad/mock"
 	"github.com/hashicorp/nomad/nomad/state"
+	"github.com/hashicorp/nomad/nomad/stream"
 	"github.com/hashicorp/nomad/nomad/structs"
 	"github.com/hashicorp/nomad/testutil"
 	"github.com/hashicorp/raft"
@@ -1968,3 +1971,84 @@ func TestServer_handleEvalBrokerStateChange(t *testing.T) {
 		})
 	}
 }
+
+func TestLeader_NotifyExpiringACLTokens(t *testing.T) {
+	ci.Parallel(t)
+
+	s1, _, cleanupS1 := TestACLServer(t, func(c *Config) {
+		c.ACLTokenExpirationNotifyInterval = 50 * time.Millisecond
+		c.ACLTokenExpirationLeadTime = time.Hour
+	})
+	defer cleanupS1()
+	testutil.WaitForLeader(t, s1.RPC)
+
+	broker, err := s1.State().EventBroker()
+	must.NoError(t, err)
+	sub, err := broker.Subscribe(&stream.SubscribeRequest{
+		Topics: map[structs.Topic][]string{structs.TopicACLToken: {"*"}},
+	})
+	must.NoError(t, err)
+	defer sub.Unsubscribe()
+
+	now := time.Now().UTC()
+	soonToken := mock.ACLToken()
+	soonToken.ExpirationTime = pointer.Of(now.Add(10 * time.Minute))
+	laterToken := mock.ACLToken()
+	laterToken.ExpirationTime = pointer.Of(now.Add(3 * time.Hour))
+	neverExpireToken := mock.ACLToken()
+	must.NoError(t, s1.State().UpsertACLTokens(structs.MsgTypeTestSetup, 100,
+		[]*structs.ACLToken{soonToken, laterToken, neverExpireToken}))
+
+	// Collect the events over several intervals, so duplicate events would
+	// be seen.
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+
+	var expiring []structs.Event
+	for {
+		out, err := sub.Next(ctx)
+		if err != nil {
+			break
+		}
+		for _, event := range out.Events {
+			if event.Type == structs.TypeACLTokenExpiring {
+				expiring = append(expiring, event)
+			}
+		}
+	}
+
+	must.Len(t, 1, expiring)
+	must.Eq(t, soonToken.AccessorID, expiring[0].Key)
+	payload, ok := expiring[0].Payload.(*structs.ACLTokenEvent)
+	must.True(t, ok)
+	must.Eq(t, soonToken.AccessorID, payload.ACLToken.AccessorID)
+	must.Eq(t, "", payload.ACLToken.SecretID)
+
+	// The notification is recorded in state by the raft log entry which
+	// published the event, so it survives leader elections.
+	iter, err := s1.State().ACLTokenExpiryNotifications(nil)
+	must.NoError(t, err)
+	raw := iter.Next()
+	must.NotNil(t, raw)
+	must.Nil(t, iter.Next())
+	notification := raw.(*structs.ACLTokenExpiryNotification)
+	must.Eq(t, soonToken.AccessorID, notification.AccessorID)
+	must.Eq(t, *soonToken.ExpirationTime, notification.ExpirationTime)
+	must.Eq(t, notification.ModifyIndex, expiring[0].Index)
+
+	// Notifications of deleted tokens are forgotten
+	must.NoError(t, s1.State().DeleteACLTokens(structs.MsgTypeTestSetup, 200,
+		[]string{soonToken.AccessorID}))
+	testutil.WaitForResult(func() (bool, error) {
+		iter, err := s1.State().ACLTokenExpiryNotifications(nil)
+		if err != nil {
+			return false, err
+		}
+		if raw := iter.Next(); raw != nil {
+			return false, fmt.Errorf("notification not forgotten: %v", raw)
+		}
+		return true, nil
+	}, func(err error) {
+		t.Fatal(err)
+	})
+}
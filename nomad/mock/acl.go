@@ -279,6 +279,49 @@ func ACLJWTAuthMethod() *structs.ACLAuthMethod {
 	return &method
 }
 
+func ACLLDAPAuthMethod() *structs.ACLAuthMethod {
+	maxTokenTTL, _ := time.ParseDuration("3600s")
+	method := structs.ACLAuthMethod{
+		Name:          fmt.Sprintf("acl-auth-method-%s", uuid.Short()),
+		Type:          "LDAP",
+		TokenLocality: "local",
+		MaxTokenTTL:   maxTokenTTL,
+		Default:       false,
+		Config: &structs.ACLAuthMethodConfig{
+			LDAPURL:           "ldap://ldap.example.com",
+			LDAPBindDN:        "cn=admin,dc=example,dc=com",
+			LDAPBindPassword:  "very secret password",
+			LDAPUserBaseDN:    "ou=users,dc=example,dc=com",
+			LDAPGroupBaseDN:   "ou=groups,dc=example,dc=com",
+			ClaimMappings:     map[string]string{"username": "username"},
+			ListClaimMappings: map[string]string{"groups": "groups"},
+		},
+		CreateTime:  time.Now().UTC(),
+		CreateIndex: 10,
+		ModifyIndex: 10,
+	}
+	method.Canonicalize()
+	method.SetHash()
+	return &method
+}
+
+func ACLWorkloadAuthMethod() *structs.ACLAuthMethod {
+	maxTokenTTL, _ := time.ParseDuration("3600s")
+	method := structs.ACLAuthMethod{
+		Name:          fmt.Sprintf("acl-auth-method-%s", uuid.Short()),
+		Type:          "WORKLOAD",
+		TokenLocality: "local",
+		MaxTokenTTL:   maxTokenTTL,
+		Default:       false,
+		CreateTime:    time.Now().UTC(),
+		CreateIndex:   10,
+		ModifyIndex:   10,
+	}
+	method.Canonicalize()
+	method.SetHash()
+	return &method
+}
+
 // SampleJWTokenWithKeys takes a set of claims (can be nil) and optionally
 // a private RSA key that should be used for signing the JWT, and returns:
 // - a JWT signed with a randomly generated RSA key
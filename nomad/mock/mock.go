@@ -204,6 +204,17 @@ func Namespace() *structs.Namespace {
 	return ns
 }
 
+// EventSink returns a webhook event sink subscribed to all events.
+func EventSink() *structs.EventSink {
+	sink := &structs.EventSink{
+		ID:      fmt.Sprintf("sink-%s", uuid.Short()),
+		Type:    structs.EventSinkWebhook,
+		Address: "http://127.0.0.1:8080/events",
+	}
+	sink.Canonicalize()
+	return sink
+}
+
 // ServiceRegistrations generates an array containing two unique service
 // registrations.
 func ServiceRegistrations() []*structs.ServiceRegistration {
@@ -31,7 +31,9 @@ func (n *Namespace) UpsertNamespaces(args *structs.NamespaceUpsertRequest,
 	if done, err := n.srv.forward("Namespace.UpsertNamespaces", args, args, reply); done {
 		return err
 	}
-	n.srv.MeasureRPCRate("namespace", structs.RateMetricWrite, args)
+	if err := n.srv.MeasureRPCRate("namespace", structs.RateMetricWrite, args); err != nil {
+		return err
+	}
 	if authErr != nil {
 		return structs.ErrPermissionDenied
 	}
@@ -78,7 +80,9 @@ func (n *Namespace) DeleteNamespaces(args *structs.NamespaceDeleteRequest, reply
 	if done, err := n.srv.forward("Namespace.DeleteNamespaces", args, args, reply); done {
 		return err
 	}
-	n.srv.MeasureRPCRate("namespace", structs.RateMetricWrite, args)
+	if err := n.srv.MeasureRPCRate("namespace", structs.RateMetricWrite, args); err != nil {
+		return err
+	}
 	if authErr != nil {
 		return structs.ErrPermissionDenied
 	}
@@ -227,7 +231,9 @@ func (n *Namespace) ListNamespaces(args *structs.NamespaceListRequest, reply *st
 	if done, err := n.srv.forward("Namespace.ListNamespaces", args, args, reply); done {
 		return err
 	}
-	n.srv.MeasureRPCRate("namespace", structs.RateMetricList, args)
+	if err := n.srv.MeasureRPCRate("namespace", structs.RateMetricList, args); err != nil {
+		return err
+	}
 	if authErr != nil {
 		return structs.ErrPermissionDenied
 	}
@@ -294,7 +300,9 @@ func (n *Namespace) GetNamespace(args *structs.NamespaceSpecificRequest, reply *
 	if done, err := n.srv.forward("Namespace.GetNamespace", args, args, reply); done {
 		return err
 	}
-	n.srv.MeasureRPCRate("namespace", structs.RateMetricRead, args)
+	if err := n.srv.MeasureRPCRate("namespace", structs.RateMetricRead, args); err != nil {
+		return err
+	}
 	if authErr != nil {
 		return structs.ErrPermissionDenied
 	}
@@ -348,7 +356,9 @@ func (n *Namespace) GetNamespaces(args *structs.NamespaceSetRequest, reply *stru
 	if done, err := n.srv.forward("Namespace.GetNamespaces", args, args, reply); done {
 		return err
 	}
-	n.srv.MeasureRPCRate("namespace", structs.RateMetricList, args)
+	if err := n.srv.MeasureRPCRate("namespace", structs.RateMetricList, args); err != nil {
+		return err
+	}
 	if authErr != nil {
 		return structs.ErrPermissionDenied
 	}
@@ -106,7 +106,9 @@ func (n *Node) Register(args *structs.NodeRegisterRequest, reply *structs.NodeUp
 
 		return err
 	}
-	n.srv.MeasureRPCRate("node", structs.RateMetricWrite, args)
+	if err := n.srv.MeasureRPCRate("node", structs.RateMetricWrite, args); err != nil {
+		return err
+	}
 	if authErr != nil {
 		return structs.ErrPermissionDenied
 	}
@@ -330,7 +332,9 @@ func (n *Node) Deregister(args *structs.NodeDeregisterRequest, reply *structs.No
 	if done, err := n.srv.forward("Node.Deregister", args, args, reply); done {
 		return err
 	}
-	n.srv.MeasureRPCRate("node", structs.RateMetricWrite, args)
+	if err := n.srv.MeasureRPCRate("node", structs.RateMetricWrite, args); err != nil {
+		return err
+	}
 	if authErr != nil {
 		return structs.ErrPermissionDenied
 	}
@@ -357,7 +361,9 @@ func (n *Node) BatchDeregister(args *structs.NodeBatchDeregisterRequest, reply *
 	if done, err := n.srv.forward("Node.BatchDeregister", args, args, reply); done {
 		return err
 	}
-	n.srv.MeasureRPCRate("node", structs.RateMetricWrite, args)
+	if err := n.srv.MeasureRPCRate("node", structs.RateMetricWrite, args); err != nil {
+		return err
+	}
 	if authErr != nil {
 		return structs.ErrPermissionDenied
 	}
@@ -491,7 +497,9 @@ func (n *Node) UpdateStatus(args *structs.NodeUpdateStatusRequest, reply *struct
 
 		return err
 	}
-	n.srv.MeasureRPCRate("node", structs.RateMetricWrite, args)
+	if err := n.srv.MeasureRPCRate("node", structs.RateMetricWrite, args); err != nil {
+		return err
+	}
 	if authErr != nil {
 		return structs.ErrPermissionDenied
 	}
@@ -674,7 +682,9 @@ func (n *Node) UpdateDrain(args *structs.NodeUpdateDrainRequest,
 	if done, err := n.srv.forward("Node.UpdateDrain", args, args, reply); done {
 		return err
 	}
-	n.srv.MeasureRPCRate("node", structs.RateMetricWrite, args)
+	if err := n.srv.MeasureRPCRate("node", structs.RateMetricWrite, args); err != nil {
+		return err
+	}
 	if authErr != nil {
 		return structs.ErrPermissionDenied
 	}
@@ -774,7 +784,9 @@ func (n *Node) UpdateEligibility(args *structs.NodeUpdateEligibilityRequest,
 	if done, err := n.srv.forward("Node.UpdateEligibility", args, args, reply); done {
 		return err
 	}
-	n.srv.MeasureRPCRate("node", structs.RateMetricWrite, args)
+	if err := n.srv.MeasureRPCRate("node", structs.RateMetricWrite, args); err != nil {
+		return err
+	}
 	if authErr != nil {
 		return structs.ErrPermissionDenied
 	}
@@ -877,7 +889,9 @@ func (n *Node) Evaluate(args *structs.NodeEvaluateRequest, reply *structs.NodeUp
 	if done, err := n.srv.forward("Node.Evaluate", args, args, reply); done {
 		return err
 	}
-	n.srv.MeasureRPCRate("node", structs.RateMetricWrite, args)
+	if err := n.srv.MeasureRPCRate("node", structs.RateMetricWrite, args); err != nil {
+		return err
+	}
 	if authErr != nil {
 		return structs.ErrPermissionDenied
 	}
@@ -938,7 +952,9 @@ func (n *Node) GetNode(args *structs.NodeSpecificRequest,
 	if done, err := n.srv.forward("Node.GetNode", args, args, reply); done {
 		return err
 	}
-	n.srv.MeasureRPCRate("node", structs.RateMetricRead, args)
+	if err := n.srv.MeasureRPCRate("node", structs.RateMetricRead, args); err != nil {
+		return err
+	}
 	if authErr != nil {
 		return structs.ErrPermissionDenied
 	}
@@ -999,7 +1015,9 @@ func (n *Node) GetAllocs(args *structs.NodeSpecificRequest,
 	if done, err := n.srv.forward("Node.GetAllocs", args, args, reply); done {
 		return err
 	}
-	n.srv.MeasureRPCRate("node", structs.RateMetricList, args)
+	if err := n.srv.MeasureRPCRate("node", structs.RateMetricList, args); err != nil {
+		return err
+	}
 	if authErr != nil {
 		return structs.ErrPermissionDenied
 	}
@@ -1104,7 +1122,9 @@ func (n *Node) GetClientAllocs(args *structs.NodeSpecificRequest,
 
 		return err
 	}
-	n.srv.MeasureRPCRate("node", structs.RateMetricList, args)
+	if err := n.srv.MeasureRPCRate("node", structs.RateMetricList, args); err != nil {
+		return err
+	}
 	if authErr != nil {
 		return structs.ErrPermissionDenied
 	}
@@ -1252,7 +1272,9 @@ func (n *Node) UpdateAlloc(args *structs.AllocUpdateRequest, reply *structs.Gene
 	if done, err := n.srv.forward("Node.UpdateAlloc", args, args, reply); done {
 		return err
 	}
-	n.srv.MeasureRPCRate("node", structs.RateMetricWrite, args)
+	if err := n.srv.MeasureRPCRate("node", structs.RateMetricWrite, args); err != nil {
+		return err
+	}
 	if authErr != nil {
 		return structs.ErrPermissionDenied
 	}
@@ -1515,7 +1537,9 @@ func (n *Node) List(args *structs.NodeListRequest,
 	if done, err := n.srv.forward("Node.List", args, args, reply); done {
 		return err
 	}
-	n.srv.MeasureRPCRate("node", structs.RateMetricList, args)
+	if err := n.srv.MeasureRPCRate("node", structs.RateMetricList, args); err != nil {
+		return err
+	}
 	if authErr != nil {
 		return structs.ErrPermissionDenied
 	}
@@ -1725,7 +1749,9 @@ func (n *Node) DeriveVaultToken(args *structs.DeriveVaultTokenRequest, reply *st
 		setError(err, structs.IsRecoverable(err) || err == structs.ErrNoLeader)
 		return nil
 	}
-	n.srv.MeasureRPCRate("node", structs.RateMetricWrite, args)
+	if err := n.srv.MeasureRPCRate("node", structs.RateMetricWrite, args); err != nil {
+		return err
+	}
 	if authErr != nil {
 		return structs.ErrPermissionDenied
 	}
@@ -1954,7 +1980,9 @@ func (n *Node) DeriveSIToken(args *structs.DeriveSITokenRequest, reply *structs.
 		setError(err, structs.IsRecoverable(err) || err == structs.ErrNoLeader)
 		return nil
 	}
-	n.srv.MeasureRPCRate("node", structs.RateMetricWrite, args)
+	if err := n.srv.MeasureRPCRate("node", structs.RateMetricWrite, args); err != nil {
+		return err
+	}
 	if authErr != nil {
 		return structs.ErrPermissionDenied
 	}
@@ -2187,7 +2215,9 @@ func (n *Node) EmitEvents(args *structs.EmitNodeEventsRequest, reply *structs.Em
 	if done, err := n.srv.forward("Node.EmitEvents", args, args, reply); done {
 		return err
 	}
-	n.srv.MeasureRPCRate("node", structs.RateMetricWrite, args)
+	if err := n.srv.MeasureRPCRate("node", structs.RateMetricWrite, args); err != nil {
+		return err
+	}
 	if authErr != nil {
 		return structs.ErrPermissionDenied
 	}
@@ -40,7 +40,9 @@ func (op *Operator) RaftGetConfiguration(args *structs.GenericRequest, reply *st
 	if done, err := op.srv.forward("Operator.RaftGetConfiguration", args, args, reply); done {
 		return err
 	}
-	op.srv.MeasureRPCRate("operator", structs.RateMetricRead, args)
+	if err := op.srv.MeasureRPCRate("operator", structs.RateMetricRead, args); err != nil {
+		return err
+	}
 	if authErr != nil {
 		return structs.ErrPermissionDenied
 	}
@@ -107,7 +109,9 @@ func (op *Operator) RaftRemovePeerByAddress(args *structs.RaftPeerByAddressReque
 	if done, err := op.srv.forward("Operator.RaftRemovePeerByAddress", args, args, reply); done {
 		return err
 	}
-	op.srv.MeasureRPCRate("operator", structs.RateMetricWrite, args)
+	if err := op.srv.MeasureRPCRate("operator", structs.RateMetricWrite, args); err != nil {
+		return err
+	}
 	if authErr != nil {
 		return structs.ErrPermissionDenied
 	}
@@ -165,7 +169,9 @@ func (op *Operator) RaftRemovePeerByID(args *structs.RaftPeerByIDRequest, reply
 	if done, err := op.srv.forward("Operator.RaftRemovePeerByID", args, args, reply); done {
 		return err
 	}
-	op.srv.MeasureRPCRate("operator", structs.RateMetricWrite, args)
+	if err := op.srv.MeasureRPCRate("operator", structs.RateMetricWrite, args); err != nil {
+		return err
+	}
 	if authErr != nil {
 		return structs.ErrPermissionDenied
 	}
@@ -232,7 +238,9 @@ func (op *Operator) AutopilotGetConfiguration(args *structs.GenericRequest, repl
 	if done, err := op.srv.forward("Operator.AutopilotGetConfiguration", args, args, reply); done {
 		return err
 	}
-	op.srv.MeasureRPCRate("operator", structs.RateMetricRead, args)
+	if err := op.srv.MeasureRPCRate("operator", structs.RateMetricRead, args); err != nil {
+		return err
+	}
 	if authErr != nil {
 		return structs.ErrPermissionDenied
 	}
@@ -267,7 +275,9 @@ func (op *Operator) AutopilotSetConfiguration(args *structs.AutopilotSetConfigRe
 	if done, err := op.srv.forward("Operator.AutopilotSetConfiguration", args, args, reply); done {
 		return err
 	}
-	op.srv.MeasureRPCRate("operator", structs.RateMetricWrite, args)
+	if err := op.srv.MeasureRPCRate("operator", structs.RateMetricWrite, args); err != nil {
+		return err
+	}
 	if authErr != nil {
 		return structs.ErrPermissionDenied
 	}
@@ -310,7 +320,9 @@ func (op *Operator) ServerHealth(args *structs.GenericRequest, reply *structs.Op
 	if done, err := op.srv.forward("Operator.ServerHealth", args, args, reply); done {
 		return err
 	}
-	op.srv.MeasureRPCRate("operator", structs.RateMetricRead, args)
+	if err := op.srv.MeasureRPCRate("operator", structs.RateMetricRead, args); err != nil {
+		return err
+	}
 	if authErr != nil {
 		return structs.ErrPermissionDenied
 	}
@@ -345,7 +357,9 @@ func (op *Operator) SchedulerSetConfiguration(args *structs.SchedulerSetConfigRe
 	if done, err := op.srv.forward("Operator.SchedulerSetConfiguration", args, args, reply); done {
 		return err
 	}
-	op.srv.MeasureRPCRate("operator", structs.RateMetricWrite, args)
+	if err := op.srv.MeasureRPCRate("operator", structs.RateMetricWrite, args); err != nil {
+		return err
+	}
 	if authErr != nil {
 		return structs.ErrPermissionDenied
 	}
@@ -399,7 +413,9 @@ func (op *Operator) SchedulerGetConfiguration(args *structs.GenericRequest, repl
 	if done, err := op.srv.forward("Operator.SchedulerGetConfiguration", args, args, reply); done {
 		return err
 	}
-	op.srv.MeasureRPCRate("operator", structs.RateMetricRead, args)
+	if err := op.srv.MeasureRPCRate("operator", structs.RateMetricRead, args); err != nil {
+		return err
+	}
 	if authErr != nil {
 		return structs.ErrPermissionDenied
 	}
@@ -501,7 +517,10 @@ func (op *Operator) snapshotSave(conn io.ReadWriteCloser) {
 		}
 	}
 
-	op.srv.MeasureRPCRate("operator", structs.RateMetricWrite, &args)
+	if err := op.srv.MeasureRPCRate("operator", structs.RateMetricWrite, &args); err != nil {
+		handleFailure(429, err)
+		return
+	}
 	if authErr != nil {
 		handleFailure(403, structs.ErrPermissionDenied)
 	}
@@ -588,7 +607,10 @@ func (op *Operator) snapshotRestore(conn io.ReadWriteCloser) {
 
 	}
 
-	op.srv.MeasureRPCRate("operator", structs.RateMetricWrite, &args)
+	if err := op.srv.MeasureRPCRate("operator", structs.RateMetricWrite, &args); err != nil {
+		handleFailure(429, err)
+		return
+	}
 	if authErr != nil {
 		handleFailure(403, structs.ErrPermissionDenied)
 	}
@@ -30,7 +30,9 @@ func (p *Periodic) Force(args *structs.PeriodicForceRequest, reply *structs.Peri
 	if done, err := p.srv.forward("Periodic.Force", args, args, reply); done {
 		return err
 	}
-	p.srv.MeasureRPCRate("periodic", structs.RateMetricWrite, args)
+	if err := p.srv.MeasureRPCRate("periodic", structs.RateMetricWrite, args); err != nil {
+		return err
+	}
 	if authErr != nil {
 		return structs.ErrPermissionDenied
 	}
@@ -34,7 +34,9 @@ func (p *Plan) Submit(args *structs.PlanRequest, reply *structs.PlanResponse) er
 	if done, err := p.srv.forward("Plan.Submit", args, args, reply); done {
 		return err
 	}
-	p.srv.MeasureRPCRate("plan", structs.RateMetricWrite, args)
+	if err := p.srv.MeasureRPCRate("plan", structs.RateMetricWrite, args); err != nil {
+		return err
+	}
 	if authErr != nil {
 		return structs.ErrPermissionDenied
 	}
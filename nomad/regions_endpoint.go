@@ -24,7 +24,9 @@ func (r *Region) List(args *structs.GenericRequest, reply *[]string) error {
 	// note: we're intentionally throwing away any auth error here and only
 	// authenticate so that we can measure rate metrics
 	r.srv.Authenticate(r.ctx, args)
-	r.srv.MeasureRPCRate("region", structs.RateMetricList, args)
+	if err := r.srv.MeasureRPCRate("region", structs.RateMetricList, args); err != nil {
+		return err
+	}
 
 	*reply = r.srv.Regions()
 	return nil
@@ -0,0 +1,193 @@
+package nomad
+
+import (
+	"fmt"
+	"net"
+	"strings"
+	"time"
+
+	lru "github.com/hashicorp/golang-lru/v2"
+	"github.com/hashicorp/nomad/nomad/state"
+	"github.com/hashicorp/nomad/nomad/structs"
+	"github.com/hashicorp/nomad/nomad/structs/config"
+	"golang.org/x/exp/slices"
+	"golang.org/x/time/rate"
+)
+
+const (
+	// rpcRateLimitersSize is the number of token buckets kept by the RPC rate
+	// limiter. Buckets of the least recently seen identities are evicted
+	// first, which resets their limit.
+	rpcRateLimitersSize = 16 * 1024
+)
+
+// rpcRateLimiter limits the rate of RPCs per identity, endpoint and
+// operation with token buckets.
+type rpcRateLimiter struct {
+	config *config.RPCRateLimitConfig
+
+	// limiters holds a token bucket per identity, endpoint, operation and
+	// the config block the limit came from
+	limiters *lru.Cache[string, *rate.Limiter]
+
+	// isServerAddr returns whether an address belongs to another server. It
+	// identifies servers when mTLS isn't enabled, and may be nil.
+	isServerAddr func(net.IP) bool
+
+	// now is the current time, and may be overridden in tests
+	now func() time.Time
+}
+
+// newRPCRateLimiter returns a rate limiter for the config, or nil if no
+// limits are configured.
+func newRPCRateLimiter(c *config.RPCRateLimitConfig, isServerAddr func(net.IP) bool) (*rpcRateLimiter, error) {
+	if c == nil {
+		return nil, nil
+	}
+	limiters, err := lru.New[string, *rate.Limiter](rpcRateLimitersSize)
+	if err != nil {
+		return nil, err
+	}
+	return &rpcRateLimiter{
+		config:       c.Copy(),
+		limiters:     limiters,
+		isServerAddr: isServerAddr,
+		now:          time.Now,
+	}, nil
+}
+
+// allow returns how long to wait before retrying if the request exceeds its
+// limit, or zero if the request is allowed. Forwarded requests are limited by
+// the identity of their original caller rather than exempted as requests
+// from the forwarding server.
+func (r *rpcRateLimiter) allow(snap *state.StateStore, endpoint, op, namespace string,
+	identity *structs.AuthenticatedIdentity, forwarded bool) time.Duration {
+
+	// Requests made by the cluster itself are never limited: the leader's own
+	// requests, requests from clients authenticated by their node secret, and
+	// requests between servers
+	switch {
+	case identity.GetACLToken() == structs.LeaderACLToken:
+		return 0
+	case identity != nil && identity.ClientID != "":
+		return 0
+	case !forwarded && r.isServer(identity):
+		return 0
+	}
+
+	limit, source := r.limit(snap, endpoint, op, namespace, identity)
+	if limit <= 0 {
+		return 0
+	}
+
+	key := fmt.Sprintf("%s|%s|%s|%s", rateLimitIdentity(identity), endpoint, op, source)
+	limiter, ok := r.limiters.Get(key)
+	if !ok || limiter.Limit() != rate.Limit(limit) {
+		limiter = rate.NewLimiter(rate.Limit(limit), limit)
+		r.limiters.Add(key, limiter)
+	}
+
+	now := r.now()
+	reservation := limiter.ReserveN(now, 1)
+	delay := reservation.DelayFrom(now)
+	if delay == 0 {
+		return 0
+	}
+
+	// Rejected requests don't consume tokens
+	reservation.CancelAt(now)
+	return delay
+}
+
+// rateLimitIdentity returns the name of the identity requests are limited
+// by. Requests made without an ACL token or workload identity, whether
+// anonymous or because ACLs are disabled, are limited by their remote
+// address. Requests without one are made in-process, such as through the HTTP
+// API of a server agent, and share a single limit.
+func rateLimitIdentity(identity *structs.AuthenticatedIdentity) string {
+	if identity == nil {
+		return "local"
+	}
+	token := identity.GetACLToken()
+	if (token == nil || token == structs.AnonymousACLToken) && identity.GetClaims() == nil {
+		if identity.RemoteIP == nil {
+			return "local"
+		}
+		return "ip:" + identity.RemoteIP.String()
+	}
+	return identity.String()
+}
+
+// isServer returns whether the identity was authenticated from a connection
+// made by a server, by the name of its mTLS certificate or by its address.
+func (r *rpcRateLimiter) isServer(identity *structs.AuthenticatedIdentity) bool {
+	if identity == nil {
+		return false
+	}
+	if identity.TLSName != "" {
+		return strings.HasPrefix(identity.TLSName, "server.") &&
+			strings.HasSuffix(identity.TLSName, ".nomad")
+	}
+	return identity.RemoteIP != nil && r.isServerAddr != nil && r.isServerAddr(identity.RemoteIP)
+}
+
+// limit returns the limit of the request and the name of the config block it
+// came from. The first override selecting the request that sets a limit for
+// the operation applies, then the limit of the endpoint, then the default.
+func (r *rpcRateLimiter) limit(snap *state.StateStore, endpoint, op, namespace string,
+	identity *structs.AuthenticatedIdentity) (int, string) {
+
+	for _, o := range r.config.Overrides {
+		if !rateLimitOverrideMatches(snap, o, namespace, identity) {
+			continue
+		}
+		if limit, ok := o.Get(op); ok {
+			return limit, "override:" + o.Name
+		}
+	}
+
+	for _, e := range r.config.Endpoints {
+		if e.Name != endpoint {
+			continue
+		}
+		if limit, ok := e.Get(op); ok {
+			return limit, "endpoint"
+		}
+	}
+
+	limit, _ := r.config.Get(op)
+	return limit, "default"
+}
+
+// rateLimitOverrideMatches returns whether the override selects a request
+// made by the identity to the namespace.
+func rateLimitOverrideMatches(snap *state.StateStore, o *config.RPCRateLimitOverride,
+	namespace string, identity *structs.AuthenticatedIdentity) bool {
+
+	if namespace != "" && slices.Contains(o.Namespaces, namespace) {
+		return true
+	}
+
+	token := identity.GetACLToken()
+	if token == nil {
+		return false
+	}
+	if slices.Contains(o.AccessorIDs, token.AccessorID) {
+		return true
+	}
+
+	// Role names are not stored on tokens, so they must be looked up
+	for _, link := range token.Roles {
+		if slices.Contains(o.Roles, link.ID) {
+			return true
+		}
+		if len(o.Roles) == 0 || snap == nil {
+			continue
+		}
+		role, err := snap.GetACLRoleByID(nil, link.ID)
+		if err == nil && role != nil && slices.Contains(o.Roles, role.Name) {
+			return true
+		}
+	}
+	return false
+}
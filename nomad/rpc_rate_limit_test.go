@@ -0,0 +1,201 @@
+package nomad
+
+import (
+	"net"
+	"testing"
+	"time"
+
+	msgpackrpc "github.com/hashicorp/net-rpc-msgpackrpc"
+	"github.com/hashicorp/nomad/ci"
+	"github.com/hashicorp/nomad/helper/pointer"
+	"github.com/hashicorp/nomad/helper/uuid"
+	"github.com/hashicorp/nomad/nomad/mock"
+	"github.com/hashicorp/nomad/nomad/structs"
+	"github.com/hashicorp/nomad/nomad/structs/config"
+	"github.com/hashicorp/nomad/testutil"
+	"github.com/shoenig/test/must"
+)
+
+func TestRPCRateLimiter_allow(t *testing.T) {
+	ci.Parallel(t)
+
+	serverIP := net.ParseIP("10.0.0.2")
+	limiter, err := newRPCRateLimiter(&config.RPCRateLimitConfig{
+		RateLimits: config.RateLimits{
+			Read:  pointer.Of(2),
+			Write: pointer.Of(1),
+		},
+		Endpoints: []*config.RPCRateLimitEndpoint{{
+			Name:       "node",
+			RateLimits: config.RateLimits{Write: pointer.Of(0)},
+		}},
+		Overrides: []*config.RPCRateLimitOverride{
+			{
+				Name:        "ci",
+				AccessorIDs: []string{"ci-accessor"},
+				RateLimits:  config.RateLimits{Read: pointer.Of(1)},
+			},
+			{
+				Name:       "batch",
+				Namespaces: []string{"batch"},
+				RateLimits: config.RateLimits{Write: pointer.Of(3)},
+			},
+		},
+	}, func(ip net.IP) bool { return ip.Equal(serverIP) })
+	must.NoError(t, err)
+
+	now := time.Now()
+	limiter.now = func() time.Time { return now }
+
+	token := func(accessor string) *structs.AuthenticatedIdentity {
+		return &structs.AuthenticatedIdentity{ACLToken: &structs.ACLToken{AccessorID: accessor}}
+	}
+
+	// Default limits, with a burst of the rate
+	alice := token("alice")
+	must.Zero(t, limiter.allow(nil, "job", "read", "default", alice, false))
+	must.Zero(t, limiter.allow(nil, "job", "read", "default", alice, false))
+	must.Eq(t, 500*time.Millisecond, limiter.allow(nil, "job", "read", "default", alice, false))
+
+	// Rejected requests don't consume tokens, and tokens refill over time
+	now = now.Add(500 * time.Millisecond)
+	must.Zero(t, limiter.allow(nil, "job", "read", "default", alice, false))
+	must.Positive(t, limiter.allow(nil, "job", "read", "default", alice, false))
+
+	// Identities, endpoints and operations are limited separately
+	bob := token("bob")
+	must.Zero(t, limiter.allow(nil, "job", "read", "default", bob, false))
+	must.Zero(t, limiter.allow(nil, "job", "write", "default", alice, false))
+	must.Positive(t, limiter.allow(nil, "job", "write", "default", alice, false))
+	must.Zero(t, limiter.allow(nil, "deployment", "write", "default", alice, false))
+
+	// An endpoint limit of zero is unlimited
+	for i := 0; i < 10; i++ {
+		must.Zero(t, limiter.allow(nil, "node", "write", "default", alice, false))
+	}
+
+	// Overrides by accessor and namespace
+	ciToken := token("ci-accessor")
+	must.Zero(t, limiter.allow(nil, "job", "read", "default", ciToken, false))
+	must.Positive(t, limiter.allow(nil, "job", "read", "default", ciToken, false))
+	for i := 0; i < 3; i++ {
+		must.Zero(t, limiter.allow(nil, "job", "write", "batch", bob, false))
+	}
+	must.Positive(t, limiter.allow(nil, "job", "write", "batch", bob, false))
+
+	// The leader, clients and other servers are never limited
+	leader := &structs.AuthenticatedIdentity{ACLToken: structs.LeaderACLToken}
+	client := &structs.AuthenticatedIdentity{ClientID: uuid.Generate()}
+	tlsServer := &structs.AuthenticatedIdentity{TLSName: "server.global.nomad", RemoteIP: net.ParseIP("10.0.0.3")}
+	server := &structs.AuthenticatedIdentity{RemoteIP: serverIP}
+	for i := 0; i < 10; i++ {
+		must.Zero(t, limiter.allow(nil, "job", "write", "default", leader, false))
+		must.Zero(t, limiter.allow(nil, "job", "write", "default", client, false))
+		must.Zero(t, limiter.allow(nil, "job", "write", "default", tlsServer, false))
+		must.Zero(t, limiter.allow(nil, "job", "write", "default", server, false))
+	}
+
+	// Requests forwarded by servers are limited
+	must.Zero(t, limiter.allow(nil, "job", "write", "default", server, true))
+	must.Positive(t, limiter.allow(nil, "job", "write", "default", server, true))
+
+	// Other callers are limited, even with a certificate for another name
+	other := &structs.AuthenticatedIdentity{TLSName: "cli.global.nomad", RemoteIP: net.ParseIP("10.0.0.4")}
+	must.Zero(t, limiter.allow(nil, "job", "write", "default", other, false))
+	must.Positive(t, limiter.allow(nil, "job", "write", "default", other, false))
+
+	// Anonymous requests and requests made with ACLs disabled are limited by
+	// their remote address
+	anonymous := &structs.AuthenticatedIdentity{ACLToken: structs.AnonymousACLToken, RemoteIP: net.ParseIP("10.0.0.5")}
+	aclsDisabled := &structs.AuthenticatedIdentity{RemoteIP: net.ParseIP("10.0.0.6")}
+	for _, identity := range []*structs.AuthenticatedIdentity{anonymous, aclsDisabled} {
+		must.Zero(t, limiter.allow(nil, "job", "write", "default", identity, false))
+		must.Positive(t, limiter.allow(nil, "job", "write", "default", identity, false))
+	}
+	anonymous = &structs.AuthenticatedIdentity{ACLToken: structs.AnonymousACLToken, RemoteIP: net.ParseIP("10.0.0.7")}
+	must.Zero(t, limiter.allow(nil, "job", "write", "default", anonymous, false))
+}
+
+func TestRPCRateLimiter_overrideRoles(t *testing.T) {
+	ci.Parallel(t)
+
+	s1, cleanupS1 := TestServer(t, nil)
+	defer cleanupS1()
+	testutil.WaitForLeader(t, s1.RPC)
+
+	role := mock.ACLRole()
+	must.NoError(t, s1.State().UpsertACLRoles(
+		structs.MsgTypeTestSetup, 10, []*structs.ACLRole{role}, true))
+
+	override := &config.RPCRateLimitOverride{Name: "role", Roles: []string{role.Name}}
+	identity := &structs.AuthenticatedIdentity{ACLToken: &structs.ACLToken{
+		AccessorID: uuid.Generate(),
+		Roles:      []*structs.ACLTokenRoleLink{{ID: role.ID}},
+	}}
+	must.True(t, rateLimitOverrideMatches(s1.State(), override, "default", identity))
+
+	override.Roles = []string{role.ID}
+	must.True(t, rateLimitOverrideMatches(s1.State(), override, "default", identity))
+
+	override.Roles = []string{"other"}
+	must.False(t, rateLimitOverrideMatches(s1.State(), override, "default", identity))
+}
+
+func TestServer_RPCRateLimit(t *testing.T) {
+	ci.Parallel(t)
+
+	s1, cleanupS1 := TestServer(t, func(c *Config) {
+		c.NumSchedulers = 0 // Prevent automatic dequeue
+		c.RPCRateLimit = &config.RPCRateLimitConfig{
+			RateLimits: config.RateLimits{Write: pointer.Of(1)},
+		}
+	})
+	defer cleanupS1()
+	codec := rpcClient(t, s1)
+	testutil.WaitForLeader(t, s1.RPC)
+
+	register := func() error {
+		job := mock.Job()
+		req := &structs.JobRegisterRequest{
+			Job: job,
+			WriteRequest: structs.WriteRequest{
+				Region:    "global",
+				Namespace: job.Namespace,
+			},
+		}
+		var resp structs.JobRegisterResponse
+		return msgpackrpc.CallWithCodec(codec, "Job.Register", req, &resp)
+	}
+
+	must.NoError(t, register())
+
+	err := register()
+	must.True(t, structs.IsErrRateLimited(err))
+	code, msg, ok := structs.CodeFromRPCCodedErr(err)
+	must.True(t, ok)
+	must.Eq(t, 429, code)
+	retryAfter, ok := structs.RetryAfterFromRateLimitedErr(msg)
+	must.True(t, ok)
+	must.Positive(t, retryAfter)
+
+	// Reads are not limited
+	var listResp structs.JobListResponse
+	must.NoError(t, msgpackrpc.CallWithCodec(codec, "Job.List", &structs.JobListRequest{
+		QueryOptions: structs.QueryOptions{Region: "global", Namespace: "default"},
+	}, &listResp))
+
+	// Clients are identified by their node secret and not limited, even
+	// though ACLs are disabled
+	node := mock.Node()
+	must.NoError(t, s1.State().UpsertNode(structs.MsgTypeTestSetup, 1000, node))
+
+	nodeCodec := rpcClient(t, s1)
+	var nodeResp structs.NodeUpdateResponse
+	for i := 0; i < 5; i++ {
+		must.NoError(t, msgpackrpc.CallWithCodec(nodeCodec, "Node.UpdateStatus", &structs.NodeUpdateStatusRequest{
+			NodeID:       node.ID,
+			Status:       structs.NodeStatusReady,
+			WriteRequest: structs.WriteRequest{Region: "global", AuthToken: node.SecretID},
+		}, &nodeResp))
+	}
+}
@@ -7,17 +7,39 @@ import (
 )
 
 // MeasureRPCRate increments the appropriate rate metric for this endpoint,
-// with a label from the identity
-func (srv *Server) MeasureRPCRate(endpoint, op string, args structs.RequestWithIdentity) {
+// with a label from the identity. If RPC rate limits are configured, it
+// returns an error when the identity has exceeded its limit for the endpoint
+// and the request must be rejected.
+func (srv *Server) MeasureRPCRate(endpoint, op string, args structs.RequestWithIdentity) error {
 	identity := args.GetIdentity()
 
-	if !srv.config.ACLEnabled || identity == nil || srv.config.DisableRPCRateMetricsLabels {
+	var labels []metrics.Label
+	if srv.config.ACLEnabled && identity != nil && !srv.config.DisableRPCRateMetricsLabels {
 		// If ACLs aren't enabled, we never have a sensible identity.
 		// Or the administrator may have disabled the identity labels.
-		metrics.IncrCounter([]string{"nomad", "rpc", endpoint, op}, 1)
-	} else {
-		metrics.IncrCounterWithLabels(
-			[]string{"nomad", "rpc", endpoint, op}, 1,
-			[]metrics.Label{{Name: "identity", Value: identity.String()}})
+		labels = []metrics.Label{{Name: "identity", Value: identity.String()}}
 	}
+	metrics.IncrCounterWithLabels([]string{"nomad", "rpc", endpoint, op}, 1, labels)
+
+	if srv.rpcRateLimiter == nil {
+		return nil
+	}
+
+	var namespace string
+	if req, ok := args.(interface{ RequestNamespace() string }); ok {
+		namespace = req.RequestNamespace()
+	}
+
+	var forwarded bool
+	if req, ok := args.(interface{ IsForwarded() bool }); ok {
+		forwarded = req.IsForwarded()
+	}
+
+	retryAfter := srv.rpcRateLimiter.allow(srv.State(), endpoint, op, namespace, identity, forwarded)
+	if retryAfter == 0 {
+		return nil
+	}
+
+	metrics.IncrCounterWithLabels([]string{"nomad", "rpc", endpoint, op, "rate_limited"}, 1, labels)
+	return structs.NewErrRateLimited(retryAfter)
 }
@@ -32,7 +32,9 @@ func (p *Scaling) ListPolicies(args *structs.ScalingPolicyListRequest, reply *st
 	if done, err := p.srv.forward("Scaling.ListPolicies", args, args, reply); done {
 		return err
 	}
-	p.srv.MeasureRPCRate("scaling", structs.RateMetricList, args)
+	if err := p.srv.MeasureRPCRate("scaling", structs.RateMetricList, args); err != nil {
+		return err
+	}
 	if authErr != nil {
 		return structs.ErrPermissionDenied
 	}
@@ -104,7 +106,9 @@ func (p *Scaling) GetPolicy(args *structs.ScalingPolicySpecificRequest,
 	if done, err := p.srv.forward("Scaling.GetPolicy", args, args, reply); done {
 		return err
 	}
-	p.srv.MeasureRPCRate("scaling", structs.RateMetricRead, args)
+	if err := p.srv.MeasureRPCRate("scaling", structs.RateMetricRead, args); err != nil {
+		return err
+	}
 	if authErr != nil {
 		return structs.ErrPermissionDenied
 	}
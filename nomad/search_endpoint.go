@@ -553,7 +553,9 @@ func (s *Search) PrefixSearch(args *structs.SearchRequest, reply *structs.Search
 	if done, err := s.srv.forward("Search.PrefixSearch", args, args, reply); done {
 		return err
 	}
-	s.srv.MeasureRPCRate("search", structs.RateMetricList, args)
+	if err := s.srv.MeasureRPCRate("search", structs.RateMetricList, args); err != nil {
+		return err
+	}
 	if authErr != nil {
 		return structs.ErrPermissionDenied
 	}
@@ -687,7 +689,9 @@ func (s *Search) FuzzySearch(args *structs.FuzzySearchRequest, reply *structs.Fu
 	if done, err := s.srv.forward("Search.FuzzySearch", args, args, reply); done {
 		return err
 	}
-	s.srv.MeasureRPCRate("search", structs.RateMetricList, args)
+	if err := s.srv.MeasureRPCRate("search", structs.RateMetricList, args); err != nil {
+		return err
+	}
 	if authErr != nil {
 		return structs.ErrPermissionDenied
 	}
@@ -215,6 +215,9 @@ type Server struct {
 	// volumeWatcher is used to release volume claims
 	volumeWatcher *volumewatcher.Watcher
 
+	// eventSinkManager is used to deliver events to event sinks
+	eventSinkManager *eventSinkManager
+
 	// keyringReplicator is used to replicate root encryption keys from the
 	// leader
 	keyringReplicator *KeyringReplicator
@@ -260,6 +263,14 @@ type Server struct {
 	// shutting down, the oidcProviderCache.Shutdown() function must be called.
 	oidcProviderCache *oidc.ProviderCache
 
+	// admissionWebhooks are the external admission webhooks jobs are sent to
+	// when they are registered, planned or validated.
+	admissionWebhooks []*admissionWebhook
+
+	// rpcRateLimiter limits the rate of RPCs per identity. It is nil if no
+	// limits are configured.
+	rpcRateLimiter *rpcRateLimiter
+
 	// leaderAcl is the management ACL token that is valid when resolved by the
 	// current leader.
 	leaderAcl     string
@@ -426,6 +437,20 @@ func NewServer(config *Config, consulCatalog consul.CatalogAPI, consulConfigEntr
 	// when it shuts down itself.
 	s.oidcProviderCache = oidc.NewProviderCache()
 
+	// Set up the external admission webhooks before the job endpoint
+	// can receive jobs.
+	s.admissionWebhooks, err = newAdmissionWebhooks(s.config.AdmissionWebhooks)
+	if err != nil {
+		s.Shutdown()
+		return nil, err
+	}
+
+	s.rpcRateLimiter, err = newRPCRateLimiter(s.config.RPCRateLimit, s.isPeerAddr)
+	if err != nil {
+		s.Shutdown()
+		return nil, err
+	}
+
 	// Initialize the RPC layer
 	if err := s.setupRPC(tlsWrap); err != nil {
 		s.Shutdown()
@@ -473,6 +498,9 @@ func NewServer(config *Config, consulCatalog consul.CatalogAPI, consulConfigEntr
 		return nil, fmt.Errorf("failed to create volume watcher: %v", err)
 	}
 
+	// Setup the event sink manager
+	s.eventSinkManager = newEventSinkManager(s.logger, s.raftApply)
+
 	// Start the eval broker notification system so any subscribers can get
 	// updates when the processes SetEnabled is triggered.
 	go s.evalBroker.enabledNotifier.Run(s.shutdownCh)
@@ -1260,6 +1288,7 @@ func (s *Server) setupRpcServer(server *rpc.Server, ctx *RPCContext) {
 	// These endpoints are client RPCs and don't include a connection context
 	_ = server.Register(NewClientStatsEndpoint(s))
 	_ = server.Register(newNodeMetaEndpoint(s))
+	_ = server.Register(newNodePluginEndpoint(s))
 
 	// These endpoints have their streaming component registered in
 	// setupStreamingEndpoints, but their non-streaming RPCs are registered
@@ -1279,6 +1308,7 @@ func (s *Server) setupRpcServer(server *rpc.Server, ctx *RPCContext) {
 	_ = server.Register(NewCSIPluginEndpoint(s, ctx))
 	_ = server.Register(NewDeploymentEndpoint(s, ctx))
 	_ = server.Register(NewEvalEndpoint(s, ctx))
+	_ = server.Register(NewEventSinkEndpoint(s, ctx))
 	_ = server.Register(NewJobEndpoints(s, ctx))
 	_ = server.Register(NewKeyringEndpoint(s, ctx, s.encrypter))
 	_ = server.Register(NewNamespaceEndpoint(s, ctx))
@@ -1941,6 +1971,25 @@ func (s *Server) Regions() []string {
 	return regions
 }
 
+// isPeerAddr returns whether the IP is the address of another known server,
+// in any region.
+func (s *Server) isPeerAddr(ip net.IP) bool {
+	s.peerLock.RLock()
+	defer s.peerLock.RUnlock()
+
+	for _, servers := range s.peers {
+		for _, parts := range servers {
+			if parts.ID == s.config.NodeID {
+				continue
+			}
+			if addr, ok := parts.Addr.(*net.TCPAddr); ok && addr.IP.Equal(ip) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
 // RPC is used to make a local RPC call
 func (s *Server) RPC(method string, args interface{}, reply interface{}) error {
 	codec := &codec.InmemCodec{
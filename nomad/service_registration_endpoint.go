@@ -46,7 +46,9 @@ func (s *ServiceRegistration) Upsert(
 	if done, err := s.srv.forward(structs.ServiceRegistrationUpsertRPCMethod, args, args, reply); done {
 		return err
 	}
-	s.srv.MeasureRPCRate("service_registration", structs.RateMetricWrite, args)
+	if err := s.srv.MeasureRPCRate("service_registration", structs.RateMetricWrite, args); err != nil {
+		return err
+	}
 	if authErr != nil {
 		return structs.ErrPermissionDenied
 	}
@@ -108,7 +110,9 @@ func (s *ServiceRegistration) DeleteByID(
 	if done, err := s.srv.forward(structs.ServiceRegistrationDeleteByIDRPCMethod, args, args, reply); done {
 		return err
 	}
-	s.srv.MeasureRPCRate("service_registration", structs.RateMetricWrite, args)
+	if err := s.srv.MeasureRPCRate("service_registration", structs.RateMetricWrite, args); err != nil {
+		return err
+	}
 	if authErr != nil {
 		return structs.ErrPermissionDenied
 	}
@@ -201,7 +205,9 @@ func (s *ServiceRegistration) List(
 	if done, err := s.srv.forward(structs.ServiceRegistrationListRPCMethod, args, args, reply); done {
 		return err
 	}
-	s.srv.MeasureRPCRate("service_registration", structs.RateMetricList, args)
+	if err := s.srv.MeasureRPCRate("service_registration", structs.RateMetricList, args); err != nil {
+		return err
+	}
 	if authErr != nil {
 		return structs.ErrPermissionDenied
 	}
@@ -372,7 +378,9 @@ func (s *ServiceRegistration) GetService(
 	if done, err := s.srv.forward(structs.ServiceRegistrationGetServiceRPCMethod, args, args, reply); done {
 		return err
 	}
-	s.srv.MeasureRPCRate("service_registration", structs.RateMetricRead, args)
+	if err := s.srv.MeasureRPCRate("service_registration", structs.RateMetricRead, args); err != nil {
+		return err
+	}
 	if authErr != nil {
 		return structs.ErrPermissionDenied
 	}
@@ -3,6 +3,7 @@ package state
 import (
 	memdb "github.com/hashicorp/go-memdb"
 	"github.com/hashicorp/nomad/nomad/structs"
+	"golang.org/x/exp/maps"
 )
 
 var MsgTypeEvents = map[structs.MessageType]string{
@@ -25,6 +26,7 @@ var MsgTypeEvents = map[structs.MessageType]string{
 	structs.ApplyPlanResultsRequestType:                  structs.TypePlanResult,
 	structs.ACLTokenDeleteRequestType:                    structs.TypeACLTokenDeleted,
 	structs.ACLTokenUpsertRequestType:                    structs.TypeACLTokenUpserted,
+	structs.ACLTokenExpiryNotificationsRequestType:       structs.TypeACLTokenExpiring,
 	structs.ACLPolicyDeleteRequestType:                   structs.TypeACLPolicyDeleted,
 	structs.ACLPolicyUpsertRequestType:                   structs.TypeACLPolicyUpserted,
 	structs.ACLRolesDeleteByIDRequestType:                structs.TypeACLRoleDeleted,
@@ -36,6 +38,16 @@ var MsgTypeEvents = map[structs.MessageType]string{
 	structs.ServiceRegistrationUpsertRequestType:         structs.TypeServiceRegistration,
 	structs.ServiceRegistrationDeleteByIDRequestType:     structs.TypeServiceDeregistration,
 	structs.ServiceRegistrationDeleteByNodeIDRequestType: structs.TypeServiceDeregistration,
+	structs.VarApplyStateRequestType:                     structs.TypeVariableUpserted,
+	structs.NamespaceUpsertRequestType:                   structs.TypeNamespaceUpserted,
+	structs.NamespaceDeleteRequestType:                   structs.TypeNamespaceDeleted,
+	structs.CSIVolumeRegisterRequestType:                 structs.TypeCSIVolumeRegistered,
+	structs.CSIVolumeDeregisterRequestType:               structs.TypeCSIVolumeDeregistered,
+	structs.CSIVolumeClaimRequestType:                    structs.TypeCSIVolumeClaim,
+	structs.CSIVolumeClaimBatchRequestType:               structs.TypeCSIVolumeClaim,
+	structs.CSIPluginDeleteRequestType:                   structs.TypeCSIPluginDeleted,
+	structs.RootKeyMetaUpsertRequestType:                 structs.TypeRootKeyMetaUpserted,
+	structs.RootKeyMetaDeleteRequestType:                 structs.TypeRootKeyMetaDeleted,
 }
 
 func eventsFromChanges(tx ReadTxn, changes Changes) *structs.Events {
@@ -46,8 +58,20 @@ func eventsFromChanges(tx ReadTxn, changes Changes) *structs.Events {
 
 	var events []structs.Event
 	for _, change := range changes.Changes {
-		if event, ok := eventFromChange(change); ok {
-			event.Type = eventType
+		event, ok := eventFromChange(change)
+
+		// Expiry notifications only record the accessor ID of the token, so
+		// the token is looked up for the event payload.
+		if change.Table == TableACLTokenExpiryNotifications {
+			event, ok = aclTokenExpiringEvent(tx, change)
+		}
+
+		if ok {
+			// Objects which are changed alongside others, such as the scaling
+			// policies of a job, set their own event type
+			if event.Type == "" {
+				event.Type = eventType
+			}
 			event.Index = changes.Index
 			events = append(events, event)
 		}
@@ -56,6 +80,29 @@ func eventsFromChanges(tx ReadTxn, changes Changes) *structs.Events {
 	return &structs.Events{Index: changes.Index, Events: events}
 }
 
+// aclTokenExpiringEvent returns the event for an inserted ACL token expiry
+// notification, if the token still exists.
+func aclTokenExpiringEvent(tx ReadTxn, change memdb.Change) (structs.Event, bool) {
+	if change.Deleted() {
+		return structs.Event{}, false
+	}
+	after, ok := change.After.(*structs.ACLTokenExpiryNotification)
+	if !ok {
+		return structs.Event{}, false
+	}
+
+	raw, err := tx.First("acl_token", indexID, after.AccessorID)
+	if err != nil || raw == nil {
+		return structs.Event{}, false
+	}
+
+	return structs.Event{
+		Topic:   structs.TopicACLToken,
+		Key:     after.AccessorID,
+		Payload: structs.NewACLTokenEvent(raw.(*structs.ACLToken)),
+	}, true
+}
+
 func eventFromChange(change memdb.Change) (structs.Event, bool) {
 	if change.Deleted() {
 		switch change.Table {
@@ -151,6 +198,50 @@ func eventFromChange(change memdb.Change) (structs.Event, bool) {
 					Service: before,
 				},
 			}, true
+		case TableVariables:
+			before, ok := change.Before.(*structs.VariableEncrypted)
+			if !ok {
+				return structs.Event{}, false
+			}
+			event := variableEvent(before)
+			event.Type = structs.TypeVariableDeleted
+			return event, true
+		case TableNamespaces:
+			before, ok := change.Before.(*structs.Namespace)
+			if !ok {
+				return structs.Event{}, false
+			}
+			return namespaceEvent(before), true
+		case "csi_volumes":
+			before, ok := change.Before.(*structs.CSIVolume)
+			if !ok {
+				return structs.Event{}, false
+			}
+			event := csiVolumeEvent(before)
+			event.Type = structs.TypeCSIVolumeDeregistered
+			return event, true
+		case "csi_plugins":
+			before, ok := change.Before.(*structs.CSIPlugin)
+			if !ok {
+				return structs.Event{}, false
+			}
+			event := csiPluginEvent(before)
+			event.Type = structs.TypeCSIPluginDeleted
+			return event, true
+		case "scaling_policy":
+			before, ok := change.Before.(*structs.ScalingPolicy)
+			if !ok {
+				return structs.Event{}, false
+			}
+			event := scalingPolicyEvent(before)
+			event.Type = structs.TypeScalingPolicyDeleted
+			return event, true
+		case TableRootKeyMeta:
+			before, ok := change.Before.(*structs.RootKeyMeta)
+			if !ok {
+				return structs.Event{}, false
+			}
+			return rootKeyMetaEvent(before), true
 		}
 		return structs.Event{}, false
 	}
@@ -316,7 +407,144 @@ func eventFromChange(change memdb.Change) (structs.Event, bool) {
 				Service: after,
 			},
 		}, true
+	case TableVariables:
+		after, ok := change.After.(*structs.VariableEncrypted)
+		if !ok {
+			return structs.Event{}, false
+		}
+		return variableEvent(after), true
+	case TableNamespaces:
+		after, ok := change.After.(*structs.Namespace)
+		if !ok {
+			return structs.Event{}, false
+		}
+		return namespaceEvent(after), true
+	case "csi_volumes":
+		after, ok := change.After.(*structs.CSIVolume)
+		if !ok {
+			return structs.Event{}, false
+		}
+		// Volumes are also written by plans, allocation updates and job or
+		// node deregistrations, which only ever change their claims
+		event := csiVolumeEvent(after)
+		event.Type = structs.TypeCSIVolumeRegistered
+		if before, ok := change.Before.(*structs.CSIVolume); ok && csiVolumeClaimsChanged(before, after) {
+			event.Type = structs.TypeCSIVolumeClaim
+		}
+		return event, true
+	case "csi_plugins":
+		after, ok := change.After.(*structs.CSIPlugin)
+		if !ok {
+			return structs.Event{}, false
+		}
+		event := csiPluginEvent(after)
+		event.Type = structs.TypeCSIPluginUpserted
+		return event, true
+	case "scaling_policy":
+		after, ok := change.After.(*structs.ScalingPolicy)
+		if !ok {
+			return structs.Event{}, false
+		}
+		event := scalingPolicyEvent(after)
+		event.Type = structs.TypeScalingPolicyUpserted
+		return event, true
+	case TableRootKeyMeta:
+		after, ok := change.After.(*structs.RootKeyMeta)
+		if !ok {
+			return structs.Event{}, false
+		}
+		return rootKeyMetaEvent(after), true
 	}
 
 	return structs.Event{}, false
 }
+
+// variableEvent returns an event with the metadata of the variable. The
+// encrypted items are never included in events.
+func variableEvent(variable *structs.VariableEncrypted) structs.Event {
+	meta := variable.VariableMetadata
+	return structs.Event{
+		Topic:     structs.TopicVariables,
+		Key:       meta.Path,
+		Namespace: meta.Namespace,
+		Payload: &structs.VariableEvent{
+			Variable: &meta,
+		},
+	}
+}
+
+// namespaceEvent returns an event for the namespace. The namespace of the
+// event is the namespace itself, so that subscribers only receive events for
+// the namespaces they can read.
+func namespaceEvent(ns *structs.Namespace) structs.Event {
+	return structs.Event{
+		Topic:     structs.TopicNamespace,
+		Key:       ns.Name,
+		Namespace: ns.Name,
+		Payload: &structs.NamespaceEvent{
+			Namespace: ns,
+		},
+	}
+}
+
+// csiVolumeEvent returns an event for a copy of the volume with its secrets
+// removed.
+func csiVolumeEvent(vol *structs.CSIVolume) structs.Event {
+	vol = vol.Copy()
+	vol.Secrets = nil
+	return structs.Event{
+		Topic:      structs.TopicCSIVolume,
+		Key:        vol.ID,
+		Namespace:  vol.Namespace,
+		FilterKeys: []string{vol.PluginID},
+		Payload: &structs.CSIVolumeEvent{
+			Volume: vol,
+		},
+	}
+}
+
+// csiVolumeClaimsChanged returns whether any of the claims of the volume
+// differ between the two versions.
+func csiVolumeClaimsChanged(before, after *structs.CSIVolume) bool {
+	claimEqual := func(a, b *structs.CSIVolumeClaim) bool {
+		if a == nil || b == nil {
+			return a == b
+		}
+		return *a == *b
+	}
+	return !maps.EqualFunc(before.ReadClaims, after.ReadClaims, claimEqual) ||
+		!maps.EqualFunc(before.WriteClaims, after.WriteClaims, claimEqual) ||
+		!maps.EqualFunc(before.PastClaims, after.PastClaims, claimEqual)
+}
+
+func csiPluginEvent(plugin *structs.CSIPlugin) structs.Event {
+	return structs.Event{
+		Topic: structs.TopicCSIPlugin,
+		Key:   plugin.ID,
+		Payload: &structs.CSIPluginEvent{
+			Plugin: plugin,
+		},
+	}
+}
+
+func scalingPolicyEvent(policy *structs.ScalingPolicy) structs.Event {
+	return structs.Event{
+		Topic:      structs.TopicScalingPolicy,
+		Key:        policy.ID,
+		Namespace:  policy.Target[structs.ScalingTargetNamespace],
+		FilterKeys: []string{policy.Target[structs.ScalingTargetJob]},
+		Payload: &structs.ScalingPolicyEvent{
+			ScalingPolicy: policy,
+		},
+	}
+}
+
+func rootKeyMetaEvent(meta *structs.RootKeyMeta) structs.Event {
+	return structs.Event{
+		Topic: structs.TopicRootKey,
+		Key:   meta.KeyID,
+		Payload: &structs.RootKeyMetaEvent{
+			RootKeyMeta: meta,
+		},
+	}
+}
@@ -1,6 +1,7 @@
 package state
 
 import (
+	"context"
 	"testing"
 	"time"
 
@@ -9,6 +10,7 @@ import (
 	"github.com/hashicorp/nomad/helper/pointer"
 	"github.com/hashicorp/nomad/helper/uuid"
 	"github.com/hashicorp/nomad/nomad/mock"
+	"github.com/hashicorp/nomad/nomad/stream"
 	"github.com/hashicorp/nomad/nomad/structs"
 	"github.com/shoenig/test/must"
 	"github.com/stretchr/testify/require"
@@ -43,6 +45,54 @@ func TestEventFromChange_SingleEventPerTable(t *testing.T) {
 	require.Equal(t, out.Events[0].Type, structs.TypeJobRegistered)
 }
 
+func TestEventsFromChanges_ACLTokenExpiring(t *testing.T) {
+	ci.Parallel(t)
+	s := TestStateStoreCfg(t, TestStateStorePublisher(t))
+	defer s.StopEventBroker()
+
+	token := mock.ACLToken()
+	token.ExpirationTime = pointer.Of(time.Now().UTC().Add(10 * time.Minute))
+	require.NoError(t, s.UpsertACLTokens(structs.MsgTypeTestSetup, 100, []*structs.ACLToken{token}))
+
+	// Notifications of deleted tokens don't publish events
+	deleted := uuid.Generate()
+	changes := Changes{
+		Index:   110,
+		MsgType: structs.ACLTokenExpiryNotificationsRequestType,
+		Changes: memdb.Changes{
+			{
+				Table: TableACLTokenExpiryNotifications,
+				After: &structs.ACLTokenExpiryNotification{
+					AccessorID:     token.AccessorID,
+					ExpirationTime: *token.ExpirationTime,
+				},
+			},
+			{
+				Table: TableACLTokenExpiryNotifications,
+				After: &structs.ACLTokenExpiryNotification{AccessorID: deleted},
+			},
+			{
+				Table:  TableACLTokenExpiryNotifications,
+				Before: &structs.ACLTokenExpiryNotification{AccessorID: uuid.Generate()},
+			},
+		},
+	}
+
+	out := eventsFromChanges(s.db.ReadTxn(), changes)
+	require.Len(t, out.Events, 1)
+
+	event := out.Events[0]
+	require.Equal(t, structs.TopicACLToken, event.Topic)
+	require.Equal(t, structs.TypeACLTokenExpiring, event.Type)
+	require.Equal(t, token.AccessorID, event.Key)
+	require.Equal(t, uint64(110), event.Index)
+
+	tokenEvent, ok := event.Payload.(*structs.ACLTokenEvent)
+	require.True(t, ok)
+	require.Equal(t, token.AccessorID, tokenEvent.ACLToken.AccessorID)
+	require.Empty(t, tokenEvent.ACLToken.SecretID)
+}
+
 func TestEventFromChange_ACLTokenSecretID(t *testing.T) {
 	ci.Parallel(t)
 	s := TestStateStoreCfg(t, TestStateStorePublisher(t))
@@ -1213,3 +1263,166 @@ func testNodeID() string {
 func testNodeIDTwo() string {
 	return "694ff31d-8c59-4030-ac83-e15692560c8d"
 }
+
+func Test_eventsFromChanges_Variables(t *testing.T) {
+	ci.Parallel(t)
+	testState := TestStateStoreCfg(t, TestStateStorePublisher(t))
+	defer testState.StopEventBroker()
+
+	sv := mock.VariableEncrypted()
+
+	writeTxn := testState.db.WriteTxn(10)
+	resp := testState.varSetTxn(writeTxn, 10, &structs.VarApplyStateRequest{
+		Op: structs.VarOpSet, Var: sv})
+	must.NoError(t, resp.Error)
+	must.NoError(t, writeTxn.Commit())
+
+	upsertChange := Changes{Changes: writeTxn.Changes(), Index: 10, MsgType: structs.VarApplyStateRequestType}
+	received := eventsFromChanges(writeTxn, upsertChange)
+
+	// Only the metadata of the variable is sent
+	must.Len(t, 1, received.Events)
+	event := received.Events[0]
+	must.Eq(t, structs.TopicVariables, event.Topic)
+	must.Eq(t, structs.TypeVariableUpserted, event.Type)
+	must.Eq(t, sv.Path, event.Key)
+	must.Eq(t, sv.Namespace, event.Namespace)
+	payload := event.Payload.(*structs.VariableEvent)
+	must.Eq(t, sv.Path, payload.Variable.Path)
+	must.Eq(t, 10, payload.Variable.ModifyIndex)
+
+	deleteTxn := testState.db.WriteTxn(20)
+	resp = testState.svDeleteTxn(deleteTxn, 20, &structs.VarApplyStateRequest{
+		Op: structs.VarOpDelete, Var: sv})
+	must.NoError(t, resp.Error)
+	must.NoError(t, deleteTxn.Commit())
+
+	deleteChange := Changes{Changes: deleteTxn.Changes(), Index: 20, MsgType: structs.VarApplyStateRequestType}
+	received = eventsFromChanges(deleteTxn, deleteChange)
+	must.Len(t, 1, received.Events)
+	must.Eq(t, structs.TypeVariableDeleted, received.Events[0].Type)
+	must.Eq(t, sv.Path, received.Events[0].Key)
+}
+
+func Test_eventsFromChanges_ScalingPolicy(t *testing.T) {
+	ci.Parallel(t)
+	testState := TestStateStoreCfg(t, TestStateStorePublisher(t))
+	defer testState.StopEventBroker()
+
+	job, policy := mock.JobWithScalingPolicy()
+
+	writeTxn := testState.db.WriteTxn(10)
+	must.NoError(t, testState.upsertJobImpl(10, job, false, writeTxn))
+	must.NoError(t, writeTxn.Commit())
+
+	// Scaling policies are registered alongside their job, but have their
+	// own event type
+	change := Changes{Changes: writeTxn.Changes(), Index: 10, MsgType: structs.JobRegisterRequestType}
+	received := eventsFromChanges(writeTxn, change)
+
+	var policyEvents []structs.Event
+	for _, event := range received.Events {
+		if event.Topic == structs.TopicScalingPolicy {
+			policyEvents = append(policyEvents, event)
+		} else {
+			must.Eq(t, structs.TypeJobRegistered, event.Type)
+		}
+	}
+	must.Len(t, 1, policyEvents)
+	must.Eq(t, structs.TypeScalingPolicyUpserted, policyEvents[0].Type)
+	must.Eq(t, job.Namespace, policyEvents[0].Namespace)
+	must.Eq(t, []string{job.ID}, policyEvents[0].FilterKeys)
+	must.Eq(t, policy.Target, policyEvents[0].Payload.(*structs.ScalingPolicyEvent).ScalingPolicy.Target)
+}
+
+func Test_eventsFromChanges_CSIVolume(t *testing.T) {
+	ci.Parallel(t)
+	testState := TestStateStoreCfg(t, TestStateStorePublisher(t))
+	defer testState.StopEventBroker()
+
+	vol := mock.CSIVolume(mock.CSIPlugin())
+	vol.Secrets = structs.CSISecrets{"password": "hunter2"}
+
+	writeTxn := testState.db.WriteTxn(10)
+	must.NoError(t, writeTxn.Insert("csi_volumes", vol))
+	must.NoError(t, writeTxn.Commit())
+
+	change := Changes{Changes: writeTxn.Changes(), Index: 10, MsgType: structs.CSIVolumeRegisterRequestType}
+	received := eventsFromChanges(writeTxn, change)
+
+	// The secrets of the volume are never sent
+	must.Len(t, 1, received.Events)
+	must.Eq(t, structs.TypeCSIVolumeRegistered, received.Events[0].Type)
+	must.Eq(t, []string{vol.PluginID}, received.Events[0].FilterKeys)
+	must.MapEmpty(t, received.Events[0].Payload.(*structs.CSIVolumeEvent).Volume.Secrets)
+	must.MapLen(t, 1, vol.Secrets)
+
+	// Claims written alongside other objects, such as by a plan, are
+	// reported as claims rather than with the type of the request
+	claimed := vol.Copy()
+	claimed.WriteClaims = map[string]*structs.CSIVolumeClaim{
+		"alloc": {AllocationID: "alloc", Mode: structs.CSIVolumeClaimWrite},
+	}
+	writeTxn = testState.db.WriteTxn(11)
+	must.NoError(t, writeTxn.Insert("csi_volumes", claimed))
+	must.NoError(t, writeTxn.Commit())
+
+	change = Changes{Changes: writeTxn.Changes(), Index: 11, MsgType: structs.ApplyPlanResultsRequestType}
+	received = eventsFromChanges(writeTxn, change)
+	must.Len(t, 1, received.Events)
+	must.Eq(t, structs.TopicCSIVolume, received.Events[0].Topic)
+	must.Eq(t, structs.TypeCSIVolumeClaim, received.Events[0].Type)
+
+	// Deleting a volume is always a deregistration
+	writeTxn = testState.db.WriteTxn(12)
+	must.NoError(t, writeTxn.Delete("csi_volumes", claimed))
+	must.NoError(t, writeTxn.Commit())
+
+	change = Changes{Changes: writeTxn.Changes(), Index: 12, MsgType: structs.JobDeregisterRequestType}
+	received = eventsFromChanges(writeTxn, change)
+	must.Len(t, 1, received.Events)
+	must.Eq(t, structs.TypeCSIVolumeDeregistered, received.Events[0].Type)
+}
+
+// TestStateStore_PublishesEvents ensures that the state store methods used by
+// the FSM publish events for the topics whose tables they write to.
+func TestStateStore_PublishesEvents(t *testing.T) {
+	ci.Parallel(t)
+	testState := TestStateStoreCfg(t, TestStateStorePublisher(t))
+	defer testState.StopEventBroker()
+
+	broker, err := testState.EventBroker()
+	must.NoError(t, err)
+	sub, err := broker.Subscribe(&stream.SubscribeRequest{
+		Topics:    map[structs.Topic][]string{structs.TopicAll: {"*"}},
+		Namespace: "*",
+	})
+	must.NoError(t, err)
+	defer sub.Unsubscribe()
+
+	ns := mock.Namespace()
+	must.NoError(t, testState.UpsertNamespaces(10, []*structs.Namespace{ns}))
+	sv := mock.VariableEncrypted()
+	resp := testState.VarSet(20, &structs.VarApplyStateRequest{Op: structs.VarOpSet, Var: sv})
+	must.NoError(t, resp.Error)
+	must.NoError(t, testState.UpsertRootKeyMeta(30, structs.NewRootKeyMeta(), false))
+	must.NoError(t, testState.DeleteNamespaces(40, []string{ns.Name}))
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	var types []string
+	for len(types) < 4 {
+		events, err := sub.Next(ctx)
+		must.NoError(t, err)
+		for _, event := range events.Events {
+			types = append(types, event.Type)
+		}
+	}
+	must.Eq(t, []string{
+		structs.TypeNamespaceUpserted,
+		structs.TypeVariableUpserted,
+		structs.TypeRootKeyMetaUpserted,
+		structs.TypeNamespaceDeleted,
+	}, types)
+}
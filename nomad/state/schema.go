@@ -12,15 +12,17 @@ import (
 const (
 	tableIndex = "index"
 
-	TableNamespaces           = "namespaces"
-	TableServiceRegistrations = "service_registrations"
-	TableVariables            = "variables"
-	TableVariablesQuotas      = "variables_quota"
-	TableRootKeyMeta          = "root_key_meta"
-	TableACLRoles             = "acl_roles"
-	TableACLAuthMethods       = "acl_auth_methods"
-	TableACLBindingRules      = "acl_binding_rules"
-	TableAllocs               = "allocs"
+	TableNamespaces                  = "namespaces"
+	TableServiceRegistrations        = "service_registrations"
+	TableVariables                   = "variables"
+	TableVariablesQuotas             = "variables_quota"
+	TableRootKeyMeta                 = "root_key_meta"
+	TableACLRoles                    = "acl_roles"
+	TableACLAuthMethods              = "acl_auth_methods"
+	TableACLBindingRules             = "acl_binding_rules"
+	TableEventSinks                  = "event_sinks"
+	TableACLTokenExpiryNotifications = "acl_token_expiry_notifications"
+	TableAllocs                      = "allocs"
 )
 
 const (
@@ -90,6 +92,8 @@ func init() {
 		aclRolesTableSchema,
 		aclAuthMethodsTableSchema,
 		bindingRulesTableSchema,
+		eventSinksTableSchema,
+		aclTokenExpiryNotificationsTableSchema,
 	}...)
 }
 
@@ -1561,3 +1565,38 @@ func bindingRulesTableSchema() *memdb.TableSchema {
 		},
 	}
 }
+
+// eventSinksTableSchema returns the MemDB schema for the event sinks table.
+func eventSinksTableSchema() *memdb.TableSchema {
+	return &memdb.TableSchema{
+		Name: TableEventSinks,
+		Indexes: map[string]*memdb.IndexSchema{
+			indexID: {
+				Name:         indexID,
+				AllowMissing: false,
+				Unique:       true,
+				Indexer: &memdb.StringFieldIndex{
+					Field: "ID",
+				},
+			},
+		},
+	}
+}
+
+// aclTokenExpiryNotificationsTableSchema returns the MemDB schema for the
+// table recording which expiring ACL tokens were notified about.
+func aclTokenExpiryNotificationsTableSchema() *memdb.TableSchema {
+	return &memdb.TableSchema{
+		Name: TableACLTokenExpiryNotifications,
+		Indexes: map[string]*memdb.IndexSchema{
+			indexID: {
+				Name:         indexID,
+				AllowMissing: false,
+				Unique:       true,
+				Indexer: &memdb.UUIDFieldIndex{
+					Field: "AccessorID",
+				},
+			},
+		},
+	}
+}
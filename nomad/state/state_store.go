@@ -186,11 +186,19 @@ func (s *StateStore) namespaceInit() error {
 		Description: structs.DefaultNamespaceDescription,
 	}
 
-	if err := s.UpsertNamespaces(1, []*structs.Namespace{defaultNs}); err != nil {
+	// The default namespace isn't written by a Raft log, so it doesn't
+	// publish an event.
+	txn := s.db.WriteTxn(1)
+	defer txn.Abort()
+
+	if err := s.upsertNamespaceImpl(1, txn, defaultNs); err != nil {
 		return fmt.Errorf("inserting default namespace failed: %v", err)
 	}
+	if err := txn.Insert("index", &IndexEntry{TableNamespaces, 1}); err != nil {
+		return fmt.Errorf("index update failed: %v", err)
+	}
 
-	return nil
+	return txn.Commit()
 }
 
 // Config returns the state store configuration.
@@ -2251,7 +2259,7 @@ func (s *StateStore) JobSummaryByPrefix(ws memdb.WatchSet, namespace, id string)
 
 // UpsertCSIVolume inserts a volume in the state store.
 func (s *StateStore) UpsertCSIVolume(index uint64, volumes []*structs.CSIVolume) error {
-	txn := s.db.WriteTxn(index)
+	txn := s.db.WriteTxnMsgT(structs.CSIVolumeRegisterRequestType, index)
 	defer txn.Abort()
 
 	for _, v := range volumes {
@@ -2481,7 +2489,7 @@ func (s *StateStore) csiVolumesByNamespaceImpl(txn *txn, ws memdb.WatchSet, name
 
 // CSIVolumeClaim updates the volume's claim count and allocation list
 func (s *StateStore) CSIVolumeClaim(index uint64, namespace, id string, claim *structs.CSIVolumeClaim) error {
-	txn := s.db.WriteTxn(index)
+	txn := s.db.WriteTxnMsgT(structs.CSIVolumeClaimRequestType, index)
 	defer txn.Abort()
 
 	row, err := txn.First("csi_volumes", "id", namespace, id)
@@ -2557,7 +2565,7 @@ func (s *StateStore) CSIVolumeClaim(index uint64, namespace, id string, claim *s
 
 // CSIVolumeDeregister removes the volume from the server
 func (s *StateStore) CSIVolumeDeregister(index uint64, namespace string, ids []string, force bool) error {
-	txn := s.db.WriteTxn(index)
+	txn := s.db.WriteTxnMsgT(structs.CSIVolumeDeregisterRequestType, index)
 	defer txn.Abort()
 
 	for _, id := range ids {
@@ -2889,7 +2897,7 @@ func (s *StateStore) UpsertCSIPlugin(index uint64, plug *structs.CSIPlugin) erro
 
 // DeleteCSIPlugin deletes the plugin if it's not in use.
 func (s *StateStore) DeleteCSIPlugin(index uint64, id string) error {
-	txn := s.db.WriteTxn(index)
+	txn := s.db.WriteTxnMsgT(structs.CSIPluginDeleteRequestType, index)
 	defer txn.Abort()
 
 	plug, err := s.CSIPluginByIDTxn(txn, nil, id)
@@ -6471,7 +6479,7 @@ func (s *StateStore) NamespaceNames() ([]string, error) {
 
 // UpsertNamespaces is used to register or update a set of namespaces.
 func (s *StateStore) UpsertNamespaces(index uint64, namespaces []*structs.Namespace) error {
-	txn := s.db.WriteTxn(index)
+	txn := s.db.WriteTxnMsgT(structs.NamespaceUpsertRequestType, index)
 	defer txn.Abort()
 
 	for _, ns := range namespaces {
@@ -6538,7 +6546,7 @@ func (s *StateStore) upsertNamespaceImpl(index uint64, txn *txn, namespace *stru
 
 // DeleteNamespaces is used to remove a set of namespaces
 func (s *StateStore) DeleteNamespaces(index uint64, names []string) error {
-	txn := s.db.WriteTxn(index)
+	txn := s.db.WriteTxnMsgT(structs.NamespaceDeleteRequestType, index)
 	defer txn.Abort()
 
 	for _, name := range names {
@@ -6922,7 +6930,7 @@ func getPreemptedAllocDesiredDescription(preemptedByAllocID string) string {
 
 // UpsertRootKeyMeta saves root key meta or updates it in-place.
 func (s *StateStore) UpsertRootKeyMeta(index uint64, rootKeyMeta *structs.RootKeyMeta, rekey bool) error {
-	txn := s.db.WriteTxn(index)
+	txn := s.db.WriteTxnMsgT(structs.RootKeyMetaUpsertRequestType, index)
 	defer txn.Abort()
 
 	// get any existing key for updating
@@ -7004,7 +7012,7 @@ func (s *StateStore) UpsertRootKeyMeta(index uint64, rootKeyMeta *structs.RootKe
 // DeleteRootKeyMeta deletes a single root key, or returns an error if
 // it doesn't exist.
 func (s *StateStore) DeleteRootKeyMeta(index uint64, keyID string) error {
-	txn := s.db.WriteTxn(index)
+	txn := s.db.WriteTxnMsgT(structs.RootKeyMetaDeleteRequestType, index)
 	defer txn.Abort()
 
 	// find the old key
@@ -3,6 +3,7 @@ package state
 import (
 	"errors"
 	"fmt"
+	"time"
 
 	"github.com/hashicorp/go-memdb"
 	"github.com/hashicorp/nomad/nomad/structs"
@@ -26,6 +27,90 @@ func (s *StateStore) ACLTokensByExpired(global bool) (memdb.ResultIterator, erro
 	return iter, nil
 }
 
+// ACLTokensByExpiration returns an iterator over the ACL tokens which expire
+// at or after the passed time.Time value, ordered by their expiration time.
+// Tokens without an expiration time are not included.
+//
+// The function handles global and local tokens independently as determined by
+// the global boolean argument.
+func (s *StateStore) ACLTokensByExpiration(
+	ws memdb.WatchSet, global bool, after time.Time) (memdb.ResultIterator, error) {
+
+	txn := s.db.ReadTxn()
+
+	iter, err := txn.Get("acl_token", expiresIndexName(global))
+	if err != nil {
+		return nil, fmt.Errorf("failed acl token listing: %v", err)
+	}
+	ws.Add(iter.WatchCh())
+
+	// Expired tokens are only kept until they are garbage collected, so there
+	// are few of them to skip.
+	return memdb.NewFilterIterator(iter, func(raw interface{}) bool {
+		token, ok := raw.(*structs.ACLToken)
+		if !ok {
+			return true
+		}
+		return token.ExpirationTime.Before(after)
+	}), nil
+}
+
+// UpsertACLTokenExpiryNotifications records the ACL tokens which expiring
+// events are published for, and deletes the notifications of the passed
+// forget accessor IDs. The events are published by the event stream as the
+// notifications are inserted.
+func (s *StateStore) UpsertACLTokenExpiryNotifications(msgType structs.MessageType, index uint64,
+	notifications []*structs.ACLTokenExpiryNotification, forget []string) error {
+
+	txn := s.db.WriteTxnMsgT(msgType, index)
+	defer txn.Abort()
+
+	for _, notification := range notifications {
+		existing, err := txn.First(TableACLTokenExpiryNotifications, indexID, notification.AccessorID)
+		if err != nil {
+			return fmt.Errorf("ACL token expiry notification lookup failed: %v", err)
+		}
+
+		if existing != nil {
+			notification.CreateIndex = existing.(*structs.ACLTokenExpiryNotification).CreateIndex
+		} else {
+			notification.CreateIndex = index
+		}
+		notification.ModifyIndex = index
+
+		if err := txn.Insert(TableACLTokenExpiryNotifications, notification); err != nil {
+			return fmt.Errorf("ACL token expiry notification insert failed: %v", err)
+		}
+	}
+
+	for _, accessorID := range forget {
+		if _, err := txn.DeleteAll(TableACLTokenExpiryNotifications, indexID, accessorID); err != nil {
+			return fmt.Errorf("ACL token expiry notification deletion failed: %v", err)
+		}
+	}
+
+	// Update the index table to indicate an update has occurred.
+	if err := txn.Insert(tableIndex, &IndexEntry{TableACLTokenExpiryNotifications, index}); err != nil {
+		return fmt.Errorf("index update failed: %v", err)
+	}
+
+	return txn.Commit()
+}
+
+// ACLTokenExpiryNotifications returns an iterator over the notifications
+// recording which expiring ACL tokens were notified about.
+func (s *StateStore) ACLTokenExpiryNotifications(ws memdb.WatchSet) (memdb.ResultIterator, error) {
+	txn := s.db.ReadTxn()
+
+	iter, err := txn.Get(TableACLTokenExpiryNotifications, indexID)
+	if err != nil {
+		return nil, fmt.Errorf("ACL token expiry notification lookup failed: %v", err)
+	}
+	ws.Add(iter.WatchCh())
+
+	return iter, nil
+}
+
 // expiresIndexName is a helper function to identify the correct ACL token
 // table expiry index to use.
 func expiresIndexName(global bool) string {
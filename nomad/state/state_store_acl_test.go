@@ -126,6 +126,105 @@ func TestStateStore_ACLTokensByExpired(t *testing.T) {
 	testFn(expiredGlobalToken, true)
 }
 
+func TestStateStore_ACLTokensByExpiration(t *testing.T) {
+	ci.Parallel(t)
+	testState := testStateStore(t)
+
+	now := time.Date(2022, time.April, 27, 14, 50, 0, 0, time.UTC)
+
+	expiredToken := mock.ACLToken()
+	expiredToken.ExpirationTime = pointer.Of(now.Add(-time.Hour))
+	laterToken := mock.ACLToken()
+	laterToken.ExpirationTime = pointer.Of(now.Add(2 * time.Hour))
+	soonToken := mock.ACLToken()
+	soonToken.ExpirationTime = pointer.Of(now.Add(time.Hour))
+	globalToken := mock.ACLToken()
+	globalToken.Global = true
+	globalToken.ExpirationTime = pointer.Of(now.Add(time.Hour))
+	neverExpireToken := mock.ACLToken()
+
+	must.NoError(t, testState.UpsertACLTokens(structs.MsgTypeTestSetup, 10, []*structs.ACLToken{
+		expiredToken, laterToken, soonToken, globalToken, neverExpireToken}))
+
+	accessorIDs := func(iter memdb.ResultIterator) []string {
+		var ids []string
+		for raw := iter.Next(); raw != nil; raw = iter.Next() {
+			ids = append(ids, raw.(*structs.ACLToken).AccessorID)
+		}
+		return ids
+	}
+
+	// Local tokens are ordered by their expiration time, and expired tokens
+	// are skipped.
+	ws := memdb.NewWatchSet()
+	iter, err := testState.ACLTokensByExpiration(ws, false, now)
+	must.NoError(t, err)
+	must.Eq(t, []string{soonToken.AccessorID, laterToken.AccessorID}, accessorIDs(iter))
+
+	iter, err = testState.ACLTokensByExpiration(ws, true, now)
+	must.NoError(t, err)
+	must.Eq(t, []string{globalToken.AccessorID}, accessorIDs(iter))
+
+	// Updating a token must fire the watch set.
+	must.NoError(t, testState.DeleteACLTokens(
+		structs.MsgTypeTestSetup, 20, []string{soonToken.AccessorID}))
+	must.True(t, watchFired(ws))
+}
+
+func TestStateStore_UpsertACLTokenExpiryNotifications(t *testing.T) {
+	ci.Parallel(t)
+	testState := testStateStore(t)
+
+	now := time.Now().UTC()
+	token1, token2 := mock.ACLToken(), mock.ACLToken()
+
+	notifications := func() map[string]*structs.ACLTokenExpiryNotification {
+		iter, err := testState.ACLTokenExpiryNotifications(nil)
+		must.NoError(t, err)
+		out := make(map[string]*structs.ACLTokenExpiryNotification)
+		for raw := iter.Next(); raw != nil; raw = iter.Next() {
+			notification := raw.(*structs.ACLTokenExpiryNotification)
+			out[notification.AccessorID] = notification
+		}
+		return out
+	}
+
+	must.NoError(t, testState.UpsertACLTokenExpiryNotifications(
+		structs.ACLTokenExpiryNotificationsRequestType, 10,
+		[]*structs.ACLTokenExpiryNotification{
+			{AccessorID: token1.AccessorID, ExpirationTime: now.Add(time.Minute)},
+			{AccessorID: token2.AccessorID, ExpirationTime: now.Add(time.Hour)},
+		}, nil))
+
+	out := notifications()
+	must.MapLen(t, 2, out)
+	must.Eq(t, 10, out[token1.AccessorID].CreateIndex)
+	must.Eq(t, 10, out[token1.AccessorID].ModifyIndex)
+
+	index, err := testState.Index(TableACLTokenExpiryNotifications)
+	must.NoError(t, err)
+	must.Eq(t, 10, index)
+
+	// Notifying about a token again keeps its create index, and forgotten
+	// tokens are deleted.
+	ws := memdb.NewWatchSet()
+	_, err = testState.ACLTokenExpiryNotifications(ws)
+	must.NoError(t, err)
+
+	must.NoError(t, testState.UpsertACLTokenExpiryNotifications(
+		structs.ACLTokenExpiryNotificationsRequestType, 20,
+		[]*structs.ACLTokenExpiryNotification{
+			{AccessorID: token1.AccessorID, ExpirationTime: now.Add(2 * time.Minute)},
+		}, []string{token2.AccessorID, uuid.Generate()}))
+	must.True(t, watchFired(ws))
+
+	out = notifications()
+	must.MapLen(t, 1, out)
+	must.Eq(t, 10, out[token1.AccessorID].CreateIndex)
+	must.Eq(t, 20, out[token1.AccessorID].ModifyIndex)
+	must.Eq(t, now.Add(2*time.Minute), out[token1.AccessorID].ExpirationTime)
+}
+
 func Test_expiresIndexName(t *testing.T) {
 	testCases := []struct {
 		globalInput    bool
@@ -0,0 +1,165 @@
+package state
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/go-memdb"
+	"github.com/hashicorp/nomad/nomad/structs"
+)
+
+// UpsertEventSinks is used to register or update a number of event sinks. It
+// uses a single write transaction for efficiency, however, any error means no
+// entries will be committed.
+func (s *StateStore) UpsertEventSinks(index uint64, sinks []*structs.EventSink) error {
+	txn := s.db.WriteTxnMsgT(structs.EventSinkRegisterRequestType, index)
+	defer txn.Abort()
+
+	var updated bool
+	for _, sink := range sinks {
+		sinkUpdated, err := s.upsertEventSinkTxn(index, txn, sink)
+		if err != nil {
+			return err
+		}
+		updated = updated || sinkUpdated
+	}
+
+	// If we did not perform any inserts, exit early.
+	if !updated {
+		return nil
+	}
+
+	if err := txn.Insert(tableIndex, &IndexEntry{TableEventSinks, index}); err != nil {
+		return fmt.Errorf("index update failed: %v", err)
+	}
+	return txn.Commit()
+}
+
+// upsertEventSinkTxn inserts a single event sink into the state store using
+// the provided write transaction. It is the responsibility of the caller to
+// update the index table.
+func (s *StateStore) upsertEventSinkTxn(index uint64, txn *txn, sink *structs.EventSink) (bool, error) {
+	existingRaw, err := txn.First(TableEventSinks, indexID, sink.ID)
+	if err != nil {
+		return false, fmt.Errorf("event sink lookup failed: %v", err)
+	}
+
+	if existingRaw != nil {
+		existing := existingRaw.(*structs.EventSink)
+
+		// Avoid waking up blocking queries and the leader's sink manager if
+		// nothing changed.
+		if existing.Equal(sink) {
+			return false, nil
+		}
+
+		// The delivery progress is owned by the leader and carries over
+		// updates of the sink.
+		sink.CreateIndex = existing.CreateIndex
+		sink.LatestIndex = existing.LatestIndex
+	} else {
+		sink.CreateIndex = index
+		sink.LatestIndex = 0
+	}
+	sink.ModifyIndex = index
+
+	if err := txn.Insert(TableEventSinks, sink); err != nil {
+		return false, fmt.Errorf("event sink insert failed: %v", err)
+	}
+	return true, nil
+}
+
+// DeleteEventSinks is used to deregister a number of event sinks. An error
+// is returned if any of the sinks doesn't exist.
+func (s *StateStore) DeleteEventSinks(index uint64, ids []string) error {
+	txn := s.db.WriteTxnMsgT(structs.EventSinkDeregisterRequestType, index)
+	defer txn.Abort()
+
+	for _, id := range ids {
+		existing, err := txn.First(TableEventSinks, indexID, id)
+		if err != nil {
+			return fmt.Errorf("event sink lookup failed: %v", err)
+		}
+		if existing == nil {
+			return fmt.Errorf("event sink %q not found", id)
+		}
+		if err := txn.Delete(TableEventSinks, existing); err != nil {
+			return fmt.Errorf("event sink deletion failed: %v", err)
+		}
+	}
+
+	if err := txn.Insert(tableIndex, &IndexEntry{TableEventSinks, index}); err != nil {
+		return fmt.Errorf("index update failed: %v", err)
+	}
+	return txn.Commit()
+}
+
+// UpdateEventSinksProgress stores the latest index acknowledged by a number
+// of event sinks. Progress is never moved backwards, and progress of sinks
+// that were deregistered in the meantime is ignored. The ModifyIndex of the
+// sinks isn't changed, as their configuration is unchanged.
+func (s *StateStore) UpdateEventSinksProgress(index uint64, progress map[string]uint64) error {
+	txn := s.db.WriteTxnMsgT(structs.EventSinkProgressRequestType, index)
+	defer txn.Abort()
+
+	var updated bool
+	for id, latest := range progress {
+		existingRaw, err := txn.First(TableEventSinks, indexID, id)
+		if err != nil {
+			return fmt.Errorf("event sink lookup failed: %v", err)
+		}
+		if existingRaw == nil {
+			continue
+		}
+
+		existing := existingRaw.(*structs.EventSink)
+		if latest <= existing.LatestIndex {
+			continue
+		}
+
+		sink := existing.Copy()
+		sink.LatestIndex = latest
+		if err := txn.Insert(TableEventSinks, sink); err != nil {
+			return fmt.Errorf("event sink insert failed: %v", err)
+		}
+		updated = true
+	}
+
+	if !updated {
+		return nil
+	}
+
+	if err := txn.Insert(tableIndex, &IndexEntry{TableEventSinks, index}); err != nil {
+		return fmt.Errorf("index update failed: %v", err)
+	}
+	return txn.Commit()
+}
+
+// EventSinks returns an iterator over all event sinks.
+func (s *StateStore) EventSinks(ws memdb.WatchSet) (memdb.ResultIterator, error) {
+	txn := s.db.ReadTxn()
+
+	iter, err := txn.Get(TableEventSinks, indexID)
+	if err != nil {
+		return nil, fmt.Errorf("event sink lookup failed: %v", err)
+	}
+	ws.Add(iter.WatchCh())
+
+	return iter, nil
+}
+
+// EventSinkByID returns the event sink with the given ID, or nil if it
+// doesn't exist.
+func (s *StateStore) EventSinkByID(ws memdb.WatchSet, id string) (*structs.EventSink, error) {
+	txn := s.db.ReadTxn()
+
+	watchCh, existing, err := txn.FirstWatch(TableEventSinks, indexID, id)
+	if err != nil {
+		return nil, fmt.Errorf("event sink lookup failed: %v", err)
+	}
+	ws.Add(watchCh)
+
+	if existing != nil {
+		return existing.(*structs.EventSink), nil
+	}
+	return nil, nil
+}
@@ -0,0 +1,112 @@
+package state
+
+import (
+	"testing"
+
+	"github.com/hashicorp/go-memdb"
+	"github.com/hashicorp/nomad/ci"
+	"github.com/hashicorp/nomad/nomad/mock"
+	"github.com/hashicorp/nomad/nomad/structs"
+	"github.com/shoenig/test/must"
+)
+
+func TestStateStore_UpsertEventSinks(t *testing.T) {
+	ci.Parallel(t)
+	testState := testStateStore(t)
+
+	sink1, sink2 := mock.EventSink(), mock.EventSink()
+	must.NoError(t, testState.UpsertEventSinks(10, []*structs.EventSink{sink1, sink2}))
+
+	index, err := testState.Index(TableEventSinks)
+	must.NoError(t, err)
+	must.Eq(t, 10, index)
+
+	iter, err := testState.EventSinks(memdb.NewWatchSet())
+	must.NoError(t, err)
+	var count int
+	for raw := iter.Next(); raw != nil; raw = iter.Next() {
+		count++
+		sink := raw.(*structs.EventSink)
+		must.Eq(t, 10, sink.CreateIndex)
+		must.Eq(t, 10, sink.ModifyIndex)
+	}
+	must.Eq(t, 2, count)
+
+	// Upserting an unchanged sink is a no-op
+	must.NoError(t, testState.UpsertEventSinks(20, []*structs.EventSink{sink1.Copy()}))
+	index, err = testState.Index(TableEventSinks)
+	must.NoError(t, err)
+	must.Eq(t, 10, index)
+
+	// Updates keep the create index and the delivery progress
+	must.NoError(t, testState.UpdateEventSinksProgress(30, map[string]uint64{sink1.ID: 25}))
+
+	update := sink1.Copy()
+	update.Address = "http://127.0.0.1:9090"
+	update.LatestIndex = 0
+	must.NoError(t, testState.UpsertEventSinks(40, []*structs.EventSink{update}))
+
+	out, err := testState.EventSinkByID(nil, sink1.ID)
+	must.NoError(t, err)
+	must.Eq(t, "http://127.0.0.1:9090", out.Address)
+	must.Eq(t, 10, out.CreateIndex)
+	must.Eq(t, 40, out.ModifyIndex)
+	must.Eq(t, 25, out.LatestIndex)
+}
+
+func TestStateStore_UpdateEventSinksProgress(t *testing.T) {
+	ci.Parallel(t)
+	testState := testStateStore(t)
+
+	sink := mock.EventSink()
+	must.NoError(t, testState.UpsertEventSinks(10, []*structs.EventSink{sink}))
+
+	ws := memdb.NewWatchSet()
+	_, err := testState.EventSinkByID(ws, sink.ID)
+	must.NoError(t, err)
+
+	// Progress of unknown sinks is ignored
+	must.NoError(t, testState.UpdateEventSinksProgress(20, map[string]uint64{
+		sink.ID:   15,
+		"unknown": 15,
+	}))
+	must.True(t, watchFired(ws))
+
+	out, err := testState.EventSinkByID(nil, sink.ID)
+	must.NoError(t, err)
+	must.Eq(t, 15, out.LatestIndex)
+	must.Eq(t, 10, out.ModifyIndex)
+
+	// Progress never moves backwards
+	must.NoError(t, testState.UpdateEventSinksProgress(30, map[string]uint64{sink.ID: 12}))
+	out, err = testState.EventSinkByID(nil, sink.ID)
+	must.NoError(t, err)
+	must.Eq(t, 15, out.LatestIndex)
+
+	index, err := testState.Index(TableEventSinks)
+	must.NoError(t, err)
+	must.Eq(t, 20, index)
+}
+
+func TestStateStore_DeleteEventSinks(t *testing.T) {
+	ci.Parallel(t)
+	testState := testStateStore(t)
+
+	sink1, sink2 := mock.EventSink(), mock.EventSink()
+	must.NoError(t, testState.UpsertEventSinks(10, []*structs.EventSink{sink1, sink2}))
+
+	must.ErrorContains(t, testState.DeleteEventSinks(20, []string{sink1.ID, "unknown"}), "not found")
+
+	must.NoError(t, testState.DeleteEventSinks(30, []string{sink1.ID}))
+	out, err := testState.EventSinkByID(nil, sink1.ID)
+	must.NoError(t, err)
+	must.Nil(t, out)
+
+	out, err = testState.EventSinkByID(nil, sink2.ID)
+	must.NoError(t, err)
+	must.NotNil(t, out)
+
+	index, err := testState.Index(TableEventSinks)
+	must.NoError(t, err)
+	must.Eq(t, 30, index)
+}
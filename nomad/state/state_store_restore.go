@@ -251,3 +251,21 @@ func (r *StateRestore) ACLBindingRuleRestore(aclBindingRule *structs.ACLBindingR
 	}
 	return nil
 }
+
+// EventSinkRestore is used to restore a single event sink into the
+// event_sinks table.
+func (r *StateRestore) EventSinkRestore(sink *structs.EventSink) error {
+	if err := r.txn.Insert(TableEventSinks, sink); err != nil {
+		return fmt.Errorf("event sink insert failed: %v", err)
+	}
+	return nil
+}
+
+// ACLTokenExpiryNotificationRestore is used to restore a single ACL token
+// expiry notification into the acl_token_expiry_notifications table.
+func (r *StateRestore) ACLTokenExpiryNotificationRestore(notification *structs.ACLTokenExpiryNotification) error {
+	if err := r.txn.Insert(TableACLTokenExpiryNotifications, notification); err != nil {
+		return fmt.Errorf("ACL token expiry notification insert failed: %v", err)
+	}
+	return nil
+}
@@ -26,7 +26,9 @@ func (s *Status) Ping(args structs.GenericRequest, reply *struct{}) error {
 	// note: we're intentionally throwing away any auth error here and only
 	// authenticate so that we can measure rate metrics
 	s.srv.Authenticate(s.ctx, &args)
-	s.srv.MeasureRPCRate("status", structs.RateMetricRead, &args)
+	if err := s.srv.MeasureRPCRate("status", structs.RateMetricRead, &args); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -35,7 +37,9 @@ func (s *Status) Leader(args *structs.GenericRequest, reply *string) error {
 	// note: we're intentionally throwing away any auth error here and only
 	// authenticate so that we can measure rate metrics
 	s.srv.Authenticate(s.ctx, args)
-	s.srv.MeasureRPCRate("status", structs.RateMetricRead, args)
+	if err := s.srv.MeasureRPCRate("status", structs.RateMetricRead, args); err != nil {
+		return err
+	}
 
 	if args.Region == "" {
 		args.Region = s.srv.config.Region
@@ -58,7 +62,9 @@ func (s *Status) Peers(args *structs.GenericRequest, reply *[]string) error {
 	// note: we're intentionally throwing away any auth error here and only
 	// authenticate so that we can measure rate metrics
 	s.srv.Authenticate(s.ctx, args)
-	s.srv.MeasureRPCRate("status", structs.RateMetricList, args)
+	if err := s.srv.MeasureRPCRate("status", structs.RateMetricList, args); err != nil {
+		return err
+	}
 
 	if args.Region == "" {
 		args.Region = s.srv.config.Region
@@ -82,7 +88,9 @@ func (s *Status) Peers(args *structs.GenericRequest, reply *[]string) error {
 // aware of
 func (s *Status) Members(args *structs.GenericRequest, reply *structs.ServerMembersResponse) error {
 	authErr := s.srv.Authenticate(s.ctx, args)
-	s.srv.MeasureRPCRate("status", structs.RateMetricList, args)
+	if err := s.srv.MeasureRPCRate("status", structs.RateMetricList, args); err != nil {
+		return err
+	}
 	if authErr != nil {
 		return structs.ErrPermissionDenied
 	}
@@ -124,7 +132,9 @@ func (s *Status) RaftStats(args *structs.GenericRequest, reply *structs.RaftStat
 	// note: we're intentionally throwing away any auth error here and only
 	// authenticate so that we can measure rate metrics
 	s.srv.Authenticate(s.ctx, args)
-	s.srv.MeasureRPCRate("status", structs.RateMetricRead, args)
+	if err := s.srv.MeasureRPCRate("status", structs.RateMetricRead, args); err != nil {
+		return err
+	}
 
 	stats := s.srv.raft.Stats()
 
@@ -148,7 +158,9 @@ func (s *Status) HasNodeConn(args *structs.NodeSpecificRequest, reply *structs.N
 	// note: we're intentionally throwing away any auth error here and only
 	// authenticate so that we can measure rate metrics
 	s.srv.Authenticate(s.ctx, args)
-	s.srv.MeasureRPCRate("status", structs.RateMetricRead, args)
+	if err := s.srv.MeasureRPCRate("status", structs.RateMetricRead, args); err != nil {
+		return err
+	}
 
 	// Validate the args
 	if args.NodeID == "" {
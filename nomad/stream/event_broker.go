@@ -132,11 +132,17 @@ func (e *EventBroker) SubscribeWithACLCheck(req *SubscribeRequest) (*Subscriptio
 // A Subscription will start at the requested index, or as close as possible to
 // the requested index if it is no longer in the buffer. If StartExactlyAtIndex is
 // set and the index is no longer in the buffer or not yet in the buffer an error
-// will be returned.
+// will be returned. Otherwise Subscription.Truncated reports whether the
+// requested index was older than every event in the buffer.
 //
 // When a caller is finished with the subscription it must call Subscription.Unsubscribe
 // to free ACL tracking resources.
 func (e *EventBroker) Subscribe(req *SubscribeRequest) (*Subscription, error) {
+	filter, err := compileFilter(req)
+	if err != nil {
+		return nil, err
+	}
+
 	e.mu.Lock()
 	defer e.mu.Unlock()
 
@@ -160,11 +166,19 @@ func (e *EventBroker) Subscribe(req *SubscribeRequest) (*Subscription, error) {
 	close(start.link.nextCh)
 
 	sub := newSubscription(req, start, e.subscriptions.unsubscribeFn(req))
+	sub.filter = filter
+	sub.truncated = req.Index != 0 && head.Events.Index > req.Index && head == e.eventBuf.Oldest()
 
 	e.subscriptions.add(req, sub)
 	return sub, nil
 }
 
+// LatestIndex returns the index of the latest events published to the
+// broker.
+func (e *EventBroker) LatestIndex() uint64 {
+	return e.eventBuf.Tail().Events.Index
+}
+
 // CloseAll closes all subscriptions
 func (e *EventBroker) CloseAll() {
 	e.subscriptions.closeAll()
@@ -364,7 +378,34 @@ func aclAllowsSubscription(aclObj *acl.ACL, subReq *SubscribeRequest) bool {
 			if ok := aclObj.AllowNodeRead(); !ok {
 				return false
 			}
+		case structs.TopicVariables:
+			// Variable events only hold metadata, so the list capability
+			// is required for each path the subscription is for
+			for _, path := range subReq.Topics[topic] {
+				if ok := aclObj.AllowVariableOperation(
+					subReq.Namespace, path, acl.VariablesCapabilityList, nil); !ok {
+					return false
+				}
+			}
+		case structs.TopicNamespace:
+			if ok := aclObj.AllowNamespace(subReq.Namespace); !ok {
+				return false
+			}
+		case structs.TopicCSIVolume:
+			if ok := aclObj.AllowNsOp(subReq.Namespace, acl.NamespaceCapabilityCSIReadVolume); !ok {
+				return false
+			}
+		case structs.TopicCSIPlugin:
+			if ok := aclObj.AllowPluginRead(); !ok {
+				return false
+			}
+		case structs.TopicScalingPolicy:
+			if ok := aclObj.AllowNsOp(subReq.Namespace, acl.NamespaceCapabilityReadScalingPolicy); !ok {
+				return false
+			}
 		default:
+			// The RootKey topic and the wildcard topic require a management
+			// token
 			if ok := aclObj.IsManagement(); !ok {
 				return false
 			}
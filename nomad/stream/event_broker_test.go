@@ -1038,3 +1038,123 @@ func consumeSub(ctx context.Context, sub *Subscription) error {
 		}
 	}
 }
+
+func TestEventBroker_aclAllowsSubscription(t *testing.T) {
+	ci.Parallel(t)
+
+	policy, err := acl.Parse(`
+namespace "default" {
+  capabilities = ["csi-read-volume", "read-scaling-policy"]
+  variables {
+    path "app/*" {
+      capabilities = ["list"]
+    }
+  }
+}
+plugin {
+  policy = "read"
+}
+`)
+	require.NoError(t, err)
+	aclObj, err := acl.NewACL(false, []*acl.Policy{policy})
+	require.NoError(t, err)
+
+	cases := []struct {
+		name      string
+		topic     structs.Topic
+		keys      []string
+		namespace string
+		allowed   bool
+	}{
+		{"variables in allowed path", structs.TopicVariables, []string{"app/db"}, "default", true},
+		{"variables in other path", structs.TopicVariables, []string{"other/db"}, "default", false},
+		{"all variables", structs.TopicVariables, []string{"*"}, "default", false},
+		{"namespace", structs.TopicNamespace, []string{"*"}, "default", true},
+		{"other namespace", structs.TopicNamespace, []string{"*"}, "other", false},
+		{"csi volumes", structs.TopicCSIVolume, []string{"*"}, "default", true},
+		{"csi volumes in other namespace", structs.TopicCSIVolume, []string{"*"}, "other", false},
+		{"csi plugins", structs.TopicCSIPlugin, []string{"*"}, "default", true},
+		{"scaling policies", structs.TopicScalingPolicy, []string{"*"}, "default", true},
+		{"root keys", structs.TopicRootKey, []string{"*"}, "default", false},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			req := &SubscribeRequest{
+				Namespace: tc.namespace,
+				Topics:    map[structs.Topic][]string{tc.topic: tc.keys},
+			}
+			require.Equal(t, tc.allowed, aclAllowsSubscription(aclObj, req))
+			require.True(t, aclAllowsSubscription(acl.ManagementACL, req))
+		})
+	}
+}
+
+func TestEventBroker_SubscribeFilter(t *testing.T) {
+	ci.Parallel(t)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 2*time.Second)
+	defer cancel()
+
+	publisher, err := NewEventBroker(ctx, nil, EventBrokerCfg{EventBufferSize: 100})
+	require.NoError(t, err)
+
+	_, err = publisher.Subscribe(&SubscribeRequest{
+		Topics: map[structs.Topic][]string{"*": {"*"}},
+		Filter: "Job.ID ==",
+	})
+	require.ErrorIs(t, err, ErrInvalidFilter)
+
+	sub, err := publisher.Subscribe(&SubscribeRequest{
+		Topics: map[structs.Topic][]string{"*": {"*"}},
+		Filter: `Job.ID == "web"`,
+	})
+	require.NoError(t, err)
+	eventCh := consumeSubscription(ctx, sub)
+
+	publisher.Publish(&structs.Events{Index: 1, Events: []structs.Event{
+		{Topic: structs.TopicJob, Key: "api", Index: 1, Payload: &structs.JobEvent{Job: &structs.Job{ID: "api"}}},
+		{Topic: structs.TopicJob, Key: "web", Index: 1, Payload: &structs.JobEvent{Job: &structs.Job{ID: "web"}}},
+	}})
+
+	result := nextResult(t, eventCh)
+	require.NoError(t, result.Err)
+	require.Len(t, result.Events, 1)
+	require.Equal(t, "web", result.Events[0].Key)
+}
+
+func TestEventBroker_SubscribeTruncated(t *testing.T) {
+	ci.Parallel(t)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 2*time.Second)
+	defer cancel()
+
+	publisher, err := NewEventBroker(ctx, nil, EventBrokerCfg{EventBufferSize: 2})
+	require.NoError(t, err)
+
+	subscribe := func(index uint64) *Subscription {
+		sub, err := publisher.Subscribe(&SubscribeRequest{
+			Index:  index,
+			Topics: map[structs.Topic][]string{"*": {"*"}},
+		})
+		require.NoError(t, err)
+		t.Cleanup(sub.Unsubscribe)
+		return sub
+	}
+
+	// Nothing can be missed while the buffer is empty
+	require.False(t, subscribe(5).Truncated())
+
+	for _, index := range []uint64{10, 20, 30, 40} {
+		publisher.Publish(&structs.Events{Index: index, Events: []structs.Event{{Topic: "Test", Index: index}}})
+	}
+	require.Eventually(t, func() bool {
+		return publisher.LatestIndex() == 40
+	}, time.Second, 10*time.Millisecond)
+
+	// The events at index 10 were dropped from the buffer
+	require.True(t, subscribe(5).Truncated())
+	require.True(t, subscribe(15).Truncated())
+	require.False(t, subscribe(20).Truncated())
+	require.False(t, subscribe(25).Truncated())
+	require.False(t, subscribe(0).Truncated())
+}
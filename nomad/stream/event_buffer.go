@@ -178,6 +178,20 @@ func (b *eventBuffer) StartAtClosest(index uint64) (*bufferItem, int) {
 	}
 }
 
+// Oldest returns the oldest item in the buffer holding events, skipping the
+// sentinel value at its head if there is one. The sentinel is returned if the
+// buffer holds no events.
+func (b *eventBuffer) Oldest() *bufferItem {
+	item := b.Head()
+	if item.Events.Index != 0 {
+		return item
+	}
+	if next := item.NextNoBlock(); next != nil {
+		return next
+	}
+	return item
+}
+
 // Len returns the current length of the buffer
 func (b *eventBuffer) Len() int {
 	return int(atomic.LoadInt64(b.size))
@@ -3,8 +3,10 @@ package stream
 import (
 	"context"
 	"errors"
+	"fmt"
 	"sync/atomic"
 
+	"github.com/hashicorp/go-bexpr"
 	"github.com/hashicorp/nomad/nomad/structs"
 )
 
@@ -24,12 +26,20 @@ const (
 var ErrSubscriptionClosed = errors.New("subscription closed by server, client should resubscribe")
 var ErrACLInvalid = errors.New("Provided ACL token is invalid for requested topics")
 
+// ErrInvalidFilter is returned when a subscription's filter expression can't
+// be parsed.
+var ErrInvalidFilter = errors.New("invalid filter expression")
+
 type Subscription struct {
 	// state must be accessed atomically 0 means open, 1 means closed with reload
 	state uint32
 
 	req *SubscribeRequest
 
+	// filter is the compiled filter expression of the request, nil if the
+	// request has none.
+	filter *bexpr.Evaluator
+
 	// currentItem stores the current buffer item we are on. It
 	// is mutated by calls to Next.
 	currentItem *bufferItem
@@ -43,6 +53,10 @@ type Subscription struct {
 	// It must be safe to call the function from multiple goroutines and the function
 	// must be idempotent.
 	unsub func()
+
+	// truncated is true if the requested index was older than every event in
+	// the buffer when the subscription started.
+	truncated bool
 }
 
 type SubscribeRequest struct {
@@ -52,6 +66,10 @@ type SubscribeRequest struct {
 
 	Topics map[structs.Topic][]string
 
+	// Filter is a go-bexpr expression evaluated against the payload of each
+	// event. Only events which match the expression are sent.
+	Filter string
+
 	// StartExactlyAtIndex specifies if a subscription needs to
 	// start exactly at the requested Index. If set to false,
 	// the closest index in the buffer will be returned if there is not
@@ -68,6 +86,26 @@ func newSubscription(req *SubscribeRequest, item *bufferItem, unsub func()) *Sub
 	}
 }
 
+// compileFilter parses the filter expression of the request, if any.
+func compileFilter(req *SubscribeRequest) (*bexpr.Evaluator, error) {
+	if req.Filter == "" {
+		return nil, nil
+	}
+	eval, err := bexpr.CreateEvaluator(req.Filter)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidFilter, err)
+	}
+	return eval, nil
+}
+
+// Truncated returns whether the subscription started after the requested
+// index because the events from that index were no longer in the buffer, or
+// were published before the buffer was created. Events between the requested
+// index and the first event of the subscription may have been missed.
+func (s *Subscription) Truncated() bool {
+	return s.truncated
+}
+
 func (s *Subscription) Next(ctx context.Context) (structs.Events, error) {
 	if atomic.LoadUint32(&s.state) == subscriptionStateClosed {
 		return structs.Events{}, ErrSubscriptionClosed
@@ -83,7 +121,7 @@ func (s *Subscription) Next(ctx context.Context) (structs.Events, error) {
 		}
 		s.currentItem = next
 
-		events := filter(s.req, next.Events.Events)
+		events := s.filterPayloads(filter(s.req, next.Events.Events))
 		if len(events) == 0 {
 			continue
 		}
@@ -103,7 +141,7 @@ func (s *Subscription) NextNoBlock() ([]structs.Event, error) {
 		}
 		s.currentItem = next
 
-		events := filter(s.req, next.Events.Events)
+		events := s.filterPayloads(filter(s.req, next.Events.Events))
 		if len(events) == 0 {
 			continue
 		}
@@ -163,6 +201,24 @@ func filter(req *SubscribeRequest, events []structs.Event) []structs.Event {
 	return result
 }
 
+// filterPayloads returns the events whose payload matches the subscription's
+// filter expression. Payloads that the expression can't be evaluated against,
+// such as those of another topic than the one it was written for, don't
+// match.
+func (s *Subscription) filterPayloads(events []structs.Event) []structs.Event {
+	if s.filter == nil || len(events) == 0 {
+		return events
+	}
+
+	result := make([]structs.Event, 0, len(events))
+	for _, event := range events {
+		if match, err := s.filter.Evaluate(event.Payload); err == nil && match {
+			result = append(result, event)
+		}
+	}
+	return result
+}
+
 func eventMatchesKey(event structs.Event, key string) bool {
 	if event.Key == key {
 		return true
@@ -208,3 +208,55 @@ func TestFilter_FilterKeys(t *testing.T) {
 
 	require.Equal(t, 1, cap(actual))
 }
+
+func TestSubscription_filterPayloads(t *testing.T) {
+	ci.Parallel(t)
+
+	req := &SubscribeRequest{
+		Topics: map[structs.Topic][]string{"*": {"*"}},
+		Filter: `Allocation.ClientStatus == "failed"`,
+	}
+	eval, err := compileFilter(req)
+	require.NoError(t, err)
+	sub := &Subscription{req: req, filter: eval}
+
+	failed := structs.Event{
+		Topic: structs.TopicAllocation,
+		Key:   "failed",
+		Payload: &structs.AllocationEvent{
+			Allocation: &structs.Allocation{ClientStatus: structs.AllocClientStatusFailed},
+		},
+	}
+	running := structs.Event{
+		Topic: structs.TopicAllocation,
+		Key:   "running",
+		Payload: &structs.AllocationEvent{
+			Allocation: &structs.Allocation{ClientStatus: structs.AllocClientStatusRunning},
+		},
+	}
+	job := structs.Event{
+		Topic:   structs.TopicJob,
+		Key:     "job",
+		Payload: &structs.JobEvent{Job: &structs.Job{ID: "job"}},
+	}
+
+	// Payloads the expression can't be evaluated against don't match
+	actual := sub.filterPayloads([]structs.Event{failed, running, job})
+	require.Equal(t, []structs.Event{failed}, actual)
+
+	// Without a filter all events match
+	sub.filter = nil
+	actual = sub.filterPayloads([]structs.Event{failed, running, job})
+	require.Len(t, actual, 3)
+}
+
+func TestSubscription_compileFilter(t *testing.T) {
+	ci.Parallel(t)
+
+	eval, err := compileFilter(&SubscribeRequest{})
+	require.NoError(t, err)
+	require.Nil(t, eval)
+
+	_, err = compileFilter(&SubscribeRequest{Filter: `Allocation.ClientStatus ==`})
+	require.ErrorIs(t, err, ErrInvalidFilter)
+}
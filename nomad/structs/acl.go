@@ -5,19 +5,24 @@ import (
 	"encoding/json"
 	"errors"
 	"fmt"
+	"net/url"
 	"regexp"
 	"strconv"
+	"strings"
+	"text/template"
 	"time"
 
 	"github.com/hashicorp/go-bexpr"
 	"github.com/hashicorp/go-multierror"
 	"github.com/hashicorp/go-set"
 	lru "github.com/hashicorp/golang-lru/v2"
+	"github.com/hashicorp/nomad/acl"
 	"github.com/hashicorp/nomad/helper"
 	"github.com/hashicorp/nomad/helper/pointer"
 	"github.com/hashicorp/nomad/helper/uuid"
 	"github.com/hashicorp/nomad/lib/lang"
 	"golang.org/x/crypto/blake2b"
+	"golang.org/x/exp/maps"
 	"golang.org/x/exp/slices"
 	"oss.indeed.com/go/libtime"
 )
@@ -177,6 +182,28 @@ const (
 	// Args: ACLLoginRequest
 	// Reply: ACLLoginResponse
 	ACLLoginRPCMethod = "ACL.Login"
+
+	// ACLAuthorizeRPCMethod is the RPC method for checking whether an ACL
+	// token, role or set of policies allows an operation, and explaining
+	// which policy rules allowed or denied it.
+	//
+	// Args: ACLAuthorizeRequest
+	// Reply: ACLAuthorizeResponse
+	ACLAuthorizeRPCMethod = "ACL.Authorize"
+
+	// ACLRotateTokenRPCMethod is the RPC method for replacing an ACL token
+	// with a new token which has the same policies, roles and TTL.
+	//
+	// Args: ACLTokenRotateRequest
+	// Reply: ACLTokenRotateResponse
+	ACLRotateTokenRPCMethod = "ACL.RotateToken"
+
+	// ACLListExpiringTokensRPCMethod is the RPC method for listing the ACL
+	// tokens which expire within a time window.
+	//
+	// Args: ACLTokenExpiringListRequest
+	// Reply: ACLTokenListResponse
+	ACLListExpiringTokensRPCMethod = "ACL.ListExpiringTokens"
 )
 
 const (
@@ -187,6 +214,11 @@ const (
 	// a potential limiting factor.
 	ACLMaxExpiredBatchSize = 4096
 
+	// DefaultACLTokenRotateGracePeriod is how long a rotated ACL token stays
+	// valid after its replacement is created, if the rotate request doesn't
+	// set a grace period.
+	DefaultACLTokenRotateGracePeriod = 1 * time.Hour
+
 	// maxACLRoleDescriptionLength limits an ACL roles description length.
 	maxACLRoleDescriptionLength = 256
 
@@ -210,6 +242,15 @@ const (
 	// ACLAuthMethodTypeJWT the ACLAuthMethod.Type and represents an auth-method
 	// which uses the JWT type.
 	ACLAuthMethodTypeJWT = "JWT"
+
+	// ACLAuthMethodTypeLDAP the ACLAuthMethod.Type and represents an
+	// auth-method which authenticates users against an LDAP directory.
+	ACLAuthMethodTypeLDAP = "LDAP"
+
+	// ACLAuthMethodTypeWorkload the ACLAuthMethod.Type and represents an
+	// auth-method which authenticates Nomad workloads using their workload
+	// identity.
+	ACLAuthMethodTypeWorkload = "WORKLOAD"
 )
 
 var (
@@ -220,7 +261,19 @@ var (
 	ValidACLAuthMethod = regexp.MustCompile("^[a-zA-Z0-9-]{1,128}$")
 
 	// ValitACLAuthMethodTypes lists supported auth method types.
-	ValidACLAuthMethodTypes = []string{ACLAuthMethodTypeOIDC, ACLAuthMethodTypeJWT}
+	ValidACLAuthMethodTypes = []string{
+		ACLAuthMethodTypeOIDC, ACLAuthMethodTypeJWT, ACLAuthMethodTypeLDAP, ACLAuthMethodTypeWorkload}
+
+	// WorkloadClaimMappings are the default claim mappings of workload auth
+	// methods, which make the claims of workload identities available to the
+	// selectors of binding rules.
+	WorkloadClaimMappings = map[string]string{
+		"nomad_namespace":     "namespace",
+		"nomad_job_id":        "job_id",
+		"nomad_task_group":    "task_group",
+		"nomad_task":          "task",
+		"nomad_allocation_id": "allocation_id",
+	}
 )
 
 type ACLCacheEntry[T any] lang.Pair[T, time.Time]
@@ -299,6 +352,17 @@ func (a *ACLToken) Canonicalize() {
 	}
 }
 
+// IsLoginToken returns whether the token was created by logging in with an
+// auth method. These tokens are named after the type and name of the method.
+func (a *ACLToken) IsLoginToken() bool {
+	for _, methodType := range ValidACLAuthMethodTypes {
+		if strings.HasPrefix(a.Name, methodType+"-") {
+			return true
+		}
+	}
+	return false
+}
+
 // Validate is used to check a token for reasonableness
 func (a *ACLToken) Validate(minTTL, maxTTL time.Duration, existing *ACLToken) error {
 	var mErr multierror.Error
@@ -792,6 +856,20 @@ func (a *ACLAuthMethod) SetHash() []byte {
 			_, _ = hash.Write([]byte(k))
 			_, _ = hash.Write([]byte(v))
 		}
+		_, _ = hash.Write([]byte(a.Config.LDAPURL))
+		_, _ = hash.Write([]byte(strconv.FormatBool(a.Config.LDAPStartTLS)))
+		_, _ = hash.Write([]byte(a.Config.LDAPCACert))
+		_, _ = hash.Write([]byte(strconv.FormatBool(a.Config.LDAPInsecureSkipVerify)))
+		_, _ = hash.Write([]byte(a.Config.LDAPBindDN))
+		_, _ = hash.Write([]byte(a.Config.LDAPBindPassword))
+		_, _ = hash.Write([]byte(a.Config.LDAPUserBaseDN))
+		_, _ = hash.Write([]byte(a.Config.LDAPUserFilter))
+		for _, attr := range a.Config.LDAPUserAttributes {
+			_, _ = hash.Write([]byte(attr))
+		}
+		_, _ = hash.Write([]byte(a.Config.LDAPGroupBaseDN))
+		_, _ = hash.Write([]byte(a.Config.LDAPGroupFilter))
+		_, _ = hash.Write([]byte(a.Config.LDAPGroupAttribute))
 	}
 
 	// Finalize the hash.
@@ -894,6 +972,17 @@ func (a *ACLAuthMethod) Canonicalize() {
 		a.CreateTime = t
 	}
 	a.ModifyTime = t
+
+	// Workload identities always have the same claims, so workload auth
+	// methods map them by default.
+	if a.Type == ACLAuthMethodTypeWorkload {
+		if a.Config == nil {
+			a.Config = &ACLAuthMethodConfig{}
+		}
+		if len(a.Config.ClaimMappings) == 0 {
+			a.Config.ClaimMappings = maps.Clone(WorkloadClaimMappings)
+		}
+	}
 }
 
 // Merge merges auth method a with method b. It sets all required empty fields
@@ -934,6 +1023,20 @@ func (a *ACLAuthMethod) Validate(minTTL, maxTTL time.Duration) error {
 			a.MaxTokenTTL.String(), minTTL.String(), maxTTL.String()))
 	}
 
+	if a.Type == ACLAuthMethodTypeLDAP {
+		if a.Config == nil {
+			mErr.Errors = append(mErr.Errors, errors.New("missing LDAP config"))
+		} else if err := a.Config.validateLDAP(); err != nil {
+			mErr.Errors = append(mErr.Errors, err)
+		}
+	}
+
+	// Workload identities can only be verified in the region of their
+	// allocation, so they can't be exchanged for global tokens.
+	if a.Type == ACLAuthMethodTypeWorkload && a.TokenLocality != ACLAuthMethodTokenLocalityLocal {
+		mErr.Errors = append(mErr.Errors, errors.New("WORKLOAD auth methods must use local token locality"))
+	}
+
 	return mErr.ErrorOrNil()
 }
 
@@ -998,6 +1101,101 @@ type ACLAuthMethodConfig struct {
 	// (value).
 	ClaimMappings     map[string]string
 	ListClaimMappings map[string]string
+
+	// LDAPURL is the URL of the LDAP server, using the ldap:// or ldaps://
+	// scheme
+	LDAPURL string
+
+	// LDAPStartTLS upgrades ldap:// connections to TLS using StartTLS
+	LDAPStartTLS bool
+
+	// LDAPCACert is the PEM encoded CA cert used to verify the certificate of
+	// the LDAP server. The system CAs are used if it's empty.
+	LDAPCACert string
+
+	// LDAPInsecureSkipVerify disables verification of the certificate of the
+	// LDAP server
+	LDAPInsecureSkipVerify bool
+
+	// LDAPBindDN and LDAPBindPassword are the credentials used to search for
+	// users and groups. An anonymous bind is used if LDAPBindDN is empty.
+	LDAPBindDN       string
+	LDAPBindPassword string
+
+	// LDAPUserBaseDN is the base DN under which users are searched for
+	LDAPUserBaseDN string
+
+	// LDAPUserFilter is the filter used to search for the user logging in.
+	// The {{.Username}} placeholder is replaced by the escaped username.
+	LDAPUserFilter string
+
+	// LDAPUserAttributes are the attributes of the user entry which are
+	// added to the claims of the user. All attributes are added if it's
+	// empty.
+	LDAPUserAttributes []string
+
+	// LDAPGroupBaseDN is the base DN under which groups are searched for.
+	// Groups aren't searched for if it's empty.
+	LDAPGroupBaseDN string
+
+	// LDAPGroupFilter is the filter used to search for the groups of the
+	// user. The {{.Username}} and {{.UserDN}} placeholders are replaced by the
+	// escaped username and DN of the user.
+	LDAPGroupFilter string
+
+	// LDAPGroupAttribute is the attribute of group entries used as the name
+	// of the group in the groups claim
+	LDAPGroupAttribute string
+}
+
+const (
+	// ACLAuthMethodLDAPDefaultUserFilter is the default filter used to search
+	// for users of LDAP auth methods.
+	ACLAuthMethodLDAPDefaultUserFilter = "(uid={{.Username}})"
+
+	// ACLAuthMethodLDAPDefaultGroupFilter is the default filter used to
+	// search for the groups of users of LDAP auth methods.
+	ACLAuthMethodLDAPDefaultGroupFilter = "(|(member={{.UserDN}})(uniqueMember={{.UserDN}})(memberUid={{.Username}}))"
+
+	// ACLAuthMethodLDAPDefaultGroupAttribute is the default attribute of
+	// groups used as their name.
+	ACLAuthMethodLDAPDefaultGroupAttribute = "cn"
+)
+
+// validateLDAP returns an error if the LDAP configuration of an auth method
+// is invalid.
+func (a *ACLAuthMethodConfig) validateLDAP() error {
+	var mErr multierror.Error
+
+	if a.LDAPURL == "" {
+		mErr.Errors = append(mErr.Errors, errors.New("missing LDAPURL"))
+	} else if u, err := url.Parse(a.LDAPURL); err != nil {
+		mErr.Errors = append(mErr.Errors, fmt.Errorf("invalid LDAPURL: %v", err))
+	} else if u.Scheme != "ldap" && u.Scheme != "ldaps" {
+		mErr.Errors = append(mErr.Errors, fmt.Errorf(
+			"invalid LDAPURL %q: scheme must be ldap or ldaps", a.LDAPURL))
+	} else if u.Scheme == "ldaps" && a.LDAPStartTLS {
+		mErr.Errors = append(mErr.Errors, errors.New("LDAPStartTLS can't be used with ldaps URLs"))
+	}
+
+	if a.LDAPBindDN == "" && a.LDAPBindPassword != "" {
+		mErr.Errors = append(mErr.Errors, errors.New("LDAPBindPassword requires LDAPBindDN"))
+	}
+
+	if a.LDAPUserBaseDN == "" {
+		mErr.Errors = append(mErr.Errors, errors.New("missing LDAPUserBaseDN"))
+	}
+
+	for name, filter := range map[string]string{
+		"LDAPUserFilter":  a.LDAPUserFilter,
+		"LDAPGroupFilter": a.LDAPGroupFilter,
+	} {
+		if _, err := template.New(name).Option("missingkey=error").Parse(filter); err != nil {
+			mErr.Errors = append(mErr.Errors, fmt.Errorf("invalid %s: %v", name, err))
+		}
+	}
+
+	return mErr.ErrorOrNil()
 }
 
 func (a *ACLAuthMethodConfig) Copy() *ACLAuthMethodConfig {
@@ -1015,6 +1213,7 @@ func (a *ACLAuthMethodConfig) Copy() *ACLAuthMethodConfig {
 	c.AllowedRedirectURIs = slices.Clone(a.AllowedRedirectURIs)
 	c.DiscoveryCaPem = slices.Clone(a.DiscoveryCaPem)
 	c.SigningAlgs = slices.Clone(a.SigningAlgs)
+	c.LDAPUserAttributes = slices.Clone(a.LDAPUserAttributes)
 
 	return c
 }
@@ -1611,9 +1810,15 @@ type ACLLoginRequest struct {
 	AuthMethodName string
 
 	// LoginToken is the 3rd party token that we use to exchange for Nomad ACL
-	// Token in order to authenticate. This is a required parameter.
+	// Token in order to authenticate. It is required unless Username and
+	// Password are set.
 	LoginToken string
 
+	// Username and Password are the credentials used to login with auth
+	// methods which authenticate users directly, such as LDAP.
+	Username string
+	Password string
+
 	WriteRequest
 }
 
@@ -1626,8 +1831,166 @@ func (a *ACLLoginRequest) Validate() error {
 	if a.AuthMethodName == "" {
 		mErr.Errors = append(mErr.Errors, errors.New("missing auth method name"))
 	}
-	if a.LoginToken == "" {
+	if a.Username != "" || a.Password != "" {
+		if a.Username == "" {
+			mErr.Errors = append(mErr.Errors, errors.New("missing username"))
+		}
+		if a.Password == "" {
+			mErr.Errors = append(mErr.Errors, errors.New("missing password"))
+		}
+	} else if a.LoginToken == "" {
 		mErr.Errors = append(mErr.Errors, errors.New("missing login token"))
 	}
 	return mErr.ErrorOrNil()
 }
+
+// ACLAuthorizeRequest is the request object used to check whether an
+// operation on a resource is allowed.
+type ACLAuthorizeRequest struct {
+
+	// AccessorID, Roles and Policies select the ACL token, the ACL roles by
+	// name, or the ACL policies by name whose access is checked. They can only
+	// be set by management tokens. The token of the request is checked if
+	// none of them are set.
+	AccessorID string
+	Roles      []string
+	Policies   []string
+
+	// Resource is the type of the resource, which is one of "namespace",
+	// "job", "variable", "node" or "host_volume". The namespace of namespace,
+	// job and variable resources is the namespace of the request.
+	Resource string
+
+	// Name is the job ID, variable path or host volume name of the resource.
+	Name string
+
+	// Capability is the capability being checked, or "read" or "write" for
+	// nodes.
+	Capability string
+
+	QueryOptions
+}
+
+// Validate ensures the request object contains all the required fields.
+func (a *ACLAuthorizeRequest) Validate() error {
+	var mErr multierror.Error
+
+	if a.AccessorID != "" && (len(a.Roles) > 0 || len(a.Policies) > 0) {
+		mErr.Errors = append(mErr.Errors, errors.New("accessor ID can't be used with roles or policies"))
+	}
+	if a.Resource == "" {
+		mErr.Errors = append(mErr.Errors, errors.New("missing resource"))
+	}
+	if a.Capability == "" {
+		mErr.Errors = append(mErr.Errors, errors.New("missing capability"))
+	}
+	switch a.Resource {
+	case acl.ResourceJob, acl.ResourceVariable, acl.ResourceHostVolume:
+		if a.Name == "" {
+			mErr.Errors = append(mErr.Errors, fmt.Errorf("missing %s name", a.Resource))
+		}
+	}
+	return mErr.ErrorOrNil()
+}
+
+// ACLAuthorizeResponse is the response object when checking whether an
+// operation on a resource is allowed.
+type ACLAuthorizeResponse struct {
+
+	// Allowed is whether the operation is allowed.
+	Allowed bool
+
+	// Reason describes how the policies allowed or denied the operation.
+	Reason string
+
+	// Rules are the rules of the policies which allowed or denied the
+	// operation.
+	Rules []*ACLAuthorizeRule
+
+	QueryMeta
+}
+
+// ACLAuthorizeRule is a rule of an ACL policy which allowed or denied an
+// operation.
+type ACLAuthorizeRule struct {
+
+	// Policy is the name of the ACL policy.
+	Policy string
+
+	// Rule identifies the rule within the policy, for example
+	// `namespace "prod" job "web-*"`.
+	Rule string
+
+	// Capabilities are the capabilities of the rule which allowed or denied
+	// the operation.
+	Capabilities []string
+}
+
+// ACLTokenRotateRequest is the request object used to replace an ACL token
+// with a new token.
+type ACLTokenRotateRequest struct {
+
+	// AccessorID is the accessor ID of the token to rotate.
+	AccessorID string
+
+	// GracePeriod is how long the rotated token stays valid after the new
+	// token is created. It defaults to DefaultACLTokenRotateGracePeriod. The
+	// rotated token never stays valid beyond its own expiration time.
+	GracePeriod time.Duration
+
+	WriteRequest
+}
+
+// ACLTokenRotateResponse is the response object when rotating an ACL token.
+type ACLTokenRotateResponse struct {
+
+	// Token is the new ACL token.
+	Token *ACLToken
+
+	// Rotated is the rotated ACL token, with its updated expiration time.
+	Rotated *ACLToken
+
+	WriteMeta
+}
+
+// ACLTokenExpiringListRequest is the request object used to list the ACL
+// tokens which expire within a time window.
+type ACLTokenExpiringListRequest struct {
+
+	// Within is the length of the time window, starting now. It defaults to
+	// the lead time used by servers to notify about expiring tokens.
+	Within time.Duration
+
+	QueryOptions
+}
+
+// ACLTokenExpiryNotification records that an ACLTokenExpiring event was
+// published for an ACL token. It's stored in state, so each expiration time
+// of a token is only notified about once, even across leader elections.
+type ACLTokenExpiryNotification struct {
+
+	// AccessorID is the accessor ID of the expiring ACL token.
+	AccessorID string
+
+	// ExpirationTime is the expiration time of the token when it was
+	// notified about. Tokens are notified about again if it changes.
+	ExpirationTime time.Time
+
+	CreateIndex uint64
+	ModifyIndex uint64
+}
+
+// ACLTokenExpiryNotificationsRequest is used by the leader to publish the
+// ACLTokenExpiring events of a number of ACL tokens through raft, and to
+// forget the notifications of tokens which no longer expire soon.
+type ACLTokenExpiryNotificationsRequest struct {
+
+	// Notifications are the ACL tokens to publish events for.
+	Notifications []*ACLTokenExpiryNotification
+
+	// Forget is the accessor IDs of the notifications to delete, because
+	// their tokens expired, were deleted or don't expire soon anymore.
+	Forget []string
+
+	WriteRequest
+}
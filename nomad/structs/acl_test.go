@@ -300,6 +300,15 @@ func TestACLToken_IsExpired(t *testing.T) {
 	}
 }
 
+func TestACLToken_IsLoginToken(t *testing.T) {
+	ci.Parallel(t)
+
+	must.True(t, (&ACLToken{Name: ACLAuthMethodTypeOIDC + "-auth0"}).IsLoginToken())
+	must.True(t, (&ACLToken{Name: ACLAuthMethodTypeWorkload + "-nomad-workloads"}).IsLoginToken())
+	must.False(t, (&ACLToken{Name: "my token"}).IsLoginToken())
+	must.False(t, (&ACLToken{Name: ACLAuthMethodTypeOIDC}).IsLoginToken())
+}
+
 func TestACLToken_HasRoles(t *testing.T) {
 	testCases := []struct {
 		name           string
@@ -1021,6 +1030,65 @@ func TestACLAuthMethod_Validate(t *testing.T) {
 		{"invalid token locality", &ACLAuthMethod{TokenLocality: "regional"}, true, "invalid token locality"},
 		{"invalid type", &ACLAuthMethod{Type: "groovy"}, true, "invalid token type"},
 		{"invalid max ttl", &ACLAuthMethod{MaxTokenTTL: badTTL}, true, "invalid token type"},
+		{
+			"valid ldap method",
+			&ACLAuthMethod{
+				Name:          "mock-auth-method",
+				Type:          "LDAP",
+				TokenLocality: "local",
+				MaxTokenTTL:   goodTTL,
+				Config: &ACLAuthMethodConfig{
+					LDAPURL:        "ldaps://ldap.example.com",
+					LDAPUserBaseDN: "ou=users,dc=example,dc=com",
+					LDAPUserFilter: "(sAMAccountName={{.Username}})",
+				},
+			},
+			false,
+			"",
+		},
+		{"missing ldap config", &ACLAuthMethod{Type: "LDAP"}, true, "missing LDAP config"},
+		{
+			"invalid ldap url",
+			&ACLAuthMethod{Type: "LDAP", Config: &ACLAuthMethodConfig{LDAPURL: "https://ldap.example.com"}},
+			true,
+			"scheme must be ldap or ldaps",
+		},
+		{
+			"ldap starttls with ldaps",
+			&ACLAuthMethod{Type: "LDAP", Config: &ACLAuthMethodConfig{
+				LDAPURL: "ldaps://ldap.example.com", LDAPStartTLS: true}},
+			true,
+			"LDAPStartTLS can't be used with ldaps URLs",
+		},
+		{
+			"ldap bind password without dn",
+			&ACLAuthMethod{Type: "LDAP", Config: &ACLAuthMethodConfig{LDAPBindPassword: "secret"}},
+			true,
+			"LDAPBindPassword requires LDAPBindDN",
+		},
+		{
+			"invalid ldap user filter",
+			&ACLAuthMethod{Type: "LDAP", Config: &ACLAuthMethodConfig{LDAPUserFilter: "(uid={{.Username)"}},
+			true,
+			"invalid LDAPUserFilter",
+		},
+		{
+			"valid workload method",
+			&ACLAuthMethod{
+				Name:          "mock-auth-method",
+				Type:          "WORKLOAD",
+				TokenLocality: "local",
+				MaxTokenTTL:   goodTTL,
+			},
+			false,
+			"",
+		},
+		{
+			"global workload method",
+			&ACLAuthMethod{Type: "WORKLOAD", TokenLocality: "global"},
+			true,
+			"WORKLOAD auth methods must use local token locality",
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -1139,6 +1207,23 @@ func TestACLAuthMethod_Canonicalize(t *testing.T) {
 	}
 }
 
+func TestACLAuthMethod_Canonicalize_Workload(t *testing.T) {
+	ci.Parallel(t)
+
+	// Workload auth methods map the claims of workload identities by default
+	method := &ACLAuthMethod{Type: ACLAuthMethodTypeWorkload}
+	method.Canonicalize()
+	must.Eq(t, WorkloadClaimMappings, method.Config.ClaimMappings)
+
+	// But custom mappings are kept
+	method = &ACLAuthMethod{
+		Type:   ACLAuthMethodTypeWorkload,
+		Config: &ACLAuthMethodConfig{ClaimMappings: map[string]string{"nomad_job_id": "job"}},
+	}
+	method.Canonicalize()
+	must.Eq(t, map[string]string{"nomad_job_id": "job"}, method.Config.ClaimMappings)
+}
+
 func TestACLAuthMethod_TokenLocalityIsGlobal(t *testing.T) {
 	ci.Parallel(t)
 
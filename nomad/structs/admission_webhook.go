@@ -0,0 +1,107 @@
+package structs
+
+import (
+	"github.com/hashicorp/nomad/helper/jsonpatch"
+)
+
+const (
+	// AdmissionOperationRegister, AdmissionOperationPlan and
+	// AdmissionOperationValidate are the operations that send jobs to
+	// admission webhooks.
+	AdmissionOperationRegister = "register"
+	AdmissionOperationPlan     = "plan"
+	AdmissionOperationValidate = "validate"
+)
+
+// AdmissionWebhookRequest is the body POSTed to external admission webhooks
+// configured on servers.
+type AdmissionWebhookRequest struct {
+	// Webhook is the name of the webhook configuration
+	Webhook string
+
+	// Kind is either "mutating" or "validating"
+	Kind string
+
+	// Operation is one of "register", "plan" or "validate"
+	Operation string
+
+	// Namespace is the namespace the job is submitted to
+	Namespace string
+
+	// Job is the submitted job after Nomad's built-in mutations. JSON
+	// patches returned by mutating webhooks apply to this document.
+	Job *Job
+
+	// Identity is the identity submitting the job
+	Identity *AdmissionWebhookIdentity
+}
+
+// AdmissionWebhookIdentity describes the identity submitting a job to an
+// admission webhook. It never includes secrets.
+type AdmissionWebhookIdentity struct {
+	// ACL token fields, set if the job was submitted with an ACL token
+	AccessorID string   `json:",omitempty"`
+	TokenName  string   `json:",omitempty"`
+	TokenType  string   `json:",omitempty"`
+	Policies   []string `json:",omitempty"`
+	RoleIDs    []string `json:",omitempty"`
+
+	// Workload identity fields, set if the job was submitted by a task
+	Namespace    string `json:",omitempty"`
+	JobID        string `json:",omitempty"`
+	AllocationID string `json:",omitempty"`
+	TaskName     string `json:",omitempty"`
+
+	ClientID string `json:",omitempty"`
+	TLSName  string `json:",omitempty"`
+	RemoteIP string `json:",omitempty"`
+}
+
+// NewAdmissionWebhookIdentity returns the webhook view of an authenticated
+// identity.
+func NewAdmissionWebhookIdentity(ai *AuthenticatedIdentity) *AdmissionWebhookIdentity {
+	if ai == nil {
+		return nil
+	}
+
+	id := &AdmissionWebhookIdentity{
+		ClientID: ai.ClientID,
+		TLSName:  ai.TLSName,
+	}
+	if ai.RemoteIP != nil {
+		id.RemoteIP = ai.RemoteIP.String()
+	}
+	if token := ai.ACLToken; token != nil {
+		id.AccessorID = token.AccessorID
+		id.TokenName = token.Name
+		id.TokenType = token.Type
+		id.Policies = token.Policies
+		for _, role := range token.Roles {
+			id.RoleIDs = append(id.RoleIDs, role.ID)
+		}
+	}
+	if claims := ai.Claims; claims != nil {
+		id.Namespace = claims.Namespace
+		id.JobID = claims.JobID
+		id.AllocationID = claims.AllocationID
+		id.TaskName = claims.TaskName
+	}
+	return id
+}
+
+// AdmissionWebhookResponse is the body returned by external admission
+// webhooks.
+type AdmissionWebhookResponse struct {
+	// Allowed must be true for the job to be admitted
+	Allowed bool
+
+	// Messages explain why the job was denied
+	Messages []string
+
+	// Warnings are returned to the submitter even if the job is admitted
+	Warnings []string
+
+	// Patch is a JSON patch applied to the job. Only mutating webhooks may
+	// return a patch.
+	Patch []jsonpatch.Operation
+}
@@ -0,0 +1,172 @@
+package config
+
+import (
+	"errors"
+	"fmt"
+	"net/url"
+	"time"
+
+	"github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/nomad/helper/pointer"
+	"golang.org/x/exp/maps"
+	"golang.org/x/exp/slices"
+)
+
+const (
+	// AdmissionWebhookMutating webhooks may return a JSON patch to apply to
+	// the job, as well as deny it.
+	AdmissionWebhookMutating = "mutating"
+
+	// AdmissionWebhookValidating webhooks may only allow or deny the job.
+	AdmissionWebhookValidating = "validating"
+
+	// DefaultAdmissionWebhookTimeout is the default time to wait for a
+	// webhook to respond.
+	DefaultAdmissionWebhookTimeout = 10 * time.Second
+)
+
+// AdmissionWebhookConfig configures an external admission webhook called
+// by servers when jobs are registered, planned or validated.
+type AdmissionWebhookConfig struct {
+	// Name identifies the webhook in warnings and errors
+	Name string `hcl:",key"`
+
+	// URL is the HTTP endpoint the job is POSTed to
+	URL string `hcl:"url"`
+
+	// Kind is either "mutating" or "validating"
+	Kind string `hcl:"kind"`
+
+	// Namespaces and JobTypes select the jobs sent to the webhook. Empty
+	// selectors match all jobs.
+	Namespaces []string `hcl:"namespaces"`
+	JobTypes   []string `hcl:"job_types"`
+
+	// Timeout is how long to wait for the webhook to respond
+	Timeout *string `hcl:"timeout"`
+
+	// FailOpen admits jobs when the webhook cannot be reached or fails.
+	// By default such jobs are rejected.
+	FailOpen *bool `hcl:"fail_open"`
+
+	// Headers are added to every request, typically for authentication
+	Headers map[string]string `hcl:"headers"`
+
+	// CAFile is the path to a CA certificate used to verify the webhook
+	CAFile string `hcl:"ca_file"`
+
+	// ExtraKeysHCL is used by hcl to surface unexpected keys
+	ExtraKeysHCL []string `hcl:",unusedKeys" json:"-"`
+}
+
+func (a *AdmissionWebhookConfig) Copy() *AdmissionWebhookConfig {
+	if a == nil {
+		return nil
+	}
+
+	c := *a
+	c.Namespaces = slices.Clone(a.Namespaces)
+	c.JobTypes = slices.Clone(a.JobTypes)
+	c.Timeout = pointer.Copy(a.Timeout)
+	c.FailOpen = pointer.Copy(a.FailOpen)
+	c.Headers = maps.Clone(a.Headers)
+	c.ExtraKeysHCL = slices.Clone(a.ExtraKeysHCL)
+	return &c
+}
+
+func (a *AdmissionWebhookConfig) Merge(o *AdmissionWebhookConfig) *AdmissionWebhookConfig {
+	m := a.Copy()
+
+	if o.URL != "" {
+		m.URL = o.URL
+	}
+	if o.Kind != "" {
+		m.Kind = o.Kind
+	}
+	if len(o.Namespaces) != 0 {
+		m.Namespaces = slices.Clone(o.Namespaces)
+	}
+	if len(o.JobTypes) != 0 {
+		m.JobTypes = slices.Clone(o.JobTypes)
+	}
+	if o.Timeout != nil {
+		m.Timeout = pointer.Copy(o.Timeout)
+	}
+	if o.FailOpen != nil {
+		m.FailOpen = pointer.Copy(o.FailOpen)
+	}
+	if len(o.Headers) != 0 {
+		m.Headers = maps.Clone(o.Headers)
+	}
+	if o.CAFile != "" {
+		m.CAFile = o.CAFile
+	}
+
+	return m
+}
+
+// TimeoutDuration returns the parsed timeout or the default if unset. The
+// config must have been validated.
+func (a *AdmissionWebhookConfig) TimeoutDuration() time.Duration {
+	if a.Timeout == nil || *a.Timeout == "" {
+		return DefaultAdmissionWebhookTimeout
+	}
+	d, _ := time.ParseDuration(*a.Timeout)
+	return d
+}
+
+func (a *AdmissionWebhookConfig) Validate() error {
+	var mErr *multierror.Error
+
+	if a.Name == "" {
+		mErr = multierror.Append(mErr, errors.New("name must be set"))
+	}
+
+	if a.URL == "" {
+		mErr = multierror.Append(mErr, errors.New("url must be set"))
+	} else if u, err := url.Parse(a.URL); err != nil {
+		mErr = multierror.Append(mErr, fmt.Errorf("url is invalid: %v", err))
+	} else if u.Scheme != "http" && u.Scheme != "https" {
+		mErr = multierror.Append(mErr, fmt.Errorf("url scheme must be http or https, not %q", u.Scheme))
+	}
+
+	switch a.Kind {
+	case AdmissionWebhookMutating, AdmissionWebhookValidating:
+	default:
+		mErr = multierror.Append(mErr, fmt.Errorf("kind must be %q or %q, not %q",
+			AdmissionWebhookMutating, AdmissionWebhookValidating, a.Kind))
+	}
+
+	if a.Timeout != nil && *a.Timeout != "" {
+		if d, err := time.ParseDuration(*a.Timeout); err != nil {
+			mErr = multierror.Append(mErr, fmt.Errorf("timeout is invalid: %v", err))
+		} else if d <= 0 {
+			mErr = multierror.Append(mErr, errors.New("timeout must be greater than zero"))
+		}
+	}
+
+	return mErr.ErrorOrNil()
+}
+
+// AdmissionWebhookSetMerge merges two sets of webhook configs. Webhooks
+// with the same name are merged, and the order of the first set is kept
+// since webhooks are called in order.
+func AdmissionWebhookSetMerge(first, second []*AdmissionWebhookConfig) []*AdmissionWebhookConfig {
+	out := make([]*AdmissionWebhookConfig, 0, len(first)+len(second))
+	index := make(map[string]int, len(first))
+	for _, w := range first {
+		index[w.Name] = len(out)
+		out = append(out, w.Copy())
+	}
+
+	for _, w := range second {
+		if i, ok := index[w.Name]; ok {
+			out[i] = out[i].Merge(w)
+			continue
+		}
+		index[w.Name] = len(out)
+		out = append(out, w.Copy())
+	}
+
+	return out
+}
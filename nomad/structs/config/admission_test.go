@@ -0,0 +1,66 @@
+package config
+
+import (
+	"testing"
+	"time"
+
+	"github.com/hashicorp/nomad/ci"
+	"github.com/hashicorp/nomad/helper/pointer"
+	"github.com/shoenig/test/must"
+)
+
+func TestAdmissionWebhookConfig_Validate(t *testing.T) {
+	ci.Parallel(t)
+
+	valid := &AdmissionWebhookConfig{
+		Name: "policy",
+		URL:  "https://policy.example.com/admit",
+		Kind: AdmissionWebhookValidating,
+	}
+	must.NoError(t, valid.Validate())
+	must.Eq(t, DefaultAdmissionWebhookTimeout, valid.TimeoutDuration())
+
+	valid.Timeout = pointer.Of("3s")
+	must.NoError(t, valid.Validate())
+	must.Eq(t, 3*time.Second, valid.TimeoutDuration())
+
+	invalid := &AdmissionWebhookConfig{
+		URL:     "ftp://policy.example.com",
+		Kind:    "rewriting",
+		Timeout: pointer.Of("-1s"),
+	}
+	err := invalid.Validate()
+	must.ErrorContains(t, err, "name must be set")
+	must.ErrorContains(t, err, `url scheme must be http or https, not "ftp"`)
+	must.ErrorContains(t, err, `kind must be "mutating" or "validating", not "rewriting"`)
+	must.ErrorContains(t, err, "timeout must be greater than zero")
+}
+
+func TestAdmissionWebhookSetMerge(t *testing.T) {
+	ci.Parallel(t)
+
+	first := []*AdmissionWebhookConfig{
+		{Name: "a", URL: "http://a", Kind: AdmissionWebhookMutating},
+		{Name: "b", URL: "http://b", Kind: AdmissionWebhookValidating},
+	}
+	second := []*AdmissionWebhookConfig{
+		{Name: "c", URL: "http://c", Kind: AdmissionWebhookValidating},
+		{Name: "a", FailOpen: pointer.Of(true), Namespaces: []string{"prod"}},
+	}
+
+	out := AdmissionWebhookSetMerge(first, second)
+	must.Eq(t, []*AdmissionWebhookConfig{
+		{
+			Name:       "a",
+			URL:        "http://a",
+			Kind:       AdmissionWebhookMutating,
+			FailOpen:   pointer.Of(true),
+			Namespaces: []string{"prod"},
+		},
+		{Name: "b", URL: "http://b", Kind: AdmissionWebhookValidating},
+		{Name: "c", URL: "http://c", Kind: AdmissionWebhookValidating},
+	}, out)
+
+	// The inputs are not modified
+	must.Nil(t, first[0].FailOpen)
+}
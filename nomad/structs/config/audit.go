@@ -19,6 +19,11 @@ type AuditConfig struct {
 	// from being written to a sink.
 	Filters []*AuditFilter `hcl:"filter"`
 
+	// HMACKey is the key used to hash sensitive fields, such as variable
+	// items and token secrets, before they are written to a sink. A random
+	// key is generated when the agent starts if it is not set.
+	HMACKey string `hcl:"hmac_key" json:"-"`
+
 	// ExtraKeysHCL is used by hcl to surface unexpected keys
 	ExtraKeysHCL []string `hcl:",unusedKeys" json:"-"`
 }
@@ -102,6 +107,10 @@ func (a *AuditConfig) Merge(b *AuditConfig) *AuditConfig {
 		result.Enabled = pointer.Of(*b.Enabled)
 	}
 
+	if b.HMACKey != "" {
+		result.HMACKey = b.HMACKey
+	}
+
 	// Merge Sinks
 	if len(a.Sinks) == 0 && len(b.Sinks) != 0 {
 		result.Sinks = copySliceAuditSink(b.Sinks)
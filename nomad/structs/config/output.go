@@ -0,0 +1,95 @@
+package config
+
+import (
+	"fmt"
+	"path/filepath"
+	"time"
+
+	"github.com/hashicorp/nomad/helper/pointer"
+	"golang.org/x/exp/slices"
+)
+
+// OutputConfig is the configuration specific to uploading the outputs of
+// tasks.
+type OutputConfig struct {
+	// UploadTimeout is the duration in which uploading the outputs of a task
+	// must complete or it will be canceled. Defaults to 30m.
+	UploadTimeout *string `hcl:"upload_timeout"`
+
+	// FileAllowlist is the list of host directories the outputs of tasks may
+	// be copied to with file destinations. Defaults to no directories, which
+	// disables file destinations.
+	FileAllowlist []string `hcl:"file_allowlist"`
+}
+
+func (o *OutputConfig) Copy() *OutputConfig {
+	if o == nil {
+		return nil
+	}
+	return &OutputConfig{
+		UploadTimeout: pointer.Copy(o.UploadTimeout),
+		FileAllowlist: slices.Clone(o.FileAllowlist),
+	}
+}
+
+func (o *OutputConfig) Merge(other *OutputConfig) *OutputConfig {
+	switch {
+	case o == nil:
+		return other.Copy()
+	case other == nil:
+		return o.Copy()
+	default:
+		result := &OutputConfig{
+			UploadTimeout: pointer.Merge(o.UploadTimeout, other.UploadTimeout),
+			FileAllowlist: slices.Clone(o.FileAllowlist),
+		}
+		if len(other.FileAllowlist) != 0 {
+			result.FileAllowlist = slices.Clone(other.FileAllowlist)
+		}
+		return result
+	}
+}
+
+func (o *OutputConfig) Equal(other *OutputConfig) bool {
+	if o == nil || other == nil {
+		return o == other
+	}
+	switch {
+	case !pointer.Eq(o.UploadTimeout, other.UploadTimeout):
+		return false
+	case !slices.Equal(o.FileAllowlist, other.FileAllowlist):
+		return false
+	}
+	return true
+}
+
+func (o *OutputConfig) Validate() error {
+	if o == nil {
+		return fmt.Errorf("output must not be nil")
+	}
+
+	if o.UploadTimeout == nil {
+		return fmt.Errorf("upload_timeout must be set")
+	}
+	if v, err := time.ParseDuration(*o.UploadTimeout); err != nil {
+		return fmt.Errorf("upload_timeout not a valid duration: %w", err)
+	} else if v <= 0 {
+		return fmt.Errorf("upload_timeout must be > 0")
+	}
+
+	for _, dir := range o.FileAllowlist {
+		if !filepath.IsAbs(dir) {
+			return fmt.Errorf("file_allowlist path %q must be absolute", dir)
+		}
+	}
+
+	return nil
+}
+
+func DefaultOutputConfig() *OutputConfig {
+	return &OutputConfig{
+		// Timeout for uploading the outputs of a task. Must be long enough
+		// to accommodate large/slow uploads.
+		UploadTimeout: pointer.Of("30m"),
+	}
+}
@@ -0,0 +1,77 @@
+package config
+
+import (
+	"testing"
+
+	"github.com/hashicorp/nomad/ci"
+	"github.com/hashicorp/nomad/helper/pointer"
+	"github.com/shoenig/test/must"
+)
+
+func TestOutputConfig_Validate(t *testing.T) {
+	ci.Parallel(t)
+
+	testCases := []struct {
+		name   string
+		config func(*OutputConfig)
+		expErr string
+	}{
+		{
+			name:   "default config is valid",
+			config: nil,
+			expErr: "",
+		},
+		{
+			name: "missing upload timeout",
+			config: func(o *OutputConfig) {
+				o.UploadTimeout = nil
+			},
+			expErr: "upload_timeout must be set",
+		},
+		{
+			name: "upload timeout is invalid",
+			config: func(o *OutputConfig) {
+				o.UploadTimeout = pointer.Of("invalid")
+			},
+			expErr: "upload_timeout not a valid duration",
+		},
+		{
+			name: "upload timeout is zero",
+			config: func(o *OutputConfig) {
+				o.UploadTimeout = pointer.Of("0s")
+			},
+			expErr: "upload_timeout must be > 0",
+		},
+		{
+			name: "upload timeout is negative",
+			config: func(o *OutputConfig) {
+				o.UploadTimeout = pointer.Of("-1m")
+			},
+			expErr: "upload_timeout must be > 0",
+		},
+		{
+			name: "file allowlist path is relative",
+			config: func(o *OutputConfig) {
+				o.FileAllowlist = []string{"outputs"}
+			},
+			expErr: `file_allowlist path "outputs" must be absolute`,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			o := DefaultOutputConfig()
+			if tc.config != nil {
+				tc.config(o)
+			}
+
+			err := o.Validate()
+			if tc.expErr != "" {
+				must.Error(t, err)
+				must.StrContains(t, err.Error(), tc.expErr)
+			} else {
+				must.NoError(t, err)
+			}
+		})
+	}
+}
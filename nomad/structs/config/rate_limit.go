@@ -0,0 +1,247 @@
+package config
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/nomad/helper"
+	"github.com/hashicorp/nomad/helper/pointer"
+	"golang.org/x/exp/slices"
+)
+
+// RateLimits are the number of requests per second an identity may make for
+// each kind of operation. The burst allowed is the same as the rate. A nil
+// limit is inherited from the enclosing block, and a limit of 0 is unlimited.
+type RateLimits struct {
+	Read  *int `hcl:"read"`
+	List  *int `hcl:"list"`
+	Write *int `hcl:"write"`
+}
+
+func (r *RateLimits) Copy() *RateLimits {
+	if r == nil {
+		return nil
+	}
+	return &RateLimits{
+		Read:  pointer.Copy(r.Read),
+		List:  pointer.Copy(r.List),
+		Write: pointer.Copy(r.Write),
+	}
+}
+
+func (r *RateLimits) Merge(o *RateLimits) *RateLimits {
+	m := r.Copy()
+	if m == nil {
+		m = &RateLimits{}
+	}
+	if o == nil {
+		return m
+	}
+	if o.Read != nil {
+		m.Read = pointer.Copy(o.Read)
+	}
+	if o.List != nil {
+		m.List = pointer.Copy(o.List)
+	}
+	if o.Write != nil {
+		m.Write = pointer.Copy(o.Write)
+	}
+	return m
+}
+
+// Get returns the limit for the operation, which is one of "read", "list" or
+// "write", and whether it is set.
+func (r *RateLimits) Get(op string) (int, bool) {
+	if r == nil {
+		return 0, false
+	}
+
+	var limit *int
+	switch op {
+	case "read":
+		limit = r.Read
+	case "list":
+		limit = r.List
+	case "write":
+		limit = r.Write
+	}
+	if limit == nil {
+		return 0, false
+	}
+	return *limit, true
+}
+
+func (r *RateLimits) validate() error {
+	var mErr *multierror.Error
+	for op, limit := range map[string]*int{"read": r.Read, "list": r.List, "write": r.Write} {
+		if limit != nil && *limit < 0 {
+			mErr = multierror.Append(mErr, fmt.Errorf("%s must not be negative", op))
+		}
+	}
+	return mErr.ErrorOrNil()
+}
+
+// RPCRateLimitConfig configures per-identity rate limits on server RPCs.
+// Requests are limited per identity, endpoint and operation, and requests
+// beyond the limit are rejected.
+type RPCRateLimitConfig struct {
+	// RateLimits are the default limits of all endpoints
+	RateLimits `hcl:",squash"`
+
+	// Endpoints override the defaults for an endpoint, such as "job"
+	Endpoints []*RPCRateLimitEndpoint `hcl:"endpoint"`
+
+	// Overrides override the limits for selected identities. The first
+	// override selecting a request applies.
+	Overrides []*RPCRateLimitOverride `hcl:"override"`
+
+	// ExtraKeysHCL is used by hcl to surface unexpected keys
+	ExtraKeysHCL []string `hcl:",unusedKeys" json:"-"`
+}
+
+// RPCRateLimitEndpoint sets the limits of an endpoint class.
+type RPCRateLimitEndpoint struct {
+	Name       string `hcl:",key"`
+	RateLimits `hcl:",squash"`
+
+	// ExtraKeysHCL is used by hcl to surface unexpected keys
+	ExtraKeysHCL []string `hcl:",unusedKeys" json:"-"`
+}
+
+func (e *RPCRateLimitEndpoint) Copy() *RPCRateLimitEndpoint {
+	if e == nil {
+		return nil
+	}
+	c := *e
+	c.RateLimits = *e.RateLimits.Copy()
+	c.ExtraKeysHCL = slices.Clone(e.ExtraKeysHCL)
+	return &c
+}
+
+// RPCRateLimitOverride sets the limits of requests made with one of the
+// given ACL tokens or roles, or to one of the given namespaces.
+type RPCRateLimitOverride struct {
+	Name string `hcl:",key"`
+
+	// AccessorIDs selects requests made with these ACL tokens
+	AccessorIDs []string `hcl:"accessor_ids"`
+
+	// Roles selects requests made with ACL tokens linked to these roles,
+	// by name or ID
+	Roles []string `hcl:"roles"`
+
+	// Namespaces selects requests to these namespaces
+	Namespaces []string `hcl:"namespaces"`
+
+	RateLimits `hcl:",squash"`
+
+	// ExtraKeysHCL is used by hcl to surface unexpected keys
+	ExtraKeysHCL []string `hcl:",unusedKeys" json:"-"`
+}
+
+func (o *RPCRateLimitOverride) Copy() *RPCRateLimitOverride {
+	if o == nil {
+		return nil
+	}
+	c := *o
+	c.AccessorIDs = slices.Clone(o.AccessorIDs)
+	c.Roles = slices.Clone(o.Roles)
+	c.Namespaces = slices.Clone(o.Namespaces)
+	c.RateLimits = *o.RateLimits.Copy()
+	c.ExtraKeysHCL = slices.Clone(o.ExtraKeysHCL)
+	return &c
+}
+
+func (r *RPCRateLimitConfig) Copy() *RPCRateLimitConfig {
+	if r == nil {
+		return nil
+	}
+	c := *r
+	c.RateLimits = *r.RateLimits.Copy()
+	c.Endpoints = helper.CopySlice(r.Endpoints)
+	c.Overrides = helper.CopySlice(r.Overrides)
+	c.ExtraKeysHCL = slices.Clone(r.ExtraKeysHCL)
+	return &c
+}
+
+// Merge returns a new config with the limits of o applied on top of r.
+// Endpoints and overrides with the same name are merged.
+func (r *RPCRateLimitConfig) Merge(o *RPCRateLimitConfig) *RPCRateLimitConfig {
+	if r == nil {
+		return o.Copy()
+	}
+	m := r.Copy()
+	if o == nil {
+		return m
+	}
+
+	m.RateLimits = *r.RateLimits.Merge(&o.RateLimits)
+
+	for _, oe := range o.Endpoints {
+		i := slices.IndexFunc(m.Endpoints, func(e *RPCRateLimitEndpoint) bool { return e.Name == oe.Name })
+		if i < 0 {
+			m.Endpoints = append(m.Endpoints, oe.Copy())
+			continue
+		}
+		m.Endpoints[i].RateLimits = *m.Endpoints[i].RateLimits.Merge(&oe.RateLimits)
+	}
+
+	for _, oo := range o.Overrides {
+		i := slices.IndexFunc(m.Overrides, func(ov *RPCRateLimitOverride) bool { return ov.Name == oo.Name })
+		if i < 0 {
+			m.Overrides = append(m.Overrides, oo.Copy())
+			continue
+		}
+		mo := m.Overrides[i]
+		if len(oo.AccessorIDs) != 0 {
+			mo.AccessorIDs = slices.Clone(oo.AccessorIDs)
+		}
+		if len(oo.Roles) != 0 {
+			mo.Roles = slices.Clone(oo.Roles)
+		}
+		if len(oo.Namespaces) != 0 {
+			mo.Namespaces = slices.Clone(oo.Namespaces)
+		}
+		mo.RateLimits = *mo.RateLimits.Merge(&oo.RateLimits)
+	}
+
+	return m
+}
+
+func (r *RPCRateLimitConfig) Validate() error {
+	if r == nil {
+		return nil
+	}
+
+	var mErr *multierror.Error
+	if err := r.RateLimits.validate(); err != nil {
+		mErr = multierror.Append(mErr, err)
+	}
+
+	seen := make(map[string]struct{})
+	for _, e := range r.Endpoints {
+		if _, ok := seen[e.Name]; ok {
+			mErr = multierror.Append(mErr, fmt.Errorf("endpoint %q is defined more than once", e.Name))
+		}
+		seen[e.Name] = struct{}{}
+		if err := e.RateLimits.validate(); err != nil {
+			mErr = multierror.Append(mErr, fmt.Errorf("endpoint %q: %v", e.Name, err))
+		}
+	}
+
+	seen = make(map[string]struct{})
+	for _, o := range r.Overrides {
+		if _, ok := seen[o.Name]; ok {
+			mErr = multierror.Append(mErr, fmt.Errorf("override %q is defined more than once", o.Name))
+		}
+		seen[o.Name] = struct{}{}
+		if len(o.AccessorIDs) == 0 && len(o.Roles) == 0 && len(o.Namespaces) == 0 {
+			mErr = multierror.Append(mErr, fmt.Errorf("override %q must select accessor_ids, roles or namespaces", o.Name))
+		}
+		if err := o.RateLimits.validate(); err != nil {
+			mErr = multierror.Append(mErr, fmt.Errorf("override %q: %v", o.Name, err))
+		}
+	}
+
+	return mErr.ErrorOrNil()
+}
@@ -0,0 +1,74 @@
+package config
+
+import (
+	"testing"
+
+	"github.com/hashicorp/nomad/ci"
+	"github.com/hashicorp/nomad/helper/pointer"
+	"github.com/shoenig/test/must"
+)
+
+func TestRPCRateLimitConfig_Merge(t *testing.T) {
+	ci.Parallel(t)
+
+	a := &RPCRateLimitConfig{
+		RateLimits: RateLimits{Read: pointer.Of(100), Write: pointer.Of(10)},
+		Endpoints: []*RPCRateLimitEndpoint{
+			{Name: "job", RateLimits: RateLimits{Write: pointer.Of(5)}},
+		},
+		Overrides: []*RPCRateLimitOverride{
+			{Name: "ci", Roles: []string{"ci"}, RateLimits: RateLimits{Write: pointer.Of(1)}},
+		},
+	}
+	b := &RPCRateLimitConfig{
+		RateLimits: RateLimits{Write: pointer.Of(20)},
+		Endpoints: []*RPCRateLimitEndpoint{
+			{Name: "job", RateLimits: RateLimits{Read: pointer.Of(50)}},
+			{Name: "node", RateLimits: RateLimits{Write: pointer.Of(0)}},
+		},
+		Overrides: []*RPCRateLimitOverride{
+			{Name: "ci", Namespaces: []string{"ci"}},
+		},
+	}
+
+	must.Eq(t, &RPCRateLimitConfig{
+		RateLimits: RateLimits{Read: pointer.Of(100), Write: pointer.Of(20)},
+		Endpoints: []*RPCRateLimitEndpoint{
+			{Name: "job", RateLimits: RateLimits{Read: pointer.Of(50), Write: pointer.Of(5)}},
+			{Name: "node", RateLimits: RateLimits{Write: pointer.Of(0)}},
+		},
+		Overrides: []*RPCRateLimitOverride{
+			{
+				Name:       "ci",
+				Roles:      []string{"ci"},
+				Namespaces: []string{"ci"},
+				RateLimits: RateLimits{Write: pointer.Of(1)},
+			},
+		},
+	}, a.Merge(b))
+
+	// The inputs are not modified
+	must.Nil(t, a.Endpoints[0].Read)
+	must.Eq(t, 10, *a.Write)
+}
+
+func TestRPCRateLimitConfig_Validate(t *testing.T) {
+	ci.Parallel(t)
+
+	var nilConfig *RPCRateLimitConfig
+	must.NoError(t, nilConfig.Validate())
+
+	err := (&RPCRateLimitConfig{
+		RateLimits: RateLimits{Read: pointer.Of(-1)},
+		Endpoints: []*RPCRateLimitEndpoint{
+			{Name: "job"},
+			{Name: "job"},
+		},
+		Overrides: []*RPCRateLimitOverride{
+			{Name: "empty"},
+		},
+	}).Validate()
+	must.ErrorContains(t, err, "read must not be negative")
+	must.ErrorContains(t, err, `endpoint "job" is defined more than once`)
+	must.ErrorContains(t, err, `override "empty" must select accessor_ids, roles or namespaces`)
+}
@@ -527,6 +527,17 @@ func (t *Task) Diff(other *Task, contextual bool) (*TaskDiff, error) {
 		diff.Objects = append(diff.Objects, diffs...)
 	}
 
+	// Outputs diff
+	diffs = primitiveObjectSetDiff(
+		interfaceSlice(t.Outputs),
+		interfaceSlice(other.Outputs),
+		nil,
+		"Output",
+		contextual)
+	if diffs != nil {
+		diff.Objects = append(diff.Objects, diffs...)
+	}
+
 	// Services diff
 	if sDiffs := serviceDiffs(t.Services, other.Services, contextual); sDiffs != nil {
 		diff.Objects = append(diff.Objects, sDiffs...)
@@ -550,6 +561,11 @@ func (t *Task) Diff(other *Task, contextual bool) (*TaskDiff, error) {
 		diff.Objects = append(diff.Objects, idDiffs)
 	}
 
+	// PreStop diff
+	if psDiff := preStopDiff(t.PreStop, other.PreStop, contextual); psDiff != nil {
+		diff.Objects = append(diff.Objects, psDiff)
+	}
+
 	return diff, nil
 }
 
@@ -2402,6 +2418,40 @@ func idDiff(oldWI, newWI *WorkloadIdentity, contextual bool) *ObjectDiff {
 	return diff
 }
 
+// preStopDiff returns the diff of two prestop blocks, including the fields of
+// their exec or http action.
+func preStopDiff(old, new *TaskPreStop, contextual bool) *ObjectDiff {
+	diff := &ObjectDiff{Type: DiffTypeNone, Name: "PreStop"}
+	var oldFlat, newFlat map[string]string
+
+	if reflect.DeepEqual(old, new) {
+		return nil
+	} else if old == nil {
+		diff.Type = DiffTypeAdded
+		newFlat = flatmap.Flatten(new, nil, false)
+	} else if new == nil {
+		diff.Type = DiffTypeDeleted
+		oldFlat = flatmap.Flatten(old, nil, false)
+	} else {
+		diff.Type = DiffTypeEdited
+		oldFlat = flatmap.Flatten(old, nil, false)
+		newFlat = flatmap.Flatten(new, nil, false)
+	}
+
+	// Only one of the actions is set, so drop the one that isn't
+	for _, action := range []string{"Exec", "HTTP"} {
+		if oldFlat[action] == "nil" {
+			delete(oldFlat, action)
+		}
+		if newFlat[action] == "nil" {
+			delete(newFlat, action)
+		}
+	}
+
+	diff.Fields = fieldDiffs(oldFlat, newFlat, contextual)
+	return diff
+}
+
 // ObjectDiff contains the diff of two generic objects.
 type ObjectDiff struct {
 	Type    DiffType
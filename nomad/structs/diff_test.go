@@ -7639,6 +7639,86 @@ func TestTaskDiff(t *testing.T) {
 				},
 			},
 		},
+		{
+			Name: "PreStop added",
+			Old:  &Task{},
+			New: &Task{
+				PreStop: &TaskPreStop{
+					Exec: &TaskPreStopExec{
+						Command: "/bin/drain",
+						Args:    []string{"-wait"},
+					},
+					Timeout: 5 * time.Second,
+				},
+			},
+			Expected: &TaskDiff{
+				Type: DiffTypeEdited,
+				Objects: []*ObjectDiff{
+					{
+						Type: DiffTypeAdded,
+						Name: "PreStop",
+						Fields: []*FieldDiff{
+							{
+								Type: DiffTypeAdded,
+								Name: "Exec.Args[0]",
+								Old:  "",
+								New:  "-wait",
+							},
+							{
+								Type: DiffTypeAdded,
+								Name: "Exec.Command",
+								Old:  "",
+								New:  "/bin/drain",
+							},
+							{
+								Type: DiffTypeAdded,
+								Name: "Timeout",
+								Old:  "",
+								New:  "5000000000",
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			Name: "PreStop modified",
+			Old: &Task{
+				PreStop: &TaskPreStop{
+					HTTP: &TaskPreStopHTTP{
+						Port: "admin",
+						Path: "/drain",
+					},
+					Timeout: 5 * time.Second,
+				},
+			},
+			New: &Task{
+				PreStop: &TaskPreStop{
+					HTTP: &TaskPreStopHTTP{
+						Port: "admin",
+						Path: "/shutdown",
+					},
+					Timeout: 5 * time.Second,
+				},
+			},
+			Expected: &TaskDiff{
+				Type: DiffTypeEdited,
+				Objects: []*ObjectDiff{
+					{
+						Type: DiffTypeEdited,
+						Name: "PreStop",
+						Fields: []*FieldDiff{
+							{
+								Type: DiffTypeEdited,
+								Name: "HTTP.Path",
+								Old:  "/drain",
+								New:  "/shutdown",
+							},
+						},
+					},
+				},
+			},
+		},
 	}
 
 	for _, c := range cases {
@@ -3,8 +3,10 @@ package structs
 import (
 	"errors"
 	"fmt"
+	"net/http"
 	"strconv"
 	"strings"
+	"time"
 )
 
 const (
@@ -23,6 +25,7 @@ const (
 	errMissingAllocID             = "Missing allocation ID"
 	errIncompatibleFiltering      = "Filter expression cannot be used with other filter parameters"
 	errMalformedChooseParameter   = "Parameter for choose must be in form '<number>|<key>'"
+	errRateLimited                = "Rate limit exceeded"
 
 	// Prefix based errors that are used to check if the error is of a given
 	// type. These errors should be created with the associated constructor.
@@ -206,6 +209,36 @@ func NewErrRPCCodedf(code int, format string, args ...interface{}) error {
 	return fmt.Errorf("%s%d,%s", errRPCCodedErrorPrefix, code, msg)
 }
 
+// NewErrRateLimited returns an RPC error, converted to HTTP status code 429,
+// for a request that was rejected because its identity exceeded its rate
+// limit. retryAfter is how long to wait before the request may be retried.
+func NewErrRateLimited(retryAfter time.Duration) error {
+	return NewErrRPCCodedf(http.StatusTooManyRequests, "%s, retry after %s",
+		errRateLimited, retryAfter.Round(time.Millisecond))
+}
+
+// IsErrRateLimited returns whether the error is due to the request being
+// rejected by a rate limit.
+func IsErrRateLimited(err error) bool {
+	return err != nil && strings.Contains(err.Error(), errRateLimited)
+}
+
+// RetryAfterFromRateLimitedErr returns how long to wait before retrying a
+// request rejected with an error created by NewErrRateLimited, or the
+// message of such an error. Returns `ok` false if the error is not a rate
+// limit error.
+func RetryAfterFromRateLimitedErr(msg string) (retryAfter time.Duration, ok bool) {
+	_, after, found := strings.Cut(msg, errRateLimited+", retry after ")
+	if !found {
+		return 0, false
+	}
+	retryAfter, err := time.ParseDuration(after)
+	if err != nil {
+		return 0, false
+	}
+	return retryAfter, true
+}
+
 // CodeFromRPCCodedErr returns the code and message of error if it's an RPC error
 // created through NewErrRPCCoded function.  Returns `ok` false if error is not
 // an rpc error
@@ -3,6 +3,7 @@ package structs
 import (
 	"errors"
 	"testing"
+	"time"
 
 	"github.com/hashicorp/nomad/ci"
 	"github.com/stretchr/testify/assert"
@@ -50,3 +51,21 @@ func TestRPCCodedErrors(t *testing.T) {
 		})
 	}
 }
+
+func TestRateLimitedErrors(t *testing.T) {
+	ci.Parallel(t)
+
+	err := NewErrRateLimited(1500 * time.Millisecond)
+	assert.True(t, IsErrRateLimited(err))
+
+	code, msg, ok := CodeFromRPCCodedErr(err)
+	assert.True(t, ok)
+	assert.Equal(t, 429, code)
+
+	retryAfter, ok := RetryAfterFromRateLimitedErr(msg)
+	assert.True(t, ok)
+	assert.Equal(t, 1500*time.Millisecond, retryAfter)
+
+	_, ok = RetryAfterFromRateLimitedErr("random error")
+	assert.False(t, ok)
+}
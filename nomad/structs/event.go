@@ -27,6 +27,12 @@ const (
 	TopicACLAuthMethod  Topic = "ACLAuthMethod"
 	TopicACLBindingRule Topic = "ACLBindingRule"
 	TopicService        Topic = "Service"
+	TopicVariables      Topic = "Variables"
+	TopicNamespace      Topic = "Namespace"
+	TopicCSIVolume      Topic = "CSIVolume"
+	TopicCSIPlugin      Topic = "CSIPlugin"
+	TopicScalingPolicy  Topic = "ScalingPolicy"
+	TopicRootKey        Topic = "RootKey"
 	TopicAll            Topic = "*"
 
 	TypeNodeRegistration              = "NodeRegistration"
@@ -47,6 +53,7 @@ const (
 	TypePlanResult                    = "PlanResult"
 	TypeACLTokenDeleted               = "ACLTokenDeleted"
 	TypeACLTokenUpserted              = "ACLTokenUpserted"
+	TypeACLTokenExpiring              = "ACLTokenExpiring"
 	TypeACLPolicyDeleted              = "ACLPolicyDeleted"
 	TypeACLPolicyUpserted             = "ACLPolicyUpserted"
 	TypeACLRoleDeleted                = "ACLRoleDeleted"
@@ -57,6 +64,19 @@ const (
 	TypeACLBindingRuleDeleted         = "ACLBindingRuleDeleted"
 	TypeServiceRegistration           = "ServiceRegistration"
 	TypeServiceDeregistration         = "ServiceDeregistration"
+	TypeVariableUpserted              = "VariableUpserted"
+	TypeVariableDeleted               = "VariableDeleted"
+	TypeNamespaceUpserted             = "NamespaceUpserted"
+	TypeNamespaceDeleted              = "NamespaceDeleted"
+	TypeCSIVolumeRegistered           = "CSIVolumeRegistered"
+	TypeCSIVolumeDeregistered         = "CSIVolumeDeregistered"
+	TypeCSIVolumeClaim                = "CSIVolumeClaim"
+	TypeCSIPluginUpserted             = "CSIPluginUpserted"
+	TypeCSIPluginDeleted              = "CSIPluginDeleted"
+	TypeScalingPolicyUpserted         = "ScalingPolicyUpserted"
+	TypeScalingPolicyDeleted          = "ScalingPolicyDeleted"
+	TypeRootKeyMetaUpserted           = "RootKeyMetaUpserted"
+	TypeRootKeyMetaDeleted            = "RootKeyMetaDeleted"
 )
 
 // Event represents a change in Nomads state.
@@ -178,3 +198,36 @@ type ACLAuthMethodEvent struct {
 type ACLBindingRuleEvent struct {
 	ACLBindingRule *ACLBindingRule
 }
+
+// VariableEvent holds the metadata of a newly updated or deleted variable.
+// The items of the variable are never included.
+type VariableEvent struct {
+	Variable *VariableMetadata
+}
+
+// NamespaceEvent holds a newly updated or deleted namespace.
+type NamespaceEvent struct {
+	Namespace *Namespace
+}
+
+// CSIVolumeEvent holds a newly updated or deleted CSI volume. The secrets of
+// the volume have been removed.
+type CSIVolumeEvent struct {
+	Volume *CSIVolume
+}
+
+// CSIPluginEvent holds a newly updated or deleted CSI plugin.
+type CSIPluginEvent struct {
+	Plugin *CSIPlugin
+}
+
+// ScalingPolicyEvent holds a newly updated or deleted scaling policy.
+type ScalingPolicyEvent struct {
+	ScalingPolicy *ScalingPolicy
+}
+
+// RootKeyMetaEvent holds the metadata of a newly updated or deleted root
+// key. The key material is never included.
+type RootKeyMetaEvent struct {
+	RootKeyMeta *RootKeyMeta
+}
@@ -0,0 +1,272 @@
+package structs
+
+import (
+	"errors"
+	"fmt"
+	"net/url"
+	"regexp"
+	"time"
+
+	"github.com/hashicorp/go-bexpr"
+	"github.com/hashicorp/go-multierror"
+	"golang.org/x/exp/maps"
+	"golang.org/x/exp/slices"
+)
+
+const (
+	// EventSinkWebhook is the only supported event sink type. Webhook sinks
+	// POST batches of events to an HTTP endpoint.
+	EventSinkWebhook = "webhook"
+
+	// EventSinkDefaultBatchSize is the number of events sent to a sink in a
+	// single request when the sink doesn't set a batch size.
+	EventSinkDefaultBatchSize = 100
+
+	// EventSinkMaxBatchSize is the largest batch size a sink may set.
+	EventSinkMaxBatchSize = 10000
+)
+
+var (
+	// validEventSinkID is used to validate an event sink ID
+	validEventSinkID = regexp.MustCompile("^[a-zA-Z0-9-_]{1,128}$")
+)
+
+// EventSink is a destination that the leader delivers events from the event
+// stream to. Delivery is at-least-once: the index of the last event the
+// sink acknowledged is stored in Raft, so a new leader resumes delivery from
+// where the previous one stopped.
+type EventSink struct {
+	// ID is the unique, user provided identifier of the sink
+	ID string
+
+	// Type is the type of the sink. Only "webhook" is supported.
+	Type string
+
+	// Address is the URL events are POSTed to
+	Address string
+
+	// Topics are the topics and keys of the events sent to the sink, in the
+	// same format as the event stream topic query parameters.
+	Topics map[Topic][]string
+
+	// Namespace restricts the sink to events of a single namespace. "*"
+	// includes events of all namespaces.
+	Namespace string
+
+	// Filter is a go-bexpr expression evaluated against the payload of each
+	// event. Only events which match the expression are sent.
+	Filter string
+
+	// BatchSize is the maximum number of events sent in a single request
+	BatchSize int
+
+	// LatestIndex is the Raft index of the latest event acknowledged by the
+	// sink. Delivery resumes after this index when leadership changes.
+	LatestIndex uint64
+
+	CreateIndex uint64
+	ModifyIndex uint64
+}
+
+// Canonicalize sets the defaults of an event sink.
+func (e *EventSink) Canonicalize() {
+	if e.Type == "" {
+		e.Type = EventSinkWebhook
+	}
+	if len(e.Topics) == 0 {
+		e.Topics = map[Topic][]string{TopicAll: {string(TopicAll)}}
+	}
+	if e.Namespace == "" {
+		e.Namespace = "*"
+	}
+	if e.BatchSize == 0 {
+		e.BatchSize = EventSinkDefaultBatchSize
+	}
+}
+
+// Validate returns an error if the event sink is invalid.
+func (e *EventSink) Validate() error {
+	var mErr multierror.Error
+
+	if !validEventSinkID.MatchString(e.ID) {
+		mErr.Errors = append(mErr.Errors,
+			fmt.Errorf("invalid ID %q. Must match regex %s", e.ID, validEventSinkID))
+	}
+
+	if e.Type != EventSinkWebhook {
+		mErr.Errors = append(mErr.Errors, fmt.Errorf("unsupported sink type %q", e.Type))
+	}
+
+	if e.Address == "" {
+		mErr.Errors = append(mErr.Errors, errors.New("missing address"))
+	} else if u, err := url.Parse(e.Address); err != nil {
+		mErr.Errors = append(mErr.Errors, fmt.Errorf("invalid address: %v", err))
+	} else if u.Scheme != "http" && u.Scheme != "https" {
+		mErr.Errors = append(mErr.Errors,
+			fmt.Errorf("invalid address %q: scheme must be http or https", e.Address))
+	}
+
+	if e.Namespace != "*" && !validNamespaceName.MatchString(e.Namespace) {
+		mErr.Errors = append(mErr.Errors, fmt.Errorf("invalid namespace %q", e.Namespace))
+	}
+
+	for topic, keys := range e.Topics {
+		if len(keys) == 0 {
+			mErr.Errors = append(mErr.Errors, fmt.Errorf("topic %q has no keys", topic))
+		}
+	}
+
+	if e.Filter != "" {
+		if _, err := bexpr.CreateEvaluator(e.Filter); err != nil {
+			mErr.Errors = append(mErr.Errors, fmt.Errorf("invalid filter: %v", err))
+		}
+	}
+
+	if e.BatchSize < 1 || e.BatchSize > EventSinkMaxBatchSize {
+		mErr.Errors = append(mErr.Errors,
+			fmt.Errorf("batch size must be between 1 and %d", EventSinkMaxBatchSize))
+	}
+
+	return mErr.ErrorOrNil()
+}
+
+// Copy returns a deep copy of the event sink.
+func (e *EventSink) Copy() *EventSink {
+	if e == nil {
+		return nil
+	}
+
+	ne := new(EventSink)
+	*ne = *e
+
+	if e.Topics != nil {
+		ne.Topics = make(map[Topic][]string, len(e.Topics))
+		for topic, keys := range e.Topics {
+			ne.Topics[topic] = slices.Clone(keys)
+		}
+	}
+	return ne
+}
+
+// Equal returns whether the configuration of two event sinks is equal. The
+// delivery progress and Raft indexes aren't compared.
+func (e *EventSink) Equal(o *EventSink) bool {
+	if e == nil || o == nil {
+		return e == o
+	}
+
+	return e.ID == o.ID &&
+		e.Type == o.Type &&
+		e.Address == o.Address &&
+		e.Namespace == o.Namespace &&
+		e.Filter == o.Filter &&
+		e.BatchSize == o.BatchSize &&
+		maps.EqualFunc(e.Topics, o.Topics, slices.Equal[string])
+}
+
+// EventSinkStatus is the delivery status of an event sink, as tracked by the
+// leader.
+type EventSinkStatus struct {
+	// ID is the ID of the sink
+	ID string
+
+	// Running is true if the leader is delivering events to the sink
+	Running bool
+
+	// Healthy is true if the last delivery attempt to the sink succeeded
+	// and the sink isn't lagging.
+	Healthy bool
+
+	// Lagging is true if events for the sink were dropped from the leader's
+	// event buffer before they could be delivered, so the sink missed them.
+	Lagging bool
+
+	// LatestIndex is the index of the latest event acknowledged by the sink
+	LatestIndex uint64
+
+	// Lag is the number of Raft indexes the sink is behind the latest
+	// event published by the leader.
+	Lag uint64
+
+	// LastError is the error of the last failed delivery attempt, if it
+	// failed after the last successful one.
+	LastError string
+
+	// Failures is the number of consecutive failed delivery attempts
+	Failures int
+
+	// LastSuccess and LastFailure are the times of the last successful and
+	// failed delivery attempts.
+	LastSuccess time.Time
+	LastFailure time.Time
+}
+
+// EventSinkPayload is the body POSTed to webhook event sinks.
+type EventSinkPayload struct {
+	// Sink is the ID of the sink the events are delivered to
+	Sink string
+
+	// Index is the index of the latest event in the batch
+	Index uint64
+
+	// Events are the events being delivered
+	Events []Event
+}
+
+// EventSinkUpsertRequest is used to register or update event sinks.
+type EventSinkUpsertRequest struct {
+	Sinks []*EventSink
+	WriteRequest
+}
+
+// EventSinkDeleteRequest is used to deregister event sinks.
+type EventSinkDeleteRequest struct {
+	IDs []string
+	WriteRequest
+}
+
+// EventSinkProgressRequest is used by the leader to persist the latest index
+// acknowledged by event sinks.
+type EventSinkProgressRequest struct {
+	// Progress maps sink IDs to the index of the latest event they
+	// acknowledged.
+	Progress map[string]uint64
+	WriteRequest
+}
+
+// EventSinkSpecificRequest is used to query a single event sink.
+type EventSinkSpecificRequest struct {
+	ID string
+	QueryOptions
+}
+
+// EventSinkResponse is used to return a single event sink.
+type EventSinkResponse struct {
+	Sink *EventSink
+	QueryMeta
+}
+
+// EventSinkListRequest is used to list event sinks.
+type EventSinkListRequest struct {
+	QueryOptions
+}
+
+// EventSinkListResponse is used to return a list of event sinks.
+type EventSinkListResponse struct {
+	Sinks []*EventSink
+	QueryMeta
+}
+
+// EventSinkStatusRequest is used to query the delivery status of event
+// sinks. The status of all sinks is returned if ID is empty.
+type EventSinkStatusRequest struct {
+	ID string
+	QueryOptions
+}
+
+// EventSinkStatusResponse is used to return the delivery status of event
+// sinks.
+type EventSinkStatusResponse struct {
+	Statuses []*EventSinkStatus
+	QueryMeta
+}
@@ -0,0 +1,80 @@
+package structs
+
+import (
+	"testing"
+
+	"github.com/hashicorp/nomad/ci"
+	"github.com/shoenig/test/must"
+)
+
+func TestEventSink_Canonicalize(t *testing.T) {
+	ci.Parallel(t)
+
+	sink := &EventSink{ID: "sink", Address: "http://127.0.0.1"}
+	sink.Canonicalize()
+	must.Eq(t, EventSinkWebhook, sink.Type)
+	must.Eq(t, map[Topic][]string{TopicAll: {"*"}}, sink.Topics)
+	must.Eq(t, "*", sink.Namespace)
+	must.Eq(t, EventSinkDefaultBatchSize, sink.BatchSize)
+}
+
+func TestEventSink_Validate(t *testing.T) {
+	ci.Parallel(t)
+
+	valid := func() *EventSink {
+		sink := &EventSink{
+			ID:      "my-sink",
+			Address: "https://example.com/events",
+			Topics:  map[Topic][]string{TopicJob: {"*"}},
+			Filter:  `Job.Type == "service"`,
+		}
+		sink.Canonicalize()
+		return sink
+	}
+	must.NoError(t, valid().Validate())
+
+	cases := []struct {
+		name   string
+		modify func(*EventSink)
+		err    string
+	}{
+		{"id", func(s *EventSink) { s.ID = "my sink" }, "invalid ID"},
+		{"type", func(s *EventSink) { s.Type = "kafka" }, `unsupported sink type "kafka"`},
+		{"missing address", func(s *EventSink) { s.Address = "" }, "missing address"},
+		{"address scheme", func(s *EventSink) { s.Address = "ftp://example.com" }, "scheme must be http or https"},
+		{"namespace", func(s *EventSink) { s.Namespace = "a b" }, "invalid namespace"},
+		{"topic keys", func(s *EventSink) { s.Topics[TopicNode] = nil }, `topic "Node" has no keys`},
+		{"filter", func(s *EventSink) { s.Filter = "Job.Type ==" }, "invalid filter"},
+		{"batch size", func(s *EventSink) { s.BatchSize = EventSinkMaxBatchSize + 1 }, "batch size must be between"},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			sink := valid()
+			tc.modify(sink)
+			must.ErrorContains(t, sink.Validate(), tc.err)
+		})
+	}
+}
+
+func TestEventSink_Equal(t *testing.T) {
+	ci.Parallel(t)
+
+	sink := &EventSink{
+		ID:          "my-sink",
+		Type:        EventSinkWebhook,
+		Address:     "http://127.0.0.1",
+		Topics:      map[Topic][]string{TopicJob: {"*"}},
+		LatestIndex: 10,
+		ModifyIndex: 5,
+	}
+
+	// Progress and indexes aren't part of the configuration
+	other := sink.Copy()
+	other.LatestIndex = 20
+	other.ModifyIndex = 15
+	must.True(t, sink.Equal(other))
+
+	other.Topics[TopicJob] = append(other.Topics[TopicJob], "example")
+	must.False(t, sink.Equal(other))
+	must.Eq(t, []string{"*"}, sink.Topics[TopicJob])
+}
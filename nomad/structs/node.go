@@ -300,6 +300,13 @@ func (c *CSIInfo) IsNode() bool {
 	return true
 }
 
+// DriverUpdateTaskResourcesAttr returns the attribute set to "true" on the
+// driver info of drivers which can update the cpu and memory of running
+// tasks.
+func DriverUpdateTaskResourcesAttr(driver string) string {
+	return "driver." + driver + ".update_task_resources"
+}
+
 // DriverInfo is the current state of a single driver. This is updated
 // regularly as driver health changes on the node.
 type DriverInfo struct {
@@ -307,6 +314,7 @@ type DriverInfo struct {
 	Detected          bool
 	Healthy           bool
 	HealthDescription string
+	PluginVersion     string
 	UpdateTime        time.Time
 }
 
@@ -334,6 +342,7 @@ func (di *DriverInfo) MergeHealthCheck(other *DriverInfo) {
 func (di *DriverInfo) MergeFingerprintInfo(other *DriverInfo) {
 	di.Detected = other.Detected
 	di.Attributes = other.Attributes
+	di.PluginVersion = other.PluginVersion
 }
 
 // HealthCheckEquals determines if two driver info objects are equal. As this
@@ -401,3 +410,39 @@ type NodeMetaResponse struct {
 	// Static is the static Node metadata (set via agent configuration)
 	Static map[string]string
 }
+
+// NodePluginReloadRequest is used to reload an external driver or device
+// plugin on Client agents.
+type NodePluginReloadRequest struct {
+	QueryOptions // Client RPCs must use QueryOptions to set AllowStale=true
+
+	// NodeID is the node being targeted by this request (or the node
+	// receiving this request if NodeID is empty).
+	NodeID string
+
+	// Name is the name of the plugin to reload.
+	Name string
+}
+
+func (n *NodePluginReloadRequest) Validate() error {
+	if n.Name == "" {
+		return fmt.Errorf("missing required plugin name")
+	}
+	return nil
+}
+
+// NodePluginReloadResponse is the result of reloading a plugin on a Client
+// agent.
+type NodePluginReloadResponse struct {
+	// Name is the name of the reloaded plugin
+	Name string
+
+	// Type is the type of the reloaded plugin, either driver or device
+	Type string
+
+	// PreviousVersion is the version of the plugin that was replaced
+	PreviousVersion string
+
+	// Version is the version of the plugin now running
+	Version string
+}
@@ -16,7 +16,9 @@ import (
 	"hash/crc32"
 	"math"
 	"net"
+	"net/http"
 	"os"
+	"path/filepath"
 	"reflect"
 	"regexp"
 	"sort"
@@ -123,6 +125,16 @@ const (
 	ACLBindingRulesUpsertRequestType             MessageType = 57
 	ACLBindingRulesDeleteRequestType             MessageType = 58
 
+	// The event sink message types replace EventSinkUpsertRequestType,
+	// EventSinkDeleteRequestType and BatchEventSinkUpdateProgressType, which
+	// were removed during the 1.0 betas and are ignored by the FSM. They
+	// can't be reused, as old logs may still contain them.
+	EventSinkRegisterRequestType   MessageType = 59
+	EventSinkDeregisterRequestType MessageType = 60
+	EventSinkProgressRequestType   MessageType = 61
+
+	ACLTokenExpiryNotificationsRequestType MessageType = 62
+
 	// Namespace types were moved from enterprise and therefore start at 64
 	NamespaceUpsertRequestType MessageType = 64
 	NamespaceDeleteRequestType MessageType = 65
@@ -1165,6 +1177,24 @@ type AllocRestartRequest struct {
 	QueryOptions
 }
 
+// AllocPauseRequest is used to pause or resume the tasks of an allocation. If
+// Task is empty, all running tasks of the allocation are paused or resumed.
+type AllocPauseRequest struct {
+	AllocID string
+	Task    string
+
+	QueryOptions
+}
+
+// AllocPinRequest is used to pin or unpin a terminal allocation, exempting
+// it from garbage collection on its client.
+type AllocPinRequest struct {
+	AllocID string
+	Pinned  bool
+
+	QueryOptions
+}
+
 // PeriodicForceRequest is used to force a specific periodic job.
 type PeriodicForceRequest struct {
 	JobID string
@@ -5662,6 +5692,125 @@ func (d *TaskLifecycleConfig) Validate() error {
 	return nil
 }
 
+const (
+	TaskPreStopProtocolHTTP  = "http"
+	TaskPreStopProtocolHTTPS = "https"
+)
+
+// TaskPreStop configures an action that is run against a task right before
+// it is killed, to allow it to drain connections, deregister from load
+// balancers or flush state. Exactly one of Exec or HTTP must be set.
+type TaskPreStop struct {
+	// Exec runs a command inside the task
+	Exec *TaskPreStopExec
+
+	// HTTP makes a request to the task
+	HTTP *TaskPreStopHTTP
+
+	// Timeout is how long the action is allowed to run for. The time spent
+	// running the action counts against the task's KillTimeout.
+	Timeout time.Duration
+}
+
+// TaskPreStopExec is a command run inside the task before it is killed.
+type TaskPreStopExec struct {
+	Command string
+	Args    []string
+}
+
+// TaskPreStopHTTP is an HTTP request made to the task before it is killed.
+type TaskPreStopHTTP struct {
+	// Port is the label of the port the request is sent to
+	Port string
+
+	Path     string
+	Method   string
+	Protocol string
+	Header   map[string][]string
+}
+
+func (p *TaskPreStop) Copy() *TaskPreStop {
+	if p == nil {
+		return nil
+	}
+	np := new(TaskPreStop)
+	*np = *p
+	if p.Exec != nil {
+		np.Exec = &TaskPreStopExec{
+			Command: p.Exec.Command,
+			Args:    slices.Clone(p.Exec.Args),
+		}
+	}
+	if p.HTTP != nil {
+		h := *p.HTTP
+		h.Header = helper.CopyMapOfSlice(p.HTTP.Header)
+		np.HTTP = &h
+	}
+	return np
+}
+
+func (p *TaskPreStop) Canonicalize() {
+	if p.HTTP != nil {
+		if p.HTTP.Method == "" {
+			p.HTTP.Method = http.MethodGet
+		}
+		if p.HTTP.Protocol == "" {
+			p.HTTP.Protocol = TaskPreStopProtocolHTTP
+		}
+		if len(p.HTTP.Header) == 0 {
+			p.HTTP.Header = nil
+		}
+	}
+}
+
+// Validate checks the prestop block. The ports of the task group and task
+// networks are used to verify the port of an HTTP action exists.
+func (p *TaskPreStop) Validate(killTimeout time.Duration, ports map[string]struct{}) error {
+	if p == nil {
+		return nil
+	}
+
+	var mErr multierror.Error
+	switch {
+	case p.Exec == nil && p.HTTP == nil:
+		mErr.Errors = append(mErr.Errors, errors.New("one of exec or http must be set"))
+	case p.Exec != nil && p.HTTP != nil:
+		mErr.Errors = append(mErr.Errors, errors.New("only one of exec or http may be set"))
+	}
+
+	if p.Timeout <= 0 {
+		mErr.Errors = append(mErr.Errors, errors.New("timeout must be greater than zero"))
+	} else if p.Timeout > killTimeout {
+		mErr.Errors = append(mErr.Errors,
+			fmt.Errorf("timeout (%s) must not be greater than the kill_timeout (%s)", p.Timeout, killTimeout))
+	}
+
+	if p.Exec != nil && p.Exec.Command == "" {
+		mErr.Errors = append(mErr.Errors, errors.New("exec must have a command"))
+	}
+
+	if p.HTTP != nil {
+		if p.HTTP.Port == "" {
+			mErr.Errors = append(mErr.Errors, errors.New("http must have a port"))
+		} else if _, ok := ports[p.HTTP.Port]; !ok {
+			mErr.Errors = append(mErr.Errors, fmt.Errorf("http port %q not found in network", p.HTTP.Port))
+		}
+		if p.HTTP.Path != "" && !strings.HasPrefix(p.HTTP.Path, "/") {
+			mErr.Errors = append(mErr.Errors, fmt.Errorf("http path %q must be absolute", p.HTTP.Path))
+		}
+		if p.HTTP.Method != "" && !helper.IsMethodHTTP(p.HTTP.Method) {
+			mErr.Errors = append(mErr.Errors, fmt.Errorf("http method %q not supported", p.HTTP.Method))
+		}
+		switch p.HTTP.Protocol {
+		case "", TaskPreStopProtocolHTTP, TaskPreStopProtocolHTTPS:
+		default:
+			mErr.Errors = append(mErr.Errors, fmt.Errorf("http protocol %q not supported", p.HTTP.Protocol))
+		}
+	}
+
+	return mErr.ErrorOrNil()
+}
+
 var (
 	// These default restart policies needs to be in sync with
 	// Canonicalize in api/tasks.go
@@ -7258,6 +7407,10 @@ type Task struct {
 	// the task.
 	Artifacts []*TaskArtifact
 
+	// Outputs is a list of outputs to upload once the task has finished
+	// running.
+	Outputs []*TaskOutput
+
 	// Leader marks the task as the leader within the group. When the leader
 	// task exits, other tasks will be gracefully terminated.
 	Leader bool
@@ -7287,6 +7440,9 @@ type Task struct {
 	// Identity controls if and how the workload identity is exposed to
 	// tasks similar to the Vault block.
 	Identity *WorkloadIdentity
+
+	// PreStop is an action run against the task right before it is killed
+	PreStop *TaskPreStop
 }
 
 // UsesConnect is for conveniently detecting if the Task is able to make use
@@ -7348,6 +7504,7 @@ func (t *Task) Copy() *Task {
 	nt.DispatchPayload = nt.DispatchPayload.Copy()
 	nt.Lifecycle = nt.Lifecycle.Copy()
 	nt.Identity = nt.Identity.Copy()
+	nt.PreStop = nt.PreStop.Copy()
 
 	if t.Artifacts != nil {
 		artifacts := make([]*TaskArtifact, 0, len(t.Artifacts))
@@ -7357,6 +7514,14 @@ func (t *Task) Copy() *Task {
 		nt.Artifacts = artifacts
 	}
 
+	if t.Outputs != nil {
+		outputs := make([]*TaskOutput, 0, len(t.Outputs))
+		for _, o := range nt.Outputs {
+			outputs = append(outputs, o.Copy())
+		}
+		nt.Outputs = outputs
+	}
+
 	if i, err := copystructure.Copy(nt.Config); err != nil {
 		panic(err.Error())
 	} else {
@@ -7415,6 +7580,10 @@ func (t *Task) Canonicalize(job *Job, tg *TaskGroup) {
 	for _, template := range t.Templates {
 		template.Canonicalize()
 	}
+
+	if t.PreStop != nil {
+		t.PreStop.Canonicalize()
+	}
 }
 
 func (t *Task) GoString() string {
@@ -7505,6 +7674,13 @@ func (t *Task) Validate(ephemeralDisk *EphemeralDisk, jobType string, tgServices
 		}
 	}
 
+	for idx, output := range t.Outputs {
+		if err := output.Validate(); err != nil {
+			outer := fmt.Errorf("Output %d validation failed: %v", idx+1, err)
+			mErr.Errors = append(mErr.Errors, outer)
+		}
+	}
+
 	if t.Vault != nil {
 		if err := t.Vault.Validate(); err != nil {
 			mErr.Errors = append(mErr.Errors, fmt.Errorf("Vault validation failed: %v", err))
@@ -7541,6 +7717,28 @@ func (t *Task) Validate(ephemeralDisk *EphemeralDisk, jobType string, tgServices
 
 	}
 
+	// Validate the PreStop block if there
+	if t.PreStop != nil {
+		ports := make(map[string]struct{})
+		addPorts := func(networks Networks) {
+			for _, network := range networks {
+				for _, port := range network.ReservedPorts {
+					ports[port.Label] = struct{}{}
+				}
+				for _, port := range network.DynamicPorts {
+					ports[port.Label] = struct{}{}
+				}
+			}
+		}
+		addPorts(tgNetworks)
+		if t.Resources != nil {
+			addPorts(t.Resources.Networks)
+		}
+		if err := t.PreStop.Validate(t.KillTimeout, ports); err != nil {
+			mErr.Errors = append(mErr.Errors, fmt.Errorf("PreStop validation failed: %v", err))
+		}
+	}
+
 	// Validation for TaskKind field which is used for Consul Connect integration
 	if t.Kind.IsConnectProxy() {
 		// This task is a Connect proxy so it should not have service blocks
@@ -8276,6 +8474,7 @@ func (h *TaskHandle) Copy() *TaskHandle {
 const (
 	TaskStatePending = "pending" // The task is waiting to be run.
 	TaskStateRunning = "running" // The task is currently running.
+	TaskStatePaused  = "paused"  // The task processes are frozen.
 	TaskStateDead    = "dead"    // Terminal state of task.
 )
 
@@ -8403,6 +8602,18 @@ const (
 	// failed.
 	TaskArtifactDownloadFailed = "Failed Artifact Download"
 
+	// TaskUploadingOutputs means the task has finished running and its
+	// outputs are being uploaded.
+	TaskUploadingOutputs = "Uploading Outputs"
+
+	// TaskOutputsUploaded indicates that the outputs of the task were
+	// uploaded.
+	TaskOutputsUploaded = "Outputs Uploaded"
+
+	// TaskOutputUploadFailed indicates that uploading the outputs of the task
+	// failed.
+	TaskOutputUploadFailed = "Failed Output Upload"
+
 	// TaskBuildingTaskDir indicates that the task directory/chroot is being
 	// built.
 	TaskBuildingTaskDir = "Building Task Directory"
@@ -8456,6 +8667,25 @@ const (
 	// TaskSkippingShutdownDelay indicates that the task operation was
 	// configured to ignore the shutdown delay value set for the tas.
 	TaskSkippingShutdownDelay = "Skipping shutdown delay"
+
+	// TaskPreStopSucceeded indicates that the task's prestop action completed
+	// successfully before the task was killed
+	TaskPreStopSucceeded = "PreStop Succeeded"
+
+	// TaskPreStopFailed indicates that the task's prestop action failed or
+	// timed out. The task is killed regardless.
+	TaskPreStopFailed = "PreStop Failed"
+
+	// TaskResourcesUpdated indicates that an in-place update of the
+	// allocation changed the resources of the task.
+	TaskResourcesUpdated = "Resources Updated"
+
+	// TaskPaused indicates that the processes of the task have been frozen.
+	TaskPaused = "Paused"
+
+	// TaskResumed indicates that the processes of a paused task have been
+	// thawed.
+	TaskResumed = "Resumed"
 )
 
 // TaskEvent is an event that effects the state of a task and contains meta-data
@@ -8591,6 +8821,12 @@ func (e *TaskEvent) PopulateEventDisplayMessage() {
 		}
 	case TaskDownloadingArtifacts:
 		desc = "Client is downloading artifacts"
+	case TaskUploadingOutputs:
+		desc = "Client is uploading outputs"
+	case TaskOutputsUploaded:
+		desc = "Outputs uploaded"
+	case TaskOutputUploadFailed:
+		desc = "Failed to upload outputs"
 	case TaskArtifactDownloadFailed:
 		if e.DownloadError != "" {
 			desc = e.DownloadError
@@ -8636,6 +8872,12 @@ func (e *TaskEvent) PopulateEventDisplayMessage() {
 		} else {
 			desc = "Task exceeded restart policy"
 		}
+	case TaskDiskExceeded:
+		if e.DiskLimit != 0 {
+			desc = fmt.Sprintf("Disk usage exceeded the ephemeral disk size of %d MB", e.DiskLimit)
+		} else {
+			desc = "Disk usage exceeded the ephemeral disk size"
+		}
 	case TaskSiblingFailed:
 		if e.FailedSibling != "" {
 			desc = fmt.Sprintf("Task's sibling %q failed", e.FailedSibling)
@@ -8669,6 +8911,10 @@ func (e *TaskEvent) PopulateEventDisplayMessage() {
 		desc = "Main tasks in the group died"
 	case TaskClientReconnected:
 		desc = "Client reconnected"
+	case TaskPaused:
+		desc = "Task paused by user"
+	case TaskResumed:
+		desc = "Task resumed by user"
 	default:
 		desc = e.Message
 	}
@@ -8829,6 +9075,13 @@ func (e *TaskEvent) SetDiskLimit(limit int64) *TaskEvent {
 	return e
 }
 
+// SetDiskSize sets the disk usage, in MB, that caused the task to exceed its
+// disk limit.
+func (e *TaskEvent) SetDiskSize(size int64) *TaskEvent {
+	e.Details["disk_size"] = fmt.Sprintf("%d", size)
+	return e
+}
+
 func (e *TaskEvent) SetFailedSibling(sibling string) *TaskEvent {
 	e.FailedSibling = sibling
 	e.Details["failed_sibling"] = sibling
@@ -9031,6 +9284,127 @@ func (ta *TaskArtifact) validateChecksum() error {
 	return nil
 }
 
+const (
+	// TaskOutputOnSuccess uploads the outputs when the task succeeds.
+	TaskOutputOnSuccess = "on_success"
+
+	// TaskOutputOnFailure uploads the outputs when the task fails.
+	TaskOutputOnFailure = "on_failure"
+
+	// TaskOutputAlways uploads the outputs however the task finishes.
+	TaskOutputAlways = "always"
+)
+
+// TaskOutput is a set of files produced by a task that are uploaded once the
+// task has finished running. It is the reverse of a TaskArtifact.
+type TaskOutput struct {
+	// Source is a glob of the files and directories to upload, given
+	// relative to the task's directory.
+	Source string
+
+	// Destination is the URL the outputs are uploaded to. The scheme selects
+	// the uploader, such as http(s), s3 or file.
+	Destination string
+
+	// Options are uploader specific options, such as the region of an S3
+	// bucket.
+	Options map[string]string
+
+	// Headers are headers to use when uploading the outputs over HTTP.
+	Headers map[string]string
+
+	// When is when the outputs are uploaded depending on how the task
+	// finished. Defaults to "on_success" but can be set to "on_failure" or
+	// "always".
+	When string
+}
+
+func (to *TaskOutput) Equal(o *TaskOutput) bool {
+	if to == nil || o == nil {
+		return to == o
+	}
+	switch {
+	case to.Source != o.Source:
+		return false
+	case to.Destination != o.Destination:
+		return false
+	case !maps.Equal(to.Options, o.Options):
+		return false
+	case !maps.Equal(to.Headers, o.Headers):
+		return false
+	case to.When != o.When:
+		return false
+	}
+	return true
+}
+
+func (to *TaskOutput) Copy() *TaskOutput {
+	if to == nil {
+		return nil
+	}
+	return &TaskOutput{
+		Source:      to.Source,
+		Destination: to.Destination,
+		Options:     maps.Clone(to.Options),
+		Headers:     maps.Clone(to.Headers),
+		When:        to.When,
+	}
+}
+
+func (to *TaskOutput) GoString() string {
+	return fmt.Sprintf("%+v", to)
+}
+
+// DiffID fulfills the DiffableWithID interface.
+func (to *TaskOutput) DiffID() string {
+	return to.Destination
+}
+
+// ShouldUpload returns whether the outputs are uploaded when the task has
+// finished running with the given outcome.
+func (to *TaskOutput) ShouldUpload(successful bool) bool {
+	switch to.When {
+	case TaskOutputAlways:
+		return true
+	case TaskOutputOnFailure:
+		return !successful
+	default:
+		return successful
+	}
+}
+
+func (to *TaskOutput) Validate() error {
+	var mErr multierror.Error
+	if to.Source == "" {
+		mErr.Errors = append(mErr.Errors, fmt.Errorf("source must be specified"))
+	} else if _, err := filepath.Match(to.Source, ""); err != nil {
+		mErr.Errors = append(mErr.Errors, fmt.Errorf("invalid source glob: %v", err))
+	} else if filepath.IsAbs(to.Source) {
+		mErr.Errors = append(mErr.Errors, fmt.Errorf("source must be relative to the task directory"))
+	} else if escaped, err := escapingfs.PathEscapesAllocViaRelative("task", to.Source); err != nil {
+		mErr.Errors = append(mErr.Errors, fmt.Errorf("invalid source path: %v", err))
+	} else if escaped {
+		mErr.Errors = append(mErr.Errors, fmt.Errorf("source escapes allocation directory"))
+	}
+
+	if to.Destination == "" {
+		mErr.Errors = append(mErr.Errors, fmt.Errorf("destination must be specified"))
+	}
+
+	switch to.When {
+	case "":
+		// Default to on_success
+		to.When = TaskOutputOnSuccess
+	case TaskOutputOnSuccess, TaskOutputOnFailure, TaskOutputAlways:
+		// Ok
+	default:
+		mErr.Errors = append(mErr.Errors, fmt.Errorf("invalid output when %q; must be one of: %s, %s, %s",
+			to.When, TaskOutputOnSuccess, TaskOutputOnFailure, TaskOutputAlways))
+	}
+
+	return mErr.ErrorOrNil()
+}
+
 const (
 	ConstraintDistinctProperty  = "distinct_property"
 	ConstraintDistinctHosts     = "distinct_hosts"
@@ -12490,6 +12864,12 @@ func (a *ACLToken) SetHash() []byte {
 		_, _ = hash.Write([]byte(roleLink.ID))
 	}
 
+	// The expiration time of a token is shortened when it's rotated, which
+	// must be replicated to federated regions.
+	if a.ExpirationTime != nil {
+		_, _ = hash.Write([]byte(a.ExpirationTime.UTC().Format(time.RFC3339Nano)))
+	}
+
 	// Finalize the hash
 	hashVal := hash.Sum(nil)
 
@@ -3936,6 +3936,130 @@ func TestTaskLifecycleConfig_Validate(t *testing.T) {
 	}
 }
 
+func TestTaskPreStop_Validate(t *testing.T) {
+	ci.Parallel(t)
+
+	ports := map[string]struct{}{"admin": {}}
+
+	testCases := []struct {
+		name    string
+		prestop *TaskPreStop
+		err     string
+	}{
+		{
+			name: "exec",
+			prestop: &TaskPreStop{
+				Exec:    &TaskPreStopExec{Command: "/bin/drain"},
+				Timeout: 5 * time.Second,
+			},
+		},
+		{
+			name: "http",
+			prestop: &TaskPreStop{
+				HTTP: &TaskPreStopHTTP{
+					Port:     "admin",
+					Path:     "/drain",
+					Method:   "POST",
+					Protocol: "https",
+				},
+				Timeout: 5 * time.Second,
+			},
+		},
+		{
+			name:    "no action",
+			prestop: &TaskPreStop{Timeout: 5 * time.Second},
+			err:     "one of exec or http must be set",
+		},
+		{
+			name: "both actions",
+			prestop: &TaskPreStop{
+				Exec:    &TaskPreStopExec{Command: "/bin/drain"},
+				HTTP:    &TaskPreStopHTTP{Port: "admin"},
+				Timeout: 5 * time.Second,
+			},
+			err: "only one of exec or http may be set",
+		},
+		{
+			name: "timeout exceeds kill timeout",
+			prestop: &TaskPreStop{
+				Exec:    &TaskPreStopExec{Command: "/bin/drain"},
+				Timeout: time.Minute,
+			},
+			err: "must not be greater than the kill_timeout",
+		},
+		{
+			name: "no timeout",
+			prestop: &TaskPreStop{
+				Exec: &TaskPreStopExec{Command: "/bin/drain"},
+			},
+			err: "timeout must be greater than zero",
+		},
+		{
+			name: "no command",
+			prestop: &TaskPreStop{
+				Exec:    &TaskPreStopExec{},
+				Timeout: 5 * time.Second,
+			},
+			err: "exec must have a command",
+		},
+		{
+			name: "unknown port",
+			prestop: &TaskPreStop{
+				HTTP:    &TaskPreStopHTTP{Port: "http"},
+				Timeout: 5 * time.Second,
+			},
+			err: `http port "http" not found in network`,
+		},
+		{
+			name: "relative path",
+			prestop: &TaskPreStop{
+				HTTP:    &TaskPreStopHTTP{Port: "admin", Path: "drain"},
+				Timeout: 5 * time.Second,
+			},
+			err: `http path "drain" must be absolute`,
+		},
+		{
+			name: "bad protocol",
+			prestop: &TaskPreStop{
+				HTTP:    &TaskPreStopHTTP{Port: "admin", Protocol: "grpc"},
+				Timeout: 5 * time.Second,
+			},
+			err: `http protocol "grpc" not supported`,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.prestop.Validate(30*time.Second, ports)
+			if tc.err != "" {
+				require.Error(t, err)
+				require.Contains(t, err.Error(), tc.err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestTaskPreStop_Copy(t *testing.T) {
+	ci.Parallel(t)
+
+	p := &TaskPreStop{
+		HTTP: &TaskPreStopHTTP{
+			Port:   "admin",
+			Header: map[string][]string{"X-Drain": {"true"}},
+		},
+		Timeout: 5 * time.Second,
+	}
+	c := p.Copy()
+	require.Equal(t, p, c)
+
+	c.HTTP.Header["X-Drain"][0] = "false"
+	c.HTTP.Port = "other"
+	require.Equal(t, "true", p.HTTP.Header["X-Drain"][0])
+	require.Equal(t, "admin", p.HTTP.Port)
+}
+
 func TestRestartPolicy_Validate(t *testing.T) {
 	ci.Parallel(t)
 
@@ -4238,6 +4362,69 @@ func TestTaskArtifact_Validate_Dest(t *testing.T) {
 	}
 }
 
+func TestTaskOutput_Validate(t *testing.T) {
+	ci.Parallel(t)
+
+	cases := []struct {
+		name   string
+		output *TaskOutput
+		expErr string
+	}{
+		{
+			name:   "ok",
+			output: &TaskOutput{Source: "local/*.log", Destination: "s3://bucket/logs/"},
+		},
+		{
+			name:   "missing source and destination",
+			output: &TaskOutput{},
+			expErr: "source must be specified",
+		},
+		{
+			name:   "bad glob",
+			output: &TaskOutput{Source: "local/[", Destination: "s3://bucket/logs/"},
+			expErr: "invalid source glob",
+		},
+		{
+			name:   "absolute source",
+			output: &TaskOutput{Source: "/etc/passwd", Destination: "s3://bucket/logs/"},
+			expErr: "source must be relative",
+		},
+		{
+			name:   "escaping source",
+			output: &TaskOutput{Source: "local/../../../etc/passwd", Destination: "s3://bucket/logs/"},
+			expErr: "source escapes allocation directory",
+		},
+		{
+			name:   "bad when",
+			output: &TaskOutput{Source: "local/out", Destination: "s3://bucket/out", When: "sometimes"},
+			expErr: "invalid output when",
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.output.Validate()
+			if tc.expErr == "" {
+				must.NoError(t, err)
+				must.Eq(t, TaskOutputOnSuccess, tc.output.When)
+			} else {
+				must.ErrorContains(t, err, tc.expErr)
+			}
+		})
+	}
+}
+
+func TestTaskOutput_ShouldUpload(t *testing.T) {
+	ci.Parallel(t)
+
+	must.True(t, (&TaskOutput{When: TaskOutputOnSuccess}).ShouldUpload(true))
+	must.False(t, (&TaskOutput{When: TaskOutputOnSuccess}).ShouldUpload(false))
+	must.False(t, (&TaskOutput{When: TaskOutputOnFailure}).ShouldUpload(true))
+	must.True(t, (&TaskOutput{When: TaskOutputOnFailure}).ShouldUpload(false))
+	must.True(t, (&TaskOutput{When: TaskOutputAlways}).ShouldUpload(true))
+	must.True(t, (&TaskOutput{When: TaskOutputAlways}).ShouldUpload(false))
+}
+
 // TestTaskArtifact_Hash asserts an artifact's hash changes when any of the
 // fields change.
 func TestTaskArtifact_Hash(t *testing.T) {
@@ -6123,6 +6310,10 @@ func TestACLTokenSetHash(t *testing.T) {
 	assert.NotNil(t, tk.Hash)
 	assert.Equal(t, out2, tk.Hash)
 	assert.NotEqual(t, out1, out2)
+
+	tk.ExpirationTime = pointer.Of(time.Now().Add(time.Hour))
+	out3 := tk.SetHash()
+	assert.NotEqual(t, out2, out3)
 }
 
 func TestACLPolicySetHash(t *testing.T) {
@@ -6181,6 +6372,8 @@ func TestTaskEventPopulate(t *testing.T) {
 		{NewTaskEvent(TaskNotRestarting).SetRestartReason("Chaos Monkey did it"), "Chaos Monkey did it"},
 		{NewTaskEvent(TaskNotRestarting), "Task exceeded restart policy"},
 		{NewTaskEvent(TaskLeaderDead), "Leader Task in Group dead"},
+		{NewTaskEvent(TaskDiskExceeded), "Disk usage exceeded the ephemeral disk size"},
+		{NewTaskEvent(TaskDiskExceeded).SetDiskLimit(300).SetDiskSize(301), "Disk usage exceeded the ephemeral disk size of 300 MB"},
 		{NewTaskEvent(TaskSiblingFailed), "Task's sibling failed"},
 		{NewTaskEvent(TaskSiblingFailed).SetFailedSibling("patient zero"), "Task's sibling \"patient zero\" failed"},
 		{NewTaskEvent(TaskSignaling), "Task being sent a signal"},
@@ -10,6 +10,11 @@ type WorkloadIdentity struct {
 	// File writes the Workload Identity into the Task's secrets directory
 	// if set.
 	File bool
+
+	// AuthMethod is the name of a WORKLOAD ACL auth method. If set, the
+	// Workload Identity is exchanged for a short-lived ACL token using the
+	// auth method, and the ACL token is exposed to the Task instead.
+	AuthMethod string
 }
 
 func (wi *WorkloadIdentity) Copy() *WorkloadIdentity {
@@ -17,8 +22,9 @@ func (wi *WorkloadIdentity) Copy() *WorkloadIdentity {
 		return nil
 	}
 	return &WorkloadIdentity{
-		Env:  wi.Env,
-		File: wi.File,
+		Env:        wi.Env,
+		File:       wi.File,
+		AuthMethod: wi.AuthMethod,
 	}
 }
 
@@ -35,5 +41,9 @@ func (wi *WorkloadIdentity) Equal(other *WorkloadIdentity) bool {
 		return false
 	}
 
+	if wi.AuthMethod != other.AuthMethod {
+		return false
+	}
+
 	return true
 }
@@ -29,4 +29,11 @@ func TestWorkloadIdentity_Equal(t *testing.T) {
 
 	newWI.File = true
 	must.NotEqual(t, orig, newWI)
+
+	orig.File = true
+	must.Equal(t, orig, newWI)
+
+	newWI.AuthMethod = "nomad-workloads"
+	must.NotEqual(t, orig, newWI)
+	must.Equal(t, newWI, newWI.Copy())
 }
@@ -39,7 +39,9 @@ func (sv *Variables) Apply(args *structs.VariablesApplyRequest, reply *structs.V
 	if done, err := sv.srv.forward(structs.VariablesApplyRPCMethod, args, args, reply); done {
 		return err
 	}
-	sv.srv.MeasureRPCRate("variables", structs.RateMetricWrite, args)
+	if err := sv.srv.MeasureRPCRate("variables", structs.RateMetricWrite, args); err != nil {
+		return err
+	}
 	if authErr != nil {
 		return structs.ErrPermissionDenied
 	}
@@ -230,7 +232,9 @@ func (sv *Variables) Read(args *structs.VariablesReadRequest, reply *structs.Var
 	if done, err := sv.srv.forward(structs.VariablesReadRPCMethod, args, args, reply); done {
 		return err
 	}
-	sv.srv.MeasureRPCRate("variables", structs.RateMetricRead, args)
+	if err := sv.srv.MeasureRPCRate("variables", structs.RateMetricRead, args); err != nil {
+		return err
+	}
 	if authErr != nil {
 		return structs.ErrPermissionDenied
 	}
@@ -281,7 +285,9 @@ func (sv *Variables) List(
 	if done, err := sv.srv.forward(structs.VariablesListRPCMethod, args, args, reply); done {
 		return err
 	}
-	sv.srv.MeasureRPCRate("variables", structs.RateMetricList, args)
+	if err := sv.srv.MeasureRPCRate("variables", structs.RateMetricList, args); err != nil {
+		return err
+	}
 	if authErr != nil {
 		return structs.ErrPermissionDenied
 	}
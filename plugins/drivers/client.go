@@ -74,6 +74,9 @@ func (d *driverPluginClient) Capabilities() (*Capabilities, error) {
 
 		caps.MountConfigs = MountConfigSupport(resp.Capabilities.MountConfigs)
 		caps.RemoteTasks = resp.Capabilities.RemoteTasks
+		caps.UserNamespaceIDs = IDMappingFromProto(resp.Capabilities.UserNamespaceIds)
+		caps.UpdateTaskResources = resp.Capabilities.UpdateTaskResources
+		caps.PauseTask = resp.Capabilities.PauseTask
 	}
 
 	return caps, nil
@@ -379,6 +382,30 @@ func (d *driverPluginClient) SignalTask(taskID string, signal string) error {
 	return grpcutils.HandleGrpcErr(err, d.doneCtx)
 }
 
+// UpdateTaskResources will change the resources enforced on the specified task
+func (d *driverPluginClient) UpdateTaskResources(taskID string, resources *Resources) error {
+	req := &proto.UpdateTaskResourcesRequest{
+		TaskId:    taskID,
+		Resources: ResourcesToProto(resources),
+	}
+	_, err := d.client.UpdateTaskResources(d.doneCtx, req)
+	return grpcutils.HandleGrpcErr(err, d.doneCtx)
+}
+
+// PauseTask will freeze the processes of the specified task
+func (d *driverPluginClient) PauseTask(taskID string) error {
+	req := &proto.PauseTaskRequest{TaskId: taskID}
+	_, err := d.client.PauseTask(d.doneCtx, req)
+	return grpcutils.HandleGrpcErr(err, d.doneCtx)
+}
+
+// ResumeTask will thaw the processes of the specified paused task
+func (d *driverPluginClient) ResumeTask(taskID string) error {
+	req := &proto.ResumeTaskRequest{TaskId: taskID}
+	_, err := d.client.ResumeTask(d.doneCtx, req)
+	return grpcutils.HandleGrpcErr(err, d.doneCtx)
+}
+
 // ExecTask will run the given command within the execution context of the task.
 // The driver will wait for the given timeout for the command to complete before
 // terminating it. The stdout and stderr of the command will be return to the caller,
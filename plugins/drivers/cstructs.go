@@ -14,6 +14,9 @@ type MemoryStats = cstructs.MemoryStats
 // CpuStats holds cpu usage related stats
 type CpuStats = cstructs.CpuStats
 
+// IOStats holds block IO related stats
+type IOStats = cstructs.IOStats
+
 // ResourceUsage holds information related to cpu and memory stats
 type ResourceUsage = cstructs.ResourceUsage
 
@@ -62,6 +62,9 @@ type DriverPlugin interface {
 
 	SignalTask(taskID string, signal string) error
 	ExecTask(taskID string, cmd []string, timeout time.Duration) (*ExecTaskResult, error)
+	UpdateTaskResources(taskID string, resources *Resources) error
+	PauseTask(taskID string) error
+	ResumeTask(taskID string) error
 }
 
 // ExecTaskStreamingDriver marks that a driver supports streaming exec task.  This represents a user friendly
@@ -112,6 +115,28 @@ func (_ DriverExecTaskNotSupported) ExecTask(taskID string, cmd []string, timeou
 	return nil, fmt.Errorf("ExecTask is not supported by this driver")
 }
 
+// DriverUpdateTaskResourcesNotSupported can be embedded by drivers which
+// don't support the UpdateTaskResources RPC. This satisfies the
+// UpdateTaskResources func requirement of the DriverPlugin interface.
+type DriverUpdateTaskResourcesNotSupported struct{}
+
+func (DriverUpdateTaskResourcesNotSupported) UpdateTaskResources(taskID string, resources *Resources) error {
+	return fmt.Errorf("UpdateTaskResources is not supported by this driver")
+}
+
+// DriverPauseTaskNotSupported can be embedded by drivers which don't support
+// pausing tasks. This satisfies the PauseTask and ResumeTask func requirements
+// of the DriverPlugin interface.
+type DriverPauseTaskNotSupported struct{}
+
+func (DriverPauseTaskNotSupported) PauseTask(taskID string) error {
+	return fmt.Errorf("PauseTask is not supported by this driver")
+}
+
+func (DriverPauseTaskNotSupported) ResumeTask(taskID string) error {
+	return fmt.Errorf("ResumeTask is not supported by this driver")
+}
+
 type HealthState string
 
 var (
@@ -171,6 +196,20 @@ type Capabilities struct {
 	// adjust behavior such as propogating task handles between allocations
 	// to avoid downtime when a client is lost.
 	RemoteTasks bool
+
+	// UserNamespaceIDs is the range of host IDs from which the client
+	// allocates the user namespace of each allocation. It is nil if the driver
+	// does not run tasks in user namespaces.
+	UserNamespaceIDs *IDMapping
+
+	// UpdateTaskResources marks the driver as being able to change the
+	// resources of running tasks with the UpdateTaskResources RPC, so that
+	// in-place updates of their resources take effect without restarts.
+	UpdateTaskResources bool
+
+	// PauseTask marks the driver as being able to freeze the processes of
+	// running tasks with the PauseTask RPC and thaw them with ResumeTask.
+	PauseTask bool
 }
 
 func (c *Capabilities) HasNetIsolationMode(m NetIsolationMode) bool {
@@ -284,6 +323,11 @@ type TaskConfig struct {
 	AllocID          string
 	NetworkIsolation *NetworkIsolationSpec
 	DNS              *DNSConfig
+
+	// UserNamespace is the range of host IDs the user and group IDs of the
+	// task are mapped to. It is nil if the task does not run in a user
+	// namespace.
+	UserNamespace *IDMapping
 }
 
 func (tc *TaskConfig) Copy() *TaskConfig {
@@ -296,6 +340,7 @@ func (tc *TaskConfig) Copy() *TaskConfig {
 	c.DeviceEnv = maps.Clone(c.DeviceEnv)
 	c.Resources = tc.Resources.Copy()
 	c.DNS = tc.DNS.Copy()
+	c.UserNamespace = tc.UserNamespace.Copy()
 
 	if c.Devices != nil {
 		dc := make([]*DeviceConfig, len(c.Devices))
@@ -431,6 +476,32 @@ func (d *DeviceConfig) Copy() *DeviceConfig {
 	return dc
 }
 
+// IDMapping maps the user and group IDs from 0 to Size inside a user namespace
+// to the host IDs starting at UIDStart and GIDStart.
+type IDMapping struct {
+	UIDStart uint32
+	GIDStart uint32
+	Size     uint32
+}
+
+func (m *IDMapping) Copy() *IDMapping {
+	if m == nil {
+		return nil
+	}
+
+	mc := new(IDMapping)
+	*mc = *m
+	return mc
+}
+
+// Overlaps returns true if any user or group ID is in both ranges.
+func (m *IDMapping) Overlaps(o *IDMapping) bool {
+	overlaps := func(a, b uint32) bool {
+		return uint64(a) < uint64(b)+uint64(o.Size) && uint64(b) < uint64(a)+uint64(m.Size)
+	}
+	return overlaps(m.UIDStart, o.UIDStart) || overlaps(m.GIDStart, o.GIDStart)
+}
+
 type MountConfig struct {
 	TaskPath        string
 	HostPath        string
@@ -137,7 +137,7 @@ func (x DriverCapabilities_FSIsolation) String() string {
 }
 
 func (DriverCapabilities_FSIsolation) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_4a8f45747846a74d, []int{32, 0}
+	return fileDescriptor_4a8f45747846a74d, []int{38, 0}
 }
 
 type DriverCapabilities_MountConfigs int32
@@ -165,7 +165,7 @@ func (x DriverCapabilities_MountConfigs) String() string {
 }
 
 func (DriverCapabilities_MountConfigs) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_4a8f45747846a74d, []int{32, 1}
+	return fileDescriptor_4a8f45747846a74d, []int{38, 1}
 }
 
 type NetworkIsolationSpec_NetworkIsolationMode int32
@@ -196,7 +196,7 @@ func (x NetworkIsolationSpec_NetworkIsolationMode) String() string {
 }
 
 func (NetworkIsolationSpec_NetworkIsolationMode) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_4a8f45747846a74d, []int{33, 0}
+	return fileDescriptor_4a8f45747846a74d, []int{39, 0}
 }
 
 type CPUUsage_Fields int32
@@ -233,7 +233,7 @@ func (x CPUUsage_Fields) String() string {
 }
 
 func (CPUUsage_Fields) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_4a8f45747846a74d, []int{54, 0}
+	return fileDescriptor_4a8f45747846a74d, []int{61, 0}
 }
 
 type MemoryUsage_Fields int32
@@ -273,7 +273,38 @@ func (x MemoryUsage_Fields) String() string {
 }
 
 func (MemoryUsage_Fields) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_4a8f45747846a74d, []int{55, 0}
+	return fileDescriptor_4a8f45747846a74d, []int{62, 0}
+}
+
+type IOUsage_Fields int32
+
+const (
+	IOUsage_READ_BYTES  IOUsage_Fields = 0
+	IOUsage_WRITE_BYTES IOUsage_Fields = 1
+	IOUsage_READ_OPS    IOUsage_Fields = 2
+	IOUsage_WRITE_OPS   IOUsage_Fields = 3
+)
+
+var IOUsage_Fields_name = map[int32]string{
+	0: "READ_BYTES",
+	1: "WRITE_BYTES",
+	2: "READ_OPS",
+	3: "WRITE_OPS",
+}
+
+var IOUsage_Fields_value = map[string]int32{
+	"READ_BYTES":  0,
+	"WRITE_BYTES": 1,
+	"READ_OPS":    2,
+	"WRITE_OPS":   3,
+}
+
+func (x IOUsage_Fields) String() string {
+	return proto.EnumName(IOUsage_Fields_name, int32(x))
+}
+
+func (IOUsage_Fields) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_4a8f45747846a74d, []int{63, 0}
 }
 
 type TaskConfigSchemaRequest struct {
@@ -1271,6 +1302,228 @@ func (m *SignalTaskResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_SignalTaskResponse proto.InternalMessageInfo
 
+type UpdateTaskResourcesRequest struct {
+	// TaskId is the ID of the target task
+	TaskId string `protobuf:"bytes,1,opt,name=task_id,json=taskId,proto3" json:"task_id,omitempty"`
+	// Resources are the resources to enforce on the task
+	Resources            *Resources `protobuf:"bytes,2,opt,name=resources,proto3" json:"resources,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}   `json:"-"`
+	XXX_unrecognized     []byte     `json:"-"`
+	XXX_sizecache        int32      `json:"-"`
+}
+
+func (m *UpdateTaskResourcesRequest) Reset()         { *m = UpdateTaskResourcesRequest{} }
+func (m *UpdateTaskResourcesRequest) String() string { return proto.CompactTextString(m) }
+func (*UpdateTaskResourcesRequest) ProtoMessage()    {}
+func (*UpdateTaskResourcesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4a8f45747846a74d, []int{23}
+}
+
+func (m *UpdateTaskResourcesRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_UpdateTaskResourcesRequest.Unmarshal(m, b)
+}
+func (m *UpdateTaskResourcesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_UpdateTaskResourcesRequest.Marshal(b, m, deterministic)
+}
+func (m *UpdateTaskResourcesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UpdateTaskResourcesRequest.Merge(m, src)
+}
+func (m *UpdateTaskResourcesRequest) XXX_Size() int {
+	return xxx_messageInfo_UpdateTaskResourcesRequest.Size(m)
+}
+func (m *UpdateTaskResourcesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_UpdateTaskResourcesRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_UpdateTaskResourcesRequest proto.InternalMessageInfo
+
+func (m *UpdateTaskResourcesRequest) GetTaskId() string {
+	if m != nil {
+		return m.TaskId
+	}
+	return ""
+}
+
+func (m *UpdateTaskResourcesRequest) GetResources() *Resources {
+	if m != nil {
+		return m.Resources
+	}
+	return nil
+}
+
+type UpdateTaskResourcesResponse struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *UpdateTaskResourcesResponse) Reset()         { *m = UpdateTaskResourcesResponse{} }
+func (m *UpdateTaskResourcesResponse) String() string { return proto.CompactTextString(m) }
+func (*UpdateTaskResourcesResponse) ProtoMessage()    {}
+func (*UpdateTaskResourcesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4a8f45747846a74d, []int{24}
+}
+
+func (m *UpdateTaskResourcesResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_UpdateTaskResourcesResponse.Unmarshal(m, b)
+}
+func (m *UpdateTaskResourcesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_UpdateTaskResourcesResponse.Marshal(b, m, deterministic)
+}
+func (m *UpdateTaskResourcesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UpdateTaskResourcesResponse.Merge(m, src)
+}
+func (m *UpdateTaskResourcesResponse) XXX_Size() int {
+	return xxx_messageInfo_UpdateTaskResourcesResponse.Size(m)
+}
+func (m *UpdateTaskResourcesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_UpdateTaskResourcesResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_UpdateTaskResourcesResponse proto.InternalMessageInfo
+
+type PauseTaskRequest struct {
+	// TaskId is the ID of the target task
+	TaskId               string   `protobuf:"bytes,1,opt,name=task_id,json=taskId,proto3" json:"task_id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *PauseTaskRequest) Reset()         { *m = PauseTaskRequest{} }
+func (m *PauseTaskRequest) String() string { return proto.CompactTextString(m) }
+func (*PauseTaskRequest) ProtoMessage()    {}
+func (*PauseTaskRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4a8f45747846a74d, []int{25}
+}
+
+func (m *PauseTaskRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_PauseTaskRequest.Unmarshal(m, b)
+}
+func (m *PauseTaskRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_PauseTaskRequest.Marshal(b, m, deterministic)
+}
+func (m *PauseTaskRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PauseTaskRequest.Merge(m, src)
+}
+func (m *PauseTaskRequest) XXX_Size() int {
+	return xxx_messageInfo_PauseTaskRequest.Size(m)
+}
+func (m *PauseTaskRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_PauseTaskRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PauseTaskRequest proto.InternalMessageInfo
+
+func (m *PauseTaskRequest) GetTaskId() string {
+	if m != nil {
+		return m.TaskId
+	}
+	return ""
+}
+
+type PauseTaskResponse struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *PauseTaskResponse) Reset()         { *m = PauseTaskResponse{} }
+func (m *PauseTaskResponse) String() string { return proto.CompactTextString(m) }
+func (*PauseTaskResponse) ProtoMessage()    {}
+func (*PauseTaskResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4a8f45747846a74d, []int{26}
+}
+
+func (m *PauseTaskResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_PauseTaskResponse.Unmarshal(m, b)
+}
+func (m *PauseTaskResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_PauseTaskResponse.Marshal(b, m, deterministic)
+}
+func (m *PauseTaskResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PauseTaskResponse.Merge(m, src)
+}
+func (m *PauseTaskResponse) XXX_Size() int {
+	return xxx_messageInfo_PauseTaskResponse.Size(m)
+}
+func (m *PauseTaskResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_PauseTaskResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PauseTaskResponse proto.InternalMessageInfo
+
+type ResumeTaskRequest struct {
+	// TaskId is the ID of the target task
+	TaskId               string   `protobuf:"bytes,1,opt,name=task_id,json=taskId,proto3" json:"task_id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ResumeTaskRequest) Reset()         { *m = ResumeTaskRequest{} }
+func (m *ResumeTaskRequest) String() string { return proto.CompactTextString(m) }
+func (*ResumeTaskRequest) ProtoMessage()    {}
+func (*ResumeTaskRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4a8f45747846a74d, []int{27}
+}
+
+func (m *ResumeTaskRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ResumeTaskRequest.Unmarshal(m, b)
+}
+func (m *ResumeTaskRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ResumeTaskRequest.Marshal(b, m, deterministic)
+}
+func (m *ResumeTaskRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ResumeTaskRequest.Merge(m, src)
+}
+func (m *ResumeTaskRequest) XXX_Size() int {
+	return xxx_messageInfo_ResumeTaskRequest.Size(m)
+}
+func (m *ResumeTaskRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ResumeTaskRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ResumeTaskRequest proto.InternalMessageInfo
+
+func (m *ResumeTaskRequest) GetTaskId() string {
+	if m != nil {
+		return m.TaskId
+	}
+	return ""
+}
+
+type ResumeTaskResponse struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ResumeTaskResponse) Reset()         { *m = ResumeTaskResponse{} }
+func (m *ResumeTaskResponse) String() string { return proto.CompactTextString(m) }
+func (*ResumeTaskResponse) ProtoMessage()    {}
+func (*ResumeTaskResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4a8f45747846a74d, []int{28}
+}
+
+func (m *ResumeTaskResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ResumeTaskResponse.Unmarshal(m, b)
+}
+func (m *ResumeTaskResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ResumeTaskResponse.Marshal(b, m, deterministic)
+}
+func (m *ResumeTaskResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ResumeTaskResponse.Merge(m, src)
+}
+func (m *ResumeTaskResponse) XXX_Size() int {
+	return xxx_messageInfo_ResumeTaskResponse.Size(m)
+}
+func (m *ResumeTaskResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ResumeTaskResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ResumeTaskResponse proto.InternalMessageInfo
+
 type ExecTaskRequest struct {
 	// TaskId is the ID of the target task
 	TaskId string `protobuf:"bytes,1,opt,name=task_id,json=taskId,proto3" json:"task_id,omitempty"`
@@ -1288,7 +1541,7 @@ func (m *ExecTaskRequest) Reset()         { *m = ExecTaskRequest{} }
 func (m *ExecTaskRequest) String() string { return proto.CompactTextString(m) }
 func (*ExecTaskRequest) ProtoMessage()    {}
 func (*ExecTaskRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_4a8f45747846a74d, []int{23}
+	return fileDescriptor_4a8f45747846a74d, []int{29}
 }
 
 func (m *ExecTaskRequest) XXX_Unmarshal(b []byte) error {
@@ -1346,7 +1599,7 @@ func (m *ExecTaskResponse) Reset()         { *m = ExecTaskResponse{} }
 func (m *ExecTaskResponse) String() string { return proto.CompactTextString(m) }
 func (*ExecTaskResponse) ProtoMessage()    {}
 func (*ExecTaskResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_4a8f45747846a74d, []int{24}
+	return fileDescriptor_4a8f45747846a74d, []int{30}
 }
 
 func (m *ExecTaskResponse) XXX_Unmarshal(b []byte) error {
@@ -1400,7 +1653,7 @@ func (m *ExecTaskStreamingIOOperation) Reset()         { *m = ExecTaskStreamingI
 func (m *ExecTaskStreamingIOOperation) String() string { return proto.CompactTextString(m) }
 func (*ExecTaskStreamingIOOperation) ProtoMessage()    {}
 func (*ExecTaskStreamingIOOperation) Descriptor() ([]byte, []int) {
-	return fileDescriptor_4a8f45747846a74d, []int{25}
+	return fileDescriptor_4a8f45747846a74d, []int{31}
 }
 
 func (m *ExecTaskStreamingIOOperation) XXX_Unmarshal(b []byte) error {
@@ -1448,7 +1701,7 @@ func (m *ExecTaskStreamingRequest) Reset()         { *m = ExecTaskStreamingReque
 func (m *ExecTaskStreamingRequest) String() string { return proto.CompactTextString(m) }
 func (*ExecTaskStreamingRequest) ProtoMessage()    {}
 func (*ExecTaskStreamingRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_4a8f45747846a74d, []int{26}
+	return fileDescriptor_4a8f45747846a74d, []int{32}
 }
 
 func (m *ExecTaskStreamingRequest) XXX_Unmarshal(b []byte) error {
@@ -1503,7 +1756,7 @@ func (m *ExecTaskStreamingRequest_Setup) Reset()         { *m = ExecTaskStreamin
 func (m *ExecTaskStreamingRequest_Setup) String() string { return proto.CompactTextString(m) }
 func (*ExecTaskStreamingRequest_Setup) ProtoMessage()    {}
 func (*ExecTaskStreamingRequest_Setup) Descriptor() ([]byte, []int) {
-	return fileDescriptor_4a8f45747846a74d, []int{26, 0}
+	return fileDescriptor_4a8f45747846a74d, []int{32, 0}
 }
 
 func (m *ExecTaskStreamingRequest_Setup) XXX_Unmarshal(b []byte) error {
@@ -1557,7 +1810,7 @@ func (m *ExecTaskStreamingRequest_TerminalSize) Reset()         { *m = ExecTaskS
 func (m *ExecTaskStreamingRequest_TerminalSize) String() string { return proto.CompactTextString(m) }
 func (*ExecTaskStreamingRequest_TerminalSize) ProtoMessage()    {}
 func (*ExecTaskStreamingRequest_TerminalSize) Descriptor() ([]byte, []int) {
-	return fileDescriptor_4a8f45747846a74d, []int{26, 1}
+	return fileDescriptor_4a8f45747846a74d, []int{32, 1}
 }
 
 func (m *ExecTaskStreamingRequest_TerminalSize) XXX_Unmarshal(b []byte) error {
@@ -1606,7 +1859,7 @@ func (m *ExecTaskStreamingResponse) Reset()         { *m = ExecTaskStreamingResp
 func (m *ExecTaskStreamingResponse) String() string { return proto.CompactTextString(m) }
 func (*ExecTaskStreamingResponse) ProtoMessage()    {}
 func (*ExecTaskStreamingResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_4a8f45747846a74d, []int{27}
+	return fileDescriptor_4a8f45747846a74d, []int{33}
 }
 
 func (m *ExecTaskStreamingResponse) XXX_Unmarshal(b []byte) error {
@@ -1669,7 +1922,7 @@ func (m *CreateNetworkRequest) Reset()         { *m = CreateNetworkRequest{} }
 func (m *CreateNetworkRequest) String() string { return proto.CompactTextString(m) }
 func (*CreateNetworkRequest) ProtoMessage()    {}
 func (*CreateNetworkRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_4a8f45747846a74d, []int{28}
+	return fileDescriptor_4a8f45747846a74d, []int{34}
 }
 
 func (m *CreateNetworkRequest) XXX_Unmarshal(b []byte) error {
@@ -1719,7 +1972,7 @@ func (m *CreateNetworkResponse) Reset()         { *m = CreateNetworkResponse{} }
 func (m *CreateNetworkResponse) String() string { return proto.CompactTextString(m) }
 func (*CreateNetworkResponse) ProtoMessage()    {}
 func (*CreateNetworkResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_4a8f45747846a74d, []int{29}
+	return fileDescriptor_4a8f45747846a74d, []int{35}
 }
 
 func (m *CreateNetworkResponse) XXX_Unmarshal(b []byte) error {
@@ -1767,7 +2020,7 @@ func (m *DestroyNetworkRequest) Reset()         { *m = DestroyNetworkRequest{} }
 func (m *DestroyNetworkRequest) String() string { return proto.CompactTextString(m) }
 func (*DestroyNetworkRequest) ProtoMessage()    {}
 func (*DestroyNetworkRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_4a8f45747846a74d, []int{30}
+	return fileDescriptor_4a8f45747846a74d, []int{36}
 }
 
 func (m *DestroyNetworkRequest) XXX_Unmarshal(b []byte) error {
@@ -1812,7 +2065,7 @@ func (m *DestroyNetworkResponse) Reset()         { *m = DestroyNetworkResponse{}
 func (m *DestroyNetworkResponse) String() string { return proto.CompactTextString(m) }
 func (*DestroyNetworkResponse) ProtoMessage()    {}
 func (*DestroyNetworkResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_4a8f45747846a74d, []int{31}
+	return fileDescriptor_4a8f45747846a74d, []int{37}
 }
 
 func (m *DestroyNetworkResponse) XXX_Unmarshal(b []byte) error {
@@ -1848,7 +2101,15 @@ type DriverCapabilities struct {
 	MountConfigs DriverCapabilities_MountConfigs `protobuf:"varint,6,opt,name=mount_configs,json=mountConfigs,proto3,enum=hashicorp.nomad.plugins.drivers.proto.DriverCapabilities_MountConfigs" json:"mount_configs,omitempty"`
 	// remote_tasks indicates whether the driver executes tasks remotely such
 	// on cloud runtimes like AWS ECS.
-	RemoteTasks          bool     `protobuf:"varint,7,opt,name=remote_tasks,json=remoteTasks,proto3" json:"remote_tasks,omitempty"`
+	RemoteTasks bool `protobuf:"varint,7,opt,name=remote_tasks,json=remoteTasks,proto3" json:"remote_tasks,omitempty"`
+	// user_namespace_ids is the range of host IDs from which the user namespace
+	// of each allocation is allocated, if the driver remaps users.
+	UserNamespaceIds *IDMapping `protobuf:"bytes,8,opt,name=user_namespace_ids,json=userNamespaceIds,proto3" json:"user_namespace_ids,omitempty"`
+	// update_task_resources indicates that the driver can change the resources
+	// of running tasks.
+	UpdateTaskResources bool `protobuf:"varint,9,opt,name=update_task_resources,json=updateTaskResources,proto3" json:"update_task_resources,omitempty"`
+	// pause_task indicates that the driver can pause and resume running tasks.
+	PauseTask            bool     `protobuf:"varint,10,opt,name=pause_task,json=pauseTask,proto3" json:"pause_task,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -1858,7 +2119,7 @@ func (m *DriverCapabilities) Reset()         { *m = DriverCapabilities{} }
 func (m *DriverCapabilities) String() string { return proto.CompactTextString(m) }
 func (*DriverCapabilities) ProtoMessage()    {}
 func (*DriverCapabilities) Descriptor() ([]byte, []int) {
-	return fileDescriptor_4a8f45747846a74d, []int{32}
+	return fileDescriptor_4a8f45747846a74d, []int{38}
 }
 
 func (m *DriverCapabilities) XXX_Unmarshal(b []byte) error {
@@ -1928,6 +2189,27 @@ func (m *DriverCapabilities) GetRemoteTasks() bool {
 	return false
 }
 
+func (m *DriverCapabilities) GetUserNamespaceIds() *IDMapping {
+	if m != nil {
+		return m.UserNamespaceIds
+	}
+	return nil
+}
+
+func (m *DriverCapabilities) GetUpdateTaskResources() bool {
+	if m != nil {
+		return m.UpdateTaskResources
+	}
+	return false
+}
+
+func (m *DriverCapabilities) GetPauseTask() bool {
+	if m != nil {
+		return m.PauseTask
+	}
+	return false
+}
+
 type NetworkIsolationSpec struct {
 	Mode                 NetworkIsolationSpec_NetworkIsolationMode `protobuf:"varint,1,opt,name=mode,proto3,enum=hashicorp.nomad.plugins.drivers.proto.NetworkIsolationSpec_NetworkIsolationMode" json:"mode,omitempty"`
 	Path                 string                                    `protobuf:"bytes,2,opt,name=path,proto3" json:"path,omitempty"`
@@ -1942,7 +2224,7 @@ func (m *NetworkIsolationSpec) Reset()         { *m = NetworkIsolationSpec{} }
 func (m *NetworkIsolationSpec) String() string { return proto.CompactTextString(m) }
 func (*NetworkIsolationSpec) ProtoMessage()    {}
 func (*NetworkIsolationSpec) Descriptor() ([]byte, []int) {
-	return fileDescriptor_4a8f45747846a74d, []int{33}
+	return fileDescriptor_4a8f45747846a74d, []int{39}
 }
 
 func (m *NetworkIsolationSpec) XXX_Unmarshal(b []byte) error {
@@ -2003,7 +2285,7 @@ func (m *HostsConfig) Reset()         { *m = HostsConfig{} }
 func (m *HostsConfig) String() string { return proto.CompactTextString(m) }
 func (*HostsConfig) ProtoMessage()    {}
 func (*HostsConfig) Descriptor() ([]byte, []int) {
-	return fileDescriptor_4a8f45747846a74d, []int{34}
+	return fileDescriptor_4a8f45747846a74d, []int{40}
 }
 
 func (m *HostsConfig) XXX_Unmarshal(b []byte) error {
@@ -2051,7 +2333,7 @@ func (m *DNSConfig) Reset()         { *m = DNSConfig{} }
 func (m *DNSConfig) String() string { return proto.CompactTextString(m) }
 func (*DNSConfig) ProtoMessage()    {}
 func (*DNSConfig) Descriptor() ([]byte, []int) {
-	return fileDescriptor_4a8f45747846a74d, []int{35}
+	return fileDescriptor_4a8f45747846a74d, []int{41}
 }
 
 func (m *DNSConfig) XXX_Unmarshal(b []byte) error {
@@ -2141,17 +2423,20 @@ type TaskConfig struct {
 	// NodeName is the name of the node where the associated allocation is running
 	NodeName string `protobuf:"bytes,20,opt,name=node_name,json=nodeName,proto3" json:"node_name,omitempty"`
 	// NodeId is the ID of the node where the associated allocation is running
-	NodeId               string   `protobuf:"bytes,21,opt,name=node_id,json=nodeId,proto3" json:"node_id,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+	NodeId string `protobuf:"bytes,21,opt,name=node_id,json=nodeId,proto3" json:"node_id,omitempty"`
+	// UserNamespace is the range of host IDs the user and group IDs of the
+	// task are mapped to, if it runs in a user namespace.
+	UserNamespace        *IDMapping `protobuf:"bytes,22,opt,name=user_namespace,json=userNamespace,proto3" json:"user_namespace,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}   `json:"-"`
+	XXX_unrecognized     []byte     `json:"-"`
+	XXX_sizecache        int32      `json:"-"`
 }
 
 func (m *TaskConfig) Reset()         { *m = TaskConfig{} }
 func (m *TaskConfig) String() string { return proto.CompactTextString(m) }
 func (*TaskConfig) ProtoMessage()    {}
 func (*TaskConfig) Descriptor() ([]byte, []int) {
-	return fileDescriptor_4a8f45747846a74d, []int{36}
+	return fileDescriptor_4a8f45747846a74d, []int{42}
 }
 
 func (m *TaskConfig) XXX_Unmarshal(b []byte) error {
@@ -2319,6 +2604,13 @@ func (m *TaskConfig) GetNodeId() string {
 	return ""
 }
 
+func (m *TaskConfig) GetUserNamespace() *IDMapping {
+	if m != nil {
+		return m.UserNamespace
+	}
+	return nil
+}
+
 type Resources struct {
 	// AllocatedResources are the resources set for the task
 	AllocatedResources *AllocatedTaskResources `protobuf:"bytes,1,opt,name=allocated_resources,json=allocatedResources,proto3" json:"allocated_resources,omitempty"`
@@ -2336,7 +2628,7 @@ func (m *Resources) Reset()         { *m = Resources{} }
 func (m *Resources) String() string { return proto.CompactTextString(m) }
 func (*Resources) ProtoMessage()    {}
 func (*Resources) Descriptor() ([]byte, []int) {
-	return fileDescriptor_4a8f45747846a74d, []int{37}
+	return fileDescriptor_4a8f45747846a74d, []int{43}
 }
 
 func (m *Resources) XXX_Unmarshal(b []byte) error {
@@ -2391,7 +2683,7 @@ func (m *AllocatedTaskResources) Reset()         { *m = AllocatedTaskResources{}
 func (m *AllocatedTaskResources) String() string { return proto.CompactTextString(m) }
 func (*AllocatedTaskResources) ProtoMessage()    {}
 func (*AllocatedTaskResources) Descriptor() ([]byte, []int) {
-	return fileDescriptor_4a8f45747846a74d, []int{38}
+	return fileDescriptor_4a8f45747846a74d, []int{44}
 }
 
 func (m *AllocatedTaskResources) XXX_Unmarshal(b []byte) error {
@@ -2444,7 +2736,7 @@ func (m *AllocatedCpuResources) Reset()         { *m = AllocatedCpuResources{} }
 func (m *AllocatedCpuResources) String() string { return proto.CompactTextString(m) }
 func (*AllocatedCpuResources) ProtoMessage()    {}
 func (*AllocatedCpuResources) Descriptor() ([]byte, []int) {
-	return fileDescriptor_4a8f45747846a74d, []int{39}
+	return fileDescriptor_4a8f45747846a74d, []int{45}
 }
 
 func (m *AllocatedCpuResources) XXX_Unmarshal(b []byte) error {
@@ -2484,7 +2776,7 @@ func (m *AllocatedMemoryResources) Reset()         { *m = AllocatedMemoryResourc
 func (m *AllocatedMemoryResources) String() string { return proto.CompactTextString(m) }
 func (*AllocatedMemoryResources) ProtoMessage()    {}
 func (*AllocatedMemoryResources) Descriptor() ([]byte, []int) {
-	return fileDescriptor_4a8f45747846a74d, []int{40}
+	return fileDescriptor_4a8f45747846a74d, []int{46}
 }
 
 func (m *AllocatedMemoryResources) XXX_Unmarshal(b []byte) error {
@@ -2535,7 +2827,7 @@ func (m *NetworkResource) Reset()         { *m = NetworkResource{} }
 func (m *NetworkResource) String() string { return proto.CompactTextString(m) }
 func (*NetworkResource) ProtoMessage()    {}
 func (*NetworkResource) Descriptor() ([]byte, []int) {
-	return fileDescriptor_4a8f45747846a74d, []int{41}
+	return fileDescriptor_4a8f45747846a74d, []int{47}
 }
 
 func (m *NetworkResource) XXX_Unmarshal(b []byte) error {
@@ -2610,7 +2902,7 @@ func (m *NetworkPort) Reset()         { *m = NetworkPort{} }
 func (m *NetworkPort) String() string { return proto.CompactTextString(m) }
 func (*NetworkPort) ProtoMessage()    {}
 func (*NetworkPort) Descriptor() ([]byte, []int) {
-	return fileDescriptor_4a8f45747846a74d, []int{42}
+	return fileDescriptor_4a8f45747846a74d, []int{48}
 }
 
 func (m *NetworkPort) XXX_Unmarshal(b []byte) error {
@@ -2659,7 +2951,7 @@ func (m *PortMapping) Reset()         { *m = PortMapping{} }
 func (m *PortMapping) String() string { return proto.CompactTextString(m) }
 func (*PortMapping) ProtoMessage()    {}
 func (*PortMapping) Descriptor() ([]byte, []int) {
-	return fileDescriptor_4a8f45747846a74d, []int{43}
+	return fileDescriptor_4a8f45747846a74d, []int{49}
 }
 
 func (m *PortMapping) XXX_Unmarshal(b []byte) error {
@@ -2736,7 +3028,7 @@ func (m *LinuxResources) Reset()         { *m = LinuxResources{} }
 func (m *LinuxResources) String() string { return proto.CompactTextString(m) }
 func (*LinuxResources) ProtoMessage()    {}
 func (*LinuxResources) Descriptor() ([]byte, []int) {
-	return fileDescriptor_4a8f45747846a74d, []int{44}
+	return fileDescriptor_4a8f45747846a74d, []int{50}
 }
 
 func (m *LinuxResources) XXX_Unmarshal(b []byte) error {
@@ -2813,6 +3105,64 @@ func (m *LinuxResources) GetPercentTicks() float64 {
 	return 0
 }
 
+type IDMapping struct {
+	// UidStart is the first host user ID of the range
+	UidStart uint32 `protobuf:"varint,1,opt,name=uid_start,json=uidStart,proto3" json:"uid_start,omitempty"`
+	// GidStart is the first host group ID of the range
+	GidStart uint32 `protobuf:"varint,2,opt,name=gid_start,json=gidStart,proto3" json:"gid_start,omitempty"`
+	// Size is the number of IDs in the range
+	Size                 uint32   `protobuf:"varint,3,opt,name=size,proto3" json:"size,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *IDMapping) Reset()         { *m = IDMapping{} }
+func (m *IDMapping) String() string { return proto.CompactTextString(m) }
+func (*IDMapping) ProtoMessage()    {}
+func (*IDMapping) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4a8f45747846a74d, []int{51}
+}
+
+func (m *IDMapping) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_IDMapping.Unmarshal(m, b)
+}
+func (m *IDMapping) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_IDMapping.Marshal(b, m, deterministic)
+}
+func (m *IDMapping) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_IDMapping.Merge(m, src)
+}
+func (m *IDMapping) XXX_Size() int {
+	return xxx_messageInfo_IDMapping.Size(m)
+}
+func (m *IDMapping) XXX_DiscardUnknown() {
+	xxx_messageInfo_IDMapping.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_IDMapping proto.InternalMessageInfo
+
+func (m *IDMapping) GetUidStart() uint32 {
+	if m != nil {
+		return m.UidStart
+	}
+	return 0
+}
+
+func (m *IDMapping) GetGidStart() uint32 {
+	if m != nil {
+		return m.GidStart
+	}
+	return 0
+}
+
+func (m *IDMapping) GetSize() uint32 {
+	if m != nil {
+		return m.Size
+	}
+	return 0
+}
+
 type Mount struct {
 	// TaskPath is the file path within the task directory to mount to
 	TaskPath string `protobuf:"bytes,1,opt,name=task_path,json=taskPath,proto3" json:"task_path,omitempty"`
@@ -2832,7 +3182,7 @@ func (m *Mount) Reset()         { *m = Mount{} }
 func (m *Mount) String() string { return proto.CompactTextString(m) }
 func (*Mount) ProtoMessage()    {}
 func (*Mount) Descriptor() ([]byte, []int) {
-	return fileDescriptor_4a8f45747846a74d, []int{45}
+	return fileDescriptor_4a8f45747846a74d, []int{52}
 }
 
 func (m *Mount) XXX_Unmarshal(b []byte) error {
@@ -2903,7 +3253,7 @@ func (m *Device) Reset()         { *m = Device{} }
 func (m *Device) String() string { return proto.CompactTextString(m) }
 func (*Device) ProtoMessage()    {}
 func (*Device) Descriptor() ([]byte, []int) {
-	return fileDescriptor_4a8f45747846a74d, []int{46}
+	return fileDescriptor_4a8f45747846a74d, []int{53}
 }
 
 func (m *Device) XXX_Unmarshal(b []byte) error {
@@ -2965,7 +3315,7 @@ func (m *TaskHandle) Reset()         { *m = TaskHandle{} }
 func (m *TaskHandle) String() string { return proto.CompactTextString(m) }
 func (*TaskHandle) ProtoMessage()    {}
 func (*TaskHandle) Descriptor() ([]byte, []int) {
-	return fileDescriptor_4a8f45747846a74d, []int{47}
+	return fileDescriptor_4a8f45747846a74d, []int{54}
 }
 
 func (m *TaskHandle) XXX_Unmarshal(b []byte) error {
@@ -3033,7 +3383,7 @@ func (m *NetworkOverride) Reset()         { *m = NetworkOverride{} }
 func (m *NetworkOverride) String() string { return proto.CompactTextString(m) }
 func (*NetworkOverride) ProtoMessage()    {}
 func (*NetworkOverride) Descriptor() ([]byte, []int) {
-	return fileDescriptor_4a8f45747846a74d, []int{48}
+	return fileDescriptor_4a8f45747846a74d, []int{55}
 }
 
 func (m *NetworkOverride) XXX_Unmarshal(b []byte) error {
@@ -3092,7 +3442,7 @@ func (m *ExitResult) Reset()         { *m = ExitResult{} }
 func (m *ExitResult) String() string { return proto.CompactTextString(m) }
 func (*ExitResult) ProtoMessage()    {}
 func (*ExitResult) Descriptor() ([]byte, []int) {
-	return fileDescriptor_4a8f45747846a74d, []int{49}
+	return fileDescriptor_4a8f45747846a74d, []int{56}
 }
 
 func (m *ExitResult) XXX_Unmarshal(b []byte) error {
@@ -3156,7 +3506,7 @@ func (m *TaskStatus) Reset()         { *m = TaskStatus{} }
 func (m *TaskStatus) String() string { return proto.CompactTextString(m) }
 func (*TaskStatus) ProtoMessage()    {}
 func (*TaskStatus) Descriptor() ([]byte, []int) {
-	return fileDescriptor_4a8f45747846a74d, []int{50}
+	return fileDescriptor_4a8f45747846a74d, []int{57}
 }
 
 func (m *TaskStatus) XXX_Unmarshal(b []byte) error {
@@ -3232,7 +3582,7 @@ func (m *TaskDriverStatus) Reset()         { *m = TaskDriverStatus{} }
 func (m *TaskDriverStatus) String() string { return proto.CompactTextString(m) }
 func (*TaskDriverStatus) ProtoMessage()    {}
 func (*TaskDriverStatus) Descriptor() ([]byte, []int) {
-	return fileDescriptor_4a8f45747846a74d, []int{51}
+	return fileDescriptor_4a8f45747846a74d, []int{58}
 }
 
 func (m *TaskDriverStatus) XXX_Unmarshal(b []byte) error {
@@ -3278,7 +3628,7 @@ func (m *TaskStats) Reset()         { *m = TaskStats{} }
 func (m *TaskStats) String() string { return proto.CompactTextString(m) }
 func (*TaskStats) ProtoMessage()    {}
 func (*TaskStats) Descriptor() ([]byte, []int) {
-	return fileDescriptor_4a8f45747846a74d, []int{52}
+	return fileDescriptor_4a8f45747846a74d, []int{59}
 }
 
 func (m *TaskStats) XXX_Unmarshal(b []byte) error {
@@ -3331,17 +3681,19 @@ type TaskResourceUsage struct {
 	// CPU usage stats
 	Cpu *CPUUsage `protobuf:"bytes,1,opt,name=cpu,proto3" json:"cpu,omitempty"`
 	// Memory usage stats
-	Memory               *MemoryUsage `protobuf:"bytes,2,opt,name=memory,proto3" json:"memory,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}     `json:"-"`
-	XXX_unrecognized     []byte       `json:"-"`
-	XXX_sizecache        int32        `json:"-"`
+	Memory *MemoryUsage `protobuf:"bytes,2,opt,name=memory,proto3" json:"memory,omitempty"`
+	// Block IO usage stats
+	Io                   *IOUsage `protobuf:"bytes,3,opt,name=io,proto3" json:"io,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
 func (m *TaskResourceUsage) Reset()         { *m = TaskResourceUsage{} }
 func (m *TaskResourceUsage) String() string { return proto.CompactTextString(m) }
 func (*TaskResourceUsage) ProtoMessage()    {}
 func (*TaskResourceUsage) Descriptor() ([]byte, []int) {
-	return fileDescriptor_4a8f45747846a74d, []int{53}
+	return fileDescriptor_4a8f45747846a74d, []int{60}
 }
 
 func (m *TaskResourceUsage) XXX_Unmarshal(b []byte) error {
@@ -3376,6 +3728,13 @@ func (m *TaskResourceUsage) GetMemory() *MemoryUsage {
 	return nil
 }
 
+func (m *TaskResourceUsage) GetIo() *IOUsage {
+	if m != nil {
+		return m.Io
+	}
+	return nil
+}
+
 type CPUUsage struct {
 	SystemMode       float64 `protobuf:"fixed64,1,opt,name=system_mode,json=systemMode,proto3" json:"system_mode,omitempty"`
 	UserMode         float64 `protobuf:"fixed64,2,opt,name=user_mode,json=userMode,proto3" json:"user_mode,omitempty"`
@@ -3394,7 +3753,7 @@ func (m *CPUUsage) Reset()         { *m = CPUUsage{} }
 func (m *CPUUsage) String() string { return proto.CompactTextString(m) }
 func (*CPUUsage) ProtoMessage()    {}
 func (*CPUUsage) Descriptor() ([]byte, []int) {
-	return fileDescriptor_4a8f45747846a74d, []int{54}
+	return fileDescriptor_4a8f45747846a74d, []int{61}
 }
 
 func (m *CPUUsage) XXX_Unmarshal(b []byte) error {
@@ -3483,7 +3842,7 @@ func (m *MemoryUsage) Reset()         { *m = MemoryUsage{} }
 func (m *MemoryUsage) String() string { return proto.CompactTextString(m) }
 func (*MemoryUsage) ProtoMessage()    {}
 func (*MemoryUsage) Descriptor() ([]byte, []int) {
-	return fileDescriptor_4a8f45747846a74d, []int{55}
+	return fileDescriptor_4a8f45747846a74d, []int{62}
 }
 
 func (m *MemoryUsage) XXX_Unmarshal(b []byte) error {
@@ -3560,6 +3919,78 @@ func (m *MemoryUsage) GetMeasuredFields() []MemoryUsage_Fields {
 	return nil
 }
 
+type IOUsage struct {
+	ReadBytes  uint64 `protobuf:"varint,1,opt,name=read_bytes,json=readBytes,proto3" json:"read_bytes,omitempty"`
+	WriteBytes uint64 `protobuf:"varint,2,opt,name=write_bytes,json=writeBytes,proto3" json:"write_bytes,omitempty"`
+	ReadOps    uint64 `protobuf:"varint,3,opt,name=read_ops,json=readOps,proto3" json:"read_ops,omitempty"`
+	WriteOps   uint64 `protobuf:"varint,4,opt,name=write_ops,json=writeOps,proto3" json:"write_ops,omitempty"`
+	// MeasuredFields indicates which fields were actually sampled
+	MeasuredFields       []IOUsage_Fields `protobuf:"varint,5,rep,packed,name=measured_fields,json=measuredFields,proto3,enum=hashicorp.nomad.plugins.drivers.proto.IOUsage_Fields" json:"measured_fields,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}         `json:"-"`
+	XXX_unrecognized     []byte           `json:"-"`
+	XXX_sizecache        int32            `json:"-"`
+}
+
+func (m *IOUsage) Reset()         { *m = IOUsage{} }
+func (m *IOUsage) String() string { return proto.CompactTextString(m) }
+func (*IOUsage) ProtoMessage()    {}
+func (*IOUsage) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4a8f45747846a74d, []int{63}
+}
+
+func (m *IOUsage) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_IOUsage.Unmarshal(m, b)
+}
+func (m *IOUsage) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_IOUsage.Marshal(b, m, deterministic)
+}
+func (m *IOUsage) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_IOUsage.Merge(m, src)
+}
+func (m *IOUsage) XXX_Size() int {
+	return xxx_messageInfo_IOUsage.Size(m)
+}
+func (m *IOUsage) XXX_DiscardUnknown() {
+	xxx_messageInfo_IOUsage.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_IOUsage proto.InternalMessageInfo
+
+func (m *IOUsage) GetReadBytes() uint64 {
+	if m != nil {
+		return m.ReadBytes
+	}
+	return 0
+}
+
+func (m *IOUsage) GetWriteBytes() uint64 {
+	if m != nil {
+		return m.WriteBytes
+	}
+	return 0
+}
+
+func (m *IOUsage) GetReadOps() uint64 {
+	if m != nil {
+		return m.ReadOps
+	}
+	return 0
+}
+
+func (m *IOUsage) GetWriteOps() uint64 {
+	if m != nil {
+		return m.WriteOps
+	}
+	return 0
+}
+
+func (m *IOUsage) GetMeasuredFields() []IOUsage_Fields {
+	if m != nil {
+		return m.MeasuredFields
+	}
+	return nil
+}
+
 type DriverTaskEvent struct {
 	// TaskId is the id of the task for the event
 	TaskId string `protobuf:"bytes,1,opt,name=task_id,json=taskId,proto3" json:"task_id,omitempty"`
@@ -3582,7 +4013,7 @@ func (m *DriverTaskEvent) Reset()         { *m = DriverTaskEvent{} }
 func (m *DriverTaskEvent) String() string { return proto.CompactTextString(m) }
 func (*DriverTaskEvent) ProtoMessage()    {}
 func (*DriverTaskEvent) Descriptor() ([]byte, []int) {
-	return fileDescriptor_4a8f45747846a74d, []int{56}
+	return fileDescriptor_4a8f45747846a74d, []int{64}
 }
 
 func (m *DriverTaskEvent) XXX_Unmarshal(b []byte) error {
@@ -3654,6 +4085,7 @@ func init() {
 	proto.RegisterEnum("hashicorp.nomad.plugins.drivers.proto.NetworkIsolationSpec_NetworkIsolationMode", NetworkIsolationSpec_NetworkIsolationMode_name, NetworkIsolationSpec_NetworkIsolationMode_value)
 	proto.RegisterEnum("hashicorp.nomad.plugins.drivers.proto.CPUUsage_Fields", CPUUsage_Fields_name, CPUUsage_Fields_value)
 	proto.RegisterEnum("hashicorp.nomad.plugins.drivers.proto.MemoryUsage_Fields", MemoryUsage_Fields_name, MemoryUsage_Fields_value)
+	proto.RegisterEnum("hashicorp.nomad.plugins.drivers.proto.IOUsage_Fields", IOUsage_Fields_name, IOUsage_Fields_value)
 	proto.RegisterType((*TaskConfigSchemaRequest)(nil), "hashicorp.nomad.plugins.drivers.proto.TaskConfigSchemaRequest")
 	proto.RegisterType((*TaskConfigSchemaResponse)(nil), "hashicorp.nomad.plugins.drivers.proto.TaskConfigSchemaResponse")
 	proto.RegisterType((*CapabilitiesRequest)(nil), "hashicorp.nomad.plugins.drivers.proto.CapabilitiesRequest")
@@ -3678,6 +4110,12 @@ func init() {
 	proto.RegisterType((*TaskEventsRequest)(nil), "hashicorp.nomad.plugins.drivers.proto.TaskEventsRequest")
 	proto.RegisterType((*SignalTaskRequest)(nil), "hashicorp.nomad.plugins.drivers.proto.SignalTaskRequest")
 	proto.RegisterType((*SignalTaskResponse)(nil), "hashicorp.nomad.plugins.drivers.proto.SignalTaskResponse")
+	proto.RegisterType((*UpdateTaskResourcesRequest)(nil), "hashicorp.nomad.plugins.drivers.proto.UpdateTaskResourcesRequest")
+	proto.RegisterType((*UpdateTaskResourcesResponse)(nil), "hashicorp.nomad.plugins.drivers.proto.UpdateTaskResourcesResponse")
+	proto.RegisterType((*PauseTaskRequest)(nil), "hashicorp.nomad.plugins.drivers.proto.PauseTaskRequest")
+	proto.RegisterType((*PauseTaskResponse)(nil), "hashicorp.nomad.plugins.drivers.proto.PauseTaskResponse")
+	proto.RegisterType((*ResumeTaskRequest)(nil), "hashicorp.nomad.plugins.drivers.proto.ResumeTaskRequest")
+	proto.RegisterType((*ResumeTaskResponse)(nil), "hashicorp.nomad.plugins.drivers.proto.ResumeTaskResponse")
 	proto.RegisterType((*ExecTaskRequest)(nil), "hashicorp.nomad.plugins.drivers.proto.ExecTaskRequest")
 	proto.RegisterType((*ExecTaskResponse)(nil), "hashicorp.nomad.plugins.drivers.proto.ExecTaskResponse")
 	proto.RegisterType((*ExecTaskStreamingIOOperation)(nil), "hashicorp.nomad.plugins.drivers.proto.ExecTaskStreamingIOOperation")
@@ -3705,6 +4143,7 @@ func init() {
 	proto.RegisterType((*NetworkPort)(nil), "hashicorp.nomad.plugins.drivers.proto.NetworkPort")
 	proto.RegisterType((*PortMapping)(nil), "hashicorp.nomad.plugins.drivers.proto.PortMapping")
 	proto.RegisterType((*LinuxResources)(nil), "hashicorp.nomad.plugins.drivers.proto.LinuxResources")
+	proto.RegisterType((*IDMapping)(nil), "hashicorp.nomad.plugins.drivers.proto.IDMapping")
 	proto.RegisterType((*Mount)(nil), "hashicorp.nomad.plugins.drivers.proto.Mount")
 	proto.RegisterType((*Device)(nil), "hashicorp.nomad.plugins.drivers.proto.Device")
 	proto.RegisterType((*TaskHandle)(nil), "hashicorp.nomad.plugins.drivers.proto.TaskHandle")
@@ -3719,6 +4158,7 @@ func init() {
 	proto.RegisterType((*TaskResourceUsage)(nil), "hashicorp.nomad.plugins.drivers.proto.TaskResourceUsage")
 	proto.RegisterType((*CPUUsage)(nil), "hashicorp.nomad.plugins.drivers.proto.CPUUsage")
 	proto.RegisterType((*MemoryUsage)(nil), "hashicorp.nomad.plugins.drivers.proto.MemoryUsage")
+	proto.RegisterType((*IOUsage)(nil), "hashicorp.nomad.plugins.drivers.proto.IOUsage")
 	proto.RegisterType((*DriverTaskEvent)(nil), "hashicorp.nomad.plugins.drivers.proto.DriverTaskEvent")
 	proto.RegisterMapType((map[string]string)(nil), "hashicorp.nomad.plugins.drivers.proto.DriverTaskEvent.AnnotationsEntry")
 }
@@ -3728,248 +4168,269 @@ func init() {
 }
 
 var fileDescriptor_4a8f45747846a74d = []byte{
-	// 3851 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x5a, 0xcd, 0x73, 0x1b, 0x47,
-	0x76, 0xe7, 0xe0, 0x8b, 0xc0, 0x03, 0x09, 0x0e, 0x9b, 0xa4, 0x05, 0xc1, 0x9b, 0x58, 0x3b, 0x29,
-	0xa7, 0x98, 0x5d, 0x1b, 0xf2, 0x72, 0x2b, 0x96, 0xe5, 0x95, 0x57, 0x86, 0x41, 0x48, 0x84, 0x45,
-	0x82, 0x4c, 0x03, 0x2c, 0xad, 0xa2, 0xc4, 0x93, 0xe1, 0x4c, 0x0b, 0x1c, 0x11, 0xf3, 0xe1, 0xe9,
-	0x01, 0x45, 0x6e, 0x2a, 0x95, 0xd4, 0xa6, 0x2a, 0xe5, 0x54, 0x25, 0x95, 0x5c, 0x9c, 0xbd, 0xe4,
-	0xb4, 0x55, 0x39, 0xe5, 0x1f, 0x48, 0x6d, 0x6a, 0x4f, 0x39, 0xe4, 0x8f, 0x48, 0x2e, 0xb9, 0xe5,
-	0x9a, 0x53, 0xae, 0x5b, 0xfd, 0x31, 0x83, 0x19, 0x00, 0xb2, 0x06, 0xa0, 0x4e, 0x98, 0xf7, 0xba,
-	0xfb, 0xd7, 0x0f, 0xef, 0xbd, 0x7e, 0xfd, 0xba, 0xfb, 0x81, 0xe6, 0x8f, 0xc6, 0x43, 0xdb, 0xa5,
-	0x77, 0xad, 0xc0, 0xbe, 0x24, 0x01, 0xbd, 0xeb, 0x07, 0x5e, 0xe8, 0x49, 0xaa, 0xc9, 0x09, 0xf4,
-	0xfe, 0xb9, 0x41, 0xcf, 0x6d, 0xd3, 0x0b, 0xfc, 0xa6, 0xeb, 0x39, 0x86, 0xd5, 0x94, 0x63, 0x9a,
-	0x72, 0x8c, 0xe8, 0xd6, 0xf8, 0xdd, 0xa1, 0xe7, 0x0d, 0x47, 0x44, 0x20, 0x9c, 0x8d, 0x5f, 0xdc,
-	0xb5, 0xc6, 0x81, 0x11, 0xda, 0x9e, 0x2b, 0xdb, 0xdf, 0x9b, 0x6e, 0x0f, 0x6d, 0x87, 0xd0, 0xd0,
-	0x70, 0x7c, 0xd9, 0xe1, 0xfd, 0x48, 0x16, 0x7a, 0x6e, 0x04, 0xc4, 0xba, 0x7b, 0x6e, 0x8e, 0xa8,
-	0x4f, 0x4c, 0xf6, 0xab, 0xb3, 0x0f, 0xd9, 0xed, 0x83, 0xa9, 0x6e, 0x34, 0x0c, 0xc6, 0x66, 0x18,
-	0x49, 0x6e, 0x84, 0x61, 0x60, 0x9f, 0x8d, 0x43, 0x22, 0x7a, 0x6b, 0xb7, 0xe1, 0xd6, 0xc0, 0xa0,
-	0x17, 0x6d, 0xcf, 0x7d, 0x61, 0x0f, 0xfb, 0xe6, 0x39, 0x71, 0x0c, 0x4c, 0xbe, 0x1e, 0x13, 0x1a,
-	0x6a, 0x7f, 0x02, 0xf5, 0xd9, 0x26, 0xea, 0x7b, 0x2e, 0x25, 0xe8, 0x73, 0x28, 0xb0, 0x29, 0xeb,
-	0xca, 0x1d, 0x65, 0xb7, 0xba, 0xf7, 0x41, 0xf3, 0x75, 0x2a, 0x10, 0x32, 0x34, 0xa5, 0xa8, 0xcd,
-	0xbe, 0x4f, 0x4c, 0xcc, 0x47, 0x6a, 0x3b, 0xb0, 0xd5, 0x36, 0x7c, 0xe3, 0xcc, 0x1e, 0xd9, 0xa1,
-	0x4d, 0x68, 0x34, 0xe9, 0x18, 0xb6, 0xd3, 0x6c, 0x39, 0xe1, 0x9f, 0xc2, 0x9a, 0x99, 0xe0, 0xcb,
-	0x89, 0xef, 0x37, 0x33, 0xe9, 0xbe, 0xb9, 0xcf, 0xa9, 0x14, 0x70, 0x0a, 0x4e, 0xdb, 0x06, 0xf4,
-	0xc8, 0x76, 0x87, 0x24, 0xf0, 0x03, 0xdb, 0x0d, 0x23, 0x61, 0x7e, 0x93, 0x87, 0xad, 0x14, 0x5b,
-	0x0a, 0xf3, 0x12, 0x20, 0xd6, 0x23, 0x13, 0x25, 0xbf, 0x5b, 0xdd, 0xfb, 0x32, 0xa3, 0x28, 0x73,
-	0xf0, 0x9a, 0xad, 0x18, 0xac, 0xe3, 0x86, 0xc1, 0x35, 0x4e, 0xa0, 0xa3, 0xaf, 0xa0, 0x74, 0x4e,
-	0x8c, 0x51, 0x78, 0x5e, 0xcf, 0xdd, 0x51, 0x76, 0x6b, 0x7b, 0x8f, 0x6e, 0x30, 0xcf, 0x01, 0x07,
-	0xea, 0x87, 0x46, 0x48, 0xb0, 0x44, 0x45, 0x1f, 0x02, 0x12, 0x5f, 0xba, 0x45, 0xa8, 0x19, 0xd8,
-	0x3e, 0x73, 0xc9, 0x7a, 0xfe, 0x8e, 0xb2, 0x5b, 0xc1, 0x9b, 0xa2, 0x65, 0x7f, 0xd2, 0xd0, 0xf0,
-	0x61, 0x63, 0x4a, 0x5a, 0xa4, 0x42, 0xfe, 0x82, 0x5c, 0x73, 0x8b, 0x54, 0x30, 0xfb, 0x44, 0x8f,
-	0xa1, 0x78, 0x69, 0x8c, 0xc6, 0x84, 0x8b, 0x5c, 0xdd, 0xfb, 0xd1, 0x9b, 0xdc, 0x43, 0xba, 0xe8,
-	0x44, 0x0f, 0x58, 0x8c, 0xff, 0x34, 0xf7, 0x89, 0xa2, 0xdd, 0x87, 0x6a, 0x42, 0x6e, 0x54, 0x03,
-	0x38, 0xed, 0xed, 0x77, 0x06, 0x9d, 0xf6, 0xa0, 0xb3, 0xaf, 0xae, 0xa0, 0x75, 0xa8, 0x9c, 0xf6,
-	0x0e, 0x3a, 0xad, 0xc3, 0xc1, 0xc1, 0x33, 0x55, 0x41, 0x55, 0x58, 0x8d, 0x88, 0x9c, 0x76, 0x05,
-	0x08, 0x13, 0xd3, 0xbb, 0x24, 0x01, 0x73, 0x64, 0x69, 0x55, 0x74, 0x0b, 0x56, 0x43, 0x83, 0x5e,
-	0xe8, 0xb6, 0x25, 0x65, 0x2e, 0x31, 0xb2, 0x6b, 0xa1, 0x2e, 0x94, 0xce, 0x0d, 0xd7, 0x1a, 0xbd,
-	0x59, 0xee, 0xb4, 0xaa, 0x19, 0xf8, 0x01, 0x1f, 0x88, 0x25, 0x00, 0xf3, 0xee, 0xd4, 0xcc, 0xc2,
-	0x00, 0xda, 0x33, 0x50, 0xfb, 0xa1, 0x11, 0x84, 0x49, 0x71, 0x3a, 0x50, 0x60, 0xf3, 0x4b, 0x8f,
-	0x5e, 0x64, 0x4e, 0xb1, 0x32, 0x31, 0x1f, 0xae, 0xfd, 0x5f, 0x0e, 0x36, 0x13, 0xd8, 0xd2, 0x53,
-	0x9f, 0x42, 0x29, 0x20, 0x74, 0x3c, 0x0a, 0x39, 0x7c, 0x6d, 0xef, 0x61, 0x46, 0xf8, 0x19, 0xa4,
-	0x26, 0xe6, 0x30, 0x58, 0xc2, 0xa1, 0x5d, 0x50, 0xc5, 0x08, 0x9d, 0x04, 0x81, 0x17, 0xe8, 0x0e,
-	0x1d, 0x72, 0xad, 0x55, 0x70, 0x4d, 0xf0, 0x3b, 0x8c, 0x7d, 0x44, 0x87, 0x09, 0xad, 0xe6, 0x6f,
-	0xa8, 0x55, 0x64, 0x80, 0xea, 0x92, 0xf0, 0x95, 0x17, 0x5c, 0xe8, 0x4c, 0xb5, 0x81, 0x6d, 0x91,
-	0x7a, 0x81, 0x83, 0x7e, 0x9c, 0x11, 0xb4, 0x27, 0x86, 0x1f, 0xcb, 0xd1, 0x78, 0xc3, 0x4d, 0x33,
-	0xb4, 0x1f, 0x42, 0x49, 0xfc, 0x53, 0xe6, 0x49, 0xfd, 0xd3, 0x76, 0xbb, 0xd3, 0xef, 0xab, 0x2b,
-	0xa8, 0x02, 0x45, 0xdc, 0x19, 0x60, 0xe6, 0x61, 0x15, 0x28, 0x3e, 0x6a, 0x0d, 0x5a, 0x87, 0x6a,
-	0x4e, 0xfb, 0x01, 0x6c, 0x3c, 0x35, 0xec, 0x30, 0x8b, 0x73, 0x69, 0x1e, 0xa8, 0x93, 0xbe, 0xd2,
-	0x3a, 0xdd, 0x94, 0x75, 0xb2, 0xab, 0xa6, 0x73, 0x65, 0x87, 0x53, 0xf6, 0x50, 0x21, 0x4f, 0x82,
-	0x40, 0x9a, 0x80, 0x7d, 0x6a, 0xaf, 0x60, 0xa3, 0x1f, 0x7a, 0x7e, 0x26, 0xcf, 0xff, 0x31, 0xac,
-	0xb2, 0xdd, 0xc6, 0x1b, 0x87, 0xd2, 0xf5, 0x6f, 0x37, 0xc5, 0x6e, 0xd4, 0x8c, 0x76, 0xa3, 0xe6,
-	0xbe, 0xdc, 0xad, 0x70, 0xd4, 0x13, 0xbd, 0x03, 0x25, 0x6a, 0x0f, 0x5d, 0x63, 0x24, 0xa3, 0x85,
-	0xa4, 0x34, 0xc4, 0x9c, 0x3c, 0x9a, 0x58, 0x3a, 0x7e, 0x1b, 0xd0, 0x3e, 0xa1, 0x61, 0xe0, 0x5d,
-	0x67, 0x92, 0x67, 0x1b, 0x8a, 0x2f, 0xbc, 0xc0, 0x14, 0x0b, 0xb1, 0x8c, 0x05, 0xc1, 0x16, 0x55,
-	0x0a, 0x44, 0x62, 0x7f, 0x08, 0xa8, 0xeb, 0xb2, 0x3d, 0x25, 0x9b, 0x21, 0xfe, 0x31, 0x07, 0x5b,
-	0xa9, 0xfe, 0xd2, 0x18, 0xcb, 0xaf, 0x43, 0x16, 0x98, 0xc6, 0x54, 0xac, 0x43, 0x74, 0x0c, 0x25,
-	0xd1, 0x43, 0x6a, 0xf2, 0xde, 0x02, 0x40, 0x62, 0x9b, 0x92, 0x70, 0x12, 0x66, 0xae, 0xd3, 0xe7,
-	0xdf, 0xae, 0xd3, 0xbf, 0x02, 0x35, 0xfa, 0x1f, 0xf4, 0x8d, 0xb6, 0xf9, 0x12, 0xb6, 0x4c, 0x6f,
-	0x34, 0x22, 0x26, 0xf3, 0x06, 0xdd, 0x76, 0x43, 0x12, 0x5c, 0x1a, 0xa3, 0x37, 0xfb, 0x0d, 0x9a,
-	0x8c, 0xea, 0xca, 0x41, 0xda, 0x73, 0xd8, 0x4c, 0x4c, 0x2c, 0x0d, 0xf1, 0x08, 0x8a, 0x94, 0x31,
-	0xa4, 0x25, 0x3e, 0x5a, 0xd0, 0x12, 0x14, 0x8b, 0xe1, 0xda, 0x96, 0x00, 0xef, 0x5c, 0x12, 0x37,
-	0xfe, 0x5b, 0xda, 0x3e, 0x6c, 0xf6, 0xb9, 0x9b, 0x66, 0xf2, 0xc3, 0x89, 0x8b, 0xe7, 0x52, 0x2e,
-	0xbe, 0x0d, 0x28, 0x89, 0x22, 0x1d, 0xf1, 0x1a, 0x36, 0x3a, 0x57, 0xc4, 0xcc, 0x84, 0x5c, 0x87,
-	0x55, 0xd3, 0x73, 0x1c, 0xc3, 0xb5, 0xea, 0xb9, 0x3b, 0xf9, 0xdd, 0x0a, 0x8e, 0xc8, 0xe4, 0x5a,
-	0xcc, 0x67, 0x5d, 0x8b, 0xda, 0xdf, 0x2b, 0xa0, 0x4e, 0xe6, 0x96, 0x8a, 0x64, 0xd2, 0x87, 0x16,
-	0x03, 0x62, 0x73, 0xaf, 0x61, 0x49, 0x49, 0x7e, 0x14, 0x2e, 0x04, 0x9f, 0x04, 0x41, 0x22, 0x1c,
-	0xe5, 0x6f, 0x18, 0x8e, 0xb4, 0x03, 0xf8, 0x5e, 0x24, 0x4e, 0x3f, 0x0c, 0x88, 0xe1, 0xd8, 0xee,
-	0xb0, 0x7b, 0x7c, 0xec, 0x13, 0x21, 0x38, 0x42, 0x50, 0xb0, 0x8c, 0xd0, 0x90, 0x82, 0xf1, 0x6f,
-	0xb6, 0xe8, 0xcd, 0x91, 0x47, 0xe3, 0x45, 0xcf, 0x09, 0xed, 0x3f, 0xf3, 0x50, 0x9f, 0x81, 0x8a,
-	0xd4, 0xfb, 0x1c, 0x8a, 0x94, 0x84, 0x63, 0x5f, 0xba, 0x4a, 0x27, 0xb3, 0xc0, 0xf3, 0xf1, 0x9a,
-	0x7d, 0x06, 0x86, 0x05, 0x26, 0x1a, 0x42, 0x39, 0x0c, 0xaf, 0x75, 0x6a, 0xff, 0x3c, 0x4a, 0x08,
-	0x0e, 0x6f, 0x8a, 0x3f, 0x20, 0x81, 0x63, 0xbb, 0xc6, 0xa8, 0x6f, 0xff, 0x9c, 0xe0, 0xd5, 0x30,
-	0xbc, 0x66, 0x1f, 0xe8, 0x19, 0x73, 0x78, 0xcb, 0x76, 0xa5, 0xda, 0xdb, 0xcb, 0xce, 0x92, 0x50,
-	0x30, 0x16, 0x88, 0x8d, 0x43, 0x28, 0xf2, 0xff, 0xb4, 0x8c, 0x23, 0xaa, 0x90, 0x0f, 0xc3, 0x6b,
-	0x2e, 0x54, 0x19, 0xb3, 0xcf, 0xc6, 0x03, 0x58, 0x4b, 0xfe, 0x03, 0xe6, 0x48, 0xe7, 0xc4, 0x1e,
-	0x9e, 0x0b, 0x07, 0x2b, 0x62, 0x49, 0x31, 0x4b, 0xbe, 0xb2, 0x2d, 0x99, 0xb2, 0x16, 0xb1, 0x20,
-	0xb4, 0x7f, 0xcb, 0xc1, 0xed, 0x39, 0x9a, 0x91, 0xce, 0xfa, 0x3c, 0xe5, 0xac, 0x6f, 0x49, 0x0b,
-	0x91, 0xc7, 0x3f, 0x4f, 0x79, 0xfc, 0x5b, 0x04, 0x67, 0xcb, 0xe6, 0x1d, 0x28, 0x91, 0x2b, 0x3b,
-	0x24, 0x96, 0x54, 0x95, 0xa4, 0x12, 0xcb, 0xa9, 0x70, 0xd3, 0xe5, 0x74, 0x04, 0xdb, 0xed, 0x80,
-	0x18, 0x21, 0x91, 0xa1, 0x3c, 0xf2, 0xff, 0xdb, 0x50, 0x36, 0x46, 0x23, 0xcf, 0x9c, 0x98, 0x75,
-	0x95, 0xd3, 0x5d, 0x0b, 0x35, 0xa0, 0x7c, 0xee, 0xd1, 0xd0, 0x35, 0x1c, 0x22, 0x83, 0x57, 0x4c,
-	0x6b, 0xdf, 0x2a, 0xb0, 0x33, 0x85, 0x27, 0xad, 0x70, 0x06, 0x35, 0x9b, 0x7a, 0x23, 0xfe, 0x07,
-	0xf5, 0xc4, 0x09, 0xef, 0x27, 0x8b, 0x6d, 0x35, 0xdd, 0x08, 0x83, 0x1f, 0xf8, 0xd6, 0xed, 0x24,
-	0xc9, 0x3d, 0x8e, 0x4f, 0x6e, 0xc9, 0x95, 0x1e, 0x91, 0xda, 0x3f, 0x29, 0xb0, 0x23, 0x77, 0xf8,
-	0xec, 0x7f, 0x74, 0x56, 0xe4, 0xdc, 0xdb, 0x16, 0x59, 0xab, 0xc3, 0x3b, 0xd3, 0x72, 0xc9, 0x98,
-	0xff, 0xff, 0x05, 0x40, 0xb3, 0xa7, 0x4b, 0xf4, 0x7d, 0x58, 0xa3, 0xc4, 0xb5, 0x74, 0xb1, 0x5f,
-	0x88, 0xad, 0xac, 0x8c, 0xab, 0x8c, 0x27, 0x36, 0x0e, 0xca, 0x42, 0x20, 0xb9, 0x92, 0xd2, 0x96,
-	0x31, 0xff, 0x46, 0xe7, 0xb0, 0xf6, 0x82, 0xea, 0xf1, 0xdc, 0xdc, 0xa1, 0x6a, 0x99, 0xc3, 0xda,
-	0xac, 0x1c, 0xcd, 0x47, 0xfd, 0xf8, 0x7f, 0xe1, 0xea, 0x0b, 0x1a, 0x13, 0xe8, 0x1b, 0x05, 0x6e,
-	0x45, 0x69, 0xc5, 0x44, 0x7d, 0x8e, 0x67, 0x11, 0x5a, 0x2f, 0xdc, 0xc9, 0xef, 0xd6, 0xf6, 0x4e,
-	0x6e, 0xa0, 0xbf, 0x19, 0xe6, 0x91, 0x67, 0x11, 0xbc, 0xe3, 0xce, 0xe1, 0x52, 0xd4, 0x84, 0x2d,
-	0x67, 0x4c, 0x43, 0x5d, 0x78, 0x81, 0x2e, 0x3b, 0xd5, 0x8b, 0x5c, 0x2f, 0x9b, 0xac, 0x29, 0xe5,
-	0xab, 0xe8, 0x02, 0xd6, 0x1d, 0x6f, 0xec, 0x86, 0xba, 0xc9, 0xcf, 0x3f, 0xb4, 0x5e, 0x5a, 0xe8,
-	0x60, 0x3c, 0x47, 0x4b, 0x47, 0x0c, 0x4e, 0x9c, 0xa6, 0x28, 0x5e, 0x73, 0x12, 0x14, 0x33, 0x64,
-	0x40, 0x1c, 0x2f, 0x24, 0x3a, 0x8b, 0x97, 0xb4, 0xbe, 0x2a, 0x0c, 0x29, 0x78, 0x2c, 0x34, 0x50,
-	0xad, 0x09, 0xd5, 0x84, 0x9a, 0x51, 0x19, 0x0a, 0xbd, 0xe3, 0x5e, 0x47, 0x5d, 0x41, 0x00, 0xa5,
-	0xf6, 0x01, 0x3e, 0x3e, 0x1e, 0x88, 0x53, 0x43, 0xf7, 0xa8, 0xf5, 0xb8, 0xa3, 0xe6, 0xb4, 0x0e,
-	0xac, 0x25, 0x27, 0x44, 0x08, 0x6a, 0xa7, 0xbd, 0x27, 0xbd, 0xe3, 0xa7, 0x3d, 0xfd, 0xe8, 0xf8,
-	0xb4, 0x37, 0x60, 0xe7, 0x8d, 0x1a, 0x40, 0xab, 0xf7, 0x6c, 0x42, 0xaf, 0x43, 0xa5, 0x77, 0x1c,
-	0x91, 0x4a, 0x23, 0xa7, 0x2a, 0xda, 0x7f, 0xe4, 0x61, 0x7b, 0x9e, 0xee, 0x91, 0x05, 0x05, 0x66,
-	0x47, 0x79, 0xe2, 0x7b, 0xfb, 0x66, 0xe4, 0xe8, 0xcc, 0x7d, 0x7d, 0x43, 0x86, 0xf8, 0x0a, 0xe6,
-	0xdf, 0x48, 0x87, 0xd2, 0xc8, 0x38, 0x23, 0x23, 0x5a, 0xcf, 0xf3, 0x3b, 0x91, 0xc7, 0x37, 0x99,
-	0xfb, 0x90, 0x23, 0x89, 0x0b, 0x11, 0x09, 0x8b, 0x06, 0x50, 0x65, 0x41, 0x8c, 0x0a, 0xd5, 0xc9,
-	0xb8, 0xba, 0x97, 0x71, 0x96, 0x83, 0xc9, 0x48, 0x9c, 0x84, 0x69, 0xdc, 0x87, 0x6a, 0x62, 0xb2,
-	0x39, 0xf7, 0x19, 0xdb, 0xc9, 0xfb, 0x8c, 0x4a, 0xf2, 0x72, 0xe2, 0xe1, 0xac, 0x0d, 0x98, 0x8e,
-	0x98, 0x13, 0x1c, 0x1c, 0xf7, 0x07, 0xe2, 0xe4, 0xf8, 0x18, 0x1f, 0x9f, 0x9e, 0xa8, 0x0a, 0x63,
-	0x0e, 0x5a, 0xfd, 0x27, 0x6a, 0x2e, 0xf6, 0x91, 0xbc, 0xd6, 0x86, 0x6a, 0x42, 0xae, 0x54, 0xd4,
-	0x56, 0xd2, 0x51, 0x9b, 0xc5, 0x4d, 0xc3, 0xb2, 0x02, 0x42, 0xa9, 0x94, 0x23, 0x22, 0xb5, 0xe7,
-	0x50, 0xd9, 0xef, 0xf5, 0x25, 0x44, 0x1d, 0x56, 0x29, 0x09, 0xd8, 0xff, 0xe6, 0x37, 0x53, 0x15,
-	0x1c, 0x91, 0x0c, 0x9c, 0x12, 0x23, 0x30, 0xcf, 0x09, 0x95, 0x7b, 0x7d, 0x4c, 0xb3, 0x51, 0x1e,
-	0xbf, 0xe1, 0x11, 0xb6, 0xab, 0xe0, 0x88, 0xd4, 0xfe, 0xab, 0x0c, 0x30, 0xb9, 0x6d, 0x40, 0x35,
-	0xc8, 0xc5, 0x31, 0x38, 0x67, 0x5b, 0xcc, 0x0f, 0x12, 0x7b, 0x0c, 0xff, 0x46, 0x7b, 0xb0, 0xe3,
-	0xd0, 0xa1, 0x6f, 0x98, 0x17, 0xba, 0xbc, 0x24, 0x10, 0x4b, 0x95, 0xc7, 0xb3, 0x35, 0xbc, 0x25,
-	0x1b, 0xe5, 0x4a, 0x14, 0xb8, 0x87, 0x90, 0x27, 0xee, 0x25, 0x8f, 0x3d, 0xd5, 0xbd, 0x4f, 0x17,
-	0xbe, 0x05, 0x69, 0x76, 0xdc, 0x4b, 0xe1, 0x2b, 0x0c, 0x06, 0xe9, 0x00, 0x16, 0xb9, 0xb4, 0x4d,
-	0xa2, 0x33, 0xd0, 0x22, 0x07, 0xfd, 0x7c, 0x71, 0xd0, 0x7d, 0x8e, 0x11, 0x43, 0x57, 0xac, 0x88,
-	0x46, 0x3d, 0xa8, 0x04, 0x84, 0x7a, 0xe3, 0xc0, 0x24, 0x22, 0x00, 0x65, 0x3f, 0xa8, 0xe0, 0x68,
-	0x1c, 0x9e, 0x40, 0xa0, 0x7d, 0x28, 0xf1, 0xb8, 0xc3, 0x22, 0x4c, 0xfe, 0x3b, 0xaf, 0x54, 0xd3,
-	0x60, 0x3c, 0x92, 0x60, 0x39, 0x16, 0x3d, 0x86, 0x55, 0x21, 0x22, 0xad, 0x97, 0x39, 0xcc, 0x87,
-	0x59, 0x83, 0x22, 0x1f, 0x85, 0xa3, 0xd1, 0xcc, 0xaa, 0x63, 0x4a, 0x82, 0x7a, 0x45, 0x58, 0x95,
-	0x7d, 0xa3, 0x77, 0xa1, 0x22, 0xf6, 0x60, 0xcb, 0x0e, 0xea, 0x20, 0x9c, 0x93, 0x33, 0xf6, 0xed,
-	0x00, 0xbd, 0x07, 0x55, 0x91, 0x6b, 0xe9, 0x3c, 0x2a, 0x54, 0x79, 0x33, 0x08, 0xd6, 0x09, 0x8b,
-	0x0d, 0xa2, 0x03, 0x09, 0x02, 0xd1, 0x61, 0x2d, 0xee, 0x40, 0x82, 0x80, 0x77, 0xf8, 0x7d, 0xd8,
-	0xe0, 0x19, 0xea, 0x30, 0xf0, 0xc6, 0xbe, 0xce, 0x7d, 0x6a, 0x9d, 0x77, 0x5a, 0x67, 0xec, 0xc7,
-	0x8c, 0xdb, 0x63, 0xce, 0x75, 0x1b, 0xca, 0x2f, 0xbd, 0x33, 0xd1, 0xa1, 0x26, 0xd6, 0xc1, 0x4b,
-	0xef, 0x2c, 0x6a, 0x8a, 0xb3, 0x84, 0x8d, 0x74, 0x96, 0xf0, 0x35, 0xbc, 0x33, 0xbb, 0xdd, 0xf1,
-	0x6c, 0x41, 0xbd, 0x79, 0xb6, 0xb0, 0xed, 0xce, 0x8b, 0xc3, 0x5f, 0x40, 0xde, 0x72, 0x69, 0x7d,
-	0x73, 0x21, 0xe7, 0x88, 0xd7, 0x31, 0x66, 0x83, 0xd1, 0x0e, 0x94, 0xd8, 0x9f, 0xb5, 0xad, 0x3a,
-	0x12, 0xa1, 0xe7, 0xa5, 0x77, 0xd6, 0xb5, 0xd0, 0xf7, 0xa0, 0xc2, 0xfe, 0x3f, 0xf5, 0x0d, 0x93,
-	0xd4, 0xb7, 0x78, 0xcb, 0x84, 0xc1, 0x0c, 0xe5, 0x7a, 0x16, 0x11, 0x2a, 0xda, 0x16, 0x86, 0x62,
-	0x0c, 0xae, 0xa3, 0x5b, 0xb0, 0xca, 0x1b, 0x6d, 0xab, 0xbe, 0x23, 0x0e, 0x02, 0x8c, 0xec, 0x5a,
-	0x8d, 0x8f, 0xa1, 0x1c, 0x39, 0xfa, 0x22, 0x21, 0xb0, 0xf1, 0x00, 0x6a, 0xe9, 0x65, 0xb2, 0x50,
-	0x00, 0xfd, 0x97, 0x1c, 0x54, 0xe2, 0x05, 0x81, 0x5c, 0xd8, 0xe2, 0x06, 0x63, 0xd9, 0xa0, 0x3e,
-	0x59, 0x5f, 0x22, 0x07, 0xfd, 0x2c, 0xa3, 0x0a, 0x5b, 0x11, 0x82, 0x3c, 0x0c, 0xcb, 0xc5, 0x86,
-	0x62, 0xe4, 0xc9, 0x7c, 0x5f, 0xc1, 0xc6, 0xc8, 0x76, 0xc7, 0x57, 0x89, 0xb9, 0x44, 0xf2, 0xf8,
-	0x87, 0x19, 0xe7, 0x3a, 0x64, 0xa3, 0x27, 0x73, 0xd4, 0x46, 0x29, 0x1a, 0x1d, 0x40, 0xd1, 0xf7,
-	0x82, 0x30, 0xda, 0x0f, 0xb3, 0xee, 0x54, 0x27, 0x5e, 0x10, 0x1e, 0x19, 0xbe, 0xcf, 0xce, 0x47,
-	0x02, 0x40, 0xfb, 0x36, 0x07, 0xef, 0xcc, 0xff, 0x63, 0xa8, 0x07, 0x79, 0xd3, 0x1f, 0x4b, 0x25,
-	0x3d, 0x58, 0x54, 0x49, 0x6d, 0x7f, 0x3c, 0x91, 0x9f, 0x01, 0xa1, 0xa7, 0x50, 0x72, 0x88, 0xe3,
-	0x05, 0xd7, 0x52, 0x17, 0x0f, 0x17, 0x85, 0x3c, 0xe2, 0xa3, 0x27, 0xa8, 0x12, 0x0e, 0x61, 0x28,
-	0xcb, 0x85, 0x42, 0x65, 0x48, 0x5e, 0xf0, 0x06, 0x2b, 0x82, 0xc4, 0x31, 0x8e, 0xf6, 0x31, 0xec,
-	0xcc, 0xfd, 0x2b, 0xe8, 0x77, 0x00, 0x4c, 0x7f, 0xac, 0xf3, 0x17, 0x06, 0xe1, 0x41, 0x79, 0x5c,
-	0x31, 0xfd, 0x71, 0x9f, 0x33, 0xb4, 0xe7, 0x50, 0x7f, 0x9d, 0xbc, 0x6c, 0xfd, 0x08, 0x89, 0x75,
-	0xe7, 0x8c, 0xeb, 0x20, 0x8f, 0xcb, 0x82, 0x71, 0x74, 0x86, 0x34, 0x58, 0x8f, 0x1a, 0x8d, 0x2b,
-	0xd6, 0x21, 0xcf, 0x3b, 0x54, 0x65, 0x07, 0xe3, 0xea, 0xe8, 0x4c, 0xfb, 0x65, 0x0e, 0x36, 0xa6,
-	0x44, 0x66, 0xa7, 0x44, 0x11, 0x5c, 0xa3, 0xf3, 0xb7, 0xa0, 0x58, 0xa4, 0x35, 0x6d, 0x2b, 0xba,
-	0xb9, 0xe5, 0xdf, 0x7c, 0x8f, 0xf5, 0xe5, 0xad, 0x6a, 0xce, 0xf6, 0xd9, 0xf2, 0x71, 0xce, 0xec,
-	0x90, 0xf2, 0x84, 0xa7, 0x88, 0x05, 0x81, 0x9e, 0x41, 0x2d, 0x20, 0x7c, 0x6f, 0xb7, 0x74, 0xe1,
-	0x65, 0xc5, 0x85, 0xbc, 0x4c, 0x4a, 0xc8, 0x9c, 0x0d, 0xaf, 0x47, 0x48, 0x8c, 0xa2, 0xe8, 0x29,
-	0xac, 0x5b, 0xd7, 0xae, 0xe1, 0xd8, 0xa6, 0x44, 0x2e, 0x2d, 0x8d, 0xbc, 0x26, 0x81, 0x38, 0xb0,
-	0x76, 0x1f, 0xaa, 0x89, 0x46, 0xf6, 0xc7, 0x78, 0x66, 0x27, 0x75, 0x22, 0x88, 0x74, 0xb4, 0x28,
-	0xca, 0x68, 0xa1, 0x9d, 0x41, 0x35, 0xb1, 0x2e, 0x16, 0x19, 0xca, 0xf4, 0x19, 0x7a, 0x5c, 0x9f,
-	0x45, 0x9c, 0x0b, 0x3d, 0x16, 0x03, 0x59, 0x56, 0xa5, 0xdb, 0x3e, 0xd7, 0x68, 0x05, 0x97, 0x18,
-	0xd9, 0xf5, 0xb5, 0x5f, 0xe7, 0xa0, 0x96, 0x5e, 0xd2, 0x91, 0x1f, 0xf9, 0x24, 0xb0, 0x3d, 0x2b,
-	0xe1, 0x47, 0x27, 0x9c, 0xc1, 0x7c, 0x85, 0x35, 0x7f, 0x3d, 0xf6, 0x42, 0x23, 0xf2, 0x15, 0xd3,
-	0x1f, 0xff, 0x11, 0xa3, 0xa7, 0x7c, 0x30, 0x3f, 0xe5, 0x83, 0xe8, 0x03, 0x40, 0xd2, 0x95, 0x46,
-	0xb6, 0x63, 0x87, 0xfa, 0xd9, 0x75, 0x48, 0x84, 0x8d, 0xf3, 0x58, 0x15, 0x2d, 0x87, 0xac, 0xe1,
-	0x0b, 0xc6, 0x67, 0x8e, 0xe7, 0x79, 0x8e, 0x4e, 0x4d, 0x2f, 0x20, 0xba, 0x61, 0xbd, 0xe4, 0x07,
-	0xa4, 0x3c, 0xae, 0x7a, 0x9e, 0xd3, 0x67, 0xbc, 0x96, 0xf5, 0x92, 0x6d, 0xb2, 0xa6, 0x3f, 0xa6,
-	0x24, 0xd4, 0xd9, 0x0f, 0xcf, 0x4b, 0x2a, 0x18, 0x04, 0xab, 0xed, 0x8f, 0x29, 0xfa, 0x3d, 0x58,
-	0x8f, 0x3a, 0xf0, 0x7d, 0x56, 0x6e, 0xf0, 0x6b, 0xb2, 0x0b, 0xe7, 0x21, 0x0d, 0xd6, 0x4e, 0x48,
-	0x60, 0x12, 0x37, 0x1c, 0xd8, 0xe6, 0x05, 0x4b, 0x25, 0x94, 0x5d, 0x05, 0xa7, 0x78, 0x5f, 0x16,
-	0xca, 0xab, 0x6a, 0x19, 0x47, 0xb3, 0x39, 0xc4, 0xa1, 0xda, 0x37, 0x0a, 0x14, 0x79, 0x3a, 0xc2,
-	0x94, 0xc2, 0xb7, 0x72, 0xbe, 0xd3, 0xcb, 0x34, 0x96, 0x31, 0xf8, 0x3e, 0xff, 0x2e, 0x54, 0xb8,
-	0xf2, 0x13, 0xa7, 0x07, 0x9e, 0xe3, 0xf2, 0xc6, 0x06, 0x94, 0x03, 0x62, 0x58, 0x9e, 0x3b, 0x8a,
-	0x2e, 0x9e, 0x62, 0x1a, 0xfd, 0x01, 0xa8, 0x7e, 0xe0, 0xf9, 0xc6, 0x70, 0x72, 0x56, 0x95, 0xe6,
-	0xdb, 0x48, 0xf0, 0x59, 0xfa, 0xad, 0x7d, 0x0d, 0x25, 0xb1, 0x27, 0xdd, 0x40, 0x94, 0x0f, 0x01,
-	0x09, 0x1d, 0x31, 0xdb, 0x3b, 0x36, 0xa5, 0x32, 0x39, 0xe6, 0x0f, 0xa3, 0xa2, 0xe5, 0x64, 0xd2,
-	0xa0, 0xfd, 0xb7, 0x22, 0xd2, 0x64, 0xf1, 0x64, 0xc5, 0xf2, 0x69, 0xb6, 0x20, 0xd8, 0x21, 0x5e,
-	0xdc, 0x8d, 0x45, 0x24, 0xea, 0x42, 0x49, 0x66, 0xc3, 0xb9, 0x65, 0x5f, 0xfc, 0x24, 0x40, 0x74,
-	0x53, 0x4e, 0xe4, 0x3d, 0xc1, 0xa2, 0x37, 0xe5, 0x44, 0xdc, 0x94, 0x13, 0x76, 0xc8, 0x95, 0x79,
-	0xba, 0x80, 0x2b, 0xf0, 0x34, 0xbd, 0x6a, 0xc5, 0xcf, 0x11, 0x44, 0xfb, 0x5f, 0x25, 0x0e, 0x69,
-	0xd1, 0xb3, 0x01, 0xfa, 0x0a, 0xca, 0x2c, 0x3a, 0xe8, 0x8e, 0xe1, 0xcb, 0x47, 0xf0, 0xf6, 0x72,
-	0x2f, 0x12, 0xd1, 0x86, 0x27, 0xb2, 0xec, 0x55, 0x5f, 0x50, 0x2c, 0x34, 0xb2, 0x13, 0x4e, 0x14,
-	0x1a, 0xd9, 0x37, 0x7a, 0x1f, 0x6a, 0xc6, 0x38, 0xf4, 0x74, 0xc3, 0xba, 0x24, 0x41, 0x68, 0x53,
-	0x22, 0xdd, 0x64, 0x9d, 0x71, 0x5b, 0x11, 0xb3, 0xf1, 0x29, 0xac, 0x25, 0x31, 0xdf, 0x94, 0x92,
-	0x14, 0x93, 0x29, 0xc9, 0x9f, 0x01, 0x4c, 0xae, 0xe0, 0x98, 0x8f, 0x90, 0x2b, 0x3b, 0xd4, 0xcd,
-	0xe8, 0x48, 0x5d, 0xc4, 0x65, 0xc6, 0x68, 0xb3, 0x63, 0x5e, 0xfa, 0x7d, 0xa0, 0x18, 0xbd, 0x0f,
-	0xb0, 0x85, 0xcf, 0xd6, 0xea, 0x85, 0x3d, 0x1a, 0xc5, 0xd7, 0x82, 0x15, 0xcf, 0x73, 0x9e, 0x70,
-	0x86, 0xf6, 0x9b, 0x9c, 0xf0, 0x15, 0xf1, 0xd2, 0x93, 0xe9, 0x48, 0xf5, 0xb6, 0x4c, 0x7d, 0x1f,
-	0x80, 0x86, 0x46, 0xc0, 0xf2, 0x2b, 0x23, 0xba, 0x98, 0x6c, 0xcc, 0x3c, 0x30, 0x0c, 0xa2, 0xd2,
-	0x13, 0x5c, 0x91, 0xbd, 0x5b, 0x21, 0xfa, 0x0c, 0xd6, 0x4c, 0xcf, 0xf1, 0x47, 0x44, 0x0e, 0x2e,
-	0xbe, 0x71, 0x70, 0x35, 0xee, 0xdf, 0x0a, 0x13, 0xd7, 0xa1, 0xa5, 0x9b, 0x5e, 0x87, 0xfe, 0x5a,
-	0x11, 0x0f, 0x56, 0xc9, 0xf7, 0x32, 0x34, 0x9c, 0x53, 0x94, 0xf1, 0x78, 0xc9, 0xc7, 0xb7, 0xef,
-	0xaa, 0xc8, 0x68, 0x7c, 0x96, 0xa5, 0x04, 0xe2, 0xf5, 0x19, 0xef, 0xbf, 0xe7, 0xa1, 0x12, 0xbf,
-	0x55, 0xcd, 0xd8, 0xfe, 0x13, 0xa8, 0xc4, 0x75, 0x3f, 0x32, 0x40, 0x7c, 0xa7, 0x79, 0xe2, 0xce,
-	0xe8, 0x05, 0x20, 0x63, 0x38, 0x8c, 0x33, 0x59, 0x7d, 0x4c, 0x8d, 0x61, 0xf4, 0x52, 0xf8, 0xc9,
-	0x02, 0x7a, 0x88, 0xb6, 0xbe, 0x53, 0x36, 0x1e, 0xab, 0xc6, 0x70, 0x98, 0xe2, 0xa0, 0x3f, 0x87,
-	0x9d, 0xf4, 0x1c, 0xfa, 0xd9, 0xb5, 0xee, 0xdb, 0x96, 0x3c, 0xba, 0x1f, 0x2c, 0xfa, 0x5c, 0xd7,
-	0x4c, 0xc1, 0x7f, 0x71, 0x7d, 0x62, 0x5b, 0x42, 0xe7, 0x28, 0x98, 0x69, 0x68, 0xfc, 0x25, 0xdc,
-	0x7a, 0x4d, 0xf7, 0x39, 0x36, 0xe8, 0xa5, 0xcb, 0x50, 0x96, 0x57, 0x42, 0xc2, 0x7a, 0xbf, 0x52,
-	0xc4, 0xab, 0x62, 0x5a, 0x27, 0xad, 0x64, 0x0a, 0x7e, 0x37, 0xe3, 0x3c, 0xed, 0x93, 0x53, 0x01,
-	0xcf, 0xb3, 0xee, 0x2f, 0xa7, 0xb2, 0xee, 0xac, 0xb9, 0x96, 0x48, 0x5e, 0x05, 0x90, 0x44, 0xd0,
-	0xfe, 0x35, 0x0f, 0xe5, 0x08, 0x9d, 0x1f, 0xbc, 0xaf, 0x69, 0x48, 0x1c, 0x3d, 0xbe, 0x15, 0x54,
-	0x30, 0x08, 0x16, 0xbf, 0xab, 0x7a, 0x17, 0x2a, 0xec, 0x7c, 0x2f, 0x9a, 0x73, 0xbc, 0xb9, 0xcc,
-	0x18, 0xbc, 0xf1, 0x3d, 0xa8, 0x86, 0x5e, 0x68, 0x8c, 0xf4, 0x90, 0xa7, 0x02, 0x79, 0x31, 0x9a,
-	0xb3, 0x78, 0x22, 0x80, 0x7e, 0x08, 0x9b, 0xe1, 0x79, 0xe0, 0x85, 0xe1, 0x88, 0xa5, 0xa1, 0x3c,
-	0x29, 0x12, 0x39, 0x4c, 0x01, 0xab, 0x71, 0x83, 0x48, 0x96, 0x28, 0x8b, 0xde, 0x93, 0xce, 0xcc,
-	0x75, 0x79, 0x10, 0x29, 0xe0, 0xf5, 0x98, 0xcb, 0x5c, 0x9b, 0x6d, 0x9e, 0xbe, 0x48, 0x36, 0x78,
-	0xac, 0x50, 0x70, 0x44, 0x22, 0x1d, 0x36, 0x1c, 0x62, 0xd0, 0x71, 0x40, 0x2c, 0xfd, 0x85, 0x4d,
-	0x46, 0x96, 0xb8, 0x2f, 0xa9, 0x65, 0x3e, 0x49, 0x44, 0x6a, 0x69, 0x3e, 0xe2, 0xa3, 0x71, 0x2d,
-	0x82, 0x13, 0x34, 0xcb, 0x1c, 0xc4, 0x17, 0xda, 0x80, 0x6a, 0xff, 0x59, 0x7f, 0xd0, 0x39, 0xd2,
-	0x8f, 0x8e, 0xf7, 0x3b, 0xb2, 0xd2, 0xa8, 0xdf, 0xc1, 0x82, 0x54, 0x58, 0xfb, 0xe0, 0x78, 0xd0,
-	0x3a, 0xd4, 0x07, 0xdd, 0xf6, 0x93, 0xbe, 0x9a, 0x43, 0x3b, 0xb0, 0x39, 0x38, 0xc0, 0xc7, 0x83,
-	0xc1, 0x61, 0x67, 0x5f, 0x3f, 0xe9, 0xe0, 0xee, 0xf1, 0x7e, 0x5f, 0xcd, 0x23, 0x04, 0xb5, 0x09,
-	0x7b, 0xd0, 0x3d, 0xea, 0xa8, 0x05, 0x54, 0x85, 0xd5, 0x93, 0x0e, 0x6e, 0x77, 0x7a, 0x03, 0xb5,
-	0xa8, 0xfd, 0x32, 0x0f, 0xd5, 0x84, 0x15, 0x99, 0x23, 0x07, 0x54, 0x1c, 0x59, 0x0a, 0x98, 0x7d,
-	0xf2, 0x97, 0x51, 0xc3, 0x3c, 0x17, 0xd6, 0x29, 0x60, 0x41, 0xf0, 0x63, 0x8a, 0x71, 0x95, 0x58,
-	0xe7, 0x05, 0x5c, 0x76, 0x8c, 0x2b, 0x01, 0xf2, 0x7d, 0x58, 0xbb, 0x20, 0x81, 0x4b, 0x46, 0xb2,
-	0x5d, 0x58, 0xa4, 0x2a, 0x78, 0xa2, 0xcb, 0x2e, 0xa8, 0xb2, 0xcb, 0x04, 0x46, 0x98, 0xa3, 0x26,
-	0xf8, 0x47, 0x11, 0xd8, 0x36, 0x14, 0x45, 0xf3, 0xaa, 0x98, 0x9f, 0x13, 0x6c, 0x9b, 0xa2, 0xaf,
-	0x0c, 0x9f, 0xa7, 0x87, 0x05, 0xcc, 0xbf, 0xd1, 0xd9, 0xac, 0x7d, 0x4a, 0xdc, 0x3e, 0xf7, 0x17,
-	0x77, 0xe7, 0xd7, 0x99, 0xe8, 0x3c, 0x36, 0xd1, 0x2a, 0xe4, 0x71, 0x54, 0x9e, 0xd3, 0x6e, 0xb5,
-	0x0f, 0x98, 0x59, 0xd6, 0xa1, 0x72, 0xd4, 0xfa, 0x99, 0x7e, 0xda, 0xe7, 0x97, 0xed, 0x48, 0x85,
-	0xb5, 0x27, 0x1d, 0xdc, 0xeb, 0x1c, 0x4a, 0x4e, 0x1e, 0x6d, 0x83, 0x2a, 0x39, 0x93, 0x7e, 0x05,
-	0x86, 0x20, 0x3e, 0x8b, 0xa8, 0x0c, 0x85, 0xfe, 0xd3, 0xd6, 0x89, 0x5a, 0xd2, 0xfe, 0x27, 0x07,
-	0x1b, 0x62, 0x5b, 0x88, 0x0b, 0x09, 0x5e, 0xff, 0x90, 0x9a, 0xbc, 0x7c, 0xca, 0xa5, 0x2f, 0x9f,
-	0xa2, 0x24, 0x94, 0xef, 0xea, 0xf9, 0x49, 0x12, 0xca, 0x2f, 0x64, 0x52, 0x11, 0xbf, 0xb0, 0x48,
-	0xc4, 0xaf, 0xc3, 0xaa, 0x43, 0x68, 0x6c, 0xb7, 0x0a, 0x8e, 0x48, 0x64, 0x43, 0xd5, 0x70, 0x5d,
-	0x2f, 0x34, 0xc4, 0x8d, 0x6e, 0x69, 0xa1, 0xcd, 0x70, 0xea, 0x1f, 0x37, 0x5b, 0x13, 0x24, 0x11,
-	0x98, 0x93, 0xd8, 0x8d, 0x9f, 0x82, 0x3a, 0xdd, 0x61, 0x91, 0xed, 0xf0, 0x07, 0x3f, 0x9a, 0xec,
-	0x86, 0x84, 0xad, 0x0b, 0xf9, 0x14, 0xa2, 0xae, 0x30, 0x02, 0x9f, 0xf6, 0x7a, 0xdd, 0xde, 0x63,
-	0x55, 0x41, 0x00, 0xa5, 0xce, 0xcf, 0xba, 0x83, 0xce, 0xbe, 0x9a, 0xdb, 0xfb, 0xd5, 0x26, 0x94,
-	0x84, 0x90, 0xe8, 0x5b, 0x99, 0x09, 0x24, 0x8b, 0x54, 0xd1, 0x4f, 0x17, 0xce, 0xa8, 0x53, 0x85,
-	0xaf, 0x8d, 0x87, 0x4b, 0x8f, 0x97, 0x8f, 0x82, 0x2b, 0xe8, 0x6f, 0x15, 0x58, 0x4b, 0x3d, 0x08,
-	0x66, 0xbd, 0xd1, 0x9e, 0x53, 0x13, 0xdb, 0xf8, 0xc9, 0x52, 0x63, 0x63, 0x59, 0xbe, 0x51, 0xa0,
-	0x9a, 0xa8, 0x06, 0x45, 0xf7, 0x97, 0xa9, 0x20, 0x15, 0x92, 0x7c, 0xba, 0x7c, 0xf1, 0xa9, 0xb6,
-	0xf2, 0x91, 0x82, 0xfe, 0x46, 0x81, 0x6a, 0xa2, 0x2e, 0x32, 0xb3, 0x28, 0xb3, 0x55, 0x9c, 0x99,
-	0x45, 0x99, 0x57, 0x86, 0xb9, 0x82, 0xfe, 0x4a, 0x81, 0x4a, 0x5c, 0xe3, 0x88, 0xee, 0x2d, 0x5e,
-	0x15, 0x29, 0x84, 0xf8, 0x64, 0xd9, 0x72, 0x4a, 0x6d, 0x05, 0xfd, 0x05, 0x94, 0xa3, 0x82, 0x40,
-	0x94, 0x75, 0xf7, 0x9a, 0xaa, 0x36, 0x6c, 0xdc, 0x5b, 0x78, 0x5c, 0x72, 0xfa, 0xa8, 0x4a, 0x2f,
-	0xf3, 0xf4, 0x53, 0xf5, 0x84, 0x8d, 0x7b, 0x0b, 0x8f, 0x8b, 0xa7, 0x67, 0x9e, 0x90, 0x28, 0xe6,
-	0xcb, 0xec, 0x09, 0xb3, 0x55, 0x84, 0x99, 0x3d, 0x61, 0x5e, 0xed, 0xa0, 0x10, 0x24, 0x51, 0x0e,
-	0x98, 0x59, 0x90, 0xd9, 0x92, 0xc3, 0xcc, 0x82, 0xcc, 0xa9, 0x3e, 0xd4, 0x56, 0xd0, 0x2f, 0x94,
-	0xe4, 0xb9, 0xe0, 0xde, 0xc2, 0x55, 0x6f, 0x0b, 0xba, 0xe4, 0x4c, 0xdd, 0x1d, 0x5f, 0xa0, 0xbf,
-	0x90, 0xb7, 0x18, 0xa2, 0x68, 0x0e, 0x2d, 0x02, 0x96, 0xaa, 0xb3, 0x6b, 0x7c, 0xbc, 0xdc, 0x66,
-	0xc3, 0x85, 0xf8, 0x6b, 0x05, 0x60, 0x52, 0x5e, 0x97, 0x59, 0x88, 0x99, 0xba, 0xbe, 0xc6, 0xfd,
-	0x25, 0x46, 0x26, 0x17, 0x48, 0x54, 0xfe, 0x93, 0x79, 0x81, 0x4c, 0x95, 0xff, 0x65, 0x5e, 0x20,
-	0xd3, 0xa5, 0x7b, 0xda, 0x0a, 0xfa, 0x67, 0x05, 0x36, 0x67, 0xca, 0x8f, 0xd0, 0xc3, 0x1b, 0x56,
-	0xa0, 0x35, 0x3e, 0x5f, 0x1e, 0x20, 0x12, 0x6d, 0x57, 0xf9, 0x48, 0x41, 0x7f, 0xa7, 0xc0, 0x7a,
-	0xba, 0x2c, 0x23, 0xf3, 0x2e, 0x35, 0xa7, 0x90, 0xa9, 0xf1, 0x60, 0xb9, 0xc1, 0xb1, 0xb6, 0xfe,
-	0x41, 0x81, 0x5a, 0xba, 0x42, 0x07, 0x3d, 0x58, 0x2c, 0x2c, 0x4c, 0x09, 0xf4, 0xd9, 0x92, 0xa3,
-	0x23, 0x89, 0xbe, 0x58, 0xfd, 0xe3, 0xa2, 0xc8, 0xde, 0x4a, 0xfc, 0xe7, 0xc7, 0xbf, 0x0d, 0x00,
-	0x00, 0xff, 0xff, 0x65, 0x60, 0xd8, 0xbc, 0x4b, 0x34, 0x00, 0x00,
+	// 4187 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x5a, 0x4f, 0x73, 0xdb, 0x48,
+	0x76, 0x37, 0xf8, 0x4f, 0xc4, 0xa3, 0x44, 0x41, 0x2d, 0xc9, 0xa6, 0x39, 0xbb, 0x19, 0x2f, 0x52,
+	0x93, 0x72, 0x76, 0x66, 0xe8, 0x59, 0x6d, 0xed, 0xf8, 0xcf, 0x7a, 0xc6, 0x43, 0x53, 0xb4, 0xc5,
+	0xb1, 0x44, 0x2a, 0x4d, 0xaa, 0xbc, 0x8e, 0x93, 0x41, 0x20, 0xa2, 0x4d, 0xc1, 0x26, 0x09, 0x0c,
+	0x00, 0xca, 0xd2, 0xa6, 0x52, 0x49, 0x6d, 0x2a, 0xa9, 0x49, 0x55, 0x52, 0xc9, 0x65, 0xb2, 0x97,
+	0x54, 0x6e, 0x39, 0x24, 0xb9, 0xe6, 0x90, 0xda, 0xd4, 0x9e, 0x72, 0xc8, 0x25, 0x1f, 0x21, 0x97,
+	0xe4, 0x94, 0x6b, 0xbe, 0x41, 0xea, 0x75, 0x37, 0x40, 0x40, 0xa4, 0xc7, 0x20, 0xe5, 0x13, 0xf1,
+	0x5e, 0x77, 0xff, 0xfa, 0xf1, 0xf5, 0xeb, 0xd7, 0xaf, 0x5f, 0x3f, 0xd0, 0xdd, 0xe1, 0x64, 0x60,
+	0x8f, 0xfd, 0x5b, 0x96, 0x67, 0x9f, 0x32, 0xcf, 0xbf, 0xe5, 0x7a, 0x4e, 0xe0, 0x48, 0xaa, 0xc6,
+	0x09, 0xf2, 0xc1, 0x89, 0xe9, 0x9f, 0xd8, 0x7d, 0xc7, 0x73, 0x6b, 0x63, 0x67, 0x64, 0x5a, 0x35,
+	0x39, 0xa6, 0x26, 0xc7, 0x88, 0x6e, 0xd5, 0xdf, 0x18, 0x38, 0xce, 0x60, 0xc8, 0x04, 0xc2, 0xf1,
+	0xe4, 0xc5, 0x2d, 0x6b, 0xe2, 0x99, 0x81, 0xed, 0x8c, 0x65, 0xfb, 0xfb, 0x17, 0xdb, 0x03, 0x7b,
+	0xc4, 0xfc, 0xc0, 0x1c, 0xb9, 0xb2, 0xc3, 0x07, 0xa1, 0x2c, 0xfe, 0x89, 0xe9, 0x31, 0xeb, 0xd6,
+	0x49, 0x7f, 0xe8, 0xbb, 0xac, 0x8f, 0xbf, 0x06, 0x7e, 0xc8, 0x6e, 0x1f, 0x5d, 0xe8, 0xe6, 0x07,
+	0xde, 0xa4, 0x1f, 0x84, 0x92, 0x9b, 0x41, 0xe0, 0xd9, 0xc7, 0x93, 0x80, 0x89, 0xde, 0xfa, 0x75,
+	0xb8, 0xd6, 0x33, 0xfd, 0x57, 0x0d, 0x67, 0xfc, 0xc2, 0x1e, 0x74, 0xfb, 0x27, 0x6c, 0x64, 0x52,
+	0xf6, 0xf5, 0x84, 0xf9, 0x81, 0xfe, 0x7b, 0x50, 0x99, 0x6d, 0xf2, 0x5d, 0x67, 0xec, 0x33, 0xf2,
+	0x05, 0xe4, 0x70, 0xca, 0x8a, 0x72, 0x43, 0xb9, 0x59, 0xda, 0xf9, 0xa8, 0xf6, 0x26, 0x15, 0x08,
+	0x19, 0x6a, 0x52, 0xd4, 0x5a, 0xd7, 0x65, 0x7d, 0xca, 0x47, 0xea, 0xdb, 0xb0, 0xd9, 0x30, 0x5d,
+	0xf3, 0xd8, 0x1e, 0xda, 0x81, 0xcd, 0xfc, 0x70, 0xd2, 0x09, 0x6c, 0x25, 0xd9, 0x72, 0xc2, 0xdf,
+	0x87, 0xd5, 0x7e, 0x8c, 0x2f, 0x27, 0xbe, 0x5b, 0x4b, 0xa5, 0xfb, 0xda, 0x2e, 0xa7, 0x12, 0xc0,
+	0x09, 0x38, 0x7d, 0x0b, 0xc8, 0x23, 0x7b, 0x3c, 0x60, 0x9e, 0xeb, 0xd9, 0xe3, 0x20, 0x14, 0xe6,
+	0xd7, 0x59, 0xd8, 0x4c, 0xb0, 0xa5, 0x30, 0x2f, 0x01, 0x22, 0x3d, 0xa2, 0x28, 0xd9, 0x9b, 0xa5,
+	0x9d, 0x2f, 0x53, 0x8a, 0x32, 0x07, 0xaf, 0x56, 0x8f, 0xc0, 0x9a, 0xe3, 0xc0, 0x3b, 0xa7, 0x31,
+	0x74, 0xf2, 0x15, 0x14, 0x4e, 0x98, 0x39, 0x0c, 0x4e, 0x2a, 0x99, 0x1b, 0xca, 0xcd, 0xf2, 0xce,
+	0xa3, 0x4b, 0xcc, 0xb3, 0xc7, 0x81, 0xba, 0x81, 0x19, 0x30, 0x2a, 0x51, 0xc9, 0xc7, 0x40, 0xc4,
+	0x97, 0x61, 0x31, 0xbf, 0xef, 0xd9, 0x2e, 0x9a, 0x64, 0x25, 0x7b, 0x43, 0xb9, 0xa9, 0xd2, 0x0d,
+	0xd1, 0xb2, 0x3b, 0x6d, 0xa8, 0xba, 0xb0, 0x7e, 0x41, 0x5a, 0xa2, 0x41, 0xf6, 0x15, 0x3b, 0xe7,
+	0x2b, 0xa2, 0x52, 0xfc, 0x24, 0x8f, 0x21, 0x7f, 0x6a, 0x0e, 0x27, 0x8c, 0x8b, 0x5c, 0xda, 0xf9,
+	0xd1, 0xdb, 0xcc, 0x43, 0x9a, 0xe8, 0x54, 0x0f, 0x54, 0x8c, 0xbf, 0x97, 0xb9, 0xa3, 0xe8, 0x77,
+	0xa1, 0x14, 0x93, 0x9b, 0x94, 0x01, 0x8e, 0xda, 0xbb, 0xcd, 0x5e, 0xb3, 0xd1, 0x6b, 0xee, 0x6a,
+	0x57, 0xc8, 0x1a, 0xa8, 0x47, 0xed, 0xbd, 0x66, 0x7d, 0xbf, 0xb7, 0xf7, 0x4c, 0x53, 0x48, 0x09,
+	0x56, 0x42, 0x22, 0xa3, 0x9f, 0x01, 0xa1, 0xac, 0xef, 0x9c, 0x32, 0x0f, 0x0d, 0x59, 0xae, 0x2a,
+	0xb9, 0x06, 0x2b, 0x81, 0xe9, 0xbf, 0x32, 0x6c, 0x4b, 0xca, 0x5c, 0x40, 0xb2, 0x65, 0x91, 0x16,
+	0x14, 0x4e, 0xcc, 0xb1, 0x35, 0x7c, 0xbb, 0xdc, 0x49, 0x55, 0x23, 0xf8, 0x1e, 0x1f, 0x48, 0x25,
+	0x00, 0x5a, 0x77, 0x62, 0x66, 0xb1, 0x00, 0xfa, 0x33, 0xd0, 0xba, 0x81, 0xe9, 0x05, 0x71, 0x71,
+	0x9a, 0x90, 0xc3, 0xf9, 0x2b, 0xca, 0xc2, 0x73, 0x8a, 0x9d, 0x49, 0xf9, 0x70, 0xfd, 0xff, 0x32,
+	0xb0, 0x11, 0xc3, 0x96, 0x96, 0xfa, 0x14, 0x0a, 0x1e, 0xf3, 0x27, 0xc3, 0x80, 0xc3, 0x97, 0x77,
+	0x1e, 0xa4, 0x84, 0x9f, 0x41, 0xaa, 0x51, 0x0e, 0x43, 0x25, 0x1c, 0xb9, 0x09, 0x9a, 0x18, 0x61,
+	0x30, 0xcf, 0x73, 0x3c, 0x63, 0xe4, 0x0f, 0xb8, 0xd6, 0x54, 0x5a, 0x16, 0xfc, 0x26, 0xb2, 0x0f,
+	0xfc, 0x41, 0x4c, 0xab, 0xd9, 0x4b, 0x6a, 0x95, 0x98, 0xa0, 0x8d, 0x59, 0xf0, 0xda, 0xf1, 0x5e,
+	0x19, 0xa8, 0x5a, 0xcf, 0xb6, 0x58, 0x25, 0xc7, 0x41, 0x3f, 0x4d, 0x09, 0xda, 0x16, 0xc3, 0x3b,
+	0x72, 0x34, 0x5d, 0x1f, 0x27, 0x19, 0xfa, 0x87, 0x50, 0x10, 0xff, 0x14, 0x2d, 0xa9, 0x7b, 0xd4,
+	0x68, 0x34, 0xbb, 0x5d, 0xed, 0x0a, 0x51, 0x21, 0x4f, 0x9b, 0x3d, 0x8a, 0x16, 0xa6, 0x42, 0xfe,
+	0x51, 0xbd, 0x57, 0xdf, 0xd7, 0x32, 0xfa, 0x0f, 0x61, 0xfd, 0xa9, 0x69, 0x07, 0x69, 0x8c, 0x4b,
+	0x77, 0x40, 0x9b, 0xf6, 0x95, 0xab, 0xd3, 0x4a, 0xac, 0x4e, 0x7a, 0xd5, 0x34, 0xcf, 0xec, 0xe0,
+	0xc2, 0x7a, 0x68, 0x90, 0x65, 0x9e, 0x27, 0x97, 0x00, 0x3f, 0xf5, 0xd7, 0xb0, 0xde, 0x0d, 0x1c,
+	0x37, 0x95, 0xe5, 0xff, 0x18, 0x56, 0xf0, 0xb4, 0x71, 0x26, 0x81, 0x34, 0xfd, 0xeb, 0x35, 0x71,
+	0x1a, 0xd5, 0xc2, 0xd3, 0xa8, 0xb6, 0x2b, 0x4f, 0x2b, 0x1a, 0xf6, 0x24, 0x57, 0xa1, 0xe0, 0xdb,
+	0x83, 0xb1, 0x39, 0x94, 0xde, 0x42, 0x52, 0x3a, 0x01, 0x6d, 0x3a, 0xb1, 0x34, 0xfc, 0x06, 0x90,
+	0x5d, 0xe6, 0x07, 0x9e, 0x73, 0x9e, 0x4a, 0x9e, 0x2d, 0xc8, 0xbf, 0x70, 0xbc, 0xbe, 0xd8, 0x88,
+	0x45, 0x2a, 0x08, 0xdc, 0x54, 0x09, 0x10, 0x89, 0xfd, 0x31, 0x90, 0xd6, 0x18, 0xcf, 0x94, 0x74,
+	0x0b, 0xf1, 0x37, 0x19, 0xd8, 0x4c, 0xf4, 0x97, 0x8b, 0xb1, 0xfc, 0x3e, 0x44, 0xc7, 0x34, 0xf1,
+	0xc5, 0x3e, 0x24, 0x1d, 0x28, 0x88, 0x1e, 0x52, 0x93, 0xb7, 0x17, 0x00, 0x12, 0xc7, 0x94, 0x84,
+	0x93, 0x30, 0x73, 0x8d, 0x3e, 0xfb, 0x6e, 0x8d, 0xfe, 0x35, 0x68, 0xe1, 0xff, 0xf0, 0xdf, 0xba,
+	0x36, 0x5f, 0xc2, 0x66, 0xdf, 0x19, 0x0e, 0x59, 0x1f, 0xad, 0xc1, 0xb0, 0xc7, 0x01, 0xf3, 0x4e,
+	0xcd, 0xe1, 0xdb, 0xed, 0x86, 0x4c, 0x47, 0xb5, 0xe4, 0x20, 0xfd, 0x39, 0x6c, 0xc4, 0x26, 0x96,
+	0x0b, 0xf1, 0x08, 0xf2, 0x3e, 0x32, 0xe4, 0x4a, 0x7c, 0xb2, 0xe0, 0x4a, 0xf8, 0x54, 0x0c, 0xd7,
+	0x37, 0x05, 0x78, 0xf3, 0x94, 0x8d, 0xa3, 0xbf, 0xa5, 0xef, 0xc2, 0x46, 0x97, 0x9b, 0x69, 0x2a,
+	0x3b, 0x9c, 0x9a, 0x78, 0x26, 0x61, 0xe2, 0x5b, 0x40, 0xe2, 0x28, 0xd2, 0x10, 0xff, 0x4c, 0x81,
+	0xea, 0x91, 0x6b, 0x99, 0x01, 0x93, 0x6c, 0x67, 0xe2, 0xf5, 0xd9, 0xdb, 0x35, 0xda, 0x06, 0xd5,
+	0x0b, 0x3b, 0x57, 0x32, 0x0b, 0xfd, 0xe9, 0xe9, 0x24, 0x53, 0x08, 0xfd, 0xfb, 0xf0, 0xde, 0x5c,
+	0x31, 0xa4, 0x98, 0x1f, 0x82, 0x76, 0x68, 0x4e, 0x7c, 0x96, 0x6a, 0xb7, 0x6c, 0xc2, 0x46, 0xac,
+	0xb3, 0x44, 0xf8, 0x08, 0x36, 0xd0, 0xfd, 0x8c, 0xd2, 0x41, 0x6c, 0x01, 0x89, 0xf7, 0x96, 0x18,
+	0xe7, 0xb0, 0xde, 0x3c, 0x63, 0xfd, 0x54, 0xcb, 0x50, 0x81, 0x95, 0xbe, 0x33, 0x1a, 0x99, 0x63,
+	0xab, 0x92, 0xb9, 0x91, 0xbd, 0xa9, 0xd2, 0x90, 0x8c, 0x3b, 0xae, 0x6c, 0x5a, 0xc7, 0xa5, 0xff,
+	0x95, 0x02, 0xda, 0x74, 0x6e, 0x69, 0x75, 0xb8, 0xd4, 0x81, 0x85, 0x40, 0x38, 0xf7, 0x2a, 0x95,
+	0x94, 0xe4, 0x87, 0xbe, 0x55, 0xf0, 0x99, 0xe7, 0xc5, 0x7c, 0x77, 0xf6, 0x92, 0xbe, 0x5b, 0xdf,
+	0x83, 0xef, 0x85, 0xe2, 0x74, 0x03, 0x8f, 0x99, 0x23, 0x7b, 0x3c, 0x68, 0x75, 0x3a, 0x2e, 0x13,
+	0x82, 0x13, 0x02, 0x39, 0xcb, 0x0c, 0x4c, 0x29, 0x18, 0xff, 0x46, 0x0f, 0xd9, 0x1f, 0x3a, 0x7e,
+	0xe4, 0x21, 0x39, 0xa1, 0xff, 0x47, 0x16, 0x2a, 0x33, 0x50, 0xa1, 0x7a, 0x9f, 0x43, 0xde, 0x67,
+	0xc1, 0xc4, 0x95, 0xfb, 0xaa, 0x99, 0x5a, 0xe0, 0xf9, 0x78, 0xb5, 0x2e, 0x82, 0x51, 0x81, 0x49,
+	0x06, 0x50, 0x0c, 0x82, 0x73, 0xc3, 0xb7, 0x7f, 0x1e, 0x46, 0x4f, 0xfb, 0x97, 0xc5, 0xef, 0x31,
+	0x6f, 0x64, 0x8f, 0xcd, 0x61, 0xd7, 0xfe, 0x39, 0xa3, 0x2b, 0x41, 0x70, 0x8e, 0x1f, 0xe4, 0x19,
+	0x7a, 0x07, 0xcb, 0x1e, 0x4b, 0xb5, 0x37, 0x96, 0x9d, 0x25, 0xa6, 0x60, 0x2a, 0x10, 0xab, 0xfb,
+	0x90, 0xe7, 0xff, 0x69, 0x19, 0x43, 0xd4, 0x20, 0x1b, 0x04, 0xe7, 0x5c, 0xa8, 0x22, 0xc5, 0xcf,
+	0xea, 0x7d, 0x58, 0x8d, 0xff, 0x03, 0x34, 0xa4, 0x13, 0x66, 0x0f, 0x4e, 0x84, 0x81, 0xe5, 0xa9,
+	0xa4, 0x70, 0x25, 0x5f, 0xdb, 0x96, 0x8c, 0xef, 0xf3, 0x54, 0x10, 0xfa, 0xbf, 0x66, 0xe0, 0xfa,
+	0x1c, 0xcd, 0x48, 0x63, 0x7d, 0x9e, 0x30, 0xd6, 0x77, 0xa4, 0x85, 0xd0, 0xe2, 0x9f, 0x27, 0x2c,
+	0xfe, 0x1d, 0x82, 0xe3, 0xb6, 0xb9, 0x0a, 0x05, 0x76, 0x66, 0x07, 0xcc, 0x92, 0xaa, 0x92, 0x54,
+	0x6c, 0x3b, 0xe5, 0x2e, 0xbb, 0x9d, 0x0e, 0x60, 0xab, 0xe1, 0x31, 0x33, 0x60, 0xf2, 0xdc, 0x0b,
+	0xed, 0xff, 0x3a, 0x14, 0xcd, 0xe1, 0xd0, 0xe9, 0x4f, 0x97, 0x75, 0x85, 0xd3, 0x2d, 0x8b, 0x54,
+	0xa1, 0x78, 0xe2, 0xf8, 0xc1, 0xd8, 0x1c, 0x31, 0xe9, 0xe9, 0x23, 0x5a, 0xff, 0x56, 0x81, 0xed,
+	0x0b, 0x78, 0x72, 0x15, 0x8e, 0xa1, 0x6c, 0xfb, 0xce, 0x90, 0xff, 0x41, 0x23, 0x76, 0x1d, 0xfe,
+	0xe9, 0x62, 0xe7, 0x72, 0x2b, 0xc4, 0xe0, 0xb7, 0xe3, 0x35, 0x3b, 0x4e, 0x72, 0x8b, 0xe3, 0x93,
+	0x5b, 0x72, 0xa7, 0x87, 0xa4, 0xfe, 0xb7, 0x0a, 0x6c, 0xcb, 0x70, 0x28, 0xfd, 0x1f, 0x9d, 0x15,
+	0x39, 0xf3, 0xae, 0x45, 0xd6, 0x2b, 0x70, 0xf5, 0xa2, 0x5c, 0xd2, 0xe7, 0xff, 0x53, 0x01, 0xc8,
+	0xec, 0x55, 0x9c, 0xfc, 0x00, 0x56, 0x7d, 0x36, 0xb6, 0x0c, 0x71, 0xb8, 0x8a, 0x73, 0xbf, 0x48,
+	0x4b, 0xc8, 0x13, 0xa7, 0xac, 0x8f, 0x2e, 0x90, 0x9d, 0x49, 0x69, 0x8b, 0x94, 0x7f, 0x93, 0x13,
+	0x58, 0x7d, 0xe1, 0x1b, 0xd1, 0xdc, 0xdc, 0xa0, 0xca, 0xa9, 0xdd, 0xda, 0xac, 0x1c, 0xb5, 0x47,
+	0xdd, 0xe8, 0x7f, 0xd1, 0xd2, 0x0b, 0x3f, 0x22, 0xc8, 0x37, 0x0a, 0x5c, 0x0b, 0x63, 0xb0, 0xa9,
+	0xfa, 0x46, 0x8e, 0xc5, 0xfc, 0x4a, 0xee, 0x46, 0xf6, 0x66, 0x79, 0xe7, 0xf0, 0x12, 0xfa, 0x9b,
+	0x61, 0x1e, 0x38, 0x16, 0xa3, 0xdb, 0xe3, 0x39, 0x5c, 0x9f, 0xd4, 0x60, 0x73, 0x34, 0xf1, 0x03,
+	0x43, 0x58, 0x81, 0x21, 0x3b, 0x55, 0xf2, 0x5c, 0x2f, 0x1b, 0xd8, 0x94, 0xb0, 0x55, 0xf2, 0x0a,
+	0xd6, 0x46, 0xce, 0x64, 0x1c, 0x18, 0x7d, 0x7e, 0x59, 0xf4, 0x2b, 0x85, 0x85, 0xb2, 0x08, 0x73,
+	0xb4, 0x74, 0x80, 0x70, 0xe2, 0xea, 0xe9, 0xd3, 0xd5, 0x51, 0x8c, 0xc2, 0x85, 0xf4, 0xd8, 0xc8,
+	0x09, 0x98, 0x81, 0xfe, 0xd2, 0xaf, 0xac, 0x88, 0x85, 0x14, 0x3c, 0x74, 0x0d, 0x98, 0xce, 0x20,
+	0x13, 0x9f, 0x79, 0x06, 0x6e, 0x2d, 0xdf, 0x35, 0xfb, 0xcc, 0xb0, 0x2d, 0xbf, 0x52, 0x5c, 0x28,
+	0xe8, 0x69, 0xed, 0x1e, 0x98, 0xae, 0x8b, 0xfe, 0x50, 0x43, 0xac, 0x76, 0x08, 0xd5, 0xb2, 0x7c,
+	0xb2, 0x03, 0xdb, 0x13, 0x1e, 0xfb, 0x70, 0x11, 0x8c, 0x69, 0x5c, 0xa5, 0x72, 0x59, 0x36, 0x27,
+	0xb3, 0x81, 0x11, 0xf9, 0x3e, 0x80, 0x6b, 0x4e, 0x7c, 0x31, 0xa4, 0x02, 0xbc, 0xa3, 0xea, 0x86,
+	0x51, 0x8f, 0x5e, 0x83, 0x52, 0xcc, 0x32, 0x48, 0x11, 0x72, 0xed, 0x4e, 0xbb, 0xa9, 0x5d, 0x21,
+	0x00, 0x85, 0xc6, 0x1e, 0xed, 0x74, 0x7a, 0xe2, 0x56, 0xd8, 0x3a, 0xa8, 0x3f, 0x6e, 0x6a, 0x19,
+	0xbd, 0x09, 0xab, 0x71, 0x1d, 0x11, 0x02, 0xe5, 0xa3, 0xf6, 0x93, 0x76, 0xe7, 0x69, 0xdb, 0x38,
+	0xe8, 0x1c, 0xb5, 0x7b, 0x78, 0x9f, 0x2c, 0x03, 0xd4, 0xdb, 0xcf, 0xa6, 0xf4, 0x1a, 0xa8, 0xed,
+	0x4e, 0x48, 0x2a, 0xd5, 0x8c, 0xa6, 0xe8, 0xff, 0x9e, 0x85, 0xad, 0x79, 0xe6, 0x42, 0x2c, 0xc8,
+	0xa1, 0xe9, 0xc9, 0x1b, 0xfd, 0xbb, 0xb7, 0x3c, 0x8e, 0x8e, 0x3b, 0xce, 0x35, 0xe5, 0xa9, 0xa4,
+	0x52, 0xfe, 0x4d, 0x0c, 0x28, 0x0c, 0xcd, 0x63, 0x36, 0xf4, 0x2b, 0x59, 0x9e, 0xf3, 0x7a, 0x7c,
+	0x99, 0xb9, 0xf7, 0x39, 0x92, 0x48, 0x78, 0x49, 0x58, 0xd2, 0x83, 0x12, 0xfa, 0x5d, 0x5f, 0xa8,
+	0x4e, 0x1e, 0x05, 0x3b, 0x29, 0x67, 0xd9, 0x9b, 0x8e, 0xa4, 0x71, 0x98, 0xea, 0x5d, 0x28, 0xc5,
+	0x26, 0x9b, 0x93, 0xaf, 0xda, 0x8a, 0xe7, 0xab, 0xd4, 0x78, 0xf2, 0xe9, 0x01, 0x6c, 0xcd, 0xd3,
+	0x11, 0x1a, 0xc1, 0x5e, 0xa7, 0xdb, 0x13, 0x99, 0x81, 0xc7, 0xb4, 0x73, 0x74, 0xa8, 0x29, 0xc8,
+	0xec, 0xd5, 0xbb, 0x4f, 0xb4, 0x4c, 0x64, 0x23, 0x59, 0xbd, 0x01, 0xa5, 0x98, 0x5c, 0x89, 0x83,
+	0x46, 0x49, 0x1e, 0x34, 0xe8, 0xea, 0x4d, 0xcb, 0xf2, 0x98, 0xef, 0x4b, 0x39, 0x42, 0x52, 0x7f,
+	0x0e, 0xea, 0x6e, 0xbb, 0x2b, 0x21, 0x2a, 0xb0, 0xe2, 0x33, 0x0f, 0xff, 0x37, 0xcf, 0x3c, 0xaa,
+	0x34, 0x24, 0x11, 0xdc, 0x67, 0xa6, 0xd7, 0x3f, 0xe1, 0xd7, 0x08, 0x6c, 0x8a, 0x68, 0x1c, 0xe5,
+	0xf0, 0x0c, 0x9e, 0x58, 0x3b, 0x95, 0x86, 0xa4, 0xfe, 0x2f, 0x2a, 0xc0, 0x34, 0x9b, 0x44, 0xca,
+	0x90, 0x89, 0x8e, 0x8d, 0x8c, 0x6d, 0xa1, 0x1d, 0xc4, 0x8e, 0x45, 0xfe, 0x8d, 0x9b, 0x6c, 0xe4,
+	0x0f, 0x5c, 0xb3, 0xff, 0xca, 0x90, 0x49, 0x20, 0xe1, 0x5d, 0xb8, 0x0b, 0x5e, 0xa5, 0x9b, 0xb2,
+	0x51, 0x3a, 0x0f, 0x81, 0xbb, 0x0f, 0x59, 0x36, 0x3e, 0xe5, 0xee, 0xb2, 0xb4, 0x73, 0x6f, 0xe1,
+	0x2c, 0x57, 0xad, 0x39, 0x3e, 0x15, 0xb6, 0x82, 0x30, 0xc4, 0x00, 0xb0, 0xd8, 0xa9, 0xdd, 0x67,
+	0x06, 0x82, 0xe6, 0x39, 0xe8, 0x17, 0x8b, 0x83, 0xee, 0x72, 0x8c, 0x08, 0x5a, 0xb5, 0x42, 0x3a,
+	0x79, 0x27, 0x2b, 0x5c, 0xfa, 0x4e, 0x46, 0x76, 0xa1, 0xc0, 0x5d, 0x25, 0x3a, 0xc5, 0xec, 0x77,
+	0xa6, 0xcc, 0x93, 0x60, 0xdc, 0x93, 0x50, 0x39, 0x96, 0x3c, 0x86, 0x15, 0x21, 0x22, 0xba, 0x4c,
+	0x84, 0xf9, 0x38, 0xad, 0x1f, 0xe7, 0xa3, 0x68, 0x38, 0x1a, 0x57, 0x15, 0x5d, 0x27, 0xf7, 0x8a,
+	0x2a, 0xe5, 0xdf, 0xe4, 0x3d, 0x50, 0x45, 0xd8, 0x60, 0xd9, 0x1e, 0xf7, 0x82, 0x2a, 0x15, 0x71,
+	0xc4, 0xae, 0xed, 0x91, 0xf7, 0xa1, 0x24, 0xc2, 0x43, 0x83, 0x7b, 0x85, 0x12, 0x6f, 0x06, 0xc1,
+	0x3a, 0x44, 0xdf, 0x20, 0x3a, 0x30, 0xcf, 0x13, 0x1d, 0x56, 0xa3, 0x0e, 0xcc, 0xf3, 0x78, 0x87,
+	0xdf, 0x82, 0x75, 0xee, 0x92, 0x07, 0x9e, 0x33, 0x71, 0xb9, 0xff, 0xaf, 0xac, 0xf1, 0x4e, 0x6b,
+	0xc8, 0x7e, 0x8c, 0x5c, 0xf4, 0xe4, 0x18, 0xbd, 0xbc, 0x74, 0x8e, 0x45, 0x87, 0xb2, 0xd8, 0x07,
+	0x2f, 0x9d, 0xe3, 0xb0, 0x29, 0x0a, 0x6c, 0xd6, 0x93, 0x81, 0xcd, 0xd7, 0x70, 0x75, 0xf6, 0x84,
+	0xe6, 0x01, 0x8e, 0x76, 0xf9, 0x00, 0x67, 0x6b, 0x3c, 0x87, 0x4b, 0x1e, 0x42, 0xd6, 0x1a, 0xfb,
+	0x95, 0x8d, 0x85, 0x8c, 0x23, 0xda, 0xc7, 0x14, 0x07, 0x93, 0x6d, 0x28, 0xe0, 0x9f, 0xb5, 0xad,
+	0x0a, 0x11, 0xae, 0xe7, 0xa5, 0x73, 0xdc, 0xb2, 0xc8, 0xf7, 0x40, 0x8d, 0x0e, 0xc8, 0xca, 0x26,
+	0x6f, 0x99, 0x32, 0x70, 0xa1, 0xc6, 0x8e, 0xc5, 0x84, 0x8a, 0xb6, 0xc4, 0x42, 0x21, 0x83, 0xeb,
+	0xe8, 0x1a, 0xac, 0xf0, 0x46, 0xdb, 0xaa, 0x6c, 0x8b, 0xbb, 0x0b, 0x92, 0x2d, 0x8b, 0x3c, 0x85,
+	0x72, 0xf2, 0xe4, 0xad, 0x5c, 0x5d, 0xf2, 0xd4, 0x5d, 0x4b, 0x9c, 0xba, 0xd5, 0x4f, 0xa1, 0x18,
+	0xee, 0xa0, 0x45, 0x7c, 0x6b, 0xf5, 0x3e, 0x94, 0x93, 0xfb, 0x6f, 0x21, 0xcf, 0xfc, 0x0f, 0x19,
+	0x50, 0xa7, 0x47, 0xf8, 0x18, 0x36, 0xb9, 0x25, 0x98, 0x01, 0xb3, 0x62, 0x87, 0xbe, 0x88, 0xc7,
+	0x3f, 0x4b, 0xf9, 0x0f, 0xeb, 0x21, 0x42, 0x32, 0x6f, 0x42, 0x22, 0xe4, 0xe9, 0x7c, 0x5f, 0xc1,
+	0xfa, 0xd0, 0x1e, 0x4f, 0xce, 0x8c, 0x8b, 0x89, 0x9b, 0x9f, 0xa4, 0x9c, 0x6b, 0x1f, 0x47, 0x4f,
+	0xe7, 0x28, 0x0f, 0x13, 0x34, 0xd9, 0x83, 0xbc, 0xeb, 0x78, 0x41, 0x78, 0xd0, 0xa6, 0x3d, 0x02,
+	0x0f, 0x1d, 0x2f, 0x08, 0x57, 0x49, 0x00, 0xe8, 0xdf, 0x66, 0xe0, 0xea, 0xfc, 0x3f, 0x46, 0xda,
+	0x90, 0xed, 0xbb, 0x13, 0xa9, 0xa4, 0xfb, 0x8b, 0x2a, 0xa9, 0xe1, 0x4e, 0xa6, 0xf2, 0x23, 0x10,
+	0x3e, 0x36, 0x8c, 0xd8, 0xc8, 0xf1, 0xce, 0xa5, 0x2e, 0x1e, 0x2c, 0x0a, 0x79, 0xc0, 0x47, 0x4f,
+	0x51, 0x25, 0x1c, 0xa1, 0x50, 0x94, 0x3b, 0xd0, 0x97, 0xbe, 0x7e, 0xc1, 0xd4, 0x67, 0x08, 0x49,
+	0x23, 0x1c, 0xfd, 0x53, 0xd8, 0x9e, 0xfb, 0x57, 0x30, 0x1a, 0xec, 0xbb, 0x13, 0x83, 0x3f, 0x4d,
+	0x09, 0x0b, 0xca, 0x52, 0xb5, 0xef, 0x4e, 0xba, 0x9c, 0xa1, 0x3f, 0x87, 0xca, 0x9b, 0xe4, 0xc5,
+	0x8d, 0x29, 0x24, 0x36, 0x46, 0xc7, 0x5c, 0x07, 0x59, 0x5a, 0x14, 0x8c, 0x83, 0x63, 0xa2, 0xc3,
+	0x5a, 0xd8, 0x68, 0x9e, 0x61, 0x87, 0x2c, 0xef, 0x50, 0x92, 0x1d, 0xcc, 0xb3, 0x83, 0x63, 0xfd,
+	0x97, 0x19, 0x58, 0xbf, 0x20, 0x32, 0xde, 0x98, 0x85, 0xd7, 0x0e, 0x73, 0x11, 0x82, 0x42, 0x17,
+	0xde, 0xb7, 0xad, 0x30, 0xe5, 0xcf, 0xbf, 0xf9, 0xe1, 0xed, 0xca, 0x74, 0x7c, 0xc6, 0x76, 0x71,
+	0xfb, 0x8c, 0x8e, 0xed, 0xc0, 0xe7, 0x91, 0x54, 0x9e, 0x0a, 0x82, 0x3c, 0x83, 0xb2, 0xc7, 0x78,
+	0xd0, 0x60, 0x19, 0xc2, 0xca, 0xf2, 0x0b, 0x59, 0x99, 0x94, 0x10, 0x8d, 0x8d, 0xae, 0x85, 0x48,
+	0x48, 0xf9, 0xe4, 0x29, 0xac, 0x59, 0xe7, 0x63, 0x73, 0x64, 0xf7, 0x25, 0x72, 0x61, 0x69, 0xe4,
+	0x55, 0x09, 0xc4, 0x81, 0xf1, 0x15, 0x30, 0xd6, 0x88, 0x7f, 0x8c, 0x87, 0x8c, 0x52, 0x27, 0x82,
+	0x48, 0x7a, 0x8b, 0xbc, 0xf4, 0x16, 0xfa, 0x31, 0x94, 0x62, 0xfb, 0x62, 0x91, 0xa1, 0xa8, 0xcf,
+	0xc0, 0xe1, 0xfa, 0xcc, 0xd3, 0x4c, 0xe0, 0xa0, 0x73, 0xc5, 0x70, 0xcd, 0xb0, 0x5d, 0xae, 0x51,
+	0x95, 0x16, 0x90, 0x6c, 0xb9, 0xfa, 0xaf, 0x32, 0x50, 0x4e, 0x6e, 0xe9, 0xd0, 0x8e, 0x5c, 0xe6,
+	0xd9, 0x8e, 0x15, 0xb3, 0xa3, 0x43, 0xce, 0x40, 0x5b, 0xc1, 0xe6, 0xaf, 0x27, 0x4e, 0x60, 0x86,
+	0xb6, 0xd2, 0x77, 0x27, 0xbf, 0x83, 0xf4, 0x05, 0x1b, 0xcc, 0x5e, 0xb0, 0x41, 0xf2, 0x11, 0x10,
+	0x69, 0x4a, 0x43, 0x7b, 0x64, 0x07, 0xc6, 0xf1, 0x79, 0xc0, 0xc4, 0x1a, 0x67, 0xa9, 0x26, 0x5a,
+	0xf6, 0xb1, 0xe1, 0x21, 0xf2, 0xd1, 0xf0, 0x1c, 0x67, 0x64, 0xf8, 0x7d, 0xc7, 0x63, 0x86, 0x69,
+	0xbd, 0xe4, 0x97, 0xc5, 0x2c, 0x2d, 0x39, 0xce, 0xa8, 0x8b, 0xbc, 0xba, 0xf5, 0x12, 0x4f, 0xef,
+	0xbe, 0x3b, 0xf1, 0x59, 0x60, 0xe0, 0x0f, 0x0f, 0x78, 0x54, 0x0a, 0x82, 0xd5, 0x70, 0x27, 0x3e,
+	0xf9, 0x4d, 0x58, 0x0b, 0x3b, 0xf0, 0x03, 0x5c, 0x46, 0x0e, 0xab, 0xb2, 0x0b, 0xe7, 0x11, 0x1d,
+	0x56, 0x0f, 0x99, 0xd7, 0x67, 0xe3, 0xa0, 0x67, 0xf7, 0x5f, 0x89, 0x6b, 0x9d, 0x42, 0x13, 0xbc,
+	0x2f, 0x73, 0xc5, 0x15, 0xad, 0x48, 0xc3, 0xd9, 0x46, 0x6c, 0xe4, 0xeb, 0xcf, 0x40, 0x8d, 0x0e,
+	0x17, 0xd4, 0xcb, 0xc4, 0xb6, 0x0c, 0x3f, 0x30, 0x3d, 0x91, 0xdd, 0x5a, 0xa3, 0xc5, 0x89, 0x6d,
+	0xf1, 0x07, 0x49, 0x6c, 0x1c, 0x44, 0x8d, 0x19, 0xd1, 0x38, 0x08, 0x1b, 0x09, 0xe4, 0x78, 0xfa,
+	0x31, 0xcb, 0xf9, 0xfc, 0x5b, 0xff, 0x46, 0x81, 0x3c, 0x0f, 0xa1, 0x70, 0x28, 0x0f, 0x3f, 0x78,
+	0x74, 0x22, 0x43, 0x6f, 0x64, 0xf0, 0xd8, 0xe4, 0x3d, 0x50, 0xf9, 0xba, 0xc6, 0x6e, 0x3c, 0x3c,
+	0x2e, 0xe7, 0x8d, 0x55, 0x28, 0x7a, 0xcc, 0xb4, 0x9c, 0xf1, 0x30, 0xcc, 0xef, 0x45, 0x34, 0xf9,
+	0x6d, 0xd0, 0x5c, 0xcf, 0x71, 0xcd, 0xc1, 0x34, 0x25, 0x20, 0x2d, 0x63, 0x3d, 0xc6, 0xc7, 0x2b,
+	0x83, 0xfe, 0x35, 0x14, 0xc4, 0x71, 0x77, 0x09, 0x51, 0x3e, 0x06, 0x22, 0xd4, 0x8f, 0x66, 0x35,
+	0xb2, 0x7d, 0x5f, 0x06, 0xf4, 0xfc, 0xb1, 0x5e, 0xb4, 0x1c, 0x4e, 0x1b, 0xf4, 0xff, 0x52, 0x00,
+	0xa6, 0xcf, 0xa8, 0x78, 0x07, 0xc0, 0xbd, 0x86, 0xb9, 0x12, 0x91, 0x82, 0x0c, 0x49, 0xcc, 0xbe,
+	0xc9, 0x08, 0x3e, 0xb3, 0xec, 0x2b, 0xb4, 0x04, 0x08, 0x5f, 0x6f, 0x98, 0x4c, 0xc7, 0x2c, 0xfa,
+	0x7a, 0xc3, 0xc4, 0xeb, 0x0d, 0xc3, 0x5c, 0x82, 0xbc, 0x5b, 0x08, 0xb8, 0x1c, 0xbf, 0x5a, 0x94,
+	0xac, 0xe8, 0x89, 0x8c, 0xe9, 0xff, 0xab, 0x44, 0xde, 0x32, 0x7c, 0xca, 0x22, 0x5f, 0x41, 0x11,
+	0x1d, 0x8f, 0x31, 0x32, 0x5d, 0x59, 0x98, 0xd1, 0x58, 0xee, 0x95, 0x2c, 0x3c, 0x4b, 0xc5, 0xcd,
+	0x60, 0xc5, 0x15, 0x14, 0x1a, 0x19, 0xde, 0xca, 0x42, 0xaf, 0x8b, 0xdf, 0xe4, 0x03, 0x28, 0x9b,
+	0x93, 0xc0, 0x31, 0x4c, 0xeb, 0x94, 0x79, 0x81, 0xed, 0x33, 0x69, 0x26, 0x6b, 0xc8, 0xad, 0x87,
+	0xcc, 0xea, 0x3d, 0x58, 0x8d, 0x63, 0xbe, 0x2d, 0xda, 0xc9, 0xc7, 0xa3, 0x9d, 0x3f, 0x00, 0x98,
+	0x66, 0x3a, 0xd1, 0x46, 0x30, 0x6d, 0x6a, 0xf4, 0xc3, 0x34, 0x40, 0x9e, 0x16, 0x91, 0xd1, 0xc0,
+	0xab, 0x69, 0xf2, 0xcd, 0x2a, 0x1f, 0xbe, 0x59, 0xa1, 0x4f, 0x41, 0x37, 0xf0, 0xca, 0x1e, 0x0e,
+	0xa3, 0xec, 0xab, 0xea, 0x38, 0xa3, 0x27, 0x9c, 0xa1, 0xff, 0x3a, 0x23, 0x6c, 0x45, 0xbc, 0x3e,
+	0xa6, 0xba, 0x06, 0xbe, 0xab, 0xa5, 0xbe, 0x0b, 0xc0, 0x77, 0x34, 0xb3, 0x0c, 0x33, 0xcc, 0xff,
+	0x56, 0x67, 0xde, 0x71, 0x7a, 0x61, 0x39, 0x14, 0x55, 0x65, 0xef, 0x7a, 0x40, 0x3e, 0x83, 0xd5,
+	0xbe, 0x33, 0x72, 0x87, 0x4c, 0x0e, 0xce, 0xbf, 0x75, 0x70, 0x29, 0xea, 0x5f, 0x0f, 0x62, 0x59,
+	0xe7, 0xc2, 0x65, 0xb3, 0xce, 0xbf, 0x52, 0xc4, 0x23, 0x6a, 0xfc, 0x0d, 0x97, 0x0c, 0xe6, 0x14,
+	0x0a, 0x3d, 0x5e, 0xf2, 0x41, 0xf8, 0xbb, 0xaa, 0x84, 0xaa, 0x9f, 0xa5, 0x29, 0xcb, 0x79, 0x73,
+	0x30, 0xfd, 0x6f, 0x59, 0x50, 0xc3, 0x65, 0x99, 0x5d, 0xfb, 0x3b, 0xa0, 0x46, 0xb5, 0x68, 0x95,
+	0xcc, 0x5b, 0x35, 0x3c, 0xed, 0x4c, 0x5e, 0x00, 0x31, 0x07, 0x83, 0x28, 0x48, 0x36, 0x26, 0xbe,
+	0x39, 0x08, 0x5f, 0xaf, 0xef, 0x2c, 0xa0, 0x87, 0xf0, 0x54, 0x3d, 0xc2, 0xf1, 0x54, 0x33, 0x07,
+	0x83, 0x04, 0x87, 0xfc, 0x21, 0x6c, 0x27, 0xe7, 0x30, 0x8e, 0xcf, 0x0d, 0xd7, 0xb6, 0x64, 0xba,
+	0x61, 0x6f, 0xd1, 0x27, 0xe4, 0x5a, 0x02, 0xfe, 0xe1, 0xf9, 0xa1, 0x6d, 0x09, 0x9d, 0x13, 0x6f,
+	0xa6, 0xa1, 0xfa, 0xc7, 0x70, 0xed, 0x0d, 0xdd, 0xe7, 0xac, 0x41, 0x3b, 0x59, 0x1a, 0xb5, 0xbc,
+	0x12, 0x62, 0xab, 0xf7, 0x3f, 0x0a, 0x6c, 0xcc, 0x74, 0x20, 0xf5, 0x78, 0x74, 0x7f, 0x2b, 0xe5,
+	0x3c, 0x8d, 0xc3, 0x23, 0x01, 0x8f, 0x63, 0xc9, 0x97, 0x17, 0x02, 0xfa, 0xb4, 0x61, 0x9c, 0x88,
+	0x8b, 0x05, 0x50, 0x18, 0xc3, 0x7f, 0x0e, 0x19, 0xdb, 0x91, 0x4b, 0x5f, 0x4b, 0x7b, 0xe5, 0xec,
+	0x08, 0x8c, 0x8c, 0xed, 0xe8, 0xff, 0x9c, 0x85, 0x62, 0x28, 0x1d, 0x4f, 0x36, 0x9c, 0xfb, 0x01,
+	0x1b, 0x19, 0x51, 0x26, 0x54, 0xa1, 0x20, 0x58, 0x3c, 0x3f, 0x87, 0x51, 0x04, 0x5e, 0x76, 0x79,
+	0x73, 0x86, 0x37, 0x17, 0x91, 0xc1, 0x1b, 0xdf, 0x87, 0x52, 0xe0, 0x04, 0xe6, 0xd0, 0x08, 0x78,
+	0x94, 0x92, 0x15, 0xa3, 0x39, 0x8b, 0xc7, 0x28, 0xe4, 0x43, 0xd8, 0x08, 0x4e, 0x3c, 0x27, 0x08,
+	0x86, 0x18, 0x21, 0xf3, 0x78, 0x4d, 0x84, 0x57, 0x39, 0xaa, 0x45, 0x0d, 0x22, 0x8e, 0xf3, 0xd1,
+	0xfb, 0x4f, 0x3b, 0xa3, 0xe9, 0x73, 0x27, 0x94, 0xa3, 0x6b, 0x11, 0x17, 0xb7, 0x06, 0x1e, 0xbe,
+	0xae, 0x88, 0x83, 0xb8, 0xaf, 0x51, 0x68, 0x48, 0x12, 0x03, 0xd6, 0x47, 0xcc, 0xf4, 0x27, 0x1e,
+	0xb3, 0x8c, 0x17, 0x36, 0x1b, 0x5a, 0x22, 0x47, 0x54, 0x4e, 0x7d, 0xc9, 0x09, 0xd5, 0x52, 0x7b,
+	0xc4, 0x47, 0xd3, 0x72, 0x08, 0x27, 0x68, 0x8c, 0x3c, 0xc4, 0x17, 0x59, 0x87, 0x52, 0xf7, 0x59,
+	0xb7, 0xd7, 0x3c, 0x30, 0x0e, 0x3a, 0xbb, 0x4d, 0x59, 0x3d, 0xd7, 0x6d, 0x52, 0x41, 0x2a, 0xd8,
+	0xde, 0xeb, 0xf4, 0xea, 0xfb, 0x46, 0xaf, 0xd5, 0x78, 0xd2, 0xd5, 0x32, 0x64, 0x1b, 0x36, 0x7a,
+	0x7b, 0xb4, 0xd3, 0xeb, 0xed, 0x37, 0x77, 0x8d, 0xc3, 0x26, 0x6d, 0x75, 0x76, 0xbb, 0x5a, 0x16,
+	0x53, 0xda, 0x53, 0x76, 0xaf, 0x75, 0xd0, 0xd4, 0x72, 0x58, 0x2f, 0x75, 0xd8, 0xa4, 0x8d, 0x66,
+	0xbb, 0xa7, 0xe5, 0xf5, 0x5f, 0x66, 0xa1, 0x14, 0xb3, 0x02, 0xdc, 0x08, 0x9e, 0x2f, 0x6e, 0x53,
+	0x39, 0x8a, 0x9f, 0xfc, 0x01, 0xdb, 0xec, 0x9f, 0x88, 0xd5, 0xc9, 0x51, 0x41, 0xf0, 0x1b, 0x94,
+	0x79, 0x16, 0xf3, 0x13, 0x39, 0x5a, 0x1c, 0x99, 0x67, 0x02, 0xe4, 0x07, 0xb0, 0xfa, 0x8a, 0x79,
+	0x63, 0x36, 0x94, 0xed, 0x62, 0x45, 0x4a, 0x82, 0x27, 0xba, 0xdc, 0x04, 0x4d, 0x76, 0x99, 0xc2,
+	0x88, 0xe5, 0x28, 0x0b, 0xfe, 0x41, 0x08, 0xb6, 0x05, 0x79, 0xd1, 0xbc, 0x22, 0xe6, 0xe7, 0x04,
+	0x8f, 0x21, 0x5f, 0x9b, 0x2e, 0x8f, 0x5c, 0x73, 0x94, 0x7f, 0x93, 0xe3, 0xd9, 0xf5, 0x29, 0xf0,
+	0xf5, 0xb9, 0xbb, 0xf8, 0x76, 0x78, 0xd3, 0x12, 0x9d, 0x44, 0x4b, 0xb4, 0x02, 0x59, 0x1a, 0x96,
+	0x9c, 0x35, 0xea, 0x8d, 0x3d, 0x5c, 0x96, 0x35, 0x50, 0x0f, 0xea, 0x3f, 0x33, 0x8e, 0xba, 0xfc,
+	0x81, 0x81, 0x68, 0xb0, 0xfa, 0xa4, 0x49, 0xdb, 0xcd, 0x7d, 0xc9, 0xc9, 0x92, 0x2d, 0xd0, 0x24,
+	0x67, 0xda, 0x2f, 0x87, 0x08, 0xe2, 0x33, 0x8f, 0x09, 0xe9, 0xee, 0xd3, 0xfa, 0xa1, 0x56, 0xd0,
+	0xff, 0x31, 0x03, 0x2b, 0x72, 0x5f, 0x61, 0x48, 0xe0, 0x31, 0xd3, 0x92, 0xf7, 0x07, 0xb1, 0x38,
+	0x2a, 0x72, 0xc4, 0xc5, 0xe1, 0x7d, 0x28, 0xbd, 0xf6, 0xec, 0x80, 0xc9, 0x76, 0xb1, 0x50, 0xc0,
+	0x59, 0xa2, 0xc3, 0x75, 0x11, 0x19, 0x1b, 0x8e, 0xeb, 0xcb, 0xc5, 0x5a, 0x41, 0xba, 0xe3, 0xf2,
+	0xab, 0xb0, 0x18, 0x8b, 0x6d, 0x62, 0xa1, 0x8a, 0x9c, 0x81, 0x8d, 0x5f, 0xcd, 0x6a, 0x34, 0xcf,
+	0x35, 0xfa, 0x93, 0xc5, 0x1c, 0xc3, 0x9b, 0xb4, 0xf9, 0x28, 0xd2, 0x66, 0x19, 0x80, 0x36, 0xeb,
+	0xbb, 0xc6, 0xc3, 0x67, 0xbd, 0x26, 0x2a, 0x75, 0x1d, 0x4a, 0x4f, 0x69, 0xab, 0xd7, 0x94, 0x0c,
+	0x85, 0xac, 0x42, 0x91, 0x77, 0xe8, 0x1c, 0xa2, 0xb9, 0xaf, 0x81, 0x2a, 0x9a, 0x91, 0xcc, 0xea,
+	0xff, 0x9d, 0x81, 0x75, 0x71, 0x04, 0x47, 0x85, 0x44, 0x6f, 0xae, 0x0d, 0x88, 0x27, 0x27, 0x33,
+	0xc9, 0xe4, 0x64, 0x18, 0xf0, 0xf3, 0x08, 0x2a, 0x3b, 0x0d, 0xf8, 0x79, 0xc2, 0x2e, 0x71, 0xba,
+	0xe6, 0x16, 0x39, 0x5d, 0x2b, 0xb0, 0x32, 0x62, 0x7e, 0x64, 0xe3, 0x2a, 0x0d, 0x49, 0x62, 0x43,
+	0xc9, 0x1c, 0x8f, 0x9d, 0xc0, 0x14, 0x19, 0xff, 0xc2, 0x42, 0x81, 0xc7, 0x85, 0x7f, 0x5c, 0xab,
+	0x4f, 0x91, 0xc4, 0x21, 0x18, 0xc7, 0xae, 0x7e, 0x0e, 0xda, 0xc5, 0x0e, 0x8b, 0x84, 0x1e, 0x3f,
+	0xfc, 0xd1, 0x34, 0xf2, 0x60, 0xe8, 0x43, 0xe4, 0x53, 0x99, 0x76, 0x05, 0x09, 0x7a, 0xd4, 0x6e,
+	0xb7, 0xda, 0x8f, 0x35, 0x05, 0xdf, 0xda, 0x9a, 0x3f, 0x6b, 0x61, 0xc9, 0x6f, 0x66, 0xe7, 0x3f,
+	0xb7, 0xa0, 0x20, 0x84, 0x24, 0xdf, 0xca, 0xa8, 0x2b, 0x5e, 0xa4, 0x4e, 0x3e, 0x5f, 0xf8, 0xf6,
+	0x92, 0x28, 0x7c, 0xaf, 0x3e, 0x58, 0x7a, 0xbc, 0x7c, 0xe7, 0xbe, 0x42, 0xfe, 0x42, 0x81, 0xd5,
+	0xc4, 0x1b, 0x77, 0xda, 0x17, 0x8f, 0x39, 0x35, 0xf1, 0xd5, 0x9f, 0x2e, 0x35, 0x36, 0x92, 0xe5,
+	0x1b, 0x05, 0x4a, 0xb1, 0x6a, 0x70, 0x72, 0x77, 0x99, 0x0a, 0x72, 0x21, 0xc9, 0xbd, 0xe5, 0x8b,
+	0xcf, 0xf5, 0x2b, 0x9f, 0x28, 0xe4, 0xcf, 0x15, 0x28, 0xc5, 0xea, 0xa2, 0x53, 0x8b, 0x32, 0x5b,
+	0xc5, 0x5d, 0xbd, 0xb7, 0xcc, 0xd0, 0x48, 0x27, 0x7f, 0xa2, 0x80, 0x1a, 0xd5, 0x38, 0x93, 0xdb,
+	0x8b, 0x57, 0x45, 0x0b, 0x21, 0xee, 0x2c, 0x5b, 0x4e, 0xad, 0x5f, 0x21, 0x7f, 0x04, 0xc5, 0xb0,
+	0x20, 0x98, 0xa4, 0x3d, 0xe9, 0x2f, 0x54, 0x1b, 0x57, 0x6f, 0x2f, 0x3c, 0x2e, 0x3e, 0x7d, 0x58,
+	0xa5, 0x9b, 0x7a, 0xfa, 0x0b, 0xf5, 0xc4, 0xd5, 0xdb, 0x0b, 0x8f, 0x8b, 0xa6, 0x47, 0x4b, 0x88,
+	0x15, 0xf3, 0xa6, 0xb6, 0x84, 0xd9, 0x2a, 0xe2, 0xea, 0xbd, 0x65, 0x86, 0x26, 0x04, 0x89, 0x95,
+	0x03, 0xa7, 0x16, 0x64, 0xb6, 0xe4, 0xb8, 0x7a, 0x6f, 0x99, 0xa1, 0x91, 0x20, 0xbf, 0x50, 0xe2,
+	0x77, 0xb0, 0xdb, 0x0b, 0x57, 0xbd, 0x2e, 0x68, 0x92, 0x33, 0x75, 0xb7, 0x7c, 0x83, 0xfe, 0x42,
+	0x66, 0x8c, 0x44, 0xd1, 0x2c, 0x59, 0x04, 0x2c, 0x51, 0x67, 0x5b, 0xfd, 0x74, 0xb9, 0xc3, 0x86,
+	0x0b, 0xf1, 0xa7, 0x0a, 0xc0, 0xb4, 0xbc, 0x36, 0xb5, 0x10, 0x33, 0x75, 0xbd, 0xd5, 0xbb, 0x4b,
+	0x8c, 0x8c, 0x6f, 0x90, 0xb0, 0xa2, 0x2d, 0xf5, 0x06, 0xb9, 0x50, 0xd1, 0x5a, 0xbd, 0xbd, 0xf0,
+	0xb8, 0x68, 0xfa, 0xbf, 0x57, 0x60, 0x73, 0x4e, 0x15, 0x2f, 0xa9, 0xa7, 0x84, 0x7c, 0x73, 0x21,
+	0x72, 0xf5, 0xe1, 0x65, 0x20, 0x12, 0x2e, 0x34, 0x2a, 0x0d, 0x4e, 0x6d, 0xaf, 0x17, 0x2b, 0x8f,
+	0xab, 0x77, 0x16, 0x1f, 0x18, 0x89, 0x80, 0x86, 0x32, 0x2d, 0x2d, 0x4e, 0x6d, 0x28, 0x33, 0xb5,
+	0xcb, 0xd5, 0xbb, 0x4b, 0x8c, 0x8c, 0xa4, 0xf8, 0x3b, 0x05, 0x36, 0x66, 0x6a, 0x1f, 0xc9, 0x83,
+	0x4b, 0x96, 0xbf, 0x56, 0xbf, 0x58, 0x1e, 0x20, 0x14, 0xed, 0xa6, 0xf2, 0x89, 0x42, 0xfe, 0x52,
+	0x81, 0xb5, 0x64, 0x4d, 0x58, 0xea, 0x78, 0x62, 0x4e, 0x15, 0x65, 0xf5, 0xfe, 0x72, 0x83, 0x23,
+	0x6d, 0xfd, 0xb5, 0x02, 0x65, 0xe9, 0x89, 0x43, 0x79, 0xee, 0x2f, 0xe6, 0xc0, 0x2f, 0x08, 0xf4,
+	0xd9, 0x92, 0xa3, 0x43, 0x89, 0x1e, 0xae, 0xfc, 0x6e, 0x5e, 0xc4, 0xd9, 0x05, 0xfe, 0xf3, 0xe3,
+	0xff, 0x1f, 0x00, 0xc4, 0x50, 0x9f, 0xcc, 0xf5, 0x39, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -4027,6 +4488,12 @@ type DriverClient interface {
 	SignalTask(ctx context.Context, in *SignalTaskRequest, opts ...grpc.CallOption) (*SignalTaskResponse, error)
 	// ExecTask executes a command inside the tasks execution context
 	ExecTask(ctx context.Context, in *ExecTaskRequest, opts ...grpc.CallOption) (*ExecTaskResponse, error)
+	// UpdateTaskResources changes the resources enforced on a running task
+	UpdateTaskResources(ctx context.Context, in *UpdateTaskResourcesRequest, opts ...grpc.CallOption) (*UpdateTaskResourcesResponse, error)
+	// PauseTask freezes the processes of a running task
+	PauseTask(ctx context.Context, in *PauseTaskRequest, opts ...grpc.CallOption) (*PauseTaskResponse, error)
+	// ResumeTask thaws the processes of a paused task
+	ResumeTask(ctx context.Context, in *ResumeTaskRequest, opts ...grpc.CallOption) (*ResumeTaskResponse, error)
 	// ExecTaskStreaming executes a command inside the tasks execution context
 	// and streams back results
 	// buf:lint:ignore RPC_REQUEST_RESPONSE_UNIQUE
@@ -4233,6 +4700,33 @@ func (c *driverClient) ExecTask(ctx context.Context, in *ExecTaskRequest, opts .
 	return out, nil
 }
 
+func (c *driverClient) UpdateTaskResources(ctx context.Context, in *UpdateTaskResourcesRequest, opts ...grpc.CallOption) (*UpdateTaskResourcesResponse, error) {
+	out := new(UpdateTaskResourcesResponse)
+	err := c.cc.Invoke(ctx, "/hashicorp.nomad.plugins.drivers.proto.Driver/UpdateTaskResources", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *driverClient) PauseTask(ctx context.Context, in *PauseTaskRequest, opts ...grpc.CallOption) (*PauseTaskResponse, error) {
+	out := new(PauseTaskResponse)
+	err := c.cc.Invoke(ctx, "/hashicorp.nomad.plugins.drivers.proto.Driver/PauseTask", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *driverClient) ResumeTask(ctx context.Context, in *ResumeTaskRequest, opts ...grpc.CallOption) (*ResumeTaskResponse, error) {
+	out := new(ResumeTaskResponse)
+	err := c.cc.Invoke(ctx, "/hashicorp.nomad.plugins.drivers.proto.Driver/ResumeTask", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *driverClient) ExecTaskStreaming(ctx context.Context, opts ...grpc.CallOption) (Driver_ExecTaskStreamingClient, error) {
 	stream, err := c.cc.NewStream(ctx, &_Driver_serviceDesc.Streams[3], "/hashicorp.nomad.plugins.drivers.proto.Driver/ExecTaskStreaming", opts...)
 	if err != nil {
@@ -4327,6 +4821,12 @@ type DriverServer interface {
 	SignalTask(context.Context, *SignalTaskRequest) (*SignalTaskResponse, error)
 	// ExecTask executes a command inside the tasks execution context
 	ExecTask(context.Context, *ExecTaskRequest) (*ExecTaskResponse, error)
+	// UpdateTaskResources changes the resources enforced on a running task
+	UpdateTaskResources(context.Context, *UpdateTaskResourcesRequest) (*UpdateTaskResourcesResponse, error)
+	// PauseTask freezes the processes of a running task
+	PauseTask(context.Context, *PauseTaskRequest) (*PauseTaskResponse, error)
+	// ResumeTask thaws the processes of a paused task
+	ResumeTask(context.Context, *ResumeTaskRequest) (*ResumeTaskResponse, error)
 	// ExecTaskStreaming executes a command inside the tasks execution context
 	// and streams back results
 	// buf:lint:ignore RPC_REQUEST_RESPONSE_UNIQUE
@@ -4382,6 +4882,15 @@ func (*UnimplementedDriverServer) SignalTask(ctx context.Context, req *SignalTas
 func (*UnimplementedDriverServer) ExecTask(ctx context.Context, req *ExecTaskRequest) (*ExecTaskResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ExecTask not implemented")
 }
+func (*UnimplementedDriverServer) UpdateTaskResources(ctx context.Context, req *UpdateTaskResourcesRequest) (*UpdateTaskResourcesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateTaskResources not implemented")
+}
+func (*UnimplementedDriverServer) PauseTask(ctx context.Context, req *PauseTaskRequest) (*PauseTaskResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PauseTask not implemented")
+}
+func (*UnimplementedDriverServer) ResumeTask(ctx context.Context, req *ResumeTaskRequest) (*ResumeTaskResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ResumeTask not implemented")
+}
 func (*UnimplementedDriverServer) ExecTaskStreaming(srv Driver_ExecTaskStreamingServer) error {
 	return status.Errorf(codes.Unimplemented, "method ExecTaskStreaming not implemented")
 }
@@ -4639,6 +5148,60 @@ func _Driver_ExecTask_Handler(srv interface{}, ctx context.Context, dec func(int
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Driver_UpdateTaskResources_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateTaskResourcesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DriverServer).UpdateTaskResources(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/hashicorp.nomad.plugins.drivers.proto.Driver/UpdateTaskResources",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DriverServer).UpdateTaskResources(ctx, req.(*UpdateTaskResourcesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Driver_PauseTask_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PauseTaskRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DriverServer).PauseTask(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/hashicorp.nomad.plugins.drivers.proto.Driver/PauseTask",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DriverServer).PauseTask(ctx, req.(*PauseTaskRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Driver_ResumeTask_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ResumeTaskRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DriverServer).ResumeTask(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/hashicorp.nomad.plugins.drivers.proto.Driver/ResumeTask",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DriverServer).ResumeTask(ctx, req.(*ResumeTaskRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Driver_ExecTaskStreaming_Handler(srv interface{}, stream grpc.ServerStream) error {
 	return srv.(DriverServer).ExecTaskStreaming(&driverExecTaskStreamingServer{stream})
 }
@@ -4745,6 +5308,18 @@ var _Driver_serviceDesc = grpc.ServiceDesc{
 			MethodName: "ExecTask",
 			Handler:    _Driver_ExecTask_Handler,
 		},
+		{
+			MethodName: "UpdateTaskResources",
+			Handler:    _Driver_UpdateTaskResources_Handler,
+		},
+		{
+			MethodName: "PauseTask",
+			Handler:    _Driver_PauseTask_Handler,
+		},
+		{
+			MethodName: "ResumeTask",
+			Handler:    _Driver_ResumeTask_Handler,
+		},
 		{
 			MethodName: "CreateNetwork",
 			Handler:    _Driver_CreateNetwork_Handler,
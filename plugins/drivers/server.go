@@ -46,6 +46,9 @@ func (b *driverPluginServer) Capabilities(ctx context.Context, req *proto.Capabi
 			MustCreateNetwork:     caps.MustInitiateNetwork,
 			NetworkIsolationModes: []proto.NetworkIsolationSpec_NetworkIsolationMode{},
 			RemoteTasks:           caps.RemoteTasks,
+			UserNamespaceIds:      IDMappingToProto(caps.UserNamespaceIDs),
+			UpdateTaskResources:   caps.UpdateTaskResources,
+			PauseTask:             caps.PauseTask,
 		},
 	}
 
@@ -353,6 +356,34 @@ func (b *driverPluginServer) SignalTask(ctx context.Context, req *proto.SignalTa
 	return resp, nil
 }
 
+func (b *driverPluginServer) UpdateTaskResources(ctx context.Context, req *proto.UpdateTaskResourcesRequest) (*proto.UpdateTaskResourcesResponse, error) {
+	err := b.impl.UpdateTaskResources(req.TaskId, ResourcesFromProto(req.Resources))
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &proto.UpdateTaskResourcesResponse{}
+	return resp, nil
+}
+
+func (b *driverPluginServer) PauseTask(ctx context.Context, req *proto.PauseTaskRequest) (*proto.PauseTaskResponse, error) {
+	if err := b.impl.PauseTask(req.TaskId); err != nil {
+		return nil, err
+	}
+
+	resp := &proto.PauseTaskResponse{}
+	return resp, nil
+}
+
+func (b *driverPluginServer) ResumeTask(ctx context.Context, req *proto.ResumeTaskRequest) (*proto.ResumeTaskResponse, error) {
+	if err := b.impl.ResumeTask(req.TaskId); err != nil {
+		return nil, err
+	}
+
+	resp := &proto.ResumeTaskResponse{}
+	return resp, nil
+}
+
 func (b *driverPluginServer) TaskEvents(req *proto.TaskEventsRequest, srv proto.Driver_TaskEventsServer) error {
 	ch, err := b.impl.TaskEvents(srv.Context())
 	if err != nil {
@@ -223,20 +223,23 @@ func (h *DriverHarness) WaitUntilStarted(taskID string, timeout time.Duration) e
 // is passed through the base plugin layer.
 type MockDriver struct {
 	base.MockPlugin
-	TaskConfigSchemaF  func() (*hclspec.Spec, error)
-	FingerprintF       func(context.Context) (<-chan *drivers.Fingerprint, error)
-	CapabilitiesF      func() (*drivers.Capabilities, error)
-	RecoverTaskF       func(*drivers.TaskHandle) error
-	StartTaskF         func(*drivers.TaskConfig) (*drivers.TaskHandle, *drivers.DriverNetwork, error)
-	WaitTaskF          func(context.Context, string) (<-chan *drivers.ExitResult, error)
-	StopTaskF          func(string, time.Duration, string) error
-	DestroyTaskF       func(string, bool) error
-	InspectTaskF       func(string) (*drivers.TaskStatus, error)
-	TaskStatsF         func(context.Context, string, time.Duration) (<-chan *drivers.TaskResourceUsage, error)
-	TaskEventsF        func(context.Context) (<-chan *drivers.TaskEvent, error)
-	SignalTaskF        func(string, string) error
-	ExecTaskF          func(string, []string, time.Duration) (*drivers.ExecTaskResult, error)
-	ExecTaskStreamingF func(context.Context, string, *drivers.ExecOptions) (*drivers.ExitResult, error)
+	TaskConfigSchemaF    func() (*hclspec.Spec, error)
+	FingerprintF         func(context.Context) (<-chan *drivers.Fingerprint, error)
+	CapabilitiesF        func() (*drivers.Capabilities, error)
+	RecoverTaskF         func(*drivers.TaskHandle) error
+	StartTaskF           func(*drivers.TaskConfig) (*drivers.TaskHandle, *drivers.DriverNetwork, error)
+	WaitTaskF            func(context.Context, string) (<-chan *drivers.ExitResult, error)
+	StopTaskF            func(string, time.Duration, string) error
+	DestroyTaskF         func(string, bool) error
+	InspectTaskF         func(string) (*drivers.TaskStatus, error)
+	TaskStatsF           func(context.Context, string, time.Duration) (<-chan *drivers.TaskResourceUsage, error)
+	TaskEventsF          func(context.Context) (<-chan *drivers.TaskEvent, error)
+	SignalTaskF          func(string, string) error
+	ExecTaskF            func(string, []string, time.Duration) (*drivers.ExecTaskResult, error)
+	UpdateTaskResourcesF func(string, *drivers.Resources) error
+	PauseTaskF           func(string) error
+	ResumeTaskF          func(string) error
+	ExecTaskStreamingF   func(context.Context, string, *drivers.ExecOptions) (*drivers.ExitResult, error)
 	MockNetworkManager
 }
 
@@ -282,6 +285,15 @@ func (d *MockDriver) TaskEvents(ctx context.Context) (<-chan *drivers.TaskEvent,
 func (d *MockDriver) SignalTask(taskID string, signal string) error {
 	return d.SignalTaskF(taskID, signal)
 }
+func (d *MockDriver) UpdateTaskResources(taskID string, resources *drivers.Resources) error {
+	return d.UpdateTaskResourcesF(taskID, resources)
+}
+func (d *MockDriver) PauseTask(taskID string) error {
+	return d.PauseTaskF(taskID)
+}
+func (d *MockDriver) ResumeTask(taskID string) error {
+	return d.ResumeTaskF(taskID)
+}
 func (d *MockDriver) ExecTask(taskID string, cmd []string, timeout time.Duration) (*drivers.ExecTaskResult, error) {
 	return d.ExecTaskF(taskID, cmd, timeout)
 }
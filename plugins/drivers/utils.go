@@ -70,6 +70,7 @@ func taskConfigFromProto(pb *proto.TaskConfig) *TaskConfig {
 		AllocID:          pb.AllocId,
 		NetworkIsolation: NetworkIsolationSpecFromProto(pb.NetworkIsolationSpec),
 		DNS:              dnsConfigFromProto(pb.Dns),
+		UserNamespace:    IDMappingFromProto(pb.UserNamespace),
 	}
 }
 
@@ -99,6 +100,7 @@ func taskConfigToProto(cfg *TaskConfig) *proto.TaskConfig {
 		AllocId:              cfg.AllocID,
 		NetworkIsolationSpec: NetworkIsolationSpecToProto(cfg.NetworkIsolation),
 		Dns:                  dnsConfigToProto(cfg.DNS),
+		UserNamespace:        IDMappingToProto(cfg.UserNamespace),
 	}
 	return pb
 }
@@ -485,9 +487,21 @@ func resourceUsageToProto(ru *ResourceUsage) *proto.TaskResourceUsage {
 		KernelMaxUsage: ru.MemoryStats.KernelMaxUsage,
 	}
 
+	var io *proto.IOUsage
+	if ru.IOStats != nil {
+		io = &proto.IOUsage{
+			MeasuredFields: ioUsageMeasuredFieldsToProto(ru.IOStats.Measured),
+			ReadBytes:      ru.IOStats.ReadBytes,
+			WriteBytes:     ru.IOStats.WriteBytes,
+			ReadOps:        ru.IOStats.ReadOps,
+			WriteOps:       ru.IOStats.WriteOps,
+		}
+	}
+
 	return &proto.TaskResourceUsage{
 		Cpu:    cpu,
 		Memory: memory,
+		Io:     io,
 	}
 }
 
@@ -519,9 +533,21 @@ func resourceUsageFromProto(pb *proto.TaskResourceUsage) *ResourceUsage {
 		}
 	}
 
+	var io *IOStats
+	if pb.Io != nil {
+		io = &IOStats{
+			Measured:   ioUsageMeasuredFieldsFromProto(pb.Io.MeasuredFields),
+			ReadBytes:  pb.Io.ReadBytes,
+			WriteBytes: pb.Io.WriteBytes,
+			ReadOps:    pb.Io.ReadOps,
+			WriteOps:   pb.Io.WriteOps,
+		}
+	}
+
 	return &ResourceUsage{
 		CpuStats:    &cpu,
 		MemoryStats: &memory,
+		IOStats:     io,
 	}
 }
 
@@ -615,6 +641,44 @@ func memoryUsageMeasuredFieldsFromProto(fields []proto.MemoryUsage_Fields) []str
 	return r
 }
 
+var ioUsageMeasuredFieldToProtoMap = map[string]proto.IOUsage_Fields{
+	"Read Bytes":  proto.IOUsage_READ_BYTES,
+	"Write Bytes": proto.IOUsage_WRITE_BYTES,
+	"Read Ops":    proto.IOUsage_READ_OPS,
+	"Write Ops":   proto.IOUsage_WRITE_OPS,
+}
+
+var ioUsageMeasuredFieldFromProtoMap = map[proto.IOUsage_Fields]string{
+	proto.IOUsage_READ_BYTES:  "Read Bytes",
+	proto.IOUsage_WRITE_BYTES: "Write Bytes",
+	proto.IOUsage_READ_OPS:    "Read Ops",
+	proto.IOUsage_WRITE_OPS:   "Write Ops",
+}
+
+func ioUsageMeasuredFieldsToProto(fields []string) []proto.IOUsage_Fields {
+	r := make([]proto.IOUsage_Fields, 0, len(fields))
+
+	for _, f := range fields {
+		if v, ok := ioUsageMeasuredFieldToProtoMap[f]; ok {
+			r = append(r, v)
+		}
+	}
+
+	return r
+}
+
+func ioUsageMeasuredFieldsFromProto(fields []proto.IOUsage_Fields) []string {
+	r := make([]string, 0, len(fields))
+
+	for _, f := range fields {
+		if v, ok := ioUsageMeasuredFieldFromProtoMap[f]; ok {
+			r = append(r, v)
+		}
+	}
+
+	return r
+}
+
 func netIsolationModeToProto(mode NetIsolationMode) proto.NetworkIsolationSpec_NetworkIsolationMode {
 	switch mode {
 	case NetIsolationModeHost:
@@ -723,3 +787,27 @@ func dnsConfigFromProto(pb *proto.DNSConfig) *DNSConfig {
 		Options:  pb.Options,
 	}
 }
+
+func IDMappingToProto(m *IDMapping) *proto.IDMapping {
+	if m == nil {
+		return nil
+	}
+
+	return &proto.IDMapping{
+		UidStart: m.UIDStart,
+		GidStart: m.GIDStart,
+		Size:     m.Size,
+	}
+}
+
+func IDMappingFromProto(pb *proto.IDMapping) *IDMapping {
+	if pb == nil {
+		return nil
+	}
+
+	return &IDMapping{
+		UIDStart: pb.UidStart,
+		GIDStart: pb.GidStart,
+		Size:     pb.Size,
+	}
+}
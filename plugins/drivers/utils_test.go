@@ -30,6 +30,13 @@ func TestResourceUsageRoundTrip(t *testing.T) {
 			KernelMaxUsage: 45,
 			Measured:       []string{"RSS", "Swap"},
 		},
+		IOStats: &IOStats{
+			ReadBytes:  4096,
+			WriteBytes: 8192,
+			ReadOps:    1,
+			WriteOps:   2,
+			Measured:   []string{"Read Bytes", "Write Bytes"},
+		},
 	}
 
 	parsed := resourceUsageFromProto(resourceUsageToProto(input))
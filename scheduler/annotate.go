@@ -26,7 +26,9 @@ const (
 
 // Annotate takes the diff between the old and new version of a Job, the
 // scheduler's plan annotations and will add annotations to the diff to aide
-// human understanding of the plan.
+// human understanding of the plan. The state is used to find the nodes of the
+// job's allocations, as whether changes of the cpu and memory of tasks are
+// in-place updates depends on their drivers on the nodes.
 //
 // Currently the things that are annotated are:
 // * Task group changes will be annotated with:
@@ -36,14 +38,27 @@ const (
 // * Task changes will be annotated with:
 //   - forces create/destroy update
 //   - forces in-place update
-func Annotate(diff *structs.JobDiff, annotations *structs.PlanAnnotations) error {
+func Annotate(diff *structs.JobDiff, annotations *structs.PlanAnnotations, state State, namespace string) error {
 	tgDiffs := diff.TaskGroups
 	if len(tgDiffs) == 0 {
 		return nil
 	}
 
+	var allocs []*structs.Allocation
+	if state != nil {
+		var err error
+		allocs, err = state.AllocsByJob(nil, namespace, diff.ID, false)
+		if err != nil {
+			return err
+		}
+	}
+
 	for _, tgDiff := range tgDiffs {
-		if err := annotateTaskGroup(tgDiff, annotations); err != nil {
+		inplaceTasks, err := inplaceResourcesTasks(state, allocs, tgDiff.Name)
+		if err != nil {
+			return err
+		}
+		if err := annotateTaskGroup(tgDiff, annotations, inplaceTasks); err != nil {
 			return err
 		}
 	}
@@ -51,8 +66,43 @@ func Annotate(diff *structs.JobDiff, annotations *structs.PlanAnnotations) error
 	return nil
 }
 
-// annotateTaskGroup takes a task group diff and annotates it.
-func annotateTaskGroup(diff *structs.TaskGroupDiff, annotations *structs.PlanAnnotations) error {
+// inplaceResourcesTasks returns the set of tasks of the task group whose cpu
+// and memory can be updated in-place, because their driver can update running
+// tasks on the nodes of all of the task group's allocations.
+func inplaceResourcesTasks(state State, allocs []*structs.Allocation, taskGroup string) (map[string]bool, error) {
+	var tasks map[string]bool
+	for _, alloc := range allocs {
+		if alloc.TaskGroup != taskGroup || alloc.TerminalStatus() {
+			continue
+		}
+		tg := alloc.Job.LookupTaskGroup(taskGroup)
+		if tg == nil {
+			continue
+		}
+
+		node, err := state.NodeByID(nil, alloc.NodeID)
+		if err != nil {
+			return nil, err
+		}
+
+		allocTasks := make(map[string]bool, len(tg.Tasks))
+		for _, task := range tg.Tasks {
+			// Tasks are only updated in-place if they can be on every node
+			if tasks != nil && !tasks[task.Name] {
+				continue
+			}
+			if node != nil && driverUpdatesTaskResources(node, task.Driver) {
+				allocTasks[task.Name] = true
+			}
+		}
+		tasks = allocTasks
+	}
+	return tasks, nil
+}
+
+// annotateTaskGroup takes a task group diff and annotates it. inplaceTasks is
+// the set of tasks whose cpu and memory can be updated in-place.
+func annotateTaskGroup(diff *structs.TaskGroupDiff, annotations *structs.PlanAnnotations, inplaceTasks map[string]bool) error {
 	// Annotate the updates
 	if annotations != nil {
 		tg, ok := annotations.DesiredTGUpdates[diff.Name]
@@ -97,7 +147,7 @@ func annotateTaskGroup(diff *structs.TaskGroupDiff, annotations *structs.PlanAnn
 	}
 
 	for _, taskDiff := range taskDiffs {
-		annotateTask(taskDiff, diff)
+		annotateTask(taskDiff, diff, inplaceTasks[taskDiff.Name])
 	}
 
 	return nil
@@ -147,8 +197,9 @@ func annotateCountChange(diff *structs.TaskGroupDiff) error {
 	return nil
 }
 
-// annotateCountChange takes a task diff and annotates it.
-func annotateTask(diff *structs.TaskDiff, parent *structs.TaskGroupDiff) {
+// annotateTask takes a task diff and annotates it. inplaceResources is whether
+// the cpu and memory of the task can be updated in-place.
+func annotateTask(diff *structs.TaskDiff, parent *structs.TaskGroupDiff, inplaceResources bool) {
 	if diff.Type == structs.DiffTypeNone {
 		return
 	}
@@ -179,7 +230,7 @@ FieldsLoop:
 	}
 
 	// Object changes that can be done in-place are log configs, services,
-	// constraints.
+	// constraints and cpu or memory resources if the driver can update them.
 
 	if !destructive {
 	ObjectsLoop:
@@ -187,6 +238,12 @@ FieldsLoop:
 			switch oDiff.Name {
 			case "LogConfig", "Service", "Constraint":
 				continue
+			case "Resources":
+				if inplaceResources && inplaceResourcesDiff(oDiff) {
+					continue
+				}
+				destructive = true
+				break ObjectsLoop
 			default:
 				destructive = true
 				break ObjectsLoop
@@ -200,3 +257,23 @@ FieldsLoop:
 		diff.Annotations = append(diff.Annotations, AnnotationForcesInplaceUpdate)
 	}
 }
+
+// inplaceResourcesDiff returns whether a resources diff only changes the cpu or
+// memory of a task.
+func inplaceResourcesDiff(diff *structs.ObjectDiff) bool {
+	if len(diff.Objects) != 0 {
+		return false
+	}
+	for _, fDiff := range diff.Fields {
+		// Contextual diffs include the fields which didn't change
+		if fDiff.Type == structs.DiffTypeNone {
+			continue
+		}
+		switch fDiff.Name {
+		case "CPU", "MemoryMB", "MemoryMaxMB":
+		default:
+			return false
+		}
+	}
+	return true
+}
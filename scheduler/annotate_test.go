@@ -5,7 +5,10 @@ import (
 	"testing"
 
 	"github.com/hashicorp/nomad/ci"
+	"github.com/hashicorp/nomad/nomad/mock"
+	"github.com/hashicorp/nomad/nomad/state"
 	"github.com/hashicorp/nomad/nomad/structs"
+	"github.com/shoenig/test/must"
 )
 
 func TestAnnotateTaskGroup_Updates(t *testing.T) {
@@ -43,7 +46,7 @@ func TestAnnotateTaskGroup_Updates(t *testing.T) {
 		},
 	}
 
-	if err := annotateTaskGroup(tgDiff, annotations); err != nil {
+	if err := annotateTaskGroup(tgDiff, annotations, nil); err != nil {
 		t.Fatalf("annotateTaskGroup(%#v, %#v) failed: %#v", tgDiff, annotations, err)
 	}
 
@@ -112,7 +115,7 @@ func TestAnnotateTask_NonEdited(t *testing.T) {
 	tgd := &structs.TaskGroupDiff{Type: structs.DiffTypeNone}
 	td := &structs.TaskDiff{Type: structs.DiffTypeNone}
 	tdOrig := &structs.TaskDiff{Type: structs.DiffTypeNone}
-	annotateTask(td, tgd)
+	annotateTask(td, tgd, false)
 	if !reflect.DeepEqual(tdOrig, td) {
 		t.Fatalf("annotateTask(%#v) should not have caused any annotation: %#v", tdOrig, td)
 	}
@@ -122,9 +125,10 @@ func TestAnnotateTask(t *testing.T) {
 	ci.Parallel(t)
 
 	cases := []struct {
-		Diff    *structs.TaskDiff
-		Parent  *structs.TaskGroupDiff
-		Desired string
+		Diff             *structs.TaskDiff
+		Parent           *structs.TaskGroupDiff
+		InplaceResources bool
+		Desired          string
 	}{
 		{
 			Diff: &structs.TaskDiff{
@@ -252,6 +256,61 @@ func TestAnnotateTask(t *testing.T) {
 			Parent:  &structs.TaskGroupDiff{Type: structs.DiffTypeEdited},
 			Desired: AnnotationForcesDestructiveUpdate,
 		},
+		{
+			Diff: &structs.TaskDiff{
+				Type: structs.DiffTypeEdited,
+				Objects: []*structs.ObjectDiff{
+					{
+						Type: structs.DiffTypeEdited,
+						Name: "Resources",
+						Fields: []*structs.FieldDiff{
+							{
+								Type: structs.DiffTypeEdited,
+								Name: "CPU",
+								Old:  "100",
+								New:  "200",
+							},
+							{
+								Type: structs.DiffTypeEdited,
+								Name: "MemoryMB",
+								Old:  "100",
+								New:  "200",
+							},
+						},
+					},
+				},
+			},
+			Parent:           &structs.TaskGroupDiff{Type: structs.DiffTypeEdited},
+			InplaceResources: true,
+			Desired:          AnnotationForcesInplaceUpdate,
+		},
+		{
+			Diff: &structs.TaskDiff{
+				Type: structs.DiffTypeEdited,
+				Objects: []*structs.ObjectDiff{
+					{
+						Type: structs.DiffTypeEdited,
+						Name: "Resources",
+						Fields: []*structs.FieldDiff{
+							{
+								Type: structs.DiffTypeEdited,
+								Name: "CPU",
+								Old:  "100",
+								New:  "200",
+							},
+							{
+								Type: structs.DiffTypeEdited,
+								Name: "MemoryMB",
+								Old:  "100",
+								New:  "200",
+							},
+						},
+					},
+				},
+			},
+			Parent:  &structs.TaskGroupDiff{Type: structs.DiffTypeEdited},
+			Desired: AnnotationForcesDestructiveUpdate,
+		},
 		{
 			Diff: &structs.TaskDiff{
 				Type: structs.DiffTypeEdited,
@@ -436,9 +495,55 @@ func TestAnnotateTask(t *testing.T) {
 	}
 
 	for i, c := range cases {
-		annotateTask(c.Diff, c.Parent)
+		annotateTask(c.Diff, c.Parent, c.InplaceResources)
 		if len(c.Diff.Annotations) != 1 || c.Diff.Annotations[0] != c.Desired {
 			t.Fatalf("case %d not properly annotated; got %s, want %s", i+1, c.Diff.Annotations[0], c.Desired)
 		}
 	}
 }
+
+func TestAnnotate_InplaceResources(t *testing.T) {
+	ci.Parallel(t)
+
+	store := state.TestStateStore(t)
+
+	job := mock.Job()
+	task := job.TaskGroups[0].Tasks[0]
+	must.NoError(t, store.UpsertJob(structs.MsgTypeTestSetup, 1000, job))
+
+	// One node's driver can update running tasks, the other's can't
+	node1 := mock.Node()
+	node1.Drivers[task.Driver].Attributes = map[string]string{
+		structs.DriverUpdateTaskResourcesAttr(task.Driver): "true",
+	}
+	node2 := mock.Node()
+	must.NoError(t, store.UpsertNode(structs.MsgTypeTestSetup, 1001, node1))
+	must.NoError(t, store.UpsertNode(structs.MsgTypeTestSetup, 1002, node2))
+
+	alloc1 := mock.Alloc()
+	alloc1.Job = job
+	alloc1.JobID = job.ID
+	alloc1.NodeID = node1.ID
+	must.NoError(t, store.UpsertAllocs(structs.MsgTypeTestSetup, 1003, []*structs.Allocation{alloc1}))
+
+	annotate := func() []string {
+		newJob := job.Copy()
+		newJob.TaskGroups[0].Tasks[0].Resources.CPU += 100
+		diff, err := job.Diff(newJob, true)
+		must.NoError(t, err)
+		must.NoError(t, Annotate(diff, nil, store, job.Namespace))
+		return diff.TaskGroups[0].Tasks[0].Annotations
+	}
+
+	// All of the task group's allocations are on nodes that can update the
+	// task's resources
+	must.Eq(t, []string{AnnotationForcesInplaceUpdate}, annotate())
+
+	// An allocation is on a node that can't update the task's resources
+	alloc2 := mock.Alloc()
+	alloc2.Job = job
+	alloc2.JobID = job.ID
+	alloc2.NodeID = node2.ID
+	must.NoError(t, store.UpsertAllocs(structs.MsgTypeTestSetup, 1004, []*structs.Allocation{alloc2}))
+	must.Eq(t, []string{AnnotationForcesDestructiveUpdate}, annotate())
+}
@@ -280,7 +280,7 @@ func TestServiceSched_JobRegister_StickyAllocs(t *testing.T) {
 
 	// Update the job to force a rolling upgrade
 	updated := job.Copy()
-	updated.TaskGroups[0].Tasks[0].Resources.CPU += 10
+	updated.TaskGroups[0].Tasks[0].Config["command"] = "/bin/other"
 	require.NoError(t, h.State.UpsertJob(structs.MsgTypeTestSetup, h.NextIndex(), updated))
 
 	// Create a mock evaluation to handle the update
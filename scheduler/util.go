@@ -201,7 +201,9 @@ func (c comparison) String() string {
 var same = comparison{modified: false}
 
 // tasksUpdated creates a comparison between task groups to see if the tasks, their
-// drivers, environment variables or config have been modified.
+// drivers, environment variables or config have been modified. Changes of the
+// cpu and memory of tasks are compared by taskResourcesUpdated, as whether
+// they are destructive depends on the node.
 func tasksUpdated(jobA, jobB *structs.Job, taskGroup string) comparison {
 	a := jobA.LookupTaskGroup(taskGroup)
 	b := jobB.LookupTaskGroup(taskGroup)
@@ -308,23 +310,59 @@ func tasksUpdated(jobA, jobB *structs.Job, taskGroup string) comparison {
 	return same
 }
 
+// nonNetworkResourcesUpdated returns a difference if the non-network resources
+// of a task other than cpu, memory and memory_max changed.
 func nonNetworkResourcesUpdated(a, b *structs.Resources) comparison {
 	// Inspect the non-network resources
 	switch {
-	case a.CPU != b.CPU:
-		return difference("task cpu", a.CPU, b.CPU)
 	case a.Cores != b.Cores:
 		return difference("task cores", a.Cores, b.Cores)
-	case a.MemoryMB != b.MemoryMB:
-		return difference("task memory", a.MemoryMB, b.MemoryMB)
-	case a.MemoryMaxMB != b.MemoryMaxMB:
-		return difference("task memory max", a.MemoryMaxMB, b.MemoryMaxMB)
 	case !a.Devices.Equal(&b.Devices):
 		return difference("task devices", a.Devices, b.Devices)
 	}
 	return same
 }
 
+// taskResourcesUpdated returns a difference if the cpu, memory or memory_max of
+// a task changed and the driver of the task on the node can't update the
+// resources of running tasks. Otherwise the change is an in-place update.
+func taskResourcesUpdated(jobA, jobB *structs.Job, taskGroup string, node *structs.Node) comparison {
+	a := jobA.LookupTaskGroup(taskGroup)
+	b := jobB.LookupTaskGroup(taskGroup)
+
+	for _, at := range a.Tasks {
+		bt := b.LookupTask(at.Name)
+		if bt == nil {
+			continue
+		}
+
+		ar, br := at.Resources, bt.Resources
+		var c comparison
+		switch {
+		case ar.CPU != br.CPU:
+			c = difference("task cpu", ar.CPU, br.CPU)
+		case ar.MemoryMB != br.MemoryMB:
+			c = difference("task memory", ar.MemoryMB, br.MemoryMB)
+		case ar.MemoryMaxMB != br.MemoryMaxMB:
+			c = difference("task memory max", ar.MemoryMaxMB, br.MemoryMaxMB)
+		default:
+			continue
+		}
+
+		if !driverUpdatesTaskResources(node, at.Driver) {
+			return c
+		}
+	}
+	return same
+}
+
+// driverUpdatesTaskResources returns true if the driver on the node can update
+// the cpu and memory of running tasks.
+func driverUpdatesTaskResources(node *structs.Node, driver string) bool {
+	info := node.Drivers[driver]
+	return info != nil && info.Attributes[structs.DriverUpdateTaskResourcesAttr(driver)] == "true"
+}
+
 // consulNamespaceUpdated returns true if the Consul namespace in the task group
 // has been changed.
 //
@@ -617,6 +655,12 @@ func inplaceUpdate(ctx Context, eval *structs.Evaluation, job *structs.Job,
 			continue
 		}
 
+		// Changes of the resources of tasks require a destructive update
+		// unless their driver can update them
+		if c := taskResourcesUpdated(job, existing, update.TaskGroup.Name, node); c.modified {
+			continue
+		}
+
 		// The alloc is on a node that's now in an ineligible DC
 		if !node.IsInAnyDC(job.Datacenters) {
 			continue
@@ -861,6 +905,12 @@ func genericAllocUpdateFn(ctx Context, stack Stack, evalID string) allocUpdateTy
 			return false, true, nil
 		}
 
+		// Changes of the resources of tasks require a destructive update
+		// unless their driver can update them
+		if c := taskResourcesUpdated(newJob, existing.Job, newTG.Name, node); c.modified {
+			return false, true, nil
+		}
+
 		// The alloc is on a node that's now in an ineligible DC
 		if !node.IsInAnyDC(newJob.Datacenters) {
 			return false, true, nil
@@ -380,7 +380,9 @@ func TestTasksUpdated(t *testing.T) {
 
 	j11 := mock.Job()
 	j11.TaskGroups[0].Tasks[0].Resources.CPU = 1337
-	must.True(t, tasksUpdated(j1, j11, name).modified)
+	j11.TaskGroups[0].Tasks[0].Resources.MemoryMB = 1024
+	j11.TaskGroups[0].Tasks[0].Resources.MemoryMaxMB = 2048
+	must.False(t, tasksUpdated(j1, j11, name).modified)
 
 	j11d1 := mock.Job()
 	j11d1.TaskGroups[0].Tasks[0].Resources.Devices = structs.ResourceDevices{
@@ -1101,6 +1103,41 @@ func TestTasksUpdated_Identity(t *testing.T) {
 	must.True(t, tasksUpdated(j1, j2, name).modified)
 }
 
+func TestTaskResourcesUpdated(t *testing.T) {
+	ci.Parallel(t)
+
+	j1 := mock.Job()
+	name := j1.TaskGroups[0].Name
+	driver := j1.TaskGroups[0].Tasks[0].Driver
+
+	j2 := j1.Copy()
+	j2.TaskGroups[0].Tasks[0].Resources.CPU = 1337
+	j3 := j1.Copy()
+	j3.TaskGroups[0].Tasks[0].Resources.MemoryMB = 1024
+	j4 := j1.Copy()
+	j4.TaskGroups[0].Tasks[0].Resources.MemoryMaxMB = 2048
+
+	// Nodes whose driver can't update running tasks require destructive
+	// updates
+	node := mock.Node()
+	must.False(t, taskResourcesUpdated(j1, j1.Copy(), name, node).modified)
+	for _, j := range []*structs.Job{j2, j3, j4} {
+		must.True(t, taskResourcesUpdated(j1, j, name, node).modified)
+	}
+
+	// Nodes whose driver can update running tasks allow in-place updates
+	node.Drivers[driver].Attributes = map[string]string{
+		structs.DriverUpdateTaskResourcesAttr(driver): "true",
+	}
+	for _, j := range []*structs.Job{j2, j3, j4} {
+		must.False(t, taskResourcesUpdated(j1, j, name, node).modified)
+	}
+
+	// Nodes without the driver require destructive updates
+	delete(node.Drivers, driver)
+	must.True(t, taskResourcesUpdated(j1, j2, name, node).modified)
+}
+
 func TestTaskGroupConstraints(t *testing.T) {
 	ci.Parallel(t)
 